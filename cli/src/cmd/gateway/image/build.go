@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/api-platform/cli/internal/gateway"
@@ -41,7 +42,13 @@ ap gateway image build --name my-gateway
 ap gateway image build --name my-gateway --path ./my-policies --repository myregistry
 
 # Build with platform specification
-ap gateway image build --name my-gateway --platform linux/amd64`
+ap gateway image build --name my-gateway --platform linux/amd64
+
+# Build and push a multi-arch (amd64 + arm64) image
+ap gateway image build --name my-gateway --platform multi --push
+
+# Build, push and sign images with cosign, attaching an SPDX SBOM to each
+ap gateway image build --name my-gateway --push --sign --sign-key cosign.key`
 )
 
 var (
@@ -57,6 +64,9 @@ var (
 	noCache                  bool
 	platform                 string
 	outputDir                string
+	sign                     bool
+	signKey                  string
+	sbomFormat               string
 
 	// Computed values
 	imageTag string
@@ -82,8 +92,12 @@ func init() {
 	buildCmd.Flags().StringVar(&imageRepository, "repository", utils.DefaultImageRepository, "Docker image repository")
 	buildCmd.Flags().BoolVar(&push, "push", false, "Push image to registry after build")
 	buildCmd.Flags().BoolVar(&noCache, "no-cache", false, "Build without using cache")
-	buildCmd.Flags().StringVar(&platform, "platform", "", "Target platform (e.g., linux/amd64)")
+	buildCmd.Flags().StringVar(&platform, "platform", "",
+		"Target platform: linux/amd64, linux/arm64, a comma-separated list of both, or multi for both")
 	buildCmd.Flags().StringVar(&outputDir, "output-dir", "", "Output directory for build artifacts")
+	buildCmd.Flags().BoolVar(&sign, "sign", false, "Generate an SBOM for each pushed image and sign it with cosign (requires --push)")
+	buildCmd.Flags().StringVar(&signKey, "sign-key", "", "Cosign key reference used with --sign (default: keyless OIDC signing)")
+	buildCmd.Flags().StringVar(&sbomFormat, "sbom-format", gateway.SBOMFormatSPDX, "SBOM format used with --sign: spdx or cyclonedx")
 }
 
 // initializeDefaults sets smart defaults for gateway name and constructs the image tag
@@ -139,6 +153,12 @@ func runBuildCommand() error {
 	}
 	fmt.Println("  ✓ Docker is available")
 
+	normalized, err := normalizePlatform(platform, push)
+	if err != nil {
+		return err
+	}
+	platform = normalized
+
 	// Check docker buildx if platform is specified
 	if platform != "" {
 		if err := utils.IsDockerBuildxAvailable(); err != nil {
@@ -147,10 +167,87 @@ func runBuildCommand() error {
 		fmt.Println("  ✓ Docker buildx is available")
 	}
 
+	if err := validateSignFlags(sign, push, signKey, sbomFormat); err != nil {
+		return err
+	}
+	if sign {
+		if err := utils.IsSyftAvailable(); err != nil {
+			return fmt.Errorf("syft is required for --sign to generate SBOMs: %w", err)
+		}
+		if err := utils.IsCosignAvailable(); err != nil {
+			return fmt.Errorf("cosign is required for --sign to sign images: %w", err)
+		}
+		fmt.Println("  ✓ Syft and cosign are available")
+	}
+
 	fmt.Println()
 	return runUnifiedBuild()
 }
 
+// validateSignFlags checks the signing flags. Signatures and attestations are
+// stored next to the image in the registry, so signing requires --push.
+func validateSignFlags(sign, push bool, key, format string) error {
+	if !sign {
+		if key != "" {
+			return fmt.Errorf("--sign-key requires --sign")
+		}
+		return nil
+	}
+	if !push {
+		return fmt.Errorf("--sign requires --push: signatures are stored in the registry next to the image")
+	}
+	if format != gateway.SBOMFormatSPDX && format != gateway.SBOMFormatCycloneDX {
+		return fmt.Errorf("unsupported SBOM format %q: use %s or %s", format, gateway.SBOMFormatSPDX, gateway.SBOMFormatCycloneDX)
+	}
+	return nil
+}
+
+// supportedPlatforms are the architectures gateway images are published for
+var supportedPlatforms = []string{"linux/amd64", "linux/arm64"}
+
+// normalizePlatform validates the --platform value and expands it to the list
+// passed to docker buildx. "multi" selects every supported platform, and bare
+// architectures such as "arm64" are accepted. Building more than one platform
+// produces a manifest list, which only exists in a registry, so it requires --push.
+func normalizePlatform(value string, push bool) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if value == "multi" {
+		value = strings.Join(supportedPlatforms, ",")
+	}
+
+	var platforms []string
+	seen := make(map[string]bool)
+	for _, p := range strings.Split(value, ",") {
+		p = strings.TrimSpace(p)
+		if !strings.Contains(p, "/") {
+			p = "linux/" + p
+		}
+		supported := false
+		for _, s := range supportedPlatforms {
+			if p == s {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return "", fmt.Errorf("unsupported platform %q: supported platforms are %s (or multi for both)",
+				p, strings.Join(supportedPlatforms, ", "))
+		}
+		if !seen[p] {
+			seen[p] = true
+			platforms = append(platforms, p)
+		}
+	}
+
+	if len(platforms) > 1 && !push {
+		return "", fmt.Errorf("multi-platform builds produce a manifest list that must be pushed to a registry: add --push")
+	}
+	return strings.Join(platforms, ","), nil
+}
+
 // getBuildFilePath returns the full path to the build file
 func getBuildFilePath(basePath string) (string, error) {
 	// Check if path exists
@@ -251,13 +348,27 @@ func runUnifiedBuild() error {
 		fmt.Printf("  ✓ Build manifest file written: build-manifest.yaml\n")
 	}
 
+	// Optional supply-chain phase: SBOM + signature for each pushed image
+	var attestations []gateway.ImageAttestation
+	if sign {
+		attestDir := outputDir
+		if attestDir == "" {
+			attestDir = filepath.Dir(resolvedBuildFilePath)
+		}
+		attestations, err = runImageAttestation(tempDir, filepath.Join(attestDir, "attestations"))
+		if err != nil {
+			return fmt.Errorf("failed to sign gateway images: %w", err)
+		}
+		fmt.Println("  ✓ All images signed")
+	}
+
 	// Display Summary
-	displayBuildSummary(processed)
+	displayBuildSummary(processed, attestations)
 
 	return nil
 }
 
-func displayBuildSummary(processed []policy.ProcessedPolicy) {
+func displayBuildSummary(processed []policy.ProcessedPolicy, attestations []gateway.ImageAttestation) {
 	fmt.Println("=== Build Summary ===")
 	fmt.Println()
 
@@ -285,6 +396,36 @@ func displayBuildSummary(processed []policy.ProcessedPolicy) {
 		fmt.Printf("✓ Output artifacts copied to: %s\n", outputDir)
 		fmt.Println()
 	}
+
+	if len(attestations) > 0 {
+		fmt.Println("✓ Images signed with cosign:")
+		for _, a := range attestations {
+			fmt.Printf("  • %s@%s\n", a.Image, a.Digest)
+			fmt.Printf("    Signature: %s\n", a.Signature)
+			fmt.Printf("    SBOM:      %s\n", a.SBOMPath)
+		}
+		fmt.Printf("  Recorded in: %s\n", filepath.Join(filepath.Dir(attestations[0].SBOMPath), gateway.AttestationsFileName))
+		fmt.Println()
+	}
+}
+
+// runImageAttestation generates SBOMs for the pushed images and signs them
+func runImageAttestation(tempDir, attestDir string) ([]gateway.ImageAttestation, error) {
+	fmt.Println("  → Generating SBOMs and signing images...")
+	logFilePath, err := getDockerBuildLogPath(tempDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return gateway.AttestGatewayImages(gateway.AttestConfig{
+		ImageRepository: imageRepository,
+		GatewayName:     gatewayName,
+		GatewayVersion:  gatewayVersion,
+		SBOMFormat:      sbomFormat,
+		SigningKey:      signKey,
+		OutputDir:       attestDir,
+		LogFilePath:     logFilePath,
+	})
 }
 
 // runDockerBuild executes the docker build process for gateway images
@@ -21,6 +21,7 @@ package image
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -56,3 +57,81 @@ func TestGetDockerBuildLogPathUsesPersistentLogsDirectory(t *testing.T) {
 		t.Fatalf("expected logs directory to survive workspace cleanup: %v", err)
 	}
 }
+
+func TestNormalizePlatform(t *testing.T) {
+	tests := []struct {
+		value string
+		push  bool
+		want  string
+	}{
+		{value: "", want: ""},
+		{value: "linux/amd64", want: "linux/amd64"},
+		{value: "arm64", want: "linux/arm64"},
+		{value: "multi", push: true, want: "linux/amd64,linux/arm64"},
+		{value: "linux/arm64, amd64", push: true, want: "linux/arm64,linux/amd64"},
+		{value: "amd64,linux/amd64", want: "linux/amd64"},
+	}
+	for _, tt := range tests {
+		got, err := normalizePlatform(tt.value, tt.push)
+		if err != nil {
+			t.Fatalf("normalizePlatform(%q) failed: %v", tt.value, err)
+		}
+		if got != tt.want {
+			t.Fatalf("normalizePlatform(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizePlatformRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		value   string
+		push    bool
+		wantErr string
+	}{
+		{value: "linux/386", push: true, wantErr: `unsupported platform "linux/386"`},
+		{value: "amd64,windows/amd64", push: true, wantErr: `unsupported platform "windows/amd64"`},
+		{value: "amd64,", push: true, wantErr: `unsupported platform "linux/"`},
+		{value: "multi", push: false, wantErr: "add --push"},
+	}
+	for _, tt := range tests {
+		_, err := normalizePlatform(tt.value, tt.push)
+		if err == nil {
+			t.Fatalf("normalizePlatform(%q) succeeded, want error", tt.value)
+		}
+		if !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("normalizePlatform(%q) error = %q, want it to contain %q", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateSignFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		sign    bool
+		push    bool
+		key     string
+		format  string
+		wantErr string
+	}{
+		{name: "unsigned local build", format: "spdx"},
+		{name: "keyless", sign: true, push: true, format: "spdx"},
+		{name: "key and cyclonedx", sign: true, push: true, key: "cosign.key", format: "cyclonedx"},
+		{name: "without push", sign: true, format: "spdx", wantErr: "--sign requires --push"},
+		{name: "key without sign", push: true, key: "cosign.key", format: "spdx", wantErr: "--sign-key requires --sign"},
+		{name: "unknown format", sign: true, push: true, format: "syft-json", wantErr: `unsupported SBOM format "syft-json"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSignFlags(tt.sign, tt.push, tt.key, tt.format)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSignFlags() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("validateSignFlags() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
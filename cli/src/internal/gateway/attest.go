@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/api-platform/cli/internal/terminal"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// SBOMFormatSPDX generates SPDX JSON SBOMs
+	SBOMFormatSPDX = "spdx"
+	// SBOMFormatCycloneDX generates CycloneDX JSON SBOMs
+	SBOMFormatCycloneDX = "cyclonedx"
+
+	// AttestationsFileName is the file recording the SBOMs and signatures of a signed build
+	AttestationsFileName = "image-attestations.yaml"
+)
+
+// AttestConfig holds configuration for the post-build supply-chain phase
+type AttestConfig struct {
+	ImageRepository string
+	GatewayName     string
+	GatewayVersion  string
+	// SBOMFormat is either SBOMFormatSPDX or SBOMFormatCycloneDX
+	SBOMFormat string
+	// SigningKey is a cosign key reference (file path or KMS URI). When empty,
+	// images are signed keyless with an OIDC identity.
+	SigningKey string
+	// OutputDir receives the SBOM files and the attestations file
+	OutputDir   string
+	LogFilePath string
+}
+
+// ImageAttestation records the supply-chain artifacts produced for one image
+type ImageAttestation struct {
+	Component string `yaml:"component"`
+	Image     string `yaml:"image"`
+	Digest    string `yaml:"digest"`
+	SBOMPath  string `yaml:"sbomPath"`
+	SBOMType  string `yaml:"sbomFormat"`
+	Signature string `yaml:"signature"`
+	Keyless   bool   `yaml:"keyless"`
+}
+
+// attestationsFile is the content of AttestationsFileName
+type attestationsFile struct {
+	Gateway string             `yaml:"gateway"`
+	Version string             `yaml:"version"`
+	Images  []ImageAttestation `yaml:"images"`
+}
+
+// AttestGatewayImages generates an SBOM for each pushed gateway image, signs the
+// image with cosign and attaches the SBOM as a signed attestation. Images are
+// referenced by digest so that the signature covers exactly what was pushed.
+// The results are written to AttestationsFileName in the output directory.
+func AttestGatewayImages(config AttestConfig) ([]ImageAttestation, error) {
+	sbomFormat, sbomPredicate, err := sbomFormatArgs(config.SBOMFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	logFile, err := os.OpenFile(config.LogFilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open docker log file: %w", err)
+	}
+	defer logFile.Close()
+
+	if err := os.MkdirAll(config.OutputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create SBOM output directory: %w", err)
+	}
+
+	components := []string{"gateway-runtime", "gateway-controller"}
+	var attestations []ImageAttestation
+	for _, component := range components {
+		imageTag := fmt.Sprintf("%s/%s-%s:%s", config.ImageRepository, config.GatewayName, component, config.GatewayVersion)
+		fmt.Printf("    → Attesting %s...\n", component)
+
+		digest, err := resolveImageDigest(imageTag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve digest of %s: %w", imageTag, err)
+		}
+		ref := digestReference(imageTag, digest)
+
+		sbomPath := filepath.Join(config.OutputDir,
+			fmt.Sprintf("%s-%s-%s.%s.json", config.GatewayName, component, config.GatewayVersion, config.SBOMFormat))
+		if err := runAttestTool(logFile, "syft", "scan", "registry:"+ref, "-o", sbomFormat+"="+sbomPath); err != nil {
+			return nil, fmt.Errorf("failed to generate SBOM for %s: %w\n\nCheck logs at: %s", component, err, config.LogFilePath)
+		}
+		if err := runAttestTool(logFile, "cosign", cosignSignArgs(ref, config.SigningKey)...); err != nil {
+			return nil, fmt.Errorf("failed to sign %s: %w\n\nCheck logs at: %s", component, err, config.LogFilePath)
+		}
+		if err := runAttestTool(logFile, "cosign", cosignAttestArgs(ref, sbomPath, sbomPredicate, config.SigningKey)...); err != nil {
+			return nil, fmt.Errorf("failed to attach SBOM attestation to %s: %w\n\nCheck logs at: %s", component, err, config.LogFilePath)
+		}
+		signature, err := outputOf("cosign", "triangulate", ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve signature reference of %s: %w", imageTag, err)
+		}
+
+		attestations = append(attestations, ImageAttestation{
+			Component: component,
+			Image:     imageTag,
+			Digest:    digest,
+			SBOMPath:  sbomPath,
+			SBOMType:  config.SBOMFormat,
+			Signature: signature,
+			Keyless:   config.SigningKey == "",
+		})
+		fmt.Printf("    ✓ Signed %s\n", ref)
+	}
+
+	if err := writeAttestations(filepath.Join(config.OutputDir, AttestationsFileName), attestationsFile{
+		Gateway: config.GatewayName,
+		Version: config.GatewayVersion,
+		Images:  attestations,
+	}); err != nil {
+		return nil, err
+	}
+
+	return attestations, nil
+}
+
+// sbomFormatArgs maps an SBOM format to the syft output format and the cosign
+// attestation predicate type
+func sbomFormatArgs(format string) (string, string, error) {
+	switch format {
+	case SBOMFormatSPDX:
+		return "spdx-json", "spdxjson", nil
+	case SBOMFormatCycloneDX:
+		return "cyclonedx-json", "cyclonedx", nil
+	default:
+		return "", "", fmt.Errorf("unsupported SBOM format %q: use %s or %s", format, SBOMFormatSPDX, SBOMFormatCycloneDX)
+	}
+}
+
+// digestReference replaces the tag of an image reference with its digest
+func digestReference(imageTag, digest string) string {
+	name := imageTag
+	// Only a colon after the last slash separates the tag; earlier ones are registry ports
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + "@" + digest
+}
+
+// cosignSignArgs builds the cosign arguments to sign ref, keyless when key is empty
+func cosignSignArgs(ref, key string) []string {
+	args := []string{"sign", "--yes"}
+	if key != "" {
+		args = append(args, "--key", key)
+	}
+	return append(args, ref)
+}
+
+// cosignAttestArgs builds the cosign arguments to attach the SBOM at predicatePath to ref
+func cosignAttestArgs(ref, predicatePath, predicateType, key string) []string {
+	args := []string{"attest", "--yes", "--type", predicateType, "--predicate", predicatePath}
+	if key != "" {
+		args = append(args, "--key", key)
+	}
+	return append(args, ref)
+}
+
+// resolveImageDigest returns the registry digest of a pushed image
+func resolveImageDigest(imageTag string) (string, error) {
+	digest, err := outputOf("docker", "buildx", "imagetools", "inspect", imageTag, "--format", "{{.Manifest.Digest}}")
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("unexpected digest %q", digest)
+	}
+	return digest, nil
+}
+
+// runAttestTool runs a supply-chain tool with scrolling output written to the log file
+func runAttestTool(logFile *os.File, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+
+	// Setup scrolling output - auto-detects TTY internally, falls back to file-only if not TTY
+	scroller := terminal.NewScrollingLogger(terminal.ScrollingLoggerConfig{
+		LogFile: logFile,
+		Prefix:  "      ",
+	})
+	cmd.Stdout = scroller
+	cmd.Stderr = scroller
+	scroller.Start()
+
+	err := cmd.Run()
+	scroller.Stop()
+
+	if err != nil {
+		return fmt.Errorf("%s command failed: %w", name, err)
+	}
+
+	// Clear the scrolled logs before returning success
+	scroller.ClearDisplay()
+	return nil
+}
+
+// outputOf runs a command and returns its trimmed standard output
+func outputOf(name string, args ...string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s command failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func writeAttestations(path string, content attestationsFile) error {
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("failed to encode image attestations: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write image attestations: %w", err)
+	}
+	return nil
+}
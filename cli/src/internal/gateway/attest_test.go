@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDigestReference(t *testing.T) {
+	const digest = "sha256:abc"
+	tests := map[string]string{
+		"ghcr.io/wso2/gw-gateway-runtime:1.0.0":        "ghcr.io/wso2/gw-gateway-runtime@sha256:abc",
+		"localhost:5000/gw-gateway-runtime:1.0.0":      "localhost:5000/gw-gateway-runtime@sha256:abc",
+		"localhost:5000/gw-gateway-runtime":            "localhost:5000/gw-gateway-runtime@sha256:abc",
+		"registry.example.com/team/gw-controller:v1.2": "registry.example.com/team/gw-controller@sha256:abc",
+	}
+	for tag, want := range tests {
+		if got := digestReference(tag, digest); got != want {
+			t.Errorf("digestReference(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
+func TestCosignArgs(t *testing.T) {
+	const ref = "ghcr.io/wso2/gw-gateway-runtime@sha256:abc"
+
+	if got, want := cosignSignArgs(ref, ""), []string{"sign", "--yes", ref}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keyless sign args = %v, want %v", got, want)
+	}
+	if got, want := cosignSignArgs(ref, "cosign.key"), []string{"sign", "--yes", "--key", "cosign.key", ref}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keyed sign args = %v, want %v", got, want)
+	}
+
+	got := cosignAttestArgs(ref, "sbom.json", "spdxjson", "")
+	want := []string{"attest", "--yes", "--type", "spdxjson", "--predicate", "sbom.json", ref}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("attest args = %v, want %v", got, want)
+	}
+}
+
+func TestSBOMFormatArgs(t *testing.T) {
+	if format, predicate, err := sbomFormatArgs(SBOMFormatSPDX); err != nil || format != "spdx-json" || predicate != "spdxjson" {
+		t.Errorf("spdx = (%q, %q, %v)", format, predicate, err)
+	}
+	if format, predicate, err := sbomFormatArgs(SBOMFormatCycloneDX); err != nil || format != "cyclonedx-json" || predicate != "cyclonedx" {
+		t.Errorf("cyclonedx = (%q, %q, %v)", format, predicate, err)
+	}
+	if _, _, err := sbomFormatArgs("syft-json"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
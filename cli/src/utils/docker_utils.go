@@ -42,6 +42,24 @@ func IsDockerBuildxAvailable() error {
 	return nil
 }
 
+// IsCosignAvailable checks if cosign is installed for signing images
+func IsCosignAvailable() error {
+	cmd := exec.Command("cosign", "version")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cosign is not available: %w", err)
+	}
+	return nil
+}
+
+// IsSyftAvailable checks if syft is installed for generating SBOMs
+func IsSyftAvailable() error {
+	cmd := exec.Command("syft", "version")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("syft is not available: %w", err)
+	}
+	return nil
+}
+
 // RunDockerCommand runs a docker command and logs output to the provided file
 func RunDockerCommand(args []string, logFile *os.File) error {
 	cmd := exec.Command("docker", args...)
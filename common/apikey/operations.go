@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrOperationNotAllowed is returned when a valid API key is presented for an
+// operation outside the key's operation scope. Callers should respond with 403.
+var ErrOperationNotAllowed = errors.New("API key is not allowed to access this operation")
+
+// ValidationStatusCode returns the HTTP status an authentication policy should reject a
+// request with when ResolveValidatedAPIKey returns err or no key: 403 Forbidden for a valid
+// key used outside its operation scope and 401 Unauthorized otherwise. The api-key-auth
+// policy is built from github.com/wso2/gateway-controllers and maps its failures through
+// this function, so operation denials are not reported as bad credentials.
+func ValidationStatusCode(err error) int {
+	if errors.Is(err, ErrOperationNotAllowed) {
+		return http.StatusForbidden
+	}
+	return http.StatusUnauthorized
+}
+
+// allOperations is the scope entry granting access to every operation.
+const allOperations = "*"
+
+// MatchOperation reports whether a request for method and path falls within the
+// operation scope of a key and returns the scope entry that matched.
+//
+// operations is either "*", a single entry, or a JSON array of entries such as
+// ["GET /users", "POST /users/{id}", "* /admin/*"]. An entry is "*" or a method
+// and a path separated by whitespace; the method "*" matches any method. In
+// paths, a {param} segment matches any single segment and a trailing "*"
+// segment matches the rest of the path. path may be the concrete request path
+// or the operation's path template.
+//
+// An empty scope is treated as "*": keys created before operation scoping carry
+// no operations. A scope that cannot be parsed matches nothing.
+func MatchOperation(operations, method, path string) (string, bool) {
+	entries, ok := parseOperations(operations)
+	if !ok {
+		return "", false
+	}
+	for _, entry := range entries {
+		if operationEntryMatches(entry, method, path) {
+			return entry, true
+		}
+	}
+	return "", false
+}
+
+// operationMethods are the methods an operation scope entry may name besides "*".
+var operationMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true, http.MethodPut: true,
+	http.MethodPatch: true, http.MethodDelete: true, http.MethodOptions: true,
+}
+
+// FormatOperations validates operation scope entries and encodes them in the form
+// MatchOperation accepts. Methods are upper-cased. No entries, or an entry of "*", give
+// "*". Invalid entries return an error wrapping ErrInvalidInput.
+func FormatOperations(entries []string) (string, error) {
+	normalized := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == allOperations {
+			return allOperations, nil
+		}
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return "", fmt.Errorf("%w: operation %q must be \"*\" or a method and a path", ErrInvalidInput, entry)
+		}
+		method, path := strings.ToUpper(fields[0]), fields[1]
+		if method != allOperations && !operationMethods[method] {
+			return "", fmt.Errorf("%w: operation %q has an unsupported method", ErrInvalidInput, entry)
+		}
+		if !strings.HasPrefix(path, "/") {
+			return "", fmt.Errorf("%w: operation %q must have a path starting with /", ErrInvalidInput, entry)
+		}
+		if i := strings.Index(path, "*"); i >= 0 && (i != len(path)-1 || !strings.HasSuffix(path, "/*")) {
+			return "", fmt.Errorf("%w: operation %q may only use * as its last path segment", ErrInvalidInput, entry)
+		}
+		normalized = append(normalized, method+" "+path)
+	}
+	if len(normalized) == 0 {
+		return allOperations, nil
+	}
+	encoded, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode operations: %w", err)
+	}
+	return string(encoded), nil
+}
+
+// ListOperations returns the entries of an operation scope. A scope that is empty or "*"
+// gives ["*"]; one that cannot be parsed gives nil.
+func ListOperations(operations string) []string {
+	entries, ok := parseOperations(operations)
+	if !ok {
+		return nil
+	}
+	return entries
+}
+
+func parseOperations(operations string) ([]string, bool) {
+	operations = strings.TrimSpace(operations)
+	if operations == "" {
+		return []string{allOperations}, true
+	}
+	if !strings.HasPrefix(operations, "[") {
+		return []string{operations}, true
+	}
+	var entries []string
+	if err := json.Unmarshal([]byte(operations), &entries); err != nil {
+		return nil, false
+	}
+	return entries, true
+}
+
+func operationEntryMatches(entry, method, path string) bool {
+	entry = strings.TrimSpace(entry)
+	if entry == allOperations {
+		return true
+	}
+	fields := strings.Fields(entry)
+	if len(fields) != 2 {
+		return false
+	}
+	if fields[0] != allOperations && !strings.EqualFold(fields[0], method) {
+		return false
+	}
+	return operationPathMatches(fields[1], path)
+}
+
+func operationPathMatches(pattern, path string) bool {
+	pattern = strings.TrimSuffix(pattern, "/")
+	path = strings.TrimSuffix(path, "/")
+	if pattern == path {
+		return true
+	}
+
+	patternSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(path, "/")
+	for i, segment := range patternSegments {
+		if segment == "*" && i == len(patternSegments)-1 {
+			return true
+		}
+		if i >= len(pathSegments) {
+			return false
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return len(patternSegments) == len(pathSegments)
+}
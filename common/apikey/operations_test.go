@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatchOperation(t *testing.T) {
+	tests := []struct {
+		name        string
+		operations  string
+		method      string
+		path        string
+		wantMatched string
+		wantOK      bool
+	}{
+		{"empty scope allows all", "", "DELETE", "/users/1", "*", true},
+		{"bare wildcard", "*", "POST", "/users", "*", true},
+		{"wildcard array", `["*"]`, "PATCH", "/orders/7", "*", true},
+		{"exact match", `["GET /users"]`, "GET", "/users", "GET /users", true},
+		{"method is case insensitive", `["get /users"]`, "GET", "/users", "get /users", true},
+		{"trailing slash", `["GET /users"]`, "GET", "/users/", "GET /users", true},
+		{"other method", `["GET /users"]`, "POST", "/users", "", false},
+		{"other path", `["GET /users"]`, "GET", "/users/1", "", false},
+		{"path prefix is not a match", `["GET /users"]`, "GET", "/usersx", "", false},
+		{"second entry", `["GET /users", "POST /users"]`, "POST", "/users", "POST /users", true},
+		{"any method", `["* /users"]`, "DELETE", "/users", "* /users", true},
+		{"path param", `["GET /users/{id}"]`, "GET", "/users/42", "GET /users/{id}", true},
+		{"path param template", `["GET /users/{id}"]`, "GET", "/users/{id}", "GET /users/{id}", true},
+		{"path param is one segment", `["GET /users/{id}"]`, "GET", "/users/42/orders", "", false},
+		{"path param must not be empty", `["GET /users/{id}/orders"]`, "GET", "/users//orders", "", false},
+		{"nested path params", `["PUT /users/{id}/orders/{orderId}"]`, "PUT", "/users/1/orders/9", "PUT /users/{id}/orders/{orderId}", true},
+		{"trailing wildcard", `["GET /admin/*"]`, "GET", "/admin/users/1", "GET /admin/*", true},
+		{"trailing wildcard sibling", `["GET /admin/*"]`, "GET", "/administrator", "", false},
+		{"malformed scope", `["GET /users"`, "GET", "/users", "", false},
+		{"malformed entry", `["GET"]`, "GET", "/users", "", false},
+		{"empty array", `[]`, "GET", "/users", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, ok := MatchOperation(tt.operations, tt.method, tt.path)
+			if ok != tt.wantOK || matched != tt.wantMatched {
+				t.Errorf("MatchOperation(%q, %q, %q) = (%q, %v), want (%q, %v)",
+					tt.operations, tt.method, tt.path, matched, ok, tt.wantMatched, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestResolveValidatedAPIKeyEnforcesOperations(t *testing.T) {
+	store := NewAPIkeyStore()
+	plainAPIKey := "apip_5d1f0c4b6e1a8a2f9e7c3b0d4a6f8e2c1b3d5f7a9c0e2b4d6f8a1c3e5b7d9f0a"
+
+	apiKey := &APIKey{
+		ID:         "scoped-id",
+		Name:       "scoped-key",
+		Source:     "local",
+		APIKey:     ComputeAPIKeyHash(plainAPIKey),
+		APIId:      "api-ops",
+		Operations: `["GET /users", "GET /users/{id}"]`,
+		Status:     Active,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	if err := store.StoreAPIKey("api-ops", apiKey); err != nil {
+		t.Fatalf("Failed to store API key: %v", err)
+	}
+
+	resolvedKey, err := store.ResolveValidatedAPIKey("api-ops", "/users/17", "GET", plainAPIKey, "")
+	if err != nil || resolvedKey == nil {
+		t.Fatalf("GET /users/17 should be allowed, got key=%v err=%v", resolvedKey, err)
+	}
+
+	resolvedKey, err = store.ResolveValidatedAPIKey("api-ops", "/users", "POST", plainAPIKey, "")
+	if !errors.Is(err, ErrOperationNotAllowed) {
+		t.Errorf("POST /users error = %v, want ErrOperationNotAllowed", err)
+	}
+	if resolvedKey != nil {
+		t.Error("No API key should be returned for an operation outside the key's scope")
+	}
+}
+
+func TestResolveValidatedAPIKeyLogsDeniedOperations(t *testing.T) {
+	store := NewAPIkeyStore()
+	var logs bytes.Buffer
+	store.SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	plainAPIKey := "apip_0c2e4a6b8d1f3e5a7c9b0d2f4e6a8c1b3d5f7e9a0c2b4d6f8e1a3c5b7d9f0e2a"
+
+	apiKey := &APIKey{
+		ID:         "logged-id",
+		Name:       "logged-key",
+		Source:     "local",
+		APIKey:     ComputeAPIKeyHash(plainAPIKey),
+		APIId:      "api-ops",
+		Operations: `["GET /users"]`,
+		Status:     Active,
+	}
+	if err := store.StoreAPIKey("api-ops", apiKey); err != nil {
+		t.Fatalf("Failed to store API key: %v", err)
+	}
+
+	if _, err := store.ResolveValidatedAPIKey("api-ops", "/users", "DELETE", plainAPIKey, ""); !errors.Is(err, ErrOperationNotAllowed) {
+		t.Fatalf("DELETE /users error = %v, want ErrOperationNotAllowed", err)
+	}
+	if !strings.Contains(logs.String(), "API key operation denied") || !strings.Contains(logs.String(), "api_key_name=logged-key") {
+		t.Errorf("denied operation was not logged to the store logger: %q", logs.String())
+	}
+	if strings.Contains(logs.String(), plainAPIKey) {
+		t.Error("the store logger must never receive the API key value")
+	}
+}
+
+func TestValidationStatusCode(t *testing.T) {
+	if got := ValidationStatusCode(ErrOperationNotAllowed); got != http.StatusForbidden {
+		t.Errorf("ValidationStatusCode(ErrOperationNotAllowed) = %d, want 403", got)
+	}
+	if got := ValidationStatusCode(fmt.Errorf("wrapped: %w", ErrOperationNotAllowed)); got != http.StatusForbidden {
+		t.Errorf("ValidationStatusCode(wrapped ErrOperationNotAllowed) = %d, want 403", got)
+	}
+	for _, err := range []error{nil, ErrNotFound, errors.New("API key is empty")} {
+		if got := ValidationStatusCode(err); got != http.StatusUnauthorized {
+			t.Errorf("ValidationStatusCode(%v) = %d, want 401", err, got)
+		}
+	}
+}
+
+func TestFormatOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		want    string
+		wantErr bool
+	}{
+		{name: "no entries", entries: nil, want: "*"},
+		{name: "wildcard", entries: []string{"*"}, want: "*"},
+		{name: "wildcard among entries", entries: []string{"GET /users", " * "}, want: "*"},
+		{name: "entries", entries: []string{"get /users", "POST  /users/{id}"}, want: `["GET /users","POST /users/{id}"]`},
+		{name: "any method and trailing wildcard", entries: []string{"* /admin/*"}, want: `["* /admin/*"]`},
+		{name: "missing path", entries: []string{"GET"}, wantErr: true},
+		{name: "relative path", entries: []string{"GET users"}, wantErr: true},
+		{name: "unknown method", entries: []string{"FETCH /users"}, wantErr: true},
+		{name: "wildcard inside path", entries: []string{"GET /users/*/orders"}, wantErr: true},
+		{name: "partial wildcard segment", entries: []string{"GET /users*"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatOperations(tt.entries)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Errorf("FormatOperations(%q) error = %v, want ErrInvalidInput", tt.entries, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("FormatOperations(%q) = (%q, %v), want %q", tt.entries, got, err, tt.want)
+			}
+			if entries := ListOperations(got); len(entries) == 0 {
+				t.Errorf("ListOperations(%q) returned no entries", got)
+			}
+		})
+	}
+}
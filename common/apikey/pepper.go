@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha3"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// PepperedHashPrefix marks a stored key hash keyed with a server-side pepper:
+	// $pepper$<id>$<hex HMAC-SHA3-256 of the SHA-256 key hash>
+	PepperedHashPrefix = "$pepper$"
+
+	// MaxPepperIDLength is the maximum length of a pepper ID
+	MaxPepperIDLength = 32
+
+	// MaxSecretFileBytes is the maximum size of a signing secret or pepper file
+	MaxSecretFileBytes = 4096
+)
+
+var pepperIDRegex = regexp.MustCompile(fmt.Sprintf(`^[A-Za-z0-9_-]{1,%d}$`, MaxPepperIDLength))
+
+// ValidPepperID reports whether id can be recorded in a peppered hash
+func ValidPepperID(id string) bool {
+	return pepperIDRegex.MatchString(id)
+}
+
+// PepperAPIKeyHash keys the SHA-256 hash of an API key (see ComputeAPIKeyHash) with a
+// pepper. Peppering the SHA-256 hash rather than the key lets keys that arrive already
+// hashed from the control plane be peppered too. The pepper ID is recorded in the result
+// so the pepper can be rotated.
+func PepperAPIKeyHash(pepperID string, pepper []byte, sha256Hash string) string {
+	mac := hmac.New(func() hash.Hash { return sha3.New256() }, pepper)
+	mac.Write([]byte(sha256Hash))
+	return PepperedHashPrefix + pepperID + "$" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PepperIDOf returns the ID of the pepper a stored hash was keyed with, or false when
+// the hash is not peppered.
+func PepperIDOf(storedHash string) (string, bool) {
+	rest, ok := strings.CutPrefix(storedHash, PepperedHashPrefix)
+	if !ok {
+		return "", false
+	}
+	id, _, ok := strings.Cut(rest, "$")
+	if !ok || !ValidPepperID(id) {
+		return "", false
+	}
+	return id, true
+}
+
+// LookupHashes returns every form in which the hash of an API key may be stored: keyed
+// with each of the given peppers, in pepper ID order, and finally unpeppered for keys
+// stored before peppering was enabled. It returns nil for an empty key.
+func LookupHashes(plainAPIKey string, peppers map[string][]byte) []string {
+	sha256Hash := ComputeAPIKeyHash(plainAPIKey)
+	if sha256Hash == "" {
+		return nil
+	}
+	ids := make([]string, 0, len(peppers))
+	for id := range peppers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	hashes := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		hashes = append(hashes, PepperAPIKeyHash(id, peppers[id], sha256Hash))
+	}
+	return append(hashes, sha256Hash)
+}
+
+// ReadSecretFile reads a signing secret or pepper. The file must be a regular file that
+// is not accessible by group or others, and the secret must be at least
+// MinSignedAPIKeySecretLength bytes once surrounding whitespace is trimmed. kind names
+// the secret in errors.
+func ReadSecretFile(path, kind string) ([]byte, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s file: %w", kind, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s file: %w", kind, err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s file must be a regular file", kind)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return nil, fmt.Errorf("%s file permissions %s are too permissive; restrict to owner (e.g. 0600)", kind, perm)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(f, MaxSecretFileBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", kind, err)
+	}
+	if len(data) > MaxSecretFileBytes {
+		return nil, fmt.Errorf("%s file exceeds %d bytes", kind, MaxSecretFileBytes)
+	}
+	secret := bytes.TrimSpace(data)
+	if len(secret) < MinSignedAPIKeySecretLength {
+		return nil, fmt.Errorf("%s must be at least %d bytes", kind, MinSignedAPIKeySecretLength)
+	}
+	return secret, nil
+}
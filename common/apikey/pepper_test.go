@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testPepperOne = "pepper-one-0123456789abcdef012345"
+	testPepperTwo = "pepper-two-0123456789abcdef012345"
+)
+
+func TestPepperAPIKeyHash(t *testing.T) {
+	sha256Hash := ComputeAPIKeyHash("apip_test-key")
+	hash := PepperAPIKeyHash("p1", []byte(testPepperOne), sha256Hash)
+
+	if !strings.HasPrefix(hash, "$pepper$p1$") || len(hash) != len("$pepper$p1$")+64 {
+		t.Fatalf("Peppered hash should be $pepper$<id>$<64 hex chars>, got %s", hash)
+	}
+	if strings.Contains(hash, sha256Hash) {
+		t.Error("Peppered hash should not contain the unpeppered hash")
+	}
+	if PepperAPIKeyHash("p1", []byte(testPepperTwo), sha256Hash) == hash {
+		t.Error("Different peppers should produce different hashes")
+	}
+	if id, ok := PepperIDOf(hash); !ok || id != "p1" {
+		t.Errorf("PepperIDOf(%q) = %q, %v; want p1, true", hash, id, ok)
+	}
+	for _, stored := range []string{sha256Hash, "$pepper$", "$pepper$p1", "$pepper$bad id$abc"} {
+		if _, ok := PepperIDOf(stored); ok {
+			t.Errorf("PepperIDOf(%q) should not find a pepper ID", stored)
+		}
+	}
+}
+
+func TestLookupHashes(t *testing.T) {
+	plainAPIKey := "apip_test-key"
+	sha256Hash := ComputeAPIKeyHash(plainAPIKey)
+	peppers := map[string][]byte{"p2": []byte(testPepperTwo), "p1": []byte(testPepperOne)}
+
+	hashes := LookupHashes(plainAPIKey, peppers)
+	want := []string{
+		PepperAPIKeyHash("p1", peppers["p1"], sha256Hash),
+		PepperAPIKeyHash("p2", peppers["p2"], sha256Hash),
+		sha256Hash,
+	}
+	if strings.Join(hashes, ",") != strings.Join(want, ",") {
+		t.Errorf("LookupHashes() = %v, want %v", hashes, want)
+	}
+	if hashes := LookupHashes(plainAPIKey, nil); len(hashes) != 1 || hashes[0] != sha256Hash {
+		t.Errorf("Without peppers only the unpeppered hash should be returned, got %v", hashes)
+	}
+	if hashes := LookupHashes("  ", peppers); hashes != nil {
+		t.Errorf("An empty key should have no lookup hashes, got %v", hashes)
+	}
+}
+
+func TestAPIKeyStorePepperRotation(t *testing.T) {
+	store := NewAPIkeyStore()
+	storeKey := func(name, hash string) {
+		t.Helper()
+		err := store.StoreAPIKey("api-1", &APIKey{
+			ID: name, Name: name, APIKey: hash, APIId: "api-1", Operations: "*",
+			Status: Active, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to store API key %s: %v", name, err)
+		}
+	}
+	resolves := func(plainAPIKey string) bool {
+		key, err := store.ResolveValidatedAPIKey("api-1", "/", "GET", plainAPIKey)
+		return err == nil && key != nil
+	}
+
+	// Keys stored before peppering, under p1, and under p2
+	storeKey("legacy", ComputeAPIKeyHash("apip_legacy"))
+	storeKey("first", PepperAPIKeyHash("p1", []byte(testPepperOne), ComputeAPIKeyHash("apip_first")))
+	storeKey("second", PepperAPIKeyHash("p2", []byte(testPepperTwo), ComputeAPIKeyHash("apip_second")))
+
+	// Without peppers, only the unpeppered key validates
+	if !resolves("apip_legacy") || resolves("apip_first") || resolves("apip_second") {
+		t.Error("Peppered keys should not validate before their peppers are set")
+	}
+
+	// During a rotation both peppers are set and every key validates
+	store.SetPeppers(map[string][]byte{"p1": []byte(testPepperOne), "p2": []byte(testPepperTwo)})
+	for _, key := range []string{"apip_legacy", "apip_first", "apip_second"} {
+		if !resolves(key) {
+			t.Errorf("%s should validate while its pepper is set", key)
+		}
+	}
+	if resolves("apip_unknown") {
+		t.Error("An unknown key should not validate")
+	}
+
+	// Retiring p1 stops keys hashed with it from validating
+	store.SetPeppers(map[string][]byte{"p2": []byte(testPepperTwo)})
+	if resolves("apip_first") || !resolves("apip_second") {
+		t.Error("Only keys hashed with a set pepper should validate")
+	}
+
+	// Revocation by plain key finds the peppered hash
+	if err := store.RevokeAPIKey("api-1", "apip_second"); err != nil {
+		t.Fatalf("RevokeAPIKey() error = %v", err)
+	}
+	if resolves("apip_second") {
+		t.Error("A revoked peppered key should not validate")
+	}
+}
+
+func TestReadSecretFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string, perm os.FileMode) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), perm); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+		if err := os.Chmod(path, perm); err != nil {
+			t.Fatalf("Failed to chmod %s: %v", name, err)
+		}
+		return path
+	}
+
+	secret, err := ReadSecretFile(write("ok", testPepperOne+"\n", 0o600), "pepper")
+	if err != nil || string(secret) != testPepperOne {
+		t.Fatalf("ReadSecretFile() = %q, %v; want the trimmed secret", secret, err)
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		errContains string
+	}{
+		{name: "missing file", path: filepath.Join(dir, "missing"), errContains: "failed to open pepper file"},
+		{name: "group readable", path: write("group", testPepperOne, 0o640), errContains: "too permissive"},
+		{name: "too short", path: write("short", "short", 0o600), errContains: "at least 32 bytes"},
+		{name: "too large", path: write("large", strings.Repeat("a", MaxSecretFileBytes+1), 0o600), errContains: "exceeds"},
+		{name: "directory", path: dir, errContains: "regular file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadSecretFile(tt.path, "pepper")
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("ReadSecretFile() error = %v, want it to contain %q", err, tt.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+//
+//  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: apikey_usage.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsageReport carries API key usage from a policy engine to the gateway controller.
+type UsageReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*KeyUsage            `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageReport) Reset() {
+	*x = UsageReport{}
+	mi := &file_apikey_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReport) ProtoMessage() {}
+
+func (x *UsageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReport.ProtoReflect.Descriptor instead.
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return file_apikey_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UsageReport) GetKeys() []*KeyUsage {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// KeyUsage records when an API key last passed validation.
+type KeyUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiId         string                 `protobuf:"bytes,1,opt,name=api_id,json=apiId,proto3" json:"api_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyUsage) Reset() {
+	*x = KeyUsage{}
+	mi := &file_apikey_usage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyUsage) ProtoMessage() {}
+
+func (x *KeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_usage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyUsage.ProtoReflect.Descriptor instead.
+func (*KeyUsage) Descriptor() ([]byte, []int) {
+	return file_apikey_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyUsage) GetApiId() string {
+	if x != nil {
+		return x.ApiId
+	}
+	return ""
+}
+
+func (x *KeyUsage) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyUsage) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+var File_apikey_usage_proto protoreflect.FileDescriptor
+
+const file_apikey_usage_proto_rawDesc = "" +
+	"\n" +
+	"\x12apikey_usage.proto\x12\x16wso2.gateway.apikey.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"C\n" +
+	"\vUsageReport\x124\n" +
+	"\x04keys\x18\x01 \x03(\v2 .wso2.gateway.apikey.v1.KeyUsageR\x04keys\"v\n" +
+	"\bKeyUsage\x12\x15\n" +
+	"\x06api_id\x18\x01 \x01(\tR\x05apiId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12<\n" +
+	"\flast_used_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt2`\n" +
+	"\x12APIKeyUsageService\x12J\n" +
+	"\vReportUsage\x12#.wso2.gateway.apikey.v1.UsageReport\x1a\x16.google.protobuf.EmptyB2Z0github.com/wso2/api-platform/common/apikey/protob\x06proto3"
+
+var (
+	file_apikey_usage_proto_rawDescOnce sync.Once
+	file_apikey_usage_proto_rawDescData []byte
+)
+
+func file_apikey_usage_proto_rawDescGZIP() []byte {
+	file_apikey_usage_proto_rawDescOnce.Do(func() {
+		file_apikey_usage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apikey_usage_proto_rawDesc), len(file_apikey_usage_proto_rawDesc)))
+	})
+	return file_apikey_usage_proto_rawDescData
+}
+
+var file_apikey_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_apikey_usage_proto_goTypes = []any{
+	(*UsageReport)(nil),           // 0: wso2.gateway.apikey.v1.UsageReport
+	(*KeyUsage)(nil),              // 1: wso2.gateway.apikey.v1.KeyUsage
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 3: google.protobuf.Empty
+}
+var file_apikey_usage_proto_depIdxs = []int32{
+	1, // 0: wso2.gateway.apikey.v1.UsageReport.keys:type_name -> wso2.gateway.apikey.v1.KeyUsage
+	2, // 1: wso2.gateway.apikey.v1.KeyUsage.last_used_at:type_name -> google.protobuf.Timestamp
+	0, // 2: wso2.gateway.apikey.v1.APIKeyUsageService.ReportUsage:input_type -> wso2.gateway.apikey.v1.UsageReport
+	3, // 3: wso2.gateway.apikey.v1.APIKeyUsageService.ReportUsage:output_type -> google.protobuf.Empty
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_apikey_usage_proto_init() }
+func file_apikey_usage_proto_init() {
+	if File_apikey_usage_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apikey_usage_proto_rawDesc), len(file_apikey_usage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apikey_usage_proto_goTypes,
+		DependencyIndexes: file_apikey_usage_proto_depIdxs,
+		MessageInfos:      file_apikey_usage_proto_msgTypes,
+	}.Build()
+	File_apikey_usage_proto = out.File
+	file_apikey_usage_proto_goTypes = nil
+	file_apikey_usage_proto_depIdxs = nil
+}
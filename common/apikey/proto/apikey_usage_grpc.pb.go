@@ -0,0 +1,146 @@
+//
+//  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+//
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: apikey_usage.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	APIKeyUsageService_ReportUsage_FullMethodName = "/wso2.gateway.apikey.v1.APIKeyUsageService/ReportUsage"
+)
+
+// APIKeyUsageServiceClient is the client API for APIKeyUsageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// APIKeyUsageService is served by the gateway controller's policy xDS server
+// for policy engines to report the API keys they validated.
+type APIKeyUsageServiceClient interface {
+	// ReportUsage records when each reported key last passed validation.
+	ReportUsage(ctx context.Context, in *UsageReport, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type aPIKeyUsageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyUsageServiceClient(cc grpc.ClientConnInterface) APIKeyUsageServiceClient {
+	return &aPIKeyUsageServiceClient{cc}
+}
+
+func (c *aPIKeyUsageServiceClient) ReportUsage(ctx context.Context, in *UsageReport, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, APIKeyUsageService_ReportUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyUsageServiceServer is the server API for APIKeyUsageService service.
+// All implementations must embed UnimplementedAPIKeyUsageServiceServer
+// for forward compatibility.
+//
+// APIKeyUsageService is served by the gateway controller's policy xDS server
+// for policy engines to report the API keys they validated.
+type APIKeyUsageServiceServer interface {
+	// ReportUsage records when each reported key last passed validation.
+	ReportUsage(context.Context, *UsageReport) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAPIKeyUsageServiceServer()
+}
+
+// UnimplementedAPIKeyUsageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAPIKeyUsageServiceServer struct{}
+
+func (UnimplementedAPIKeyUsageServiceServer) ReportUsage(context.Context, *UsageReport) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUsage not implemented")
+}
+func (UnimplementedAPIKeyUsageServiceServer) mustEmbedUnimplementedAPIKeyUsageServiceServer() {}
+func (UnimplementedAPIKeyUsageServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAPIKeyUsageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIKeyUsageServiceServer will
+// result in compilation errors.
+type UnsafeAPIKeyUsageServiceServer interface {
+	mustEmbedUnimplementedAPIKeyUsageServiceServer()
+}
+
+func RegisterAPIKeyUsageServiceServer(s grpc.ServiceRegistrar, srv APIKeyUsageServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAPIKeyUsageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&APIKeyUsageService_ServiceDesc, srv)
+}
+
+func _APIKeyUsageService_ReportUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyUsageServiceServer).ReportUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyUsageService_ReportUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyUsageServiceServer).ReportUsage(ctx, req.(*UsageReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIKeyUsageService_ServiceDesc is the grpc.ServiceDesc for APIKeyUsageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APIKeyUsageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wso2.gateway.apikey.v1.APIKeyUsageService",
+	HandlerType: (*APIKeyUsageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportUsage",
+			Handler:    _APIKeyUsageService_ReportUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apikey_usage.proto",
+}
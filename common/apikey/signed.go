@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha3"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MinSignedAPIKeySecretLength is the minimum accepted length (in bytes) of the
+// secret used to sign stateless API keys.
+const MinSignedAPIKeySecretLength = 32
+
+const (
+	signedAPIKeySeparator   = "."
+	signedAPIKeyNonceLength = 16
+)
+
+var (
+	// ErrInvalidSignedAPIKey is returned when a signed API key is malformed or its signature does not match
+	ErrInvalidSignedAPIKey = errors.New("invalid signed API key")
+
+	// ErrSignedAPIKeyExpired is returned when a signed API key is past its expiry
+	ErrSignedAPIKeyExpired = errors.New("signed API key has expired")
+
+	// ErrSignedAPIKeyRevoked is returned when a signed API key is present in the revocation list
+	ErrSignedAPIKeyRevoked = errors.New("signed API key has been revoked")
+)
+
+// SignedAPIKeyClaims is the payload embedded in a signed API key.
+type SignedAPIKeyClaims struct {
+	// KeyID is the UUID of the API key record
+	KeyID string `json:"kid"`
+	// APIID is the artifact the key is bound to
+	APIID string `json:"api"`
+	// ExpiresAt is the unix expiry time in seconds (0 if the key does not expire)
+	ExpiresAt int64 `json:"exp,omitempty"`
+	// Operations is the key's operation scope in the form MatchOperation accepts
+	// (empty if the key is not scoped to operations)
+	Operations string `json:"ops,omitempty"`
+	// Nonce makes every issued value unique, including re-issues with identical claims
+	Nonce string `json:"nonce"`
+}
+
+// SignedAPIKeyCodec issues and verifies stateless API keys of the form
+// <prefix><base64url(claims)>.<base64url(mac)>.
+//
+// The MAC is always HMAC-SHA3-256 over the encoded claims. The algorithm is
+// fixed by the gateway and is never taken from the key itself.
+type SignedAPIKeyCodec struct {
+	prefix string
+	secret []byte
+}
+
+// NewSignedAPIKeyCodec creates a codec for the given key prefix and signing secret.
+func NewSignedAPIKeyCodec(prefix string, secret []byte) (*SignedAPIKeyCodec, error) {
+	if len(secret) < MinSignedAPIKeySecretLength {
+		return nil, fmt.Errorf("signing secret must be at least %d bytes, got %d",
+			MinSignedAPIKeySecretLength, len(secret))
+	}
+	return &SignedAPIKeyCodec{
+		prefix: prefix,
+		secret: append([]byte(nil), secret...),
+	}, nil
+}
+
+// Sign encodes the claims and returns the signed API key value.
+// A random nonce is generated when the claims do not carry one.
+func (c *SignedAPIKeyCodec) Sign(claims SignedAPIKeyClaims) (string, error) {
+	if claims.KeyID == "" || claims.APIID == "" {
+		return "", fmt.Errorf("signed API key claims require both key ID and API ID")
+	}
+	if claims.Nonce == "" {
+		nonce := make([]byte, signedAPIKeyNonceLength)
+		if _, err := rand.Read(nonce); err != nil {
+			return "", fmt.Errorf("failed to generate signed API key nonce: %w", err)
+		}
+		claims.Nonce = base64.RawURLEncoding.EncodeToString(nonce)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode signed API key claims: %w", err)
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	mac := c.mac(encodedPayload)
+	return c.prefix + encodedPayload + signedAPIKeySeparator + base64.RawURLEncoding.EncodeToString(mac), nil
+}
+
+// Verify checks the signature and expiry of a signed API key and returns its claims.
+func (c *SignedAPIKeyCodec) Verify(signedAPIKey string, now time.Time) (*SignedAPIKeyClaims, error) {
+	signedAPIKey = strings.TrimSpace(signedAPIKey)
+	body, ok := strings.CutPrefix(signedAPIKey, c.prefix)
+	if !ok {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	encodedPayload, encodedMAC, ok := strings.Cut(body, signedAPIKeySeparator)
+	if !ok || encodedPayload == "" || encodedMAC == "" {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	providedMAC, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	if !hmac.Equal(providedMAC, c.mac(encodedPayload)) {
+		return nil, ErrInvalidSignedAPIKey
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	var claims SignedAPIKeyClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	if claims.KeyID == "" || claims.APIID == "" {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	if claims.ExpiresAt != 0 && !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, ErrSignedAPIKeyExpired
+	}
+	return &claims, nil
+}
+
+func (c *SignedAPIKeyCodec) mac(encodedPayload string) []byte {
+	h := hmac.New(func() hash.Hash { return sha3.New256() }, c.secret)
+	h.Write([]byte(encodedPayload))
+	return h.Sum(nil)
+}
+
+// RevocationList is a concurrency-safe set of revoked signed API keys.
+// Entries are key hashes in the form the control plane stores them: the SHA-256 hash
+// from ComputeAPIKeyHash, or its peppered form (see PepperAPIKeyHash). The gateway
+// controller publishes them over xDS with the API key state. Keying by hash rather
+// than key ID keeps a regenerated key valid while the value it replaced stays revoked.
+type RevocationList struct {
+	mu     sync.RWMutex
+	hashes map[string]struct{}
+}
+
+// NewRevocationList creates an empty revocation list
+func NewRevocationList() *RevocationList {
+	return &RevocationList{hashes: make(map[string]struct{})}
+}
+
+// Add marks the key with the given hash as revoked
+func (r *RevocationList) Add(keyHash string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hashes[keyHash] = struct{}{}
+}
+
+// Remove clears the revocation for the key with the given hash
+func (r *RevocationList) Remove(keyHash string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.hashes, keyHash)
+}
+
+// Contains reports whether the key with the given hash has been revoked
+func (r *RevocationList) Contains(keyHash string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.hashes[keyHash]
+	return ok
+}
+
+// Replace atomically replaces the revocation list with the given key hashes
+func (r *RevocationList) Replace(keyHashes []string) {
+	next := make(map[string]struct{}, len(keyHashes))
+	for _, keyHash := range keyHashes {
+		next[keyHash] = struct{}{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hashes = next
+}
+
+// SignedAPIKeyVerifier validates signed API keys without a key store lookup.
+// Only the revocation list must be kept in sync with the control plane.
+// APIkeyStore falls back to it for keys it does not hold (see SetSignedAPIKeyCodec).
+type SignedAPIKeyVerifier struct {
+	codec       *SignedAPIKeyCodec
+	revocations *RevocationList
+	now         func() time.Time
+}
+
+// NewSignedAPIKeyVerifier creates a verifier. A nil revocation list is treated as empty.
+func NewSignedAPIKeyVerifier(codec *SignedAPIKeyCodec, revocations *RevocationList) *SignedAPIKeyVerifier {
+	if revocations == nil {
+		revocations = NewRevocationList()
+	}
+	return &SignedAPIKeyVerifier{
+		codec:       codec,
+		revocations: revocations,
+		now:         time.Now,
+	}
+}
+
+// Revocations returns the revocation list consulted by the verifier
+func (v *SignedAPIKeyVerifier) Revocations() *RevocationList {
+	return v.revocations
+}
+
+// Verify validates the provided signed API key for the given API and returns its claims.
+// peppers are those the revoked hashes may be keyed with, as for LookupHashes.
+func (v *SignedAPIKeyVerifier) Verify(apiID, providedAPIKey string, peppers map[string][]byte) (*SignedAPIKeyClaims, error) {
+	if v == nil || v.codec == nil {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	claims, err := v.codec.Verify(providedAPIKey, v.now())
+	if err != nil {
+		return nil, err
+	}
+	if claims.APIID != apiID {
+		return nil, ErrInvalidSignedAPIKey
+	}
+	for _, hash := range LookupHashes(strings.TrimSpace(providedAPIKey), peppers) {
+		if v.revocations.Contains(hash) {
+			return nil, ErrSignedAPIKeyRevoked
+		}
+	}
+	return claims, nil
+}
+
+// apiKey returns the API key record implied by verified claims. Signed keys carry no
+// application or issuer.
+func (c *SignedAPIKeyClaims) apiKey() *APIKey {
+	operations := c.Operations
+	if operations == "" {
+		operations = allOperations
+	}
+	apiKey := &APIKey{
+		ID:         c.KeyID,
+		APIId:      c.APIID,
+		Operations: operations,
+		Status:     Active,
+		Source:     "local",
+	}
+	if c.ExpiresAt != 0 {
+		expiresAt := time.Unix(c.ExpiresAt, 0)
+		apiKey.ExpiresAt = &expiresAt
+	}
+	return apiKey
+}
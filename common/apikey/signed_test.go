@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSigningSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestNewSignedAPIKeyCodec_RejectsShortSecret(t *testing.T) {
+	_, err := NewSignedAPIKeyCodec("apip_", []byte("too-short"))
+	assert.Error(t, err)
+}
+
+func TestSignedAPIKeyCodec_SignAndVerify(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+
+	now := time.Now()
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "apip_"))
+
+	again, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", ExpiresAt: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, again, "identical claims must still yield distinct keys")
+
+	claims, err := codec.Verify(key, now)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", claims.KeyID)
+	assert.Equal(t, "api-1", claims.APIID)
+
+	_, err = codec.Verify(key, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrSignedAPIKeyExpired)
+}
+
+func TestSignedAPIKeyCodec_RejectsTamperedKeys(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1"})
+	require.NoError(t, err)
+
+	otherCodec, err := NewSignedAPIKeyCodec("apip_", []byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(t, err)
+	forged, err := otherCodec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-2"})
+	require.NoError(t, err)
+
+	payload, mac, _ := strings.Cut(strings.TrimPrefix(key, "apip_"), ".")
+	_, forgedMAC, _ := strings.Cut(strings.TrimPrefix(forged, "apip_"), ".")
+
+	for name, candidate := range map[string]string{
+		"wrong secret":  forged,
+		"swapped mac":   "apip_" + payload + "." + forgedMAC,
+		"missing mac":   "apip_" + payload,
+		"wrong prefix":  "other_" + payload + "." + mac,
+		"garbage":       "apip_not-a-key",
+		"truncated mac": "apip_" + payload + "." + mac[:len(mac)-2],
+		"empty":         "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := codec.Verify(candidate, time.Now())
+			assert.ErrorIs(t, err, ErrInvalidSignedAPIKey)
+		})
+	}
+}
+
+func TestSignedAPIKeyVerifier(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1"})
+	require.NoError(t, err)
+
+	verifier := NewSignedAPIKeyVerifier(codec, nil)
+
+	claims, err := verifier.Verify("api-1", key, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", claims.KeyID)
+
+	_, err = verifier.Verify("api-2", key, nil)
+	assert.ErrorIs(t, err, ErrInvalidSignedAPIKey)
+
+	verifier.Revocations().Add(ComputeAPIKeyHash(key))
+	_, err = verifier.Verify("api-1", key, nil)
+	assert.ErrorIs(t, err, ErrSignedAPIKeyRevoked)
+
+	// A key re-issued under the same ID is unaffected by the revoked value
+	reissued, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	_, err = verifier.Verify("api-1", reissued, nil)
+	assert.NoError(t, err)
+
+	verifier.Revocations().Replace(nil)
+	_, err = verifier.Verify("api-1", key, nil)
+	assert.NoError(t, err)
+
+	// Revoked hashes may be peppered
+	peppers := map[string][]byte{"p1": []byte("pepper-one-0123456789abcdef012345")}
+	verifier.Revocations().Add(PepperAPIKeyHash("p1", peppers["p1"], ComputeAPIKeyHash(key)))
+	_, err = verifier.Verify("api-1", key, peppers)
+	assert.ErrorIs(t, err, ErrSignedAPIKeyRevoked)
+}
+
+func TestAPIkeyStore_ResolvesSignedAPIKeys(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	store := NewAPIkeyStore()
+	_, err = store.ResolveValidatedAPIKey("api-1", "/pets", "GET", key)
+	assert.ErrorIs(t, err, ErrNotFound, "signed keys are only verified once a codec is set")
+
+	store.SetSignedAPIKeyCodec(codec)
+	resolved, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", key)
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+	assert.Equal(t, "key-1", resolved.ID)
+	assert.Equal(t, "api-1", resolved.APIId)
+	require.NotNil(t, resolved.ExpiresAt)
+
+	_, err = store.ResolveValidatedAPIKey("api-2", "/pets", "GET", key)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.ResolveValidatedAPIKey("api-1", "/pets", "GET", "apip_unsigned-random-key")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	store.ReplaceRevocations([]string{ComputeAPIKeyHash(key)})
+	resolved, err = store.ResolveValidatedAPIKey("api-1", "/pets", "GET", key)
+	assert.NoError(t, err)
+	assert.Nil(t, resolved, "revoked signed keys must not validate")
+
+	// A stored record takes precedence over the revocation list
+	require.NoError(t, store.StoreAPIKey("api-1", &APIKey{
+		ID:         "key-1",
+		Name:       "key-1",
+		APIKey:     ComputeAPIKeyHash(key),
+		APIId:      "api-1",
+		Operations: "*",
+		Status:     Active,
+	}))
+	resolved, err = store.ResolveValidatedAPIKey("api-1", "/pets", "GET", key)
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+	assert.Equal(t, "key-1", resolved.Name)
+}
+
+func TestAPIkeyStore_EnforcesSignedAPIKeyOperations(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", Operations: `["GET /pets/{id}"]`})
+	require.NoError(t, err)
+
+	store := NewAPIkeyStore()
+	store.SetSignedAPIKeyCodec(codec)
+	resolved, err := store.ResolveValidatedAPIKey("api-1", "/pets/7", "GET", key)
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+
+	resolved, err = store.ResolveValidatedAPIKey("api-1", "/pets/7", "DELETE", key)
+	assert.ErrorIs(t, err, ErrOperationNotAllowed)
+	assert.Nil(t, resolved)
+}
+
+func TestAPIkeyStore_RejectsExpiredSignedAPIKeys(t *testing.T) {
+	codec, err := NewSignedAPIKeyCodec("apip_", testSigningSecret)
+	require.NoError(t, err)
+	key, err := codec.Sign(SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1", ExpiresAt: time.Now().Add(-time.Minute).Unix()})
+	require.NoError(t, err)
+
+	store := NewAPIkeyStore()
+	store.SetSignedAPIKeyCodec(codec)
+	resolved, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", key)
+	assert.NoError(t, err)
+	assert.Nil(t, resolved)
+}
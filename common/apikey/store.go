@@ -23,6 +23,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
@@ -101,15 +102,31 @@ var (
 type APIkeyStore struct {
 	mu sync.RWMutex // Protects concurrent access
 	// API Keys storage indexed by hash
-	// Key: "API ID" → Value: map[SHA256(plain key)]*APIKey
+	// Key: "API ID" → Value: map[stored hash]*APIKey, where the stored hash is
+	// SHA256(plain key), optionally keyed with a pepper (see PepperAPIKeyHash)
 	// Both local and external keys use the same hash-based lookup
 	apiKeysByAPI map[string]map[string]*APIKey
+	// peppers keyed by ID, used to find keys whose stored hash is peppered
+	peppers map[string][]byte
+	// signedKeys verifies signed API keys that are not stored; nil when signed keys are
+	// only accepted through their stored hash
+	signedKeys *SignedAPIKeyVerifier
+	// revocations holds the hashes of revoked signed keys, published by the controller
+	revocations *RevocationList
+	// logger receives validation decisions; nil logs through slog.Default()
+	logger *slog.Logger
+
+	usageMu sync.Mutex // Protects usage
+	// usage holds the latest validation time per key until collected by TakeUsage;
+	// nil while usage tracking is disabled
+	usage map[usageKey]time.Time
 }
 
 // NewAPIkeyStore creates a new in-memory API key store
 func NewAPIkeyStore() *APIkeyStore {
 	return &APIkeyStore{
 		apiKeysByAPI: make(map[string]map[string]*APIKey),
+		revocations:  NewRevocationList(),
 	}
 }
 
@@ -121,6 +138,57 @@ func GetAPIkeyStoreInstance() *APIkeyStore {
 	return instance
 }
 
+// SetPeppers sets the server-side peppers, keyed by ID, that stored key hashes may be
+// keyed with (see PepperAPIKeyHash). Keys whose hash names a pepper that is not set
+// never validate.
+func (aks *APIkeyStore) SetPeppers(peppers map[string][]byte) {
+	copied := make(map[string][]byte, len(peppers))
+	for id, pepper := range peppers {
+		copied[id] = append([]byte(nil), pepper...)
+	}
+
+	aks.mu.Lock()
+	defer aks.mu.Unlock()
+	aks.peppers = copied
+}
+
+// SetLogger sets the logger that validation decisions, such as operations denied to a
+// key, are logged to. A nil logger logs through slog.Default().
+func (aks *APIkeyStore) SetLogger(logger *slog.Logger) {
+	aks.mu.Lock()
+	defer aks.mu.Unlock()
+	aks.logger = logger
+}
+
+// SetSignedAPIKeyCodec enables validation of signed API keys the store does not hold,
+// such as keys issued after the last key state was received, from their signature
+// and the revocation list. A nil codec disables it.
+func (aks *APIkeyStore) SetSignedAPIKeyCodec(codec *SignedAPIKeyCodec) {
+	aks.mu.Lock()
+	defer aks.mu.Unlock()
+	if codec == nil {
+		aks.signedKeys = nil
+		return
+	}
+	aks.signedKeys = NewSignedAPIKeyVerifier(codec, aks.revocations)
+}
+
+// ReplaceRevocations replaces the hashes of revoked signed API keys (see RevocationList).
+func (aks *APIkeyStore) ReplaceRevocations(keyHashes []string) {
+	aks.revocations.Replace(keyHashes)
+}
+
+// findByPlainKey returns the stored hash and API key matching a plain key, trying each
+// form its hash may be stored in. The caller must hold aks.mu.
+func (aks *APIkeyStore) findByPlainKey(apiId, plainAPIKey string) (string, *APIKey, bool) {
+	for _, hash := range LookupHashes(plainAPIKey, aks.peppers) {
+		if apiKey, exists := aks.apiKeysByAPI[apiId][hash]; exists {
+			return hash, apiKey, true
+		}
+	}
+	return "", nil, false
+}
+
 // StoreAPIKey stores an API key in the in-memory cache, indexed by its hash
 func (aks *APIkeyStore) StoreAPIKey(apiId string, apiKey *APIKey) error {
 	if apiKey == nil {
@@ -193,7 +261,9 @@ func cloneAPIKey(apiKey *APIKey) *APIKey {
 }
 
 // ResolveValidatedAPIKey validates the provided API key and returns the matched API key object.
-// It returns (nil, nil) when a key is found but does not satisfy validation constraints.
+// It returns (nil, nil) when a key is found but does not satisfy validation constraints, and
+// ErrOperationNotAllowed when a valid key is used outside its operation scope (see MatchOperation);
+// ValidationStatusCode maps the outcome to the status a policy should respond with.
 // issuer, when non-empty, restricts validation to keys from a specific portal.
 func (aks *APIkeyStore) ResolveValidatedAPIKey(apiId, apiOperation, operationMethod, providedAPIKey string, issuer ...string) (*APIKey, error) {
 	// Normalize the provided API key.
@@ -202,23 +272,32 @@ func (aks *APIkeyStore) ResolveValidatedAPIKey(apiId, apiOperation, operationMet
 		return nil, fmt.Errorf("API key is empty")
 	}
 
-	// Compute hash for lookup (hash the full API key value as-is).
-	hash := ComputeAPIKeyHash(providedAPIKey)
-	if hash == "" {
-		return nil, fmt.Errorf("failed to compute API key hash")
-	}
-
 	aks.mu.RLock()
 
-	// Single unified O(1) lookup by hash.
-	targetAPIKey, exists := aks.apiKeysByAPI[apiId][hash]
-	if !exists {
-		aks.mu.RUnlock()
-		return nil, ErrNotFound
-	}
-
+	// O(1) lookup by hash, once for each pepper the hash may be keyed with.
+	_, targetAPIKey, exists := aks.findByPlainKey(apiId, providedAPIKey)
 	clonedAPIKey := cloneAPIKey(targetAPIKey)
+	signedKeys, peppers, logger := aks.signedKeys, aks.peppers, aks.logger
 	aks.mu.RUnlock()
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	if !exists {
+		// A stored key always takes precedence; otherwise fall back to the signature.
+		if signedKeys == nil {
+			return nil, ErrNotFound
+		}
+		claims, err := signedKeys.Verify(apiId, providedAPIKey, peppers)
+		if errors.Is(err, ErrInvalidSignedAPIKey) {
+			return nil, ErrNotFound
+		}
+		if err != nil {
+			// Expired or revoked
+			return nil, nil
+		}
+		clonedAPIKey = claims.apiKey()
+	}
 
 	// Check if the API key belongs to the specified API.
 	if clonedAPIKey.APIId != apiId {
@@ -242,9 +321,25 @@ func (aks *APIkeyStore) ResolveValidatedAPIKey(apiId, apiOperation, operationMet
 		return nil, nil
 	}
 
-	// TODO: Currently, API key creation happens only per API, not per operation, since it was decided to remove the operations field from API keys.
-	// Therefore, this implementation should include some kind of mapping so that when a policy is attached to a resource, this method
-	// can look up that mapping and perform the validation.
+	// Check that the requested operation is within the key's operation scope.
+	matched, ok := MatchOperation(clonedAPIKey.Operations, operationMethod, apiOperation)
+	if !ok {
+		logger.Info("API key operation denied",
+			slog.String("api_id", apiId),
+			slog.String("api_key_name", clonedAPIKey.Name),
+			slog.String("method", operationMethod),
+			slog.String("operation", apiOperation))
+		return nil, ErrOperationNotAllowed
+	}
+	logger.Debug("API key operation allowed",
+		slog.String("api_id", apiId),
+		slog.String("api_key_name", clonedAPIKey.Name),
+		slog.String("method", operationMethod),
+		slog.String("operation", apiOperation),
+		slog.String("matched_operation", matched))
+
+	aks.recordUsage(apiId, clonedAPIKey.ID, time.Now())
+
 	return clonedAPIKey, nil
 }
 
@@ -286,14 +381,8 @@ func (aks *APIkeyStore) RevokeAPIKey(apiId, providedAPIKey string) error {
 		return nil // Idempotent - treat empty key as already revoked
 	}
 
-	// Compute hash for lookup (hash the full API key value as-is)
-	hash := ComputeAPIKeyHash(providedAPIKey)
-	if hash == "" {
-		return nil // Idempotent - treat invalid key as already revoked
-	}
-
-	// Single unified O(1) lookup by hash
-	matchedKey, exists := aks.apiKeysByAPI[apiId][hash]
+	// Lookup by hash, once for each pepper the hash may be keyed with
+	hash, matchedKey, exists := aks.findByPlainKey(apiId, providedAPIKey)
 	if !exists {
 		return nil // Idempotent - key doesn't exist, treat as already revoked
 	}
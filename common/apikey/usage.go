@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"sort"
+	"time"
+)
+
+//go:generate protoc -I proto --go_out=proto --go_opt=paths=source_relative --go-grpc_out=proto --go-grpc_opt=paths=source_relative apikey_usage.proto
+
+// MaxUsageReportKeys caps the number of keys in a single usage report sent to
+// the gateway controller's APIKeyUsageService (see proto/apikey_usage.proto).
+const MaxUsageReportKeys = 1000
+
+// KeyUsage records when an API key last passed validation.
+type KeyUsage struct {
+	APIId      string
+	KeyID      string
+	LastUsedAt time.Time
+}
+
+type usageKey struct {
+	apiId string
+	keyID string
+}
+
+// EnableUsageTracking makes the store record the keys that pass validation until
+// they are collected with TakeUsage. Tracking is off by default so stores whose
+// usage is never collected do not accumulate it.
+func (aks *APIkeyStore) EnableUsageTracking() {
+	aks.usageMu.Lock()
+	defer aks.usageMu.Unlock()
+	if aks.usage == nil {
+		aks.usage = make(map[usageKey]time.Time)
+	}
+}
+
+// TakeUsage returns the usage recorded since the last call, ordered by API and key ID,
+// and clears it.
+func (aks *APIkeyStore) TakeUsage() []KeyUsage {
+	aks.usageMu.Lock()
+	pending := aks.usage
+	if pending != nil {
+		aks.usage = make(map[usageKey]time.Time)
+	}
+	aks.usageMu.Unlock()
+
+	records := make([]KeyUsage, 0, len(pending))
+	for key, usedAt := range pending {
+		records = append(records, KeyUsage{APIId: key.apiId, KeyID: key.keyID, LastUsedAt: usedAt})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].APIId != records[j].APIId {
+			return records[i].APIId < records[j].APIId
+		}
+		return records[i].KeyID < records[j].KeyID
+	})
+	return records
+}
+
+// RequeueUsage puts back usage that could not be delivered, keeping the later time
+// for keys used again in the meantime.
+func (aks *APIkeyStore) RequeueUsage(records []KeyUsage) {
+	for _, record := range records {
+		aks.recordUsage(record.APIId, record.KeyID, record.LastUsedAt)
+	}
+}
+
+// recordUsage notes that a key was used at usedAt when usage tracking is enabled.
+func (aks *APIkeyStore) recordUsage(apiId, keyID string, usedAt time.Time) {
+	if keyID == "" {
+		return
+	}
+
+	aks.usageMu.Lock()
+	defer aks.usageMu.Unlock()
+	if aks.usage == nil {
+		return
+	}
+	key := usageKey{apiId: apiId, keyID: keyID}
+	if previous, ok := aks.usage[key]; !ok || usedAt.After(previous) {
+		aks.usage[key] = usedAt
+	}
+}
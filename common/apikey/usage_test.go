@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikey
+
+import (
+	"testing"
+	"time"
+)
+
+func newUsageTestStore(t *testing.T, plainAPIKey string) *APIkeyStore {
+	t.Helper()
+	store := NewAPIkeyStore()
+	err := store.StoreAPIKey("api-1", &APIKey{
+		ID:         "key-1",
+		Name:       "usage-key",
+		APIKey:     ComputeAPIKeyHash(plainAPIKey),
+		APIId:      "api-1",
+		Operations: "*",
+		Status:     Active,
+	})
+	if err != nil {
+		t.Fatalf("Failed to store API key: %v", err)
+	}
+	return store
+}
+
+func TestAPIKeyStoreUsageTracking(t *testing.T) {
+	plainAPIKey := "apip_usage-key"
+	store := newUsageTestStore(t, plainAPIKey)
+
+	if _, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", plainAPIKey); err != nil {
+		t.Fatalf("Failed to validate API key: %v", err)
+	}
+	if usage := store.TakeUsage(); len(usage) != 0 {
+		t.Fatalf("Usage should not be recorded while tracking is disabled, got %v", usage)
+	}
+
+	store.EnableUsageTracking()
+	before := time.Now()
+	if _, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", plainAPIKey); err != nil {
+		t.Fatalf("Failed to validate API key: %v", err)
+	}
+	if _, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", "apip_unknown-key"); err != ErrNotFound {
+		t.Fatalf("Unknown key should not be found, got %v", err)
+	}
+
+	usage := store.TakeUsage()
+	if len(usage) != 1 || usage[0].APIId != "api-1" || usage[0].KeyID != "key-1" || usage[0].LastUsedAt.Before(before) {
+		t.Fatalf("Expected one usage record for key-1, got %v", usage)
+	}
+	if again := store.TakeUsage(); len(again) != 0 {
+		t.Fatalf("TakeUsage should clear collected usage, got %v", again)
+	}
+
+	// Requeued usage keeps the later of the requeued and newly recorded times
+	if _, err := store.ResolveValidatedAPIKey("api-1", "/pets", "GET", plainAPIKey); err != nil {
+		t.Fatalf("Failed to validate API key: %v", err)
+	}
+	stale := KeyUsage{APIId: "api-1", KeyID: "key-1", LastUsedAt: before.Add(-time.Hour)}
+	other := KeyUsage{APIId: "api-2", KeyID: "key-2", LastUsedAt: before}
+	store.RequeueUsage([]KeyUsage{stale, other})
+	requeued := store.TakeUsage()
+	if len(requeued) != 2 || requeued[0].KeyID != "key-1" || requeued[0].LastUsedAt.Before(before) || requeued[1] != other {
+		t.Fatalf("Requeued usage should keep the latest time per key, got %v", requeued)
+	}
+}
@@ -68,8 +68,19 @@ func AuthMiddleware(config models.AuthConfig, logger *slog.Logger) (func(http.Ha
 		authenticators = append(authenticators, NewBasicAuthenticator(config, logger))
 	}
 
-	if config.JWTConfig != nil && config.JWTConfig.Enabled {
-		jwtAuthenticator, err := NewJWTAuthenticator(&config, logger)
+	// A single identity provider validates every token; with several, the
+	// provider is selected by the token's issuer.
+	switch idps := enabledIDPConfigs(config); {
+	case len(idps) == 1:
+		idpConfig := config
+		idpConfig.JWTConfig = &idps[0]
+		jwtAuthenticator, err := NewJWTAuthenticator(&idpConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize JWT authenticator: %w", err)
+		}
+		authenticators = append(authenticators, jwtAuthenticator)
+	case len(idps) > 1:
+		jwtAuthenticator, err := NewIssuerJWTAuthenticator(&config, idps, logger)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize JWT authenticator: %w", err)
 		}
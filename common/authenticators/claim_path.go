@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// claimPath locates a value in the JWT claims, such as realm_access.roles,
+// resource_access["gateway-client"].roles or groups[0]. Keys are separated
+// by dots or given in brackets as quoted strings; array elements are
+// selected by a bracketed index.
+type claimPath struct {
+	raw      string
+	segments []claimPathSegment
+}
+
+// claimPathSegment is a map key, or an array index when isIndex is set.
+type claimPathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseClaimPath parses a claim path in dot/bracket notation.
+func parseClaimPath(path string) (claimPath, error) {
+	if strings.TrimSpace(path) == "" {
+		return claimPath{}, fmt.Errorf("claim path is empty")
+	}
+
+	var segments []claimPathSegment
+	rest := path
+	expectKey := true
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return claimPath{}, fmt.Errorf("claim path %q: missing ']'", path)
+			}
+			inner := rest[1:end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				segments = append(segments, claimPathSegment{key: inner[1 : len(inner)-1]})
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return claimPath{}, fmt.Errorf("claim path %q: %q is neither a quoted key nor an array index", path, inner)
+				}
+				segments = append(segments, claimPathSegment{index: index, isIndex: true})
+			}
+			rest = rest[end+1:]
+			expectKey = false
+		case rest[0] == '.':
+			if expectKey {
+				return claimPath{}, fmt.Errorf("claim path %q: empty key", path)
+			}
+			rest = rest[1:]
+			expectKey = true
+			if rest == "" {
+				return claimPath{}, fmt.Errorf("claim path %q: empty key", path)
+			}
+		default:
+			if !expectKey {
+				return claimPath{}, fmt.Errorf("claim path %q: expected '.' or '['", path)
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			segments = append(segments, claimPathSegment{key: rest[:end]})
+			rest = rest[end:]
+			expectKey = false
+		}
+	}
+	return claimPath{raw: path, segments: segments}, nil
+}
+
+// lookup returns the value at the path, and false when it is not present.
+// A top-level claim whose name is the whole path, such as a namespaced
+// claim like https://example.com/roles, takes precedence.
+func (p claimPath) lookup(claims map[string]any) (any, bool) {
+	if value, ok := claims[p.raw]; ok {
+		return value, true
+	}
+
+	var current any = claims
+	for _, segment := range p.segments {
+		if segment.isIndex {
+			array, ok := current.([]any)
+			if !ok || segment.index >= len(array) {
+				return nil, false
+			}
+			current = array[segment.index]
+			continue
+		}
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[segment.key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// claimValues flattens a claim value into strings. A string is split on
+// whitespace, as for space-delimited scopes. Arrays are flattened, including
+// nested arrays, and their string elements are kept whole. Other values are
+// ignored.
+func claimValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []string:
+		return v
+	case []any:
+		return arrayClaimValues(v)
+	default:
+		return nil
+	}
+}
+
+func arrayClaimValues(array []any) []string {
+	var values []string
+	for _, element := range array {
+		switch e := element.(type) {
+		case string:
+			values = append(values, e)
+		case []any:
+			values = append(values, arrayClaimValues(e)...)
+		}
+	}
+	return values
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/models"
+)
+
+// keycloakClaims are claims shaped like a Keycloak access token.
+const keycloakClaims = `{
+	"sub": "user123",
+	"scope": "openid profile",
+	"groups": [["gateway-admins"], "ops team"],
+	"realm_access": {"roles": ["offline_access", "gateway-dev"]},
+	"resource_access": {"gateway-client": {"roles": ["gateway-admin"]}},
+	"https://example.com/roles": ["namespaced"]
+}`
+
+func parseTestClaims(t *testing.T) jwt.MapClaims {
+	t.Helper()
+	claims := jwt.MapClaims{}
+	require.NoError(t, json.Unmarshal([]byte(keycloakClaims), &claims))
+	return claims
+}
+
+func TestClaimPath_Lookup(t *testing.T) {
+	claims := parseTestClaims(t)
+
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "scope", expected: []string{"openid", "profile"}},
+		{path: "realm_access.roles", expected: []string{"offline_access", "gateway-dev"}},
+		{path: `resource_access["gateway-client"].roles`, expected: []string{"gateway-admin"}},
+		{path: `resource_access['gateway-client']["roles"][0]`, expected: []string{"gateway-admin"}},
+		{path: "groups", expected: []string{"gateway-admins", "ops team"}},
+		{path: "groups[0][0]", expected: []string{"gateway-admins"}},
+		{path: "https://example.com/roles", expected: []string{"namespaced"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, err := parseClaimPath(tt.path)
+			require.NoError(t, err)
+			value, ok := path.lookup(claims)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, claimValues(value))
+		})
+	}
+
+	for _, missing := range []string{"realm_access.missing", "realm_access.roles[5]", "scope.roles", "sub[0]"} {
+		path, err := parseClaimPath(missing)
+		require.NoError(t, err)
+		_, ok := path.lookup(claims)
+		assert.False(t, ok, missing)
+	}
+}
+
+func TestParseClaimPath_Invalid(t *testing.T) {
+	for _, path := range []string{"", "realm_access.", ".roles", "a..b", "roles[", "roles[x]", "roles[-1]", "roles[0]name"} {
+		_, err := parseClaimPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestJWTAuthenticator_ResolvePermissions_NestedClaim(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	config := &models.AuthConfig{JWTConfig: &models.IDPConfig{
+		ScopeClaim:        "realm_access.roles",
+		PermissionMapping: &map[string][]string{"developer": {"gateway-dev"}},
+	}}
+
+	authenticator, err := newJWTAuthenticatorWithJWKS(config, logger, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"offline_access", "developer"}, authenticator.resolvePermissions(parseTestClaims(t)))
+}
+
+func TestJWTAuthenticator_ResolvePermissions_MultipleClaims(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	config := &models.AuthConfig{JWTConfig: &models.IDPConfig{
+		ScopeClaim:  "realm_access.roles",
+		ScopeClaims: []string{`resource_access["gateway-client"].roles`, "groups", "missing.claim", "realm_access.roles"},
+		PermissionMapping: &map[string][]string{
+			"admin":     {"gateway-admin", "gateway-admins"},
+			"developer": {"gateway-dev"},
+			"consumer":  {"*"},
+		},
+	}}
+
+	authenticator, err := newJWTAuthenticatorWithJWKS(config, logger, false)
+	require.NoError(t, err)
+
+	// Values are merged and deduplicated before the mapping is applied.
+	assert.Equal(t,
+		[]string{"consumer", "developer", "admin", "admin", "consumer"},
+		authenticator.resolvePermissions(parseTestClaims(t)))
+}
+
+func TestNewJWTAuthenticator_InvalidRolesClaim(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	config := &models.AuthConfig{JWTConfig: &models.IDPConfig{ScopeClaims: []string{"realm_access..roles"}}}
+
+	_, err := newJWTAuthenticatorWithJWKS(config, logger, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid roles claim")
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/wso2/api-platform/common/constants"
+	"github.com/wso2/api-platform/common/models"
+)
+
+// ErrUnknownIssuer is returned for tokens whose issuer is not configured.
+var ErrUnknownIssuer = errors.New("token issuer is not trusted")
+
+// IssuerJWTAuthenticator accepts JWTs from several identity providers. The
+// provider is selected by the token's "iss" claim, and the token is then
+// validated with that provider's JWKS and rules, including the issuer check.
+type IssuerJWTAuthenticator struct {
+	byIssuer map[string]*JWTAuthenticator
+	logger   *slog.Logger
+}
+
+// NewIssuerJWTAuthenticator creates a JWT authenticator for each of idps.
+// Every provider needs a distinct issuer URL.
+func NewIssuerJWTAuthenticator(config *models.AuthConfig, idps []models.IDPConfig, logger *slog.Logger) (*IssuerJWTAuthenticator, error) {
+	byIssuer := make(map[string]*JWTAuthenticator, len(idps))
+	for i := range idps {
+		issuer := idps[i].IssuerURL
+		if issuer == "" {
+			return nil, fmt.Errorf("identity provider %d: issuer URL not configured", i+1)
+		}
+		if _, exists := byIssuer[issuer]; exists {
+			return nil, fmt.Errorf("identity provider %d: duplicate issuer URL %s", i+1, issuer)
+		}
+
+		idpConfig := *config
+		idpConfig.JWTConfig = &idps[i]
+		authenticator, err := NewJWTAuthenticator(&idpConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("identity provider %s: %w", issuer, err)
+		}
+		byIssuer[issuer] = authenticator
+	}
+	return &IssuerJWTAuthenticator{byIssuer: byIssuer, logger: logger}, nil
+}
+
+// Authenticate selects the identity provider by the token's issuer and
+// validates the token with it. The issuer is read before the signature is
+// verified only to select the provider.
+func (a *IssuerJWTAuthenticator) Authenticate(r *http.Request) (*AuthResult, error) {
+	tokenString, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	issuer, err := claims.GetIssuer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issuer: %w", err)
+	}
+	authenticator, ok := a.byIssuer[issuer]
+	if !ok {
+		return nil, ErrUnknownIssuer
+	}
+	return authenticator.Authenticate(r)
+}
+
+// Name returns the authenticator name
+func (a *IssuerJWTAuthenticator) Name() string {
+	return "IssuerJWTAuthenticator"
+}
+
+// CanHandle checks if the request carries a bearer token
+func (a *IssuerJWTAuthenticator) CanHandle(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get(constants.AuthorizationHeader), constants.BearerPrefix)
+}
+
+// enabledIDPConfigs returns the enabled identity providers of config, the
+// one in JWTConfig first.
+func enabledIDPConfigs(config models.AuthConfig) []models.IDPConfig {
+	var idps []models.IDPConfig
+	if config.JWTConfig != nil && config.JWTConfig.Enabled {
+		idps = append(idps, *config.JWTConfig)
+	}
+	for _, idp := range config.JWTConfigs {
+		if idp.Enabled {
+			idps = append(idps, idp)
+		}
+	}
+	return idps
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/constants"
+	"github.com/wso2/api-platform/common/models"
+)
+
+// mockIssuer is an identity provider serving a JWKS with one HS256 key.
+type mockIssuer struct {
+	issuer string
+	secret []byte
+	jwks   *httptest.Server
+}
+
+func newMockIssuer(t *testing.T, issuer, secret string) *mockIssuer {
+	t.Helper()
+	m := &mockIssuer{issuer: issuer, secret: []byte(secret)}
+	m.jwks = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"keys":[{"kty":"oct","kid":"key","alg":"HS256","k":%q}]}`,
+			base64.RawURLEncoding.EncodeToString(m.secret))
+	}))
+	t.Cleanup(m.jwks.Close)
+	return m
+}
+
+func (m *mockIssuer) idpConfig() models.IDPConfig {
+	return models.IDPConfig{Enabled: true, IssuerURL: m.issuer, JWKSUrl: m.jwks.URL, ScopeClaim: "groups"}
+}
+
+// token signs a token claiming issuer with the key of m.
+func (m *mockIssuer) token(t *testing.T, issuer string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":    issuer,
+		"sub":    "user@" + m.issuer,
+		"groups": []string{"admin"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "key"
+	signed, err := token.SignedString(m.secret)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestAuthMiddleware_MultipleIssuers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	first := newMockIssuer(t, "https://first.example.com", "first-issuer-secret-0123456789ab")
+	second := newMockIssuer(t, "https://second.example.com", "second-issuer-secret-0123456789a")
+	third := newMockIssuer(t, "https://third.example.com", "third-issuer-secret-0123456789ab")
+
+	firstConfig := first.idpConfig()
+	mw, err := AuthMiddleware(models.AuthConfig{
+		JWTConfig:  &firstConfig,
+		JWTConfigs: []models.IDPConfig{second.idpConfig(), {Enabled: false, IssuerURL: third.issuer, JWKSUrl: third.jwks.URL}},
+	}, logger)
+	require.NoError(t, err)
+
+	var gotUser string
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ac, _ := GetAuthContext(r)
+		gotUser = ac.UserID
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(token string) int {
+		gotUser = ""
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(constants.AuthorizationHeader, constants.BearerPrefix+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(first.token(t, first.issuer)))
+	assert.Equal(t, "user@"+first.issuer, gotUser)
+	assert.Equal(t, http.StatusOK, serve(second.token(t, second.issuer)))
+	assert.Equal(t, "user@"+second.issuer, gotUser)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(third.token(t, third.issuer)), "disabled issuer is not trusted")
+	// The issuer selects the JWKS, so a token claiming a trusted issuer must
+	// still be signed with that issuer's key.
+	assert.Equal(t, http.StatusUnauthorized, serve(second.token(t, first.issuer)))
+	assert.Equal(t, http.StatusUnauthorized, serve("not-a-jwt"))
+}
+
+func TestIssuerJWTAuthenticator_UnknownIssuer(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	first := newMockIssuer(t, "https://first.example.com", "first-issuer-secret-0123456789ab")
+	second := newMockIssuer(t, "https://second.example.com", "second-issuer-secret-0123456789a")
+
+	a, err := NewIssuerJWTAuthenticator(&models.AuthConfig{}, []models.IDPConfig{first.idpConfig()}, logger)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(constants.AuthorizationHeader, constants.BearerPrefix+second.token(t, second.issuer))
+	_, err = a.Authenticate(req)
+	assert.ErrorIs(t, err, ErrUnknownIssuer)
+}
+
+func TestNewIssuerJWTAuthenticator_DuplicateIssuer(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	first := newMockIssuer(t, "https://first.example.com", "first-issuer-secret-0123456789ab")
+
+	_, err := NewIssuerJWTAuthenticator(&models.AuthConfig{}, []models.IDPConfig{first.idpConfig(), first.idpConfig()}, logger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate issuer URL")
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+)
+
+const (
+	// DefaultJWKSRefreshInterval is how often the JWKS is fetched when no
+	// interval is configured.
+	DefaultJWKSRefreshInterval = 10 * time.Minute
+	// DefaultJWKSFetchTimeout bounds a JWKS fetch when no timeout is configured.
+	DefaultJWKSFetchTimeout = 10 * time.Second
+)
+
+// ErrJWKSExpired is returned for key lookups once the cached JWKS has not
+// been refreshed within its TTL.
+var ErrJWKSExpired = errors.New("cached JWKS has expired")
+
+// jwksCache holds the keys of the last successful JWKS fetch. A failed
+// refresh leaves the keys in place, so tokens keep validating while the JWKS
+// endpoint is slow or down, until ttl has passed since the last successful
+// fetch. A zero ttl keeps the keys indefinitely.
+type jwksCache struct {
+	jwkset.Storage
+	ttl       time.Duration
+	now       func() time.Time
+	fetchedAt atomic.Int64 // UnixNano of the last successful fetch
+}
+
+func newJWKSCache(ttl time.Duration) *jwksCache {
+	return &jwksCache{
+		Storage: jwkset.NewMemoryStorage(),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// KeyReplaceAll stores the keys of a successful fetch. The HTTP storage only
+// calls it once the whole key set was fetched and parsed.
+func (c *jwksCache) KeyReplaceAll(ctx context.Context, given []jwkset.JWK) error {
+	if err := c.Storage.KeyReplaceAll(ctx, given); err != nil {
+		return err
+	}
+	c.fetchedAt.Store(c.now().UnixNano())
+	return nil
+}
+
+func (c *jwksCache) KeyRead(ctx context.Context, keyID string) (jwkset.JWK, error) {
+	if c.expired() {
+		return jwkset.JWK{}, ErrJWKSExpired
+	}
+	return c.Storage.KeyRead(ctx, keyID)
+}
+
+func (c *jwksCache) KeyReadAll(ctx context.Context) ([]jwkset.JWK, error) {
+	if c.expired() {
+		return nil, ErrJWKSExpired
+	}
+	return c.Storage.KeyReadAll(ctx)
+}
+
+func (c *jwksCache) expired() bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	fetchedAt := c.fetchedAt.Load()
+	return fetchedAt == 0 || c.now().Sub(time.Unix(0, fetchedAt)) > c.ttl
+}
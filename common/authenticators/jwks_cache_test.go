@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authenticators
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/constants"
+	"github.com/wso2/api-platform/common/models"
+)
+
+func TestJWKSCache_ExpiresAfterTTL(t *testing.T) {
+	clock := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	cache := newJWKSCache(time.Hour)
+	cache.now = func() time.Time { return clock }
+	ctx := context.Background()
+
+	_, err := cache.KeyReadAll(ctx)
+	assert.ErrorIs(t, err, ErrJWKSExpired, "nothing has been fetched yet")
+
+	require.NoError(t, cache.KeyReplaceAll(ctx, []jwkset.JWK{}))
+	_, err = cache.KeyReadAll(ctx)
+	assert.NoError(t, err)
+
+	clock = clock.Add(time.Hour + time.Second)
+	_, err = cache.KeyRead(ctx, "any")
+	assert.ErrorIs(t, err, ErrJWKSExpired)
+
+	// A successful refresh makes the keys usable again.
+	require.NoError(t, cache.KeyReplaceAll(ctx, []jwkset.JWK{}))
+	_, err = cache.KeyReadAll(ctx)
+	assert.NoError(t, err)
+}
+
+func TestJWKSCache_NoTTLKeepsKeys(t *testing.T) {
+	cache := newJWKSCache(0)
+	_, err := cache.KeyReadAll(context.Background())
+	assert.NoError(t, err)
+}
+
+// TestJWTAuthenticator_UsesCachedJWKSWhileEndpointIsDown serves a JWKS, takes
+// the endpoint down and checks that tokens validate from the cached keys until
+// the cache TTL passes.
+func TestJWTAuthenticator_UsesCachedJWKSWhileEndpointIsDown(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	issuer := "https://issuer.example.com"
+	secret := []byte("jwks-cache-test-secret-0123456789")
+
+	var down atomic.Bool
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"keys":[{"kty":"oct","kid":"test-key","alg":"HS256","k":%q}]}`,
+			base64.RawURLEncoding.EncodeToString(secret))
+	}))
+	defer jwksServer.Close()
+
+	var fetchFailures atomic.Int64
+	config := &models.AuthConfig{JWTConfig: &models.IDPConfig{
+		Enabled:             true,
+		IssuerURL:           issuer,
+		JWKSUrl:             jwksServer.URL,
+		JWKSRefreshInterval: 20 * time.Millisecond,
+		JWKSFetchTimeout:    time.Second,
+		JWKSCacheTTL:        500 * time.Millisecond,
+		OnJWKSFetchError:    func(error) { fetchFailures.Add(1) },
+	}}
+	a, err := NewJWTAuthenticator(config, logger)
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": issuer,
+		"sub": "user123",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "test-key"
+	tokenString, err := token.SignedString(secret)
+	require.NoError(t, err)
+	authenticate := func() error {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(constants.AuthorizationHeader, constants.BearerPrefix+tokenString)
+		_, err := a.Authenticate(req)
+		return err
+	}
+
+	require.NoError(t, authenticate())
+
+	down.Store(true)
+	require.Eventually(t, func() bool { return fetchFailures.Load() > 0 }, 2*time.Second, 10*time.Millisecond)
+	assert.NoError(t, authenticate(), "cached keys are used while the JWKS endpoint is down")
+
+	require.Eventually(t, func() bool { return authenticate() != nil }, 3*time.Second, 20*time.Millisecond,
+		"cached keys expire after the TTL")
+
+	down.Store(false)
+	require.Eventually(t, func() bool { return authenticate() == nil }, 3*time.Second, 20*time.Millisecond,
+		"keys are usable again after a successful refresh")
+}
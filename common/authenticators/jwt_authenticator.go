@@ -46,6 +46,8 @@ type JWTAuthenticator struct {
 	config *models.AuthConfig
 	logger *slog.Logger
 	jwks   keyfunc.Keyfunc
+	// roleClaims are the parsed ScopeClaim and ScopeClaims paths
+	roleClaims []claimPath
 }
 
 // NewJWTAuthenticator creates a new JWT authenticator
@@ -56,6 +58,20 @@ func NewJWTAuthenticator(config *models.AuthConfig, logger *slog.Logger) (*JWTAu
 // newJWTAuthenticatorWithJWKS creates a new JWT authenticator with optional JWKS initialization
 // This is useful for testing where JWKS is not needed
 func newJWTAuthenticatorWithJWKS(config *models.AuthConfig, logger *slog.Logger, initJWKS bool) (*JWTAuthenticator, error) {
+	var roleClaims []claimPath
+	if config.JWTConfig != nil {
+		for _, raw := range append([]string{config.JWTConfig.ScopeClaim}, config.JWTConfig.ScopeClaims...) {
+			if raw == "" {
+				continue
+			}
+			path, err := parseClaimPath(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid roles claim: %w", err)
+			}
+			roleClaims = append(roleClaims, path)
+		}
+	}
+
 	var jwks keyfunc.Keyfunc
 	if config.JWTConfig != nil && initJWKS {
 		if config.JWTConfig.IssuerURL == "" {
@@ -74,10 +90,31 @@ func newJWTAuthenticatorWithJWKS(config *models.AuthConfig, logger *slog.Logger,
 				TLSClientConfig: &tls.Config{InsecureSkipVerify: config.JWTConfig.InsecureSkipVerifyTLS}, //nolint:gosec
 			},
 		}
+		refreshInterval := config.JWTConfig.JWKSRefreshInterval
+		if refreshInterval <= 0 {
+			refreshInterval = DefaultJWKSRefreshInterval
+		}
+		fetchTimeout := config.JWTConfig.JWKSFetchTimeout
+		if fetchTimeout <= 0 {
+			fetchTimeout = DefaultJWKSFetchTimeout
+		}
+		onFetchError := config.JWTConfig.OnJWKSFetchError
+		jwksURL := config.JWTConfig.JWKSUrl
 		storageOptions := jwkset.HTTPClientStorageOptions{
 			Client:          jwksHTTPClient,
 			Ctx:             ctx,
-			RefreshInterval: 10 * time.Minute,
+			HTTPTimeout:     fetchTimeout,
+			RefreshInterval: refreshInterval,
+			// Keys are kept when a refresh fails, until the cache TTL passes.
+			RefreshErrorHandler: func(_ context.Context, err error) {
+				logger.Warn("Failed to refresh JWKS; using cached keys",
+					slog.String("jwks_url", jwksURL),
+					slog.Any("error", err))
+				if onFetchError != nil {
+					onFetchError(err)
+				}
+			},
+			Storage: newJWKSCache(config.JWTConfig.JWKSCacheTTL),
 			ValidateOptions: jwkset.JWKValidateOptions{
 				SkipAll: true, // Skip JWK metadata validation to handle provider inconsistencies (JWT signature validation still occurs)
 			},
@@ -85,6 +122,9 @@ func newJWTAuthenticatorWithJWKS(config *models.AuthConfig, logger *slog.Logger,
 
 		storage, err := jwkset.NewStorageFromHTTP(config.JWTConfig.JWKSUrl, storageOptions)
 		if err != nil {
+			if onFetchError != nil {
+				onFetchError(err)
+			}
 			return nil, fmt.Errorf("failed to create JWKS storage: %w", err)
 		}
 
@@ -100,24 +140,33 @@ func newJWTAuthenticatorWithJWKS(config *models.AuthConfig, logger *slog.Logger,
 		jwks = tempjwksProvider
 	}
 	return &JWTAuthenticator{
-		config: config,
-		logger: logger,
-		jwks:   jwks,
+		config:     config,
+		logger:     logger,
+		jwks:       jwks,
+		roleClaims: roleClaims,
 	}, nil
 }
 
-// Authenticate verifies JWT token from context
-func (j *JWTAuthenticator) Authenticate(r *http.Request) (*AuthResult, error) {
-	// Extract bearer token from Authorization header
+// bearerToken extracts the bearer token from the Authorization header
+func bearerToken(r *http.Request) (string, error) {
 	authHeader := r.Header.Get(constants.AuthorizationHeader)
 	if authHeader == "" {
-		return nil, errors.New("authorization header missing")
+		return "", errors.New("authorization header missing")
 	}
 
 	// Remove "Bearer " prefix
 	tokenString := strings.TrimPrefix(authHeader, constants.BearerPrefix)
 	if tokenString == authHeader {
-		return nil, errors.New("invalid authorization header format")
+		return "", errors.New("invalid authorization header format")
+	}
+	return tokenString, nil
+}
+
+// Authenticate verifies JWT token from context
+func (j *JWTAuthenticator) Authenticate(r *http.Request) (*AuthResult, error) {
+	tokenString, err := bearerToken(r)
+	if err != nil {
+		return nil, err
 	}
 
 	claims := jwt.MapClaims{}
@@ -175,7 +224,7 @@ func (j *JWTAuthenticator) Authenticate(r *http.Request) (*AuthResult, error) {
 	// This allows authentication-only mode where all authenticated users can access resources
 	var permissions []string
 	skipAuthz := false
-	if j.config.JWTConfig.ScopeClaim == "" {
+	if len(j.roleClaims) == 0 {
 		j.logger.Debug("No role claim configured, setting skip_authz flag")
 		skipAuthz = true
 		permissions = []string{}
@@ -196,15 +245,18 @@ func (j *JWTAuthenticator) Authenticate(r *http.Request) (*AuthResult, error) {
 }
 
 func (j *JWTAuthenticator) resolvePermissions(claims jwt.MapClaims) []string {
+	// Merge the values of all role claims, dropping duplicates
 	var permissions []string
-	// Try string first
-	if permissionClaimValue, ok := claims[j.config.JWTConfig.ScopeClaim].(string); ok {
-		permissions = strings.Split(permissionClaimValue, " ")
-	} else if permissionClaimArray, ok := claims[j.config.JWTConfig.ScopeClaim].([]any); ok {
-		permissions = make([]string, 0, len(permissionClaimArray))
-		for _, perm := range permissionClaimArray {
-			if permStr, ok := perm.(string); ok {
-				permissions = append(permissions, permStr)
+	seen := make(map[string]bool)
+	for _, path := range j.roleClaims {
+		value, ok := path.lookup(claims)
+		if !ok {
+			continue
+		}
+		for _, permission := range claimValues(value) {
+			if !seen[permission] {
+				seen[permission] = true
+				permissions = append(permissions, permission)
 			}
 		}
 	}
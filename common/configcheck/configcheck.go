@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configcheck reports whether a component configuration file is valid,
+// for the --validate-config flag of the gateway binaries, so operators can
+// check a configuration before rolling it out without starting the service.
+package configcheck
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Report is the structured result of validating a configuration file.
+type Report struct {
+	// Config is the path of the validated file.
+	Config string `json:"config"`
+	// Valid reports whether the file loaded and passed validation.
+	Valid bool `json:"valid"`
+	// Errors holds one message per problem found.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Run loads the configuration file at path with load, which parses and
+// validates it, writes the report to w as indented JSON and returns the
+// process exit code: 0 when the file is valid, 1 otherwise.
+func Run(w io.Writer, path string, load func(path string) error) int {
+	report := Report{Config: path, Valid: true}
+	if err := load(path); err != nil {
+		report.Valid = false
+		report.Errors = Messages(err)
+	}
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return 1
+	}
+	if _, err := w.Write(append(out, '\n')); err != nil {
+		return 1
+	}
+	if !report.Valid {
+		return 1
+	}
+	return 0
+}
+
+// Messages returns one message per error joined in err with errors.Join, or
+// the message of err itself.
+func Messages(err error) []string {
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+	var messages []string
+	for _, e := range joined.Unwrap() {
+		messages = append(messages, Messages(e)...)
+	}
+	return messages
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Valid(t *testing.T) {
+	var out bytes.Buffer
+	code := Run(&out, "config.toml", func(string) error { return nil })
+
+	assert.Equal(t, 0, code)
+	var report Report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.Equal(t, Report{Config: "config.toml", Valid: true}, report)
+}
+
+func TestRun_Invalid(t *testing.T) {
+	var out bytes.Buffer
+	code := Run(&out, "config.toml", func(path string) error {
+		return fmt.Errorf("invalid configuration: %w", errors.New("server.api_port must be between 1 and 65535, got: 0"))
+	})
+
+	assert.Equal(t, 1, code)
+	var report Report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.False(t, report.Valid)
+	assert.Equal(t, []string{"invalid configuration: server.api_port must be between 1 and 65535, got: 0"}, report.Errors)
+}
+
+func TestMessages(t *testing.T) {
+	assert.Nil(t, Messages(nil))
+	assert.Equal(t, []string{"a", "b", "c"},
+		Messages(errors.Join(errors.New("a"), errors.Join(errors.New("b"), errors.New("c")))))
+}
@@ -39,6 +39,11 @@ type AuthConfig struct {
 	// JWT/Bearer Auth Configuration
 	JWTConfig *IDPConfig
 
+	// JWTConfigs lists further identity providers whose tokens are accepted.
+	// Each token is validated by the provider whose IssuerURL matches its
+	// "iss" claim; tokens from other issuers are rejected.
+	JWTConfigs []IDPConfig
+
 	// Paths to skip authentication
 	SkipPaths []string
 
@@ -69,6 +74,10 @@ type IDPConfig struct {
 	IssuerURL              string               `json:"issuer_url"`
 	JWKSUrl                string               `json:"jwks_url"`
 	ScopeClaim             string               `json:"scope_claim"`
+	// ScopeClaims lists further claims to read roles from; their values are
+	// merged with ScopeClaim before PermissionMapping is applied. Claims may
+	// be paths in dot/bracket notation, such as realm_access.roles.
+	ScopeClaims []string `json:"scope_claims"`
 	UsernameClaim          string               `json:"username_claim"`
 	Audience               *[]string            `json:"audience"`
 	Certificate            *string              `json:"certificate"`
@@ -76,4 +85,13 @@ type IDPConfig struct {
 	PermissionMapping      *map[string][]string `json:"permission_mapping"`
 	InsecureSkipVerifyTLS  bool                 `json:"insecure_skip_verify_tls"`
 	JWTLeeway             *time.Duration        `json:"jwt_leeway"`
+	// JWKSRefreshInterval is how often the JWKS is fetched in the background (default: 10m).
+	JWKSRefreshInterval time.Duration `json:"jwks_refresh_interval"`
+	// JWKSFetchTimeout bounds each JWKS fetch (default: 10s).
+	JWKSFetchTimeout time.Duration `json:"jwks_fetch_timeout"`
+	// JWKSCacheTTL is how long the keys of the last successful fetch stay usable
+	// while refreshes fail. Zero keeps them until a refresh succeeds.
+	JWKSCacheTTL time.Duration `json:"jwks_cache_ttl"`
+	// OnJWKSFetchError is called whenever a JWKS fetch fails.
+	OnJWKSFetchError func(err error) `json:"-"`
 }
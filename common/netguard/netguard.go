@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package netguard keeps outbound requests to user-supplied URLs away from
+// internal destinations: loopback, RFC 1918 and IPv6 unique-local, link-local
+// and cloud metadata addresses. The check runs on the resolved IP when the
+// connection is dialed, so a hostname that passed an earlier check cannot be
+// rebound to an internal address.
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+)
+
+// ErrDeniedDestination is returned when a destination resolves to a denied address.
+var ErrDeniedDestination = errors.New("destination is not allowed")
+
+var deniedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network, including the unspecified address
+	"10.0.0.0/8",     // RFC 1918
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local, including the 169.254.169.254 metadata address
+	"172.16.0.0/12",  // RFC 1918
+	"192.168.0.0/16", // RFC 1918
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique-local, including the fd00:ec2::254 metadata address
+	"fe80::/10",      // link-local
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid built-in CIDR %q: %v", cidr, err))
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// IsDenied reports whether ip is a loopback, private, link-local, metadata or
+// unspecified address. IPv4-mapped IPv6 addresses are checked as IPv4.
+func IsDenied(ip net.IP) bool {
+	for _, network := range deniedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dialer returns a dialer that refuses to connect to denied addresses. The
+// address is checked after resolution, immediately before connecting.
+func Dialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || IsDenied(ip) {
+				return fmt.Errorf("%w: %s", ErrDeniedDestination, address)
+			}
+			return nil
+		},
+	}
+}
+
+// CheckHost resolves host and returns ErrDeniedDestination if any of its
+// addresses is denied. It lets a caller reject a URL before sending anything;
+// connections must still be made with Dialer, since the host may resolve
+// differently when dialed.
+func CheckHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if IsDenied(ip) {
+			return fmt.Errorf("%w: %s", ErrDeniedDestination, host)
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if IsDenied(addr.IP) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrDeniedDestination, host, addr.IP)
+		}
+	}
+	return nil
+}
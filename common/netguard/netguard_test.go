@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDenied(t *testing.T) {
+	denied := []string{
+		"127.0.0.1", "127.1.2.3", "::1", // loopback
+		"10.0.0.1", "172.16.5.4", "172.31.255.255", "192.168.1.1", // RFC 1918
+		"169.254.10.1", "fe80::1", // link-local
+		"169.254.169.254", "fd00:ec2::254", // cloud metadata
+		"0.0.0.0", "::", // unspecified
+		"::ffff:127.0.0.1", "::ffff:10.0.0.1", // IPv4-mapped
+		"fd12:3456::1", // unique-local
+	}
+	for _, addr := range denied {
+		assert.True(t, IsDenied(net.ParseIP(addr)), addr)
+	}
+
+	allowed := []string{"8.8.8.8", "172.32.0.1", "192.169.0.1", "2606:4700::1111"}
+	for _, addr := range allowed {
+		assert.False(t, IsDenied(net.ParseIP(addr)), addr)
+	}
+}
+
+func TestDialer_RefusesDeniedAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{DialContext: Dialer(time.Second).DialContext}}
+	_, err := client.Get(server.URL)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrDeniedDestination), "loopback must be refused at dial time: %v", err)
+
+	for _, addr := range []string{"10.0.0.1:80", "169.254.169.254:80", "[fe80::1]:80", "192.168.0.10:443"} {
+		_, err := Dialer(time.Second).DialContext(context.Background(), "tcp", addr)
+		assert.ErrorIs(t, err, ErrDeniedDestination, addr)
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	for _, host := range []string{"127.0.0.1", "localhost", "10.1.2.3", "169.254.169.254", "fe80::1", "192.168.10.1"} {
+		assert.ErrorIs(t, CheckHost(context.Background(), host), ErrDeniedDestination, host)
+	}
+	assert.NoError(t, CheckHost(context.Background(), "93.184.216.34"))
+}
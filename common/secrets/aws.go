@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// AWSConfig configures an AWSProvider.
+type AWSConfig struct {
+	// Region is the AWS region of the secrets.
+	Region string
+	// Endpoint overrides the regional https endpoint of Secrets Manager.
+	Endpoint string
+	// AccessKeyID, SecretAccessKey and SessionToken are the credentials;
+	// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN when empty.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Client sends the requests; a client with a 10s timeout when nil.
+	Client *http.Client
+}
+
+// AWSProvider reads secrets from AWS Secrets Manager. A secret name is
+// "id#key": the key of the JSON secret string of secret id, or the whole
+// secret string when no key is given.
+type AWSProvider struct {
+	region          string
+	endpoint        *url.URL
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	client          *http.Client
+	now             func() time.Time
+}
+
+// NewAWSProvider returns a provider reading from Secrets Manager in the region of cfg.
+func NewAWSProvider(cfg AWSConfig) (*AWSProvider, error) {
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("aws region is required")
+	}
+	rawEndpoint := cfg.Endpoint
+	if rawEndpoint == "" {
+		rawEndpoint = "https://secretsmanager." + cfg.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid aws secrets manager endpoint")
+	}
+	if endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("aws secrets manager endpoint must use https")
+	}
+
+	p := &AWSProvider{
+		region:          cfg.Region,
+		endpoint:        endpoint,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		sessionToken:    cfg.SessionToken,
+		client:          cfg.Client,
+		now:             time.Now,
+	}
+	if p.accessKeyID == "" && p.secretAccessKey == "" {
+		p.accessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		p.secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		p.sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if p.accessKeyID == "" || p.secretAccessKey == "" {
+		return nil, fmt.Errorf("aws credentials are required")
+	}
+	if p.client == nil {
+		p.client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	return p, nil
+}
+
+// Get implements Provider.
+func (p *AWSProvider) Get(ctx context.Context, name string) (string, error) {
+	id, key, hasKey := strings.Cut(name, "#")
+	if id == "" {
+		return "", fmt.Errorf("invalid secret name: a secret id is required")
+	}
+
+	payload, err := json.Marshal(map[string]string{"SecretId": id})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode secrets manager request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint.JoinPath("/").String(), bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create secrets manager request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	p.sign(req, payload)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("secrets manager request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secrets manager response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// Only the error type is surfaced; the message is not needed.
+		var apiErr struct {
+			Type string `json:"__type"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		if strings.HasSuffix(apiErr.Type, "ResourceNotFoundException") {
+			return "", fmt.Errorf("%w: no secrets manager secret %s", ErrNotFound, id)
+		}
+		return "", fmt.Errorf("secrets manager returned status %d", resp.StatusCode)
+	}
+
+	var out struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", fmt.Errorf("failed to decode secrets manager response: %w", err)
+	}
+	if out.SecretString == nil {
+		return "", fmt.Errorf("secrets manager secret %s has no secret string", id)
+	}
+	if !hasKey {
+		return *out.SecretString, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(*out.SecretString), &fields); err != nil {
+		return "", fmt.Errorf("secrets manager secret %s is not a JSON object", id)
+	}
+	raw, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("%w: secrets manager secret %s has no key %s", ErrNotFound, id, key)
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("secrets manager secret %s key %s is not a string", id, key)
+	}
+	return value, nil
+}
+
+// sign adds an AWS Signature Version 4 to req. SHA-256 and HMAC-SHA256 are
+// mandated by SigV4 and cannot be swapped for another hash.
+func (p *AWSProvider) sign(req *http.Request, payload []byte) {
+	now := p.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if p.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.sessionToken)
+	}
+
+	signed := []string{"content-type", "host", "x-amz-date", "x-amz-target"}
+	if p.sessionToken != "" {
+		signed = []string{"content-type", "host", "x-amz-date", "x-amz-security-token", "x-amz-target"}
+	}
+	var canonicalHeaders strings.Builder
+	for _, h := range signed {
+		value := req.Header.Get(h)
+		if h == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(h + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(signed, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(payload),
+	}, "\n")
+
+	scope := date + "/" + p.region + "/secretsmanager/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.secretAccessKey), date)
+	key = hmacSHA256(key, p.region)
+	key = hmacSHA256(key, "secretsmanager")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+p.accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAWSProvider(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secretsmanager.GetSecretValue", r.Header.Get("X-Amz-Target"))
+		auth := r.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+		assert.Contains(t, auth, "/eu-west-1/secretsmanager/aws4_request")
+		assert.NotContains(t, auth, "wJalrXUtnFEMI")
+
+		var req struct{ SecretId string }
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch req.SecretId {
+		case "plain":
+			_, _ = w.Write([]byte(`{"SecretString":"sk-plain"}`))
+		case "json":
+			_, _ = w.Write([]byte(`{"SecretString":"{\"api_key\":\"sk-json\"}"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`))
+		}
+	}))
+	defer srv.Close()
+
+	p, err := NewAWSProvider(AWSConfig{
+		Region:          "eu-west-1",
+		Endpoint:        srv.URL,
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		Client:          srv.Client(),
+	})
+	require.NoError(t, err)
+
+	value, err := p.Get(context.Background(), "plain")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-plain", value)
+
+	value, err = p.Get(context.Background(), "json#api_key")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-json", value)
+
+	_, err = p.Get(context.Background(), "json#other")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = p.Get(context.Background(), "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestNewAWSProvider_Validation(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	_, err := NewAWSProvider(AWSConfig{})
+	assert.ErrorContains(t, err, "region")
+
+	_, err = NewAWSProvider(AWSConfig{Region: "eu-west-1", Endpoint: "http://localhost:4566", AccessKeyID: "a", SecretAccessKey: "b"})
+	assert.ErrorContains(t, err, "https")
+
+	_, err = NewAWSProvider(AWSConfig{Region: "eu-west-1"})
+	assert.ErrorContains(t, err, "credentials")
+}
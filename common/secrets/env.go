@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvProvider reads secrets from environment variables. The variable of a
+// secret is its name upper-cased, with every character other than a letter or
+// digit replaced by "_", behind Prefix: with the prefix "APIP_SECRET_" the
+// secret "embedding/api-key" is read from APIP_SECRET_EMBEDDING_API_KEY.
+type EnvProvider struct {
+	Prefix string
+}
+
+// Get implements Provider.
+func (p EnvProvider) Get(_ context.Context, name string) (string, error) {
+	variable := p.Variable(name)
+	value, ok := os.LookupEnv(variable)
+	if !ok {
+		return "", fmt.Errorf("%w: environment variable %s is not set", ErrNotFound, variable)
+	}
+	return value, nil
+}
+
+// Variable returns the environment variable the named secret is read from.
+func (p EnvProvider) Variable(name string) string {
+	return p.Prefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
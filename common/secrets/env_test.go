@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvProvider(t *testing.T) {
+	p := EnvProvider{Prefix: "APIP_SECRET_"}
+	assert.Equal(t, "APIP_SECRET_EMBEDDING_API_KEY", p.Variable("embedding/api-key"))
+
+	t.Setenv("APIP_SECRET_EMBEDDING_API_KEY", "s3cr3t")
+	value, err := p.Get(context.Background(), "embedding/api-key")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+}
+
+func TestEnvProvider_NotFound(t *testing.T) {
+	_, err := EnvProvider{Prefix: "APIP_SECRET_"}.Get(context.Background(), "never-set-secret")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Contains(t, err.Error(), "APIP_SECRET_NEVER_SET_SECRET")
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultMaxFileBytes caps a secret file read when FileProvider.MaxBytes is not set.
+const DefaultMaxFileBytes int64 = 64 << 10 // 64 KiB
+
+// FileProvider reads each secret from a file named after it under Dir, as
+// mounted from a Kubernetes Secret. A trailing newline is dropped. Names must
+// stay within Dir, and a file readable or writable by anyone but its owner is
+// rejected.
+type FileProvider struct {
+	Dir      string
+	MaxBytes int64
+}
+
+// Get implements Provider.
+func (p FileProvider) Get(_ context.Context, name string) (string, error) {
+	if !filepath.IsLocal(name) || strings.ContainsRune(name, 0) {
+		return "", fmt.Errorf("invalid secret name: must be a relative path within the secrets directory")
+	}
+
+	// os.Root keeps the open within Dir, following symlinks only where they stay inside it.
+	root, err := os.OpenRoot(p.Dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to open the secrets directory: %w", err)
+	}
+	defer root.Close()
+
+	f, err := root.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%w: no file %s in the secrets directory", ErrNotFound, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to open secret file %s: %w", name, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to stat secret file %s: %w", name, err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("secret file %s is not a regular file", name)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return "", fmt.Errorf("secret file %s permissions %s are too permissive; restrict to owner (e.g. 0400)", name, perm)
+	}
+
+	maxBytes := p.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxFileBytes
+	}
+	data, err := io.ReadAll(io.LimitReader(f, maxBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file %s: %w", name, err)
+	}
+	if int64(len(data)) > maxBytes {
+		return "", fmt.Errorf("secret file %s exceeds the maximum allowed size", name)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSecret(t *testing.T, dir, name, value string, perm os.FileMode) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(value), perm))
+	require.NoError(t, os.Chmod(path, perm))
+}
+
+func TestFileProvider(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret(t, dir, "db-password", "hunter2\n", 0o400)
+	writeSecret(t, dir, "llm/api-key", "sk-abc", 0o600)
+	p := FileProvider{Dir: dir}
+
+	value, err := p.Get(context.Background(), "db-password")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", value, "trailing newline is trimmed")
+
+	value, err = p.Get(context.Background(), "llm/api-key")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-abc", value)
+}
+
+func TestFileProvider_NotFound(t *testing.T) {
+	_, err := FileProvider{Dir: t.TempDir()}.Get(context.Background(), "missing")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestFileProvider_RejectsPermissivePermissions(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret(t, dir, "open", "value", 0o644)
+
+	_, err := FileProvider{Dir: dir}.Get(context.Background(), "open")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too permissive")
+	assert.NotContains(t, err.Error(), "value")
+}
+
+func TestFileProvider_RejectsPathTraversal(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "secrets")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	writeSecret(t, parent, "outside", "value", 0o600)
+	require.NoError(t, os.Symlink(filepath.Join(parent, "outside"), filepath.Join(dir, "link")))
+	p := FileProvider{Dir: dir}
+
+	for _, name := range []string{"../outside", "/etc/passwd", "a/../../outside", "link"} {
+		_, err := p.Get(context.Background(), name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestFileProvider_RejectsOversizedFile(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret(t, dir, "big", strings.Repeat("x", 65), 0o600)
+
+	_, err := FileProvider{Dir: dir, MaxBytes: 64}.Get(context.Background(), "big")
+	require.Error(t, err)
+}
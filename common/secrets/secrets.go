@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package secrets resolves secret://name references in a configuration map
+// against a secrets provider, such as environment variables, files, HashiCorp
+// Vault or AWS Secrets Manager, so that credentials are kept out of the
+// configuration itself.
+//
+// Resolved values are never logged and never embedded in errors; callers get
+// them back alongside the resolved configuration so they can redact them.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Scheme prefixes a secret reference in a configuration value.
+const Scheme = "secret://"
+
+// ErrNotFound is returned by a Provider when the named secret does not exist.
+var ErrNotFound = errors.New("secret not found")
+
+// Provider looks up secrets by name.
+type Provider interface {
+	// Get returns the value of the named secret, or an error wrapping
+	// ErrNotFound when there is none.
+	Get(ctx context.Context, name string) (string, error)
+}
+
+// RefName returns the secret name of a "secret://name" reference.
+func RefName(value string) (string, bool) {
+	name, ok := strings.CutPrefix(value, Scheme)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+// HasRefs reports whether any string leaf of raw is a "secret://name" reference.
+func HasRefs(raw any) bool {
+	switch v := raw.(type) {
+	case string:
+		_, ok := RefName(v)
+		return ok
+	case map[string]any:
+		for _, item := range v {
+			if HasRefs(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if HasRefs(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Resolve returns a copy of raw with every string leaf that is a
+// "secret://name" reference replaced by the value of the secret, together with
+// the resolved values. The input map is not mutated. It fails closed: any
+// reference that cannot be resolved aborts the pass with an error naming the
+// field and the secret, never the value.
+func Resolve(ctx context.Context, raw map[string]any, provider Provider) (map[string]any, []string, error) {
+	r := &resolver{ctx: ctx, provider: provider, cache: make(map[string]string)}
+	out, err := r.walk(raw, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	resolved, _ := out.(map[string]any)
+	values := make([]string, 0, len(r.cache))
+	for _, v := range r.cache {
+		values = append(values, v)
+	}
+	return resolved, values, nil
+}
+
+type resolver struct {
+	ctx      context.Context
+	provider Provider
+	cache    map[string]string // secret name -> value, so each secret is fetched once per pass
+}
+
+func (r *resolver) walk(val any, path string) (any, error) {
+	switch v := val.(type) {
+	case string:
+		name, ok := RefName(v)
+		if !ok {
+			return v, nil
+		}
+		return r.get(name, path)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+			resolved, err := r.walk(item, itemPath)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := r.walk(item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	default:
+		return val, nil
+	}
+}
+
+func (r *resolver) get(name, path string) (string, error) {
+	if value, ok := r.cache[name]; ok {
+		return value, nil
+	}
+	if r.provider == nil {
+		return "", fmt.Errorf("secret %q referenced at %q: no secrets provider is configured", name, path)
+	}
+	value, err := r.provider.Get(r.ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("secret %q referenced at %q: %w", name, path, err)
+	}
+	r.cache[name] = value
+	return value, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapProvider map[string]string
+
+func (m mapProvider) Get(_ context.Context, name string) (string, error) {
+	v, ok := m[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func TestRefName(t *testing.T) {
+	name, ok := RefName("secret://openai-key")
+	assert.True(t, ok)
+	assert.Equal(t, "openai-key", name)
+
+	_, ok = RefName("secret://")
+	assert.False(t, ok)
+	_, ok = RefName("plain")
+	assert.False(t, ok)
+}
+
+func TestHasRefs(t *testing.T) {
+	assert.False(t, HasRefs(map[string]any{"a": "plain", "b": []any{1, "x"}}))
+	assert.True(t, HasRefs(map[string]any{"a": map[string]any{"b": []any{"secret://key"}}}))
+}
+
+func TestResolve(t *testing.T) {
+	raw := map[string]any{
+		"name": "plain",
+		"llm": map[string]any{
+			"api_key": "secret://openai-key",
+			"headers": []any{"secret://openai-key", "x", 3},
+		},
+	}
+
+	resolved, values, err := Resolve(context.Background(), raw, mapProvider{"openai-key": "sk-123"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "plain", resolved["name"])
+	llm := resolved["llm"].(map[string]any)
+	assert.Equal(t, "sk-123", llm["api_key"])
+	assert.Equal(t, []any{"sk-123", "x", 3}, llm["headers"])
+	assert.Equal(t, []string{"sk-123"}, values)
+
+	// The input is left untouched.
+	assert.Equal(t, "secret://openai-key", raw["llm"].(map[string]any)["api_key"])
+}
+
+func TestResolve_FailsClosed(t *testing.T) {
+	raw := map[string]any{"llm": map[string]any{"api_key": "secret://missing"}}
+
+	_, _, err := Resolve(context.Background(), raw, mapProvider{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Contains(t, err.Error(), `"missing"`)
+	assert.Contains(t, err.Error(), `"llm.api_key"`)
+
+	_, _, err = Resolve(context.Background(), raw, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no secrets provider is configured")
+}
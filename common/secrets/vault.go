@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxResponseBytes caps the response of a secrets backend.
+const maxResponseBytes = 1 << 20 // 1 MiB
+
+// defaultHTTPTimeout bounds a request to a secrets backend when no client is given.
+const defaultHTTPTimeout = 10 * time.Second
+
+// VaultProvider reads secrets from a HashiCorp Vault KV version 2 secrets
+// engine. A secret name is "path#key": the key of the secret at path, or its
+// "value" key when no key is given.
+type VaultProvider struct {
+	address *url.URL
+	token   string
+	mount   string
+	client  *http.Client
+}
+
+// VaultConfig configures a VaultProvider.
+type VaultConfig struct {
+	// Address is the https URL of the Vault server.
+	Address string
+	// Token authenticates to Vault.
+	Token string
+	// Mount is the path of the KV v2 secrets engine; "secret" when empty.
+	Mount string
+	// Client sends the requests; a client with a 10s timeout when nil.
+	Client *http.Client
+}
+
+// NewVaultProvider returns a provider reading from the Vault server of cfg.
+func NewVaultProvider(cfg VaultConfig) (*VaultProvider, error) {
+	address, err := url.Parse(cfg.Address)
+	if err != nil || address.Host == "" {
+		return nil, fmt.Errorf("invalid vault address")
+	}
+	if address.Scheme != "https" {
+		return nil, fmt.Errorf("vault address must use https")
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("vault token is required")
+	}
+	mount := strings.Trim(cfg.Mount, "/")
+	if mount == "" {
+		mount = "secret"
+	}
+	client := cfg.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPTimeout}
+	}
+	return &VaultProvider{address: address, token: cfg.Token, mount: mount, client: client}, nil
+}
+
+// Get implements Provider.
+func (p *VaultProvider) Get(ctx context.Context, name string) (string, error) {
+	path, key, _ := strings.Cut(name, "#")
+	if key == "" {
+		key = "value"
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", fmt.Errorf("invalid secret name: a path is required")
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s == "" || s == "." || s == ".." {
+			return "", fmt.Errorf("invalid secret name: bad path segment")
+		}
+		segments[i] = url.PathEscape(s)
+	}
+
+	endpoint := p.address.JoinPath("v1", p.mount, "data")
+	endpoint.RawPath = endpoint.EscapedPath() + "/" + strings.Join(segments, "/")
+	endpoint.Path, _ = url.PathUnescape(endpoint.RawPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%w: no vault secret at %s", ErrNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		// The body is not echoed: it is not needed to diagnose the status.
+		return "", fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	raw, ok := body.Data.Data[key]
+	if !ok {
+		return "", fmt.Errorf("%w: vault secret at %s has no key %s", ErrNotFound, path, key)
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("vault secret at %s key %s is not a string", path, key)
+	}
+	return value, nil
+}
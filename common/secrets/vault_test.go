@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockVault serves KV v2 reads of secrets under the "secret" mount.
+func newMockVault(t *testing.T, token string, secrets map[string]map[string]any) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		data, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVaultProvider(t *testing.T) {
+	srv := newMockVault(t, "root-token", map[string]map[string]any{
+		"/v1/secret/data/llm/openai": {"value": "sk-openai", "org": "org-1", "port": 443},
+	})
+	p, err := NewVaultProvider(VaultConfig{Address: srv.URL, Token: "root-token", Client: srv.Client()})
+	require.NoError(t, err)
+
+	value, err := p.Get(context.Background(), "llm/openai")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-openai", value)
+
+	value, err = p.Get(context.Background(), "llm/openai#org")
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", value)
+
+	_, err = p.Get(context.Background(), "llm/openai#port")
+	assert.ErrorContains(t, err, "not a string")
+
+	_, err = p.Get(context.Background(), "llm/openai#missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = p.Get(context.Background(), "llm/other")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = p.Get(context.Background(), "llm/../openai")
+	assert.Error(t, err)
+}
+
+func TestVaultProvider_DoesNotEchoErrorBody(t *testing.T) {
+	srv := newMockVault(t, "root-token", nil)
+	p, err := NewVaultProvider(VaultConfig{Address: srv.URL, Token: "wrong", Client: srv.Client()})
+	require.NoError(t, err)
+
+	_, err = p.Get(context.Background(), "llm/openai")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.NotContains(t, err.Error(), "permission denied")
+}
+
+func TestNewVaultProvider_Validation(t *testing.T) {
+	_, err := NewVaultProvider(VaultConfig{Address: "http://vault:8200", Token: "t"})
+	assert.ErrorContains(t, err, "https")
+
+	_, err = NewVaultProvider(VaultConfig{Address: "https://vault:8200"})
+	assert.ErrorContains(t, err, "token")
+}
@@ -398,7 +398,7 @@ func main() {
 	routerConnected := make(chan struct{})
 	policyEngineConnected := make(chan struct{})
 
-	xdsServer := xds.NewServer(snapshotManager, sdsSecretManager, cfg.Controller.Server.XDSPort, log, routerConnected)
+	xdsServer := xds.NewServer(snapshotManager, cfg.Controller.Server.XDSPort, log, routerConnected)
 	go func() {
 		if err := xdsServer.Start(); err != nil {
 			log.Error("xDS server failed", slog.Any("error", err))
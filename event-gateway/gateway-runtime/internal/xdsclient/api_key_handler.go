@@ -48,6 +48,7 @@ func NewAPIKeyStateHandler(store *commonapikey.APIkeyStore) *APIKeyStateHandler
 // HandleResources processes an APIKeyState xDS update and replaces the store contents.
 func (h *APIKeyStateHandler) HandleResources(ctx context.Context, resources []*discoveryv3.Resource, version string) error {
 	var allKeys []pkgapikey.APIKeyData
+	var revoked []string
 
 	for _, res := range resources {
 		if res == nil || res.Resource == nil {
@@ -60,9 +61,10 @@ func (h *APIKeyStateHandler) HandleResources(ctx context.Context, resources []*d
 		}
 
 		allKeys = append(allKeys, state.APIKeys...)
+		revoked = append(revoked, state.RevokedAPIKeys...)
 	}
 
-	combined := &pkgapikey.APIKeyStateResource{APIKeys: allKeys}
+	combined := &pkgapikey.APIKeyStateResource{APIKeys: allKeys, RevokedAPIKeys: revoked}
 	if err := pkgapikey.ApplyToStore(ctx, combined, h.store); err != nil {
 		return fmt.Errorf("failed to apply API key state to store: %w", err)
 	}
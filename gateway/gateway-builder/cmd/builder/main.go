@@ -21,6 +21,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -61,6 +62,10 @@ func main() {
 	systemBuildLockPath := flag.String("system-build-lock", DefaultSystemBuildLockFile, "Path to system build lock file")
 	policyEngineSrc := flag.String("policy-engine-src", DefaultPolicyEngineSrc, "Path to policy-engine runtime source directory")
 	outputDir := flag.String("out-dir", DefaultOutputDir, "Output directory for generated Dockerfiles and artifacts")
+	cacheDir := flag.String("cache-dir", "",
+		"Directory for the build cache; reuses the policy engine binary and Go build cache across builds (disabled when empty)")
+	plan := flag.Bool("plan", false,
+		"Run discovery, validation and code generation in a temp directory and print the build plan as JSON, without compiling or generating Dockerfiles")
 
 	// Base image configuration
 	gatewayControllerBaseImage := flag.String("gateway-controller-base-image", defaultGatewayControllerBaseImage,
@@ -73,7 +78,10 @@ func main() {
 	logLevel := flag.String("log-level", "info", "Log level: debug, info, warn, error")
 	flag.Parse()
 
-	// Setup logging
+	// Setup logging; a plan is printed on stdout, so logs go to stderr
+	if *plan {
+		logOutput = os.Stderr
+	}
 	initLogger(*logFormat, *logLevel)
 
 	// Resolve paths to absolute paths
@@ -108,6 +116,15 @@ func main() {
 	}
 	outputDir = &absOutputDir
 
+	if *cacheDir != "" {
+		absCacheDir, err := filepath.Abs(*cacheDir)
+		if err != nil {
+			slog.Error("Failed to resolve cache directory path", "path", *cacheDir, "error", err)
+			os.Exit(1)
+		}
+		cacheDir = &absCacheDir
+	}
+
 	logFields := []any{
 		"version", Version,
 		"git_commit", GitCommit,
@@ -167,15 +184,18 @@ func main() {
 	}
 	slog.Info("All policies validated successfully", "phase", "validation")
 
-	// Phase 3: Code Generation
-	slog.Info("Starting Phase 3: Code Generation", "phase", "generation")
-	if err := policyengine.GenerateCode(*policyEngineSrc, policies, *outputDir); err != nil {
-		errors.FatalError(err)
+	if *plan {
+		slog.Info("Starting Phase 3: Code Generation (plan)", "phase", "generation")
+		if err := runPlanCodeGeneration(*policyEngineSrc, policies); err != nil {
+			errors.FatalError(err)
+		}
+		if err := writeBuildPlan(os.Stdout, newBuildPlan(policies, *gatewayRuntimeBaseImage, *gatewayControllerBaseImage)); err != nil {
+			errors.FatalError(errors.NewGenerationError("failed to print build plan", err))
+		}
+		slog.Info("Build plan completed; skipping compilation and Dockerfile generation", "phase", "complete")
+		return
 	}
 
-	// Phase 4: Compilation
-	slog.Info("Starting Phase 4: Compilation", "phase", "compilation")
-
 	// Read version information from environment variables (set by Dockerfile)
 	// Fall back to builder's own version if not set
 	policyEngineVersion := os.Getenv("VERSION")
@@ -231,10 +251,52 @@ func main() {
 	policyEngineBin := filepath.Join(tempDir, "policy-engine")
 	compileOpts := compilation.BuildOptions(policyEngineBin, buildMetadata)
 
-	if err := compilation.CompileBinary(*policyEngineSrc, compileOpts); err != nil {
+	// Reuse the binary from the last build when none of its inputs changed
+	var buildCache *compilation.BuildCache
+	var cacheKey string
+	cacheHit := false
+	if *cacheDir != "" {
+		buildCache, cacheKey, cacheHit = restoreFromBuildCache(*cacheDir, compilation.CacheInputs{
+			ManifestPaths:   []string{*buildFilePath, *systemBuildLockPath},
+			Policies:        policies,
+			PolicyEngineSrc: *policyEngineSrc,
+			BuilderVersion:  Version,
+			Metadata:        buildMetadata,
+			Options:         compileOpts,
+		}, policyEngineBin)
+		if buildCache != nil {
+			compileOpts.GoCacheDir = buildCache.GoCacheDir()
+		}
+	}
+
+	// Phase 3: Code Generation
+	slog.Info("Starting Phase 3: Code Generation", "phase", "generation")
+	if cacheHit {
+		slog.Info("Skipping policy engine code generation; binary restored from build cache", "phase", "generation")
+		if err := policyengine.GeneratePythonOutputs(*policyEngineSrc, policies, *outputDir); err != nil {
+			errors.FatalError(err)
+		}
+	} else if err := policyengine.GenerateCode(*policyEngineSrc, policies, *outputDir); err != nil {
 		errors.FatalError(err)
 	}
 
+	// Phase 4: Compilation
+	slog.Info("Starting Phase 4: Compilation", "phase", "compilation")
+	if cacheHit {
+		slog.Info("Skipping compilation; binary restored from build cache",
+			"path", policyEngineBin,
+			"phase", "compilation")
+	} else {
+		if err := compilation.CompileBinary(*policyEngineSrc, compileOpts); err != nil {
+			errors.FatalError(err)
+		}
+		if buildCache != nil {
+			if err := buildCache.Store(cacheKey, policyEngineBin); err != nil {
+				slog.Warn("Failed to update build cache", "error", err, "phase", "compilation")
+			}
+		}
+	}
+
 	// Phase 5: Dockerfile Generation
 	slog.Info("Starting Phase 5: Dockerfile Generation", "phase", "dockerfile-generation")
 
@@ -297,6 +359,35 @@ func main() {
 	slog.Info("Copied build-manifest.yaml into gateway-controller build context successfully", "dst", gcBuildManifestDst)
 }
 
+// restoreFromBuildCache opens the build cache and restores the policy engine
+// binary into binPath when it was built from the same inputs. Cache errors are
+// logged and treated as a miss; a nil cache means caching is unavailable.
+func restoreFromBuildCache(dir string, inputs compilation.CacheInputs, binPath string) (*compilation.BuildCache, string, bool) {
+	buildCache, err := compilation.NewBuildCache(dir)
+	if err != nil {
+		slog.Warn("Build cache unavailable", "dir", dir, "error", err, "phase", "compilation")
+		return nil, "", false
+	}
+
+	key, err := compilation.CacheKey(inputs)
+	if err != nil {
+		slog.Warn("Failed to compute build cache key", "error", err, "phase", "compilation")
+		return nil, "", false
+	}
+
+	hit, err := buildCache.Restore(key, binPath)
+	if err != nil {
+		slog.Warn("Failed to restore from build cache", "error", err, "phase", "compilation")
+		hit = false
+	}
+	if hit {
+		slog.Info("Build cache hit", "key", key, "dir", dir, "phase", "compilation")
+	} else {
+		slog.Info("Build cache miss", "key", key, "dir", dir, "phase", "compilation")
+	}
+	return buildCache, key, hit
+}
+
 func sanitizeLogValue(value string) string {
 	return strings.NewReplacer(
 		"\n", "\\n",
@@ -305,6 +396,9 @@ func sanitizeLogValue(value string) string {
 	).Replace(value)
 }
 
+// logOutput is where log records are written
+var logOutput io.Writer = os.Stdout
+
 // initLogger sets up the slog logger based on format and level
 func initLogger(format, level string) {
 	// Determine log level
@@ -329,7 +423,7 @@ func initLogger(format, level string) {
 	}
 
 	if format == "json" {
-		handler = slog.NewJSONHandler(os.Stdout, handlerOpts)
+		handler = slog.NewJSONHandler(logOutput, handlerOpts)
 	} else {
 		// Text handler with custom formatting for cleaner output
 		handlerOpts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
@@ -339,7 +433,7 @@ func initLogger(format, level string) {
 			}
 			return a
 		}
-		handler = slog.NewTextHandler(os.Stdout, handlerOpts)
+		handler = slog.NewTextHandler(logOutput, handlerOpts)
 	}
 
 	logger := slog.New(handler)
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/internal/policyengine"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/errors"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/fsutil"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+	"golang.org/x/mod/modfile"
+)
+
+// BuildPlan describes what a build would produce. It only holds values that are
+// stable across machines, so plans from two branches can be diffed directly.
+type BuildPlan struct {
+	BuilderVersion string          `json:"builderVersion"`
+	Images         []PlannedImage  `json:"images"`
+	Policies       []PlannedPolicy `json:"policies"`
+}
+
+// PlannedImage is a gateway image the build would produce
+type PlannedImage struct {
+	Name      string `json:"name"`
+	BaseImage string `json:"baseImage"`
+}
+
+// PlannedPolicy is a policy the build would include
+type PlannedPolicy struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Runtime string `json:"runtime"`
+	Source  string `json:"source"`
+	Module  string `json:"module,omitempty"`
+}
+
+// newBuildPlan creates the plan for the discovered policies, sorted by name and version
+func newBuildPlan(policies []*types.DiscoveredPolicy, runtimeBaseImage, controllerBaseImage string) *BuildPlan {
+	plan := &BuildPlan{
+		BuilderVersion: Version,
+		Images: []PlannedImage{
+			{Name: "gateway-runtime", BaseImage: runtimeBaseImage},
+			{Name: "gateway-controller", BaseImage: controllerBaseImage},
+		},
+		Policies: make([]PlannedPolicy, 0, len(policies)),
+	}
+
+	for _, p := range policies {
+		planned := PlannedPolicy{
+			Name:    p.Name,
+			Version: p.Version,
+			Runtime: p.Runtime,
+		}
+		switch {
+		case p.IsPipPackage:
+			planned.Source = "pipPackage"
+			planned.Module = p.PipSpec
+		case p.GoModuleVersion != "":
+			planned.Source = "gomodule"
+			planned.Module = p.GoModulePath + "@" + p.GoModuleVersion
+		default:
+			planned.Source = "filePath"
+			planned.Module = p.GoModulePath
+		}
+		plan.Policies = append(plan.Policies, planned)
+	}
+
+	sort.Slice(plan.Policies, func(i, j int) bool {
+		if plan.Policies[i].Name != plan.Policies[j].Name {
+			return plan.Policies[i].Name < plan.Policies[j].Name
+		}
+		return plan.Policies[i].Version < plan.Policies[j].Version
+	})
+	return plan
+}
+
+// writeBuildPlan writes the plan as indented JSON
+func writeBuildPlan(w io.Writer, plan *BuildPlan) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal build plan: %w", err)
+	}
+	if _, err := fmt.Fprintln(w, string(data)); err != nil {
+		return fmt.Errorf("failed to write build plan: %w", err)
+	}
+	return nil
+}
+
+// runPlanCodeGeneration runs code generation against a copy of the policy engine
+// source in a temp directory, so that a plan leaves the real source and output
+// directory untouched. Compilation and Dockerfile generation are not run.
+func runPlanCodeGeneration(policyEngineSrc string, policies []*types.DiscoveredPolicy) error {
+	tempDir, err := os.MkdirTemp("", "gateway-builder-plan-*")
+	if err != nil {
+		return errors.NewGenerationError("failed to create plan directory", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	srcDir, err := stagePolicyEngineSource(policyEngineSrc, tempDir)
+	if err != nil {
+		return errors.NewGenerationError("failed to stage policy engine source for plan", err)
+	}
+
+	slog.Info("Generating code into plan directory", "dir", tempDir, "phase", "generation")
+	return policyengine.GenerateCode(srcDir, policies, filepath.Join(tempDir, "output"))
+}
+
+// stagePolicyEngineSource copies the policy engine source and the sibling
+// python-executor directory into dir, and rewrites relative replace directives
+// in go.mod to absolute paths so that they still resolve from the copy.
+// It returns the path of the copied policy engine source.
+func stagePolicyEngineSource(policyEngineSrc, dir string) (string, error) {
+	srcDir := filepath.Join(dir, filepath.Base(policyEngineSrc))
+	if err := fsutil.CopyDir(policyEngineSrc, srcDir); err != nil {
+		return "", fmt.Errorf("failed to copy policy engine source: %w", err)
+	}
+
+	executorSrc := filepath.Join(policyEngineSrc, "..", "python-executor")
+	if _, err := os.Stat(executorSrc); err == nil {
+		if err := fsutil.CopyDir(executorSrc, filepath.Join(dir, "python-executor")); err != nil {
+			return "", fmt.Errorf("failed to copy python executor source: %w", err)
+		}
+	}
+
+	goModPath := filepath.Join(srcDir, "go.mod")
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	modFile, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	for _, r := range modFile.Replace {
+		if !modfile.IsDirectoryPath(r.New.Path) || filepath.IsAbs(r.New.Path) {
+			continue
+		}
+		target := filepath.Join(policyEngineSrc, r.New.Path)
+		if err := modFile.AddReplace(r.Old.Path, r.Old.Version, target, ""); err != nil {
+			return "", fmt.Errorf("failed to rewrite replace directive for %s: %w", r.Old.Path, err)
+		}
+	}
+	out, err := modFile.Format()
+	if err != nil {
+		return "", fmt.Errorf("failed to format go.mod: %w", err)
+	}
+	if err := os.WriteFile(goModPath, out, 0644); err != nil {
+		return "", fmt.Errorf("failed to write go.mod: %w", err)
+	}
+	return srcDir, nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/internal/testutils"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+func TestNewBuildPlan(t *testing.T) {
+	policies := []*types.DiscoveredPolicy{
+		{Name: "ratelimit", Version: "v1.0.0", Runtime: "go", GoModulePath: "github.com/example/ratelimit", GoModuleVersion: "v1.0.0", Path: "/tmp/a"},
+		{Name: "local-auth", Version: "v0.1.0", Runtime: "go", GoModulePath: "github.com/example/local-auth", IsFilePathEntry: true, Path: "/tmp/b"},
+		{Name: "guard", Version: "v2.0.0", Runtime: "python", IsPipPackage: true, PipSpec: "guard==2.0.0"},
+		{Name: "slugify", Version: "v1.0.0", Runtime: "python", PythonSourceDir: "/tmp/c"},
+	}
+
+	plan := newBuildPlan(policies, "runtime:1.0.0", "controller:1.0.0")
+
+	assert.Equal(t, []PlannedImage{
+		{Name: "gateway-runtime", BaseImage: "runtime:1.0.0"},
+		{Name: "gateway-controller", BaseImage: "controller:1.0.0"},
+	}, plan.Images)
+	assert.Equal(t, []PlannedPolicy{
+		{Name: "guard", Version: "v2.0.0", Runtime: "python", Source: "pipPackage", Module: "guard==2.0.0"},
+		{Name: "local-auth", Version: "v0.1.0", Runtime: "go", Source: "filePath", Module: "github.com/example/local-auth"},
+		{Name: "ratelimit", Version: "v1.0.0", Runtime: "go", Source: "gomodule", Module: "github.com/example/ratelimit@v1.0.0"},
+		{Name: "slugify", Version: "v1.0.0", Runtime: "python", Source: "filePath"},
+	}, plan.Policies)
+}
+
+func TestWriteBuildPlan(t *testing.T) {
+	plan := newBuildPlan([]*types.DiscoveredPolicy{
+		{Name: "ratelimit", Version: "v1.0.0", Runtime: "go", GoModulePath: "github.com/example/ratelimit", IsFilePathEntry: true, Path: "/tmp/a"},
+	}, "runtime:1.0.0", "controller:1.0.0")
+
+	var buf bytes.Buffer
+	require.NoError(t, writeBuildPlan(&buf, plan))
+
+	var decoded BuildPlan
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, *plan, decoded)
+	assert.NotContains(t, buf.String(), "/tmp/a", "machine-specific paths make plans hard to diff")
+}
+
+func TestStagePolicyEngineSource(t *testing.T) {
+	root := t.TempDir()
+	engineSrc := filepath.Join(root, "gateway-runtime", "policy-engine")
+	testutils.WriteFile(t, filepath.Join(engineSrc, "go.mod"), `module github.com/example/policy-engine
+
+go 1.22
+
+replace github.com/example/common => ../../common
+
+replace github.com/example/pinned => github.com/example/fork v1.0.0
+`)
+	testutils.WriteFile(t, filepath.Join(engineSrc, "cmd", "policy-engine", "main.go"), "package main\n")
+	testutils.WriteFile(t, filepath.Join(root, "gateway-runtime", "python-executor", "main.py"), "")
+
+	planDir := t.TempDir()
+	srcDir, err := stagePolicyEngineSource(engineSrc, planDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(planDir, "policy-engine"), srcDir)
+	assert.FileExists(t, filepath.Join(srcDir, "cmd", "policy-engine", "main.go"))
+	assert.FileExists(t, filepath.Join(planDir, "python-executor", "main.py"))
+
+	goMod, err := os.ReadFile(filepath.Join(srcDir, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(goMod), "github.com/example/common => "+filepath.Join(root, "common"))
+	assert.Contains(t, string(goMod), "github.com/example/pinned => github.com/example/fork v1.0.0")
+
+	// The original source is left untouched
+	original, err := os.ReadFile(filepath.Join(engineSrc, "go.mod"))
+	require.NoError(t, err)
+	assert.Contains(t, string(original), "=> ../../common")
+}
@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package compilation
+
+import (
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/fsutil"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+const (
+	// cachedBinaryName is the policy engine binary kept from the last build
+	cachedBinaryName = "policy-engine"
+	// cacheStampName records the key the cached binary was built for
+	cacheStampName = "build-cache.json"
+	// goBuildCacheDir holds the Go build cache (GOCACHE) shared across builds
+	goBuildCacheDir = "go-build"
+)
+
+// generatedSourceFiles are written into the policy engine source by the code
+// generation phase, so they are left out of the source hash
+var generatedSourceFiles = map[string]bool{
+	filepath.Join("cmd", "policy-engine", "plugin_registry.go"): true,
+	filepath.Join("cmd", "policy-engine", "build_info.go"):      true,
+}
+
+// BuildCache keeps the last compiled policy engine binary, keyed by everything
+// that goes into it, together with a Go build cache shared across builds
+type BuildCache struct {
+	dir string
+}
+
+// cacheStamp is the content of the cache stamp file
+type cacheStamp struct {
+	Key     string    `json:"key"`
+	BuiltAt time.Time `json:"builtAt"`
+}
+
+// CacheInputs lists everything that determines the compiled policy engine binary
+type CacheInputs struct {
+	// ManifestPaths are the build file and system build lock the policies were resolved from
+	ManifestPaths   []string
+	Policies        []*types.DiscoveredPolicy
+	PolicyEngineSrc string
+	BuilderVersion  string
+	Metadata        *types.BuildMetadata
+	Options         *types.CompilationOptions
+}
+
+// NewBuildCache creates the cache directory if needed
+func NewBuildCache(dir string) (*BuildCache, error) {
+	if err := os.MkdirAll(filepath.Join(dir, goBuildCacheDir), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create build cache directory: %w", err)
+	}
+	return &BuildCache{dir: dir}, nil
+}
+
+// GoCacheDir returns the directory to use as GOCACHE
+func (c *BuildCache) GoCacheDir() string {
+	return filepath.Join(c.dir, goBuildCacheDir)
+}
+
+// Restore copies the cached binary to dst when it was built for key.
+// It reports false when the cache is empty or was built for another key.
+func (c *BuildCache) Restore(key, dst string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(c.dir, cacheStampName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read build cache stamp: %w", err)
+	}
+
+	var stamp cacheStamp
+	if err := json.Unmarshal(data, &stamp); err != nil {
+		return false, fmt.Errorf("failed to parse build cache stamp: %w", err)
+	}
+	if stamp.Key != key {
+		return false, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+		return false, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := fsutil.CopyFile(filepath.Join(c.dir, cachedBinaryName), dst); err != nil {
+		return false, fmt.Errorf("failed to restore cached binary: %w", err)
+	}
+	if err := os.Chmod(dst, 0755); err != nil {
+		return false, fmt.Errorf("failed to restore cached binary: %w", err)
+	}
+	return true, nil
+}
+
+// Store keeps binPath as the cached binary for key. The stamp is written last,
+// so an interrupted store never pairs a key with the wrong binary.
+func (c *BuildCache) Store(key, binPath string) error {
+	stampPath := filepath.Join(c.dir, cacheStampName)
+	if err := os.Remove(stampPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to invalidate build cache stamp: %w", err)
+	}
+	if err := fsutil.CopyFile(binPath, filepath.Join(c.dir, cachedBinaryName)); err != nil {
+		return fmt.Errorf("failed to cache binary: %w", err)
+	}
+
+	data, err := json.Marshal(cacheStamp{Key: key, BuiltAt: time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to encode build cache stamp: %w", err)
+	}
+	tmp := stampPath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write build cache stamp: %w", err)
+	}
+	if err := os.Rename(tmp, stampPath); err != nil {
+		return fmt.Errorf("failed to write build cache stamp: %w", err)
+	}
+	return nil
+}
+
+// CacheKey hashes the build inputs. The build date is deliberately left out so
+// that rebuilding unchanged inputs is a cache hit.
+func CacheKey(inputs CacheInputs) (string, error) {
+	h := sha3.New256()
+	field := func(name, value string) {
+		fmt.Fprintf(h, "%s=%q\n", name, value)
+	}
+
+	field("builder", inputs.BuilderVersion)
+	if inputs.Metadata != nil {
+		field("version", inputs.Metadata.Version)
+		field("gitCommit", inputs.Metadata.GitCommit)
+	}
+	if opts := inputs.Options; opts != nil {
+		field("tags", strings.Join(opts.BuildTags, ","))
+		field("cgo", fmt.Sprint(opts.CGOEnabled))
+		field("goos", opts.TargetOS)
+		field("goarch", opts.TargetArch)
+		field("coverage", fmt.Sprint(opts.EnableCoverage))
+		field("debug", fmt.Sprint(opts.EnableDebug))
+	}
+
+	for _, path := range inputs.ManifestPaths {
+		if path == "" {
+			continue
+		}
+		field("manifest", filepath.Base(path))
+		if err := hashFile(h, path); err != nil {
+			return "", err
+		}
+	}
+
+	policies := make([]*types.DiscoveredPolicy, len(inputs.Policies))
+	copy(policies, inputs.Policies)
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Name != policies[j].Name {
+			return policies[i].Name < policies[j].Name
+		}
+		return policies[i].Version < policies[j].Version
+	})
+	for _, p := range policies {
+		field("policy", p.Name+"@"+p.Version)
+		field("runtime", p.Runtime)
+		field("goModule", p.GoModulePath+"@"+p.GoModuleVersion)
+		field("pip", p.PipSpec)
+		field("pipIndex", p.PipIndexURL)
+		for _, dir := range []string{p.Path, p.PythonSourceDir} {
+			if dir == "" {
+				continue
+			}
+			if err := hashTree(h, dir, nil); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	field("policyEngine", "")
+	if err := hashTree(h, inputs.PolicyEngineSrc, generatedSourceFiles); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFile writes the size and content of path to h
+func hashFile(h hash.Hash, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	fmt.Fprintf(h, "size=%d\n", info.Size())
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	return nil
+}
+
+// hashTree writes the relative path and content of every file under root to h,
+// in lexical order, skipping VCS metadata and the given relative paths
+func hashTree(h hash.Hash, root string, skip map[string]bool) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to hash %s: %w", root, err)
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if skip[rel] {
+			return nil
+		}
+
+		fmt.Fprintf(h, "file=%q\n", filepath.ToSlash(rel))
+		switch {
+		case d.Type().IsRegular():
+			return hashFile(h, path)
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return fmt.Errorf("failed to hash %s: %w", path, err)
+			}
+			fmt.Fprintf(h, "link=%q\n", target)
+		}
+		return nil
+	})
+}
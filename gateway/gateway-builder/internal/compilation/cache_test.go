@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package compilation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+// writeFile creates path with content, including missing parent directories
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+// newCacheInputs lays out a build file, one policy and a policy engine source tree
+func newCacheInputs(t *testing.T) CacheInputs {
+	t.Helper()
+	root := t.TempDir()
+	buildFile := filepath.Join(root, "build.yaml")
+	writeFile(t, buildFile, "policies:\n  - name: ratelimit\n")
+	policyDir := filepath.Join(root, "policies", "ratelimit")
+	writeFile(t, filepath.Join(policyDir, "ratelimit.go"), "package ratelimit\n")
+	engineSrc := filepath.Join(root, "policy-engine")
+	writeFile(t, filepath.Join(engineSrc, "go.mod"), "module policy-engine\n")
+	writeFile(t, filepath.Join(engineSrc, "cmd", "policy-engine", "main.go"), "package main\n")
+
+	return CacheInputs{
+		ManifestPaths:   []string{buildFile, ""},
+		Policies:        []*types.DiscoveredPolicy{{Name: "ratelimit", Version: "v1.0.0", Path: policyDir}},
+		PolicyEngineSrc: engineSrc,
+		BuilderVersion:  "v1.0.0",
+		Metadata:        &types.BuildMetadata{Version: "1.0.0", GitCommit: "abc123", Timestamp: time.Now()},
+		Options:         &types.CompilationOptions{TargetOS: "linux", TargetArch: "amd64"},
+	}
+}
+
+func TestCacheKey_Stable(t *testing.T) {
+	inputs := newCacheInputs(t)
+
+	first, err := CacheKey(inputs)
+	require.NoError(t, err)
+	assert.Len(t, first, 64)
+
+	// The build date and generated sources must not invalidate the cache
+	inputs.Metadata.Timestamp = inputs.Metadata.Timestamp.Add(time.Hour)
+	writeFile(t, filepath.Join(inputs.PolicyEngineSrc, "cmd", "policy-engine", "plugin_registry.go"), "package main\n")
+	second, err := CacheKey(inputs)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestCacheKey_ChangesWithInputs(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, inputs *CacheInputs)
+	}{
+		{"build file", func(t *testing.T, inputs *CacheInputs) {
+			writeFile(t, inputs.ManifestPaths[0], "policies:\n  - name: ratelimit\n    version: v2\n")
+		}},
+		{"policy source", func(t *testing.T, inputs *CacheInputs) {
+			writeFile(t, filepath.Join(inputs.Policies[0].Path, "ratelimit.go"), "package ratelimit // changed\n")
+		}},
+		{"new policy file", func(t *testing.T, inputs *CacheInputs) {
+			writeFile(t, filepath.Join(inputs.Policies[0].Path, "helper.go"), "package ratelimit\n")
+		}},
+		{"policy engine source", func(t *testing.T, inputs *CacheInputs) {
+			writeFile(t, filepath.Join(inputs.PolicyEngineSrc, "cmd", "policy-engine", "main.go"), "package main // changed\n")
+		}},
+		{"target arch", func(t *testing.T, inputs *CacheInputs) {
+			inputs.Options.TargetArch = "arm64"
+		}},
+		{"coverage", func(t *testing.T, inputs *CacheInputs) {
+			inputs.Options.EnableCoverage = true
+		}},
+		{"version", func(t *testing.T, inputs *CacheInputs) {
+			inputs.Metadata.Version = "1.0.1"
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs := newCacheInputs(t)
+			before, err := CacheKey(inputs)
+			require.NoError(t, err)
+
+			tt.change(t, &inputs)
+			after, err := CacheKey(inputs)
+			require.NoError(t, err)
+
+			assert.NotEqual(t, before, after)
+		})
+	}
+}
+
+func TestCacheKey_MissingPolicySource(t *testing.T) {
+	inputs := newCacheInputs(t)
+	inputs.Policies[0].Path = filepath.Join(t.TempDir(), "missing")
+
+	_, err := CacheKey(inputs)
+
+	assert.Error(t, err)
+}
+
+func TestBuildCache_StoreAndRestore(t *testing.T) {
+	cache, err := NewBuildCache(filepath.Join(t.TempDir(), "cache"))
+	require.NoError(t, err)
+	assert.DirExists(t, cache.GoCacheDir())
+
+	dst := filepath.Join(t.TempDir(), "bin", "policy-engine")
+	hit, err := cache.Restore("key-1", dst)
+	require.NoError(t, err)
+	assert.False(t, hit, "empty cache")
+
+	bin := filepath.Join(t.TempDir(), "policy-engine")
+	writeFile(t, bin, "binary-1")
+	require.NoError(t, cache.Store("key-1", bin))
+
+	hit, err = cache.Restore("key-2", dst)
+	require.NoError(t, err)
+	assert.False(t, hit, "built for another key")
+	assert.NoFileExists(t, dst)
+
+	hit, err = cache.Restore("key-1", dst)
+	require.NoError(t, err)
+	assert.True(t, hit)
+	content, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, "binary-1", string(content))
+	info, err := os.Stat(dst)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode().Perm()&0100, "restored binary must be executable")
+}
+
+func TestBuildCache_CorruptStamp(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache, err := NewBuildCache(dir)
+	require.NoError(t, err)
+	writeFile(t, filepath.Join(dir, cacheStampName), "{not json")
+
+	hit, err := cache.Restore("key-1", filepath.Join(t.TempDir(), "policy-engine"))
+
+	assert.Error(t, err)
+	assert.False(t, hit)
+}
@@ -180,11 +180,15 @@ func runGoBuild(srcDir string, options *types.CompilationOptions) error {
 	if options.TargetArch != "" {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("GOARCH=%s", options.TargetArch))
 	}
+	if options.GoCacheDir != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOCACHE=%s", options.GoCacheDir))
+	}
 
 	slog.Debug("Build environment",
 		"CGO_ENABLED", options.CGOEnabled,
 		"GOOS", options.TargetOS,
 		"GOARCH", options.TargetArch,
+		"GOCACHE", options.GoCacheDir,
 		"phase", "compilation")
 
 	cmd.Stdout = os.Stdout
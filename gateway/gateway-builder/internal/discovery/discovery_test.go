@@ -506,6 +506,58 @@ policies:
 	assert.Contains(t, err.Error(), "policy name mismatch")
 }
 
+func TestDiscoverPoliciesFromBuildFile_VersionMismatch(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	policyDir := testutils.CreatePolicyDir(t, tmpDir, "ratelimit", "v1.0.0")
+	testutils.CreatePolicyDefinitionYAML(t, policyDir, "ratelimit", "v1.0.0")
+	testutils.WriteGoMod(t, policyDir, "github.com/example/policies/ratelimit")
+	testutils.WriteFile(t, filepath.Join(policyDir, "ratelimit.go"), "package ratelimit\n")
+
+	// The build file declares a version that is not the one in the policy definition
+	manifestContent := `version: v1
+policies:
+  - name: ratelimit
+    version: v1.0.1
+    filePath: ./policies/ratelimit/v1.0.0
+`
+	manifestPath := filepath.Join(tmpDir, "build.yaml")
+	testutils.WriteFile(t, manifestPath, manifestContent)
+
+	policies, err := DiscoverPoliciesFromBuildFile(manifestPath, "")
+
+	require.Error(t, err)
+	assert.Nil(t, policies)
+	assert.Contains(t, err.Error(), "policy version mismatch")
+	assert.Contains(t, err.Error(), "ratelimit:v1.0.1")
+	assert.Contains(t, err.Error(), "has version v1.0.0")
+}
+
+func TestDiscoverPoliciesFromBuildFile_VersionMatches(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	policyDir := testutils.CreatePolicyDir(t, tmpDir, "ratelimit", "v1.0.0")
+	testutils.CreatePolicyDefinitionYAML(t, policyDir, "ratelimit", "v1.0.0")
+	testutils.WriteGoMod(t, policyDir, "github.com/example/policies/ratelimit")
+	testutils.WriteFile(t, filepath.Join(policyDir, "ratelimit.go"), "package ratelimit\n")
+
+	// The "v" prefix is optional in the build file
+	manifestContent := `version: v1
+policies:
+  - name: ratelimit
+    version: 1.0.0
+    filePath: ./policies/ratelimit/v1.0.0
+`
+	manifestPath := filepath.Join(tmpDir, "build.yaml")
+	testutils.WriteFile(t, manifestPath, manifestContent)
+
+	policies, err := DiscoverPoliciesFromBuildFile(manifestPath, "")
+
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "v1.0.0", policies[0].Version)
+}
+
 func TestDiscoverPoliciesFromBuildFile_MissingVersion(t *testing.T) {
 	tmpDir := t.TempDir()
 
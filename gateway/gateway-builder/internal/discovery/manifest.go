@@ -27,6 +27,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/errors"
@@ -182,65 +183,73 @@ func DiscoverPoliciesFromBuildFile(buildFilePath string, baseDir string) ([]*typ
 	var discovered []*types.DiscoveredPolicy
 
 	for _, entry := range bf.Policies {
-		// Handle pip package (explicit Python remote)
-		if entry.PipPackage != "" {
-			policy, err := discoverPipPolicy(entry)
-			if err != nil {
-				return nil, err
-			}
-			discovered = append(discovered, policy)
-			continue
-		}
-
-		// Handle Go module (explicit Go remote)
-		if entry.Gomodule != "" {
-			policy, err := discoverGoPolicy(entry, baseDir)
-			if err != nil {
-				return nil, err
-			}
-			discovered = append(discovered, policy)
-			continue
+		policy, err := discoverEntry(entry, baseDir)
+		if err != nil {
+			return nil, err
 		}
-
-		// Handle filePath — auto-detect runtime by directory fingerprint
-		if entry.FilePath != "" {
-			policyPath := filepath.Join(baseDir, entry.FilePath)
-
-			if err := fsutil.ValidatePathExists(policyPath, "policy path"); err != nil {
-				return nil, errors.NewDiscoveryError(
-					fmt.Sprintf("from build file entry %s: %v", entry.Name, err),
-					err,
-				)
-			}
-
-			runtime, err := DetectRuntime(policyPath)
-			if err != nil {
-				return nil, errors.NewDiscoveryError(
-					fmt.Sprintf("failed to detect policy runtime for %s", policyPath),
-					err,
-				)
-			}
-
-			if runtime == "python" {
-				policy, err := discoverLocalPythonPolicy(entry, baseDir)
-				if err != nil {
-					return nil, err
-				}
-				discovered = append(discovered, policy)
-			} else {
-				policy, err := discoverGoPolicy(entry, baseDir)
-				if err != nil {
-					return nil, err
-				}
-				discovered = append(discovered, policy)
-			}
-			continue
+		if err := validateDeclaredVersion(entry, policy); err != nil {
+			return nil, err
 		}
+		discovered = append(discovered, policy)
 	}
 
 	return discovered, nil
 }
 
+// discoverEntry discovers the policy declared by a single build file entry
+func discoverEntry(entry types.BuildEntry, baseDir string) (*types.DiscoveredPolicy, error) {
+	// Handle pip package (explicit Python remote)
+	if entry.PipPackage != "" {
+		return discoverPipPolicy(entry)
+	}
+
+	// Handle Go module (explicit Go remote)
+	if entry.Gomodule != "" {
+		return discoverGoPolicy(entry, baseDir)
+	}
+
+	// Handle filePath — auto-detect runtime by directory fingerprint
+	policyPath := filepath.Join(baseDir, entry.FilePath)
+
+	if err := fsutil.ValidatePathExists(policyPath, "policy path"); err != nil {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("from build file entry %s: %v", entry.Name, err),
+			err,
+		)
+	}
+
+	runtime, err := DetectRuntime(policyPath)
+	if err != nil {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("failed to detect policy runtime for %s", policyPath),
+			err,
+		)
+	}
+
+	if runtime == "python" {
+		return discoverLocalPythonPolicy(entry, baseDir)
+	}
+	return discoverGoPolicy(entry, baseDir)
+}
+
+// validateDeclaredVersion fails discovery when the build file entry declares a
+// version that differs from the version in the policy's own definition, so a
+// typo surfaces here rather than as a confusing error in a later phase.
+// A leading "v" is ignored on both sides.
+func validateDeclaredVersion(entry types.BuildEntry, policy *types.DiscoveredPolicy) error {
+	if entry.Version == "" {
+		return nil
+	}
+	if strings.TrimPrefix(entry.Version, "v") != strings.TrimPrefix(policy.Version, "v") {
+		return errors.NewDiscoveryError(
+			fmt.Sprintf("policy version mismatch: build file declares %s:%s but %s has version %s at %s",
+				entry.Name, entry.Version, types.PolicyDefinitionFile, policy.Version, policy.Path),
+			nil,
+		)
+	}
+	return nil
+}
+
 // DetectRuntime auto-detects the policy runtime by examining the directory contents.
 // Presence of go.mod → "go"; presence of .py files (and no go.mod) → "python".
 // Also recognises the industry-standard src layout where pyproject.toml exists at
@@ -121,6 +121,29 @@ func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir s
 	return nil
 }
 
+// GeneratePythonOutputs writes only the Python executor artifacts into outputDir.
+// It is used instead of GenerateCode when the policy engine binary is restored
+// from the build cache, as the generated Go sources are only needed to compile it.
+func GeneratePythonOutputs(srcDir string, policies []*types.DiscoveredPolicy, outputDir string) error {
+	if err := generatePythonExecutorBase(srcDir, outputDir); err != nil {
+		return errors.NewGenerationError("failed to generate Python executor base", err)
+	}
+
+	var pythonPolicies []*types.DiscoveredPolicy
+	for _, p := range policies {
+		if p.Runtime == "python" {
+			pythonPolicies = append(pythonPolicies, p)
+		}
+	}
+	if len(pythonPolicies) > 0 {
+		if err := GeneratePythonArtifacts(srcDir, pythonPolicies, outputDir); err != nil {
+			return errors.NewGenerationError("failed to generate Python artifacts", err)
+		}
+	}
+
+	return nil
+}
+
 // generatePythonExecutorBase generates the base Python executor files
 // This is always called to ensure Docker builds don't fail
 func generatePythonExecutorBase(srcDir string, outputDir string) error {
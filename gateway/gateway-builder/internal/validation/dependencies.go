@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package validation
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+// ValidateDependencies checks the dependsOn declarations of the discovered
+// policies: every dependency must be included in the build, and dependencies
+// must not form a cycle, as the controller could then never order a chain
+// containing those policies.
+func ValidateDependencies(policies []*types.DiscoveredPolicy) []types.ValidationError {
+	var errors []types.ValidationError
+
+	included := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		included[policy.Name] = true
+	}
+
+	// Dependencies are declared per policy version but chains are ordered by
+	// name, so the graph is the union of every version's declarations
+	graph := make(map[string][]string)
+	for _, policy := range policies {
+		if policy.Definition == nil {
+			continue
+		}
+		for _, dep := range policy.Definition.DependsOn {
+			slog.Debug("Checking policy dependency",
+				"policy", policy.Name,
+				"dependsOn", dep,
+				"phase", "validation")
+
+			if !included[dep] {
+				errors = append(errors, types.ValidationError{
+					PolicyName:    policy.Name,
+					PolicyVersion: policy.Version,
+					FilePath:      policy.YAMLPath,
+					Message:       fmt.Sprintf("depends on policy %q which is not included in the build", dep),
+				})
+				continue
+			}
+			graph[policy.Name] = append(graph[policy.Name], dep)
+		}
+	}
+
+	if cycle := findDependencyCycle(graph); cycle != nil {
+		var version, yamlPath string
+		for _, policy := range policies {
+			if policy.Name == cycle[0] {
+				version, yamlPath = policy.Version, policy.YAMLPath
+				break
+			}
+		}
+		errors = append(errors, types.ValidationError{
+			PolicyName:    cycle[0],
+			PolicyVersion: version,
+			FilePath:      yamlPath,
+			Message:       fmt.Sprintf("policy dependency cycle: %s", strings.Join(cycle, " -> ")),
+		})
+	}
+
+	return errors
+}
+
+// findDependencyCycle returns the first cycle in graph as a path that starts and
+// ends with the same policy, or nil when the graph is acyclic. Policies are
+// visited in name order so the reported cycle is deterministic.
+func findDependencyCycle(graph map[string][]string) []string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = inProgress
+		stack = append(stack, name)
+		for _, dep := range graph[name] {
+			switch state[dep] {
+			case inProgress:
+				for i, n := range stack {
+					if n == dep {
+						return append(append([]string{}, stack[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = done
+		return nil
+	}
+
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if state[name] == unvisited {
+			if cycle := visit(name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
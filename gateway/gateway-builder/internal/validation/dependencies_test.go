@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func dependentPolicy(name string, dependsOn ...string) *types.DiscoveredPolicy {
+	return &types.DiscoveredPolicy{
+		Name:       name,
+		Version:    "v1.0.0",
+		Definition: &policy.PolicyDefinition{Name: name, Version: "v1.0.0", DependsOn: dependsOn},
+	}
+}
+
+func TestValidateDependencies_SimpleDependency(t *testing.T) {
+	errs := ValidateDependencies([]*types.DiscoveredPolicy{
+		dependentPolicy("transform", "jwt-auth"),
+		dependentPolicy("jwt-auth"),
+	})
+
+	assert.Empty(t, errs)
+}
+
+func TestValidateDependencies_MissingDependency(t *testing.T) {
+	errs := ValidateDependencies([]*types.DiscoveredPolicy{
+		dependentPolicy("transform", "jwt-auth"),
+	})
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "transform", errs[0].PolicyName)
+	assert.Contains(t, errs[0].Message, `depends on policy "jwt-auth" which is not included in the build`)
+}
+
+func TestValidateDependencies_Cycle(t *testing.T) {
+	errs := ValidateDependencies([]*types.DiscoveredPolicy{
+		dependentPolicy("a", "b"),
+		dependentPolicy("b", "c"),
+		dependentPolicy("c", "a"),
+		dependentPolicy("d", "a"),
+	})
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "policy dependency cycle: a -> b -> c -> a", errs[0].Message)
+}
+
+func TestValidateDependencies_SelfDependency(t *testing.T) {
+	errs := ValidateDependencies([]*types.DiscoveredPolicy{
+		dependentPolicy("a", "a"),
+	})
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "policy dependency cycle: a -> a", errs[0].Message)
+}
+
+func TestValidatePolicies_DependencyCycle(t *testing.T) {
+	// Definition-only policies fail other checks too; only the cycle matters here
+	result, err := ValidatePolicies([]*types.DiscoveredPolicy{
+		dependentPolicy("a", "b"),
+		dependentPolicy("b", "a"),
+	})
+
+	assert.Error(t, err)
+	assert.False(t, result.Valid)
+	found := false
+	for _, e := range result.Errors {
+		if e.Message == "policy dependency cycle: a -> b -> a" {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected a dependency cycle error, got %v", result.Errors)
+}
@@ -102,6 +102,13 @@ func ValidatePolicies(policies []*types.DiscoveredPolicy) (*types.ValidationResu
 		}
 	}
 
+	// Validate dependsOn declarations across all policies
+	depErrors := ValidateDependencies(policies)
+	result.Errors = append(result.Errors, depErrors...)
+	if len(depErrors) > 0 {
+		result.Valid = false
+	}
+
 	if !result.Valid {
 		return result, errors.NewValidationError(
 			fmt.Sprintf("validation failed with %d error(s)", len(result.Errors)),
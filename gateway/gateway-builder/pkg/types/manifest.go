@@ -27,6 +27,7 @@ type BuildFile struct {
 // BuildEntry represents a single policy entry in the build file
 type BuildEntry struct {
 	Name       string `yaml:"name"`
+	Version    string `yaml:"version,omitempty"` // Optional; must match the policy definition version when set
 	FilePath   string `yaml:"filePath,omitempty"`
 	Gomodule   string `yaml:"gomodule,omitempty"`
 	PipPackage string `yaml:"pipPackage,omitempty"`
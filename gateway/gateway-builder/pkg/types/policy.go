@@ -108,8 +108,9 @@ type CompilationOptions struct {
 	CGOEnabled     bool
 	TargetOS       string
 	TargetArch     string
-	EnableCoverage bool // Enable coverage instrumentation for integration tests
-	EnableDebug    bool // Disable optimizations/inlining for dlv remote debugging
+	EnableCoverage bool   // Enable coverage instrumentation for integration tests
+	EnableDebug    bool   // Disable optimizations/inlining for dlv remote debugging
+	GoCacheDir     string // GOCACHE for go build; empty uses the default Go build cache
 }
 
 // PackagingMetadata contains Docker image metadata
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+// apiSocketMode is the mode of the REST API Unix socket: readable and writable
+// by owner and group, like the policy engine socket.
+const apiSocketMode os.FileMode = 0660
+
+// listenAPI opens the REST API listener: the Unix socket at server.api_socket
+// when set, else the TCP port server.api_port. The returned cleanup removes the
+// socket file and is called after the server has shut down.
+func listenAPI(server config.ServerConfig, log *slog.Logger) (net.Listener, func(), error) {
+	if server.APISocket == "" {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", server.APIPort))
+		if err != nil {
+			return nil, nil, err
+		}
+		return lis, func() {}, nil
+	}
+
+	socketPath := server.APISocket
+	// Remove a socket left behind by an earlier run, but never another kind of file
+	if info, err := os.Lstat(socketPath); err == nil {
+		if info.Mode().Type() != os.ModeSocket {
+			return nil, nil, fmt.Errorf("server.api_socket %s exists and is not a socket", socketPath)
+		}
+		if err := os.Remove(socketPath); err != nil {
+			log.Warn("Failed to remove existing socket file", slog.String("path", socketPath), slog.Any("error", err))
+		}
+	}
+
+	lis, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := os.Chmod(socketPath, apiSocketMode); err != nil {
+		log.Warn("Failed to set socket permissions", slog.String("path", socketPath), slog.Any("error", err))
+	}
+
+	cleanup := func() {
+		if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+			log.Warn("Failed to cleanup socket file on shutdown", slog.String("path", socketPath), slog.Any("error", err))
+		}
+	}
+	return lis, cleanup, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+// shortSocketPath returns a socket path in a fresh directory. t.TempDir paths
+// can exceed the Unix socket path length limit, so a short one is made here.
+func shortSocketPath(t *testing.T) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "gwc")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "api.sock")
+}
+
+func TestListenAPI_UnixSocket(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	socketPath := shortSocketPath(t)
+
+	// A socket left behind by an earlier run is replaced
+	stale, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	lis, cleanup, err := listenAPI(config.ServerConfig{APIPort: 9090, APISocket: socketPath}, log)
+	require.NoError(t, err)
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeSocket, info.Mode().Type())
+	assert.Equal(t, apiSocketMode, info.Mode().Perm())
+
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok "+r.URL.Path)
+	})}
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(lis) }()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+	resp, err := client.Get("http://gateway-controller/api/management/v0.9/health")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok /api/management/v0.9/health", string(body))
+
+	require.NoError(t, srv.Shutdown(context.Background()))
+	assert.ErrorIs(t, <-served, http.ErrServerClosed)
+	cleanup()
+	_, err = os.Stat(socketPath)
+	assert.True(t, os.IsNotExist(err), "socket file is removed on shutdown")
+}
+
+func TestListenAPI_RefusesNonSocketFile(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	socketPath := shortSocketPath(t)
+	require.NoError(t, os.WriteFile(socketPath, []byte("data"), 0600))
+
+	_, _, err := listenAPI(config.ServerConfig{APISocket: socketPath}, log)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a socket")
+
+	data, err := os.ReadFile(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data), "the file is left untouched")
+}
+
+func TestListenAPI_TCP(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	port := freePort(t)
+
+	lis, cleanup, err := listenAPI(config.ServerConfig{APIPort: port}, log)
+	require.NoError(t, err)
+	defer cleanup()
+	defer lis.Close()
+
+	assert.Equal(t, port, lis.Addr().(*net.TCPAddr).Port)
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	require.NoError(t, lis.Close())
+	return port
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"log/slog"
+	"os"
+	"time"
+)
+
+// drain runs the first phase of shutdown. It calls every markDraining
+// function, so the controller reports not ready and refuses new xDS streams,
+// and then waits for timeout so routers can move to another controller before
+// the servers stop. Another signal on interrupt ends the wait early.
+func drain(timeout time.Duration, interrupt <-chan os.Signal, log *slog.Logger, markDraining ...func()) {
+	for _, mark := range markDraining {
+		mark()
+	}
+	if timeout <= 0 {
+		return
+	}
+
+	log.Info("Draining before shutdown", slog.Duration("drain_timeout", timeout))
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		log.Info("Drain completed")
+	case <-interrupt:
+		log.Warn("Shutdown signal received again; ending drain early")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/adminserver"
+)
+
+func readyReadiness() *adminserver.Readiness {
+	readiness := adminserver.NewReadiness()
+	readiness.MarkStorageLoaded()
+	readiness.SetSnapshotCheck(func() bool { return true })
+	return readiness
+}
+
+func TestDrainReportsNotReadyBeforeShutdown(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	readiness := readyReadiness()
+	_, ready := readiness.Check()
+	require.True(t, ready)
+
+	done := make(chan struct{})
+	go func() {
+		drain(200*time.Millisecond, make(chan os.Signal), logger, readiness.MarkDraining)
+		close(done)
+	}()
+
+	// Readiness flips as soon as the drain starts, while the servers are
+	// still up.
+	require.Eventually(t, func() bool {
+		_, ready := readiness.Check()
+		return !ready
+	}, time.Second, 5*time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("drain returned before the drain timeout")
+	default:
+	}
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("drain did not return after the drain timeout")
+	}
+	resp, ready := readiness.Check()
+	assert.False(t, ready)
+	assert.True(t, *resp.Components.Draining)
+}
+
+func TestDrainEndsOnSecondSignal(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	interrupt := make(chan os.Signal, 1)
+	interrupt <- syscall.SIGTERM
+
+	start := time.Now()
+	drain(time.Minute, interrupt, logger)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDrainWithoutTimeout(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	readiness := readyReadiness()
+	marked := false
+
+	drain(0, make(chan os.Signal), logger, readiness.MarkDraining, func() { marked = true })
+
+	_, ready := readiness.Check()
+	assert.False(t, ready)
+	assert.True(t, marked)
+}
@@ -16,8 +16,13 @@ import (
 
 	"github.com/wso2/api-platform/common/eventhub"
 	"github.com/wso2/api-platform/common/webhooksecret"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/acmecert"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeywebhook"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certmonitor"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certstore"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/adminserver"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeyxds"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/authz"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/encryption"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/encryption/aesgcm"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/eventlistener"
@@ -40,6 +45,7 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/service/restapi"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/transform"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/version"
@@ -98,6 +104,7 @@ func toBackendConfig(cfg *config.Config) storage.BackendConfig {
 func main() {
 	// Parse command-line flags
 	configPath := flag.String("config", "", "Path to configuration file (required)")
+	validateConfig := flag.Bool("validate-config", false, "Validate the configuration file and exit without starting the controller")
 	flag.Parse()
 
 	// Validate that config file is provided
@@ -107,6 +114,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *validateConfig {
+		os.Exit(runValidateConfig(os.Stdout, *configPath))
+	}
+
 	// Load configuration
 	cfg, err := config.LoadConfig(*configPath)
 	if err != nil {
@@ -119,6 +130,10 @@ func main() {
 	metrics.SetEnabled(cfg.Controller.Metrics.Enabled)
 	metrics.Init() // Initialize metrics immediately so they're available throughout the codebase
 
+	// Environment variables ${VAR} references in API specs may resolve. Set before any
+	// configuration is rendered, including the stored ones loaded at startup.
+	templateengine.SetEnvAllowlist(cfg.Controller.EnvInterpolation.AllowedVars)
+
 	// Initialize logger with config
 	log := logger.NewLogger(logger.Config{
 		Level:  cfg.Controller.Logging.Level,
@@ -130,14 +145,10 @@ func main() {
 		slog.String("git_commit", version.GitCommit),
 		slog.String("build_date", version.BuildDate),
 		slog.String("config_file", *configPath),
-		slog.String("storage_type", cfg.Controller.Storage.Type),
-		slog.Bool("access_logs_enabled", cfg.Router.AccessLogs.Enabled),
-		slog.String("control_plane_host", cfg.Controller.ControlPlane.Host),
-		slog.Bool("control_plane_token_configured", cfg.Controller.ControlPlane.Token != ""),
-		slog.Bool("skip_invalid_deployments_on_startup", cfg.Controller.Server.SkipInvalidDeploymentsOnStartup),
+		slog.Any("config", cfg.Redacted()),
 	)
 
-	if !cfg.Controller.Auth.Basic.Enabled && !cfg.Controller.Auth.IDP.Enabled {
+	if !cfg.Controller.Auth.Basic.Enabled && len(cfg.Controller.Auth.EnabledIDPs()) == 0 {
 		log.Warn("No authentication configured: both basic auth and IDP are disabled. Gateway Controller API will allow all requests without authentication")
 	}
 
@@ -223,6 +234,7 @@ func main() {
 	// Initialize in-memory API key store for xDS
 	apiKeyStore := storage.NewAPIKeyStore(log)
 	apiKeySnapshotManager := apikeyxds.NewAPIKeySnapshotManager(apiKeyStore, log)
+	apiKeySnapshotManager.SetRevocationSource(db)
 	apiKeyXDSManager := apikeyxds.NewAPIKeyStateManager(apiKeyStore, apiKeySnapshotManager, log)
 
 	// Initialize in-memory lazy resource store and components for xDS
@@ -234,6 +246,9 @@ func main() {
 	var encryptionProviderManager *encryption.ProviderManager
 	var secretsService *secrets.SecretService
 
+	// Readiness is reported on the admin server's /ready endpoint.
+	readiness := adminserver.NewReadiness()
+
 	// Load configurations from database on startup
 	log.Info("Loading configurations from database")
 	if err := storage.LoadFromDatabase(db, configStore); err != nil {
@@ -245,6 +260,7 @@ func main() {
 		os.Exit(1)
 	}
 	log.Info("Loaded configurations", slog.Int("count", len(configStore.GetAll())))
+	readiness.MarkStorageLoaded()
 
 	// Load API keys from database into both in-memory stores
 	log.Info("Loading API keys from database")
@@ -314,13 +330,7 @@ func main() {
 			slog.String("path", cfg.Controller.Policies.BuildManifestPath),
 			slog.Any("error", err))
 	}
-	for key, def := range policyDefinitions {
-		def.ManagedBy = "wso2"
-		if localPolicies[def.Name+"|"+def.Version] {
-			def.ManagedBy = "organization"
-		}
-		policyDefinitions[key] = def
-	}
+	markPolicyOwnership(policyDefinitions, localPolicies)
 
 	// MCP proxies and LLM artifacts are stored in source form and need to be
 	// rehydrated into their derived RestAPI representations before startup
@@ -339,6 +349,7 @@ func main() {
 
 	// Initialize xDS snapshot manager with router config
 	snapshotManager := xds.NewSnapshotManager(configStore, log, &cfg.Router, db, cfg)
+	readiness.SetSnapshotCheck(snapshotManager.HasSnapshot)
 
 	// Initialize SDS secret manager if custom certificates are configured
 	var sdsSecretManager *xds.SDSSecretManager
@@ -361,6 +372,33 @@ func main() {
 		}
 	}
 
+	// Publish stored certificates to the policy engine so auth policies can trust them by name
+	if err := certstore.PublishTrustedCertificates(db, lazyResourceXDSManager, ""); err != nil {
+		log.Warn("Failed to publish trusted certificates to the policy engine", slog.Any("error", err))
+	}
+
+	// Obtain and renew the HTTPS listener certificate via ACME if configured
+	var acmeManager *acmecert.Manager
+	if cfg.Controller.Certificates.ACME.Enabled {
+		if sdsSecretManager == nil {
+			// The listener certificate is served over SDS even without an upstream cert store
+			sdsSecretManager = xds.NewSDSSecretManager(nil, snapshotManager.GetCache(), "router-node", log)
+			snapshotManager.SetSDSSecretManager(sdsSecretManager)
+		}
+		acmeManager, err = acmecert.NewManager(cfg.Controller.Certificates.ACME, sdsSecretManager,
+			func(ctx context.Context) error {
+				return snapshotManager.RefreshSecrets(ctx, "")
+			}, log)
+		if err != nil {
+			log.Error("Failed to initialize ACME certificate manager", slog.Any("error", err))
+			os.Exit(1)
+		}
+		if err := acmeManager.Start(); err != nil {
+			log.Error("Failed to start ACME certificate manager", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
 	// Generate initial xDS snapshot
 	log.Info("Generating initial xDS snapshot")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -374,7 +412,7 @@ func main() {
 	policyEngineConnected := make(chan struct{})
 
 	// Start xDS gRPC server with SDS support
-	xdsServer := xds.NewServer(snapshotManager, sdsSecretManager, cfg.Controller.Server.XDSPort, log, routerConnected)
+	xdsServer := xds.NewServer(snapshotManager, cfg.Controller.Server.XDSPort, log, routerConnected)
 	go func() {
 		if err := xdsServer.Start(); err != nil {
 			log.Error("xDS server failed", slog.Any("error", err))
@@ -470,15 +508,21 @@ func main() {
 	}
 	cancel()
 
-	// Start policy xDS server in a separate goroutine
+	// Start policy xDS server in a separate goroutine. Policy engines also report the
+	// API keys they validate over it, which is persisted as each key's last-used time.
+	apiKeyUsageService := utils.NewAPIKeyService(configStore, db, apiKeyXDSManager, &cfg.APIKey, eventHubInstance, gatewayID)
 	serverOpts := []policyxds.ServerOption{
 		policyxds.WithOnFirstConnect(policyEngineConnected),
+		policyxds.WithAPIKeyUsageRecorder(apiKeyUsageService),
 	}
 	if cfg.Controller.PolicyServer.TLS.Enabled {
 		serverOpts = append(serverOpts, policyxds.WithTLS(
 			cfg.Controller.PolicyServer.TLS.CertFile,
 			cfg.Controller.PolicyServer.TLS.KeyFile,
 		))
+		if cfg.Controller.PolicyServer.TLS.ClientCAFile != "" {
+			serverOpts = append(serverOpts, policyxds.WithClientCA(cfg.Controller.PolicyServer.TLS.ClientCAFile))
+		}
 	}
 	policyXDSServer := policyxds.NewServer(policySnapshotManager, apiKeySnapshotManager, lazyResourceSnapshotManager, subscriptionSnapshotManager, nil, cfg.Controller.PolicyServer.Port, log, serverOpts...)
 	go func() {
@@ -503,6 +547,7 @@ func main() {
 	validator := config.NewAPIValidator()
 	policyValidator := config.NewPolicyValidator(policyDefinitions)
 	validator.SetPolicyValidator(policyValidator)
+	validator.SetMaxBodyBytesCeiling(int64(cfg.Router.HTTPListener.MaxBodyBytesCeiling))
 
 	apiSvc := utils.NewAPIDeploymentService(configStore, db, snapshotManager, validator, &cfg.Router, eventHubInstance, gatewayID, secretsService)
 	mcpSvc := utils.NewMCPDeploymentService(configStore, db, snapshotManager, policyManager, policyValidator, eventHubInstance, gatewayID, secretsService)
@@ -532,6 +577,9 @@ func main() {
 		log.Error("Failed to start control plane client", slog.Any("error", err))
 		// Don't fail startup - gateway can run in degraded mode without control plane
 	}
+	if cfg.Controller.ControlPlane.Token != "" {
+		readiness.SetControlPlane(cpClient.IsConnected, cfg.Controller.AdminServer.ReadinessRequiresControlPlane)
+	}
 
 	// Wire the DP->CP push into the LLM/MCP deployment services so artifacts created through
 	// the service layer (notably the immutable-gateway loader, which bypasses the REST
@@ -564,6 +612,16 @@ func main() {
 		authenticators.AuthorizationMiddleware(authConfig, log),
 		authMiddleWare,
 	}
+	// The OPA policy decides after the role check, so it is innermost.
+	if cfg.Controller.Authz.OPA.Enabled {
+		opaAuthorizer, err := authz.NewOPAAuthorizer(context.Background(), cfg.Controller.Authz.OPA, managementAPIBasePath, log)
+		if err != nil {
+			log.Error("Failed to initialize OPA authorization", slog.Any("error", err))
+			os.Exit(1)
+		}
+		perRouteMiddlewares = append([]api.MiddlewareFunc{opaAuthorizer.Middleware()}, perRouteMiddlewares...)
+		log.Info("OPA authorization enabled", slog.String("query", cfg.Controller.Authz.OPA.Query))
+	}
 
 	// Initialize EventListener for multi-replica sync (consumes EventHub events)
 	var evtListener *eventlistener.EventListener
@@ -608,6 +666,50 @@ func main() {
 		restAPIService,
 	)
 
+	// Report stored certificates that are close to expiry or revoked
+	var certificateMonitor *certmonitor.Monitor
+	if cfg.Controller.Certificates.ExpiryMonitor.Enabled {
+		certificateMonitor = certmonitor.NewMonitor(cfg.Controller.Certificates.ExpiryMonitor, db, log)
+		certificateMonitor.Start()
+		apiServer.SetCertificateMonitor(certificateMonitor)
+	}
+
+	// Notify the configured webhook of API key lifecycle operations
+	var apiKeyWebhook *apikeywebhook.Notifier
+	if cfg.APIKey.Webhook.Enabled {
+		apiKeyWebhook = apikeywebhook.NewNotifier(cfg.APIKey.Webhook, log)
+		apiKeyWebhook.Start()
+		apiServer.SetAPIKeyWebhookNotifier(apiKeyWebhook)
+	}
+
+	// Revoke API keys once they pass their expiry time
+	var apiKeyExpirySweeper *utils.APIKeyExpirySweeper
+	if cfg.APIKey.ExpirySweeper.Enabled {
+		apiKeyExpiryService := utils.NewAPIKeyService(configStore, db, apiKeyXDSManager, &cfg.APIKey, eventHubInstance, gatewayID)
+		apiKeyExpiryService.SetWebhookNotifier(apiKeyWebhook)
+		apiKeyExpirySweeper = utils.NewAPIKeyExpirySweeper(apiKeyExpiryService, cfg.APIKey.ExpirySweeper.Interval, log)
+		apiKeyExpirySweeper.Start()
+	}
+
+	// Watch the policy definitions directory so added or edited definitions take
+	// effect without a restart (and without dropping xDS connections).
+	var policyWatcherCancel context.CancelFunc
+	if cfg.Controller.Policies.HotReload {
+		policyWatcher := utils.NewPolicyDefinitionWatcher(policyDir, policyLoader,
+			func(reloaded map[string]models.PolicyDefinition) {
+				markPolicyOwnership(reloaded, localPolicies)
+				policyValidator.ReplaceDefinitions(reloaded)
+				restTransformer.SetPolicyDefinitions(reloaded)
+				llmTransformer.SetPolicyDefinitions(reloaded)
+				apiServer.ReloadPolicyDefinitions(reloaded)
+			}, log)
+		var policyWatcherCtx context.Context
+		policyWatcherCtx, policyWatcherCancel = context.WithCancel(context.Background())
+		if err := policyWatcher.Start(policyWatcherCtx); err != nil {
+			log.Warn("Policy definitions hot reload is disabled", slog.Any("error", err))
+		}
+	}
+
 	// Load immutable gateway artifacts from the filesystem (no-op when immutable mode is disabled).
 	if err := igw.LoadArtifacts(log); err != nil {
 		log.Error("Failed to load immutable gateway artifacts", slog.Any("error", err))
@@ -674,6 +776,7 @@ func main() {
 	var controllerAdminServer *adminserver.Server
 	if cfg.Controller.AdminServer.Enabled {
 		controllerAdminServer = adminserver.NewServer(&cfg.Controller.AdminServer, apiServer, log)
+		controllerAdminServer.SetReadiness(readiness)
 		go func() {
 			if err := controllerAdminServer.Start(); err != nil {
 				log.Error("Controller admin server failed", slog.Any("error", err))
@@ -704,18 +807,27 @@ func main() {
 	}
 
 	// Start REST API server
-	log.Info("Starting REST API server", slog.Int("port", cfg.Controller.Server.APIPort))
+	if cfg.Controller.Server.APISocket != "" {
+		log.Info("Starting REST API server", slog.String("socket", cfg.Controller.Server.APISocket))
+	} else {
+		log.Info("Starting REST API server", slog.Int("port", cfg.Controller.Server.APIPort))
+	}
+
+	apiListener, cleanupAPIListener, err := listenAPI(cfg.Controller.Server, log)
+	if err != nil {
+		log.Error("Failed to start REST API server", slog.Any("error", err))
+		os.Exit(1)
+	}
 
 	// Setup graceful shutdown
 	srv := &http.Server{
-		Addr:              fmt.Sprintf(":%d", cfg.Controller.Server.APIPort),
 		Handler:           handler,
 		ReadHeaderTimeout: 30 * time.Second,
 	}
 
 	// Start server in a goroutine
 	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := srv.Serve(apiListener); err != nil && err != http.ErrServerClosed {
 			log.Error("Failed to start REST API server", slog.Any("error", err))
 			os.Exit(1)
 		}
@@ -748,6 +860,10 @@ func main() {
 
 	log.Info("Shutting down Gateway-Controller")
 
+	// Report not ready and refuse new xDS streams, then give routers time to
+	// move to another controller before anything stops.
+	drain(cfg.Controller.Server.DrainTimeout, quit, log, readiness.MarkDraining, xdsServer.StartDraining)
+
 	// Graceful shutdown with timeout
 	ctx, cancel = context.WithTimeout(context.Background(), cfg.Controller.Server.ShutdownTimeout)
 	defer cancel()
@@ -765,15 +881,37 @@ func main() {
 		}
 	}
 
+	if policyWatcherCancel != nil {
+		policyWatcherCancel()
+	}
+
 	// Stop control plane client
 	cpClient.Stop()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Error("Server forced to shutdown", slog.Any("error", err))
 	}
+	cleanupAPIListener()
 
 	xdsServer.Stop()
 
+	if acmeManager != nil {
+		if err := acmeManager.Stop(ctx); err != nil {
+			log.Warn("Failed to stop ACME certificate manager cleanly", slog.Any("error", err))
+		}
+	}
+
+	if certificateMonitor != nil {
+		certificateMonitor.Stop()
+	}
+
+	if apiKeyExpirySweeper != nil {
+		apiKeyExpirySweeper.Stop()
+	}
+
+	// Stopped after the sweeper so its last revocations are still delivered
+	apiKeyWebhook.Stop()
+
 	// Stop policy xDS server if it was started
 	if policyXDSServer != nil {
 		policyXDSServer.Stop()
@@ -799,6 +937,18 @@ func main() {
 	log.Info("Gateway-Controller stopped")
 }
 
+// markPolicyOwnership records whether each policy definition ships with the gateway
+// ("wso2") or was added through the build manifest ("organization").
+func markPolicyOwnership(policyDefinitions map[string]models.PolicyDefinition, localPolicies map[string]bool) {
+	for key, def := range policyDefinitions {
+		def.ManagedBy = "wso2"
+		if localPolicies[def.Name+"|"+def.Version] {
+			def.ManagedBy = "organization"
+		}
+		policyDefinitions[key] = def
+	}
+}
+
 func generateAuthConfig(config *config.Config) commonmodels.AuthConfig {
 	// prefixed builds a resource key of the form "<METHOD> <managementAPIBasePath><path>"
 	// matching the actual routes registered via RegisterHandlersWithOptions(BaseURL=managementAPIBasePath).
@@ -816,6 +966,8 @@ func generateAuthConfig(config *config.Config) commonmodels.AuthConfig {
 		"GET /rest-apis/{id}":     {"admin", "developer"},
 		"PUT /rest-apis/{id}":     {"admin", "developer"},
 		"DELETE /rest-apis/{id}":  {"admin", "developer"},
+		"POST /rest-apis/import/openapi": {"admin", "developer"},
+		"GET /rest-apis/{id}/openapi":    {"admin", "developer"},
 
 		"GET /certificates":          {"admin", "developer"},
 		"POST /certificates":         {"admin", "developer"},
@@ -849,6 +1001,7 @@ func generateAuthConfig(config *config.Config) commonmodels.AuthConfig {
 		"DELETE /llm-proxies/{id}":  {"admin", "developer"},
 
 		"POST /rest-apis/{id}/api-keys":                          {"admin", "consumer"},
+		"POST /rest-apis/{id}/api-keys/bulk":                     {"admin", "consumer"},
 		"GET /rest-apis/{id}/api-keys":                           {"admin", "consumer"},
 		"PUT /rest-apis/{id}/api-keys/{apiKeyName}":              {"admin", "consumer"},
 		"POST /rest-apis/{id}/api-keys/{apiKeyName}/regenerate":  {"admin", "consumer"},
@@ -910,19 +1063,41 @@ func generateAuthConfig(config *config.Config) commonmodels.AuthConfig {
 		basicAuth = commonmodels.BasicAuth{Enabled: true, Users: users}
 	}
 	if config.Controller.Auth.IDP.Enabled {
-		idpAuth = commonmodels.IDPConfig{Enabled: true, IssuerURL: config.Controller.Auth.IDP.Issuer,
-			JWKSUrl:           config.Controller.Auth.IDP.JWKSURL,
-			ScopeClaim:        config.Controller.Auth.IDP.RolesClaim,
-			PermissionMapping: &config.Controller.Auth.IDP.RoleMapping,
+		idpAuth = toCommonIDPConfig(config.Controller.Auth.IDP)
+	}
+	var additionalIDPs []commonmodels.IDPConfig
+	for _, idp := range config.Controller.Auth.IDPs {
+		if idp.Enabled {
+			additionalIDPs = append(additionalIDPs, toCommonIDPConfig(idp))
 		}
 	}
 	authConfig := commonmodels.AuthConfig{BasicAuth: &basicAuth,
 		JWTConfig:     &idpAuth,
+		JWTConfigs:    additionalIDPs,
 		ResourceRoles: DefaultResourceRoles,
 	}
 	return authConfig
 }
 
+// toCommonIDPConfig converts an enabled identity provider of the controller
+// configuration to the configuration of the JWT authenticator.
+func toCommonIDPConfig(idp config.IDPConfig) commonmodels.IDPConfig {
+	return commonmodels.IDPConfig{
+		Enabled:             true,
+		IssuerURL:           idp.Issuer,
+		JWKSUrl:             idp.JWKSURL,
+		ScopeClaim:          idp.RolesClaim,
+		ScopeClaims:         idp.RolesClaims,
+		PermissionMapping:   &idp.RoleMapping,
+		JWKSRefreshInterval: idp.JWKSRefreshInterval,
+		JWKSFetchTimeout:    idp.JWKSFetchTimeout,
+		JWKSCacheTTL:        idp.JWKSCacheTTL,
+		OnJWKSFetchError: func(error) {
+			metrics.JWKSFetchFailuresTotal.Inc()
+		},
+	}
+}
+
 // deprecatedManagementPathMiddleware marks responses served on the legacy
 // unprefixed management API paths as deprecated, following RFC 8594. Adds:
 //   - `Deprecation: true`
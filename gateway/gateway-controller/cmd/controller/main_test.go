@@ -643,6 +643,30 @@ func TestGenerateAuthConfig(t *testing.T) {
 		assert.NotNil(t, authConfig.JWTConfig.PermissionMapping)
 	})
 
+	t.Run("Additional IDPs enabled", func(t *testing.T) {
+		cfg := &config.Config{
+			Controller: config.Controller{
+				Auth: config.AuthConfig{
+					IDP: config.IDPConfig{Enabled: true, Issuer: "https://first.example.com", JWKSURL: "https://first.example.com/jwks"},
+					IDPs: []config.IDPConfig{
+						{Enabled: true, Issuer: "https://second.example.com", JWKSURL: "https://second.example.com/jwks", RolesClaim: "groups", RolesClaims: []string{"realm_access.roles"}},
+						{Enabled: false, Issuer: "https://disabled.example.com", JWKSURL: "https://disabled.example.com/jwks"},
+					},
+				},
+			},
+		}
+
+		authConfig := generateAuthConfig(cfg)
+
+		assert.Equal(t, "https://first.example.com", authConfig.JWTConfig.IssuerURL)
+		require.Len(t, authConfig.JWTConfigs, 1)
+		assert.True(t, authConfig.JWTConfigs[0].Enabled)
+		assert.Equal(t, "https://second.example.com", authConfig.JWTConfigs[0].IssuerURL)
+		assert.Equal(t, "https://second.example.com/jwks", authConfig.JWTConfigs[0].JWKSUrl)
+		assert.Equal(t, "groups", authConfig.JWTConfigs[0].ScopeClaim)
+		assert.Equal(t, []string{"realm_access.roles"}, authConfig.JWTConfigs[0].ScopeClaims)
+	})
+
 	t.Run("Both basic and IDP auth enabled", func(t *testing.T) {
 		cfg := &config.Config{
 			Controller: config.Controller{
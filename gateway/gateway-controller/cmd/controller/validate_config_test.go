@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/common/configcheck"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestRunValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		errMsg   string
+	}{
+		{
+			name: "valid config",
+			contents: `
+[controller.logging]
+level = "info"
+`,
+		},
+		{
+			name: "malformed toml",
+			contents: `
+[controller.logging
+level = "info"
+`,
+			errMsg: "failed to load config file",
+		},
+		{
+			name: "unknown storage type",
+			contents: `
+[controller.storage]
+type = "mysql"
+`,
+			errMsg: "storage.type must be one of",
+		},
+		{
+			name: "sqlite without a path",
+			contents: `
+[controller.storage]
+type = "sqlite"
+
+[controller.storage.sqlite]
+path = ""
+`,
+			errMsg: "storage.sqlite.path is required",
+		},
+		{
+			name: "policy server TLS without a key",
+			contents: `
+[controller.policy_server.tls]
+enabled = true
+key_file = ""
+`,
+			errMsg: "policy_server.tls.key_file is required",
+		},
+		{
+			name: "HTTPS without a certificate",
+			contents: `
+[router]
+https_enabled = true
+
+[router.downstream_tls]
+cert_path = ""
+`,
+			errMsg: "router.downstream_tls.cert_path is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfig(t, tt.contents)
+
+			var out bytes.Buffer
+			code := runValidateConfig(&out, path)
+
+			var report configcheck.Report
+			require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+			assert.Equal(t, path, report.Config)
+			if tt.errMsg == "" {
+				assert.Equal(t, 0, code)
+				assert.True(t, report.Valid)
+				assert.Empty(t, report.Errors)
+				return
+			}
+			assert.Equal(t, 1, code)
+			assert.False(t, report.Valid)
+			require.Len(t, report.Errors, 1)
+			assert.Contains(t, report.Errors[0], tt.errMsg)
+		})
+	}
+}
+
+func TestRunValidateConfig_MissingFile(t *testing.T) {
+	var out bytes.Buffer
+	code := runValidateConfig(&out, filepath.Join(t.TempDir(), "missing.toml"))
+
+	assert.Equal(t, 1, code)
+	var report configcheck.Report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	assert.False(t, report.Valid)
+}
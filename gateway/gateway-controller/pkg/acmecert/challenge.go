@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package acmecert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// ChallengeHTTP01 answers challenges over HTTP on port 80 of every domain
+	ChallengeHTTP01 = "http-01"
+	// ChallengeDNS01 answers challenges with an _acme-challenge TXT record
+	ChallengeDNS01 = "dns-01"
+
+	http01PathPrefix = "/.well-known/acme-challenge/"
+	dns01HookTimeout = 2 * time.Minute
+)
+
+// challengeSolver publishes and withdraws the proof for a single ACME challenge.
+type challengeSolver interface {
+	present(ctx context.Context, client *acme.Client, domain string, chal *acme.Challenge) error
+	cleanUp(ctx context.Context, client *acme.Client, domain string, chal *acme.Challenge) error
+}
+
+// http01Solver serves HTTP-01 key authorizations from memory. The router (or an
+// external load balancer) must forward /.well-known/acme-challenge/ requests for
+// every domain to its listen address.
+type http01Solver struct {
+	logger *slog.Logger
+	server *http.Server
+
+	mu     sync.RWMutex
+	tokens map[string]string
+}
+
+func newHTTP01Solver(listenAddress string, logger *slog.Logger) *http01Solver {
+	s := &http01Solver{
+		logger: logger,
+		tokens: make(map[string]string),
+	}
+	s.server = &http.Server{
+		Addr:              listenAddress,
+		Handler:           s,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		MaxHeaderBytes:    8 << 10,
+	}
+	return s
+}
+
+// start serves challenge responses until stop is called.
+func (s *http01Solver) start() {
+	go func() {
+		s.logger.Info("Starting ACME HTTP-01 challenge server", slog.String("address", s.server.Addr))
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("ACME HTTP-01 challenge server failed", slog.Any("error", err))
+		}
+	}()
+}
+
+func (s *http01Solver) stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// ServeHTTP answers GET requests for pending challenge tokens and 404s everything else.
+func (s *http01Solver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, http01PathPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+	token := strings.TrimPrefix(r.URL.Path, http01PathPrefix)
+
+	s.mu.RLock()
+	keyAuth, ok := s.tokens[token]
+	s.mu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(keyAuth))
+}
+
+func (s *http01Solver) present(_ context.Context, client *acme.Client, _ string, chal *acme.Challenge) error {
+	keyAuth, err := client.HTTP01ChallengeResponse(chal.Token)
+	if err != nil {
+		return fmt.Errorf("failed to compute HTTP-01 response: %w", err)
+	}
+	s.mu.Lock()
+	s.tokens[chal.Token] = keyAuth
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *http01Solver) cleanUp(_ context.Context, _ *acme.Client, _ string, chal *acme.Challenge) error {
+	s.mu.Lock()
+	delete(s.tokens, chal.Token)
+	s.mu.Unlock()
+	return nil
+}
+
+// dns01Solver delegates TXT record management to an operator supplied hook,
+// invoked as "<hook> present|cleanup <fqdn> <value>".
+type dns01Solver struct {
+	hook string
+}
+
+func (s *dns01Solver) present(ctx context.Context, client *acme.Client, domain string, chal *acme.Challenge) error {
+	return s.run(ctx, client, "present", domain, chal)
+}
+
+func (s *dns01Solver) cleanUp(ctx context.Context, client *acme.Client, domain string, chal *acme.Challenge) error {
+	return s.run(ctx, client, "cleanup", domain, chal)
+}
+
+func (s *dns01Solver) run(ctx context.Context, client *acme.Client, action, domain string, chal *acme.Challenge) error {
+	value, err := client.DNS01ChallengeRecord(chal.Token)
+	if err != nil {
+		return fmt.Errorf("failed to compute DNS-01 record: %w", err)
+	}
+	fqdn := "_acme-challenge." + strings.TrimPrefix(domain, "*.")
+
+	ctx, cancel := context.WithTimeout(ctx, dns01HookTimeout)
+	defer cancel()
+	if err := exec.CommandContext(ctx, s.hook, action, fqdn, value).Run(); err != nil {
+		return fmt.Errorf("DNS-01 hook %s failed for %s: %w", action, fqdn, err)
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package acmecert obtains and renews the router's HTTPS listener certificate
+// from an ACME certificate authority such as Let's Encrypt.
+package acmecert
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+const (
+	// issueTimeout bounds a single order, including challenge validation
+	issueTimeout = 10 * time.Minute
+	// maxRetryInterval caps the wait before retrying a failed issuance
+	maxRetryInterval = time.Hour
+)
+
+// CertificateSink receives certificates issued by the Manager.
+// *xds.SDSSecretManager satisfies this interface.
+type CertificateSink interface {
+	SetDownstreamCertificate(certPEM, keyPEM []byte) error
+}
+
+// Manager keeps the HTTPS listener certificate issued and renewed. Each new
+// certificate is persisted, handed to the sink and followed by a call to
+// refresh so the router picks it up without a restart.
+type Manager struct {
+	cfg     config.ACMEConfig
+	sink    CertificateSink
+	refresh func(ctx context.Context) error
+	logger  *slog.Logger
+	store   *fileStore
+	solver  challengeSolver
+	http01  *http01Solver
+
+	mu      sync.RWMutex
+	current *x509.Certificate
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager creates a Manager for the given configuration.
+func NewManager(cfg config.ACMEConfig, sink CertificateSink, refresh func(ctx context.Context) error, logger *slog.Logger) (*Manager, error) {
+	store, err := newFileStore(cfg.StorageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{
+		cfg:     cfg,
+		sink:    sink,
+		refresh: refresh,
+		logger:  logger.With(slog.String("component", "acme")),
+		store:   store,
+	}
+	switch cfg.ChallengeType {
+	case ChallengeHTTP01:
+		m.http01 = newHTTP01Solver(cfg.HTTP01ListenAddress, m.logger)
+		m.solver = m.http01
+	case ChallengeDNS01:
+		m.solver = &dns01Solver{hook: cfg.DNS01Hook}
+	default:
+		return nil, fmt.Errorf("unsupported ACME challenge type: %s", cfg.ChallengeType)
+	}
+	return m, nil
+}
+
+// Start installs any previously issued certificate and starts the renewal loop.
+// The stored certificate is handed to the sink synchronously so the first xDS
+// snapshot already references it.
+func (m *Manager) Start() error {
+	certPEM, keyPEM, leaf, err := m.store.loadCertificate()
+	switch {
+	case err == nil:
+		if err := m.sink.SetDownstreamCertificate(certPEM, keyPEM); err != nil {
+			return fmt.Errorf("failed to install stored ACME certificate: %w", err)
+		}
+		m.setCurrent(leaf)
+		m.logger.Info("Loaded stored ACME certificate",
+			slog.Any("domains", leaf.DNSNames),
+			slog.Time("not_after", leaf.NotAfter))
+	case errors.Is(err, os.ErrNotExist):
+		m.logger.Info("No stored ACME certificate, one will be requested")
+	default:
+		return err
+	}
+
+	if m.http01 != nil {
+		m.http01.start()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	go m.run(ctx)
+	return nil
+}
+
+// Stop stops the renewal loop and the HTTP-01 challenge server.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel != nil {
+		m.cancel()
+		<-m.done
+	}
+	if m.http01 != nil {
+		return m.http01.stop(ctx)
+	}
+	return nil
+}
+
+// Current returns the certificate currently served, or nil before the first issuance.
+func (m *Manager) Current() *x509.Certificate {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.current
+}
+
+func (m *Manager) setCurrent(cert *x509.Certificate) {
+	m.mu.Lock()
+	m.current = cert
+	m.mu.Unlock()
+}
+
+func (m *Manager) run(ctx context.Context) {
+	defer close(m.done)
+
+	retryInterval := min(m.cfg.CheckInterval, maxRetryInterval)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		next := m.cfg.CheckInterval
+		if err := m.reconcile(ctx); err != nil {
+			m.logger.Error("Failed to obtain ACME certificate",
+				slog.Duration("retry_in", retryInterval),
+				slog.Any("error", err))
+			next = retryInterval
+		}
+		timer.Reset(next)
+	}
+}
+
+// reconcile requests a new certificate when none is served or the current one
+// is due for renewal.
+func (m *Manager) reconcile(ctx context.Context) error {
+	if !needsRenewal(m.Current(), m.cfg.Domains, m.cfg.RenewBefore, time.Now()) {
+		return nil
+	}
+
+	m.logger.Info("Requesting ACME certificate", slog.Any("domains", m.cfg.Domains))
+	issueCtx, cancel := context.WithTimeout(ctx, issueTimeout)
+	defer cancel()
+
+	certPEM, keyPEM, leaf, err := m.issue(issueCtx)
+	if err != nil {
+		return err
+	}
+	if err := m.store.saveCertificate(certPEM, keyPEM); err != nil {
+		return err
+	}
+	if err := m.sink.SetDownstreamCertificate(certPEM, keyPEM); err != nil {
+		return err
+	}
+	m.setCurrent(leaf)
+	m.logger.Info("ACME certificate issued",
+		slog.Any("domains", leaf.DNSNames),
+		slog.Time("not_after", leaf.NotAfter))
+
+	if m.refresh != nil {
+		if err := m.refresh(ctx); err != nil {
+			return fmt.Errorf("certificate issued but failed to push it to the router: %w", err)
+		}
+	}
+	return nil
+}
+
+// needsRenewal reports whether cert is missing, does not cover every domain, or
+// expires within the renewal window. The window is capped at a third of the
+// certificate lifetime so short-lived certificates are not renewed continuously.
+func needsRenewal(cert *x509.Certificate, domains []string, renewBefore time.Duration, now time.Time) bool {
+	if cert == nil {
+		return true
+	}
+	for _, domain := range domains {
+		if !slices.Contains(cert.DNSNames, domain) {
+			return true
+		}
+	}
+	window := min(renewBefore, cert.NotAfter.Sub(cert.NotBefore)/3)
+	return !now.Before(cert.NotAfter.Add(-window))
+}
+
+// issue runs a full ACME order and returns the PEM encoded chain, its private
+// key and the parsed leaf certificate.
+func (m *Manager) issue(ctx context.Context) ([]byte, []byte, *x509.Certificate, error) {
+	accountKey, err := m.store.loadOrCreateAccountKey()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	client := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: m.cfg.DirectoryURL,
+		UserAgent:    "wso2-api-platform-gateway-controller",
+	}
+
+	account := &acme.Account{}
+	if m.cfg.Email != "" {
+		account.Contact = []string{"mailto:" + m.cfg.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, nil, nil, fmt.Errorf("failed to register ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(m.cfg.Domains...))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create ACME order: %w", err)
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err := m.authorize(ctx, client, authzURL); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if order, err = client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, nil, nil, fmt.Errorf("ACME order did not become ready: %w", err)
+	}
+
+	// TODO(pqc): migrate — public CAs do not issue post-quantum TLS certificates yet.
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate certificate key: %w", err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: m.cfg.Domains[0]},
+		DNSNames: m.cfg.Domains,
+	}, certKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to finalize ACME order: %w", err)
+	}
+	if len(chain) == 0 {
+		return nil, nil, nil, fmt.Errorf("ACME server returned an empty certificate chain")
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+
+	var certPEM []byte
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM, err := encodeECPrivateKey(certKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return certPEM, keyPEM, leaf, nil
+}
+
+// authorize completes the configured challenge for a single authorization.
+func (m *Manager) authorize(ctx context.Context, client *acme.Client, authzURL string) error {
+	authz, err := client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch ACME authorization: %w", err)
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var chal *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == m.cfg.ChallengeType {
+			chal = c
+			break
+		}
+	}
+	if chal == nil {
+		return fmt.Errorf("ACME server offered no %s challenge for %s", m.cfg.ChallengeType, authz.Identifier.Value)
+	}
+
+	domain := authz.Identifier.Value
+	if err := m.solver.present(ctx, client, domain, chal); err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.solver.cleanUp(context.WithoutCancel(ctx), client, domain, chal); err != nil {
+			m.logger.Warn("Failed to clean up ACME challenge",
+				slog.String("domain", domain),
+				slog.Any("error", err))
+		}
+	}()
+
+	if _, err := client.Accept(ctx, chal); err != nil {
+		return fmt.Errorf("failed to accept %s challenge for %s: %w", chal.Type, domain, err)
+	}
+	if _, err := client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("%s challenge for %s failed: %w", chal.Type, domain, err)
+	}
+	return nil
+}
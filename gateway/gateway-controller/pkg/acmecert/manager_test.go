@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package acmecert
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+type recordingSink struct {
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func (s *recordingSink) SetDownstreamCertificate(certPEM, keyPEM []byte) error {
+	s.certPEM = certPEM
+	s.keyPEM = keyPEM
+	return nil
+}
+
+func testLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func testACMEConfig(dir string) config.ACMEConfig {
+	return config.ACMEConfig{
+		Enabled:             true,
+		DirectoryURL:        "https://acme.example.com/directory",
+		Domains:             []string{"api.example.com"},
+		ChallengeType:       ChallengeHTTP01,
+		StorageDir:          dir,
+		RenewBefore:         30 * 24 * time.Hour,
+		CheckInterval:       12 * time.Hour,
+		HTTP01ListenAddress: "127.0.0.1:0",
+	}
+}
+
+// issueTestCertificate returns a PEM encoded self-signed certificate and key.
+func issueTestCertificate(t *testing.T, domains []string, notBefore, notAfter time.Time) ([]byte, []byte) {
+	t.Helper()
+	// TODO(pqc): migrate — test fixture mirroring the ECDSA certificates issued over ACME.
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		DNSNames:     domains,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyPEM, err := encodeECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	domains := []string{"api.example.com"}
+	cert := func(dnsNames []string, lifetime, remaining time.Duration) *x509.Certificate {
+		notAfter := now.Add(remaining)
+		return &x509.Certificate{DNSNames: dnsNames, NotBefore: notAfter.Add(-lifetime), NotAfter: notAfter}
+	}
+
+	tests := []struct {
+		name string
+		cert *x509.Certificate
+		want bool
+	}{
+		{name: "no certificate", cert: nil, want: true},
+		{name: "fresh certificate", cert: cert(domains, 90*24*time.Hour, 80*24*time.Hour), want: false},
+		{name: "inside renewal window", cert: cert(domains, 90*24*time.Hour, 20*24*time.Hour), want: true},
+		{name: "expired", cert: cert(domains, 90*24*time.Hour, -time.Hour), want: true},
+		{name: "missing domain", cert: cert([]string{"other.example.com"}, 90*24*time.Hour, 80*24*time.Hour), want: true},
+		{name: "short-lived certificate uses a third of its lifetime", cert: cert(domains, 6*24*time.Hour, 3*24*time.Hour), want: false},
+		{name: "short-lived certificate near expiry", cert: cert(domains, 6*24*time.Hour, 24*time.Hour), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, needsRenewal(tt.cert, domains, 30*24*time.Hour, now))
+		})
+	}
+}
+
+func TestFileStore_AccountKeyRoundTrip(t *testing.T) {
+	store, err := newFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	first, err := store.loadOrCreateAccountKey()
+	require.NoError(t, err)
+	second, err := store.loadOrCreateAccountKey()
+	require.NoError(t, err)
+	assert.True(t, first.Public().(*ecdsa.PublicKey).Equal(second.Public()), "account key must be reused")
+
+	info, err := os.Stat(filepath.Join(store.dir, accountKeyFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestFileStore_RejectsPermissiveKeyFile(t *testing.T) {
+	store, err := newFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	now := time.Now()
+	certPEM, keyPEM := issueTestCertificate(t, []string{"api.example.com"}, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, store.saveCertificate(certPEM, keyPEM))
+	require.NoError(t, os.Chmod(filepath.Join(store.dir, privateKeyFile), 0o644))
+
+	_, _, _, err = store.loadCertificate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permissions")
+}
+
+func TestManager_StartInstallsStoredCertificate(t *testing.T) {
+	dir := t.TempDir()
+	store, err := newFileStore(dir)
+	require.NoError(t, err)
+
+	now := time.Now()
+	certPEM, keyPEM := issueTestCertificate(t, []string{"api.example.com"}, now.Add(-time.Hour), now.Add(60*24*time.Hour))
+	require.NoError(t, store.saveCertificate(certPEM, keyPEM))
+
+	sink := &recordingSink{}
+	manager, err := NewManager(testACMEConfig(dir), sink, nil, testLogger())
+	require.NoError(t, err)
+	require.NoError(t, manager.Start())
+	defer func() {
+		assert.NoError(t, manager.Stop(context.Background()))
+	}()
+
+	assert.Equal(t, certPEM, sink.certPEM)
+	assert.Equal(t, keyPEM, sink.keyPEM)
+	require.NotNil(t, manager.Current())
+	assert.Equal(t, []string{"api.example.com"}, manager.Current().DNSNames)
+
+	// The stored certificate is still valid, so the renewal loop must not replace it
+	require.NoError(t, manager.reconcile(context.Background()))
+	assert.Equal(t, certPEM, sink.certPEM)
+}
+
+func TestHTTP01Solver_ServeHTTP(t *testing.T) {
+	solver := newHTTP01Solver("127.0.0.1:0", testLogger())
+	accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader) // TODO(pqc): migrate
+	require.NoError(t, err)
+	client := &acme.Client{Key: accountKey}
+	chal := &acme.Challenge{Type: ChallengeHTTP01, Token: "token-123"}
+
+	require.NoError(t, solver.present(context.Background(), client, "api.example.com", chal))
+	expected, err := client.HTTP01ChallengeResponse(chal.Token)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	solver.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, http01PathPrefix+"token-123", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, expected, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	solver.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, http01PathPrefix+"unknown", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	solver.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, http01PathPrefix+"token-123", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.NoError(t, solver.cleanUp(context.Background(), client, "api.example.com", chal))
+	rec = httptest.NewRecorder()
+	solver.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, http01PathPrefix+"token-123", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
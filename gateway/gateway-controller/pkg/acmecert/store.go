@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package acmecert
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	accountKeyFile  = "account.key"
+	certificateFile = "certificate.pem"
+	privateKeyFile  = "certificate.key"
+)
+
+// fileStore persists the ACME account key and the issued certificate under a
+// single directory. Private keys are written with 0600 permissions and refused
+// on load when they are readable by anyone other than the owner.
+type fileStore struct {
+	dir string
+}
+
+func newFileStore(dir string) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create ACME storage directory: %w", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+// loadOrCreateAccountKey returns the stored ACME account key, generating and
+// persisting a new one on first use.
+func (s *fileStore) loadOrCreateAccountKey() (crypto.Signer, error) {
+	data, err := s.readPrivate(accountKeyFile)
+	if err == nil {
+		return parseECPrivateKey(data)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	// TODO(pqc): migrate — RFC 8555 defines no post-quantum JWS algorithm yet, so the
+	// account key must be one the ACME server accepts (ES256).
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ACME account key: %w", err)
+	}
+	keyPEM, err := encodeECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.write(accountKeyFile, keyPEM, 0o600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// loadCertificate returns the stored certificate chain, its private key and the
+// parsed leaf certificate. It returns an error wrapping os.ErrNotExist when no
+// certificate has been issued yet.
+func (s *fileStore) loadCertificate() ([]byte, []byte, *x509.Certificate, error) {
+	certPEM, err := os.ReadFile(filepath.Join(s.dir, certificateFile))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyPEM, err := s.readPrivate(privateKeyFile)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("stored ACME certificate is invalid: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse stored ACME certificate: %w", err)
+	}
+	return certPEM, keyPEM, leaf, nil
+}
+
+// saveCertificate persists a newly issued certificate chain and its private key.
+func (s *fileStore) saveCertificate(certPEM, keyPEM []byte) error {
+	if err := s.write(privateKeyFile, keyPEM, 0o600); err != nil {
+		return err
+	}
+	return s.write(certificateFile, certPEM, 0o644)
+}
+
+// readPrivate reads a private key file, failing when its permissions allow
+// access by group or others.
+func (s *fileStore) readPrivate(name string) ([]byte, error) {
+	path := filepath.Join(s.dir, name)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return nil, fmt.Errorf("ACME private key %s has permissions %04o, expected 0600", name, perm)
+	}
+	return os.ReadFile(path)
+}
+
+// write replaces a file atomically so a crash never leaves a truncated key or
+// certificate behind.
+func (s *fileStore) write(name string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(s.dir, name+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set permissions on %s: %w", name, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", name, err)
+	}
+	return nil
+}
+
+func encodeECPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func parseECPrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "EC PRIVATE KEY" {
+		return nil, fmt.Errorf("ACME account key is not a PEM encoded EC private key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ACME account key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,98 @@
+package adminserver
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	adminapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/admin"
+)
+
+// Readiness tracks the startup milestones the controller must reach before
+// it reports ready on GET /ready. It is safe for concurrent use.
+type Readiness struct {
+	storageLoaded atomic.Bool
+	draining      atomic.Bool
+
+	mu                  sync.RWMutex
+	snapshotReady       func() bool
+	controlPlane        func() bool
+	requireControlPlane bool
+}
+
+// NewReadiness creates a Readiness that reports not ready until storage is
+// marked loaded and the snapshot check registered with SetSnapshotCheck passes.
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// SetSnapshotCheck registers the check reporting whether the initial xDS
+// snapshot has been pushed.
+func (r *Readiness) SetSnapshotCheck(snapshotReady func() bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snapshotReady = snapshotReady
+}
+
+// MarkStorageLoaded records that configurations were loaded from the database.
+func (r *Readiness) MarkStorageLoaded() {
+	r.storageLoaded.Store(true)
+}
+
+// MarkDraining records that the controller is shutting down. From then on
+// it reports not ready, so load balancers stop routing to it.
+func (r *Readiness) MarkDraining() {
+	r.draining.Store(true)
+}
+
+// SetControlPlane registers the control plane connection check. The connection
+// state is always reported; it only gates readiness when required is true.
+func (r *Readiness) SetControlPlane(connected func() bool, required bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.controlPlane = connected
+	r.requireControlPlane = required
+}
+
+// Check evaluates every component and returns the response body along with
+// whether the controller is ready.
+func (r *Readiness) Check() (adminapi.ReadinessResponse, bool) {
+	r.mu.RLock()
+	snapshotReady := r.snapshotReady
+	controlPlane := r.controlPlane
+	requireControlPlane := r.requireControlPlane
+	r.mu.RUnlock()
+
+	storageLoaded := r.storageLoaded.Load()
+	draining := r.draining.Load()
+	xdsSnapshot := snapshotReady != nil && snapshotReady()
+	ready := storageLoaded && xdsSnapshot && !draining
+
+	resp := adminapi.ReadinessResponse{}
+	resp.Components = &struct {
+		ControlPlaneConnected *bool `json:"control_plane_connected,omitempty" yaml:"control_plane_connected,omitempty"`
+		Draining              *bool `json:"draining,omitempty" yaml:"draining,omitempty"`
+		StorageLoaded         *bool `json:"storage_loaded,omitempty" yaml:"storage_loaded,omitempty"`
+		XdsSnapshot           *bool `json:"xds_snapshot,omitempty" yaml:"xds_snapshot,omitempty"`
+	}{
+		Draining:      &draining,
+		StorageLoaded: &storageLoaded,
+		XdsSnapshot:   &xdsSnapshot,
+	}
+	if controlPlane != nil {
+		connected := controlPlane()
+		resp.Components.ControlPlaneConnected = &connected
+		if requireControlPlane {
+			ready = ready && connected
+		}
+	}
+
+	status := "not_ready"
+	if ready {
+		status = "ready"
+	}
+	timestamp := time.Now().UTC()
+	resp.Status = &status
+	resp.Timestamp = &timestamp
+	return resp, ready
+}
@@ -3,23 +3,35 @@ package adminserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
+	"strings"
 	"time"
 
 	adminapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/admin"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
 
 // AdminAPIBasePath is the URL prefix under which the gateway-controller admin API
 // is served. It must stay in sync with `servers.url` in api/admin-openapi.yaml.
 const AdminAPIBasePath = "/api/admin/v1"
 
+// eventStreamKeepAlive is how often an idle config event stream sends a
+// comment, so proxies and clients do not time the connection out.
+const eventStreamKeepAlive = 30 * time.Second
+
 type apiServer interface {
-	BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigDumpResponse, error)
+	BuildConfigDumpResponse(log *slog.Logger, params adminapi.GetConfigDumpParams) (*adminapi.ConfigDumpResponse, error)
+	BuildConfigDumpDiffResponse(sinceVersion int64) (*adminapi.ConfigDumpDiffResponse, error)
+	BuildConfigDumpDiffResponseSinceTime(since time.Time) (*adminapi.ConfigDumpDiffResponse, error)
+	SubscribeConfigChanges() (*storage.ChangeSubscription, error)
+	UnsubscribeConfigChanges(sub *storage.ChangeSubscription)
 	GetXDSSyncStatusResponse() adminapi.XDSSyncStatusResponse
 }
 
@@ -29,6 +41,7 @@ type Server struct {
 	apiServer apiServer
 	httpSrv   *http.Server
 	logger    *slog.Logger
+	readiness *Readiness
 }
 
 // NewServer creates a new admin HTTP server.
@@ -87,6 +100,12 @@ func NewServer(cfg *config.AdminServerConfig, apiServer apiServer, logger *slog.
 	return s
 }
 
+// SetReadiness sets the startup tracker consulted by GET /ready. Until it is
+// set, /ready reports not ready.
+func (s *Server) SetReadiness(readiness *Readiness) {
+	s.readiness = readiness
+}
+
 // Start starts the admin HTTP server in a blocking manner.
 func (s *Server) Start() error {
 	s.logger.Info("Starting controller admin HTTP server",
@@ -105,8 +124,12 @@ func (s *Server) Stop(ctx context.Context) error {
 }
 
 // GetConfigDump implements adminapi.ServerInterface.
-func (s *Server) GetConfigDump(w http.ResponseWriter, r *http.Request) {
-	resp, err := s.apiServer.BuildConfigDumpResponse(s.logger)
+func (s *Server) GetConfigDump(w http.ResponseWriter, r *http.Request, params adminapi.GetConfigDumpParams) {
+	if params.Status != nil && !isConfigDumpStatus(*params.Status) {
+		writeError(w, http.StatusBadRequest, "status must be one of pending, deployed, failed or undeployed")
+		return
+	}
+	resp, err := s.apiServer.BuildConfigDumpResponse(s.logger, params)
 	if err != nil {
 		http.Error(w, "Failed to retrieve configuration dump", http.StatusInternalServerError)
 		return
@@ -117,6 +140,96 @@ func (s *Server) GetConfigDump(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// GetConfigDumpDiff implements adminapi.ServerInterface. since is either a
+// resource version or an RFC 3339 timestamp.
+func (s *Server) GetConfigDumpDiff(w http.ResponseWriter, r *http.Request, params adminapi.GetConfigDumpDiffParams) {
+	var (
+		resp *adminapi.ConfigDumpDiffResponse
+		err  error
+	)
+	if version, parseErr := strconv.ParseInt(params.Since, 10, 64); parseErr == nil {
+		resp, err = s.apiServer.BuildConfigDumpDiffResponse(version)
+	} else if since, parseErr := time.Parse(time.RFC3339Nano, params.Since); parseErr == nil {
+		resp, err = s.apiServer.BuildConfigDumpDiffResponseSinceTime(since)
+	} else {
+		writeError(w, http.StatusBadRequest, "since must be a resource version or an RFC 3339 timestamp")
+		return
+	}
+	if errors.Is(err, storage.ErrChangesExpired) {
+		writeError(w, http.StatusGone, "Changes since the requested version are no longer available; fetch /config_dump instead")
+		return
+	}
+	if err != nil {
+		s.logger.Error("Failed to build configuration diff", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to retrieve configuration diff")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GetConfigDumpEvents implements adminapi.ServerInterface. It streams
+// configuration changes as server-sent events until the client disconnects.
+func (s *Server) GetConfigDumpEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+	sub, err := s.apiServer.SubscribeConfigChanges()
+	if errors.Is(err, storage.ErrTooManySubscribers) {
+		writeError(w, http.StatusServiceUnavailable, "Too many event stream clients are connected")
+		return
+	}
+	if err != nil {
+		s.logger.Error("Failed to subscribe to configuration changes", slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, "Failed to stream configuration changes")
+		return
+	}
+	defer s.apiServer.UnsubscribeConfigChanges(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	var reportedDrops int64
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case change, ok := <-sub.Changes():
+			if !ok {
+				return
+			}
+			if dropped := sub.Dropped(); dropped > reportedDrops {
+				reportedDrops = dropped
+				if _, err := fmt.Fprintf(w, "event: dropped\ndata: {\"dropped\":%d}\n\n", dropped); err != nil {
+					return
+				}
+			}
+			data, err := json.Marshal(adminapi.NewConfigChange(change))
+			if err != nil {
+				s.logger.Error("Failed to encode configuration change", slog.Any("error", err))
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: change\ndata: %s\n\n", change.Version, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
 // GetXDSSyncStatus implements adminapi.ServerInterface.
 func (s *Server) GetXDSSyncStatus(w http.ResponseWriter, r *http.Request) {
 	resp := s.apiServer.GetXDSSyncStatusResponse()
@@ -138,17 +251,59 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// GetReady implements adminapi.ServerInterface.
+func (s *Server) GetReady(w http.ResponseWriter, r *http.Request) {
+	var (
+		resp  adminapi.ReadinessResponse
+		ready bool
+	)
+	if s.readiness != nil {
+		resp, ready = s.readiness.Check()
+	} else {
+		status := "not_ready"
+		timestamp := time.Now().UTC()
+		resp = adminapi.ReadinessResponse{Status: &status, Timestamp: &timestamp}
+	}
+
+	code := http.StatusOK
+	if !ready {
+		code = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// isConfigDumpStatus reports whether status is one of the statuses reported
+// for APIs in a configuration dump.
+func isConfigDumpStatus(status string) bool {
+	switch adminapi.ConfigDumpAPIMetadataStatus(strings.ToLower(strings.TrimSpace(status))) {
+	case adminapi.Pending, adminapi.Deployed, adminapi.Failed, adminapi.Undeployed:
+		return true
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(adminapi.ErrorResponse{Status: "error", Message: message})
+}
+
 // createSelectiveIPWhitelistMiddleware creates a middleware that applies IP whitelist
-// to all endpoints except /health (which must be accessible for Docker/k8s health probes).
-// Both the versioned (AdminAPIBasePath+"/health") and the deprecated legacy
+// to all endpoints except /health and /ready (which must be accessible for Docker/k8s
+// probes). Both the versioned (AdminAPIBasePath+"/health") and the deprecated legacy
 // ("/health") variants are exempt while legacy support is retained.
 func createSelectiveIPWhitelistMiddleware(allowedIPs []string) adminapi.MiddlewareFunc {
 	healthPath := AdminAPIBasePath + "/health"
+	readyPath := AdminAPIBasePath + "/ready"
 	const legacyHealthPath = "/health"
+	const legacyReadyPath = "/ready"
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Skip IP whitelist for health endpoints (versioned and legacy).
-			if r.URL.Path == healthPath || r.URL.Path == legacyHealthPath {
+			// Skip IP whitelist for probe endpoints (versioned and legacy).
+			if r.URL.Path == healthPath || r.URL.Path == legacyHealthPath ||
+				r.URL.Path == readyPath || r.URL.Path == legacyReadyPath {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -1,31 +1,69 @@
 package adminserver
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	adminapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/admin"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
 
 type stubAPIServer struct {
-	configDump  adminapi.ConfigDumpResponse
-	configErr   error
-	xdsResponse adminapi.XDSSyncStatusResponse
+	configDump   adminapi.ConfigDumpResponse
+	configErr    error
+	configParams adminapi.GetConfigDumpParams
+	xdsResponse  adminapi.XDSSyncStatusResponse
+
+	diffErr       error
+	diffSinceTime time.Time
+
+	// changes backs the config event stream.
+	changes *storage.ConfigStore
 }
 
-func (s *stubAPIServer) BuildConfigDumpResponse(_ *slog.Logger) (*adminapi.ConfigDumpResponse, error) {
+func (s *stubAPIServer) BuildConfigDumpResponse(_ *slog.Logger, params adminapi.GetConfigDumpParams) (*adminapi.ConfigDumpResponse, error) {
+	s.configParams = params
 	if s.configErr != nil {
 		return nil, s.configErr
 	}
 	return &s.configDump, nil
 }
 
+func (s *stubAPIServer) BuildConfigDumpDiffResponse(sinceVersion int64) (*adminapi.ConfigDumpDiffResponse, error) {
+	if s.diffErr != nil {
+		return nil, s.diffErr
+	}
+	return &adminapi.ConfigDumpDiffResponse{Status: "success", Since: sinceVersion}, nil
+}
+
+func (s *stubAPIServer) BuildConfigDumpDiffResponseSinceTime(since time.Time) (*adminapi.ConfigDumpDiffResponse, error) {
+	s.diffSinceTime = since
+	return s.BuildConfigDumpDiffResponse(0)
+}
+
+func (s *stubAPIServer) SubscribeConfigChanges() (*storage.ChangeSubscription, error) {
+	if s.changes == nil {
+		return nil, storage.ErrTooManySubscribers
+	}
+	return s.changes.SubscribeChanges()
+}
+
+func (s *stubAPIServer) UnsubscribeConfigChanges(sub *storage.ChangeSubscription) {
+	s.changes.UnsubscribeChanges(sub)
+}
+
 func (s *stubAPIServer) GetXDSSyncStatusResponse() adminapi.XDSSyncStatusResponse {
 	return s.xdsResponse
 }
@@ -50,6 +88,27 @@ func TestAdminServer_ConfigDumpHandler(t *testing.T) {
 	assert.Equal(t, "ok", *body.Status)
 }
 
+func TestAdminServer_ConfigDumpHandler_Filters(t *testing.T) {
+	stub := &stubAPIServer{}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump?kind=LlmProvider&status=failed", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.NotNil(t, stub.configParams.Kind)
+	require.NotNil(t, stub.configParams.Status)
+	assert.Equal(t, "LlmProvider", *stub.configParams.Kind)
+	assert.Equal(t, "failed", *stub.configParams.Status)
+
+	req = httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump?status=broken", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr = httptest.NewRecorder()
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
 func TestAdminServer_XDSSyncStatusHandler(t *testing.T) {
 	component := "gateway-controller"
 	version := "12"
@@ -142,6 +201,79 @@ func TestAdminServer_HealthHandler_NoIPWhitelist(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestAdminServer_ReadyHandler(t *testing.T) {
+	stub := &stubAPIServer{}
+	// Restrict IPs to only 127.0.0.1 — readiness probes must still get through
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, slog.Default())
+
+	readiness := NewReadiness()
+	snapshotPushed := false
+	readiness.SetSnapshotCheck(func() bool { return snapshotPushed })
+	s.SetReadiness(readiness)
+
+	probe := func() (int, adminapi.ReadinessResponse) {
+		req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/ready", nil)
+		req.RemoteAddr = "192.168.1.10:12345"
+		rr := httptest.NewRecorder()
+		s.httpSrv.Handler.ServeHTTP(rr, req)
+
+		var body adminapi.ReadinessResponse
+		assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+		return rr.Code, body
+	}
+
+	code, body := probe()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not_ready", *body.Status)
+	assert.False(t, *body.Components.StorageLoaded)
+	assert.False(t, *body.Components.XdsSnapshot)
+	assert.Nil(t, body.Components.ControlPlaneConnected)
+
+	readiness.MarkStorageLoaded()
+	code, body = probe()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.True(t, *body.Components.StorageLoaded)
+
+	snapshotPushed = true
+	code, body = probe()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ready", *body.Status)
+	assert.True(t, *body.Components.XdsSnapshot)
+
+	// A disconnected control plane is reported but only gates readiness when required.
+	readiness.SetControlPlane(func() bool { return false }, false)
+	code, body = probe()
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, *body.Components.ControlPlaneConnected)
+
+	readiness.SetControlPlane(func() bool { return false }, true)
+	code, _ = probe()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	readiness.SetControlPlane(func() bool { return true }, true)
+	code, body = probe()
+	assert.Equal(t, http.StatusOK, code)
+	assert.False(t, *body.Components.Draining)
+
+	readiness.MarkDraining()
+	code, body = probe()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not_ready", *body.Status)
+	assert.True(t, *body.Components.Draining)
+}
+
+func TestAdminServer_ReadyHandler_NoReadinessConfigured(t *testing.T) {
+	stub := &stubAPIServer{}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/ready", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
+
 func TestIsIPAllowed(t *testing.T) {
 	assert.True(t, isIPAllowed("127.0.0.1", []string{"*"}))
 	assert.True(t, isIPAllowed("127.0.0.1", []string{"0.0.0.0/0"}))
@@ -223,3 +355,135 @@ func TestAdminServer_VersionedPathsHaveNoDeprecationHeader(t *testing.T) {
 	assert.Empty(t, rr.Header().Get("Deprecation"))
 	assert.Empty(t, rr.Header().Get("Link"))
 }
+
+func TestAdminServer_ConfigDumpDiffHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		since    string
+		diffErr  error
+		wantCode int
+	}{
+		{name: "resource version", since: "42", wantCode: http.StatusOK},
+		{name: "timestamp", since: "2026-10-17T12:00:00Z", wantCode: http.StatusOK},
+		{name: "invalid since", since: "yesterday", wantCode: http.StatusBadRequest},
+		{name: "missing since", since: "", wantCode: http.StatusBadRequest},
+		{name: "expired", since: "1", diffErr: storage.ErrChangesExpired, wantCode: http.StatusGone},
+		{name: "internal error", since: "1", diffErr: errors.New("store: boom"), wantCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubAPIServer{diffErr: tt.diffErr}
+			s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+
+			req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump/diff?since="+url.QueryEscape(tt.since), nil)
+			req.RemoteAddr = "127.0.0.1:12345"
+			rr := httptest.NewRecorder()
+
+			s.httpSrv.Handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantCode, rr.Code)
+			assert.NotContains(t, rr.Body.String(), "boom")
+		})
+	}
+}
+
+func TestAdminServer_ConfigDumpDiffHandler_Since(t *testing.T) {
+	stub := &stubAPIServer{}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump/diff?since=42", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+
+	var body adminapi.ConfigDumpDiffResponse
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.Equal(t, int64(42), body.Since)
+
+	req = httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump/diff?since="+url.QueryEscape("2026-10-17T12:00:00+05:30"), nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	s.httpSrv.Handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.True(t, stub.diffSinceTime.Equal(time.Date(2026, time.October, 17, 6, 30, 0, 0, time.UTC)))
+}
+
+// readEvent reads one server-sent event, skipping comments, and returns its
+// fields.
+func readEvent(t *testing.T, r *bufio.Reader) map[string]string {
+	t.Helper()
+	fields := map[string]string{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading event stream: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			if len(fields) > 0 {
+				return fields
+			}
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] = value
+	}
+}
+
+func TestAdminServer_ConfigDumpEvents(t *testing.T) {
+	store := storage.NewConfigStore()
+	stub := &stubAPIServer{changes: store}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	srv := httptest.NewServer(s.httpSrv.Handler)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + AdminAPIBasePath + "/config_dump/events")
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// The subscription exists once the headers have been sent.
+	deployed := &models.StoredConfig{UUID: "api-1", Kind: "RestApi", Handle: "petstore", DisplayName: "Petstore", Version: "v1"}
+	if err := store.AddWithCorrelationID(deployed, "corr-deploy"); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	event := readEvent(t, bufio.NewReader(resp.Body))
+	assert.Equal(t, "change", event["event"])
+	assert.Equal(t, "1", event["id"])
+
+	var change adminapi.ConfigChange
+	assert.NoError(t, json.Unmarshal([]byte(event["data"]), &change))
+	assert.Equal(t, adminapi.ConfigChangeResource("api"), change.Resource)
+	assert.Equal(t, adminapi.ConfigChangeAction("added"), change.Action)
+	assert.Equal(t, "api-1", change.Id)
+	if assert.NotNil(t, change.CorrelationId) {
+		assert.Equal(t, "corr-deploy", *change.CorrelationId)
+	}
+}
+
+func TestAdminServer_ConfigDumpEvents_TooManyClients(t *testing.T) {
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, &stubAPIServer{}, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump/events", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
+
+func TestAdminServer_ConfigDumpEvents_IPAllowlist(t *testing.T) {
+	stub := &stubAPIServer{changes: storage.NewConfigStore()}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump/events", nil)
+	req.RemoteAddr = "192.168.1.10:12345"
+	rr := httptest.NewRecorder()
+
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package admin
+
+import "github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+
+// NewConfigChange converts a change recorded by the config store. Only
+// identifiers are included; the configuration itself is served by the config
+// dump.
+func NewConfigChange(c storage.ConfigChange) ConfigChange {
+	change := ConfigChange{
+		Version:   c.Version,
+		Timestamp: c.Timestamp,
+		Resource:  ConfigChangeResource(c.Resource),
+		Id:        c.ID,
+		Action:    ConfigChangeAction(c.Action),
+	}
+	if c.Kind != "" {
+		change.Kind = &c.Kind
+	}
+	if c.Name != "" {
+		change.Name = &c.Name
+	}
+	if c.CorrelationID != "" {
+		change.CorrelationId = &c.CorrelationID
+	}
+	return change
+}
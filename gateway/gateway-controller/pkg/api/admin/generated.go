@@ -17,9 +17,24 @@ import (
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ConfigChangeAction.
+const (
+	Added    ConfigChangeAction = "added"
+	Modified ConfigChangeAction = "modified"
+	Removed  ConfigChangeAction = "removed"
+)
+
+// Defines values for ConfigChangeResource.
+const (
+	Api         ConfigChangeResource = "api"
+	Certificate ConfigChangeResource = "certificate"
+	Policy      ConfigChangeResource = "policy"
+)
+
 // Defines values for ConfigDumpAPIMetadataStatus.
 const (
 	Deployed   ConfigDumpAPIMetadataStatus = "deployed"
@@ -30,15 +45,51 @@ const (
 
 // CertificateResponse defines model for CertificateResponse.
 type CertificateResponse struct {
-	Count    *int       `json:"count,omitempty" yaml:"count,omitempty"`
-	Id       *string    `json:"id,omitempty" yaml:"id,omitempty"`
-	Issuer   *string    `json:"issuer,omitempty" yaml:"issuer,omitempty"`
-	Name     *string    `json:"name,omitempty" yaml:"name,omitempty"`
-	NotAfter *time.Time `json:"notAfter,omitempty" yaml:"notAfter,omitempty"`
-	Status   *string    `json:"status,omitempty" yaml:"status,omitempty"`
-	Subject  *string    `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Count *int `json:"count,omitempty" yaml:"count,omitempty"`
+
+	// ExpiresInDays Whole days until notAfter (negative once expired), from the latest expiry monitor scan
+	ExpiresInDays *int `json:"expiresInDays,omitempty" yaml:"expiresInDays,omitempty"`
+
+	// ExpiringSoon Whether the certificate expires within the expiry monitor warning threshold
+	ExpiringSoon *bool   `json:"expiringSoon,omitempty" yaml:"expiringSoon,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Issuer       *string `json:"issuer,omitempty" yaml:"issuer,omitempty"`
+
+	// LastCheckedAt When the expiry monitor last checked the certificate
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty" yaml:"lastCheckedAt,omitempty"`
+	Name          *string    `json:"name,omitempty" yaml:"name,omitempty"`
+	NotAfter      *time.Time `json:"notAfter,omitempty" yaml:"notAfter,omitempty"`
+
+	// OcspStatus Result of the latest OCSP check (good, revoked or unknown); omitted when not checked
+	OcspStatus *string `json:"ocspStatus,omitempty" yaml:"ocspStatus,omitempty"`
+	Status     *string `json:"status,omitempty" yaml:"status,omitempty"`
+	Subject    *string `json:"subject,omitempty" yaml:"subject,omitempty"`
 }
 
+// ConfigChange defines model for ConfigChange.
+type ConfigChange struct {
+	Action ConfigChangeAction `json:"action" yaml:"action"`
+
+	// CorrelationId Correlation ID of the request that triggered the change, if known
+	CorrelationId *string `json:"correlationId,omitempty" yaml:"correlationId,omitempty"`
+	Id            string  `json:"id" yaml:"id"`
+
+	// Kind Configuration kind of an API, e.g. RestApi
+	Kind      *string              `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Name      *string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Resource  ConfigChangeResource `json:"resource" yaml:"resource"`
+	Timestamp time.Time            `json:"timestamp" yaml:"timestamp"`
+
+	// Version Resource version of the last change to the resource
+	Version int64 `json:"version" yaml:"version"`
+}
+
+// ConfigChangeAction defines model for ConfigChange.Action.
+type ConfigChangeAction string
+
+// ConfigChangeResource defines model for ConfigChange.Resource.
+type ConfigChangeResource string
+
 // ConfigDumpAPIItem API item in config dump response
 type ConfigDumpAPIItem struct {
 	Configuration *map[string]interface{} `json:"configuration,omitempty" yaml:"configuration,omitempty"`
@@ -59,13 +110,32 @@ type ConfigDumpAPIMetadata struct {
 // ConfigDumpAPIMetadataStatus defines model for ConfigDumpAPIMetadata.Status.
 type ConfigDumpAPIMetadataStatus string
 
+// ConfigDumpDiffResponse defines model for ConfigDumpDiffResponse.
+type ConfigDumpDiffResponse struct {
+	Changes []ConfigChange `json:"changes" yaml:"changes"`
+
+	// ResourceVersion Version of the last configuration change
+	ResourceVersion int64 `json:"resourceVersion" yaml:"resourceVersion"`
+
+	// Since Resource version the changes start after
+	Since  int64  `json:"since" yaml:"since"`
+	Status string `json:"status" yaml:"status"`
+}
+
 // ConfigDumpResponse defines model for ConfigDumpResponse.
 type ConfigDumpResponse struct {
 	Apis         *[]ConfigDumpAPIItem      `json:"apis,omitempty" yaml:"apis,omitempty"`
 	Certificates *[]CertificateResponse    `json:"certificates,omitempty" yaml:"certificates,omitempty"`
 	Policies     *[]map[string]interface{} `json:"policies,omitempty" yaml:"policies,omitempty"`
-	Statistics   *struct {
-		TotalApis             *int `json:"totalApis,omitempty" yaml:"totalApis,omitempty"`
+
+	// ResourceVersion Version of the last configuration change, to pass to /config_dump/diff
+	ResourceVersion *int64 `json:"resourceVersion,omitempty" yaml:"resourceVersion,omitempty"`
+	Statistics      *struct {
+		// TotalApis Number of APIs listed, after the kind and status filters
+		TotalApis *int `json:"totalApis,omitempty" yaml:"totalApis,omitempty"`
+
+		// TotalApisUnfiltered Number of APIs in the gateway, regardless of the kind and status filters
+		TotalApisUnfiltered   *int `json:"totalApisUnfiltered,omitempty" yaml:"totalApisUnfiltered,omitempty"`
 		TotalCertificateBytes *int `json:"totalCertificateBytes,omitempty" yaml:"totalCertificateBytes,omitempty"`
 		TotalCertificates     *int `json:"totalCertificates,omitempty" yaml:"totalCertificates,omitempty"`
 		TotalPolicies         *int `json:"totalPolicies,omitempty" yaml:"totalPolicies,omitempty"`
@@ -77,10 +147,25 @@ type ConfigDumpResponse struct {
 	XdsSync *ConfigDumpXDSSync `json:"xds_sync,omitempty" yaml:"xds_sync,omitempty"`
 }
 
+// ConfigDumpRouteVersion Policy chain version tracking for a single route
+type ConfigDumpRouteVersion struct {
+	// Hash SHA3-256 hash of the policy chain resource pushed for the route
+	Hash *string `json:"hash,omitempty" yaml:"hash,omitempty"`
+
+	// RouteKey Route key the policy chain is attached to
+	RouteKey *string `json:"route_key,omitempty" yaml:"route_key,omitempty"`
+
+	// Version Policy chain version at which the route's hash last changed
+	Version *string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
 // ConfigDumpXDSSync xDS sync metadata included in config dump
 type ConfigDumpXDSSync struct {
 	// PolicyChainVersion Latest policy chain version published by the controller
 	PolicyChainVersion *string `json:"policy_chain_version,omitempty" yaml:"policy_chain_version,omitempty"`
+
+	// Routes Per-route policy chain hash and the version at which it last changed
+	Routes *[]ConfigDumpRouteVersion `json:"routes,omitempty" yaml:"routes,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -98,6 +183,30 @@ type HealthResponse struct {
 	Timestamp *time.Time `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
 }
 
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	// Components Readiness of each startup component
+	Components *struct {
+		// ControlPlaneConnected Control plane connection state; omitted when no control plane is configured
+		ControlPlaneConnected *bool `json:"control_plane_connected,omitempty" yaml:"control_plane_connected,omitempty"`
+
+		// Draining The controller is shutting down and reports not ready until it stops
+		Draining *bool `json:"draining,omitempty" yaml:"draining,omitempty"`
+
+		// StorageLoaded Configurations have been loaded from the database
+		StorageLoaded *bool `json:"storage_loaded,omitempty" yaml:"storage_loaded,omitempty"`
+
+		// XdsSnapshot At least one xDS snapshot has been pushed to the router cache
+		XdsSnapshot *bool `json:"xds_snapshot,omitempty" yaml:"xds_snapshot,omitempty"`
+	} `json:"components,omitempty" yaml:"components,omitempty"`
+
+	// Status Readiness status ("ready" or "not_ready")
+	Status *string `json:"status,omitempty" yaml:"status,omitempty"`
+
+	// Timestamp Timestamp of the readiness check
+	Timestamp *time.Time `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+}
+
 // XDSSyncStatusResponse defines model for XDSSyncStatusResponse.
 type XDSSyncStatusResponse struct {
 	Component *string `json:"component,omitempty" yaml:"component,omitempty"`
@@ -107,14 +216,38 @@ type XDSSyncStatusResponse struct {
 	Timestamp          *time.Time `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
 }
 
+// GetConfigDumpParams defines parameters for GetConfigDump.
+type GetConfigDumpParams struct {
+	// Kind Only list APIs of this kind, such as RestApi or LlmProvider (case-insensitive)
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" yaml:"kind,omitempty"`
+
+	// Status Only list APIs with this status (pending, deployed, failed or undeployed)
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// GetConfigDumpDiffParams defines parameters for GetConfigDumpDiff.
+type GetConfigDumpDiffParams struct {
+	// Since Resource version, or an RFC 3339 timestamp, to diff from
+	Since string `form:"since" json:"since" yaml:"since"`
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Dump current configuration state
 	// (GET /config_dump)
-	GetConfigDump(w http.ResponseWriter, r *http.Request)
+	GetConfigDump(w http.ResponseWriter, r *http.Request, params GetConfigDumpParams)
+	// Configuration changes since a version
+	// (GET /config_dump/diff)
+	GetConfigDumpDiff(w http.ResponseWriter, r *http.Request, params GetConfigDumpDiffParams)
+	// Stream configuration changes
+	// (GET /config_dump/events)
+	GetConfigDumpEvents(w http.ResponseWriter, r *http.Request)
 	// Health check
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Readiness check
+	// (GET /ready)
+	GetReady(w http.ResponseWriter, r *http.Request)
 	// Get xDS policy sync status
 	// (GET /xds_sync_status)
 	GetXDSSyncStatus(w http.ResponseWriter, r *http.Request)
@@ -132,8 +265,77 @@ type MiddlewareFunc func(http.Handler) http.Handler
 // GetConfigDump operation middleware
 func (siw *ServerInterfaceWrapper) GetConfigDump(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConfigDumpParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConfigDump(w, r)
+		siw.Handler.GetConfigDump(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfigDumpDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetConfigDumpDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConfigDumpDiffParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfigDumpDiff(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfigDumpEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetConfigDumpEvents(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfigDumpEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -157,6 +359,20 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// GetReady operation middleware
+func (siw *ServerInterfaceWrapper) GetReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetXDSSyncStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetXDSSyncStatus(w http.ResponseWriter, r *http.Request) {
 
@@ -292,7 +508,10 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	}
 
 	m.HandleFunc("GET "+options.BaseURL+"/config_dump", wrapper.GetConfigDump)
+	m.HandleFunc("GET "+options.BaseURL+"/config_dump/diff", wrapper.GetConfigDumpDiff)
+	m.HandleFunc("GET "+options.BaseURL+"/config_dump/events", wrapper.GetConfigDumpEvents)
 	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/ready", wrapper.GetReady)
 	m.HandleFunc("GET "+options.BaseURL+"/xds_sync_status", wrapper.GetXDSSyncStatus)
 
 	return m
@@ -301,27 +520,56 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYUW/bNhD+KwS3hxZwrbTFHqq3LBnSYCsW1AE2YA4CWjxbbCWSI09pjcD/fTiKkiyL",
-	"cZJiHfZUlTwe77777js697wwtTUaNHqe33NflFCL8HkGDtVaFQLhI3hrtAdats5Y2oFgVJhGI33g1gLP",
-	"udIIG3B8N+NK7q17dEpvwrL3DbjklhY1pDcMnq6xPbU2rhbIcy4FwitUNfDZ9IRHgY1POvPN6hMUmNjb",
-	"9Y5Ma7Kb8TOj12pz3tT29OryEqGmcxJ84ZRFZTTP+enVJVMINVOaFcGcyaa2zHWozSagkVHjROvgngsp",
-	"FX2L6mrPEF0DiYhaXHsYmkbJFAI1oJACBRn/6GDNc/5DNhQ7i5XORgl+6A49jsWHPf9jPLodtjaOBXBG",
-	"uEzhcCAQ5Ck+vbwSbGW2zzszUAJ0U/P8L25BS9oc/PEZXwtVhY9G96s3CXeNlc+L+jiiD7eYsCr8SxTz",
-	"z6pmoOtwrXBObOn/xdDZz/CckIOEb2sqVagDv8/i96FHqpvyqAo/xQYNiuo0AjSVoLC9F/jP25jx46bH",
-	"zK72cjw0SVX5iBgRVTyK2j6dx1+lv/VbXTydCn+eLxZ04BEKdmaThv56vmB0I+s0hSldVI0E+VhrBzZs",
-	"b4tSKH17B85HwRv7/43wRtYas2DMojGzzapSvgTJVluGJdB16ExVgXtai/3inHEPd1cN3otNeuw8WLfd",
-	"jDv4u1EOJOlItJv1zm4ScbwHUWH5cCDDZWNw2nOs3WYvlrwMC9slf5lix4hQY0/X3RYz6wBl64kVJRSf",
-	"Sfm+UcQibxYhwmNPhUjRJNb/MVO+ofOmqdOS0msTZzqK9lXRPmP4H4vf34Txd1UJpAvYNYi6nV6j54Os",
-	"lc4krJpNMKepeSEQvogtO+tTmC/1Ul+X4IGBltYojZ4JB8yDuwPJjA45S5BBviQT5Lbddcwah+yFhLVo",
-	"KszZu5N3b17Ol5qSVFhRtNMbWQiMQuIz3leEv56fzE8oC2NBC6t4zt/OT+ZvqfcFlqHUWasJt0ET8nu+",
-	"AZzW8iOgU3AHsVa1rQCBFY1zoDHwHTqiJoJrFUjpTb7Ur9hpVbFuWgcQR08s35lURpBoRfpIWCutRgYO",
-	"NsojOJBsf0jS9mLr6Y0X+1Bo2athwJGYHi67lIQm4KCrnNSi7YqAzpuTk44xsRuEtRXdpIzOPvmW+K2M",
-	"P13kh6G8m1DsrEd3H5ZWsncz/tO/GNBYbhOxXGoEp0XVURPoQOgu39S1cFuec8qnZ8I45sAL4q3YeNLe",
-	"tiz8hhxkraAdY1zjtN/XvljOyLNN5Fmx33bXpfJ9zzHlmTbI4o8IhoZdXrEvpUKolEd686NTRSAV84Zh",
-	"KXCpz03xGVwgza/NCpwGBN+FYJ1ZgWeF0MyBKEqmcJ6mVDsKviedDoZUonwXE4gIkjiTDsr4fjxfkiXr",
-	"XjO3w/x7tHYdM0ZjgB4p/ShwRjbFMAmSdU0hPBpl3xPo9MxMte7QBR3elGlMPbzKInL/x0a+AHwo3BQf",
-	"6GxwRuuT2W8KQSp/B5WxNWGyP+LoB5ureM5LRJtnWUXWpfGY07DLhFVZMM/uXvPd7NB326DZXnMe8x3p",
-	"9GqoSeqSmz7Dw9viKIn9T6oQZaif7Lz7Y0gHzu5m908AAAD//z/1/tqrEQAA",
+	"H4sIAAAAAAAC/8xab2/cNpP/KgPdAZcA8tqJ0wfoPq98di81Lr0adtoe0A1cWhqtWEukSo7W2Svy3Q9D",
+	"ivqz4trrtkmfV1mLFOf/jzM/5fck03WjFSqyyfL3xGYl1sL9PEdDspCZILxG22hlkR83Rje8gm5TpltF",
+	"/IO2DSbLRCrCNZrkU5rgx0YatJfqQmzd3hxtZmRDUqtkmfxU6gohF1sLrSJZgdJ0VhAaeKFwLUhuELTK",
+	"EPw5+csUCqNroBKhEoSW/MoWaq0kaQM2EypJ92ki1fpGaxVTBKlE4w7OBps7uRYeJJVSueUdgQ/CKKnW",
+	"QKVBW+oqH6TfaV2hUCxd5iP/WGJF3GNrWzTRpUpYOi8xu8f8jKIKR9Xh1yDz7+1ak6RJoU0tKFkmuSA8",
+	"Illjks5lK1FjVKkQHl487Cid2eaGBLWR6F+jbSsCXYzj+f35zZU3AF6stc5TMLjRbI020Kp7pR/Uy3+C",
+	"riUR5vDAflC6tzmmg+3lz5fau18xo8jap/4g7bd8SpNzrQq5Pi+FWkfKQGTert8TVG2dLH9ORJ47jWqd",
+	"y0K6nwZrvcE8+RDRM9PGYCX4lMt87q7zYRkuL4LbDP7Wst+oFARk5HqNJoTeKZqCLMC5LeabPYl5L1VU",
+	"A7a/NV4H3sNaCAVnV5cp4GK9gGu0dNbIZ2WVQatbk+HEde6MRlcy2yZpMs7imOs4/SyJujk8MzdorIxh",
+	"wXWnDnQ7hgS11PkUSHfO7zQfVZZU9I83EQhyhv7WMoyxgUH8WPeRK1xk0pBTH/Ym40VbN2dXl5eE9dyS",
+	"s6tLkIQ1SAWZ2w55WzdgApSnMyQfBZgfiDyX/FtUV6ONZFqMaORzqXdE28poOdZIIhckePO/GyySZfJv",
+	"x8MNdNxdP8cTA78LLz1SmLtbZ/4IK1BoA845E7/M3WFQUMDfw7Iqx6bS2+e9M+BTyP4GVc6Lw3mcYUJW",
+	"7ker+qexWmib/HlaP+7RC1kUj9z9rh7cT840e1hQOwgdBAtjxHaMBT/uq84fY0U5wSWv0iElmSZWqgzn",
+	"QmYQMMCpBUvCEAh3Dx4mZN/9swMJ3b6g1dwZae/uxwFhf7hEI58bqzHCRAI2QuZnnBxpKyNnO/iXO+c+",
+	"C5I+W3qlQBoaYS3/e+x33DKIHOeyKA7PC2lJZnYeKNIkqrMuWlMV/6et79CwhmdXlxYqaQnz1CekU9pd",
+	"zELl4BMKClkRGhvVoZfzg/LbMH9SYtcIrwXhg9hyg7YWJq/Q2uC2Z2swSoj/3HaZ9PTWx7ZdjXInchXP",
+	"MuWRJvEPNBcfc3trtyo7vMT+9+Lmhl94Ao2vdUv7s9cZveUUlWrALiOyex5R+NYTYKVaVwiGD5rdeKWw",
+	"5fzYm2/PTo9ef/UP4OUQ42YsK9QVNK0tMXeiqBykzHs+Xri9x20EfHkJ7nE7FyMtCCKRsQjSz+rqoq4R",
+	"BA+lzMpB1/+w3shRs5c/98YMoZzp8PHiBjgrILRAIFVWtTnmT3Ui3gu3TvvbvTa+8yNUEzO1ae8q6WJz",
+	"5x2baUVGV5W7xeLxiYDPFZojtzaV4nzGBc8nz7wradefz7x/Jlk/g/VYNL4xRpv992CN1op1fBx59nUd",
+	"Dotdyt+iqKjcr4jdMxv79wJ+vlglpXuwXSUvk6fmn+lJ78NSKFx/kh+YD6QEYh6+RpFLhdY+RgyNaaXd",
+	"Fqt7ndVCkZW+r2ob6N+KjSecsrdNJRTeZlopzAjjgypvBLcRuo2ckuxPnLEHkE32S9tf+Rinc3IjpGLP",
+	"zL09qSw+ypYtEcNvrh+UqxGDjTZkHW1hUOTbjvySBJZ0Y6MiLWkj1nhbaZHjU7M5g9gG4Q5RgX9h4M0Y",
+	"d+6ExagUd28p0dhSR0inM4IKuZC1QnBg1m3l+vfSOvwP8zHXrYGMETsi7/F7eF++DDXhnLdKQBtYJUrT",
+	"bffgT5eI6YX92Srp7gJPgR1QKVFE+uLo/+yOZ246P5Kq0KFuhWfZPAmU/HTz/Ws3gV9VglgAvEdR+wF6",
+	"knF5LdVxjnft2m3nvuKt7zvhvDdhsVIr9b5Ei4Aqb7RUZEEYBItmgzl0A1yOuWsbcxB8rF81wMUIL3Is",
+	"RFvREr4++fr1y8XKMzNUsbZzieAUY5WSUc+RvFqcLE7YCt2gEo1Mlsnp4mRxykgmqHShHg8L/PcaKZbt",
+	"ZCRusItV3VRICFlrDCryKBZyNaKc7yqkWi9X6gjOqgoCYeCcOJlnbNjS4USXPjkWUsnJBoNrad2EMCaV",
+	"3fLN1hLWoTIZ40KH00VmNhE0wogaCQ0PMLUkZ8pknuFQ40fBpgPplRJVBZ4CgXfvvoPG6I3M0dgFhFbf",
+	"nT9WzeWA0gRhtPFh5cLrWdbkLdLQaLhABc2S5c+7cfleVVunodfVhUBaZ10Kts1KEDZwoKANvKvqq05R",
+	"eJEJi0dSWVRW8qcNhinJp/7WotkmgSH15GvafYaJ9iFPqMVfK7xiASs7QintEyENvnSseni6T6OBmdir",
+	"0wc3XDt4c2n++uQklH4Ha6JpKo6L1Or4V+sRbDjvwEawZws+zbDivC+Tybzu6uxTmrz5CxWadpcRXS7V",
+	"RlRynu2syFdfVhFCo0QVwA75BYfXtq1rYbbJMmHH9tgydZ5DmiRNSKy5HBJf6MkHPmDOejyCZq1Rtq/x",
+	"NIIy8+J1XzQe0CC47yjpSoXvKJy03YcUcFyZO3ktNziaRMPV94JKXKkd4sd9t5hw4tqAUIDCVBINsDkv",
+	"+Vl/Ey5W6hvuUrsZZpAjA2T5L5Wu9iRZUBgGniWIfvtKOWPCrKSgt0narjHkVdvZ7PZp1bmgf2ulgvks",
+	"HQsC3ZK7BTv7PPTdY0MgFdRYa7Ptek9PX7p28P/QaN8DT1uCleq22aBA5buGWlsCgxmqYJsXZJCEVJgv",
+	"IHyXXKmwPgSo+1SF+TAhOnyGSqs1GhAbIStxV6E/IFzlYLr0efPqpJ8xs0qyEnVrCQqkboQv2qoaR/VJ",
+	"vL/wZN2jmL9LBqddrlz/1zmcnp5+PeSIIwU5dZx792FpT+6GUdJzl383tE54/hi8Ph3Pvw9mnU4TlH3z",
+	"6gvqcf7Hc30Hi88jXHM4V8Doo+FTgIybMHdHIfmGDIraRrlty/jj74sjy1Xmz+KnVOIWStE0qKaA6CZd",
+	"3svQBb/4h7/4N+Gh1BZBOrSSZEdg2DtH5d0uT4vxIeNPRQv4ptPBINy1ReHa0EKblXLcgYeDf3owE92f",
+	"UIiq4rm0dO3ZGLByo5umA1SxUr90fweFw4TOkXRsMihPgYf3rIZCmAWcO0GWATPTfL/ebSETVcXz/ux+",
+	"DH2Zc8X4A4MTulLOuYyucnNAq+odkjyJDYQfyWfDkXVBn+b9LtjMcttnCusby5UuN3xfc/rlKu691lAL",
+	"te1C7aM6MELToupMiOb6vlryLNlBLU054ep0Mf46MrpVFzwJSduPqJzlShN0/wcFSMPlFTOmhK6VN8hB",
+	"yXx3ZLXrh1bqQmf3jB8qh/9u79AoJLRBhcboO7SQCQXG1YWkPXnk+cXkM94sO8xnJIZvZy4CGUzZ7oTw",
+	"2ylpGQ2ZY3+ejNjD6L96zYPkmCxuSh1ZEihJ15sKtVIOFYGMKAqZLXcmaZeDY7qt4+xSX/NKkhQV82Yr",
+	"NSfO1qg4RB6TUg9koUVIx/1ZT1KuVJ/vC/hBVfIeoUvb1E9/oXH66uS0oxhxg2bbn7tSfdx8+yny7QKe",
+	"k6QrFcvScWYOVNrhyXntAvkZc3POXR+cnj7J/mK4+xP6DCTyFmmnaK5nNGa0bsLHytuBe30S88K4OGEb",
+	"mRLuGUej8zYbCMcoHsaCP2FMP2cSxKnZWHc3jMbB8WxpZzp7rsP+f8np/i3SPnVj+cDvusNio9A7nQkm",
+	"EzdY6aZ27d6ISU3SpDVVskxKomZ5fFzx7lJbWjKneiwaeey2H29eJXMOy19sxyPoeOzsLp2OhpjEhHzo",
+	"LZz1v87gcG+OiMmeQB4mNr83+fTh0/8PAH/yVPIqLQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package grpcapi defines the "GrpcApi" resource, which exposes a gRPC backend
+// through the gateway: gRPC method routing, server reflection passthrough and,
+// when a proto descriptor is supplied, JSON/HTTP transcoding.
+package grpcapi
+
+import (
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+const (
+	// KindGrpcApi is the resource kind of a gRPC API.
+	KindGrpcApi = "GrpcApi"
+
+	// ApiVersionV1 is the only supported apiVersion of a gRPC API.
+	ApiVersionV1 = "gateway.api-platform.wso2.com/v1"
+)
+
+// GrpcAPI is a gRPC API configuration.
+type GrpcAPI struct {
+	// ApiVersion API specification version
+	ApiVersion string `json:"apiVersion" yaml:"apiVersion"`
+
+	// Kind API type, always "GrpcApi"
+	Kind     string       `json:"kind" yaml:"kind"`
+	Metadata api.Metadata `json:"metadata" yaml:"metadata"`
+	Spec     GrpcAPIData  `json:"spec" yaml:"spec"`
+
+	// Status Server-managed lifecycle fields. Populated on responses.
+	Status *api.ResourceStatus `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// GrpcAPIData is the spec of a gRPC API.
+type GrpcAPIData struct {
+	// DisplayName Human-readable API name
+	DisplayName string `json:"displayName" yaml:"displayName"`
+
+	// Version Semantic version of the API
+	Version string `json:"version" yaml:"version"`
+
+	// Context Base path of the JSON/HTTP endpoints exposed through transcoding. gRPC
+	// clients always call /<package>.<Service>/<Method>, so the context only applies
+	// when a descriptor is set; the google.api.http paths in the descriptor must be
+	// under it.
+	Context *string `json:"context,omitempty" yaml:"context,omitempty"`
+
+	// Vhost Virtual host the API is exposed on. Defaults to the gateway's main vhost.
+	Vhost *string `json:"vhost,omitempty" yaml:"vhost,omitempty"`
+
+	// Upstream gRPC backend
+	Upstream GrpcUpstream `json:"upstream" yaml:"upstream"`
+
+	// Services gRPC services exposed by the API
+	Services []GrpcService `json:"services" yaml:"services"`
+
+	// Descriptor Compiled proto descriptor set of the services. Enables JSON/HTTP transcoding.
+	Descriptor *ProtoDescriptor `json:"descriptor,omitempty" yaml:"descriptor,omitempty"`
+
+	// Reflection Whether gRPC server reflection requests are passed through to the backend
+	Reflection *bool `json:"reflection,omitempty" yaml:"reflection,omitempty"`
+}
+
+// GrpcUpstream is the backend serving the gRPC services.
+type GrpcUpstream struct {
+	// Url Backend URL. The http scheme uses cleartext HTTP/2, https uses HTTP/2 over TLS.
+	Url string `json:"url" yaml:"url"`
+}
+
+// GrpcService is a fully-qualified gRPC service and the methods exposed from it.
+type GrpcService struct {
+	// Name Fully-qualified service name, e.g. "helloworld.Greeter"
+	Name string `json:"name" yaml:"name"`
+
+	// Methods Methods exposed from the service. All methods are exposed when omitted.
+	Methods *[]string `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+// ProtoDescriptor references a compiled FileDescriptorSet (protoc --include_imports
+// --descriptor_set_out). Exactly one of File and Inline must be set.
+type ProtoDescriptor struct {
+	// File Path of the descriptor set on the router's filesystem
+	File *string `json:"file,omitempty" yaml:"file,omitempty"`
+
+	// Inline Base64-encoded descriptor set
+	Inline *string `json:"inline,omitempty" yaml:"inline,omitempty"`
+}
+
+// ReflectionEnabled reports whether server reflection is passed through.
+func (s *GrpcAPIData) ReflectionEnabled() bool {
+	return s.Reflection != nil && *s.Reflection
+}
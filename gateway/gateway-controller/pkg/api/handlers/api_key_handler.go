@@ -19,6 +19,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -74,10 +75,14 @@ func (s *APIServer) CreateAPIKey(w http.ResponseWriter, r *http.Request, id stri
 		User:          user,
 		CorrelationID: correlationID,
 		Logger:        log,
+		RateLimited:   true,
 	}
 
 	result, err := s.apiKeyService.CreateAPIKey(params)
 	if err != nil {
+		if mapAPIKeyRateLimitError(w, err) {
+			return
+		}
 		// Check error type to determine appropriate status code
 		if strings.Contains(err.Error(), "not found") {
 			httputil.WriteJSON(w, http.StatusNotFound, api.ErrorResponse{
@@ -112,6 +117,104 @@ func (s *APIServer) CreateAPIKey(w http.ResponseWriter, r *http.Request, id stri
 	httputil.WriteJSON(w, http.StatusCreated, result.Response)
 }
 
+// CreateAPIKeysBulk implements ServerInterface.CreateAPIKeysBulk
+// (POST /rest-apis/{id}/api-keys/bulk)
+// Creates all requested keys in one transaction, or none of them
+func (s *APIServer) CreateAPIKeysBulk(w http.ResponseWriter, r *http.Request, id string) {
+	// Get correlation-aware logger from context
+	log := middleware.GetLogger(r, s.logger)
+	handle := id
+	correlationID := middleware.GetCorrelationID(r)
+
+	// Extract authenticated user from context
+	user, ok := s.extractAuthenticatedUser(w, r, "CreateAPIKeysBulk", correlationID)
+	if !ok {
+		return // Error response already sent by extractAuthenticatedUser
+	}
+
+	// Parse and validate request body
+	var request api.APIKeyBulkCreationRequest
+	if err := s.bindRequestBody(r, &request); err != nil {
+		log.Error("Failed to parse request body for bulk API key creation",
+			slog.Any("error", err),
+			slog.String("handle", handle),
+			slog.String("correlation_id", correlationID))
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+			Status:  "error",
+			Message: fmt.Sprintf("Invalid request body: %v", err),
+		})
+		return
+	}
+
+	log.Debug("Starting bulk API key creation",
+		slog.String("handle", handle),
+		slog.String("user", user.UserID),
+		slog.Int("count", len(request.ApiKeys)),
+		slog.String("correlation_id", correlationID))
+
+	params := make([]utils.APIKeyCreationParams, len(request.ApiKeys))
+	for i, item := range request.ApiKeys {
+		params[i] = utils.APIKeyCreationParams{
+			Kind:          models.KindRestApi,
+			Handle:        handle,
+			Request:       item,
+			User:          user,
+			CorrelationID: correlationID,
+			Logger:        log,
+			RateLimited:   true,
+		}
+	}
+
+	result, err := s.apiKeyService.CreateAPIKeysBulk(params)
+	if err != nil {
+		if mapAPIKeyRateLimitError(w, err) {
+			return
+		}
+		var status int
+		switch {
+		case storage.IsNotFoundError(err):
+			httputil.WriteJSON(w, http.StatusNotFound, api.ErrorResponse{
+				Status:  "error",
+				Message: err.Error(),
+			})
+			return
+		case errors.Is(err, utils.ErrInvalidAPIKeyRequest), errors.Is(err, utils.ErrAPIKeyLimitExceeded):
+			status = http.StatusBadRequest
+		case storage.IsConflictError(err):
+			status = http.StatusConflict
+		default:
+			log.Error("Failed to create API keys in bulk",
+				slog.Any("error", err),
+				slog.String("handle", handle),
+				slog.String("correlation_id", correlationID))
+			httputil.WriteJSON(w, http.StatusInternalServerError, api.ErrorResponse{
+				Status:  "error",
+				Message: "internal server error",
+			})
+			return
+		}
+
+		if result == nil {
+			result = &utils.APIKeyBulkCreationResult{Response: api.APIKeyBulkCreationResponse{
+				Status:  "error",
+				Message: err.Error(),
+				Results: []api.APIKeyBulkCreationItemResult{},
+			}}
+		}
+		httputil.WriteJSON(w, status, result.Response)
+		return
+	}
+
+	log.Info("Bulk API key creation completed",
+		slog.String("handle", handle),
+		slog.Int("count", len(result.Response.Results)),
+		slog.String("user", user.UserID),
+		slog.String("correlation_id", correlationID))
+
+	// Return the response using the generated schema
+	httputil.WriteJSON(w, http.StatusCreated, result.Response)
+}
+
 // RevokeAPIKey implements ServerInterface.RevokeAPIKey
 // (DELETE /apis/{id}/api-keys/{apiKeyName})
 func (s *APIServer) RevokeAPIKey(w http.ResponseWriter, r *http.Request, id string, apiKeyName string) {
@@ -341,7 +444,7 @@ func (s *APIServer) RegenerateAPIKey(w http.ResponseWriter, r *http.Request, id
 
 // ListAPIKeys implements ServerInterface.ListAPIKeys
 // (GET /apis/{id}/api-keys)
-func (s *APIServer) ListAPIKeys(w http.ResponseWriter, r *http.Request, id string) {
+func (s *APIServer) ListAPIKeys(w http.ResponseWriter, r *http.Request, id string, query api.ListAPIKeysParams) {
 	// Get correlation-aware logger from context
 	log := middleware.GetLogger(r, s.logger)
 	handle := id
@@ -365,6 +468,13 @@ func (s *APIServer) ListAPIKeys(w http.ResponseWriter, r *http.Request, id strin
 		CorrelationID: correlationID,
 		Logger:        log,
 	}
+	if err := applyAPIKeyListQuery(&params, query.Limit, query.Offset, query.Status); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+			Status:  "error",
+			Message: err.Error(),
+		})
+		return
+	}
 
 	result, err := s.apiKeyService.ListAPIKeys(params)
 	if err != nil {
@@ -396,6 +506,36 @@ func (s *APIServer) ListAPIKeys(w http.ResponseWriter, r *http.Request, id strin
 	httputil.WriteJSON(w, http.StatusOK, result.Response)
 }
 
+// maxAPIKeyListLimit is the largest page size accepted by the API key list endpoints.
+const maxAPIKeyListLimit = 1000
+
+// applyAPIKeyListQuery validates the limit, offset and status query parameters shared
+// by the API key list endpoints and copies them into the service parameters.
+func applyAPIKeyListQuery(params *utils.ListAPIKeyParams, limit, offset *int, status *string) error {
+	if limit != nil {
+		if *limit < 1 || *limit > maxAPIKeyListLimit {
+			return fmt.Errorf("limit must be between 1 and %d", maxAPIKeyListLimit)
+		}
+		params.Limit = *limit
+	}
+	if offset != nil {
+		if *offset < 0 {
+			return fmt.Errorf("offset must not be negative")
+		}
+		params.Offset = *offset
+	}
+	if status != nil && *status != "" {
+		switch s := models.APIKeyStatus(*status); s {
+		case models.APIKeyStatusActive, models.APIKeyStatusRevoked, models.APIKeyStatusExpired:
+			params.Status = s
+		default:
+			return fmt.Errorf("status must be one of: %s, %s, %s",
+				models.APIKeyStatusActive, models.APIKeyStatusRevoked, models.APIKeyStatusExpired)
+		}
+	}
+	return nil
+}
+
 // resolveAPIIDByHandle resolves an API identifier (deployment ID or handle) to the internal deployment ID.
 // It first attempts a direct ID lookup; if that fails, it falls back to handle-based resolution.
 // Returns (apiID, nil) on success; on failure writes the HTTP response and returns ("", err).
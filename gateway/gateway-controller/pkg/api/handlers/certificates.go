@@ -29,7 +29,9 @@ import (
 	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/middleware"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certstore"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 	"github.com/wso2/go-httpkit/httputil"
 )
@@ -143,6 +145,8 @@ func (s *APIServer) UploadCertificate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.store.RecordChange(storage.ChangeResourceCertificate, certID, req.Name, storage.ChangeAdded, correlationID)
+
 	log.Info("Certificate saved to database successfully",
 		slog.String("id", certID),
 		slog.String("name", req.Name),
@@ -171,8 +175,8 @@ func (s *APIServer) UploadCertificate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Trigger SDS update by regenerating the snapshot
-	if err := s.snapshotManager.UpdateSnapshot(context.Background(), correlationID); err != nil {
+	// Refresh SDS secrets and regenerate the snapshot
+	if err := s.snapshotManager.RefreshSecrets(context.Background(), correlationID); err != nil {
 		log.Error("Failed to update SDS snapshot", slog.Any("error", err))
 		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
 			"status":  "error",
@@ -181,6 +185,15 @@ func (s *APIServer) UploadCertificate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := certstore.PublishTrustedCertificates(s.db, s.lazyResourceManager, correlationID); err != nil {
+		log.Error("Failed to publish certificates to the policy engine", slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
+			"status":  "error",
+			"message": "Certificate saved but failed to update the policy engine",
+		})
+		return
+	}
+
 	log.Info("SDS snapshot updated with new certificate",
 		slog.String("id", certID),
 		slog.String("name", req.Name))
@@ -275,6 +288,7 @@ func (s *APIServer) DeleteCertificate(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	s.store.RecordChange(storage.ChangeResourceCertificate, id, "", storage.ChangeRemoved, correlationID)
 	log.Info("Certificate deleted from database", slog.String("id", id))
 
 	certStore := translator.GetCertStore()
@@ -289,8 +303,8 @@ func (s *APIServer) DeleteCertificate(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
-	// Trigger SDS update
-	if err := s.snapshotManager.UpdateSnapshot(context.Background(), correlationID); err != nil {
+	// Refresh SDS secrets and regenerate the snapshot
+	if err := s.snapshotManager.RefreshSecrets(context.Background(), correlationID); err != nil {
 		log.Error("Failed to update SDS snapshot", slog.Any("error", err))
 		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
 			"status":  "error",
@@ -299,6 +313,15 @@ func (s *APIServer) DeleteCertificate(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	if err := certstore.PublishTrustedCertificates(s.db, s.lazyResourceManager, correlationID); err != nil {
+		log.Error("Failed to publish certificates to the policy engine", slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
+			"status":  "error",
+			"message": "Certificate deleted but failed to update the policy engine",
+		})
+		return
+	}
+
 	log.Info("SDS snapshot updated after certificate deletion", slog.String("id", id))
 
 	httputil.WriteJSON(w, http.StatusOK, map[string]any{
@@ -336,8 +359,8 @@ func (s *APIServer) ReloadCertificates(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Trigger SDS update
-	if err := s.snapshotManager.UpdateSnapshot(context.Background(), correlationID); err != nil {
+	// Refresh SDS secrets and regenerate the snapshot
+	if err := s.snapshotManager.RefreshSecrets(context.Background(), correlationID); err != nil {
 		log.Error("Failed to update SDS snapshot", slog.Any("error", err))
 		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
 			"status":  "error",
@@ -346,6 +369,15 @@ func (s *APIServer) ReloadCertificates(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := certstore.PublishTrustedCertificates(s.db, s.lazyResourceManager, correlationID); err != nil {
+		log.Error("Failed to publish certificates to the policy engine", slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]any{
+			"status":  "error",
+			"message": "Certificates reloaded but failed to update the policy engine",
+		})
+		return
+	}
+
 	log.Info("Certificates reloaded and SDS snapshot updated")
 
 	combinedCerts := certStore.GetCombinedCertificates()
@@ -21,6 +21,7 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -37,7 +38,9 @@ import (
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/handlers/handlerkit"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/middleware"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeywebhook"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeyxds"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certmonitor"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/controlplane"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/lazyresourcexds"
@@ -53,7 +56,7 @@ import (
 
 // APIServer implements the generated ServerInterface
 type APIServer struct {
-	*RestAPIHandler // embedded — promotes CreateRestAPI, ListRestAPIs, GetRestAPIById, UpdateRestAPI, DeleteRestAPI
+	*RestAPIHandler // embedded — promotes CreateRestAPI, ListRestAPIs, GetRestAPIById, UpdateRestAPI, DeleteRestAPI, ImportOpenAPI, ExportOpenAPI
 
 	restAPIService              *restapi.RestAPIService
 	store                       *storage.ConfigStore
@@ -62,6 +65,7 @@ type APIServer struct {
 	policyManager               *policyxds.PolicyManager
 	policyDefinitions           map[string]models.PolicyDefinition // key name|version
 	policyDefMu                 sync.RWMutex
+	policyValidator             *config.PolicyValidator // used by the MCP and LLM deployment services
 	parser                      *config.Parser
 	validator                   config.Validator
 	logger                      *slog.Logger
@@ -71,6 +75,7 @@ type APIServer struct {
 	secretService               *secrets.SecretService
 	apiKeyService               *utils.APIKeyService
 	apiKeyXDSManager            *apikeyxds.APIKeyStateManager
+	lazyResourceManager         *lazyresourcexds.LazyResourceStateManager
 	controlPlaneClient          controlplane.ControlPlaneClient
 	routerConfig                *config.RouterConfig
 	httpClient                  *http.Client
@@ -79,6 +84,7 @@ type APIServer struct {
 	gatewayID                   string
 	subscriptionSnapshotUpdater utils.SubscriptionSnapshotUpdater
 	subscriptionResourceService *utils.SubscriptionResourceService
+	certificateMonitor          *certmonitor.Monitor // optional, adds expiry and OCSP results to the config dump
 }
 
 // NewAPIServer creates a new API server with dependencies
@@ -131,6 +137,7 @@ func NewAPIServer(
 		snapshotManager:      snapshotManager,
 		policyManager:        policyManager,
 		policyDefinitions:    policyDefinitions,
+		policyValidator:      policyValidator,
 		parser:               parser,
 		validator:            validator,
 		logger:               logger,
@@ -141,6 +148,7 @@ func NewAPIServer(
 		secretService:               secretService,
 		apiKeyService:               apiKeyService,
 		apiKeyXDSManager:            apiKeyXDSManager,
+		lazyResourceManager:         lazyResourceManager,
 		controlPlaneClient:          controlPlaneClient,
 		routerConfig:                routerConfig,
 		httpClient:                  httpClient,
@@ -170,6 +178,17 @@ func NewAPIServer(
 	return server
 }
 
+// SetCertificateMonitor registers the certificate expiry monitor whose latest
+// results are included in the config dump.
+func (s *APIServer) SetCertificateMonitor(monitor *certmonitor.Monitor) {
+	s.certificateMonitor = monitor
+}
+
+// SetAPIKeyWebhookNotifier sets the notifier that receives API key lifecycle events
+func (s *APIServer) SetAPIKeyWebhookNotifier(notifier *apikeywebhook.Notifier) {
+	s.apiKeyService.SetWebhookNotifier(notifier)
+}
+
 func (s *APIServer) getSubscriptionResourceService() *utils.SubscriptionResourceService {
 	if s.subscriptionResourceService != nil {
 		return s.subscriptionResourceService
@@ -207,6 +226,94 @@ func (s *APIServer) handleStatusUpdate(configID string, success bool, correlatio
 	}
 }
 
+// ReloadPolicyDefinitions swaps in a freshly loaded set of policy definitions
+// for the config dump and the MCP/LLM policy validator.
+func (s *APIServer) ReloadPolicyDefinitions(policyDefinitions map[string]models.PolicyDefinition) {
+	s.policyDefMu.Lock()
+	previous := s.policyDefinitions
+	s.policyDefinitions = policyDefinitions
+	s.policyDefMu.Unlock()
+	s.policyValidator.ReplaceDefinitions(policyDefinitions)
+	s.recordPolicyDefinitionChanges(previous, policyDefinitions)
+}
+
+// recordPolicyDefinitionChanges records the policy definitions added, modified
+// and removed by a reload in the store's change log.
+func (s *APIServer) recordPolicyDefinitionChanges(previous, current map[string]models.PolicyDefinition) {
+	keys := make([]string, 0, len(previous)+len(current))
+	for key := range previous {
+		keys = append(keys, key)
+	}
+	for key := range current {
+		if _, ok := previous[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		before, existed := previous[key]
+		after, exists := current[key]
+		switch {
+		case !existed:
+			s.store.RecordChange(storage.ChangeResourcePolicy, key, after.Name, storage.ChangeAdded, "")
+		case !exists:
+			s.store.RecordChange(storage.ChangeResourcePolicy, key, before.Name, storage.ChangeRemoved, "")
+		case !reflect.DeepEqual(before, after):
+			s.store.RecordChange(storage.ChangeResourcePolicy, key, after.Name, storage.ChangeModified, "")
+		}
+	}
+}
+
+// BuildConfigDumpDiffResponse builds the configuration changes made after
+// sinceVersion. It returns storage.ErrChangesExpired when they are no longer
+// retained.
+func (s *APIServer) BuildConfigDumpDiffResponse(sinceVersion int64) (*adminapi.ConfigDumpDiffResponse, error) {
+	changes, current, err := s.store.ChangesSince(sinceVersion)
+	if err != nil {
+		return nil, err
+	}
+	return newConfigDumpDiffResponse(sinceVersion, current, changes), nil
+}
+
+// BuildConfigDumpDiffResponseSinceTime builds the configuration changes made
+// after since. It returns storage.ErrChangesExpired when they are no longer
+// retained.
+func (s *APIServer) BuildConfigDumpDiffResponseSinceTime(since time.Time) (*adminapi.ConfigDumpDiffResponse, error) {
+	changes, sinceVersion, current, err := s.store.ChangesSinceTime(since)
+	if err != nil {
+		return nil, err
+	}
+	return newConfigDumpDiffResponse(sinceVersion, current, changes), nil
+}
+
+// newConfigDumpDiffResponse reports the net change of each resource.
+func newConfigDumpDiffResponse(since, current int64, changes []storage.ConfigChange) *adminapi.ConfigDumpDiffResponse {
+	net := storage.NetChanges(changes)
+	items := make([]adminapi.ConfigChange, 0, len(net))
+	for _, c := range net {
+		items = append(items, adminapi.NewConfigChange(c))
+	}
+	return &adminapi.ConfigDumpDiffResponse{
+		Status:          "success",
+		Since:           since,
+		ResourceVersion: current,
+		Changes:         items,
+	}
+}
+
+// SubscribeConfigChanges subscribes to the configuration changes recorded
+// from now on.
+func (s *APIServer) SubscribeConfigChanges() (*storage.ChangeSubscription, error) {
+	return s.store.SubscribeChanges()
+}
+
+// UnsubscribeConfigChanges cancels a subscription made with
+// SubscribeConfigChanges.
+func (s *APIServer) UnsubscribeConfigChanges(sub *storage.ChangeSubscription) {
+	s.store.UnsubscribeChanges(sub)
+}
+
 // GetXDSSyncStatus implements the GET /xds_sync_status endpoint.
 func (s *APIServer) GetXDSSyncStatus(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJSON(w, http.StatusOK, s.GetXDSSyncStatusResponse())
@@ -305,6 +412,8 @@ func (s *APIServer) SearchDeployments(w http.ResponseWriter, r *http.Request, ki
 		envelopeKey = "mcpProxies"
 	case "WebSubApi":
 		envelopeKey = "websubApis"
+	case models.KindGrpcApi:
+		envelopeKey = "grpcApis"
 	}
 
 	httputil.WriteJSON(w, http.StatusOK, map[string]any{
@@ -368,7 +477,22 @@ func (s *APIServer) GetConfigDump(w http.ResponseWriter, r *http.Request) {
 	log := middleware.GetLogger(r, s.logger)
 	log.Info("Retrieving configuration dump")
 
-	response, err := s.BuildConfigDumpResponse(log)
+	var params adminapi.GetConfigDumpParams
+	if kind := r.URL.Query().Get("kind"); kind != "" {
+		params.Kind = &kind
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		if !isConfigDumpStatus(status) {
+			httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+				Status:  "error",
+				Message: "status must be one of pending, deployed, failed or undeployed",
+			})
+			return
+		}
+		params.Status = &status
+	}
+
+	response, err := s.BuildConfigDumpResponse(log, params)
 	if err != nil {
 		log.Error("Failed to retrieve configuration dump", slog.Any("error", err))
 		httputil.WriteJSON(w, http.StatusInternalServerError, api.ErrorResponse{
@@ -400,15 +524,66 @@ func (s *APIServer) GetConfigDump(w http.ResponseWriter, r *http.Request) {
 		slog.Int("certificates", len(*response.Certificates)))
 }
 
-// BuildConfigDumpResponse builds the complete configuration dump response payload.
-func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigDumpResponse, error) {
+// isConfigDumpStatus reports whether status is one of the statuses reported
+// for APIs in a configuration dump.
+func isConfigDumpStatus(status string) bool {
+	switch adminapi.ConfigDumpAPIMetadataStatus(strings.ToLower(strings.TrimSpace(status))) {
+	case adminapi.Pending, adminapi.Deployed, adminapi.Failed, adminapi.Undeployed:
+		return true
+	}
+	return false
+}
+
+// configDumpFilter limits the APIs listed in a configuration dump. Empty
+// fields match every API.
+type configDumpFilter struct {
+	kind   string
+	status adminapi.ConfigDumpAPIMetadataStatus
+}
+
+func newConfigDumpFilter(params adminapi.GetConfigDumpParams) configDumpFilter {
+	var filter configDumpFilter
+	if params.Kind != nil {
+		filter.kind = strings.TrimSpace(*params.Kind)
+	}
+	if params.Status != nil {
+		filter.status = adminapi.ConfigDumpAPIMetadataStatus(strings.ToLower(strings.TrimSpace(*params.Status)))
+	}
+	return filter
+}
+
+func (f configDumpFilter) matches(kind string, status adminapi.ConfigDumpAPIMetadataStatus) bool {
+	if f.kind != "" && !strings.EqualFold(f.kind, kind) {
+		return false
+	}
+	return f.status == "" || f.status == status
+}
+
+// configDumpStatus converts the desired state of a configuration to the
+// admin API status type.
+func configDumpStatus(cfg *models.StoredConfig) adminapi.ConfigDumpAPIMetadataStatus {
+	switch cfg.DesiredState {
+	case models.StateDeployed:
+		return adminapi.Deployed
+	case models.StateUndeployed:
+		return adminapi.Undeployed
+	default:
+		return adminapi.Deployed
+	}
+}
+
+// BuildConfigDumpResponse builds the configuration dump response payload,
+// listing the APIs of the kind and status given in params, if any.
+func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger, params adminapi.GetConfigDumpParams) (*adminapi.ConfigDumpResponse, error) {
 	log.Info("Retrieving configuration dump")
+	filter := newConfigDumpFilter(params)
 
 	// Get all APIs
 	allConfigs := s.store.GetAll()
 
 	// Build API list with metadata using the generated types
 	apisSlice := make([]adminapi.ConfigDumpAPIItem, 0, len(allConfigs))
+	totalApisUnfiltered := 0
 
 	for _, cfg := range allConfigs {
 		// Use handle (metadata.name) as the id in the dump
@@ -418,15 +593,10 @@ func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigD
 			continue
 		}
 
-		// Convert desired state to the admin API status type
-		var status adminapi.ConfigDumpAPIMetadataStatus
-		switch cfg.DesiredState {
-		case models.StateDeployed:
-			status = adminapi.Deployed
-		case models.StateUndeployed:
-			status = adminapi.Undeployed
-		default:
-			status = adminapi.Deployed
+		totalApisUnfiltered++
+		status := configDumpStatus(cfg)
+		if !filter.matches(cfg.Kind, status) {
+			continue
 		}
 
 		configuration, err := toGenericMap(cfg.Configuration)
@@ -485,7 +655,7 @@ func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigD
 		totalBytes += len(cert.Certificate)
 
 		certStatus := "success"
-		certificates = append(certificates, adminapi.CertificateResponse{
+		item := adminapi.CertificateResponse{
 			Id:       &cert.UUID,
 			Name:     &cert.Name,
 			Subject:  &cert.Subject,
@@ -493,7 +663,18 @@ func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigD
 			NotAfter: &cert.NotAfter,
 			Count:    &cert.CertCount,
 			Status:   &certStatus,
-		})
+		}
+		if s.certificateMonitor != nil {
+			if monitored, ok := s.certificateMonitor.Status(cert.UUID); ok {
+				item.ExpiresInDays = ptr(monitored.ExpiresInDays)
+				item.ExpiringSoon = ptr(monitored.ExpiringSoon)
+				item.LastCheckedAt = ptr(monitored.CheckedAt)
+				if monitored.OCSPStatus != "" {
+					item.OcspStatus = ptr(monitored.OCSPStatus)
+				}
+			}
+		}
+		certificates = append(certificates, item)
 	}
 
 	// Calculate statistics
@@ -504,6 +685,8 @@ func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigD
 	timestamp := time.Now()
 	status := "success"
 	policyChainVersion := s.getPolicyChainVersionString()
+	routeVersions := s.getRouteVersions()
+	resourceVersion := s.store.GetResourceVersion()
 
 	// Build response
 	response := &adminapi.ConfigDumpResponse{
@@ -514,23 +697,46 @@ func (s *APIServer) BuildConfigDumpResponse(log *slog.Logger) (*adminapi.ConfigD
 		Certificates: &certificates,
 		Statistics: &struct {
 			TotalApis             *int `json:"totalApis,omitempty" yaml:"totalApis,omitempty"`
+			TotalApisUnfiltered   *int `json:"totalApisUnfiltered,omitempty" yaml:"totalApisUnfiltered,omitempty"`
 			TotalCertificateBytes *int `json:"totalCertificateBytes,omitempty" yaml:"totalCertificateBytes,omitempty"`
 			TotalCertificates     *int `json:"totalCertificates,omitempty" yaml:"totalCertificates,omitempty"`
 			TotalPolicies         *int `json:"totalPolicies,omitempty" yaml:"totalPolicies,omitempty"`
 		}{
 			TotalApis:             &totalApis,
+			TotalApisUnfiltered:   &totalApisUnfiltered,
 			TotalPolicies:         &totalPolicies,
 			TotalCertificates:     &totalCertificates,
 			TotalCertificateBytes: &totalBytes,
 		},
 		XdsSync: &adminapi.ConfigDumpXDSSync{
 			PolicyChainVersion: &policyChainVersion,
+			Routes:             &routeVersions,
 		},
+		ResourceVersion: &resourceVersion,
 	}
 
 	return response, nil
 }
 
+// getRouteVersions returns the per-route policy chain hashes sorted by route key.
+func (s *APIServer) getRouteVersions() []adminapi.ConfigDumpRouteVersion {
+	routes := make([]adminapi.ConfigDumpRouteVersion, 0)
+	if s.policyManager == nil {
+		return routes
+	}
+	for key, rv := range s.policyManager.GetRouteVersions() {
+		routes = append(routes, adminapi.ConfigDumpRouteVersion{
+			RouteKey: ptr(key),
+			Hash:     ptr(rv.Hash),
+			Version:  ptr(strconv.FormatInt(rv.Version, 10)),
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return *routes[i].RouteKey < *routes[j].RouteKey
+	})
+	return routes
+}
+
 func (s *APIServer) getPolicyChainVersionString() string {
 	if s.policyManager == nil {
 		return "0"
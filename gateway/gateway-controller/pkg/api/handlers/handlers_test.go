@@ -26,9 +26,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -42,6 +44,7 @@ import (
 	adminapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/admin"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/middleware"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certmonitor"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
@@ -49,6 +52,7 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/policyxds"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/service/restapi"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 )
 
@@ -335,6 +339,16 @@ func (m *MockStorage) SaveAPIKey(apiKey *models.APIKey) error {
 	return nil
 }
 
+func (m *MockStorage) SaveAPIKeys(apiKeys []*models.APIKey) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	for _, apiKey := range apiKeys {
+		m.apiKeys[apiKey.UUID] = cloneAPIKey(apiKey)
+	}
+	return nil
+}
+
 func (m *MockStorage) UpsertAPIKey(apiKey *models.APIKey) error {
 	if m.saveErr != nil {
 		return m.saveErr
@@ -390,6 +404,15 @@ func (m *MockStorage) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) {
 	return result, nil
 }
 
+func (m *MockStorage) ListAPIKeysByAPI(apiId string, filter storage.APIKeyListFilter) ([]*models.APIKey, int, error) {
+	keys, err := m.GetAPIKeysByAPI(apiId)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, total := storage.FilterAPIKeys(keys, filter)
+	return page, total, nil
+}
+
 func (m *MockStorage) GetAllAPIKeys() ([]*models.APIKey, error) {
 	if m.getErr != nil {
 		return nil, m.getErr
@@ -434,6 +457,39 @@ func (m *MockStorage) UpdateAPIKey(apiKey *models.APIKey) error {
 	return nil
 }
 
+func (m *MockStorage) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+func (m *MockStorage) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockStorage) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	result := make([]*models.APIKey, 0)
+	for _, key := range m.apiKeys {
+		if key.Status == models.APIKeyStatusActive && key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+			result = append(result, cloneAPIKey(key))
+		}
+	}
+	return result, nil
+}
+
+func (m *MockStorage) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	if m.updateErr != nil {
+		return false, m.updateErr
+	}
+	stored, ok := m.apiKeys[apiKey.UUID]
+	if !ok || stored.Status != models.APIKeyStatusActive || stored.APIKey != apiKey.APIKey {
+		return false, nil
+	}
+	stored.Status = models.APIKeyStatusRevoked
+	stored.UpdatedAt = apiKey.UpdatedAt
+	return true, nil
+}
+
 func (m *MockStorage) DeleteAPIKey(key string) error {
 	if m.deleteErr != nil {
 		return m.deleteErr
@@ -1659,6 +1715,132 @@ func TestGetConfigDump(t *testing.T) {
 	assert.Equal(t, "0", *response.XdsSync.PolicyChainVersion)
 }
 
+// configDumpTestServer returns a server holding two deployed REST APIs, an
+// undeployed REST API and an undeployed LLM provider.
+func configDumpTestServer(t *testing.T) *APIServer {
+	t.Helper()
+	server := createTestAPIServer()
+	configs := []struct {
+		id    string
+		kind  string
+		state models.DesiredState
+	}{
+		{"0000-rest-deployed-1-0000-00000000", "RestApi", models.StateDeployed},
+		{"0000-rest-deployed-2-0000-00000000", "RestApi", models.StateDeployed},
+		{"0000-rest-undeployed-0000-00000000", "RestApi", models.StateUndeployed},
+		{"0000-llm-undeployed-0000-000000000", "LlmProvider", models.StateUndeployed},
+	}
+	for i, c := range configs {
+		cfg := createTestStoredConfig(c.id, c.id, "v1.0.0", fmt.Sprintf("/dump-%d", i))
+		cfg.Kind = c.kind
+		cfg.DesiredState = c.state
+		require.NoError(t, server.store.Add(cfg))
+	}
+	return server
+}
+
+// getFilteredConfigDump calls the config dump endpoint with query and
+// returns the statuses of the listed APIs along with the statistics.
+func getFilteredConfigDump(t *testing.T, server *APIServer, query string) ([]adminapi.ConfigDumpAPIMetadataStatus, int, int) {
+	t.Helper()
+	w, r := createTestContext("GET", "/config_dump?"+query, nil)
+	server.GetConfigDump(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var response adminapi.ConfigDumpResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	statuses := make([]adminapi.ConfigDumpAPIMetadataStatus, 0, len(*response.Apis))
+	for _, item := range *response.Apis {
+		statuses = append(statuses, *item.Metadata.Status)
+	}
+	return statuses, *response.Statistics.TotalApis, *response.Statistics.TotalApisUnfiltered
+}
+
+func TestGetConfigDumpKindFilter(t *testing.T) {
+	server := configDumpTestServer(t)
+
+	statuses, total, unfiltered := getFilteredConfigDump(t, server, "kind=RestApi")
+	assert.Len(t, statuses, 3)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 4, unfiltered)
+
+	statuses, total, unfiltered = getFilteredConfigDump(t, server, "kind=llmprovider")
+	assert.Equal(t, []adminapi.ConfigDumpAPIMetadataStatus{adminapi.Undeployed}, statuses)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, 4, unfiltered)
+
+	statuses, total, _ = getFilteredConfigDump(t, server, "kind=Mcp")
+	assert.Empty(t, statuses)
+	assert.Equal(t, 0, total)
+}
+
+func TestGetConfigDumpStatusFilter(t *testing.T) {
+	server := configDumpTestServer(t)
+
+	statuses, total, unfiltered := getFilteredConfigDump(t, server, "status=deployed")
+	assert.Equal(t, []adminapi.ConfigDumpAPIMetadataStatus{adminapi.Deployed, adminapi.Deployed}, statuses)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 4, unfiltered)
+
+	statuses, total, _ = getFilteredConfigDump(t, server, "status=Undeployed")
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, 2, total)
+
+	statuses, _, _ = getFilteredConfigDump(t, server, "status=failed")
+	assert.Empty(t, statuses)
+
+	w, r := createTestContext("GET", "/config_dump?status=broken", nil)
+	server.GetConfigDump(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetConfigDumpKindAndStatusFilter(t *testing.T) {
+	server := configDumpTestServer(t)
+
+	statuses, total, unfiltered := getFilteredConfigDump(t, server, "kind=RestApi&status=undeployed")
+	assert.Equal(t, []adminapi.ConfigDumpAPIMetadataStatus{adminapi.Undeployed}, statuses)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, 4, unfiltered)
+
+	statuses, total, _ = getFilteredConfigDump(t, server, "kind=LlmProvider&status=deployed")
+	assert.Empty(t, statuses)
+	assert.Equal(t, 0, total)
+
+	statuses, total, unfiltered = getFilteredConfigDump(t, server, "")
+	assert.Len(t, statuses, 4)
+	assert.Equal(t, 4, total)
+	assert.Equal(t, 4, unfiltered)
+}
+
+func TestGetConfigDumpWeightedUpstream(t *testing.T) {
+	server := createTestAPIServer()
+
+	cfg := createTestStoredConfig("0000-test-handle-0000-000000000000", "Orders", "v1.0.0", "/orders")
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Upstream.Main = api.Upstream{Targets: &[]api.UpstreamTarget{
+		{Url: "http://orders-v1:8080", Weight: 90},
+		{Url: "http://orders-v2:8080", Weight: 10},
+	}}
+	cfg.Configuration = restAPI
+	require.NoError(t, server.store.Add(cfg))
+
+	w, r := createTestContext("GET", "/config_dump", nil)
+	server.GetConfigDump(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response adminapi.ConfigDumpResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotNil(t, response.Apis)
+	require.Len(t, *response.Apis, 1)
+	configuration := *(*response.Apis)[0].Configuration
+	spec := configuration["spec"].(map[string]interface{})
+	main := spec["upstream"].(map[string]interface{})["main"].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"url": "http://orders-v1:8080", "weight": float64(90)},
+		map[string]interface{}{"url": "http://orders-v2:8080", "weight": float64(10)},
+	}, main["targets"])
+}
+
 func TestGetXDSSyncStatus(t *testing.T) {
 	server := createTestAPIServer()
 
@@ -1722,6 +1904,45 @@ func TestGetConfigDumpWithCertificates(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+// TestGetConfigDumpWithCertificateMonitor tests that expiry monitor results are included in the config dump
+func TestGetConfigDumpWithCertificateMonitor(t *testing.T) {
+	server := createTestAPIServer()
+	mockDB := server.db.(*MockStorage)
+	mockDB.certs = []*models.StoredCertificate{
+		{
+			UUID:        "0000-cert-1-0000-000000000000",
+			Name:        "expiring-cert",
+			NotAfter:    time.Now().Add(5*24*time.Hour + time.Hour),
+			Certificate: []byte("cert-data"),
+			CertCount:   1,
+		},
+	}
+
+	monitor := certmonitor.NewMonitor(config.CertificateExpiryMonitorConfig{
+		Enabled:          true,
+		CheckInterval:    time.Hour,
+		WarningThreshold: 30 * 24 * time.Hour,
+	}, mockDB, server.logger)
+	monitor.Start()
+	require.Eventually(t, func() bool {
+		_, ok := monitor.Status("0000-cert-1-0000-000000000000")
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	monitor.Stop()
+	server.SetCertificateMonitor(monitor)
+
+	response, err := server.BuildConfigDumpResponse(server.logger, adminapi.GetConfigDumpParams{})
+	require.NoError(t, err)
+	require.Len(t, *response.Certificates, 1)
+	cert := (*response.Certificates)[0]
+	require.NotNil(t, cert.ExpiresInDays)
+	assert.Equal(t, 5, *cert.ExpiresInDays)
+	require.NotNil(t, cert.ExpiringSoon)
+	assert.True(t, *cert.ExpiringSoon)
+	assert.NotNil(t, cert.LastCheckedAt)
+	assert.Nil(t, cert.OcspStatus, "OCSP is disabled")
+}
+
 // TestGetConfigDumpDBError tests config dump with database error
 func TestGetConfigDumpDBError(t *testing.T) {
 	server := createTestAPIServer()
@@ -1794,7 +2015,7 @@ func TestCreateRestAPIDBError(t *testing.T) {
 		"Content-Type": "application/json",
 	})
 
-	server.CreateRestAPI(w, r)
+	server.CreateRestAPI(w, r, api.CreateRestAPIParams{})
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	assert.Empty(t, mockHub.publishedEvents)
@@ -1806,6 +2027,292 @@ func TestCreateRestAPIDBError(t *testing.T) {
 	assert.NotContains(t, w.Body.String(), "db write error")
 }
 
+func TestCreateRestAPIContextConflict(t *testing.T) {
+	server := createTestAPIServer()
+	mockDB := server.db.(*MockStorage)
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	require.NoError(t, mockDB.SaveConfig(createTestStoredConfig("weather-api", "Weather", "v1.0", "/weather")))
+
+	create := func(handle, version string) *httptest.ResponseRecorder {
+		body := createTestRestAPIRequestBody(t, handle, handle, version, "/weather")
+		w, r := createTestContextWithHeader("POST", "/rest-apis", body, map[string]string{
+			"Content-Type": "application/json",
+		})
+		server.CreateRestAPI(w, r, api.CreateRestAPIParams{})
+		return w
+	}
+
+	w := create("weather-copy", "v1.0")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var response api.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Contains(t, response.Message, "already used by API 'weather-api'")
+
+	// Another version of the API may reuse the context
+	w = create("weather-next", "v2.0")
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+}
+
+func TestUpdateRestAPIContextConflict(t *testing.T) {
+	server := createTestAPIServer()
+	mockDB := server.db.(*MockStorage)
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	require.NoError(t, mockDB.SaveConfig(createTestStoredConfig("weather-api", "Weather", "v1.0", "/weather")))
+	require.NoError(t, mockDB.SaveConfig(createTestStoredConfig("forecast-api", "Forecast", "v1.0", "/forecast")))
+
+	update := func(contextPath string) *httptest.ResponseRecorder {
+		body := createTestRestAPIRequestBody(t, "forecast-api", "Forecast", "v1.0", contextPath)
+		w, r := createTestContextWithHeader("PUT", "/rest-apis/forecast-api", body, map[string]string{
+			"Content-Type": "application/json",
+		})
+		server.UpdateRestAPI(w, r, "forecast-api", api.UpdateRestAPIParams{})
+		return w
+	}
+
+	w := update("/weather")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "already used by API 'weather-api'")
+
+	// Keeping its own context is not a conflict with itself
+	w = update("/forecast")
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
+
+// invalidRestAPIBody returns a RestApi with several problems: an invalid
+// version, no context, an invalid upstream URL and an operation without a
+// method.
+func invalidRestAPIBody(handle string) []byte {
+	return []byte(`{
+		"apiVersion": "gateway.api-platform.wso2.com/v1",
+		"kind": "RestApi",
+		"metadata": {"name": "` + handle + `"},
+		"spec": {
+			"displayName": "Invalid",
+			"version": "latest",
+			"upstream": {"main": {"url": "not a url"}},
+			"operations": [
+				{"method": "GET", "path": "/ok"},
+				{"path": "/missing-method"}
+			]
+		}
+	}`)
+}
+
+// validationErrorFields decodes a 422 response and returns the reported
+// fields.
+func validationErrorFields(t *testing.T, w *httptest.ResponseRecorder) []string {
+	t.Helper()
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code, w.Body.String())
+
+	var response api.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Configuration validation failed", response.Message)
+	require.NotNil(t, response.Errors)
+
+	var fields []string
+	for _, e := range *response.Errors {
+		require.NotNil(t, e.Field)
+		require.NotNil(t, e.Message)
+		assert.NotEmpty(t, *e.Message)
+		fields = append(fields, *e.Field)
+	}
+	return fields
+}
+
+func TestCreateRestAPIReportsAllValidationErrors(t *testing.T) {
+	server := createTestAPIServer()
+	mockHub := &mockEventHub{}
+	attachTestEventHub(server, mockHub, "test-gateway")
+
+	w, r := createTestContextWithHeader("POST", "/rest-apis", invalidRestAPIBody("invalid-api"), map[string]string{
+		"Content-Type": "application/json",
+	})
+	server.CreateRestAPI(w, r, api.CreateRestAPIParams{})
+
+	fields := validationErrorFields(t, w)
+	assert.Subset(t, fields, []string{
+		"/spec/version",
+		"/spec/context",
+		"/spec/upstream/main/url",
+		"/spec/operations/1/method",
+	})
+	assert.Empty(t, mockHub.publishedEvents)
+}
+
+func TestUpdateRestAPIReportsAllValidationErrors(t *testing.T) {
+	server := createTestAPIServer()
+	mockDB := server.db.(*MockStorage)
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	require.NoError(t, mockDB.SaveConfig(createTestStoredConfig("invalid-api", "Invalid", "v1.0", "/invalid")))
+
+	w, r := createTestContextWithHeader("PUT", "/rest-apis/invalid-api", invalidRestAPIBody("invalid-api"), map[string]string{
+		"Content-Type": "application/json",
+	})
+	server.UpdateRestAPI(w, r, "invalid-api", api.UpdateRestAPIParams{})
+
+	fields := validationErrorFields(t, w)
+	assert.Subset(t, fields, []string{
+		"/spec/version",
+		"/spec/context",
+		"/spec/upstream/main/url",
+		"/spec/operations/1/method",
+	})
+}
+
+// restAPIBodyWithUpstreams returns a REST API body routing to the given main
+// and sandbox upstream URLs.
+func restAPIBodyWithUpstreams(handle, mainURL, sandboxURL string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"apiVersion": "gateway.api-platform.wso2.com/v1",
+		"kind": "RestApi",
+		"metadata": {"name": %q},
+		"spec": {
+			"displayName": %q,
+			"version": "v1.0",
+			"context": "/%s",
+			"upstream": {"main": {"url": %q}, "sandbox": {"url": %q}},
+			"operations": [{"method": "GET", "path": "/books"}]
+		}
+	}`, handle, handle, handle, mainURL, sandboxURL))
+}
+
+// startProbedUpstream starts an upstream that refuses HEAD, as some backends
+// do, and counts the requests it receives.
+func startProbedUpstream(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var methods []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream, &methods
+}
+
+// closedPortURL returns a URL nothing listens on.
+func closedPortURL(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	return "http://" + addr
+}
+
+func decodeUpstreamProbes(t *testing.T, w *httptest.ResponseRecorder) []api.UpstreamProbeResult {
+	t.Helper()
+	var response api.RestAPI
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	if response.UpstreamProbes == nil {
+		return nil
+	}
+	return *response.UpstreamProbes
+}
+
+func TestCreateRestAPIValidateUpstream(t *testing.T) {
+	server := createTestAPIServer()
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	upstream, methods := startProbedUpstream(t)
+	unreachable := closedPortURL(t)
+
+	body := restAPIBodyWithUpstreams("probed-api", upstream.URL+"/books?token=secret", unreachable)
+	w, r := createTestContextWithHeader("POST", "/rest-apis?validateUpstream=true", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	server.CreateRestAPI(w, r, api.CreateRestAPIParams{ValidateUpstream: api.Ptr(true)})
+
+	// An unreachable upstream is reported, not rejected
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	probes := decodeUpstreamProbes(t, w)
+	require.Len(t, probes, 2)
+
+	// Both upstreams are loopback addresses, so neither is probed
+	assert.Equal(t, api.Main, probes[0].Upstream)
+	assert.Equal(t, upstream.URL+"/books", probes[0].Url, "the query string is not echoed")
+	assert.False(t, probes[0].Reachable)
+	assert.Nil(t, probes[0].StatusCode)
+	assert.Nil(t, probes[0].LatencyMs)
+	require.NotNil(t, probes[0].Error)
+	assert.Equal(t, "unreachable", *probes[0].Error)
+	assert.Empty(t, *methods)
+
+	assert.Equal(t, api.Sandbox, probes[1].Upstream)
+	assert.Equal(t, unreachable, probes[1].Url)
+	assert.False(t, probes[1].Reachable)
+	assert.Nil(t, probes[1].StatusCode)
+	require.NotNil(t, probes[1].Error)
+	assert.Equal(t, "unreachable", *probes[1].Error)
+}
+
+func TestCreateRestAPISkipsUpstreamProbeByDefault(t *testing.T) {
+	server := createTestAPIServer()
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	upstream, methods := startProbedUpstream(t)
+
+	body := restAPIBodyWithUpstreams("unprobed-api", upstream.URL, closedPortURL(t))
+	w, r := createTestContextWithHeader("POST", "/rest-apis", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	server.CreateRestAPI(w, r, api.CreateRestAPIParams{})
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Nil(t, decodeUpstreamProbes(t, w))
+	assert.Empty(t, *methods)
+}
+
+func TestUpdateRestAPIUpstreamProbeConfig(t *testing.T) {
+	server := createTestAPIServer()
+	server.systemConfig.Controller.Server.UpstreamProbe.Enabled = true
+	mockDB := server.db.(*MockStorage)
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+	require.NoError(t, mockDB.SaveConfig(createTestStoredConfig("probed-api", "probed-api", "v1.0", "/probed-api")))
+	upstream, _ := startProbedUpstream(t)
+
+	update := func(params api.UpdateRestAPIParams) []api.UpstreamProbeResult {
+		body := restAPIBodyWithUpstreams("probed-api", upstream.URL, closedPortURL(t))
+		w, r := createTestContextWithHeader("PUT", "/rest-apis/probed-api", body, map[string]string{
+			"Content-Type": "application/json",
+		})
+		server.UpdateRestAPI(w, r, "probed-api", params)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return decodeUpstreamProbes(t, w)
+	}
+
+	probes := update(api.UpdateRestAPIParams{})
+	require.Len(t, probes, 2, "enabled in the configuration")
+	assert.False(t, probes[0].Reachable, "loopback upstreams are not probed")
+	assert.False(t, probes[1].Reachable)
+
+	assert.Nil(t, update(api.UpdateRestAPIParams{ValidateUpstream: api.Ptr(false)}), "the request opts out")
+}
+
+func TestCreateRestAPIUnresolvedEnvReference(t *testing.T) {
+	server := createTestAPIServer()
+	mockHub := &mockEventHub{}
+	attachTestEventHub(server, mockHub, "test-gateway")
+	templateengine.SetEnvAllowlist([]string{"BOOKS_BACKEND_URL"})
+	t.Cleanup(func() { templateengine.SetEnvAllowlist(nil) })
+
+	body := restAPIBodyWithUpstreams("env-api", "${BOOKS_BACKEND_URL}", "http://sandbox.example.com")
+	w, r := createTestContextWithHeader("POST", "/rest-apis", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	server.CreateRestAPI(w, r, api.CreateRestAPIParams{})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Empty(t, mockHub.publishedEvents)
+	var response api.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, `environment variable "BOOKS_BACKEND_URL" referenced in spec.upstream.main.url is not set`, response.Message)
+}
+
 // TestUpdateRestAPIInvalidBody tests UpdateRestAPI with invalid request body
 // Note: This test requires the validator to return errors but the parser
 // fails first due to nil pointer issues, so we skip it
@@ -1832,7 +2339,7 @@ func TestUpdateRestAPINotFound(t *testing.T) {
 	w, r := createTestContextWithHeader("PUT", "/rest-apis/nonexistent", body, map[string]string{
 		"Content-Type": "application/json",
 	})
-	server.UpdateRestAPI(w, r, "nonexistent")
+	server.UpdateRestAPI(w, r, "nonexistent", api.UpdateRestAPIParams{})
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
@@ -2319,6 +2826,109 @@ func TestCreateAPIKeyDBError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCreateAPIKeysBulk(t *testing.T) {
+	server := createTestAPIServer()
+	cfg := seedAPIForAPIKeyHandlerTests(t, server, "test-handle")
+	mockDB := server.db.(*MockStorage)
+	mockHub := &mockEventHub{}
+	attachTestEventHub(server, mockHub, "test-gateway")
+
+	body := []byte(`{"apiKeys": [{"name": "partner-a"}, {"name": "partner-b"}]}`)
+	w, r := createTestContextWithHeader("POST", "/rest-apis/test-handle/api-keys/bulk", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	r = withAuthContext(r, commonmodels.AuthContext{
+		UserID: "test-user",
+		Roles:  []string{"admin"},
+	})
+
+	server.CreateAPIKeysBulk(w, r, "test-handle")
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var response api.APIKeyBulkCreationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Results, 2)
+	for i, item := range response.Results {
+		assert.Equal(t, i, item.Index)
+		assert.Equal(t, api.Created, item.Status)
+		require.NotNil(t, item.ApiKey)
+		require.NotNil(t, item.ApiKey.ApiKey, "generated keys are returned once")
+	}
+	assert.Len(t, mockHub.publishedEvents, 2)
+
+	for _, name := range []string{"partner-a", "partner-b"} {
+		_, err := mockDB.GetAPIKeysByAPIAndName(cfg.UUID, name)
+		require.NoError(t, err)
+	}
+}
+
+func TestCreateAPIKeysBulkInvalidItemCreatesNothing(t *testing.T) {
+	server := createTestAPIServer()
+	cfg := seedAPIForAPIKeyHandlerTests(t, server, "test-handle")
+	mockDB := server.db.(*MockStorage)
+	mockHub := &mockEventHub{}
+	attachTestEventHub(server, mockHub, "test-gateway")
+
+	body := []byte(`{"apiKeys": [{"name": "partner-a"}, {"name": "Not A Valid Name"}, {"name": "partner-c"}]}`)
+	w, r := createTestContextWithHeader("POST", "/rest-apis/test-handle/api-keys/bulk", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	r = withAuthContext(r, commonmodels.AuthContext{
+		UserID: "test-user",
+		Roles:  []string{"admin"},
+	})
+
+	server.CreateAPIKeysBulk(w, r, "test-handle")
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var response api.APIKeyBulkCreationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Results, 3)
+	assert.Equal(t, api.NotCreated, response.Results[0].Status)
+	assert.Equal(t, api.Failed, response.Results[1].Status)
+	assert.Equal(t, api.NotCreated, response.Results[2].Status)
+	assert.Empty(t, mockHub.publishedEvents)
+
+	_, err := mockDB.GetAPIKeysByAPIAndName(cfg.UUID, "partner-a")
+	require.Error(t, err)
+}
+
+func TestCreateAPIKeyRateLimited(t *testing.T) {
+	server := createTestAPIServer()
+	seedAPIForAPIKeyHandlerTests(t, server, "test-handle")
+	server.systemConfig.APIKey.CreationRateLimit = config.APIKeyCreationRateLimitConfig{MaxKeys: 2, Window: time.Hour}
+	attachTestEventHub(server, &mockEventHub{}, "test-gateway")
+
+	create := func(name string) *httptest.ResponseRecorder {
+		body := []byte(fmt.Sprintf(`{"name": %q}`, name))
+		w, r := createTestContextWithHeader("POST", "/rest-apis/test-handle/api-keys", body, map[string]string{
+			"Content-Type": "application/json",
+		})
+		r = withAuthContext(r, commonmodels.AuthContext{UserID: "test-user", Roles: []string{"consumer"}})
+		server.CreateAPIKey(w, r, "test-handle")
+		return w
+	}
+
+	require.Equal(t, http.StatusCreated, create("key-one").Code)
+	require.Equal(t, http.StatusCreated, create("key-two").Code)
+
+	w := create("key-three")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	assert.True(t, retryAfter > 0 && retryAfter <= 3600, "Retry-After = %d", retryAfter)
+
+	// Bulk creation is limited by the same budget
+	body := []byte(`{"apiKeys": [{"name": "key-four"}]}`)
+	w, r := createTestContextWithHeader("POST", "/rest-apis/test-handle/api-keys/bulk", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+	r = withAuthContext(r, commonmodels.AuthContext{UserID: "test-user", Roles: []string{"consumer"}})
+	server.CreateAPIKeysBulk(w, r, "test-handle")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
 // TestRevokeAPIKeyNoAuth tests RevokeAPIKey without authentication
 func TestRevokeAPIKeyNoAuth(t *testing.T) {
 	server := createTestAPIServer()
@@ -2426,7 +3036,7 @@ func TestListAPIKeysNoAuth(t *testing.T) {
 	server := createTestAPIServer()
 
 	w, r := createTestContext("GET", "/rest-apis/test-handle/api-keys", nil)
-	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000")
+	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000", api.ListAPIKeysParams{})
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 }
@@ -3854,7 +4464,7 @@ func TestUpdateRestAPIDBError(t *testing.T) {
 		"Content-Type": "application/json",
 	})
 
-	server.UpdateRestAPI(w, r, "test-handle")
+	server.UpdateRestAPI(w, r, "test-handle", api.UpdateRestAPIParams{})
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	assert.Empty(t, mockHub.publishedEvents)
@@ -3881,7 +4491,7 @@ func TestUpdateRestAPISyncsDisplayNameAndVersion(t *testing.T) {
 		"Content-Type": "application/json",
 	})
 
-	server.UpdateRestAPI(w, r, "test-handle")
+	server.UpdateRestAPI(w, r, "test-handle", api.UpdateRestAPIParams{})
 
 	require.Equal(t, http.StatusOK, w.Code)
 
@@ -4141,3 +4751,119 @@ func TestPolicyRemovalErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestImportOpenAPI(t *testing.T) {
+	server := createTestAPIServer()
+	mockHub := &mockEventHub{}
+	attachTestEventHub(server, mockHub, "test-gateway")
+
+	body := []byte(`{
+		"openapi": "3.0.3",
+		"info": {"title": "Petstore", "version": "1.0.0"},
+		"servers": [{"url": "https://petstore.example.com/v1"}],
+		"paths": {
+			"/pets": {"get": {"responses": {"200": {"description": "ok"}}}},
+			"/pets/{petId}": {
+				"parameters": [{"name": "petId", "in": "path", "required": true, "schema": {"type": "string"}}],
+				"get": {"responses": {"200": {"description": "ok"}}}
+			}
+		}
+	}`)
+	w, r := createTestContextWithHeader("POST", "/rest-apis/import/openapi", body, map[string]string{
+		"Content-Type": "application/json",
+	})
+
+	server.ImportOpenAPI(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var response api.OpenAPIImportResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "success", response.Status)
+	assert.Equal(t, "petstore-1.0.0", response.Api.Metadata.Name)
+	assert.Equal(t, "/v1", response.Api.Spec.Context)
+	require.Len(t, response.Api.Spec.Operations, 2)
+	assert.Equal(t, "/pets/{petId}", response.Api.Spec.Operations[1].EffectivePath())
+	assert.NotNil(t, response.Warnings)
+
+	// The draft is not deployed
+	assert.Empty(t, mockHub.publishedEvents)
+	assert.Empty(t, server.store.GetAll())
+}
+
+func TestImportOpenAPIInvalidDocument(t *testing.T) {
+	server := createTestAPIServer()
+
+	w, r := createTestContextWithHeader("POST", "/rest-apis/import/openapi", []byte(`swagger: "2.0"`), map[string]string{
+		"Content-Type": "application/yaml",
+	})
+	server.ImportOpenAPI(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	large := bytes.Repeat([]byte(" "), restapi.MaxOpenAPIImportSize+1)
+	w, r = createTestContextWithHeader("POST", "/rest-apis/import/openapi", large, map[string]string{
+		"Content-Type": "application/yaml",
+	})
+	server.ImportOpenAPI(w, r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestExportOpenAPI(t *testing.T) {
+	server := createTestAPIServer()
+	seedAPIForAPIKeyHandlerTests(t, server, "test-handle")
+
+	w, r := createTestContextWithHeader("GET", "/rest-apis/test-handle/openapi", nil, nil)
+	server.ExportOpenAPI(w, r, "test-handle")
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	paths, ok := doc["paths"].(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, paths, "/resource")
+	assert.Equal(t, map[string]any{"main": map[string]any{"url": "http://backend.example.com"}}, doc["x-wso2-upstream"])
+
+	w, r = createTestContextWithHeader("GET", "/rest-apis/missing/openapi", nil, nil)
+	server.ExportOpenAPI(w, r, "missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestBuildConfigDumpDiffResponse_PolicyDefinitionChanges(t *testing.T) {
+	store := storage.NewConfigStore()
+	server := &APIServer{store: store}
+
+	server.recordPolicyDefinitionChanges(nil, map[string]models.PolicyDefinition{
+		"cors|v1.0.0":       {Name: "cors", Version: "v1.0.0"},
+		"basic-auth|v1.0.0": {Name: "basic-auth", Version: "v1.0.0"},
+	})
+	since := store.GetResourceVersion()
+
+	server.recordPolicyDefinitionChanges(
+		map[string]models.PolicyDefinition{
+			"cors|v1.0.0":       {Name: "cors", Version: "v1.0.0"},
+			"basic-auth|v1.0.0": {Name: "basic-auth", Version: "v1.0.0"},
+		},
+		map[string]models.PolicyDefinition{
+			"cors|v1.0.0":  {Name: "cors", Version: "v1.0.0", DisplayName: "CORS"},
+			"quota|v1.0.0": {Name: "quota", Version: "v1.0.0"},
+		})
+
+	resp, err := server.BuildConfigDumpDiffResponse(since)
+	require.NoError(t, err)
+	assert.Equal(t, since, resp.Since)
+	assert.Equal(t, store.GetResourceVersion(), resp.ResourceVersion)
+
+	actions := map[string]adminapi.ConfigChangeAction{}
+	for _, c := range resp.Changes {
+		assert.Equal(t, adminapi.ConfigChangeResource("policy"), c.Resource)
+		actions[c.Id] = c.Action
+	}
+	assert.Equal(t, map[string]adminapi.ConfigChangeAction{
+		"basic-auth|v1.0.0": "removed",
+		"cors|v1.0.0":       "modified",
+		"quota|v1.0.0":      "added",
+	}, actions)
+
+	_, err = server.BuildConfigDumpDiffResponse(store.GetResourceVersion() + 1)
+	assert.ErrorIs(t, err, storage.ErrChangesExpired)
+}
@@ -21,15 +21,20 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine/funcs"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 	"github.com/wso2/go-httpkit/httputil"
 )
 
@@ -66,6 +71,13 @@ func statusPtr(s string) *string {
 	return &s
 }
 
+// skipControlPlanePush reports whether the request carries the
+// X-Skip-ControlPlane-Push header set to a true value.
+func skipControlPlanePush(r *http.Request) bool {
+	skip, err := strconv.ParseBool(strings.TrimSpace(r.Header.Get(constants.SkipControlPlanePushHeader)))
+	return err == nil && skip
+}
+
 func intPtr(i int) *int {
 	return &i
 }
@@ -88,6 +100,14 @@ func mapRenderError(w http.ResponseWriter, operation string, err error) bool {
 		})
 		return true
 	}
+	var envErr *templateengine.EnvVarError
+	if errors.As(renderErr, &envErr) {
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+			Status:  "error",
+			Message: envErr.Error(),
+		})
+		return true
+	}
 	var tmplParseErr *templateengine.TemplateParseError
 	if errors.As(renderErr, &tmplParseErr) {
 		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
@@ -102,3 +122,19 @@ func mapRenderError(w http.ResponseWriter, operation string, err error) bool {
 	})
 	return true
 }
+
+// mapAPIKeyRateLimitError checks whether err is an API key creation rate limit rejection and,
+// if so, writes a 429 response with a Retry-After header in whole seconds and returns true.
+func mapAPIKeyRateLimitError(w http.ResponseWriter, err error) bool {
+	var rateLimitErr *utils.APIKeyRateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		return false
+	}
+	retryAfter := int64(math.Ceil(rateLimitErr.RetryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.FormatInt(max(retryAfter, 1), 10))
+	httputil.WriteJSON(w, http.StatusTooManyRequests, api.ErrorResponse{
+		Status:  "error",
+		Message: "Too many API keys created; try again later",
+	})
+	return true
+}
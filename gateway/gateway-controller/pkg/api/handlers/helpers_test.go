@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 )
 
 func TestUuidToOpenAPIUUID(t *testing.T) {
@@ -164,3 +168,30 @@ func TestIntPtr(t *testing.T) {
 		})
 	}
 }
+
+func TestSkipControlPlanePush(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "header absent", header: "", want: false},
+		{name: "true", header: "true", want: true},
+		{name: "numeric true", header: "1", want: true},
+		{name: "padded true", header: " TRUE ", want: true},
+		{name: "false", header: "false", want: false},
+		{name: "invalid value", header: "yes please", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/rest-apis", nil)
+			if tt.header != "" {
+				req.Header.Set(constants.SkipControlPlanePushHeader, tt.header)
+			}
+			if got := skipControlPlanePush(req); got != tt.want {
+				t.Errorf("skipControlPlanePush() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -96,7 +96,7 @@ func TestListAPIKeysSuccess(t *testing.T) {
 		Roles:  []string{"admin"},
 	})
 
-	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000")
+	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000", api.ListAPIKeysParams{})
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
@@ -106,6 +106,88 @@ func TestListAPIKeysSuccess(t *testing.T) {
 	assert.Equal(t, "success", response["status"])
 }
 
+// TestListAPIKeysPagination tests that limit, offset and status are applied to the listing
+func TestListAPIKeysPagination(t *testing.T) {
+	server := createTestAPIServer()
+
+	apiConfig := createTestStoredConfig("0000-test-handle-0000-000000000000", "Test API", "1.0.0", "/test")
+	server.db.(*MockStorage).configs["0000-test-handle-0000-000000000000"] = apiConfig
+	server.store.Add(apiConfig)
+
+	now := time.Now()
+	for i, status := range []models.APIKeyStatus{models.APIKeyStatusActive, models.APIKeyStatusActive, models.APIKeyStatusActive, models.APIKeyStatusRevoked} {
+		key := &models.APIKey{
+			UUID:         "0000-key" + string(rune('1'+i)) + "-0000-000000000000",
+			Name:         "key-" + string(rune('1'+i)),
+			APIKey:       "hashed-key-" + string(rune('1'+i)),
+			MaskedAPIKey: "***key",
+			ArtifactUUID: "0000-test-handle-0000-000000000000",
+			Status:       status,
+			CreatedAt:    now.Add(-time.Duration(i) * time.Minute),
+			CreatedBy:    "test-user",
+			UpdatedAt:    now,
+		}
+		server.db.(*MockStorage).apiKeys[key.UUID] = key
+	}
+
+	limit, offset := 2, 1
+	w, r := createTestContext("GET", "/rest-apis/test-handle/api-keys?limit=2&offset=1", nil)
+	r = withAuthContext(r, commonmodels.AuthContext{UserID: "test-user", Roles: []string{"admin"}})
+
+	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000", api.ListAPIKeysParams{Limit: &limit, Offset: &offset})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.APIKeyListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotNil(t, response.ApiKeys)
+	require.Len(t, *response.ApiKeys, 2)
+	assert.Equal(t, "key-2", (*response.ApiKeys)[0].Name)
+	assert.Equal(t, "key-3", (*response.ApiKeys)[1].Name)
+	assert.Equal(t, 3, *response.TotalCount)
+	assert.Nil(t, response.NextOffset)
+
+	status := "revoked"
+	w, r = createTestContext("GET", "/rest-apis/test-handle/api-keys?status=revoked", nil)
+	r = withAuthContext(r, commonmodels.AuthContext{UserID: "test-user", Roles: []string{"admin"}})
+
+	server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000", api.ListAPIKeysParams{Status: &status})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	response = api.APIKeyListResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, *response.ApiKeys, 1)
+	assert.Equal(t, "key-4", (*response.ApiKeys)[0].Name)
+}
+
+// TestListAPIKeysInvalidQuery tests that invalid pagination and status parameters are rejected
+func TestListAPIKeysInvalidQuery(t *testing.T) {
+	zero, negative, tooLarge := 0, -1, 1001
+	unknown := "deleted"
+
+	tests := []struct {
+		name   string
+		params api.ListAPIKeysParams
+	}{
+		{name: "zero limit", params: api.ListAPIKeysParams{Limit: &zero}},
+		{name: "limit above maximum", params: api.ListAPIKeysParams{Limit: &tooLarge}},
+		{name: "negative offset", params: api.ListAPIKeysParams{Offset: &negative}},
+		{name: "unknown status", params: api.ListAPIKeysParams{Status: &unknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createTestAPIServer()
+
+			w, r := createTestContext("GET", "/rest-apis/test-handle/api-keys", nil)
+			r = withAuthContext(r, commonmodels.AuthContext{UserID: "test-user", Roles: []string{"admin"}})
+
+			server.ListAPIKeys(w, r, "0000-test-handle-0000-000000000000", tt.params)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
 // TestListAPIKeysAPINotFound tests listing keys for non-existent API
 func TestListAPIKeysAPINotFound(t *testing.T) {
 	server := createTestAPIServer()
@@ -116,7 +198,7 @@ func TestListAPIKeysAPINotFound(t *testing.T) {
 		Roles:  []string{"admin"},
 	})
 
-	server.ListAPIKeys(w, r, "nonexistent")
+	server.ListAPIKeys(w, r, "nonexistent", api.ListAPIKeysParams{})
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 
@@ -284,10 +284,14 @@ func (s *APIServer) CreateLLMProviderAPIKey(w http.ResponseWriter, r *http.Reque
 		User:          user,
 		CorrelationID: correlationID,
 		Logger:        log,
+		RateLimited:   true,
 	}
 
 	result, err := s.apiKeyService.CreateAPIKey(params)
 	if err != nil {
+		if mapAPIKeyRateLimitError(w, err) {
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
 			httputil.WriteJSON(w, http.StatusNotFound, api.ErrorResponse{Status: "error", Message: err.Error()})
 		} else if storage.IsConflictError(err) || strings.Contains(err.Error(), "already exists") {
@@ -428,7 +432,7 @@ func (s *APIServer) RegenerateLLMProviderAPIKey(w http.ResponseWriter, r *http.R
 
 // ListLLMProviderAPIKeys implements ServerInterface.ListLLMProviderAPIKeys
 // (GET /llm-providers/{id}/api-keys)
-func (s *APIServer) ListLLMProviderAPIKeys(w http.ResponseWriter, r *http.Request, id string) {
+func (s *APIServer) ListLLMProviderAPIKeys(w http.ResponseWriter, r *http.Request, id string, query api.ListLLMProviderAPIKeysParams) {
 	log := middleware.GetLogger(r, s.logger)
 	handle := id
 	correlationID := middleware.GetCorrelationID(r)
@@ -445,6 +449,10 @@ func (s *APIServer) ListLLMProviderAPIKeys(w http.ResponseWriter, r *http.Reques
 		CorrelationID: correlationID,
 		Logger:        log,
 	}
+	if err := applyAPIKeyListQuery(&params, query.Limit, query.Offset, query.Status); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{Status: "error", Message: err.Error()})
+		return
+	}
 
 	result, err := s.apiKeyService.ListAPIKeys(params)
 	if err != nil {
@@ -299,10 +299,14 @@ func (s *APIServer) CreateLLMProxyAPIKey(w http.ResponseWriter, r *http.Request,
 		User:          user,
 		CorrelationID: correlationID,
 		Logger:        log,
+		RateLimited:   true,
 	}
 
 	result, err := s.apiKeyService.CreateAPIKey(params)
 	if err != nil {
+		if mapAPIKeyRateLimitError(w, err) {
+			return
+		}
 		if storage.IsNotFoundError(err) {
 			httputil.WriteJSON(w, http.StatusNotFound, api.ErrorResponse{Status: "error", Message: fmt.Sprintf("LLM proxy '%s' not found", handle)})
 		} else if storage.IsConflictError(err) {
@@ -447,7 +451,7 @@ func (s *APIServer) RegenerateLLMProxyAPIKey(w http.ResponseWriter, r *http.Requ
 
 // ListLLMProxyAPIKeys implements ServerInterface.ListLLMProxyAPIKeys
 // (GET /llm-proxies/{id}/api-keys)
-func (s *APIServer) ListLLMProxyAPIKeys(w http.ResponseWriter, r *http.Request, id string) {
+func (s *APIServer) ListLLMProxyAPIKeys(w http.ResponseWriter, r *http.Request, id string, query api.ListLLMProxyAPIKeysParams) {
 	log := middleware.GetLogger(r, s.logger)
 	handle := id
 	correlationID := middleware.GetCorrelationID(r)
@@ -464,6 +468,10 @@ func (s *APIServer) ListLLMProxyAPIKeys(w http.ResponseWriter, r *http.Request,
 		CorrelationID: correlationID,
 		Logger:        log,
 	}
+	if err := applyAPIKeyListQuery(&params, query.Limit, query.Offset, query.Status); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{Status: "error", Message: err.Error()})
+		return
+	}
 
 	result, err := s.apiKeyService.ListAPIKeys(params)
 	if err != nil {
@@ -51,7 +51,8 @@ func buildResourceStatus(cfg *models.StoredConfig) api.ResourceStatus {
 // buildResourceResponse merges a resource configuration value with the server-
 // managed status block. It accepts any of the k8s-shaped resource types and
 // returns a value with the Status field populated. Any user-provided Status in
-// the input is replaced with the authoritative server value.
+// the input is replaced with the authoritative server value, and upstream
+// probe results are dropped.
 //
 // The input is assumed to be the StoredConfig's Configuration or
 // SourceConfiguration (not a pointer). Unknown types are returned unchanged so
@@ -60,6 +61,7 @@ func buildResourceResponse(cfg any, status api.ResourceStatus) any {
 	switch v := cfg.(type) {
 	case api.RestAPI:
 		v.Status = &status
+		v.UpstreamProbes = nil
 		return v
 	case *api.RestAPI:
 		if v == nil {
@@ -67,6 +69,7 @@ func buildResourceResponse(cfg any, status api.ResourceStatus) any {
 		}
 		cp := *v
 		cp.Status = &status
+		cp.UpstreamProbes = nil
 		return cp
 	case api.MCPProxyConfiguration:
 		v.Status = &status
@@ -29,6 +29,7 @@ import (
 
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/middleware"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/service/restapi"
@@ -59,7 +60,7 @@ func NewRestAPIHandler(service *restapi.RestAPIService, logger *slog.Logger) *Re
 
 // CreateRestAPI implements ServerInterface.CreateRestAPI
 // (POST /rest-apis)
-func (h *RestAPIHandler) CreateRestAPI(w http.ResponseWriter, r *http.Request) {
+func (h *RestAPIHandler) CreateRestAPI(w http.ResponseWriter, r *http.Request, params api.CreateRestAPIParams) {
 	startTime := time.Now()
 	operation := "create"
 
@@ -80,10 +81,11 @@ func (h *RestAPIHandler) CreateRestAPI(w http.ResponseWriter, r *http.Request) {
 	correlationID := middleware.GetCorrelationID(r)
 
 	result, err := h.service.Create(restapi.CreateParams{
-		Body:          body,
-		ContentType:   r.Header.Get("Content-Type"),
-		CorrelationID: correlationID,
-		Logger:        log,
+		Body:                 body,
+		ContentType:          r.Header.Get("Content-Type"),
+		CorrelationID:        correlationID,
+		Logger:               log,
+		SkipControlPlanePush: skipControlPlanePush(r),
 	})
 	if err != nil {
 		log.Error("Failed to deploy API configuration", slog.Any("error", err))
@@ -96,7 +98,8 @@ func (h *RestAPIHandler) CreateRestAPI(w http.ResponseWriter, r *http.Request) {
 	metrics.APIOperationDurationSeconds.WithLabelValues(operation, "rest_api").Observe(time.Since(startTime).Seconds())
 	metrics.APIsTotal.WithLabelValues("rest_api", "active").Inc()
 
-	httputil.WriteJSON(w, http.StatusCreated, buildResourceResponseFromStored(result.StoredConfig.SourceConfiguration, result.StoredConfig))
+	resp := buildResourceResponseFromStored(result.StoredConfig.SourceConfiguration, result.StoredConfig)
+	httputil.WriteJSON(w, http.StatusCreated, h.withUpstreamProbes(r, log, params.ValidateUpstream, result.StoredConfig, resp))
 }
 
 // ListRestAPIs implements ServerInterface.ListRestAPIs
@@ -157,7 +160,7 @@ func (h *RestAPIHandler) GetRestAPIById(w http.ResponseWriter, r *http.Request,
 
 // UpdateRestAPI implements ServerInterface.UpdateRestAPI
 // (PUT /rest-apis/{id})
-func (h *RestAPIHandler) UpdateRestAPI(w http.ResponseWriter, r *http.Request, id string) {
+func (h *RestAPIHandler) UpdateRestAPI(w http.ResponseWriter, r *http.Request, id string, params api.UpdateRestAPIParams) {
 	startTime := time.Now()
 	operation := "update"
 
@@ -178,11 +181,12 @@ func (h *RestAPIHandler) UpdateRestAPI(w http.ResponseWriter, r *http.Request, i
 	correlationID := middleware.GetCorrelationID(r)
 
 	result, err := h.service.Update(restapi.UpdateParams{
-		Handle:        id,
-		Body:          body,
-		ContentType:   r.Header.Get("Content-Type"),
-		CorrelationID: correlationID,
-		Logger:        log,
+		Handle:               id,
+		Body:                 body,
+		ContentType:          r.Header.Get("Content-Type"),
+		CorrelationID:        correlationID,
+		Logger:               log,
+		SkipControlPlanePush: skipControlPlanePush(r),
 	})
 	if err != nil {
 		log.Error("Failed to update API configuration", slog.Any("error", err))
@@ -194,7 +198,36 @@ func (h *RestAPIHandler) UpdateRestAPI(w http.ResponseWriter, r *http.Request, i
 	metrics.APIOperationsTotal.WithLabelValues(operation, "success", "rest_api").Inc()
 	metrics.APIOperationDurationSeconds.WithLabelValues(operation, "rest_api").Observe(time.Since(startTime).Seconds())
 
-	httputil.WriteJSON(w, http.StatusOK, buildResourceResponseFromStored(result.Config.SourceConfiguration, result.Config))
+	resp := buildResourceResponseFromStored(result.Config.SourceConfiguration, result.Config)
+	httputil.WriteJSON(w, http.StatusOK, h.withUpstreamProbes(r, log, params.ValidateUpstream, result.Config, resp))
+}
+
+// withUpstreamProbes adds the reachability of the API's upstreams to a create
+// or update response when the request asks for it, or by default when the
+// probe is enabled in the configuration. Unreachable upstreams are logged and
+// reported but never fail the request.
+func (h *RestAPIHandler) withUpstreamProbes(r *http.Request, log *slog.Logger, validateUpstream *bool, cfg *models.StoredConfig, resp any) any {
+	enabled := h.service.UpstreamProbeEnabled()
+	if validateUpstream != nil {
+		enabled = *validateUpstream
+	}
+	restAPI, ok := resp.(api.RestAPI)
+	if !enabled || !ok {
+		return resp
+	}
+
+	probes := h.service.ProbeUpstreams(r.Context(), cfg)
+	for _, p := range probes {
+		if !p.Reachable && p.Error != nil {
+			log.Warn("Upstream is not reachable from the gateway controller",
+				slog.String("handle", cfg.Handle),
+				slog.String("upstream", string(p.Upstream)),
+				slog.String("url", p.Url),
+				slog.String("reason", *p.Error))
+		}
+	}
+	restAPI.UpstreamProbes = &probes
+	return restAPI
 }
 
 // DeleteRestAPI implements ServerInterface.DeleteRestAPI
@@ -235,6 +268,78 @@ func (h *RestAPIHandler) DeleteRestAPI(w http.ResponseWriter, r *http.Request, i
 	})
 }
 
+// ExportOpenAPI implements ServerInterface.ExportOpenAPI
+// (GET /rest-apis/{id}/openapi)
+func (h *RestAPIHandler) ExportOpenAPI(w http.ResponseWriter, r *http.Request, id string) {
+	log := middleware.GetLogger(r, h.logger)
+
+	doc, err := h.service.ExportOpenAPI(id)
+	if err != nil {
+		if errors.Is(err, restapi.ErrNotFound) {
+			h.mapGetError(w, log, id, err)
+			return
+		}
+		log.Error("Failed to export API configuration as OpenAPI", slog.String("handle", id), slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusInternalServerError, api.ErrorResponse{
+			Status:  "error",
+			Message: "Failed to export configuration",
+		})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, doc)
+}
+
+// ImportOpenAPI implements ServerInterface.ImportOpenAPI
+// (POST /rest-apis/import/openapi)
+func (h *RestAPIHandler) ImportOpenAPI(w http.ResponseWriter, r *http.Request) {
+	log := middleware.GetLogger(r, h.logger)
+
+	r.Body = http.MaxBytesReader(w, r.Body, restapi.MaxOpenAPIImportSize)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			httputil.WriteJSON(w, http.StatusRequestEntityTooLarge, api.ErrorResponse{
+				Status:  "error",
+				Message: fmt.Sprintf("OpenAPI document exceeds %d bytes", restapi.MaxOpenAPIImportSize),
+			})
+			return
+		}
+		log.Error("Failed to read request body", slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+			Status:  "error",
+			Message: "Failed to read request body",
+		})
+		return
+	}
+
+	result, err := h.service.ImportOpenAPI(body)
+	if err != nil {
+		log.Warn("Failed to import OpenAPI document", slog.Any("error", err))
+		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
+			Status:  "error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	log.Info("Generated RestAPI draft from OpenAPI document",
+		slog.String("handle", result.API.Metadata.Name),
+		slog.Int("operations", len(result.API.Spec.Operations)),
+		slog.Int("warnings", len(result.Warnings)))
+
+	warnings := result.Warnings
+	if warnings == nil {
+		warnings = []string{}
+	}
+	httputil.WriteJSON(w, http.StatusOK, api.OpenAPIImportResponse{
+		Status:   "success",
+		Api:      result.API,
+		Warnings: warnings,
+	})
+}
+
 // mapCreateError maps service errors to HTTP responses for Create.
 func (h *RestAPIHandler) mapCreateError(w http.ResponseWriter, err error) {
 	if mapRenderError(w, "create", err) {
@@ -251,18 +356,7 @@ func (h *RestAPIHandler) mapCreateError(w http.ResponseWriter, err error) {
 
 	var validationErr *utils.ValidationErrorListError
 	if errors.As(err, &validationErr) {
-		apiErrors := make([]api.ValidationError, len(validationErr.Errors))
-		for i, e := range validationErr.Errors {
-			apiErrors[i] = api.ValidationError{
-				Field:   stringPtr(e.Field),
-				Message: stringPtr(e.Message),
-			}
-		}
-		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
-			Status:  "error",
-			Message: "Configuration validation failed",
-			Errors:  &apiErrors,
-		})
+		writeValidationErrors(w, validationErr.Errors)
 		return
 	}
 
@@ -318,18 +412,7 @@ func (h *RestAPIHandler) mapUpdateError(w http.ResponseWriter, handle string, er
 	var validationErr *restapi.ValidationError
 	if errors.As(err, &validationErr) {
 		metrics.ValidationErrorsTotal.WithLabelValues("update", "validation_failed").Add(float64(len(validationErr.Errors)))
-		apiErrors := make([]api.ValidationError, len(validationErr.Errors))
-		for i, e := range validationErr.Errors {
-			apiErrors[i] = api.ValidationError{
-				Field:   stringPtr(e.Field),
-				Message: stringPtr(e.Message),
-			}
-		}
-		httputil.WriteJSON(w, http.StatusBadRequest, api.ErrorResponse{
-			Status:  "error",
-			Message: "Configuration validation failed",
-			Errors:  &apiErrors,
-		})
+		writeValidationErrors(w, validationErr.Errors)
 		return
 	}
 
@@ -355,6 +438,23 @@ func (h *RestAPIHandler) mapUpdateError(w http.ResponseWriter, handle string, er
 	})
 }
 
+// writeValidationErrors responds with 422 and every validation failure, each
+// located by a JSON pointer into the submitted configuration.
+func writeValidationErrors(w http.ResponseWriter, validationErrors []config.ValidationError) {
+	apiErrors := make([]api.ValidationError, len(validationErrors))
+	for i, e := range validationErrors {
+		apiErrors[i] = api.ValidationError{
+			Field:   stringPtr(e.Pointer()),
+			Message: stringPtr(e.Message),
+		}
+	}
+	httputil.WriteJSON(w, http.StatusUnprocessableEntity, api.ErrorResponse{
+		Status:  "error",
+		Message: "Configuration validation failed",
+		Errors:  &apiErrors,
+	})
+}
+
 // mapDeleteError maps service errors to HTTP responses for Delete.
 func (h *RestAPIHandler) mapDeleteError(w http.ResponseWriter, log *slog.Logger, handle string, err error) {
 	if errors.Is(err, restapi.ErrNotFound) {
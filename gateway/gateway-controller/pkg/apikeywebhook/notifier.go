@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package apikeywebhook delivers API key lifecycle events to an outbound webhook.
+// Events are signed with HMAC-SHA3-256, queued in a bounded buffer and retried
+// with exponential back-off; they never carry the API key value.
+package apikeywebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+// Lifecycle event types
+const (
+	EventTypeCreated     = "api_key.created"
+	EventTypeRevoked     = "api_key.revoked"
+	EventTypeRegenerated = "api_key.regenerated"
+	EventTypeUpdated     = "api_key.updated"
+)
+
+// Headers set on every delivery. The signature is computed over "<timestamp>.<body>".
+const (
+	HeaderEventID   = "X-Webhook-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signatureScheme = "sha3-256="
+)
+
+// drainTimeout bounds how long Stop waits for queued events to be delivered
+const drainTimeout = 5 * time.Second
+
+// Event is the JSON payload delivered for an API key lifecycle operation
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	APIID      string    `json:"apiId"`
+	APIKeyName string    `json:"apiKeyName"`
+	Actor      string    `json:"actor"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// Notifier queues events and delivers them from a single background worker.
+// A nil Notifier discards every event.
+type Notifier struct {
+	url          string
+	secret       []byte
+	client       *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+	logger       *slog.Logger
+	now          func() time.Time
+
+	queue  chan Event
+	mu     sync.RWMutex
+	closed bool
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewNotifier creates a Notifier for the given configuration. The secret is read
+// during config validation.
+func NewNotifier(cfg config.APIKeyWebhookConfig, logger *slog.Logger) *Notifier {
+	return &Notifier{
+		url:    cfg.URL,
+		secret: cfg.Secret(),
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			// Deliveries go only to the configured endpoint
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+		logger:       logger.With(slog.String("component", "api_key_webhook")),
+		now:          time.Now,
+		queue:        make(chan Event, cfg.QueueSize),
+	}
+}
+
+// Start begins delivering queued events until Stop is called.
+func (n *Notifier) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	n.cancel = cancel
+	n.done = make(chan struct{})
+
+	go func() {
+		defer close(n.done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-n.queue:
+				if !ok {
+					return
+				}
+				n.deliver(ctx, event)
+			}
+		}
+	}()
+}
+
+// Stop stops accepting events and gives the worker up to drainTimeout to deliver
+// what is already queued; anything still pending after that is dropped.
+func (n *Notifier) Stop() {
+	if n == nil || n.cancel == nil {
+		return
+	}
+	n.mu.Lock()
+	if !n.closed {
+		n.closed = true
+		close(n.queue)
+	}
+	n.mu.Unlock()
+
+	select {
+	case <-n.done:
+	case <-time.After(drainTimeout):
+		n.logger.Warn("Timed out delivering queued API key webhook events, dropping the rest")
+	}
+	n.cancel()
+	<-n.done
+}
+
+// Notify queues an event for delivery without blocking. Events are dropped when
+// the queue is full or the notifier is stopped.
+func (n *Notifier) Notify(eventType, apiID, apiKeyName, actor string) {
+	if n == nil {
+		return
+	}
+	event := Event{
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		APIID:      apiID,
+		APIKeyName: apiKeyName,
+		Actor:      actor,
+		Timestamp:  n.now().UTC(),
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.closed {
+		return
+	}
+	select {
+	case n.queue <- event:
+	default:
+		n.logger.Warn("API key webhook queue is full, dropping event",
+			slog.String("event_id", event.ID),
+			slog.String("type", event.Type),
+			slog.String("api_id", event.APIID))
+	}
+}
+
+// deliver posts an event, retrying transport errors, 429 and 5xx responses
+func (n *Notifier) deliver(ctx context.Context, event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		n.logger.Error("Failed to encode API key webhook event", slog.Any("error", err))
+		return
+	}
+
+	backoff := n.retryBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := n.post(ctx, event.ID, body)
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= n.maxRetries {
+			n.logger.Error("Failed to deliver API key webhook event",
+				slog.String("event_id", event.ID),
+				slog.String("type", event.Type),
+				slog.Int("attempts", attempt+1),
+				slog.Any("error", err))
+			return
+		}
+		n.logger.Debug("Retrying API key webhook delivery",
+			slog.String("event_id", event.ID),
+			slog.Int("attempt", attempt+1),
+			slog.Any("error", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends one delivery attempt and reports whether a failure is worth retrying
+func (n *Notifier) post(ctx context.Context, eventID string, body []byte) (bool, error) {
+	timestamp := strconv.FormatInt(n.now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, eventID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, signatureScheme+Sign(n.secret, timestamp, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+}
+
+// Sign returns the hex HMAC-SHA3-256 of "<timestamp>.<body>" keyed with secret.
+// Receivers recompute it to verify a delivery and reject stale timestamps.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(func() hash.Hash { return sha3.New256() }, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apikeywebhook
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+var testSecret = []byte("webhook-0123456789abcdef01234567")
+
+type delivery struct {
+	header http.Header
+	body   []byte
+}
+
+// recorder is a webhook endpoint that records deliveries and answers with the queued statuses
+type recorder struct {
+	mu         sync.Mutex
+	deliveries []delivery
+	statuses   []int
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries = append(r.deliveries, delivery{header: req.Header.Clone(), body: body})
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *recorder) received() []delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]delivery(nil), r.deliveries...)
+}
+
+func newTestNotifier(t *testing.T, url string, maxRetries, queueSize int) *Notifier {
+	t.Helper()
+	n := NewNotifier(config.APIKeyWebhookConfig{
+		URL:          url,
+		Timeout:      time.Second,
+		MaxRetries:   maxRetries,
+		RetryBackoff: time.Millisecond,
+		QueueSize:    queueSize,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	n.secret = testSecret
+	return n
+}
+
+func TestNotifier_DeliversSignedEvent(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := newTestNotifier(t, server.URL, 0, 10)
+	n.Start()
+	n.Notify(EventTypeCreated, "weather-api", "partner-key", "alice")
+	n.Stop()
+
+	deliveries := rec.received()
+	require.Len(t, deliveries, 1)
+	d := deliveries[0]
+
+	var event Event
+	require.NoError(t, json.Unmarshal(d.body, &event))
+	assert.Equal(t, EventTypeCreated, event.Type)
+	assert.Equal(t, "weather-api", event.APIID)
+	assert.Equal(t, "partner-key", event.APIKeyName)
+	assert.Equal(t, "alice", event.Actor)
+	assert.NotEmpty(t, event.ID)
+	assert.False(t, event.Timestamp.IsZero())
+
+	assert.Equal(t, "application/json", d.header.Get("Content-Type"))
+	assert.Equal(t, event.ID, d.header.Get(HeaderEventID))
+	timestamp := d.header.Get(HeaderTimestamp)
+	require.NotEmpty(t, timestamp)
+	assert.Equal(t, "sha3-256="+Sign(testSecret, timestamp, d.body), d.header.Get(HeaderSignature))
+	assert.NotEqual(t, "sha3-256="+Sign([]byte("other-secret"), timestamp, d.body), d.header.Get(HeaderSignature))
+}
+
+func TestNotifier_RetriesServerErrors(t *testing.T) {
+	rec := &recorder{statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := newTestNotifier(t, server.URL, 3, 10)
+	n.Start()
+	n.Notify(EventTypeRevoked, "weather-api", "partner-key", "alice")
+	n.Stop()
+
+	deliveries := rec.received()
+	require.Len(t, deliveries, 3)
+	// Every attempt carries the same event
+	for _, d := range deliveries[1:] {
+		assert.Equal(t, deliveries[0].header.Get(HeaderEventID), d.header.Get(HeaderEventID))
+	}
+}
+
+func TestNotifier_StopsRetryingAfterMaxRetries(t *testing.T) {
+	rec := &recorder{statuses: []int{500, 500, 500, 500, 500}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := newTestNotifier(t, server.URL, 2, 10)
+	n.Start()
+	n.Notify(EventTypeRevoked, "weather-api", "partner-key", "alice")
+	n.Stop()
+
+	assert.Len(t, rec.received(), 3)
+}
+
+func TestNotifier_DoesNotRetryClientErrors(t *testing.T) {
+	rec := &recorder{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n := newTestNotifier(t, server.URL, 3, 10)
+	n.Start()
+	n.Notify(EventTypeUpdated, "weather-api", "partner-key", "alice")
+	n.Stop()
+
+	assert.Len(t, rec.received(), 1)
+}
+
+func TestNotifier_DoesNotFollowRedirects(t *testing.T) {
+	var redirected atomic.Bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected.Store(true)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	n := newTestNotifier(t, server.URL, 0, 10)
+	n.Start()
+	n.Notify(EventTypeCreated, "weather-api", "partner-key", "alice")
+	n.Stop()
+
+	assert.False(t, redirected.Load())
+}
+
+func TestNotifier_DropsEventsWhenQueueIsFull(t *testing.T) {
+	rec := &recorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	// Not started, so nothing drains the queue
+	n := newTestNotifier(t, server.URL, 0, 2)
+	for range 5 {
+		n.Notify(EventTypeCreated, "weather-api", "partner-key", "alice")
+	}
+	assert.Len(t, n.queue, 2)
+
+	n.Start()
+	n.Stop()
+	assert.Len(t, rec.received(), 2)
+
+	// Events after Stop are discarded
+	n.Notify(EventTypeCreated, "weather-api", "partner-key", "alice")
+	assert.Len(t, rec.received(), 2)
+}
+
+func TestNotifier_NilIsNoop(t *testing.T) {
+	var n *Notifier
+	n.Notify(EventTypeCreated, "weather-api", "partner-key", "alice")
+	n.Stop()
+}
@@ -40,14 +40,21 @@ const (
 	APIKeyStateTypeURL = "api-platform.wso2.org/v1.APIKeyState"
 )
 
+// RevocationSource lists the hashes of revoked API keys that have not expired yet.
+// storage.Storage implements it.
+type RevocationSource interface {
+	ListAPIKeyRevocations(now time.Time) ([]string, error)
+}
+
 // APIKeySnapshotManager manages xDS snapshots for API key state
 type APIKeySnapshotManager struct {
-	cache      *cache.LinearCache
-	store      *storage.APIKeyStore
-	logger     *slog.Logger
-	nodeID     string
-	mu         sync.RWMutex
-	translator *APIKeyTranslator
+	cache       *cache.LinearCache
+	store       *storage.APIKeyStore
+	revocations RevocationSource
+	logger      *slog.Logger
+	nodeID      string
+	mu          sync.RWMutex
+	translator  *APIKeyTranslator
 }
 
 // NewAPIKeySnapshotManager creates a new API key snapshot manager
@@ -67,6 +74,15 @@ func NewAPIKeySnapshotManager(store *storage.APIKeyStore, log *slog.Logger) *API
 	}
 }
 
+// SetRevocationSource sets where the revoked API key hashes published with each snapshot
+// come from. Without one, snapshots carry no revocations and signed API keys stay valid
+// until they expire.
+func (sm *APIKeySnapshotManager) SetRevocationSource(source RevocationSource) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.revocations = source
+}
+
 // GetCache returns the underlying cache as the generic Cache interface
 func (sm *APIKeySnapshotManager) GetCache() cache.Cache {
 	return sm.cache
@@ -80,12 +96,24 @@ func (sm *APIKeySnapshotManager) UpdateSnapshot(ctx context.Context) error {
 	// Get all API keys from store
 	apiKeys := sm.store.GetAll()
 
+	// Fail rather than publish a state that drops revocations engines already enforce
+	var revokedAPIKeys []string
+	if sm.revocations != nil {
+		var err error
+		revokedAPIKeys, err = sm.revocations.ListAPIKeyRevocations(time.Now().UTC())
+		if err != nil {
+			sm.logger.Error("Failed to list API key revocations", slog.Any("error", err))
+			return fmt.Errorf("failed to list API key revocations: %w", err)
+		}
+	}
+
 	sm.logger.Info("Updating API key snapshot",
 		slog.Int("apikey_count", len(apiKeys)),
+		slog.Int("revoked_count", len(revokedAPIKeys)),
 		slog.String("node_id", sm.nodeID))
 
 	// Translate API keys to xDS resources
-	resourcesMap, err := sm.translator.TranslateAPIKeys(apiKeys)
+	resourcesMap, err := sm.translator.TranslateAPIKeys(apiKeys, revokedAPIKeys)
 	if err != nil {
 		sm.logger.Error("Failed to translate API keys", slog.Any("error", err))
 		return fmt.Errorf("failed to translate API keys: %w", err)
@@ -181,9 +209,12 @@ func NewAPIKeyTranslator(logger *slog.Logger) *APIKeyTranslator {
 
 // APIKeyStateResource represents the complete state of API keys for the policy engine
 type APIKeyStateResource struct {
-	APIKeys   []APIKeyData `json:"apiKeys"`
-	Version   int64        `json:"version"`
-	Timestamp int64        `json:"timestamp"`
+	APIKeys []APIKeyData `json:"apiKeys"`
+	// RevokedAPIKeys holds the stored hashes of removed or regenerated keys that have not
+	// expired. Engines reject signed API keys matching them.
+	RevokedAPIKeys []string `json:"revokedApiKeys,omitempty"`
+	Version        int64    `json:"version"`
+	Timestamp      int64    `json:"timestamp"`
 }
 
 // APIKeyData represents an API key in the state resource
@@ -194,7 +225,8 @@ type APIKeyData struct {
 	APIId           string `json:"apiId"`
 	ApplicationID   string `json:"applicationId,omitempty"`
 	ApplicationName string `json:"applicationName,omitempty"`
-	// Operations is set to "*" for backward compatibility; per-operation scoping is not used.
+	// Operations is the key's operation scope, enforced by the policy engine at validation
+	// time: "*" or a JSON array of "METHOD /path" entries.
 	Operations string     `json:"operations"`
 	Status     string     `json:"status"`
 	CreatedAt  time.Time  `json:"createdAt"`
@@ -205,8 +237,8 @@ type APIKeyData struct {
 	Issuer     *string    `json:"issuer,omitempty"`
 }
 
-// TranslateAPIKeys translates API key configurations to xDS resources
-func (t *APIKeyTranslator) TranslateAPIKeys(apiKeys []*models.APIKey) (map[string][]types.Resource, error) {
+// TranslateAPIKeys translates API key configurations and revoked key hashes to xDS resources
+func (t *APIKeyTranslator) TranslateAPIKeys(apiKeys []*models.APIKey, revokedAPIKeys []string) (map[string][]types.Resource, error) {
 	resources := make(map[string][]types.Resource)
 
 	// Convert all API keys to a single state resource
@@ -219,7 +251,7 @@ func (t *APIKeyTranslator) TranslateAPIKeys(apiKeys []*models.APIKey) (map[strin
 			APIId:           apiKey.ArtifactUUID,
 			ApplicationID:   apiKey.ApplicationID,
 			ApplicationName: apiKey.ApplicationName,
-			Operations:      "*", // All operations allowed; per-operation scoping is not supported.
+			Operations:      apiKey.OperationScope(),
 			Status:          string(apiKey.Status),
 			CreatedAt:       apiKey.CreatedAt,
 			CreatedBy:       apiKey.CreatedBy,
@@ -233,9 +265,10 @@ func (t *APIKeyTranslator) TranslateAPIKeys(apiKeys []*models.APIKey) (map[strin
 
 	// Create the state resource
 	stateResource := APIKeyStateResource{
-		APIKeys:   apiKeyData,
-		Version:   1, // This will be managed by the cache version
-		Timestamp: 0, // Current timestamp will be set by the receiving end
+		APIKeys:        apiKeyData,
+		RevokedAPIKeys: revokedAPIKeys,
+		Version:        1, // This will be managed by the cache version
+		Timestamp:      0, // Current timestamp will be set by the receiving end
 	}
 
 	// Convert to xDS resource
@@ -19,6 +19,9 @@
 package apikeyxds
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
 	"testing"
@@ -26,6 +29,9 @@ import (
 
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestMaskAPIKey(t *testing.T) {
@@ -116,7 +122,7 @@ func TestAPIKeyTranslator(t *testing.T) {
 
 	// Test with empty API keys
 	t.Run("empty api keys", func(t *testing.T) {
-		resources, err := translator.TranslateAPIKeys([]*models.APIKey{})
+		resources, err := translator.TranslateAPIKeys([]*models.APIKey{}, nil)
 		if err != nil {
 			t.Fatalf("TranslateAPIKeys failed: %v", err)
 		}
@@ -143,6 +149,7 @@ func TestAPIKeyTranslator(t *testing.T) {
 				CreatedBy:    "user1",
 				UpdatedAt:    now,
 				ExpiresAt:    &expires,
+				Operations:   `["GET /users"]`,
 			},
 			{
 				UUID:         "0000-key2-0000-000000000000",
@@ -157,7 +164,7 @@ func TestAPIKeyTranslator(t *testing.T) {
 			},
 		}
 
-		resources, err := translator.TranslateAPIKeys(apiKeys)
+		resources, err := translator.TranslateAPIKeys(apiKeys, nil)
 		if err != nil {
 			t.Fatalf("TranslateAPIKeys failed: %v", err)
 		}
@@ -165,7 +172,28 @@ func TestAPIKeyTranslator(t *testing.T) {
 			t.Error("TranslateAPIKeys returned nil resources")
 		}
 		if len(resources[APIKeyStateTypeURL]) != 1 {
-			t.Errorf("Expected 1 resource, got %d", len(resources[APIKeyStateTypeURL]))
+			t.Fatalf("Expected 1 resource, got %d", len(resources[APIKeyStateTypeURL]))
+		}
+
+		// Each key carries its operation scope; unscoped keys allow every operation
+		st := &structpb.Struct{}
+		if err := proto.Unmarshal(resources[APIKeyStateTypeURL][0].(*anypb.Any).GetValue(), st); err != nil {
+			t.Fatalf("failed to unpack resource: %v", err)
+		}
+		raw, err := st.MarshalJSON()
+		if err != nil {
+			t.Fatalf("failed to marshal resource: %v", err)
+		}
+		var state APIKeyStateResource
+		if err := json.Unmarshal(raw, &state); err != nil {
+			t.Fatalf("failed to decode resource: %v", err)
+		}
+		operations := map[string]string{}
+		for _, key := range state.APIKeys {
+			operations[key.Name] = key.Operations
+		}
+		if operations["test-key-1"] != `["GET /users"]` || operations["test-key-2"] != "*" {
+			t.Errorf("Operations = %v, want test-key-1 scoped to GET /users and test-key-2 to *", operations)
 		}
 	})
 }
@@ -203,6 +231,51 @@ func TestAPIKeySnapshotManager_UpdateSnapshot(t *testing.T) {
 	})
 }
 
+type fakeRevocationSource struct {
+	hashes []string
+	err    error
+}
+
+func (f *fakeRevocationSource) ListAPIKeyRevocations(time.Time) ([]string, error) {
+	return f.hashes, f.err
+}
+
+func TestAPIKeySnapshotManager_PublishesRevocations(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	store := storage.NewAPIKeyStore(logger)
+	manager := NewAPIKeySnapshotManager(store, logger)
+	source := &fakeRevocationSource{hashes: []string{"hash-a", "hash-b"}}
+	manager.SetRevocationSource(source)
+
+	if err := manager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+
+	var state APIKeyStateResource
+	for _, res := range manager.cache.GetResources() {
+		st := &structpb.Struct{}
+		if err := proto.Unmarshal(res.(*anypb.Any).GetValue(), st); err != nil {
+			t.Fatalf("failed to unpack resource: %v", err)
+		}
+		raw, err := st.MarshalJSON()
+		if err != nil {
+			t.Fatalf("failed to marshal resource: %v", err)
+		}
+		if err := json.Unmarshal(raw, &state); err != nil {
+			t.Fatalf("failed to decode resource: %v", err)
+		}
+	}
+	if len(state.RevokedAPIKeys) != 2 || state.RevokedAPIKeys[0] != "hash-a" || state.RevokedAPIKeys[1] != "hash-b" {
+		t.Errorf("RevokedAPIKeys = %v, want [hash-a hash-b]", state.RevokedAPIKeys)
+	}
+
+	// A state without its revocations must never be published
+	source.err = errors.New("database unavailable")
+	if err := manager.UpdateSnapshot(context.Background()); err == nil {
+		t.Error("UpdateSnapshot succeeded without revocations")
+	}
+}
+
 func TestAPIKeySnapshotManager_StoreAndRevoke(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
 	store := storage.NewAPIKeyStore(logger)
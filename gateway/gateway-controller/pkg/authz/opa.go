@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package authz evaluates an embedded OPA policy to authorize management API
+// requests beyond the built-in role check.
+package authz
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/wso2/api-platform/common/authenticators"
+	commonerrors "github.com/wso2/api-platform/common/errors"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/go-httpkit/httputil"
+)
+
+// decisionTimeout bounds the evaluation of the policy for one request.
+const decisionTimeout = time.Second
+
+// OPAAuthorizer decides whether a request is allowed by evaluating a Rego
+// query, such as data.gateway.authz.allow, against the request and the
+// authenticated user. The request is allowed only when the query evaluates
+// to true.
+//
+// The policy receives this input:
+//
+//	{
+//	  "user":     {"id": "alice", "roles": ["developer"], "claims": {...}},
+//	  "request":  {"method": "PUT", "path": "/api/management/v1/rest-apis/petstore"},
+//	  "resource": {"route": "/rest-apis/{id}", "params": {"id": "petstore"}}
+//	}
+//
+// resource.route is the matched route without the management API base path,
+// so a policy applies to the versioned and the legacy paths alike.
+type OPAAuthorizer struct {
+	query    rego.PreparedEvalQuery
+	basePath string
+	logger   *slog.Logger
+}
+
+// NewOPAAuthorizer loads and compiles the policy configured in cfg. A policy
+// that cannot be loaded or compiled is an error, so the controller does not
+// start with authorization it cannot enforce.
+func NewOPAAuthorizer(ctx context.Context, cfg config.OPAConfig, basePath string, logger *slog.Logger) (*OPAAuthorizer, error) {
+	options := []func(*rego.Rego){rego.Query(cfg.Query)}
+	if cfg.BundlePath != "" {
+		options = append(options, rego.LoadBundle(cfg.BundlePath))
+	} else {
+		options = append(options, rego.Load([]string{cfg.PolicyPath}, nil))
+	}
+
+	query, err := rego.New(options...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OPA policy: %w", err)
+	}
+	return &OPAAuthorizer{query: query, basePath: basePath, logger: logger}, nil
+}
+
+// Allowed evaluates the query with input and reports whether it is true.
+// Undefined or non-boolean results deny the request.
+func (a *OPAAuthorizer) Allowed(ctx context.Context, input map[string]any) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, decisionTimeout)
+	defer cancel()
+
+	results, err := a.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return false, err
+	}
+	if len(results) != 1 || len(results[0].Expressions) != 1 {
+		return false, nil
+	}
+	allowed, ok := results[0].Expressions[0].Value.(bool)
+	return ok && allowed, nil
+}
+
+// Middleware returns middleware that rejects requests the policy does not
+// allow. It must run after authenticators.AuthorizationMiddleware, since it
+// relies on the auth context and the pattern set by the ServeMux in the same
+// way. Requests for which authorization is skipped are not evaluated.
+func (a *OPAAuthorizer) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if authenticators.GetAuthzSkip(r) || r.Pattern == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, err := a.Allowed(r.Context(), a.input(r))
+			if err != nil {
+				a.logger.Error("Failed to evaluate OPA policy; denying request",
+					slog.String("method", r.Method),
+					slog.String("pattern", r.Pattern),
+					slog.Any("error", err))
+			}
+			if !allowed {
+				httputil.WriteError(w, http.StatusForbidden, "forbidden", commonerrors.ErrForbidden.Error())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (a *OPAAuthorizer) input(r *http.Request) map[string]any {
+	user := map[string]any{"roles": []string{}}
+	if ac, ok := authenticators.GetAuthContext(r); ok {
+		user["id"] = ac.UserID
+		if ac.Roles != nil {
+			user["roles"] = ac.Roles
+		}
+		if ac.Claims != nil {
+			user["claims"] = ac.Claims
+		}
+	}
+
+	// r.Pattern is "METHOD /path/{param}".
+	route := r.Pattern
+	if i := strings.Index(route, " "); i >= 0 {
+		route = route[i+1:]
+	}
+	params := make(map[string]any)
+	for _, segment := range strings.Split(route, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := strings.TrimSuffix(strings.TrimSuffix(segment[1:len(segment)-1], "..."), "$")
+			if name != "" {
+				params[name] = r.PathValue(name)
+			}
+		}
+	}
+	if a.basePath != "" && strings.HasPrefix(route, a.basePath+"/") {
+		route = strings.TrimPrefix(route, a.basePath)
+	}
+
+	return map[string]any{
+		"user": user,
+		"request": map[string]any{
+			"method": r.Method,
+			"path":   r.URL.Path,
+		},
+		"resource": map[string]any{
+			"route":  route,
+			"params": params,
+		},
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/authenticators"
+	"github.com/wso2/api-platform/common/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+const testBasePath = "/api/management/v1"
+
+// adminOnlyPolicy allows admins, and lets developers read the public API.
+const adminOnlyPolicy = `package gateway.authz
+
+default allow := false
+
+allow if "admin" in input.user.roles
+
+allow if {
+	"developer" in input.user.roles
+	input.request.method == "GET"
+	input.resource.route == "/rest-apis/{id}"
+	input.resource.params.id == "public"
+}
+`
+
+func writePolicy(t *testing.T, policy string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "authz.rego")
+	require.NoError(t, os.WriteFile(path, []byte(policy), 0o600))
+	return path
+}
+
+func newTestAuthorizer(t *testing.T, cfg config.OPAConfig) *OPAAuthorizer {
+	t.Helper()
+	if cfg.Query == "" {
+		cfg.Query = "data.gateway.authz.allow"
+	}
+	a, err := NewOPAAuthorizer(context.Background(), cfg, testBasePath, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	return a
+}
+
+// serve routes a request through a ServeMux, so r.Pattern is set, and runs
+// the OPA middleware as the given user.
+func serve(a *OPAAuthorizer, method, path string, ac *models.AuthContext) int {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	mux := http.NewServeMux()
+	for _, pattern := range []string{"GET " + testBasePath + "/rest-apis/{id}", "DELETE " + testBasePath + "/rest-apis/{id}", "GET /rest-apis/{id}"} {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			if ac != nil {
+				r = r.WithContext(authenticators.WithAuthContext(r.Context(), *ac))
+			}
+			a.Middleware()(ok).ServeHTTP(w, r)
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(method, path, nil))
+	return rr.Code
+}
+
+func TestOPAAuthorizer_AllowsAdminsAndDeniesOthers(t *testing.T) {
+	a := newTestAuthorizer(t, config.OPAConfig{PolicyPath: writePolicy(t, adminOnlyPolicy)})
+	admin := &models.AuthContext{Authenticated: true, UserID: "alice", Roles: []string{"admin"}}
+	developer := &models.AuthContext{Authenticated: true, UserID: "bob", Roles: []string{"developer"}}
+
+	assert.Equal(t, http.StatusOK, serve(a, http.MethodDelete, testBasePath+"/rest-apis/petstore", admin))
+	assert.Equal(t, http.StatusForbidden, serve(a, http.MethodDelete, testBasePath+"/rest-apis/petstore", developer))
+	assert.Equal(t, http.StatusForbidden, serve(a, http.MethodGet, testBasePath+"/rest-apis/petstore", developer))
+	assert.Equal(t, http.StatusForbidden, serve(a, http.MethodGet, testBasePath+"/rest-apis/petstore", nil))
+}
+
+func TestOPAAuthorizer_InputHasRouteAndParams(t *testing.T) {
+	a := newTestAuthorizer(t, config.OPAConfig{PolicyPath: writePolicy(t, adminOnlyPolicy)})
+	developer := &models.AuthContext{Authenticated: true, UserID: "bob", Roles: []string{"developer"}}
+
+	assert.Equal(t, http.StatusOK, serve(a, http.MethodGet, testBasePath+"/rest-apis/public", developer))
+	// The route is the same on the legacy, unprefixed path.
+	assert.Equal(t, http.StatusOK, serve(a, http.MethodGet, "/rest-apis/public", developer))
+}
+
+func TestOPAAuthorizer_SkipsWhenAuthorizationIsSkipped(t *testing.T) {
+	a := newTestAuthorizer(t, config.OPAConfig{PolicyPath: writePolicy(t, adminOnlyPolicy)})
+	// Without authenticators AuthMiddleware skips authorization for every request.
+	authn, err := authenticators.AuthMiddleware(models.AuthConfig{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	mux := http.NewServeMux()
+	mux.Handle("GET /rest-apis/{id}", authn(a.Middleware()(ok)))
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/rest-apis/petstore", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestOPAAuthorizer_UndefinedOrNonBooleanResultDenies(t *testing.T) {
+	admin := &models.AuthContext{Authenticated: true, UserID: "alice", Roles: []string{"admin"}}
+
+	undefined := newTestAuthorizer(t, config.OPAConfig{
+		PolicyPath: writePolicy(t, adminOnlyPolicy),
+		Query:      "data.gateway.authz.missing",
+	})
+	assert.Equal(t, http.StatusForbidden, serve(undefined, http.MethodGet, "/rest-apis/petstore", admin))
+
+	nonBoolean := newTestAuthorizer(t, config.OPAConfig{
+		PolicyPath: writePolicy(t, "package gateway.authz\n\nallow := \"yes\"\n"),
+	})
+	assert.Equal(t, http.StatusForbidden, serve(nonBoolean, http.MethodGet, "/rest-apis/petstore", admin))
+}
+
+func TestOPAAuthorizer_LoadsBundle(t *testing.T) {
+	dir := filepath.Dir(writePolicy(t, adminOnlyPolicy))
+	a := newTestAuthorizer(t, config.OPAConfig{BundlePath: dir})
+	admin := &models.AuthContext{Authenticated: true, UserID: "alice", Roles: []string{"admin"}}
+
+	assert.Equal(t, http.StatusOK, serve(a, http.MethodGet, "/rest-apis/petstore", admin))
+}
+
+func TestNewOPAAuthorizer_InvalidPolicy(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	_, err := NewOPAAuthorizer(context.Background(), config.OPAConfig{
+		PolicyPath: writePolicy(t, "package gateway.authz\n\nallow if {\n"),
+		Query:      "data.gateway.authz.allow",
+	}, testBasePath, logger)
+	assert.Error(t, err)
+
+	_, err = NewOPAAuthorizer(context.Background(), config.OPAConfig{
+		PolicyPath: filepath.Join(t.TempDir(), "missing.rego"),
+		Query:      "data.gateway.authz.allow",
+	}, testBasePath, logger)
+	assert.Error(t, err)
+}
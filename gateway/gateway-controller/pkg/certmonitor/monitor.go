@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package certmonitor periodically scans stored certificates, reports how close
+// they are to expiry and, optionally, checks their revocation status over OCSP.
+package certmonitor
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+// CertificateLister lists the certificates to monitor. storage.Storage satisfies it.
+type CertificateLister interface {
+	ListCertificates() ([]*models.StoredCertificate, error)
+}
+
+// Status is the result of the most recent check of a stored certificate.
+type Status struct {
+	// ExpiresInDays is the number of whole days until NotAfter, negative once expired
+	ExpiresInDays int
+	// ExpiringSoon is true once the certificate expires within the warning threshold
+	ExpiringSoon bool
+	// OCSPStatus is one of the OCSPStatus* constants, or empty when OCSP was not checked
+	OCSPStatus string
+	CheckedAt  time.Time
+}
+
+// Monitor scans stored certificates on a fixed interval. Results are exported as
+// metrics and kept in memory for the config dump.
+type Monitor struct {
+	cfg        config.CertificateExpiryMonitorConfig
+	certs      CertificateLister
+	logger     *slog.Logger
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu       sync.RWMutex
+	statuses map[string]Status
+	names    map[string]string // cert ID -> name of every certificate with exported metrics
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewMonitor creates a Monitor for the given configuration.
+func NewMonitor(cfg config.CertificateExpiryMonitorConfig, certs CertificateLister, logger *slog.Logger) *Monitor {
+	return &Monitor{
+		cfg:        cfg,
+		certs:      certs,
+		logger:     logger.With(slog.String("component", "certificate_monitor")),
+		httpClient: &http.Client{Timeout: cfg.OCSPTimeout},
+		now:        time.Now,
+		statuses:   make(map[string]Status),
+		names:      make(map[string]string),
+	}
+}
+
+// Start runs an initial scan and keeps scanning every check interval until Stop is called.
+func (m *Monitor) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			m.scan(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the background scan and waits for an in-flight scan to finish.
+func (m *Monitor) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+}
+
+// Status returns the latest result for a certificate ID.
+func (m *Monitor) Status(certID string) (Status, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	status, ok := m.statuses[certID]
+	return status, ok
+}
+
+// scan checks every stored certificate and replaces the previous results.
+func (m *Monitor) scan(ctx context.Context) {
+	certs, err := m.certs.ListCertificates()
+	if err != nil {
+		m.logger.Error("Failed to list certificates for expiry check", slog.Any("error", err))
+		return
+	}
+
+	now := m.now()
+	statuses := make(map[string]Status, len(certs))
+	names := make(map[string]string, len(certs))
+	for _, cert := range certs {
+		if ctx.Err() != nil {
+			return
+		}
+		statuses[cert.UUID] = m.check(ctx, cert, now)
+		names[cert.UUID] = cert.Name
+	}
+
+	m.mu.Lock()
+	for id, name := range m.names {
+		if _, ok := names[id]; !ok {
+			metrics.CertificateExpiryDays.DeleteLabelValues(id, name)
+			metrics.CertificateExpirySeconds.DeleteLabelValues(id, name)
+			metrics.CertificateRevoked.DeleteLabelValues(id, name)
+		}
+	}
+	m.statuses = statuses
+	m.names = names
+	m.mu.Unlock()
+}
+
+// check evaluates a single certificate, exports its metrics and logs a warning
+// when it is close to expiry or revoked.
+func (m *Monitor) check(ctx context.Context, cert *models.StoredCertificate, now time.Time) Status {
+	remaining := cert.NotAfter.Sub(now)
+	days := remaining.Hours() / 24
+	status := Status{
+		ExpiresInDays: int(math.Floor(days)),
+		ExpiringSoon:  remaining < m.cfg.WarningThreshold,
+		CheckedAt:     now,
+	}
+
+	metrics.CertificateExpiryDays.WithLabelValues(cert.UUID, cert.Name).Set(days)
+	metrics.CertificateExpirySeconds.WithLabelValues(cert.UUID, cert.Name).Set(float64(cert.NotAfter.Unix()))
+
+	switch {
+	case remaining <= 0:
+		m.logger.Warn("Certificate has expired",
+			slog.String("id", cert.UUID),
+			slog.String("name", cert.Name),
+			slog.Time("not_after", cert.NotAfter))
+	case status.ExpiringSoon:
+		m.logger.Warn("Certificate expires soon",
+			slog.String("id", cert.UUID),
+			slog.String("name", cert.Name),
+			slog.Int("expires_in_days", status.ExpiresInDays),
+			slog.Time("not_after", cert.NotAfter))
+	}
+
+	if m.cfg.OCSPEnabled && remaining > 0 {
+		status.OCSPStatus = m.checkOCSP(ctx, cert)
+		if status.OCSPStatus != "" {
+			revoked := 0.0
+			if status.OCSPStatus == OCSPStatusRevoked {
+				revoked = 1
+			}
+			metrics.CertificateRevoked.WithLabelValues(cert.UUID, cert.Name).Set(revoked)
+		}
+	}
+
+	return status
+}
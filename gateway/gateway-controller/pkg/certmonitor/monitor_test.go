@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certmonitor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+type staticLister struct {
+	certs []*models.StoredCertificate
+}
+
+func (l *staticLister) ListCertificates() ([]*models.StoredCertificate, error) {
+	return l.certs, nil
+}
+
+func testMonitorConfig() config.CertificateExpiryMonitorConfig {
+	return config.CertificateExpiryMonitorConfig{
+		Enabled:          true,
+		CheckInterval:    time.Hour,
+		WarningThreshold: 30 * 24 * time.Hour,
+		OCSPTimeout:      5 * time.Second,
+	}
+}
+
+func newTestMonitor(cfg config.CertificateExpiryMonitorConfig, lister CertificateLister, now time.Time) *Monitor {
+	metrics.Init()
+	m := NewMonitor(cfg, lister, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	m.now = func() time.Time { return now }
+	return m
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// TODO(pqc): migrate — test fixtures mirror the ECDSA certificates stored by operators today.
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key := newTestKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, ocspServer string) *x509.Certificate {
+	t.Helper()
+	key := newTestKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(42),
+		Subject:               pkix.Name{CommonName: "Intermediate CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(90 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		OCSPServer:            []string{ocspServer},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func encodePEM(certs ...*x509.Certificate) []byte {
+	var out []byte
+	for _, cert := range certs {
+		out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return out
+}
+
+func TestMonitor_ScanReportsExpiry(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	lister := &staticLister{certs: []*models.StoredCertificate{
+		{UUID: "fresh", Name: "fresh", NotAfter: now.Add(100 * 24 * time.Hour)},
+		{UUID: "expiring", Name: "expiring", NotAfter: now.Add(10*24*time.Hour + time.Hour)},
+		{UUID: "expired", Name: "expired", NotAfter: now.Add(-36 * time.Hour)},
+	}}
+	m := newTestMonitor(testMonitorConfig(), lister, now)
+
+	m.scan(context.Background())
+
+	tests := []struct {
+		id           string
+		days         int
+		expiringSoon bool
+	}{
+		{id: "fresh", days: 100, expiringSoon: false},
+		{id: "expiring", days: 10, expiringSoon: true},
+		{id: "expired", days: -2, expiringSoon: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			status, ok := m.Status(tt.id)
+			require.True(t, ok)
+			assert.Equal(t, tt.days, status.ExpiresInDays)
+			assert.Equal(t, tt.expiringSoon, status.ExpiringSoon)
+			assert.Empty(t, status.OCSPStatus, "OCSP is disabled")
+			assert.Equal(t, now, status.CheckedAt)
+		})
+	}
+
+	// Deleted certificates are dropped on the next scan
+	lister.certs = lister.certs[:1]
+	m.scan(context.Background())
+	_, ok := m.Status("expiring")
+	assert.False(t, ok)
+}
+
+func TestMonitor_OCSP(t *testing.T) {
+	ca := newTestCA(t)
+	var revoked bool
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		template := ocsp.Response{
+			Status:       ocsp.Good,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+		}
+		if revoked {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = time.Now().Add(-time.Hour)
+			template.RevocationReason = ocsp.KeyCompromise
+		}
+		resp, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		_, _ = w.Write(resp)
+	}))
+	defer responder.Close()
+
+	leaf := ca.issue(t, responder.URL)
+	stored := &models.StoredCertificate{
+		UUID:        "intermediate",
+		Name:        "intermediate",
+		Certificate: encodePEM(leaf, ca.cert),
+		NotAfter:    leaf.NotAfter,
+	}
+	cfg := testMonitorConfig()
+	cfg.OCSPEnabled = true
+	m := newTestMonitor(cfg, &staticLister{certs: []*models.StoredCertificate{stored}}, time.Now())
+
+	m.scan(context.Background())
+	status, _ := m.Status("intermediate")
+	assert.Equal(t, OCSPStatusGood, status.OCSPStatus)
+
+	revoked = true
+	m.scan(context.Background())
+	status, _ = m.Status("intermediate")
+	assert.Equal(t, OCSPStatusRevoked, status.OCSPStatus)
+
+	// Without the issuer in the bundle the certificate cannot be checked
+	stored.Certificate = encodePEM(leaf)
+	m.scan(context.Background())
+	status, _ = m.Status("intermediate")
+	assert.Empty(t, status.OCSPStatus)
+}
+
+func TestMonitor_OCSPResponderUnavailable(t *testing.T) {
+	ca := newTestCA(t)
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer responder.Close()
+
+	leaf := ca.issue(t, responder.URL)
+	cfg := testMonitorConfig()
+	cfg.OCSPEnabled = true
+	m := newTestMonitor(cfg, &staticLister{certs: []*models.StoredCertificate{{
+		UUID:        "intermediate",
+		Name:        "intermediate",
+		Certificate: encodePEM(leaf, ca.cert),
+		NotAfter:    leaf.NotAfter,
+	}}}, time.Now())
+
+	m.scan(context.Background())
+	status, _ := m.Status("intermediate")
+	assert.Equal(t, OCSPStatusUnknown, status.OCSPStatus)
+}
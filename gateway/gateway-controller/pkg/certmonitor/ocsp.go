@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certmonitor
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+const (
+	// OCSPStatusGood means the responder reported the certificate as not revoked
+	OCSPStatusGood = "good"
+	// OCSPStatusRevoked means the responder reported the certificate as revoked
+	OCSPStatusRevoked = "revoked"
+	// OCSPStatusUnknown means the responder did not know the certificate or could not be queried
+	OCSPStatusUnknown = "unknown"
+
+	// maxOCSPResponseBytes bounds the OCSP response body read into memory
+	maxOCSPResponseBytes = 1 << 20
+)
+
+// checkOCSP queries the OCSP responder named in the first certificate of the
+// stored bundle. It returns an empty status when the certificate cannot be
+// checked: it is self-signed, names no responder, or its issuer is not part
+// of the bundle.
+func (m *Monitor) checkOCSP(ctx context.Context, stored *models.StoredCertificate) string {
+	log := m.logger.With(slog.String("id", stored.UUID), slog.String("name", stored.Name))
+
+	leaf, issuer := leafAndIssuer(stored.Certificate)
+	if leaf == nil || issuer == nil || len(leaf.OCSPServer) == 0 {
+		log.Debug("Skipping OCSP check, certificate has no responder or issuer in the bundle")
+		return ""
+	}
+
+	resp, err := m.queryOCSP(ctx, leaf.OCSPServer[0], leaf, issuer)
+	if err != nil {
+		log.Warn("OCSP check failed", slog.String("responder", leaf.OCSPServer[0]), slog.Any("error", err))
+		return OCSPStatusUnknown
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+		return OCSPStatusGood
+	case ocsp.Revoked:
+		log.Warn("Certificate has been revoked",
+			slog.Time("revoked_at", resp.RevokedAt),
+			slog.Int("reason", resp.RevocationReason))
+		return OCSPStatusRevoked
+	default:
+		return OCSPStatusUnknown
+	}
+}
+
+// queryOCSP sends an OCSP request over HTTP POST and verifies the response
+// against the issuer.
+func (m *Monitor) queryOCSP(ctx context.Context, responder string, leaf, issuer *x509.Certificate) (*ocsp.Response, error) {
+	responderURL, err := url.Parse(responder)
+	if err != nil || (responderURL.Scheme != "http" && responderURL.Scheme != "https") {
+		return nil, fmt.Errorf("unsupported OCSP responder URL")
+	}
+
+	// OCSP CertIDs have no SHA-3 algorithm identifier; SHA-256 is the strongest
+	// hash responders commonly accept.
+	body, err := ocsp.CreateRequest(leaf, issuer, &ocsp.RequestOptions{Hash: crypto.SHA256})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCSP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responderURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCSP HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	req.Header.Set("Accept", "application/ocsp-response")
+
+	httpResp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("OCSP request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OCSP responder returned HTTP %d", httpResp.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(httpResp.Body, maxOCSPResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCSP response: %w", err)
+	}
+
+	resp, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+	return resp, nil
+}
+
+// leafAndIssuer returns the first certificate of a PEM bundle and the bundle
+// certificate that signed it. Self-signed certificates have no issuer to check
+// against, so nil is returned for them.
+func leafAndIssuer(data []byte) (*x509.Certificate, *x509.Certificate) {
+	var certs []*x509.Certificate
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, nil
+	}
+
+	leaf := certs[0]
+	for _, candidate := range certs[1:] {
+		if bytes.Equal(candidate.RawSubject, leaf.RawIssuer) && leaf.CheckSignatureFrom(candidate) == nil {
+			return leaf, candidate
+		}
+	}
+	return leaf, nil
+}
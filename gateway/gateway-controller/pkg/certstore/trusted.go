@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certstore
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/lazyresourcexds"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// LazyResourceTypeTrustedCertificate is the lazy resource type under which stored
+// certificates are published to the policy engine, keyed by certificate name.
+const LazyResourceTypeTrustedCertificate = "TrustedCertificate"
+
+// certificateLister is the part of storage.Storage needed to publish certificates.
+type certificateLister interface {
+	ListCertificates() ([]*models.StoredCertificate, error)
+}
+
+// PublishTrustedCertificates publishes every stored certificate to the policy engine
+// as a lazy resource, so policies such as mtls-auth can trust them by name. Only the
+// public PEM is published. Resources of certificates that no longer exist are removed
+// and unchanged ones are left alone to avoid needless snapshot updates.
+func PublishTrustedCertificates(db certificateLister, mgr *lazyresourcexds.LazyResourceStateManager, correlationID string) error {
+	if mgr == nil {
+		return nil
+	}
+	certs, err := db.ListCertificates()
+	if err != nil {
+		return fmt.Errorf("failed to list certificates: %w", err)
+	}
+
+	current := make(map[string]bool, len(certs))
+	for _, cert := range certs {
+		current[cert.Name] = true
+		resource := map[string]interface{}{
+			"name":        cert.Name,
+			"certificate": string(cert.Certificate),
+		}
+		if existing, ok := mgr.GetResourceByIDAndType(cert.Name, LazyResourceTypeTrustedCertificate); ok &&
+			reflect.DeepEqual(existing.Resource, resource) {
+			continue
+		}
+		if err := mgr.StoreResource(&storage.LazyResource{
+			ID:           cert.Name,
+			ResourceType: LazyResourceTypeTrustedCertificate,
+			Resource:     resource,
+		}, correlationID); err != nil {
+			return fmt.Errorf("failed to publish certificate %q: %w", cert.Name, err)
+		}
+	}
+
+	for id := range mgr.GetResourcesByType(LazyResourceTypeTrustedCertificate) {
+		if current[id] {
+			continue
+		}
+		if err := mgr.RemoveResourceByIDAndType(id, LazyResourceTypeTrustedCertificate, correlationID); err != nil {
+			return fmt.Errorf("failed to remove certificate %q: %w", id, err)
+		}
+	}
+	return nil
+}
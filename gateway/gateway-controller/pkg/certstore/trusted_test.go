@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certstore
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/lazyresourcexds"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+type fakeCertificateLister struct {
+	certs []*models.StoredCertificate
+	err   error
+}
+
+func (f *fakeCertificateLister) ListCertificates() ([]*models.StoredCertificate, error) {
+	return f.certs, f.err
+}
+
+func newTestLazyResourceManager() *lazyresourcexds.LazyResourceStateManager {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := storage.NewLazyResourceStore(logger)
+	return lazyresourcexds.NewLazyResourceStateManager(store, lazyresourcexds.NewLazyResourceSnapshotManager(store, logger), logger)
+}
+
+func TestPublishTrustedCertificates(t *testing.T) {
+	mgr := newTestLazyResourceManager()
+	db := &fakeCertificateLister{certs: []*models.StoredCertificate{
+		{UUID: "1", Name: "partner-ca", Certificate: []byte(validCertPEM)},
+		{UUID: "2", Name: "internal-ca", Certificate: []byte(validCertPEM)},
+	}}
+
+	require.NoError(t, PublishTrustedCertificates(db, mgr, "test"))
+	resources := mgr.GetResourcesByType(LazyResourceTypeTrustedCertificate)
+	require.Len(t, resources, 2)
+	assert.Equal(t, map[string]interface{}{"name": "partner-ca", "certificate": validCertPEM}, resources["partner-ca"].Resource)
+
+	// A deleted certificate is withdrawn from the policy engine
+	db.certs = db.certs[:1]
+	require.NoError(t, PublishTrustedCertificates(db, mgr, "test"))
+	resources = mgr.GetResourcesByType(LazyResourceTypeTrustedCertificate)
+	assert.Len(t, resources, 1)
+	assert.Contains(t, resources, "partner-ca")
+}
+
+func TestPublishTrustedCertificates_ListError(t *testing.T) {
+	mgr := newTestLazyResourceManager()
+	err := PublishTrustedCertificates(&fakeCertificateLister{err: errors.New("db down")}, mgr, "test")
+	assert.Error(t, err)
+	assert.Empty(t, mgr.GetResourcesByType(LazyResourceTypeTrustedCertificate))
+
+	// Without a lazy resource manager there is nothing to publish to
+	assert.NoError(t, PublishTrustedCertificates(&fakeCertificateLister{err: errors.New("db down")}, nil, "test"))
+}
@@ -39,6 +39,8 @@ type APIValidator struct {
 	urlFriendlyNameRegex *regexp.Regexp
 	// policyValidator validates policy references and parameters
 	policyValidator *PolicyValidator
+	// maxBodyBytesCeiling bounds the per-API maxRequestBytes/maxResponseBytes
+	maxBodyBytesCeiling int64
 }
 
 // NewAPIValidator creates a new API configuration validator
@@ -47,6 +49,7 @@ func NewAPIValidator() *APIValidator {
 		pathParamRegex:       regexp.MustCompile(`\{[a-zA-Z0-9_]+\}`),
 		versionRegex:         regexp.MustCompile(`^v?\d+(\.\d+)?(\.\d+)?$`),
 		urlFriendlyNameRegex: regexp.MustCompile(`^[a-zA-Z0-9\-_\. ]+$`),
+		maxBodyBytesCeiling:  int64(constants.MaxReasonableBufferLimitBytes),
 	}
 }
 
@@ -55,6 +58,14 @@ func (v *APIValidator) SetPolicyValidator(policyValidator *PolicyValidator) {
 	v.policyValidator = policyValidator
 }
 
+// SetMaxBodyBytesCeiling sets the upper bound for per-API body size limits.
+// Non-positive values leave the default ceiling in place.
+func (v *APIValidator) SetMaxBodyBytesCeiling(ceiling int64) {
+	if ceiling > 0 {
+		v.maxBodyBytesCeiling = ceiling
+	}
+}
+
 // Validate performs comprehensive validation on a configuration
 // It uses type switching to handle APIConfiguration specifically
 func (v *APIValidator) Validate(config interface{}) []ValidationError {
@@ -89,10 +100,10 @@ func (v *APIValidator) validateRestAPIConfiguration(config *api.RestAPI) []Valid
 		})
 	}
 
-	// Validate version
+	// Validate apiVersion
 	if config.ApiVersion != api.RestAPIApiVersionGatewayApiPlatformWso2Comv1 {
 		errors = append(errors, ValidationError{
-			Field:   "version",
+			Field:   "apiVersion",
 			Message: "Unsupported API version (must be 'gateway.api-platform.wso2.com/v1')",
 		})
 	}
@@ -112,6 +123,15 @@ func (v *APIValidator) validateRestAPIConfiguration(config *api.RestAPI) []Valid
 	return errors
 }
 
+// UpstreamConfigured reports whether an upstream slot points at a backend through
+// a non-blank url or ref.
+func UpstreamConfigured(up *api.Upstream) bool {
+	return up != nil &&
+		((up.Url != nil && strings.TrimSpace(*up.Url) != "") ||
+			(up.Ref != nil && strings.TrimSpace(*up.Ref) != "") ||
+			(up.Targets != nil && len(*up.Targets) > 0))
+}
+
 // validateUpstream validates a single upstream definition (main or sandbox)
 func (v *APIValidator) validateUpstream(label string, up *api.Upstream, upstreamDefinitions *[]api.UpstreamDefinition) []ValidationError {
 	var errors []ValidationError
@@ -128,8 +148,19 @@ func (v *APIValidator) validateUpstream(label string, up *api.Upstream, upstream
 		return errors
 	}
 
+	if up.Targets != nil {
+		if up.Ref != nil || up.Url != nil {
+			errors = append(errors, ValidationError{
+				Field:   "spec.upstream." + label,
+				Message: "Specify 'targets' without 'url' or 'ref'",
+			})
+			return errors
+		}
+		errors = append(errors, v.validateUpstreamTargets(label, *up.Targets)...)
+	}
+
 	// Require at least one to be set
-	if up.Ref == nil && up.Url == nil {
+	if up.Ref == nil && up.Url == nil && up.Targets == nil {
 		errors = append(errors, ValidationError{
 			Field:   "spec.upstream." + label,
 			Message: "Must specify either 'url' or 'ref'",
@@ -146,6 +177,81 @@ func (v *APIValidator) validateUpstream(label string, up *api.Upstream, upstream
 		errors = append(errors, v.validateUpstreamRef(label, up.Ref, upstreamDefinitions)...)
 	}
 
+	errors = append(errors, validateHealthCheck("spec.upstream."+label+".healthCheck", up.HealthCheck)...)
+	errors = append(errors, validateOutlierDetection("spec.upstream."+label+".outlierDetection", up.OutlierDetection)...)
+
+	if up.Upgrade != nil && *up.Upgrade != api.UpstreamUpgradeWebsocket {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream." + label + ".upgrade",
+			Message: fmt.Sprintf("Unsupported upgrade '%s' (must be 'websocket')", *up.Upgrade),
+		})
+	}
+
+	return errors
+}
+
+// validateUpstreamTargets validates weighted targets. Weights are percentages, so they must
+// add up to 100. The route rewrite is shared by all targets, so they must agree on the path,
+// and each target gets its own cluster, so no two may point at the same host and port.
+func (v *APIValidator) validateUpstreamTargets(label string, targets []api.UpstreamTarget) []ValidationError {
+	var errors []ValidationError
+	field := "spec.upstream." + label + ".targets"
+
+	if len(targets) == 0 {
+		return append(errors, ValidationError{
+			Field:   field,
+			Message: "At least one target is required",
+		})
+	}
+
+	total := 0
+	var path string
+	seen := make(map[string]struct{}, len(targets))
+	for i, target := range targets {
+		targetField := fmt.Sprintf("%s[%d]", field, i)
+		if target.Weight < 0 || target.Weight > 100 {
+			errors = append(errors, ValidationError{
+				Field:   targetField + ".weight",
+				Message: "Weight must be between 0 and 100",
+			})
+		}
+		total += target.Weight
+
+		urlErrors := v.validateUpstreamUrl(fmt.Sprintf("%s.targets[%d]", label, i), &target.Url)
+		if len(urlErrors) > 0 {
+			errors = append(errors, urlErrors...)
+			continue
+		}
+		parsedURL, _ := url.Parse(target.Url)
+		if i == 0 {
+			path = parsedURL.Path
+		} else if parsedURL.Path != path {
+			errors = append(errors, ValidationError{
+				Field:   targetField + ".url",
+				Message: fmt.Sprintf("All targets must share the same path (expected '%s')", path),
+			})
+		}
+		// Targets are clustered by host and port, so spell out default ports before comparing
+		port := parsedURL.Port()
+		if port == "" {
+			port = map[string]string{"http": "80", "https": "443"}[parsedURL.Scheme]
+		}
+		hostPort := strings.ToLower(parsedURL.Hostname()) + ":" + port
+		if _, dup := seen[hostPort]; dup {
+			errors = append(errors, ValidationError{
+				Field:   targetField + ".url",
+				Message: fmt.Sprintf("Duplicate target host '%s'", parsedURL.Host),
+			})
+		}
+		seen[hostPort] = struct{}{}
+	}
+
+	if total != 100 {
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("Target weights must add up to 100 (got %d)", total),
+		})
+	}
 	return errors
 }
 
@@ -457,8 +563,13 @@ func (v *APIValidator) validateRestData(spec *api.APIConfigData) []ValidationErr
 	// Validate upstreamDefinitions first
 	errors = append(errors, v.validateUpstreamDefinitions(spec.UpstreamDefinitions)...)
 
-	// Validate upstream (main + optional sandbox)
-	errors = append(errors, v.validateUpstream("main", &spec.Upstream.Main, spec.UpstreamDefinitions)...)
+	// Validate upstream (main + optional sandbox). The main upstream may be left empty
+	// for a sandbox-only API, which then only gets routes on the sandbox vhost.
+	sandboxOnly := spec.Upstream.Main.Url == nil && spec.Upstream.Main.Ref == nil && spec.Upstream.Main.Targets == nil &&
+		UpstreamConfigured(spec.Upstream.Sandbox)
+	if !sandboxOnly {
+		errors = append(errors, v.validateUpstream("main", &spec.Upstream.Main, spec.UpstreamDefinitions)...)
+	}
 	if spec.Upstream.Sandbox != nil {
 		errors = append(errors, v.validateUpstream("sandbox", spec.Upstream.Sandbox, spec.UpstreamDefinitions)...)
 	}
@@ -466,18 +577,44 @@ func (v *APIValidator) validateRestData(spec *api.APIConfigData) []ValidationErr
 	// Validate API-level resilience block
 	errors = append(errors, v.validateResilience("spec.resilience", spec.Resilience)...)
 
+	// Validate body size limits
+	errors = append(errors, v.validateBodyLimit("spec.maxRequestBytes", spec.MaxRequestBytes)...)
+	errors = append(errors, v.validateBodyLimit("spec.maxResponseBytes", spec.MaxResponseBytes)...)
+
 	// Validate operations
 	errors = append(errors, v.validateOperations(spec.Operations)...)
 
 	return errors
 }
 
+// validateBodyLimit validates an optional body size limit: it must be positive and
+// must not exceed the gateway's configured ceiling.
+func (v *APIValidator) validateBodyLimit(field string, limit *int64) []ValidationError {
+	if limit == nil {
+		return nil
+	}
+	if *limit <= 0 {
+		return []ValidationError{{
+			Field:   field,
+			Message: "Body size limit must be a positive number of bytes",
+		}}
+	}
+	if *limit > v.maxBodyBytesCeiling {
+		return []ValidationError{{
+			Field:   field,
+			Message: fmt.Sprintf("Body size limit must not exceed %d bytes", v.maxBodyBytesCeiling),
+		}}
+	}
+	return nil
+}
+
 // validateResilience validates a resilience block (timeout / idleTimeout). Both fields
 // are optional duration strings; "0s" is allowed (disables the timeout), negative and
 // malformed values are rejected. fieldPrefix is the path to the block (e.g.
 // "spec.resilience" or "spec.operations[2].resilience").
 func (v *APIValidator) validateResilience(fieldPrefix string, r *api.Resilience) []ValidationError {
-	return validateResilienceTimeouts(fieldPrefix, r)
+	errors := validateResilienceTimeouts(fieldPrefix, r)
+	return append(errors, validateResilienceRetries(fieldPrefix, r)...)
 }
 
 // validateResilienceTimeouts validates the timeout fields of a resilience block.
@@ -520,6 +657,76 @@ func validateResilienceTimeouts(fieldPrefix string, r *api.Resilience) []Validat
 	return errors
 }
 
+// validateResilienceRetries validates the retries block of a resilience block. A per-try
+// timeout may not exceed a non-zero route timeout configured in the same block.
+func validateResilienceRetries(fieldPrefix string, r *api.Resilience) []ValidationError {
+	var errors []ValidationError
+	if r == nil || r.Retries == nil {
+		return errors
+	}
+	field := fieldPrefix + ".retries"
+	retries := r.Retries
+
+	if retries.Attempts < 0 || retries.Attempts > constants.MaxRouteRetryAttempts {
+		errors = append(errors, ValidationError{
+			Field:   field + ".attempts",
+			Message: fmt.Sprintf("Retry attempts must be between 0 and %d", constants.MaxRouteRetryAttempts),
+		})
+	}
+
+	if retries.PerTryTimeout != nil {
+		perTry, err := optionalDuration(retries.PerTryTimeout, 0)
+		switch {
+		case err != nil:
+			errors = append(errors, ValidationError{Field: field + ".perTryTimeout", Message: err.Error()})
+		case perTry <= 0:
+			errors = append(errors, ValidationError{
+				Field:   field + ".perTryTimeout",
+				Message: "Per-try timeout must be greater than zero",
+			})
+		default:
+			if r.Timeout != nil && constants.ResilienceDurationRegex.MatchString(strings.TrimSpace(*r.Timeout)) {
+				if timeout, err := time.ParseDuration(strings.TrimSpace(*r.Timeout)); err == nil && timeout > 0 && perTry > timeout {
+					errors = append(errors, ValidationError{
+						Field:   field + ".perTryTimeout",
+						Message: "Per-try timeout must not exceed the route timeout",
+					})
+				}
+			}
+		}
+	}
+
+	if retries.RetryOn != nil {
+		if len(*retries.RetryOn) == 0 {
+			errors = append(errors, ValidationError{
+				Field:   field + ".retryOn",
+				Message: "At least one retry condition is required when retryOn is set",
+			})
+		}
+		seen := make(map[api.ResilienceRetriesRetryOn]bool, len(*retries.RetryOn))
+		for i, cond := range *retries.RetryOn {
+			switch cond {
+			case api.N5xx, api.GatewayError, api.ConnectFailure, api.RefusedStream, api.Reset, api.Retriable4xx:
+			default:
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.retryOn[%d]", field, i),
+					Message: fmt.Sprintf("Unsupported retry condition '%s'", cond),
+				})
+				continue
+			}
+			if seen[cond] {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.retryOn[%d]", field, i),
+					Message: fmt.Sprintf("Duplicate retry condition '%s'", cond),
+				})
+			}
+			seen[cond] = true
+		}
+	}
+
+	return errors
+}
+
 // validateContext validates the context path
 // ValidateContext validates a resource's context path. Exported so it can be
 // reused by other kinds' validators (e.g. an event-gateway-controller binary
@@ -628,6 +835,22 @@ func (v *APIValidator) validateOperations(operations []api.Operation) []Validati
 
 		// Validate operation-level resilience block
 		errors = append(errors, v.validateResilience(fmt.Sprintf("spec.operations[%d].resilience", i), op.Resilience)...)
+
+		// A WebSocket handshake is always a GET request
+		if op.Upgrade != nil {
+			upgradeField := fmt.Sprintf("spec.operations[%d].upgrade", i)
+			if *op.Upgrade != api.OperationUpgradeWebsocket {
+				errors = append(errors, ValidationError{
+					Field:   upgradeField,
+					Message: fmt.Sprintf("Unsupported upgrade '%s' (must be 'websocket')", *op.Upgrade),
+				})
+			} else if !strings.EqualFold(method, "GET") {
+				errors = append(errors, ValidationError{
+					Field:   upgradeField,
+					Message: "WebSocket upgrade requires the GET method",
+				})
+			}
+		}
 	}
 
 	return errors
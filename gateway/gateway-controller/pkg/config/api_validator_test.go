@@ -111,7 +111,7 @@ func TestAPIValidator_ValidateAPIVersion(t *testing.T) {
 			errors := v.Validate(config)
 			hasVersionError := false
 			for _, e := range errors {
-				if e.Field == "version" {
+				if e.Field == "apiVersion" {
 					hasVersionError = true
 					break
 				}
@@ -347,6 +347,324 @@ func TestAPIValidator_ValidateSandboxUpstream(t *testing.T) {
 	}
 }
 
+func TestAPIValidator_ValidateSandboxOnlyUpstream(t *testing.T) {
+	v := NewAPIValidator()
+
+	config := createValidRestAPIConfig()
+	config.Spec.Upstream.Main = api.Upstream{}
+	config.Spec.Upstream.Sandbox = &api.Upstream{
+		Url: stringPtr("http://sandbox:8080"),
+	}
+
+	for _, e := range v.Validate(config) {
+		if strings.HasPrefix(e.Field, "spec.upstream") {
+			t.Errorf("unexpected upstream error for sandbox-only API: %v", e)
+		}
+	}
+
+	// Without a sandbox upstream the main upstream is still required
+	config.Spec.Upstream.Sandbox = nil
+	hasMainError := false
+	for _, e := range v.Validate(config) {
+		if e.Field == "spec.upstream.main" {
+			hasMainError = true
+		}
+	}
+	if !hasMainError {
+		t.Errorf("expected spec.upstream.main error when neither upstream is configured")
+	}
+}
+
+func TestAPIValidator_ValidateUpstreamTargets(t *testing.T) {
+	v := NewAPIValidator()
+
+	tests := []struct {
+		name     string
+		targets  []api.UpstreamTarget
+		mainURL  *string
+		errField string
+		errMsg   string
+	}{
+		{
+			name: "90/10 split",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders-v1:8080/api", Weight: 90},
+				{Url: "https://orders-v2/api", Weight: 10},
+			},
+		},
+		{
+			name:    "single target",
+			targets: []api.UpstreamTarget{{Url: "http://orders-v1:8080", Weight: 100}},
+		},
+		{
+			name:     "no targets",
+			targets:  []api.UpstreamTarget{},
+			errField: "spec.upstream.main.targets",
+			errMsg:   "At least one target is required",
+		},
+		{
+			name: "weights do not add up to 100",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders-v1:8080", Weight: 90},
+				{Url: "http://orders-v2:8080", Weight: 20},
+			},
+			errField: "spec.upstream.main.targets",
+			errMsg:   "Target weights must add up to 100 (got 110)",
+		},
+		{
+			name: "all weights zero",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders-v1:8080", Weight: 0},
+				{Url: "http://orders-v2:8080", Weight: 0},
+			},
+			errField: "spec.upstream.main.targets",
+			errMsg:   "Target weights must add up to 100 (got 0)",
+		},
+		{
+			name: "weight out of range",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders-v1:8080", Weight: 110},
+				{Url: "http://orders-v2:8080", Weight: -10},
+			},
+			errField: "spec.upstream.main.targets[0].weight",
+			errMsg:   "Weight must be between 0 and 100",
+		},
+		{
+			name: "different paths",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders-v1:8080/v1", Weight: 50},
+				{Url: "http://orders-v2:8080/v2", Weight: 50},
+			},
+			errField: "spec.upstream.main.targets[1].url",
+			errMsg:   "All targets must share the same path (expected '/v1')",
+		},
+		{
+			name: "same host and default port",
+			targets: []api.UpstreamTarget{
+				{Url: "http://orders", Weight: 50},
+				{Url: "http://orders:80", Weight: 50},
+			},
+			errField: "spec.upstream.main.targets[1].url",
+			errMsg:   "Duplicate target host 'orders:80'",
+		},
+		{
+			name:     "invalid target URL",
+			targets:  []api.UpstreamTarget{{Url: "ftp://orders", Weight: 100}},
+			errField: "spec.upstream.main.targets[0].url",
+			errMsg:   "Upstream URL must use http or https scheme",
+		},
+		{
+			name:     "targets with url",
+			targets:  []api.UpstreamTarget{{Url: "http://orders-v1:8080", Weight: 100}},
+			mainURL:  stringPtr("http://backend:8080"),
+			errField: "spec.upstream.main",
+			errMsg:   "Specify 'targets' without 'url' or 'ref'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			targets := tt.targets
+			config.Spec.Upstream.Main = api.Upstream{Url: tt.mainURL, Targets: &targets}
+
+			var upstreamErrors []ValidationError
+			for _, e := range v.Validate(config) {
+				if strings.HasPrefix(e.Field, "spec.upstream") {
+					upstreamErrors = append(upstreamErrors, e)
+				}
+			}
+			if tt.errField == "" {
+				if len(upstreamErrors) > 0 {
+					t.Errorf("unexpected upstream errors: %v", upstreamErrors)
+				}
+				return
+			}
+			for _, e := range upstreamErrors {
+				if e.Field == tt.errField && e.Message == tt.errMsg {
+					return
+				}
+			}
+			t.Errorf("expected %s error %q, got: %v", tt.errField, tt.errMsg, upstreamErrors)
+		})
+	}
+}
+
+func TestAPIValidator_ValidateUpstreamHealthCheck(t *testing.T) {
+	v := NewAPIValidator()
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name     string
+		check    api.UpstreamHealthCheck
+		errField string
+	}{
+		{name: "path only", check: api.UpstreamHealthCheck{Path: "/healthz"}},
+		{
+			name: "all fields",
+			check: api.UpstreamHealthCheck{
+				Path:               "/healthz",
+				Interval:           stringPtr("30s"),
+				Timeout:            stringPtr("500ms"),
+				UnhealthyThreshold: intPtr(5),
+				HealthyThreshold:   intPtr(1),
+				ExpectedStatuses:   &[]int{200, 204},
+			},
+		},
+		{name: "relative path", check: api.UpstreamHealthCheck{Path: "healthz"}, errField: "spec.upstream.main.healthCheck.path"},
+		{name: "interval too short", check: api.UpstreamHealthCheck{Path: "/", Interval: stringPtr("100ms"), Timeout: stringPtr("50ms")}, errField: "spec.upstream.main.healthCheck.interval"},
+		{name: "interval too long", check: api.UpstreamHealthCheck{Path: "/", Interval: stringPtr("1h")}, errField: "spec.upstream.main.healthCheck.interval"},
+		{name: "compound interval", check: api.UpstreamHealthCheck{Path: "/", Interval: stringPtr("1m30s")}, errField: "spec.upstream.main.healthCheck.interval"},
+		{name: "timeout exceeds interval", check: api.UpstreamHealthCheck{Path: "/", Interval: stringPtr("2s"), Timeout: stringPtr("3s")}, errField: "spec.upstream.main.healthCheck.timeout"},
+		{name: "default timeout exceeds interval", check: api.UpstreamHealthCheck{Path: "/", Interval: stringPtr("2s")}, errField: "spec.upstream.main.healthCheck.timeout"},
+		{name: "zero timeout", check: api.UpstreamHealthCheck{Path: "/", Timeout: stringPtr("0s")}, errField: "spec.upstream.main.healthCheck.timeout"},
+		{name: "zero threshold", check: api.UpstreamHealthCheck{Path: "/", UnhealthyThreshold: intPtr(0)}, errField: "spec.upstream.main.healthCheck.unhealthyThreshold"},
+		{name: "threshold too high", check: api.UpstreamHealthCheck{Path: "/", HealthyThreshold: intPtr(11)}, errField: "spec.upstream.main.healthCheck.healthyThreshold"},
+		{name: "empty statuses", check: api.UpstreamHealthCheck{Path: "/", ExpectedStatuses: &[]int{}}, errField: "spec.upstream.main.healthCheck.expectedStatuses"},
+		{name: "invalid status", check: api.UpstreamHealthCheck{Path: "/", ExpectedStatuses: &[]int{200, 700}}, errField: "spec.upstream.main.healthCheck.expectedStatuses[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			check := tt.check
+			config.Spec.Upstream.Main.HealthCheck = &check
+
+			var checkErrors []ValidationError
+			for _, e := range v.Validate(config) {
+				if strings.HasPrefix(e.Field, "spec.upstream.main.healthCheck") {
+					checkErrors = append(checkErrors, e)
+				}
+			}
+			if tt.errField == "" {
+				if len(checkErrors) > 0 {
+					t.Errorf("unexpected health check errors: %v", checkErrors)
+				}
+				return
+			}
+			for _, e := range checkErrors {
+				if e.Field == tt.errField {
+					return
+				}
+			}
+			t.Errorf("expected error for field %s, got: %v", tt.errField, checkErrors)
+		})
+	}
+}
+
+func TestAPIValidator_ValidateUpstreamOutlierDetection(t *testing.T) {
+	v := NewAPIValidator()
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name     string
+		od       api.UpstreamOutlierDetection
+		errField string
+	}{
+		{name: "empty block uses defaults", od: api.UpstreamOutlierDetection{}},
+		{
+			name: "all fields",
+			od: api.UpstreamOutlierDetection{
+				Consecutive5xx:     intPtr(3),
+				Interval:           stringPtr("5s"),
+				BaseEjectionTime:   stringPtr("1m"),
+				MaxEjectionPercent: intPtr(100),
+			},
+		},
+		{name: "zero consecutive 5xx", od: api.UpstreamOutlierDetection{Consecutive5xx: intPtr(0)}, errField: "spec.upstream.main.outlierDetection.consecutive5xx"},
+		{name: "consecutive 5xx too high", od: api.UpstreamOutlierDetection{Consecutive5xx: intPtr(101)}, errField: "spec.upstream.main.outlierDetection.consecutive5xx"},
+		{name: "interval too short", od: api.UpstreamOutlierDetection{Interval: stringPtr("500ms")}, errField: "spec.upstream.main.outlierDetection.interval"},
+		{name: "malformed interval", od: api.UpstreamOutlierDetection{Interval: stringPtr("ten seconds")}, errField: "spec.upstream.main.outlierDetection.interval"},
+		{name: "ejection time too long", od: api.UpstreamOutlierDetection{BaseEjectionTime: stringPtr("2h")}, errField: "spec.upstream.main.outlierDetection.baseEjectionTime"},
+		{name: "zero ejection percent", od: api.UpstreamOutlierDetection{MaxEjectionPercent: intPtr(0)}, errField: "spec.upstream.main.outlierDetection.maxEjectionPercent"},
+		{name: "ejection percent too high", od: api.UpstreamOutlierDetection{MaxEjectionPercent: intPtr(150)}, errField: "spec.upstream.main.outlierDetection.maxEjectionPercent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			od := tt.od
+			config.Spec.Upstream.Main.OutlierDetection = &od
+
+			var odErrors []ValidationError
+			for _, e := range v.Validate(config) {
+				if strings.HasPrefix(e.Field, "spec.upstream.main.outlierDetection") {
+					odErrors = append(odErrors, e)
+				}
+			}
+			if tt.errField == "" {
+				if len(odErrors) > 0 {
+					t.Errorf("unexpected outlier detection errors: %v", odErrors)
+				}
+				return
+			}
+			for _, e := range odErrors {
+				if e.Field == tt.errField {
+					return
+				}
+			}
+			t.Errorf("expected error for field %s, got: %v", tt.errField, odErrors)
+		})
+	}
+}
+
+func TestAPIValidator_ValidateResilienceRetries(t *testing.T) {
+	v := NewAPIValidator()
+	retryOn := func(conds ...api.ResilienceRetriesRetryOn) *[]api.ResilienceRetriesRetryOn { return &conds }
+
+	tests := []struct {
+		name       string
+		resilience api.Resilience
+		errField   string
+	}{
+		{name: "attempts only", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 2}}},
+		{name: "zero attempts", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 0}}},
+		{
+			name: "all fields",
+			resilience: api.Resilience{
+				Timeout: stringPtr("120s"),
+				Retries: &api.ResilienceRetries{Attempts: 3, PerTryTimeout: stringPtr("30s"), RetryOn: retryOn(api.N5xx, api.Reset)},
+			},
+		},
+		{name: "per-try timeout with disabled route timeout", resilience: api.Resilience{Timeout: stringPtr("0s"), Retries: &api.ResilienceRetries{Attempts: 1, PerTryTimeout: stringPtr("5m")}}},
+		{name: "negative attempts", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: -1}}, errField: "spec.operations[0].resilience.retries.attempts"},
+		{name: "too many attempts", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 11}}, errField: "spec.operations[0].resilience.retries.attempts"},
+		{name: "zero per-try timeout", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 1, PerTryTimeout: stringPtr("0s")}}, errField: "spec.operations[0].resilience.retries.perTryTimeout"},
+		{name: "malformed per-try timeout", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 1, PerTryTimeout: stringPtr("1m30s")}}, errField: "spec.operations[0].resilience.retries.perTryTimeout"},
+		{name: "per-try timeout exceeds route timeout", resilience: api.Resilience{Timeout: stringPtr("10s"), Retries: &api.ResilienceRetries{Attempts: 1, PerTryTimeout: stringPtr("15s")}}, errField: "spec.operations[0].resilience.retries.perTryTimeout"},
+		{name: "empty retryOn", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 1, RetryOn: retryOn()}}, errField: "spec.operations[0].resilience.retries.retryOn"},
+		{name: "unsupported condition", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 1, RetryOn: retryOn("envoy-ratelimited")}}, errField: "spec.operations[0].resilience.retries.retryOn[0]"},
+		{name: "duplicate condition", resilience: api.Resilience{Retries: &api.ResilienceRetries{Attempts: 1, RetryOn: retryOn(api.N5xx, api.N5xx)}}, errField: "spec.operations[0].resilience.retries.retryOn[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			resilience := tt.resilience
+			config.Spec.Operations[0].Resilience = &resilience
+
+			var retryErrors []ValidationError
+			for _, e := range v.Validate(config) {
+				if strings.HasPrefix(e.Field, "spec.operations[0].resilience.retries") {
+					retryErrors = append(retryErrors, e)
+				}
+			}
+			if tt.errField == "" {
+				if len(retryErrors) > 0 {
+					t.Errorf("unexpected retry errors: %v", retryErrors)
+				}
+				return
+			}
+			for _, e := range retryErrors {
+				if e.Field == tt.errField {
+					return
+				}
+			}
+			t.Errorf("expected error for field %s, got: %v", tt.errField, retryErrors)
+		})
+	}
+}
+
 func TestAPIValidator_ValidateOperations(t *testing.T) {
 	v := NewAPIValidator()
 
@@ -500,6 +818,103 @@ func TestAPIValidator_ValidateResilience(t *testing.T) {
 	}
 }
 
+func TestAPIValidator_ValidateUpgrade(t *testing.T) {
+	v := NewAPIValidator()
+
+	tests := []struct {
+		name     string
+		mutate   func(cfg *api.RestAPI)
+		errField string
+	}{
+		{name: "WebSocket on a GET operation", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.Operations[0].Upgrade = api.Ptr(api.OperationUpgradeWebsocket)
+		}},
+		{name: "WebSocket on the main upstream", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.Upstream.Main.Upgrade = api.Ptr(api.UpstreamUpgradeWebsocket)
+		}},
+		{name: "WebSocket on a POST operation", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.Operations[0].Method = api.Ptr(api.OperationMethodPOST)
+			cfg.Spec.Operations[0].Upgrade = api.Ptr(api.OperationUpgradeWebsocket)
+		}, errField: "spec.operations[0].upgrade"},
+		{name: "Unknown operation upgrade", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.Operations[0].Upgrade = api.Ptr(api.OperationUpgrade("h2c"))
+		}, errField: "spec.operations[0].upgrade"},
+		{name: "Unknown upstream upgrade", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.Upstream.Main.Upgrade = api.Ptr(api.UpstreamUpgrade("CONNECT"))
+		}, errField: "spec.upstream.main.upgrade"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createValidRestAPIConfig()
+			tt.mutate(cfg)
+
+			errors := v.Validate(cfg)
+			if tt.errField == "" {
+				if len(errors) > 0 {
+					t.Errorf("expected no errors, got: %v", errors)
+				}
+				return
+			}
+			for _, e := range errors {
+				if e.Field == tt.errField {
+					return
+				}
+			}
+			t.Errorf("expected error for field %s, got: %v", tt.errField, errors)
+		})
+	}
+}
+
+func TestAPIValidator_ValidateBodyLimits(t *testing.T) {
+	v := NewAPIValidator()
+	v.SetMaxBodyBytesCeiling(1024)
+
+	tests := []struct {
+		name     string
+		mutate   func(cfg *api.RestAPI)
+		errField string
+	}{
+		{name: "Limits within the ceiling", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.MaxRequestBytes = api.Ptr(int64(512))
+			cfg.Spec.MaxResponseBytes = api.Ptr(int64(1024))
+		}},
+		{name: "Zero request limit", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.MaxRequestBytes = api.Ptr(int64(0))
+		}, errField: "spec.maxRequestBytes"},
+		{name: "Negative response limit", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.MaxResponseBytes = api.Ptr(int64(-1))
+		}, errField: "spec.maxResponseBytes"},
+		{name: "Request limit above the ceiling", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.MaxRequestBytes = api.Ptr(int64(1025))
+		}, errField: "spec.maxRequestBytes"},
+		{name: "Response limit above the ceiling", mutate: func(cfg *api.RestAPI) {
+			cfg.Spec.MaxResponseBytes = api.Ptr(int64(2048))
+		}, errField: "spec.maxResponseBytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createValidRestAPIConfig()
+			tt.mutate(cfg)
+
+			errors := v.Validate(cfg)
+			if tt.errField == "" {
+				if len(errors) > 0 {
+					t.Errorf("expected no errors, got: %v", errors)
+				}
+				return
+			}
+			for _, e := range errors {
+				if e.Field == tt.errField {
+					return
+				}
+			}
+			t.Errorf("expected error for field %s, got: %v", tt.errField, errors)
+		})
+	}
+}
+
 func TestAPIValidator_ValidateAllHTTPMethods(t *testing.T) {
 	v := NewAPIValidator()
 
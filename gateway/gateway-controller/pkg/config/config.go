@@ -21,6 +21,9 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +33,7 @@ import (
 	"github.com/knadh/koanf/providers/confmap"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
+	"github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/common/collector"
 	"github.com/wso2/api-platform/common/configinterpolate"
 	commonconstants "github.com/wso2/api-platform/common/constants"
@@ -54,8 +58,8 @@ var defaultFileSourceAllowlist = []string{
 type Config struct {
 	Controller           Controller             `koanf:"controller"`
 	Router               RouterConfig           `koanf:"router"`
-	PolicyEngine         map[string]interface{} `koanf:"policy_engine"`
-	PolicyConfigurations map[string]interface{} `koanf:"policy_configurations"`
+	PolicyEngine         map[string]interface{} `koanf:"policy_engine" secret:"true"`
+	PolicyConfigurations map[string]interface{} `koanf:"policy_configurations" secret:"true"`
 	Collector            CollectorConfig        `koanf:"collector"`
 	Analytics            AnalyticsConfig        `koanf:"analytics"`
 	TrafficLogging       TrafficLoggingConfig   `koanf:"traffic_logging"`
@@ -164,7 +168,7 @@ type AnalyticsPublishersConfig struct {
 
 // MoesifPublisherConfig holds Moesif-specific configuration
 type MoesifPublisherConfig struct {
-	ApplicationID      string `koanf:"application_id"`
+	ApplicationID      string `koanf:"application_id" secret:"true"`
 	BaseURL            string `koanf:"moesif_base_url"`
 	PublishInterval    int    `koanf:"publish_interval"`
 	EventQueueSize     int    `koanf:"event_queue_size"`
@@ -204,9 +208,70 @@ type Controller struct {
 	Policies     PoliciesConfig     `koanf:"policies"`
 	LLM          LLMConfig          `koanf:"llm"`
 	Auth         AuthConfig         `koanf:"auth"`
+	Authz        AuthzConfig        `koanf:"authz"`
 	Metrics      MetricsConfig      `koanf:"metrics"`
 	Encryption   EncryptionConfig   `koanf:"encryption"`
 	EventHub     EventHubConfig     `koanf:"event_hub"`
+	Certificates CertificatesConfig `koanf:"certificates"`
+	// EnvInterpolation controls ${VAR} references in API upstream URLs and vhosts.
+	EnvInterpolation EnvInterpolationConfig `koanf:"env_interpolation"`
+	// DefaultPolicies are applied to every REST API, ahead of its own policies.
+	DefaultPolicies []DefaultPolicyConfig `koanf:"default_policies"`
+}
+
+// DefaultPolicyConfig is a policy applied to every REST API without being
+// listed in it. A default runs before the API's own policies and is left out
+// of an API that lists a policy of the same name, at the API or operation
+// level, or names it in excludedDefaultPolicies.
+type DefaultPolicyConfig struct {
+	Name               string                 `koanf:"name"`
+	Version            string                 `koanf:"version"` // Major version, e.g. v1; the latest when empty
+	Params             map[string]interface{} `koanf:"params" secret:"true"`
+	ExecutionCondition string                 `koanf:"execution_condition"`
+}
+
+// EnvInterpolationConfig lists the controller environment variables that
+// ${VAR} and ${ENV:VAR} references in API specs may resolve. References to any
+// other variable fail the deployment.
+type EnvInterpolationConfig struct {
+	AllowedVars []string `koanf:"allowed_vars"`
+}
+
+// CertificatesConfig holds configuration for certificates managed by the controller
+type CertificatesConfig struct {
+	ACME          ACMEConfig                     `koanf:"acme"`
+	ExpiryMonitor CertificateExpiryMonitorConfig `koanf:"expiry_monitor"`
+}
+
+// CertificateExpiryMonitorConfig holds configuration for the background scan that
+// reports how close stored certificates are to expiry and, optionally, whether
+// their issuer has revoked them.
+type CertificateExpiryMonitorConfig struct {
+	Enabled          bool          `koanf:"enabled"`
+	CheckInterval    time.Duration `koanf:"check_interval"`    // How often stored certificates are scanned (default: 1h)
+	WarningThreshold time.Duration `koanf:"warning_threshold"` // Log a warning once a certificate expires within this window (default: 720h)
+	OCSPEnabled      bool          `koanf:"ocsp_enabled"`      // Query the OCSP responder named in each certificate
+	OCSPTimeout      time.Duration `koanf:"ocsp_timeout"`      // Timeout for a single OCSP request (default: 10s)
+}
+
+// ACMEConfig holds configuration for obtaining the HTTPS listener certificate from an
+// ACME certificate authority (e.g. Let's Encrypt). Issued certificates are served to
+// the router over SDS and renewed without a restart.
+type ACMEConfig struct {
+	Enabled       bool          `koanf:"enabled"`
+	DirectoryURL  string        `koanf:"directory_url"`  // ACME directory URL (default: Let's Encrypt production)
+	Email         string        `koanf:"email"`          // Contact email registered with the ACME account
+	Domains       []string      `koanf:"domains"`        // DNS names the certificate must cover
+	ChallengeType string        `koanf:"challenge_type"` // "http-01" (default) or "dns-01"
+	StorageDir    string        `koanf:"storage_dir"`    // Directory holding the account key and issued certificate
+	RenewBefore   time.Duration `koanf:"renew_before"`   // Renew once the certificate expires within this window (default: 720h)
+	CheckInterval time.Duration `koanf:"check_interval"` // How often the certificate expiry is checked (default: 12h)
+	// HTTP01ListenAddress is where the controller answers HTTP-01 challenges. Requests to
+	// /.well-known/acme-challenge/ on port 80 of every domain must be forwarded here.
+	HTTP01ListenAddress string `koanf:"http01_listen_address"`
+	// DNS01Hook is an executable invoked as "<hook> present|cleanup <fqdn> <value>" to
+	// publish and remove the _acme-challenge TXT record for DNS-01 challenges.
+	DNS01Hook string `koanf:"dns01_hook"`
 }
 
 // MetricsConfig holds Prometheus metrics server configuration
@@ -238,6 +303,23 @@ type EventHubDatabaseConfig struct {
 type AuthConfig struct {
 	Basic BasicAuth `koanf:"basic"`
 	IDP   IDPConfig `koanf:"idp"`
+	// IDPs lists further identity providers. A JWT is validated by the
+	// provider whose issuer matches its "iss" claim.
+	IDPs []IDPConfig `koanf:"idps"`
+}
+
+// EnabledIDPs returns the enabled identity providers, IDP first
+func (a AuthConfig) EnabledIDPs() []IDPConfig {
+	var idps []IDPConfig
+	if a.IDP.Enabled {
+		idps = append(idps, a.IDP)
+	}
+	for _, idp := range a.IDPs {
+		if idp.Enabled {
+			idps = append(idps, idp)
+		}
+	}
+	return idps
 }
 
 // BasicAuth describes basic authentication configuration
@@ -249,18 +331,44 @@ type BasicAuth struct {
 // AuthUser describes a locally configured user
 type AuthUser struct {
 	Username       string   `koanf:"username"`
-	Password       string   `koanf:"password"`        // plain or hashed value depending on PasswordHashed
-	PasswordHashed bool     `koanf:"password_hashed"` // true when Password is a bcrypt hash
+	Password       string   `koanf:"password" secret:"true"` // plain or hashed value depending on PasswordHashed
+	PasswordHashed bool     `koanf:"password_hashed"`        // true when Password is a bcrypt hash
 	Roles          []string `koanf:"roles"`
 }
 
+// AuthzConfig holds authorization checks applied after the role check
+type AuthzConfig struct {
+	OPA OPAConfig `koanf:"opa"`
+}
+
+// OPAConfig configures an embedded OPA policy that decides, after the role
+// check, whether each management API request is allowed
+type OPAConfig struct {
+	Enabled    bool   `koanf:"enabled"`
+	PolicyPath string `koanf:"policy_path"` // Rego file, or directory of Rego and data files
+	BundlePath string `koanf:"bundle_path"` // OPA bundle directory or .tar.gz archive
+	Query      string `koanf:"query"`       // Rule that must evaluate to true (default: data.gateway.authz.allow)
+}
+
 // IDPConfig describes an external identity provider for JWT validation
 type IDPConfig struct {
-	Enabled     bool                `koanf:"enabled"`
-	JWKSURL     string              `koanf:"jwks_url"`
-	Issuer      string              `koanf:"issuer"`
-	RolesClaim  string              `koanf:"roles_claim"`
+	Enabled bool   `koanf:"enabled"`
+	JWKSURL string `koanf:"jwks_url"`
+	Issuer  string `koanf:"issuer"`
+	// RolesClaim is the claim holding the roles, or a path to it in dot/bracket
+	// notation such as realm_access.roles
+	RolesClaim string `koanf:"roles_claim"`
+	// RolesClaims lists further claims whose roles are merged with RolesClaim
+	RolesClaims []string            `koanf:"roles_claims"`
 	RoleMapping map[string][]string `koanf:"role_mapping"` // local role -> idp roles
+
+	// JWKSRefreshInterval is how often the JWKS is fetched in the background
+	JWKSRefreshInterval time.Duration `koanf:"jwks_refresh_interval"`
+	// JWKSFetchTimeout bounds a single JWKS fetch
+	JWKSFetchTimeout time.Duration `koanf:"jwks_fetch_timeout"`
+	// JWKSCacheTTL is how long the keys of the last successful fetch are used
+	// while refreshes fail. Zero keeps them until the next successful fetch.
+	JWKSCacheTTL time.Duration `koanf:"jwks_cache_ttl"`
 }
 
 // TracingConfig holds OpenTelemetry tracing configuration
@@ -291,11 +399,23 @@ type TracingConfig struct {
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
-	APIPort                         int           `koanf:"api_port"`
-	XDSPort                         int           `koanf:"xds_port"`
-	ShutdownTimeout                 time.Duration `koanf:"shutdown_timeout"`
-	GatewayID                       string        `koanf:"gateway_id"`
-	SkipInvalidDeploymentsOnStartup bool          `koanf:"skip_invalid_deployments_on_startup"`
+	APIPort                         int                 `koanf:"api_port"`
+	APISocket                       string              `koanf:"api_socket"` // Unix socket path for the REST API; replaces api_port when set
+	XDSPort                         int                 `koanf:"xds_port"`
+	ShutdownTimeout                 time.Duration       `koanf:"shutdown_timeout"`
+	DrainTimeout                    time.Duration       `koanf:"drain_timeout"`
+	GatewayID                       string              `koanf:"gateway_id"`
+	SkipInvalidDeploymentsOnStartup bool                `koanf:"skip_invalid_deployments_on_startup"`
+	UpstreamProbe                   UpstreamProbeConfig `koanf:"upstream_probe"`
+}
+
+// UpstreamProbeConfig configures the reachability probe of REST API upstreams
+// run after a create or update. Unreachable upstreams are only reported.
+type UpstreamProbeConfig struct {
+	// Enabled probes on every create and update; the validateUpstream query
+	// parameter overrides it per request.
+	Enabled bool          `koanf:"enabled"`
+	Timeout time.Duration `koanf:"timeout"`
 }
 
 // AdminServerConfig holds controller admin HTTP server configuration.
@@ -304,6 +424,9 @@ type AdminServerConfig struct {
 	Port       int         `koanf:"port"`
 	AllowedIPs []string    `koanf:"allowed_ips"`
 	Pprof      PprofConfig `koanf:"pprof"`
+	// ReadinessRequiresControlPlane makes GET /ready report not ready while the
+	// control plane is configured but not connected.
+	ReadinessRequiresControlPlane bool `koanf:"readiness_requires_control_plane"`
 }
 
 // PprofConfig gates the Go runtime profiling endpoints (net/http/pprof) served on
@@ -329,12 +452,16 @@ type PolicyServerTLS struct {
 	Enabled  bool   `koanf:"enabled"`
 	CertFile string `koanf:"cert_file"`
 	KeyFile  string `koanf:"key_file"`
+	// ClientCAFile, when set, requires policy engines to present a certificate
+	// issued by this CA before they can stream policies or report API key usage
+	ClientCAFile string `koanf:"client_ca_file"`
 }
 
 // PoliciesConfig holds policy-related configuration
 type PoliciesConfig struct {
 	DefinitionsPath   string `koanf:"definitions_path"`    // Directory containing policy definitions
 	BuildManifestPath string `koanf:"build_manifest_path"` // Path to build-manifest.yaml for custom policy detection
+	HotReload         bool   `koanf:"hot_reload"`          // Watch DefinitionsPath and reload definitions on change
 }
 
 type LLMConfig struct {
@@ -352,13 +479,13 @@ type StorageConfig struct {
 // DatabaseConfig holds unified database configuration for all SQL backends.
 type DatabaseConfig struct {
 	Driver          string            `koanf:"driver"`
-	DSN             string            `koanf:"dsn"`
+	DSN             string            `koanf:"dsn" secret:"true"`
 	Path            string            `koanf:"path"`
 	Host            string            `koanf:"host"`
 	Port            int               `koanf:"port"`
 	Database        string            `koanf:"database"`
 	User            string            `koanf:"user"`
-	Password        string            `koanf:"password"`
+	Password        string            `koanf:"password" secret:"true"`
 	ConnectTimeout  time.Duration     `koanf:"connect_timeout"`
 	MaxOpenConns    int               `koanf:"max_open_conns"`
 	MaxIdleConns    int               `koanf:"max_idle_conns"`
@@ -375,12 +502,12 @@ type SQLiteConfig struct {
 
 // PostgresConfig holds PostgreSQL-specific configuration.
 type PostgresConfig struct {
-	DSN             string        `koanf:"dsn"`
+	DSN             string        `koanf:"dsn" secret:"true"`
 	Host            string        `koanf:"host"`
 	Port            int           `koanf:"port"`
 	Database        string        `koanf:"database"`
 	User            string        `koanf:"user"`
-	Password        string        `koanf:"password"`
+	Password        string        `koanf:"password" secret:"true"`
 	SSLMode         string        `koanf:"sslmode"`
 	ConnectTimeout  time.Duration `koanf:"connect_timeout"`
 	MaxOpenConns    int           `koanf:"max_open_conns"`
@@ -577,6 +704,11 @@ type DownstreamTLS struct {
 	MinimumProtocolVersion string `koanf:"minimum_protocol_version"`
 	MaximumProtocolVersion string `koanf:"maximum_protocol_version"`
 	Ciphers                string `koanf:"ciphers"`
+	// RequestClientCertificate makes the HTTPS listener ask clients for a certificate
+	// and forward it to the policy engine in the x-forwarded-client-cert header, where
+	// the mtls-auth policy verifies it per API. Clients without a certificate can still
+	// connect.
+	RequestClientCertificate bool `koanf:"request_client_certificate"`
 }
 
 // VHostsConfig for vhosts configuration
@@ -598,6 +730,7 @@ type HTTPListenerConfig struct {
 	ServerHeaderValue             string      `koanf:"server_header_value"`               // Custom value for the Server header
 	Timeouts                      HCMTimeouts `koanf:"timeouts"`                          // HTTP Connection Manager (downstream) timeouts
 	PerConnectionBufferLimitBytes uint32      `koanf:"per_connection_buffer_limit_bytes"` // Downstream per-connection buffer limit in bytes
+	MaxBodyBytesCeiling           uint32      `koanf:"max_body_bytes_ceiling"`            // Upper bound for per-API maxRequestBytes/maxResponseBytes
 }
 
 // HCMTimeouts holds HTTP Connection Manager (downstream/connection) timeouts.
@@ -644,20 +777,24 @@ type LoggingConfig struct {
 
 // ControlPlaneConfig holds control plane connection configuration
 type ControlPlaneConfig struct {
-	Host                  string        `koanf:"host"`                    // Control plane hostname
-	Token                 string        `koanf:"token"`                   // Registration token (api-key)
-	ReconnectInitial      time.Duration `koanf:"reconnect_initial"`       // Initial retry delay
-	ReconnectMax          time.Duration `koanf:"reconnect_max"`           // Maximum retry delay
-	PollingInterval       time.Duration `koanf:"polling_interval"`        // Reconciliation polling interval
-	InsecureSkipVerify    bool          `koanf:"insecure_skip_verify"`    // Skip TLS certificate verification (insecure, dev/test only)
-	DeploymentSyncEnabled bool          `koanf:"deployment_sync_enabled"` // Enable two-way artifact/deployment sync with the control plane: DP->CP push and CP->DP pull (default: true)
-	SyncBatchSize         int           `koanf:"sync_batch_size"`         // Number of deployments to fetch per batch request during startup sync (default: 50)
+	Host                      string        `koanf:"host"`                        // Control plane hostname
+	Token                     string        `koanf:"token" secret:"true"`         // Registration token (api-key)
+	ReconnectInitial          time.Duration `koanf:"reconnect_initial"`           // Initial retry delay
+	ReconnectMax              time.Duration `koanf:"reconnect_max"`               // Maximum retry delay
+	ReconnectMultiplier       float64       `koanf:"reconnect_multiplier"`        // Factor applied to the backoff ceiling after each failed attempt (default: 2)
+	ReconnectFailureThreshold int           `koanf:"reconnect_failure_threshold"` // Consecutive failures before switching to slow probing (default: 10, 0 disables)
+	ReconnectProbeInterval    time.Duration `koanf:"reconnect_probe_interval"`    // Delay between slow-probe attempts (default: 10m)
+	PollingInterval           time.Duration `koanf:"polling_interval"`            // Reconciliation polling interval
+	InsecureSkipVerify        bool          `koanf:"insecure_skip_verify"`        // Skip TLS certificate verification (insecure, dev/test only)
+	DeploymentSyncEnabled     bool          `koanf:"deployment_sync_enabled"`     // Enable two-way artifact/deployment sync with the control plane: DP->CP push and CP->DP pull (default: true)
+	SyncBatchSize             int           `koanf:"sync_batch_size"`             // Number of deployments to fetch per batch request during startup sync (default: 50)
+	PushLocalChanges          bool          `koanf:"push_local_changes"`          // Push APIs created or updated through the management API to the control plane (default: true)
 	// OAuth2 credentials for on-prem APIM API import (for bottom-up API deployment)
-	ApimOAuth2ClientID     string `koanf:"apim_oauth2_client_id"`     // APIM OAuth2 client ID
-	ApimOAuth2ClientSecret string `koanf:"apim_oauth2_client_secret"` // APIM OAuth2 client secret
-	ApimOAuth2Username     string `koanf:"apim_oauth2_username"`      // APIM resource owner username
-	ApimOAuth2Password     string `koanf:"apim_oauth2_password"`      // APIM resource owner password
-	GatewayName            string `koanf:"gateway_name"`              // Name of the gateway for deployment configuration
+	ApimOAuth2ClientID     string `koanf:"apim_oauth2_client_id"`                   // APIM OAuth2 client ID
+	ApimOAuth2ClientSecret string `koanf:"apim_oauth2_client_secret" secret:"true"` // APIM OAuth2 client secret
+	ApimOAuth2Username     string `koanf:"apim_oauth2_username"`                    // APIM resource owner username
+	ApimOAuth2Password     string `koanf:"apim_oauth2_password" secret:"true"`      // APIM resource owner password
+	GatewayName            string `koanf:"gateway_name"`                            // Name of the gateway for deployment configuration
 }
 
 // APIKeyConfig represents the configuration for API keys
@@ -669,6 +806,85 @@ type APIKeyConfig struct {
 	// Issuer identifies this gateway's portal; when non-empty, only API keys whose
 	// issuer field matches (or is null) will be accepted by the api-key-auth policy.
 	Issuer string `koanf:"issuer"`
+	// Prefix is prepended to locally generated API keys (e.g. "acme_"); defaults to "apip_".
+	// Keys issued under an earlier prefix keep working since lookups hash the full value.
+	Prefix string `koanf:"prefix"`
+	// Mode selects how locally generated keys are issued: "stateful" (default) or "signed".
+	// Signed keys carry their key ID, API ID and expiry and can be verified without a store lookup.
+	Mode string `koanf:"mode"`
+	// SigningSecretFile is the path to the secret used to sign keys; required when Mode is "signed".
+	SigningSecretFile string `koanf:"signing_secret_file"`
+	// Peppers are server-side secrets that key the stored SHA-256 hash of every new key with
+	// HMAC-SHA3-256. Each hash records the ID of its pepper, so older peppers stay listed
+	// until no hash uses them. The policy engine must be configured with the same peppers.
+	Peppers []APIKeyPepperConfig `koanf:"peppers"`
+	// ActivePepper is the ID of the pepper used for new hashes; defaults to the only pepper
+	// when exactly one is configured.
+	ActivePepper string `koanf:"active_pepper"`
+	// CreationRateLimit caps how many API keys a user can create through the REST API within a sliding window
+	CreationRateLimit APIKeyCreationRateLimitConfig `koanf:"creation_rate_limit"`
+	// ExpirySweeper periodically revokes keys that are past their expiry time
+	ExpirySweeper APIKeyExpirySweeperConfig `koanf:"expiry_sweeper"`
+	// Webhook notifies an external endpoint of API key lifecycle operations
+	Webhook APIKeyWebhookConfig `koanf:"webhook"`
+
+	// signingSecret holds the secret read from SigningSecretFile during validation
+	signingSecret []byte
+	// peppers holds the secrets read from the pepper files during validation, keyed by ID
+	peppers map[string][]byte
+}
+
+// APIKeyCreationRateLimitConfig represents the per-user API key creation rate limit
+type APIKeyCreationRateLimitConfig struct {
+	MaxKeys int           `koanf:"max_keys"` // Keys a user can create within the window; 0 disables the limit
+	Window  time.Duration `koanf:"window"`   // Length of the sliding window (default: 1h)
+}
+
+// APIKeyExpirySweeperConfig represents the configuration of the API key expiry sweeper
+type APIKeyExpirySweeperConfig struct {
+	Enabled  bool          `koanf:"enabled"`  // Revoke expired keys in the background (default: false)
+	Interval time.Duration `koanf:"interval"` // How often expired keys are swept (default: 5m)
+}
+
+// APIKeyWebhookConfig represents the outbound webhook notified of API key lifecycle events
+type APIKeyWebhookConfig struct {
+	Enabled      bool          `koanf:"enabled"`       // Send lifecycle events (default: false)
+	URL          string        `koanf:"url"`           // HTTPS endpoint receiving the events
+	SecretFile   string        `koanf:"secret_file"`   // Path to the HMAC secret used to sign events; owner-readable only
+	Timeout      time.Duration `koanf:"timeout"`       // Timeout for a single delivery attempt (default: 5s)
+	MaxRetries   int           `koanf:"max_retries"`   // Retries after a failed delivery (default: 3)
+	RetryBackoff time.Duration `koanf:"retry_backoff"` // Delay before the first retry, doubled on each retry (default: 1s)
+	QueueSize    int           `koanf:"queue_size"`    // Events buffered for delivery; further events are dropped (default: 1000)
+
+	// secret holds the secret read from SecretFile during validation
+	secret []byte
+}
+
+// Secret returns the webhook signing secret loaded during validation (nil when disabled)
+func (c *APIKeyWebhookConfig) Secret() []byte {
+	return c.secret
+}
+
+// APIKeyPepperConfig identifies a pepper and the file holding its secret
+type APIKeyPepperConfig struct {
+	ID   string `koanf:"id"`   // Recorded in each hash; letters, digits, '_' or '-'
+	File string `koanf:"file"` // Path to the secret; must be readable by the owner only
+}
+
+// Pepper returns the pepper secret loaded during validation for the given ID
+func (c *APIKeyConfig) Pepper(id string) ([]byte, bool) {
+	pepper, ok := c.peppers[id]
+	return pepper, ok
+}
+
+// PepperSecrets returns the pepper secrets loaded during validation, keyed by ID
+func (c *APIKeyConfig) PepperSecrets() map[string][]byte {
+	return c.peppers
+}
+
+// SigningSecret returns the API key signing secret loaded during validation (nil in stateful mode)
+func (c *APIKeyConfig) SigningSecret() []byte {
+	return c.signingSecret
 }
 
 // EncryptionConfig holds encryption provider configuration
@@ -782,8 +998,13 @@ func defaultConfig() *Config {
 				APIPort:                         9090,
 				XDSPort:                         18000,
 				ShutdownTimeout:                 15 * time.Second,
+				DrainTimeout:                    0,
 				GatewayID:                       constants.PlatformGatewayId,
 				SkipInvalidDeploymentsOnStartup: false,
+				UpstreamProbe: UpstreamProbeConfig{
+					Enabled: false,
+					Timeout: 3 * time.Second,
+				},
 			},
 			AdminServer: AdminServerConfig{
 				Enabled:    true,
@@ -806,6 +1027,7 @@ func defaultConfig() *Config {
 			Policies: PoliciesConfig{
 				DefinitionsPath:   "./default-policies",
 				BuildManifestPath: "./build-manifest.yaml",
+				HotReload:         true,
 			},
 			LLM: LLMConfig{
 				TemplateDefinitionsPath: "./default-llm-provider-templates",
@@ -837,6 +1059,16 @@ func defaultConfig() *Config {
 					Issuer:      "",
 					RolesClaim:  "",
 					RoleMapping: map[string][]string{},
+
+					JWKSRefreshInterval: 10 * time.Minute,
+					JWKSFetchTimeout:    10 * time.Second,
+					JWKSCacheTTL:        time.Hour,
+				},
+			},
+			Authz: AuthzConfig{
+				OPA: OPAConfig{
+					Enabled: false,
+					Query:   "data.gateway.authz.allow",
 				},
 			},
 			Logging: LoggingConfig{
@@ -848,14 +1080,36 @@ func defaultConfig() *Config {
 				Port:    9091,
 			},
 			ControlPlane: ControlPlaneConfig{
-				Host:                  "",
-				Token:                 "",
-				ReconnectInitial:      1 * time.Second,
-				ReconnectMax:          5 * time.Minute,
-				PollingInterval:       15 * time.Minute,
-				InsecureSkipVerify:    false,
-				DeploymentSyncEnabled: true,
-				SyncBatchSize:         50,
+				Host:                      "",
+				Token:                     "",
+				ReconnectInitial:          1 * time.Second,
+				ReconnectMax:              5 * time.Minute,
+				ReconnectMultiplier:       2,
+				ReconnectFailureThreshold: 10,
+				ReconnectProbeInterval:    10 * time.Minute,
+				PollingInterval:           15 * time.Minute,
+				InsecureSkipVerify:        false,
+				DeploymentSyncEnabled:     true,
+				SyncBatchSize:             50,
+				PushLocalChanges:          true,
+			},
+			Certificates: CertificatesConfig{
+				ACME: ACMEConfig{
+					Enabled:             false,
+					DirectoryURL:        "https://acme-v02.api.letsencrypt.org/directory",
+					ChallengeType:       "http-01",
+					StorageDir:          "./data/acme",
+					RenewBefore:         30 * 24 * time.Hour,
+					CheckInterval:       12 * time.Hour,
+					HTTP01ListenAddress: ":5002",
+				},
+				ExpiryMonitor: CertificateExpiryMonitorConfig{
+					Enabled:          true,
+					CheckInterval:    time.Hour,
+					WarningThreshold: 30 * 24 * time.Hour,
+					OCSPEnabled:      false,
+					OCSPTimeout:      10 * time.Second,
+				},
 			},
 			EventHub: EventHubConfig{
 				PollInterval:    3 * time.Second,
@@ -980,7 +1234,8 @@ func defaultConfig() *Config {
 					StreamIdleTimeout:     5 * time.Minute, // Envoy default
 					IdleTimeout:           1 * time.Hour,   // Envoy default (connection-level)
 				},
-				PerConnectionBufferLimitBytes: 1048576, // 1 MiB, matches Envoy's built-in default
+				PerConnectionBufferLimitBytes: 1048576,  // 1 MiB, matches Envoy's built-in default
+				MaxBodyBytesCeiling:           10485760, // 10 MiB
 			},
 		},
 		Analytics: AnalyticsConfig{
@@ -1023,6 +1278,21 @@ func defaultConfig() *Config {
 			Algorithm:            constants.HashingAlgorithmSHA256,
 			MinKeyLength:         constants.DefaultMinAPIKeyLength,
 			MaxKeyLength:         constants.DefaultMaxAPIKeyLength,
+			Prefix:               constants.APIKeyPrefix,
+			Mode:                 constants.APIKeyModeStateful,
+			CreationRateLimit: APIKeyCreationRateLimitConfig{
+				Window: constants.DefaultAPIKeyCreationRateLimitWindow,
+			},
+			ExpirySweeper: APIKeyExpirySweeperConfig{
+				Enabled:  false,
+				Interval: constants.DefaultAPIKeyExpirySweepInterval,
+			},
+			Webhook: APIKeyWebhookConfig{
+				Timeout:      5 * time.Second,
+				MaxRetries:   3,
+				RetryBackoff: time.Second,
+				QueueSize:    1000,
+			},
 		},
 		ImmutableGateway: ImmutableGatewayConfig{
 			Enabled:      false,
@@ -1293,15 +1563,36 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("server.xds_port must be between 1 and 65535, got: %d", c.Controller.Server.XDSPort)
 	}
 
+	if socket := c.Controller.Server.APISocket; socket != "" && !filepath.IsAbs(socket) {
+		return fmt.Errorf("server.api_socket must be an absolute path, got: %s", socket)
+	}
+
 	if strings.TrimSpace(c.Controller.Server.GatewayID) == "" {
 		return fmt.Errorf("server.gateway_id is required and cannot be empty")
 	}
 
+	for _, name := range c.Controller.EnvInterpolation.AllowedVars {
+		if !envVarNamePattern.MatchString(name) {
+			return fmt.Errorf("env_interpolation.allowed_vars must hold environment variable names, got: %q", name)
+		}
+	}
+
+	if c.Controller.Server.DrainTimeout < 0 {
+		return fmt.Errorf("server.drain_timeout must not be negative, got: %s", c.Controller.Server.DrainTimeout)
+	}
+
+	if c.Controller.Server.UpstreamProbe.Timeout == 0 {
+		c.Controller.Server.UpstreamProbe.Timeout = 3 * time.Second
+	}
+	if t := c.Controller.Server.UpstreamProbe.Timeout; t < 0 || t > 30*time.Second {
+		return fmt.Errorf("server.upstream_probe.timeout must be between 0s and 30s, got: %s", t)
+	}
+
 	if c.Controller.AdminServer.Enabled {
 		if c.Controller.AdminServer.Port < 1 || c.Controller.AdminServer.Port > 65535 {
 			return fmt.Errorf("admin_server.port must be between 1 and 65535, got: %d", c.Controller.AdminServer.Port)
 		}
-		if c.Controller.AdminServer.Port == c.Controller.Server.APIPort {
+		if c.Controller.Server.APISocket == "" && c.Controller.AdminServer.Port == c.Controller.Server.APIPort {
 			return fmt.Errorf("admin_server.port cannot be same as server.api_port")
 		}
 		if c.Controller.AdminServer.Port == c.Controller.Server.XDSPort {
@@ -1314,7 +1605,7 @@ func (c *Config) Validate() error {
 		if c.Controller.Metrics.Port < 1 || c.Controller.Metrics.Port > 65535 {
 			return fmt.Errorf("metrics.port must be between 1 and 65535, got: %d", c.Controller.Metrics.Port)
 		}
-		if c.Controller.Metrics.Port == c.Controller.Server.APIPort {
+		if c.Controller.Server.APISocket == "" && c.Controller.Metrics.Port == c.Controller.Server.APIPort {
 			return fmt.Errorf("metrics.port cannot be same as server.api_port")
 		}
 		if c.Controller.Metrics.Port == c.Controller.Server.XDSPort {
@@ -1325,6 +1616,18 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate policy xDS server TLS: serving TLS needs a certificate and its key
+	if c.Controller.PolicyServer.TLS.Enabled {
+		if strings.TrimSpace(c.Controller.PolicyServer.TLS.CertFile) == "" {
+			return fmt.Errorf("policy_server.tls.cert_file is required when policy_server.tls.enabled is true")
+		}
+		if strings.TrimSpace(c.Controller.PolicyServer.TLS.KeyFile) == "" {
+			return fmt.Errorf("policy_server.tls.key_file is required when policy_server.tls.enabled is true")
+		}
+	} else if strings.TrimSpace(c.Controller.PolicyServer.TLS.ClientCAFile) != "" {
+		return fmt.Errorf("policy_server.tls.client_ca_file requires policy_server.tls.enabled to be true")
+	}
+
 	if c.Router.ListenerPort < 1 || c.Router.ListenerPort > 65535 {
 		return fmt.Errorf("router.listener_port must be between 1 and 65535, got: %d", c.Router.ListenerPort)
 	}
@@ -1396,6 +1699,114 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.validateACMEConfig(); err != nil {
+		return err
+	}
+
+	if err := c.validateCertificateExpiryMonitorConfig(); err != nil {
+		return err
+	}
+
+	if err := c.validateDefaultPoliciesConfig(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDefaultPoliciesConfig validates the policies applied to every API
+func (c *Config) validateDefaultPoliciesConfig() error {
+	seen := make(map[string]bool, len(c.Controller.DefaultPolicies))
+	for i, p := range c.Controller.DefaultPolicies {
+		if strings.TrimSpace(p.Name) == "" {
+			return fmt.Errorf("controller.default_policies[%d].name is required", i)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("controller.default_policies: policy %q is listed more than once", p.Name)
+		}
+		seen[p.Name] = true
+		if p.Version != "" && !majorVersionPattern.MatchString(p.Version) {
+			return fmt.Errorf("controller.default_policies[%d].version must be a major version such as v1, got: %s", i, p.Version)
+		}
+	}
+	return nil
+}
+
+// validateCertificateExpiryMonitorConfig validates the certificate expiry monitor configuration
+func (c *Config) validateCertificateExpiryMonitorConfig() error {
+	monitor := c.Controller.Certificates.ExpiryMonitor
+	if !monitor.Enabled {
+		return nil
+	}
+
+	if monitor.CheckInterval <= 0 {
+		return fmt.Errorf("certificates.expiry_monitor.check_interval must be positive, got: %s", monitor.CheckInterval)
+	}
+	if monitor.WarningThreshold <= 0 {
+		return fmt.Errorf("certificates.expiry_monitor.warning_threshold must be positive, got: %s", monitor.WarningThreshold)
+	}
+	if monitor.OCSPEnabled && monitor.OCSPTimeout <= 0 {
+		return fmt.Errorf("certificates.expiry_monitor.ocsp_timeout must be positive when OCSP is enabled, got: %s", monitor.OCSPTimeout)
+	}
+
+	return nil
+}
+
+// validateACMEConfig validates the ACME certificate provisioning configuration
+func (c *Config) validateACMEConfig() error {
+	acme := c.Controller.Certificates.ACME
+	if !acme.Enabled {
+		return nil
+	}
+
+	if !c.Router.HTTPSEnabled {
+		return fmt.Errorf("certificates.acme.enabled requires router.https_enabled")
+	}
+
+	directoryURL, err := url.Parse(acme.DirectoryURL)
+	if err != nil || directoryURL.Scheme != "https" || directoryURL.Host == "" {
+		return fmt.Errorf("certificates.acme.directory_url must be an https URL, got: %q", acme.DirectoryURL)
+	}
+
+	if len(acme.Domains) == 0 {
+		return fmt.Errorf("certificates.acme.domains must list at least one domain")
+	}
+	for _, domain := range acme.Domains {
+		if strings.TrimSpace(domain) == "" {
+			return fmt.Errorf("certificates.acme.domains must not contain empty entries")
+		}
+	}
+
+	if strings.TrimSpace(acme.StorageDir) == "" {
+		return fmt.Errorf("certificates.acme.storage_dir is required when ACME is enabled")
+	}
+
+	if acme.RenewBefore <= 0 {
+		return fmt.Errorf("certificates.acme.renew_before must be positive, got: %s", acme.RenewBefore)
+	}
+
+	if acme.CheckInterval <= 0 {
+		return fmt.Errorf("certificates.acme.check_interval must be positive, got: %s", acme.CheckInterval)
+	}
+
+	switch acme.ChallengeType {
+	case "http-01":
+		if strings.TrimSpace(acme.HTTP01ListenAddress) == "" {
+			return fmt.Errorf("certificates.acme.http01_listen_address is required for the http-01 challenge")
+		}
+		for _, domain := range acme.Domains {
+			if strings.HasPrefix(domain, "*.") {
+				return fmt.Errorf("certificates.acme.domains entry %q is a wildcard, which requires the dns-01 challenge", domain)
+			}
+		}
+	case "dns-01":
+		if strings.TrimSpace(acme.DNS01Hook) == "" {
+			return fmt.Errorf("certificates.acme.dns01_hook is required for the dns-01 challenge")
+		}
+	default:
+		return fmt.Errorf("certificates.acme.challenge_type must be one of: http-01, dns-01, got: %s", acme.ChallengeType)
+	}
+
 	return nil
 }
 
@@ -1426,6 +1837,19 @@ func (c *Config) validateControlPlaneConfig() error {
 			cp.ReconnectInitial, cp.ReconnectMax)
 	}
 
+	if cp.ReconnectMultiplier < 1 {
+		return fmt.Errorf("controlplane.reconnect_multiplier must be >= 1, got: %g", cp.ReconnectMultiplier)
+	}
+
+	if cp.ReconnectFailureThreshold < 0 {
+		return fmt.Errorf("controlplane.reconnect_failure_threshold must be >= 0, got: %d", cp.ReconnectFailureThreshold)
+	}
+
+	if cp.ReconnectFailureThreshold > 0 && cp.ReconnectProbeInterval <= 0 {
+		return fmt.Errorf("controlplane.reconnect_probe_interval must be positive when reconnect_failure_threshold is set, got: %s",
+			cp.ReconnectProbeInterval)
+	}
+
 	// Validate polling interval
 	if cp.PollingInterval <= 0 {
 		return fmt.Errorf("controlplane.polling_interval must be positive, got: %s", cp.PollingInterval)
@@ -1903,10 +2327,41 @@ func (c *Config) validateAuthConfig() error {
 		}
 	}
 
-	// Validate IDP role mapping for multiple wildcards
-	if c.Controller.Auth.IDP.Enabled && len(c.Controller.Auth.IDP.RoleMapping) > 0 {
+	issuers := make(map[string]bool)
+	for _, idp := range c.Controller.Auth.EnabledIDPs() {
+		if err := validateIDPConfig(idp); err != nil {
+			return err
+		}
+		if issuers[idp.Issuer] {
+			return fmt.Errorf("auth.idps: issuer %q is configured for more than one identity provider", idp.Issuer)
+		}
+		issuers[idp.Issuer] = true
+	}
+
+	if opa := &c.Controller.Authz.OPA; opa.Enabled {
+		if (strings.TrimSpace(opa.PolicyPath) == "") == (strings.TrimSpace(opa.BundlePath) == "") {
+			return fmt.Errorf("authz.opa requires exactly one of policy_path or bundle_path")
+		}
+		authenticated := (c.Controller.Auth.Basic.Enabled && len(c.Controller.Auth.Basic.Users) > 0) ||
+			len(c.Controller.Auth.EnabledIDPs()) > 0
+		if !authenticated {
+			return fmt.Errorf("authz.opa requires auth.basic or auth.idp to be enabled; " +
+				"without authentication, authorization is skipped for every request")
+		}
+		if strings.TrimSpace(opa.Query) == "" {
+			opa.Query = "data.gateway.authz.allow"
+		}
+	}
+
+	return nil
+}
+
+// validateIDPConfig validates the role mapping and JWKS cache settings of an
+// enabled identity provider.
+func validateIDPConfig(idp IDPConfig) error {
+	if len(idp.RoleMapping) > 0 {
 		wildcardRoles := []string{}
-		for localRole, idpRoles := range c.Controller.Auth.IDP.RoleMapping {
+		for localRole, idpRoles := range idp.RoleMapping {
 			for _, idpRole := range idpRoles {
 				if idpRole == "*" {
 					wildcardRoles = append(wildcardRoles, localRole)
@@ -1925,9 +2380,22 @@ func (c *Config) validateAuthConfig() error {
 		}
 	}
 
+	if idp.JWKSRefreshInterval < 0 || idp.JWKSFetchTimeout < 0 || idp.JWKSCacheTTL < 0 {
+		return fmt.Errorf("auth.idp: jwks_refresh_interval, jwks_fetch_timeout and jwks_cache_ttl must not be negative")
+	}
+	if idp.JWKSCacheTTL > 0 && idp.JWKSCacheTTL < idp.JWKSRefreshInterval {
+		return fmt.Errorf("auth.idp.jwks_cache_ttl (%s) must not be shorter than jwks_refresh_interval (%s)",
+			idp.JWKSCacheTTL, idp.JWKSRefreshInterval)
+	}
 	return nil
 }
 
+// envVarNamePattern matches a name that ${VAR} references can refer to.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// apiKeyPrefixRegex matches an allowed API key prefix.
+var apiKeyPrefixRegex = regexp.MustCompile(fmt.Sprintf(`^[A-Za-z0-9_-]{1,%d}$`, constants.APIKeyPrefixMaxLength))
+
 // validateAPIKeyConfig validates the API key configuration
 func (c *Config) validateAPIKeyConfig() error {
 	// If number of api keys per user is not provided or negative throw error
@@ -1948,6 +2416,60 @@ func (c *Config) validateAPIKeyConfig() error {
 			c.APIKey.MinKeyLength, c.APIKey.MaxKeyLength)
 	}
 
+	// Default the generated key prefix; restrict it to characters that are safe in
+	// headers and query strings
+	if c.APIKey.Prefix == "" {
+		c.APIKey.Prefix = constants.APIKeyPrefix
+	}
+	if !apiKeyPrefixRegex.MatchString(c.APIKey.Prefix) {
+		return fmt.Errorf("api_key.prefix must be 1-%d characters of letters, digits, '_' or '-', got: %q",
+			constants.APIKeyPrefixMaxLength, c.APIKey.Prefix)
+	}
+
+	switch c.APIKey.Mode {
+	case "":
+		c.APIKey.Mode = constants.APIKeyModeStateful
+	case constants.APIKeyModeStateful:
+	case constants.APIKeyModeSigned:
+		if strings.TrimSpace(c.APIKey.SigningSecretFile) == "" {
+			return fmt.Errorf("api_key.signing_secret_file is required when api_key.mode is %q",
+				constants.APIKeyModeSigned)
+		}
+		secret, err := apikey.ReadSecretFile(c.APIKey.SigningSecretFile, "signing secret")
+		if err != nil {
+			return fmt.Errorf("api_key.signing_secret_file: %w", err)
+		}
+		c.APIKey.signingSecret = secret
+	default:
+		return fmt.Errorf("api_key.mode must be one of: %s, %s, got: %s",
+			constants.APIKeyModeStateful, constants.APIKeyModeSigned, c.APIKey.Mode)
+	}
+
+	if c.APIKey.CreationRateLimit.MaxKeys < 0 {
+		return fmt.Errorf("api_key.creation_rate_limit.max_keys must not be negative, got: %d",
+			c.APIKey.CreationRateLimit.MaxKeys)
+	}
+	if c.APIKey.CreationRateLimit.Window < 0 {
+		return fmt.Errorf("api_key.creation_rate_limit.window must not be negative, got: %s",
+			c.APIKey.CreationRateLimit.Window)
+	}
+	if c.APIKey.CreationRateLimit.Window == 0 {
+		c.APIKey.CreationRateLimit.Window = constants.DefaultAPIKeyCreationRateLimitWindow
+	}
+
+	if c.APIKey.ExpirySweeper.Enabled && c.APIKey.ExpirySweeper.Interval <= 0 {
+		return fmt.Errorf("api_key.expiry_sweeper.interval must be positive, got: %s",
+			c.APIKey.ExpirySweeper.Interval)
+	}
+
+	if err := c.APIKey.Webhook.validate(); err != nil {
+		return err
+	}
+
+	if err := c.APIKey.LoadPeppers(); err != nil {
+		return err
+	}
+
 	// If hashing is enabled but no algorithm is provided, default to SHA256
 	if c.APIKey.Algorithm == "" {
 		c.APIKey.Algorithm = constants.HashingAlgorithmSHA256
@@ -1962,6 +2484,86 @@ func (c *Config) validateAPIKeyConfig() error {
 	return nil
 }
 
+// validate checks the webhook settings and loads the signing secret when the webhook is enabled
+func (w *APIKeyWebhookConfig) validate() error {
+	w.secret = nil
+	if !w.Enabled {
+		return nil
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("api_key.webhook.url must be an absolute https URL, got: %q", w.URL)
+	}
+	if u.User != nil {
+		return fmt.Errorf("api_key.webhook.url must not embed credentials")
+	}
+	if w.Timeout <= 0 {
+		return fmt.Errorf("api_key.webhook.timeout must be positive, got: %s", w.Timeout)
+	}
+	if w.MaxRetries < 0 {
+		return fmt.Errorf("api_key.webhook.max_retries must not be negative, got: %d", w.MaxRetries)
+	}
+	if w.MaxRetries > 0 && w.RetryBackoff <= 0 {
+		return fmt.Errorf("api_key.webhook.retry_backoff must be positive, got: %s", w.RetryBackoff)
+	}
+	if w.QueueSize <= 0 {
+		return fmt.Errorf("api_key.webhook.queue_size must be positive, got: %d", w.QueueSize)
+	}
+	if strings.TrimSpace(w.SecretFile) == "" {
+		return fmt.Errorf("api_key.webhook.secret_file is required when the webhook is enabled")
+	}
+	secret, err := apikey.ReadSecretFile(w.SecretFile, "webhook secret")
+	if err != nil {
+		return fmt.Errorf("api_key.webhook.secret_file: %w", err)
+	}
+	w.secret = secret
+	return nil
+}
+
+// LoadPeppers reads the configured pepper files and resolves the active pepper.
+// It is called during config validation.
+func (c *APIKeyConfig) LoadPeppers() error {
+	c.peppers = nil
+	if len(c.Peppers) == 0 {
+		if c.ActivePepper != "" {
+			return fmt.Errorf("api_key.active_pepper %q is set but no api_key.peppers are configured", c.ActivePepper)
+		}
+		return nil
+	}
+
+	peppers := make(map[string][]byte, len(c.Peppers))
+	for i, p := range c.Peppers {
+		if !apikey.ValidPepperID(p.ID) {
+			return fmt.Errorf("api_key.peppers[%d].id must be 1-%d characters of letters, digits, '_' or '-', got: %q",
+				i, apikey.MaxPepperIDLength, p.ID)
+		}
+		if _, exists := peppers[p.ID]; exists {
+			return fmt.Errorf("api_key.peppers[%d].id %q is duplicated", i, p.ID)
+		}
+		if strings.TrimSpace(p.File) == "" {
+			return fmt.Errorf("api_key.peppers[%d].file is required", i)
+		}
+		secret, err := apikey.ReadSecretFile(p.File, "pepper")
+		if err != nil {
+			return fmt.Errorf("api_key.peppers[%d].file: %w", i, err)
+		}
+		peppers[p.ID] = secret
+	}
+
+	if c.ActivePepper == "" {
+		if len(c.Peppers) > 1 {
+			return fmt.Errorf("api_key.active_pepper is required when more than one pepper is configured")
+		}
+		c.ActivePepper = c.Peppers[0].ID
+	}
+	if _, ok := peppers[c.ActivePepper]; !ok {
+		return fmt.Errorf("api_key.active_pepper %q does not match any configured pepper", c.ActivePepper)
+	}
+	c.peppers = peppers
+	return nil
+}
+
 // validateSubscriptionsConfig validates subscriptions configuration.
 func (c *Config) validateSubscriptionsConfig() error {
 	return nil
@@ -2014,5 +2616,14 @@ func (c *Config) validateHTTPListenerConfig() error {
 			constants.MaxReasonableBufferLimitBytes, httpListener.PerConnectionBufferLimitBytes)
 	}
 
+	if httpListener.MaxBodyBytesCeiling == 0 {
+		httpListener.MaxBodyBytesCeiling = 10485760 // 10 MiB
+	}
+
+	if httpListener.MaxBodyBytesCeiling > constants.MaxReasonableBufferLimitBytes {
+		return fmt.Errorf("http_listener.max_body_bytes_ceiling must not exceed %d, got: %d",
+			constants.MaxReasonableBufferLimitBytes, httpListener.MaxBodyBytesCeiling)
+	}
+
 	return nil
 }
@@ -51,11 +51,13 @@ func validConfig() *Config {
 				Format: "json",
 			},
 			ControlPlane: ControlPlaneConfig{
-				Host:             "localhost",
-				ReconnectInitial: 1 * time.Second,
-				ReconnectMax:     30 * time.Second,
-				PollingInterval:  5 * time.Second,
-				SyncBatchSize:    50,
+				Host:                   "localhost",
+				ReconnectInitial:       1 * time.Second,
+				ReconnectMax:           30 * time.Second,
+				ReconnectMultiplier:    2,
+				ReconnectProbeInterval: 10 * time.Minute,
+				PollingInterval:        5 * time.Second,
+				SyncBatchSize:          50,
 			},
 			Metrics: MetricsConfig{
 				Enabled: false,
@@ -631,6 +633,7 @@ func TestConfig_Validate_Ports(t *testing.T) {
 		xdsPort     int
 		adminPort   int
 		adminEnable bool
+		apiSocket   string
 		wantErr     bool
 		errContains string
 	}{
@@ -644,6 +647,9 @@ func TestConfig_Validate_Ports(t *testing.T) {
 		{name: "Admin conflicts with API port", apiPort: 8080, xdsPort: 18000, adminPort: 8080, adminEnable: true, wantErr: true, errContains: "admin_server.port cannot be same as server.api_port"},
 		{name: "Admin conflicts with xDS port", apiPort: 8080, xdsPort: 18000, adminPort: 18000, adminEnable: true, wantErr: true, errContains: "admin_server.port cannot be same as server.xds_port"},
 		{name: "Admin disabled ignores admin port", apiPort: 8080, xdsPort: 18000, adminPort: 0, adminEnable: false, wantErr: false},
+		{name: "API socket", apiPort: 8080, xdsPort: 18000, adminPort: 9092, adminEnable: true, apiSocket: "/var/run/api-platform/controller-api.sock", wantErr: false},
+		{name: "Relative API socket", apiPort: 8080, xdsPort: 18000, adminPort: 9092, adminEnable: true, apiSocket: "controller-api.sock", wantErr: true, errContains: "server.api_socket must be an absolute path"},
+		{name: "API socket frees API port", apiPort: 8080, xdsPort: 18000, adminPort: 8080, adminEnable: true, apiSocket: "/var/run/api-platform/controller-api.sock", wantErr: false},
 	}
 
 	for _, tt := range tests {
@@ -653,6 +659,7 @@ func TestConfig_Validate_Ports(t *testing.T) {
 			cfg.Controller.Server.XDSPort = tt.xdsPort
 			cfg.Controller.AdminServer.Enabled = tt.adminEnable
 			cfg.Controller.AdminServer.Port = tt.adminPort
+			cfg.Controller.Server.APISocket = tt.apiSocket
 			err := cfg.Validate()
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -664,6 +671,167 @@ func TestConfig_Validate_Ports(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_UpstreamProbeTimeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeout     time.Duration
+		want        time.Duration
+		errContains string
+	}{
+		{name: "Unset defaults to 3s", timeout: 0, want: 3 * time.Second},
+		{name: "Valid timeout", timeout: 500 * time.Millisecond, want: 500 * time.Millisecond},
+		{name: "Negative timeout", timeout: -time.Second, errContains: "server.upstream_probe.timeout must be between"},
+		{name: "Timeout too long", timeout: time.Minute, errContains: "server.upstream_probe.timeout must be between"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.Server.UpstreamProbe.Timeout = tt.timeout
+			err := cfg.Validate()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, cfg.Controller.Server.UpstreamProbe.Timeout)
+		})
+	}
+}
+
+func TestConfig_Validate_OPAAuthz(t *testing.T) {
+	tests := []struct {
+		name        string
+		opa         OPAConfig
+		basicUsers  bool
+		errContains string
+	}{
+		{name: "Disabled", opa: OPAConfig{}},
+		{name: "Policy path", opa: OPAConfig{Enabled: true, PolicyPath: "/etc/authz/policy.rego"}, basicUsers: true},
+		{name: "Bundle path", opa: OPAConfig{Enabled: true, BundlePath: "/etc/authz/bundle.tar.gz"}, basicUsers: true},
+		{name: "No policy", opa: OPAConfig{Enabled: true}, basicUsers: true, errContains: "exactly one of policy_path or bundle_path"},
+		{name: "Policy and bundle", opa: OPAConfig{Enabled: true, PolicyPath: "a.rego", BundlePath: "b"}, basicUsers: true, errContains: "exactly one of policy_path or bundle_path"},
+		{name: "Without authentication", opa: OPAConfig{Enabled: true, PolicyPath: "a.rego"}, errContains: "requires auth.basic or auth.idp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.Auth.Basic.Users = nil
+			if tt.basicUsers {
+				cfg.Controller.Auth.Basic.Enabled = true
+				cfg.Controller.Auth.Basic.Users = []AuthUser{{Username: "admin", Password: "secret", Roles: []string{"admin"}}}
+			}
+			cfg.Controller.Authz.OPA = tt.opa
+			err := cfg.Validate()
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			if tt.opa.Enabled {
+				assert.Equal(t, "data.gateway.authz.allow", cfg.Controller.Authz.OPA.Query)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate_DrainTimeout(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.Server.DrainTimeout = 10 * time.Second
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Controller.Server.DrainTimeout = -time.Second
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server.drain_timeout must not be negative")
+}
+
+func TestConfig_Validate_IDPJWKSCache(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.Auth.IDP.Enabled = true
+	cfg.Controller.Auth.IDP.JWKSRefreshInterval = 10 * time.Minute
+	cfg.Controller.Auth.IDP.JWKSCacheTTL = 0
+	assert.NoError(t, cfg.Validate(), "a zero TTL keeps the keys until the next successful fetch")
+
+	cfg.Controller.Auth.IDP.JWKSCacheTTL = 5 * time.Minute
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "jwks_cache_ttl")
+
+	cfg.Controller.Auth.IDP.JWKSCacheTTL = time.Hour
+	cfg.Controller.Auth.IDP.JWKSFetchTimeout = -time.Second
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not be negative")
+}
+
+func TestConfig_Validate_MultipleIDPs(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.Auth.IDP = IDPConfig{Enabled: true, Issuer: "https://first.example.com"}
+	cfg.Controller.Auth.IDPs = []IDPConfig{
+		{Enabled: true, Issuer: "https://second.example.com"},
+		{Enabled: false, Issuer: "https://first.example.com"},
+	}
+	assert.NoError(t, cfg.Validate(), "disabled providers are ignored")
+
+	cfg.Controller.Auth.IDPs[1].Enabled = true
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "more than one identity provider")
+
+	cfg.Controller.Auth.IDPs = []IDPConfig{{
+		Enabled:     true,
+		Issuer:      "https://second.example.com",
+		RoleMapping: map[string][]string{"admin": {"*"}, "developer": {"*"}},
+	}}
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "multiple wildcard")
+}
+
+func TestLoadConfig_AdditionalIDPs(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	contents := `
+[controller.auth.idp]
+enabled = true
+jwks_url = "https://first.example.com/jwks"
+issuer = "https://first.example.com"
+
+[[controller.auth.idps]]
+enabled = true
+jwks_url = "https://second.example.com/jwks"
+issuer = "https://second.example.com"
+roles_claim = "roles"
+jwks_cache_ttl = "2h"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(contents), 0o644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.Len(t, cfg.Controller.Auth.IDPs, 1)
+	assert.Equal(t, "https://second.example.com", cfg.Controller.Auth.IDPs[0].Issuer)
+	assert.Equal(t, "roles", cfg.Controller.Auth.IDPs[0].RolesClaim)
+	assert.Equal(t, 2*time.Hour, cfg.Controller.Auth.IDPs[0].JWKSCacheTTL)
+	assert.Len(t, cfg.Controller.Auth.EnabledIDPs(), 2)
+}
+
+func TestConfig_Validate_EnvInterpolationAllowedVars(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.EnvInterpolation.AllowedVars = []string{"BACKEND_HOST", "_PORT2"}
+	assert.NoError(t, cfg.Validate())
+
+	for _, name := range []string{"", "2FAST", "BACKEND-HOST", "${BACKEND_HOST}"} {
+		cfg := validConfig()
+		cfg.Controller.EnvInterpolation.AllowedVars = []string{name}
+		err := cfg.Validate()
+		assert.Error(t, err, name)
+		assert.Contains(t, err.Error(), "env_interpolation.allowed_vars", name)
+	}
+}
+
 func TestConfig_Validate_MetricsConfig(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -711,6 +879,32 @@ func TestConfig_Validate_MetricsConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_PolicyServerTLS(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.PolicyServer.TLS = PolicyServerTLS{Enabled: true, CertFile: "/certs/server.crt", KeyFile: "/certs/server.key"}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Controller.PolicyServer.TLS.KeyFile = ""
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy_server.tls.key_file is required")
+
+	cfg.Controller.PolicyServer.TLS.CertFile = " "
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy_server.tls.cert_file is required")
+
+	// Disabled TLS needs neither
+	cfg.Controller.PolicyServer.TLS.Enabled = false
+	assert.NoError(t, cfg.Validate())
+
+	// Client certificates are only verified over TLS
+	cfg.Controller.PolicyServer.TLS.ClientCAFile = "/certs/policy-engine-ca.crt"
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy_server.tls.client_ca_file requires policy_server.tls.enabled")
+}
+
 func TestConfig_Validate_RouterListenerPort(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -883,6 +1077,121 @@ func TestConfig_ValidateControlPlaneConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateControlPlaneBackoff(t *testing.T) {
+	tests := []struct {
+		name             string
+		multiplier       float64
+		failureThreshold int
+		probeInterval    time.Duration
+		errContains      string
+	}{
+		{name: "Valid backoff", multiplier: 2, failureThreshold: 10, probeInterval: 10 * time.Minute},
+		{name: "Probing disabled", multiplier: 1.5, failureThreshold: 0, probeInterval: 0},
+		{name: "Multiplier below one", multiplier: 0.5, errContains: "controlplane.reconnect_multiplier must be >= 1"},
+		{name: "Negative failure threshold", multiplier: 2, failureThreshold: -1, errContains: "controlplane.reconnect_failure_threshold must be >= 0"},
+		{name: "Threshold without probe interval", multiplier: 2, failureThreshold: 5, errContains: "controlplane.reconnect_probe_interval must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.ControlPlane.ReconnectMultiplier = tt.multiplier
+			cfg.Controller.ControlPlane.ReconnectFailureThreshold = tt.failureThreshold
+			cfg.Controller.ControlPlane.ReconnectProbeInterval = tt.probeInterval
+			err := cfg.Validate()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateACMEConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(*ACMEConfig)
+		errContains string
+	}{
+		{name: "Valid http-01", mutate: func(*ACMEConfig) {}},
+		{name: "Valid dns-01 wildcard", mutate: func(c *ACMEConfig) {
+			c.ChallengeType = "dns-01"
+			c.DNS01Hook = "/usr/local/bin/acme-dns-hook"
+			c.Domains = []string{"*.example.com"}
+		}},
+		{name: "Non-https directory", mutate: func(c *ACMEConfig) { c.DirectoryURL = "http://acme.example.com/directory" }, errContains: "directory_url must be an https URL"},
+		{name: "No domains", mutate: func(c *ACMEConfig) { c.Domains = nil }, errContains: "domains must list at least one domain"},
+		{name: "Wildcard with http-01", mutate: func(c *ACMEConfig) { c.Domains = []string{"*.example.com"} }, errContains: "requires the dns-01 challenge"},
+		{name: "dns-01 without hook", mutate: func(c *ACMEConfig) { c.ChallengeType = "dns-01" }, errContains: "dns01_hook is required"},
+		{name: "Unknown challenge", mutate: func(c *ACMEConfig) { c.ChallengeType = "tls-alpn-01" }, errContains: "challenge_type must be one of"},
+		{name: "Non-positive renew window", mutate: func(c *ACMEConfig) { c.RenewBefore = 0 }, errContains: "renew_before must be positive"},
+		{name: "Disabled skips validation", mutate: func(c *ACMEConfig) { c.Enabled = false; c.Domains = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Router.HTTPSEnabled = true
+			cfg.Controller.Certificates.ACME = ACMEConfig{
+				Enabled:             true,
+				DirectoryURL:        "https://acme-v02.api.letsencrypt.org/directory",
+				Domains:             []string{"api.example.com"},
+				ChallengeType:       "http-01",
+				StorageDir:          "./data/acme",
+				RenewBefore:         30 * 24 * time.Hour,
+				CheckInterval:       12 * time.Hour,
+				HTTP01ListenAddress: ":5002",
+			}
+			tt.mutate(&cfg.Controller.Certificates.ACME)
+			err := cfg.validateACMEConfig()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateCertificateExpiryMonitorConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(*CertificateExpiryMonitorConfig)
+		errContains string
+	}{
+		{name: "Valid", mutate: func(*CertificateExpiryMonitorConfig) {}},
+		{name: "Valid with OCSP", mutate: func(c *CertificateExpiryMonitorConfig) { c.OCSPEnabled = true }},
+		{name: "Non-positive check interval", mutate: func(c *CertificateExpiryMonitorConfig) { c.CheckInterval = 0 }, errContains: "check_interval must be positive"},
+		{name: "Non-positive warning threshold", mutate: func(c *CertificateExpiryMonitorConfig) { c.WarningThreshold = -time.Hour }, errContains: "warning_threshold must be positive"},
+		{name: "OCSP without timeout", mutate: func(c *CertificateExpiryMonitorConfig) { c.OCSPEnabled = true; c.OCSPTimeout = 0 }, errContains: "ocsp_timeout must be positive"},
+		{name: "Timeout ignored when OCSP is disabled", mutate: func(c *CertificateExpiryMonitorConfig) { c.OCSPTimeout = 0 }},
+		{name: "Disabled skips validation", mutate: func(c *CertificateExpiryMonitorConfig) { c.Enabled = false; c.CheckInterval = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.Certificates.ExpiryMonitor = CertificateExpiryMonitorConfig{
+				Enabled:          true,
+				CheckInterval:    time.Hour,
+				WarningThreshold: 30 * 24 * time.Hour,
+				OCSPTimeout:      10 * time.Second,
+			}
+			tt.mutate(&cfg.Controller.Certificates.ExpiryMonitor)
+			err := cfg.validateCertificateExpiryMonitorConfig()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConfig_ValidateTLSConfig(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1561,6 +1870,91 @@ func TestConfig_ValidateAPIKeyConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateAPIKeyCreationRateLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		limit          APIKeyCreationRateLimitConfig
+		expectedWindow time.Duration
+		wantErr        bool
+		errContains    string
+	}{
+		{name: "Disabled by default", limit: APIKeyCreationRateLimitConfig{}, expectedWindow: time.Hour},
+		{name: "Configured limit", limit: APIKeyCreationRateLimitConfig{MaxKeys: 20, Window: 30 * time.Minute}, expectedWindow: 30 * time.Minute},
+		{name: "Window defaults to an hour", limit: APIKeyCreationRateLimitConfig{MaxKeys: 20}, expectedWindow: time.Hour},
+		{name: "Negative max keys", limit: APIKeyCreationRateLimitConfig{MaxKeys: -1}, wantErr: true, errContains: "api_key.creation_rate_limit.max_keys must not be negative"},
+		{name: "Negative window", limit: APIKeyCreationRateLimitConfig{MaxKeys: 5, Window: -time.Minute}, wantErr: true, errContains: "api_key.creation_rate_limit.window must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.APIKey.CreationRateLimit = tt.limit
+			err := cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedWindow, cfg.APIKey.CreationRateLimit.Window)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateAPIKeyExpirySweeper(t *testing.T) {
+	tests := []struct {
+		name    string
+		sweeper APIKeyExpirySweeperConfig
+		wantErr bool
+	}{
+		{name: "Enabled with interval", sweeper: APIKeyExpirySweeperConfig{Enabled: true, Interval: time.Minute}},
+		{name: "Disabled without interval", sweeper: APIKeyExpirySweeperConfig{Enabled: false}},
+		{name: "Enabled without interval", sweeper: APIKeyExpirySweeperConfig{Enabled: true}, wantErr: true},
+		{name: "Enabled with negative interval", sweeper: APIKeyExpirySweeperConfig{Enabled: true, Interval: -time.Minute}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.APIKey.ExpirySweeper = tt.sweeper
+			err := cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "api_key.expiry_sweeper.interval must be positive")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateDefaultPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []DefaultPolicyConfig
+		wantErr  string
+	}{
+		{name: "None"},
+		{name: "Valid", defaults: []DefaultPolicyConfig{{Name: "cors", Version: "v1"}, {Name: "log"}}},
+		{name: "Missing name", defaults: []DefaultPolicyConfig{{Version: "v1"}}, wantErr: "controller.default_policies[0].name is required"},
+		{name: "Duplicate name", defaults: []DefaultPolicyConfig{{Name: "cors"}, {Name: "cors", Version: "v2"}}, wantErr: "listed more than once"},
+		{name: "Full version", defaults: []DefaultPolicyConfig{{Name: "cors", Version: "v1.0.0"}}, wantErr: "must be a major version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.DefaultPolicies = tt.defaults
+			err := cfg.Validate()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConfig_ValidateHTTPListenerConfig(t *testing.T) {
 	tests := []struct {
 		name                       string
@@ -1619,6 +2013,35 @@ func TestConfig_ValidateHTTPListenerConfig_BufferLimit(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateHTTPListenerConfig_MaxBodyBytesCeiling(t *testing.T) {
+	tests := []struct {
+		name               string
+		ceiling            uint32
+		wantErr            bool
+		errContains        string
+		expectedAfterValid uint32
+	}{
+		{name: "Unset defaults to 10 MiB", ceiling: 0, wantErr: false, expectedAfterValid: 10485760},
+		{name: "Valid custom value", ceiling: 52428800, wantErr: false, expectedAfterValid: 52428800},
+		{name: "Exceeds max reasonable value", ceiling: constants.MaxReasonableBufferLimitBytes + 1, wantErr: true, errContains: "max_body_bytes_ceiling must not exceed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Router.HTTPListener.MaxBodyBytesCeiling = tt.ceiling
+			err := cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedAfterValid, cfg.Router.HTTPListener.MaxBodyBytesCeiling)
+			}
+		})
+	}
+}
+
 func TestConfig_HelperMethods(t *testing.T) {
 	t.Run("IsAccessLogsEnabled", func(t *testing.T) {
 		cfg := validConfig()
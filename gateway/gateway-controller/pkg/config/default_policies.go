@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"slices"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+// DefaultPoliciesFor returns the default policies that apply to an API, in
+// configuration order. Defaults the API excludes or lists at the API level are
+// left out; callers leave out, per operation, those the operation lists.
+func (c *Controller) DefaultPoliciesFor(apiData api.APIConfigData) []api.Policy {
+	if len(c.DefaultPolicies) == 0 {
+		return nil
+	}
+
+	var excluded []string
+	if apiData.ExcludedDefaultPolicies != nil {
+		excluded = *apiData.ExcludedDefaultPolicies
+	}
+
+	var result []api.Policy
+	for _, d := range c.DefaultPolicies {
+		if slices.Contains(excluded, d.Name) || ListsPolicy(apiData.Policies, d.Name) {
+			continue
+		}
+		result = append(result, d.APIPolicy())
+	}
+	return result
+}
+
+// APIPolicy returns the default policy as it would be listed in an API.
+func (d DefaultPolicyConfig) APIPolicy() api.Policy {
+	p := api.Policy{Name: d.Name, Version: d.Version}
+	if len(d.Params) > 0 {
+		params := d.Params
+		p.Params = &params
+	}
+	if d.ExecutionCondition != "" {
+		condition := d.ExecutionCondition
+		p.ExecutionCondition = &condition
+	}
+	return p
+}
+
+// ListsPolicy reports whether policies contains a policy named name.
+func ListsPolicy(policies *[]api.Policy, name string) bool {
+	if policies == nil {
+		return false
+	}
+	for _, p := range *policies {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/common/ast"
+)
+
+// jwtClaimsVar mirrors the policy engine's hidden claims variable that the jwt
+// macros expand to.
+const jwtClaimsVar = "@jwt_claims"
+
+// conditionEnv is the CEL environment used to type-check execution conditions,
+// created on first use.
+var conditionEnv = sync.OnceValues(newConditionEnv)
+
+// newConditionEnv declares the variables and functions available to execution
+// conditions in the policy engine. Functions are declared without bindings:
+// conditions are only compiled here, never evaluated. Keep this in sync with
+// the policy engine's CEL environment.
+func newConditionEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("processing.phase", cel.StringType),
+		cel.Variable("request", cel.ObjectType("RequestContext")),
+		cel.Variable("request.Headers", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("request.Body", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("request.Path", cel.StringType),
+		cel.Variable("request.Method", cel.StringType),
+		cel.Variable("request.RequestID", cel.StringType),
+		cel.Variable("request.Metadata", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("request.ClientIP", cel.StringType),
+		cel.Variable("response", cel.ObjectType("ResponseContext")),
+		cel.Variable("response.RequestHeaders", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("response.RequestBody", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("response.RequestPath", cel.StringType),
+		cel.Variable("response.RequestMethod", cel.StringType),
+		cel.Variable("response.ResponseHeaders", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("response.ResponseBody", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("response.ResponseStatus", cel.IntType),
+		cel.Variable("response.RequestID", cel.StringType),
+		cel.Variable("response.Metadata", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(jwtClaimsVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Macros(
+			cel.GlobalMacro("jwt", 1, jwtMacro("@jwt_claim")),
+			cel.GlobalMacro("jwtAud", 0, jwtMacro("@jwt_aud")),
+			cel.GlobalMacro("jwtSub", 0, jwtMacro("@jwt_sub")),
+		),
+		cel.Function("inCIDR",
+			cel.Overload("inCIDR_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType)),
+		cel.Function("ipInRanges",
+			cel.Overload("ipInRanges_string_list", []*cel.Type{cel.StringType, cel.ListType(cel.DynType)}, cel.BoolType)),
+		cel.Function("@jwt_claim",
+			cel.Overload("jwt_claim_map_string",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType), cel.StringType}, cel.DynType)),
+		cel.Function("@jwt_aud",
+			cel.Overload("jwt_aud_map",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType)}, cel.ListType(cel.StringType))),
+		cel.Function("@jwt_sub",
+			cel.Overload("jwt_sub_map",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType)}, cel.StringType)),
+		cel.Function("contains",
+			cel.MemberOverload("list_contains_dyn", []*cel.Type{cel.ListType(cel.DynType), cel.DynType}, cel.BoolType),
+			cel.MemberOverload("null_contains_dyn", []*cel.Type{cel.NullType, cel.DynType}, cel.BoolType)),
+	)
+}
+
+// jwtMacro expands a jwt macro call into a call of the internal function with
+// the claims variable prepended to the arguments.
+func jwtMacro(function string) cel.MacroFactory {
+	return func(eh cel.MacroExprFactory, _ ast.Expr, args []ast.Expr) (ast.Expr, *common.Error) {
+		return eh.NewCall(function, append([]ast.Expr{eh.NewIdent(jwtClaimsVar)}, args...)...), nil
+	}
+}
+
+// ValidateExecutionCondition compiles a policy execution condition and reports
+// why it would be rejected by the policy engine, if at all.
+func ValidateExecutionCondition(expression string) error {
+	env, err := conditionEnv()
+	if err != nil {
+		return fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	checked, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return issues.Err()
+	}
+	if out := checked.OutputType(); !out.IsAssignableType(cel.BoolType) {
+		return fmt.Errorf("expression must return boolean, got %s", out)
+	}
+	return nil
+}
+
+// validateExecutionCondition returns a validation error for the policy's
+// execution condition when it does not compile.
+func validateExecutionCondition(policyName string, condition *string, fieldPath string) []ValidationError {
+	if condition == nil || *condition == "" {
+		return nil
+	}
+	if err := ValidateExecutionCondition(*condition); err != nil {
+		return []ValidationError{{
+			Field:   fieldPath + ".executionCondition",
+			Message: fmt.Sprintf("Policy '%s' has an invalid execution condition %q: %v", policyName, *condition, err),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
+)
+
+// GrpcAPIValidator validates gRPC API configurations using rule-based validation
+type GrpcAPIValidator struct {
+	// versionRegex matches semantic version patterns
+	versionRegex *regexp.Regexp
+	// urlFriendlyNameRegex matches URL-safe characters for API names
+	urlFriendlyNameRegex *regexp.Regexp
+	// serviceNameRegex matches fully-qualified proto service names (package.Service)
+	serviceNameRegex *regexp.Regexp
+	// methodNameRegex matches proto method names
+	methodNameRegex *regexp.Regexp
+	// apiValidator validates the transcoding context like a REST API context
+	apiValidator *APIValidator
+}
+
+// NewGrpcAPIValidator creates a new gRPC API configuration validator
+func NewGrpcAPIValidator() *GrpcAPIValidator {
+	return &GrpcAPIValidator{
+		versionRegex:         regexp.MustCompile(`^v?\d+(\.\d+)?(\.\d+)?$`),
+		urlFriendlyNameRegex: regexp.MustCompile(`^[a-zA-Z0-9\-_\. ]+$`),
+		serviceNameRegex:     regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`),
+		methodNameRegex:      regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`),
+		apiValidator:         NewAPIValidator(),
+	}
+}
+
+// Validate performs comprehensive validation on a configuration
+// It uses type switching to handle GrpcAPI specifically
+func (v *GrpcAPIValidator) Validate(config any) []ValidationError {
+	switch cfg := config.(type) {
+	case *grpcapi.GrpcAPI:
+		if cfg == nil {
+			return []ValidationError{{Field: "config", Message: "GrpcAPI configuration is nil"}}
+		}
+		return v.validateGrpcAPIConfiguration(cfg)
+	case grpcapi.GrpcAPI:
+		return v.validateGrpcAPIConfiguration(&cfg)
+	default:
+		return []ValidationError{
+			{
+				Field:   "config",
+				Message: "Unsupported configuration type for GrpcAPIValidator (expected GrpcAPI)",
+			},
+		}
+	}
+}
+
+// validateGrpcAPIConfiguration performs comprehensive validation on a gRPC API configuration
+func (v *GrpcAPIValidator) validateGrpcAPIConfiguration(config *grpcapi.GrpcAPI) []ValidationError {
+	var errors []ValidationError
+
+	if config.Kind != grpcapi.KindGrpcApi {
+		errors = append(errors, ValidationError{
+			Field:   "kind",
+			Message: "Unsupported kind (must be 'GrpcApi')",
+		})
+	}
+
+	if config.ApiVersion != grpcapi.ApiVersionV1 {
+		errors = append(errors, ValidationError{
+			Field:   "version",
+			Message: "Unsupported API version (must be 'gateway.api-platform.wso2.com/v1')",
+		})
+	}
+
+	errors = append(errors, v.validateSpec(&config.Spec)...)
+	errors = append(errors, ValidateMetadata(&config.Metadata)...)
+
+	return errors
+}
+
+// validateSpec validates the spec section of the configuration
+func (v *GrpcAPIValidator) validateSpec(spec *grpcapi.GrpcAPIData) []ValidationError {
+	var errors []ValidationError
+
+	if spec.DisplayName == "" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.displayName",
+			Message: "API display name is required",
+		})
+	} else if len(spec.DisplayName) > 100 {
+		errors = append(errors, ValidationError{
+			Field:   "spec.displayName",
+			Message: "API display name must be 1-100 characters",
+		})
+	} else if !v.urlFriendlyNameRegex.MatchString(spec.DisplayName) {
+		errors = append(errors, ValidationError{
+			Field:   "spec.displayName",
+			Message: "API display name must be URL-friendly (only letters, numbers, spaces, hyphens, underscores, and dots allowed)",
+		})
+	}
+
+	if spec.Version == "" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.version",
+			Message: "API version is required",
+		})
+	} else if !v.versionRegex.MatchString(spec.Version) {
+		errors = append(errors, ValidationError{
+			Field:   "spec.version",
+			Message: "API version must follow semantic versioning pattern (e.g., v1.0, v2.1.3)",
+		})
+	}
+
+	if spec.Vhost != nil && strings.TrimSpace(*spec.Vhost) == "" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.vhost",
+			Message: "Vhost cannot be empty when specified",
+		})
+	}
+
+	errors = append(errors, v.validateUpstream(&spec.Upstream)...)
+	errors = append(errors, v.validateServices(spec.Services)...)
+
+	if spec.Descriptor != nil {
+		errors = append(errors, v.validateDescriptor(spec.Descriptor)...)
+		if spec.Context == nil {
+			errors = append(errors, ValidationError{
+				Field:   "spec.context",
+				Message: "Context is required when a descriptor is set for transcoding",
+			})
+		}
+	}
+	if spec.Context != nil {
+		if spec.Descriptor == nil {
+			errors = append(errors, ValidationError{
+				Field:   "spec.context",
+				Message: "Context only applies to transcoded requests and requires a descriptor",
+			})
+		} else if *spec.Context == "/" {
+			// A root transcoding route would take every non-gRPC request on the vhost
+			errors = append(errors, ValidationError{
+				Field:   "spec.context",
+				Message: "Context of a gRPC API cannot be the root context",
+			})
+		} else {
+			errors = append(errors, v.apiValidator.ValidateContext(*spec.Context)...)
+		}
+	}
+
+	return errors
+}
+
+// validateUpstream validates the gRPC backend URL. gRPC requests are routed by their
+// /<service>/<method> path, so the URL cannot carry a base path.
+func (v *GrpcAPIValidator) validateUpstream(upstream *grpcapi.GrpcUpstream) []ValidationError {
+	var errors []ValidationError
+
+	rawURL := strings.TrimSpace(upstream.Url)
+	if rawURL == "" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream.url",
+			Message: "Upstream URL is required",
+		})
+		return errors
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream.url",
+			Message: fmt.Sprintf("Invalid URL format: %v", err),
+		})
+		return errors
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream.url",
+			Message: "Upstream URL must use http or https scheme",
+		})
+	}
+
+	if parsedURL.Host == "" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream.url",
+			Message: "Upstream URL must include a host",
+		})
+	}
+
+	if parsedURL.Path != "" && parsedURL.Path != "/" {
+		errors = append(errors, ValidationError{
+			Field:   "spec.upstream.url",
+			Message: "Upstream URL of a gRPC API cannot include a path",
+		})
+	}
+
+	return errors
+}
+
+// validateServices validates the exposed services and their methods
+func (v *GrpcAPIValidator) validateServices(services []grpcapi.GrpcService) []ValidationError {
+	var errors []ValidationError
+
+	if len(services) == 0 {
+		errors = append(errors, ValidationError{
+			Field:   "spec.services",
+			Message: "At least one service is required",
+		})
+		return errors
+	}
+
+	seenServices := make(map[string]bool, len(services))
+	for i, service := range services {
+		field := fmt.Sprintf("spec.services[%d]", i)
+		if !v.serviceNameRegex.MatchString(service.Name) {
+			errors = append(errors, ValidationError{
+				Field:   field + ".name",
+				Message: "Service name must be a fully-qualified proto service name (e.g., helloworld.Greeter)",
+			})
+			continue
+		}
+		if seenServices[service.Name] {
+			errors = append(errors, ValidationError{
+				Field:   field + ".name",
+				Message: fmt.Sprintf("Duplicate service '%s'", service.Name),
+			})
+			continue
+		}
+		seenServices[service.Name] = true
+
+		if service.Methods == nil {
+			continue
+		}
+		if len(*service.Methods) == 0 {
+			errors = append(errors, ValidationError{
+				Field:   field + ".methods",
+				Message: "Methods cannot be empty when specified; omit it to expose all methods",
+			})
+			continue
+		}
+		seenMethods := make(map[string]bool, len(*service.Methods))
+		for j, method := range *service.Methods {
+			methodField := fmt.Sprintf("%s.methods[%d]", field, j)
+			if !v.methodNameRegex.MatchString(method) {
+				errors = append(errors, ValidationError{
+					Field:   methodField,
+					Message: "Method name must be a proto method name (letters, digits and underscores)",
+				})
+				continue
+			}
+			if seenMethods[method] {
+				errors = append(errors, ValidationError{
+					Field:   methodField,
+					Message: fmt.Sprintf("Duplicate method '%s'", method),
+				})
+			}
+			seenMethods[method] = true
+		}
+	}
+
+	return errors
+}
+
+// validateDescriptor validates the proto descriptor reference used for transcoding
+func (v *GrpcAPIValidator) validateDescriptor(descriptor *grpcapi.ProtoDescriptor) []ValidationError {
+	var errors []ValidationError
+
+	hasFile := descriptor.File != nil && strings.TrimSpace(*descriptor.File) != ""
+	hasInline := descriptor.Inline != nil && strings.TrimSpace(*descriptor.Inline) != ""
+	switch {
+	case hasFile && hasInline:
+		errors = append(errors, ValidationError{
+			Field:   "spec.descriptor",
+			Message: "Specify exactly one of 'file' or 'inline'",
+		})
+	case !hasFile && !hasInline:
+		errors = append(errors, ValidationError{
+			Field:   "spec.descriptor",
+			Message: "Must specify either 'file' or 'inline'",
+		})
+	case hasFile:
+		file := *descriptor.File
+		if !filepath.IsAbs(file) || filepath.Clean(file) != file {
+			errors = append(errors, ValidationError{
+				Field:   "spec.descriptor.file",
+				Message: "Descriptor file must be an absolute, clean path",
+			})
+		}
+	case hasInline:
+		if _, err := base64.StdEncoding.DecodeString(*descriptor.Inline); err != nil {
+			errors = append(errors, ValidationError{
+				Field:   "spec.descriptor.inline",
+				Message: "Inline descriptor must be a base64-encoded descriptor set",
+			})
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+func newTestGrpcAPI() grpcapi.GrpcAPI {
+	return grpcapi.GrpcAPI{
+		ApiVersion: grpcapi.ApiVersionV1,
+		Kind:       grpcapi.KindGrpcApi,
+		Metadata:   api.Metadata{Name: "greeter"},
+		Spec: grpcapi.GrpcAPIData{
+			DisplayName: "Greeter",
+			Version:     "v1.0",
+			Upstream:    grpcapi.GrpcUpstream{Url: "http://greeter:50051"},
+			Services: []grpcapi.GrpcService{
+				{Name: "helloworld.Greeter", Methods: &[]string{"SayHello", "SayHelloAgain"}},
+			},
+		},
+	}
+}
+
+func TestGrpcAPIValidator_ValidConfig(t *testing.T) {
+	v := NewGrpcAPIValidator()
+
+	cfg := newTestGrpcAPI()
+	assert.Empty(t, v.Validate(cfg))
+	assert.Empty(t, v.Validate(&cfg))
+
+	cfg.Spec.Context = stringPtr("/greeter/$version")
+	cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{File: stringPtr("/etc/envoy/protos/greeter.pb")}
+	assert.Empty(t, v.Validate(cfg))
+}
+
+func TestGrpcAPIValidator_Validate_UnsupportedType(t *testing.T) {
+	errors := NewGrpcAPIValidator().Validate("invalid type")
+	assert.Len(t, errors, 1)
+	assert.Equal(t, "config", errors[0].Field)
+}
+
+func TestGrpcAPIValidator_InvalidConfigs(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(cfg *grpcapi.GrpcAPI)
+		field  string
+	}{
+		{"wrong kind", func(cfg *grpcapi.GrpcAPI) { cfg.Kind = "RestApi" }, "kind"},
+		{"missing handle", func(cfg *grpcapi.GrpcAPI) { cfg.Metadata.Name = "" }, "metadata.name"},
+		{"missing display name", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.DisplayName = "" }, "spec.displayName"},
+		{"invalid version", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Version = "latest" }, "spec.version"},
+		{"missing upstream", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Upstream.Url = "" }, "spec.upstream.url"},
+		{"upstream scheme", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Upstream.Url = "grpc://greeter:50051" }, "spec.upstream.url"},
+		{"upstream path", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Upstream.Url = "http://greeter:50051/base" }, "spec.upstream.url"},
+		{"no services", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Services = nil }, "spec.services"},
+		{"unqualified service", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Services[0].Name = "helloworld/Greeter" }, "spec.services[0].name"},
+		{"duplicate service", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Services = append(cfg.Spec.Services, grpcapi.GrpcService{Name: "helloworld.Greeter"})
+		}, "spec.services[1].name"},
+		{"empty methods", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Services[0].Methods = &[]string{} }, "spec.services[0].methods"},
+		{"invalid method", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Services[0].Methods = &[]string{"Say/Hello"} }, "spec.services[0].methods[0]"},
+		{"duplicate method", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Services[0].Methods = &[]string{"SayHello", "SayHello"} }, "spec.services[0].methods[1]"},
+		{"descriptor without context", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{File: stringPtr("/protos/greeter.pb")}
+		}, "spec.context"},
+		{"context without descriptor", func(cfg *grpcapi.GrpcAPI) { cfg.Spec.Context = stringPtr("/greeter") }, "spec.context"},
+		{"root context", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Context = stringPtr("/")
+			cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{File: stringPtr("/protos/greeter.pb")}
+		}, "spec.context"},
+		{"descriptor file and inline", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Context = stringPtr("/greeter")
+			cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{File: stringPtr("/protos/greeter.pb"), Inline: stringPtr("CgA=")}
+		}, "spec.descriptor"},
+		{"relative descriptor file", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Context = stringPtr("/greeter")
+			cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{File: stringPtr("/protos/../../etc/passwd")}
+		}, "spec.descriptor.file"},
+		{"inline descriptor not base64", func(cfg *grpcapi.GrpcAPI) {
+			cfg.Spec.Context = stringPtr("/greeter")
+			cfg.Spec.Descriptor = &grpcapi.ProtoDescriptor{Inline: stringPtr("not base64!")}
+		}, "spec.descriptor.inline"},
+	}
+
+	v := NewGrpcAPIValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestGrpcAPI()
+			tt.mutate(&cfg)
+			errors := v.Validate(cfg)
+			fields := make([]string, 0, len(errors))
+			for _, e := range errors {
+				fields = append(fields, e.Field)
+			}
+			assert.Contains(t, fields, tt.field)
+		})
+	}
+}
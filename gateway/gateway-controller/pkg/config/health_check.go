@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+)
+
+// Defaults and bounds for upstream health checks
+const (
+	DefaultHealthCheckInterval           = 10 * time.Second
+	DefaultHealthCheckTimeout            = 5 * time.Second
+	DefaultHealthCheckUnhealthyThreshold = 3
+	DefaultHealthCheckHealthyThreshold   = 2
+
+	minHealthCheckInterval  = time.Second
+	maxHealthCheckInterval  = 5 * time.Minute
+	maxHealthCheckThreshold = 10
+)
+
+// HealthCheckDurations returns the probe interval and timeout of a health check, applying
+// the defaults for unset values.
+func HealthCheckDurations(hc *api.UpstreamHealthCheck) (interval, timeout time.Duration, err error) {
+	interval, err = optionalDuration(hc.Interval, DefaultHealthCheckInterval)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid health check interval: %w", err)
+	}
+	timeout, err = optionalDuration(hc.Timeout, DefaultHealthCheckTimeout)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid health check timeout: %w", err)
+	}
+	return interval, timeout, nil
+}
+
+// optionalDuration parses a single-unit duration, returning fallback when value is unset.
+func optionalDuration(value *string, fallback time.Duration) (time.Duration, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return fallback, nil
+	}
+	s := strings.TrimSpace(*value)
+	if !constants.ResilienceDurationRegex.MatchString(s) {
+		return 0, fmt.Errorf("'%s' is not a single-unit duration like '10s' or '500ms'", s)
+	}
+	return time.ParseDuration(s)
+}
+
+// validateHealthCheck validates an upstream health check block. A nil block is valid.
+func validateHealthCheck(field string, hc *api.UpstreamHealthCheck) []ValidationError {
+	var errors []ValidationError
+	if hc == nil {
+		return errors
+	}
+
+	if !strings.HasPrefix(hc.Path, "/") {
+		errors = append(errors, ValidationError{
+			Field:   field + ".path",
+			Message: "Health check path must start with '/'",
+		})
+	}
+
+	interval, intervalErr := optionalDuration(hc.Interval, DefaultHealthCheckInterval)
+	if intervalErr != nil {
+		errors = append(errors, ValidationError{Field: field + ".interval", Message: intervalErr.Error()})
+	} else if interval < minHealthCheckInterval || interval > maxHealthCheckInterval {
+		errors = append(errors, ValidationError{
+			Field:   field + ".interval",
+			Message: fmt.Sprintf("Health check interval must be between %s and %s", minHealthCheckInterval, maxHealthCheckInterval),
+		})
+	}
+
+	timeout, timeoutErr := optionalDuration(hc.Timeout, DefaultHealthCheckTimeout)
+	switch {
+	case timeoutErr != nil:
+		errors = append(errors, ValidationError{Field: field + ".timeout", Message: timeoutErr.Error()})
+	case timeout <= 0:
+		errors = append(errors, ValidationError{
+			Field:   field + ".timeout",
+			Message: "Health check timeout must be greater than zero",
+		})
+	case intervalErr == nil && timeout > interval:
+		errors = append(errors, ValidationError{
+			Field:   field + ".timeout",
+			Message: "Health check timeout must not exceed the interval",
+		})
+	}
+
+	validateThreshold := func(name string, value *int) {
+		if value != nil && (*value < 1 || *value > maxHealthCheckThreshold) {
+			errors = append(errors, ValidationError{
+				Field:   field + "." + name,
+				Message: fmt.Sprintf("Threshold must be between 1 and %d", maxHealthCheckThreshold),
+			})
+		}
+	}
+	validateThreshold("unhealthyThreshold", hc.UnhealthyThreshold)
+	validateThreshold("healthyThreshold", hc.HealthyThreshold)
+
+	if hc.ExpectedStatuses != nil {
+		if len(*hc.ExpectedStatuses) == 0 {
+			errors = append(errors, ValidationError{
+				Field:   field + ".expectedStatuses",
+				Message: "At least one expected status is required when expectedStatuses is set",
+			})
+		}
+		for i, status := range *hc.ExpectedStatuses {
+			if status < 100 || status > 599 {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.expectedStatuses[%d]", field, i),
+					Message: "Expected status must be between 100 and 599",
+				})
+			}
+		}
+	}
+
+	return errors
+}
@@ -392,6 +392,7 @@ func (v *LLMValidator) validateProviderSpec(spec *api.LLMProviderConfigData) []V
 	// Validate API-level resilience (timeout / idleTimeout). LLM kinds support resilience at
 	// the API level only.
 	errors = append(errors, validateResilienceTimeouts("spec.resilience", spec.Resilience)...)
+	errors = append(errors, validateResilienceRetries("spec.resilience", spec.Resilience)...)
 
 	return errors
 }
@@ -432,6 +433,15 @@ func (v *LLMValidator) validateUpstreamWithAuth(fieldPrefix string,
 		return errors
 	}
 
+	if upstream.Targets != nil {
+		errors = append(errors, ValidationError{
+			Field:   fmt.Sprintf("%s.targets", fieldPrefix),
+			Message: "Weighted targets are only supported for REST APIs",
+		})
+	}
+	errors = append(errors, validateHealthCheck(fieldPrefix+".healthCheck", upstream.HealthCheck)...)
+	errors = append(errors, validateOutlierDetection(fieldPrefix+".outlierDetection", upstream.OutlierDetection)...)
+
 	// Validate url XOR ref
 	hasURL := upstream.Url != nil && strings.TrimSpace(*upstream.Url) != ""
 	hasRef := upstream.Ref != nil && strings.TrimSpace(*upstream.Ref) != ""
@@ -672,6 +682,7 @@ func (v *LLMValidator) validateProxyData(spec *api.LLMProxyConfigData) []Validat
 	// Validate API-level resilience (timeout / idleTimeout). LLM kinds support resilience at
 	// the API level only.
 	errors = append(errors, validateResilienceTimeouts("spec.resilience", spec.Resilience)...)
+	errors = append(errors, validateResilienceRetries("spec.resilience", spec.Resilience)...)
 
 	return errors
 }
@@ -157,6 +157,7 @@ func (v *MCPValidator) validateSpec(spec *api.MCPProxyConfigData) []ValidationEr
 	// Validate API-level resilience (timeout / idleTimeout). MCP supports resilience at the API
 	// level only; the route timeout defaults to disabled for MCP when unset (see mcp-timeout-divergence.md).
 	errors = append(errors, validateResilienceTimeouts("spec.resilience", spec.Resilience)...)
+	errors = append(errors, validateResilienceRetries("spec.resilience", spec.Resilience)...)
 
 	return errors
 }
@@ -211,6 +212,15 @@ func (v *MCPValidator) validateUpstream(fieldPrefix string, upstream *api.MCPPro
 		return errors
 	}
 
+	if upstream.Targets != nil {
+		errors = append(errors, ValidationError{
+			Field:   fmt.Sprintf("%s.targets", fieldPrefix),
+			Message: "Weighted targets are only supported for REST APIs",
+		})
+	}
+	errors = append(errors, validateHealthCheck(fieldPrefix+".healthCheck", upstream.HealthCheck)...)
+	errors = append(errors, validateOutlierDetection(fieldPrefix+".outlierDetection", upstream.OutlierDetection)...)
+
 	// Validate url XOR ref
 	hasURL := upstream.Url != nil && strings.TrimSpace(*upstream.Url) != ""
 	hasRef := upstream.Ref != nil && strings.TrimSpace(*upstream.Ref) != ""
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+// Defaults and bounds for upstream outlier detection
+const (
+	DefaultOutlierConsecutive5xx     = 5
+	DefaultOutlierInterval           = 10 * time.Second
+	DefaultOutlierBaseEjectionTime   = 30 * time.Second
+	DefaultOutlierMaxEjectionPercent = 10
+	minOutlierInterval               = time.Second
+	maxOutlierInterval               = 5 * time.Minute
+	minOutlierBaseEjectionTime       = time.Second
+	maxOutlierBaseEjectionTime       = time.Hour
+	maxOutlierConsecutive5xx         = 100
+)
+
+// OutlierDetectionDurations returns the ejection sweep interval and base ejection time of an
+// outlier detection block, applying the defaults for unset values.
+func OutlierDetectionDurations(od *api.UpstreamOutlierDetection) (interval, baseEjectionTime time.Duration, err error) {
+	interval, err = optionalDuration(od.Interval, DefaultOutlierInterval)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid outlier detection interval: %w", err)
+	}
+	baseEjectionTime, err = optionalDuration(od.BaseEjectionTime, DefaultOutlierBaseEjectionTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid outlier detection base ejection time: %w", err)
+	}
+	return interval, baseEjectionTime, nil
+}
+
+// validateOutlierDetection validates an upstream outlier detection block. A nil block is valid.
+func validateOutlierDetection(field string, od *api.UpstreamOutlierDetection) []ValidationError {
+	var errors []ValidationError
+	if od == nil {
+		return errors
+	}
+
+	if od.Consecutive5xx != nil && (*od.Consecutive5xx < 1 || *od.Consecutive5xx > maxOutlierConsecutive5xx) {
+		errors = append(errors, ValidationError{
+			Field:   field + ".consecutive5xx",
+			Message: fmt.Sprintf("Consecutive 5xx count must be between 1 and %d", maxOutlierConsecutive5xx),
+		})
+	}
+
+	interval, err := optionalDuration(od.Interval, DefaultOutlierInterval)
+	if err != nil {
+		errors = append(errors, ValidationError{Field: field + ".interval", Message: err.Error()})
+	} else if interval < minOutlierInterval || interval > maxOutlierInterval {
+		errors = append(errors, ValidationError{
+			Field:   field + ".interval",
+			Message: fmt.Sprintf("Outlier detection interval must be between %s and %s", minOutlierInterval, maxOutlierInterval),
+		})
+	}
+
+	baseEjectionTime, err := optionalDuration(od.BaseEjectionTime, DefaultOutlierBaseEjectionTime)
+	if err != nil {
+		errors = append(errors, ValidationError{Field: field + ".baseEjectionTime", Message: err.Error()})
+	} else if baseEjectionTime < minOutlierBaseEjectionTime || baseEjectionTime > maxOutlierBaseEjectionTime {
+		errors = append(errors, ValidationError{
+			Field:   field + ".baseEjectionTime",
+			Message: fmt.Sprintf("Base ejection time must be between %s and %s", minOutlierBaseEjectionTime, maxOutlierBaseEjectionTime),
+		})
+	}
+
+	if od.MaxEjectionPercent != nil && (*od.MaxEjectionPercent < 1 || *od.MaxEjectionPercent > 100) {
+		errors = append(errors, ValidationError{
+			Field:   field + ".maxEjectionPercent",
+			Message: "Max ejection percent must be between 1 and 100",
+		})
+	}
+
+	return errors
+}
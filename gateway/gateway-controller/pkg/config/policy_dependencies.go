@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderPolicyChain returns the order in which the policies of a chain must run
+// so that every policy runs after the policies it depends on, as indexes into
+// names. dependsOn returns the dependencies declared by a policy; dependencies
+// on policies that are not in the chain are ignored. Policies that are not
+// constrained keep their positional order, so a chain without dependencies is
+// returned unchanged. An error naming the cycle is returned when the
+// dependencies cannot be satisfied.
+func OrderPolicyChain(names []string, dependsOn func(name string) []string) ([]int, error) {
+	// deps[i] lists the chain positions that must run before position i
+	deps := make([][]int, len(names))
+	for i, name := range names {
+		for _, dep := range dependsOn(name) {
+			for j, other := range names {
+				if other == dep {
+					deps[i] = append(deps[i], j)
+				}
+			}
+		}
+	}
+
+	placed := make([]bool, len(names))
+	order := make([]int, 0, len(names))
+	for len(order) < len(names) {
+		next := -1
+		for i := range names {
+			if !placed[i] && allPlaced(deps[i], placed) {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return nil, fmt.Errorf("policy dependency cycle: %s", strings.Join(dependencyCycle(names, deps, placed), " -> "))
+		}
+		placed[next] = true
+		order = append(order, next)
+	}
+	return order, nil
+}
+
+func allPlaced(indexes []int, placed []bool) bool {
+	for _, i := range indexes {
+		if !placed[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// dependencyCycle follows unplaced dependencies from the first unplaced policy.
+// Every unplaced policy waits on another unplaced one, so the walk must revisit
+// a policy; the path from its first visit is the cycle.
+func dependencyCycle(names []string, deps [][]int, placed []bool) []string {
+	current := -1
+	for i := range names {
+		if !placed[i] {
+			current = i
+			break
+		}
+	}
+
+	visitedAt := make(map[int]int)
+	var path []int
+	for {
+		if start, ok := visitedAt[current]; ok {
+			cycle := make([]string, 0, len(path)-start+1)
+			for _, i := range path[start:] {
+				cycle = append(cycle, names[i])
+			}
+			return append(cycle, names[current])
+		}
+		visitedAt[current] = len(path)
+		path = append(path, current)
+		for _, dep := range deps[current] {
+			if !placed[dep] {
+				current = dep
+				break
+			}
+		}
+	}
+}
+
+// validateChainDependencies reports a validation error when the dependencies of
+// the policies in a chain form a cycle.
+func validateChainDependencies(names []string, dependsOn func(name string) []string, fieldPath string) []ValidationError {
+	if _, err := OrderPolicyChain(names, dependsOn); err != nil {
+		return []ValidationError{{
+			Field:   fieldPath,
+			Message: fmt.Sprintf("Policies cannot be ordered: %v", err),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func dependsOnMap(deps map[string][]string) func(string) []string {
+	return func(name string) []string { return deps[name] }
+}
+
+func TestOrderPolicyChain(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain []string
+		deps  map[string][]string
+		want  []int
+	}{
+		{name: "no dependencies", chain: []string{"a", "b", "c"}, want: []int{0, 1, 2}},
+		{name: "already ordered", chain: []string{"auth", "transform"},
+			deps: map[string][]string{"transform": {"auth"}}, want: []int{0, 1}},
+		{name: "moves dependent after dependency", chain: []string{"transform", "log", "auth"},
+			deps: map[string][]string{"transform": {"auth"}}, want: []int{1, 2, 0}},
+		{name: "dependency not in chain", chain: []string{"transform", "log"},
+			deps: map[string][]string{"transform": {"auth"}}, want: []int{0, 1}},
+		{name: "transitive", chain: []string{"c", "b", "a"},
+			deps: map[string][]string{"c": {"b"}, "b": {"a"}}, want: []int{2, 1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := OrderPolicyChain(tt.chain, dependsOnMap(tt.deps))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, order)
+		})
+	}
+}
+
+func TestOrderPolicyChain_Cycle(t *testing.T) {
+	_, err := OrderPolicyChain([]string{"log", "a", "b", "c"}, dependsOnMap(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	}))
+
+	require.Error(t, err)
+	assert.Equal(t, "policy dependency cycle: a -> b -> c -> a", err.Error())
+}
+
+func TestOrderPolicyChain_SelfDependency(t *testing.T) {
+	_, err := OrderPolicyChain([]string{"a"}, dependsOnMap(map[string][]string{"a": {"a"}}))
+
+	require.Error(t, err)
+	assert.Equal(t, "policy dependency cycle: a -> a", err.Error())
+}
+
+func TestPolicyValidator_DependencyCycle(t *testing.T) {
+	validator := NewPolicyValidator(map[string]models.PolicyDefinition{
+		"a|v1.0.0":   {Name: "a", Version: "v1.0.0", DependsOn: []string{"b"}},
+		"b|v1.0.0":   {Name: "b", Version: "v1.0.0", DependsOn: []string{"a"}},
+		"log|v1.0.0": {Name: "log", Version: "v1.0.0"},
+	})
+	apiConfig := &api.RestAPI{
+		Spec: api.APIConfigData{
+			Policies: &[]api.Policy{{Name: "a", Version: "v1"}},
+			Operations: []api.Operation{
+				{Policies: &[]api.Policy{{Name: "log", Version: "v1"}}},
+				{Policies: &[]api.Policy{{Name: "b", Version: "v1"}}},
+			},
+		},
+	}
+
+	errors := validator.ValidateRestAPIPolicies(apiConfig)
+
+	require.Len(t, errors, 1)
+	assert.Equal(t, "spec.operations[1].policies", errors[0].Field)
+	assert.Contains(t, errors[0].Message, "policy dependency cycle: a -> b -> a")
+}
+
+func TestPolicyValidator_DependencyOrderAccepted(t *testing.T) {
+	validator := NewPolicyValidator(map[string]models.PolicyDefinition{
+		"transform|v1.0.0": {Name: "transform", Version: "v1.0.0", DependsOn: []string{"auth"}},
+		"auth|v1.0.0":      {Name: "auth", Version: "v1.0.0"},
+	})
+	apiConfig := &api.RestAPI{
+		Spec: api.APIConfigData{
+			Policies:   &[]api.Policy{{Name: "transform", Version: "v1"}},
+			Operations: []api.Operation{{Policies: &[]api.Policy{{Name: "auth", Version: "v1"}}}},
+		},
+	}
+
+	assert.Empty(t, validator.ValidateRestAPIPolicies(apiConfig))
+}
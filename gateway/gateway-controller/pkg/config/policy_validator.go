@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	versionutil "github.com/wso2/api-platform/common/version"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
@@ -31,6 +32,7 @@ import (
 
 // PolicyValidator validates policies referenced in API configurations
 type PolicyValidator struct {
+	mu                sync.RWMutex // Guards the definitions swapped in by ReplaceDefinitions
 	policyDefinitions map[string]models.PolicyDefinition
 	latestVersions    map[string]string // policyName -> latest full semver, pre-computed at construction
 }
@@ -43,6 +45,42 @@ func NewPolicyValidator(policyDefinitions map[string]models.PolicyDefinition) *P
 	}
 }
 
+// ReplaceDefinitions atomically swaps the policy definitions used for validation.
+// Validations already in progress keep using the set they started with.
+func (pv *PolicyValidator) ReplaceDefinitions(policyDefinitions map[string]models.PolicyDefinition) {
+	latestVersions := BuildLatestVersionIndex(policyDefinitions)
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	pv.policyDefinitions = policyDefinitions
+	pv.latestVersions = latestVersions
+}
+
+// definitions returns the current policy definitions and their latest-version index
+func (pv *PolicyValidator) definitions() (map[string]models.PolicyDefinition, map[string]string) {
+	pv.mu.RLock()
+	defer pv.mu.RUnlock()
+	return pv.policyDefinitions, pv.latestVersions
+}
+
+// ValidatePolicyDefinitions checks that a set of loaded policy definitions is usable
+// for validation, i.e. that every parameter schema compiles.
+func ValidatePolicyDefinitions(policyDefinitions map[string]models.PolicyDefinition) error {
+	for key, def := range policyDefinitions {
+		for field, schema := range map[string]*map[string]interface{}{
+			"parameters":       def.Parameters,
+			"systemParameters": def.SystemParameters,
+		} {
+			if schema == nil {
+				continue
+			}
+			if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(*schema)); err != nil {
+				return fmt.Errorf("policy %s has an invalid %s schema: %w", key, field, err)
+			}
+		}
+	}
+	return nil
+}
+
 // BuildLatestVersionIndex scans policy definitions once and builds a map of
 // policyName -> latest full semver. Used for O(1) empty-version resolution.
 func BuildLatestVersionIndex(definitions map[string]models.PolicyDefinition) map[string]string {
@@ -97,9 +135,88 @@ func (pv *PolicyValidator) ValidateRestAPIPolicies(apiConfig *api.RestAPI) []Val
 		}
 	}
 
+	// An operation-level policy merges with the API-level policy of the same name only
+	// when both resolve to the same version; conflicting versions are rejected rather
+	// than running two versions of one policy in the chain
+	for opIdx, operation := range apiConfig.Spec.Operations {
+		if operation.Policies != nil {
+			for pIdx, policy := range *operation.Policies {
+				fieldPath := fmt.Sprintf("spec.operations[%d].policies[%d]", opIdx, pIdx)
+				errors = append(errors, pv.validateVersionAgainstAPILevel(apiConfig.Spec.Policies, policy, fieldPath)...)
+			}
+		}
+	}
+
+	// Validate that each operation's chain (API-level then operation-level policies)
+	// can be ordered by the policies' dependsOn declarations. A cycle among the
+	// API-level policies is reported once rather than for every operation.
+	reported := make(map[string]bool)
+	for opIdx, operation := range apiConfig.Spec.Operations {
+		var chain []api.Policy
+		if apiConfig.Spec.Policies != nil {
+			chain = append(chain, *apiConfig.Spec.Policies...)
+		}
+		if operation.Policies != nil {
+			chain = append(chain, *operation.Policies...)
+		}
+		for _, err := range pv.validatePolicyChainOrder(chain, fmt.Sprintf("spec.operations[%d].policies", opIdx)) {
+			if !reported[err.Message] {
+				reported[err.Message] = true
+				errors = append(errors, err)
+			}
+		}
+	}
+
 	return errors
 }
 
+// validateVersionAgainstAPILevel reports an error when an operation-level policy resolves
+// to a different version than an API-level policy of the same name. Policies that do not
+// resolve are skipped; they are reported by validatePolicy.
+func (pv *PolicyValidator) validateVersionAgainstAPILevel(apiPolicies *[]api.Policy, policy api.Policy, fieldPath string) []ValidationError {
+	if apiPolicies == nil {
+		return nil
+	}
+	policyDefinitions, latestVersions := pv.definitions()
+	resolved, err := ResolvePolicyVersion(policyDefinitions, latestVersions, policy.Name, policy.Version)
+	if err != nil {
+		return nil
+	}
+	for i, apiPolicy := range *apiPolicies {
+		if apiPolicy.Name != policy.Name {
+			continue
+		}
+		apiResolved, err := ResolvePolicyVersion(policyDefinitions, latestVersions, apiPolicy.Name, apiPolicy.Version)
+		if err != nil || apiResolved == resolved {
+			continue
+		}
+		return []ValidationError{{
+			Field: fieldPath + ".version",
+			Message: fmt.Sprintf("Policy '%s' resolves to version '%s' but spec.policies[%d] resolves to version '%s'; a policy must use the same version at API and operation level",
+				policy.Name, resolved, i, apiResolved),
+		}}
+	}
+	return nil
+}
+
+// validatePolicyChainOrder checks that the dependencies declared by the definitions
+// of the policies in a chain do not form a cycle. Policies that do not resolve to a
+// definition are skipped; they are reported by validatePolicy.
+func (pv *PolicyValidator) validatePolicyChainOrder(chain []api.Policy, fieldPath string) []ValidationError {
+	policyDefinitions, latestVersions := pv.definitions()
+	names := make([]string, 0, len(chain))
+	dependsOn := make(map[string][]string)
+	for _, policy := range chain {
+		resolved, err := ResolvePolicyVersion(policyDefinitions, latestVersions, policy.Name, policy.Version)
+		if err != nil {
+			continue
+		}
+		names = append(names, policy.Name)
+		dependsOn[policy.Name] = append(dependsOn[policy.Name], policyDefinitions[policy.Name+"|"+resolved].DependsOn...)
+	}
+	return validateChainDependencies(names, func(name string) []string { return dependsOn[name] }, fieldPath)
+}
+
 // ValidateLLMProviderPolicies validates all policy references in an LLM provider configuration.
 // Mirrors ValidateRestAPIPolicies: it checks the user-authored global, operation and (deprecated)
 // policy references against the loaded policy definitions. Policies injected later by the
@@ -128,11 +245,13 @@ func (pv *PolicyValidator) validateLLMPolicyRefs(globalPolicies *[]api.Policy, o
 		}
 	}
 
-	// Operation-level policies: validate name + version existence.
+	// Operation-level policies: validate name + version existence and the execution condition.
 	if operationPolicies != nil {
 		for i, policy := range *operationPolicies {
-			_, errs := pv.validatePolicyRef(policy.Name, policy.Version, fmt.Sprintf("spec.operationPolicies[%d]", i))
+			fieldPath := fmt.Sprintf("spec.operationPolicies[%d]", i)
+			_, errs := pv.validatePolicyRef(policy.Name, policy.Version, fieldPath)
 			errors = append(errors, errs...)
+			errors = append(errors, validateExecutionCondition(policy.Name, policy.ExecutionCondition, fieldPath)...)
 		}
 	}
 
@@ -147,8 +266,9 @@ func (pv *PolicyValidator) validateLLMPolicyRefs(globalPolicies *[]api.Policy, o
 	return errors
 }
 
-// validatePolicy validates a single policy reference (name + version existence) and, when the
-// definition declares a parameter schema, the policy's params against that schema.
+// validatePolicy validates a single policy reference (name + version existence), the policy's
+// params against the definition's parameter schema when one is declared, and that the policy's
+// execution condition compiles.
 func (pv *PolicyValidator) validatePolicy(policy api.Policy, fieldPath string) []ValidationError {
 	policyDef, errors := pv.validatePolicyRef(policy.Name, policy.Version, fieldPath)
 	if len(errors) > 0 {
@@ -162,10 +282,12 @@ func (pv *PolicyValidator) validatePolicy(policy api.Policy, fieldPath string) [
 		if policy.Params != nil {
 			params = *policy.Params
 		}
-		schemaErrs := pv.validatePolicyParams(params, *policyDef.Parameters, fieldPath+".params")
+		schemaErrs := pv.validatePolicyParams(policy.Name, params, *policyDef.Parameters, fieldPath+".params")
 		errors = append(errors, schemaErrs...)
 	}
 
+	errors = append(errors, validateExecutionCondition(policy.Name, policy.ExecutionCondition, fieldPath)...)
+
 	return errors
 }
 
@@ -175,7 +297,8 @@ func (pv *PolicyValidator) validatePolicy(policy api.Policy, fieldPath string) [
 // - Allow major-only versions (vX) and resolve them to a single matching full version
 // - An empty version resolves to the latest available version for that policy name
 func (pv *PolicyValidator) validatePolicyRef(name, version, fieldPath string) (*models.PolicyDefinition, []ValidationError) {
-	resolvedVersion, err := pv.resolvePolicyVersion(name, version)
+	policyDefinitions, latestVersions := pv.definitions()
+	resolvedVersion, err := ResolvePolicyVersion(policyDefinitions, latestVersions, name, version)
 	if err != nil {
 		return nil, []ValidationError{{
 			Field:   fieldPath + ".version",
@@ -185,7 +308,7 @@ func (pv *PolicyValidator) validatePolicyRef(name, version, fieldPath string) (*
 
 	// Check if policy definition exists
 	key := name + "|" + resolvedVersion
-	policyDef, exists := pv.policyDefinitions[key]
+	policyDef, exists := policyDefinitions[key]
 	if !exists {
 		return nil, []ValidationError{{
 			Field:   fieldPath + ".name",
@@ -201,14 +324,6 @@ var (
 	majorVersionPattern = regexp.MustCompile(`^v\d+$`)
 )
 
-// resolvePolicyVersion resolves a policy version string. Only major-only
-// versions (e.g., v1) are accepted; they are resolved to the unique full
-// version (vX.Y.Z) from the loaded definitions. Full semantic version
-// (e.g., v1.0.0) is rejected.
-func (pv *PolicyValidator) resolvePolicyVersion(name, version string) (string, error) {
-	return ResolvePolicyVersion(pv.policyDefinitions, pv.latestVersions, name, version)
-}
-
 // ResolvePolicyVersion resolves a policy version using the given definitions map.
 // Only major-only versions (e.g., v1) are accepted; they are resolved to the
 // unique full version (vX.Y.Z) for that policy name. Full semantic version
@@ -274,8 +389,9 @@ func ResolvePolicyVersion(definitions map[string]models.PolicyDefinition, latest
 	return "", fmt.Errorf("invalid version format '%s' for policy '%s'; expected major-only version (e.g., v1)", version, name)
 }
 
-// validatePolicyParams validates policy parameters against a JSON schema
-func (pv *PolicyValidator) validatePolicyParams(params map[string]interface{}, schema map[string]interface{}, fieldPath string) []ValidationError {
+// validatePolicyParams validates policy parameters against a JSON schema. Each error names
+// the policy and the parameter at fault, and its field points at that parameter.
+func (pv *PolicyValidator) validatePolicyParams(policyName string, params map[string]interface{}, schema map[string]interface{}, fieldPath string) []ValidationError {
 	var errors []ValidationError
 
 	// Create JSON schema loader
@@ -287,7 +403,7 @@ func (pv *PolicyValidator) validatePolicyParams(params map[string]interface{}, s
 	if err != nil {
 		errors = append(errors, ValidationError{
 			Field:   fieldPath,
-			Message: fmt.Sprintf("Failed to validate policy parameters: %v", err),
+			Message: fmt.Sprintf("Failed to validate parameters of policy '%s': %v", policyName, err),
 		})
 		return errors
 	}
@@ -295,22 +411,50 @@ func (pv *PolicyValidator) validatePolicyParams(params map[string]interface{}, s
 	// Collect validation errors
 	if !result.Valid() {
 		for _, validationErr := range result.Errors() {
-			// Extract field path from the error context
-			fieldName := validationErr.Field()
-			if fieldName == "(root)" {
-				fieldName = fieldPath
-			} else {
-				// Remove the "(root)." prefix if present
-				fieldName = strings.TrimPrefix(fieldName, "(root).")
-				fieldName = fieldPath + "." + fieldName
+			param := paramPath(validationErr)
+			fieldName := fieldPath
+			if param != "" {
+				fieldName = fieldPath + "." + param
 			}
 
+			var message string
+			switch {
+			case validationErr.Type() == "required":
+				message = fmt.Sprintf("policy '%s': required parameter '%s' is missing", policyName, param)
+			case param == "":
+				message = fmt.Sprintf("policy '%s' parameters: %s", policyName, validationErr.Description())
+			default:
+				message = fmt.Sprintf("policy '%s' parameter '%s': %s", policyName, param, validationErr.Description())
+			}
 			errors = append(errors, ValidationError{
 				Field:   fieldName,
-				Message: validationErr.Description(),
+				Message: message,
 			})
 		}
 	}
 
 	return errors
 }
+
+// paramPath returns the dotted path of the parameter a schema error is about, or "" for an
+// error about the parameters as a whole. A missing required or disallowed additional
+// property is reported by gojsonschema against its parent object, so the property is
+// appended here.
+func paramPath(validationErr gojsonschema.ResultError) string {
+	path := validationErr.Field()
+	if path == "(root)" {
+		path = ""
+	}
+	path = strings.TrimPrefix(path, "(root).")
+
+	switch validationErr.Type() {
+	case "required", "additional_property_not_allowed":
+		if property, ok := validationErr.Details()["property"].(string); ok && property != "" {
+			if path == "" {
+				return property
+			}
+			return path + "." + property
+		}
+	}
+	return path
+}
@@ -156,3 +156,21 @@ func TestPolicyValidator_ValidateLLMProxyPolicies_NonExistentMajorVersion(t *tes
 	assert.Len(t, errors, 1, "expected one error for a non-existent major version")
 	assert.Contains(t, errors[0].Message, "major version 'v999' not found")
 }
+
+func TestPolicyValidator_ValidateLLMProviderPolicies_InvalidOperationPolicyCondition(t *testing.T) {
+	validator := NewPolicyValidator(ratelimitDefs())
+	condition := `request.Headers["x-tier"] == "gold"`
+
+	cfg := &api.LLMProviderConfiguration{
+		Spec: api.LLMProviderConfigData{
+			OperationPolicies: &[]api.OperationPolicy{
+				{Name: "token-based-ratelimit", Version: "v1", ExecutionCondition: &condition},
+			},
+		},
+	}
+
+	errors := validator.ValidateLLMProviderPolicies(cfg)
+	assert.Len(t, errors, 1, "headers are lists, so comparing one to a string is a type error")
+	assert.Equal(t, "spec.operationPolicies[0].executionCondition", errors[0].Field)
+	assert.Contains(t, errors[0].Message, "token-based-ratelimit")
+}
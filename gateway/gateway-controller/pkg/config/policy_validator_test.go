@@ -377,6 +377,84 @@ func TestPolicyValidator_TypeMismatch(t *testing.T) {
 	}
 }
 
+// TestPolicyValidator_ParamErrorsNamePolicyAndParam verifies that parameter schema errors
+// name the policy and the parameter at fault, and point the field at that parameter.
+func TestPolicyValidator_ParamErrorsNamePolicyAndParam(t *testing.T) {
+	policyDefs := map[string]models.PolicyDefinition{
+		"RateLimit|v1.0.0": {
+			Name:    "RateLimit",
+			Version: "v1.0.0",
+			Parameters: &map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"limit": map[string]interface{}{"type": "integer"},
+					"unit":  map[string]interface{}{"type": "string", "enum": []interface{}{"second", "minute"}},
+					"key": map[string]interface{}{
+						"type":       "object",
+						"properties": map[string]interface{}{"header": map[string]interface{}{"type": "string"}},
+						"required":   []interface{}{"header"},
+					},
+				},
+				"required": []interface{}{"limit"},
+			},
+		},
+	}
+	validator := NewPolicyValidator(policyDefs)
+
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		wantField   string
+		wantMessage string
+	}{
+		{
+			name:        "missing required param",
+			params:      map[string]interface{}{"unit": "second"},
+			wantField:   "spec.operations[0].policies[0].params.limit",
+			wantMessage: "policy 'RateLimit': required parameter 'limit' is missing",
+		},
+		{
+			name:        "missing nested required param",
+			params:      map[string]interface{}{"limit": 10, "key": map[string]interface{}{}},
+			wantField:   "spec.operations[0].policies[0].params.key.header",
+			wantMessage: "policy 'RateLimit': required parameter 'key.header' is missing",
+		},
+		{
+			name:        "wrong type",
+			params:      map[string]interface{}{"limit": "ten"},
+			wantField:   "spec.operations[0].policies[0].params.limit",
+			wantMessage: "policy 'RateLimit' parameter 'limit': Invalid type. Expected: integer, given: string",
+		},
+		{
+			name:        "value not in enum",
+			params:      map[string]interface{}{"limit": 10, "unit": "hour"},
+			wantField:   "spec.operations[0].policies[0].params.unit",
+			wantMessage: "policy 'RateLimit' parameter 'unit': ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			apiConfig := &api.RestAPI{
+				Spec: api.APIConfigData{
+					Operations: []api.Operation{{
+						Method:   api.Ptr(api.OperationMethod("GET")),
+						Path:     api.Ptr("/resource"),
+						Policies: &[]api.Policy{{Name: "RateLimit", Version: "v1", Params: &params}},
+					}},
+				},
+			}
+
+			errors := validator.ValidateRestAPIPolicies(apiConfig)
+			if assert.Len(t, errors, 1) {
+				assert.Equal(t, tt.wantField, errors[0].Field)
+				assert.True(t, strings.HasPrefix(errors[0].Message, tt.wantMessage), errors[0].Message)
+			}
+		})
+	}
+}
+
 func TestPolicyValidator_MissingRequiredParams(t *testing.T) {
 	// Create policy definitions with required parameters
 	policyDefs := map[string]models.PolicyDefinition{
@@ -521,6 +599,66 @@ func TestPolicyValidator_MixedMajorVersions_SamePolicyName(t *testing.T) {
 	}
 }
 
+// newLevelVersionTestAPI returns a REST API that attaches MultiVersionPolicy at API level
+// with apiVersion and on its only operation with opVersion.
+func newLevelVersionTestAPI(apiVersion, opVersion string) *api.RestAPI {
+	return &api.RestAPI{
+		ApiVersion: api.RestAPIApiVersionGatewayApiPlatformWso2Comv1,
+		Kind:       api.RestAPIKindRestApi,
+		Spec: api.APIConfigData{
+			DisplayName: "Test API",
+			Version:     "v1.0",
+			Context:     "/test",
+			Upstream: struct {
+				Main    api.Upstream  `json:"main" yaml:"main"`
+				Sandbox *api.Upstream `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
+			}{
+				Main: api.Upstream{
+					Url: func() *string { s := "http://backend.example.com"; return &s }(),
+				},
+			},
+			Policies: &[]api.Policy{
+				{Name: "MultiVersionPolicy", Version: apiVersion},
+			},
+			Operations: []api.Operation{
+				{
+					Method: api.Ptr(api.OperationMethod("GET")),
+					Path:   api.Ptr("/resource"),
+					Policies: &[]api.Policy{
+						{Name: "MultiVersionPolicy", Version: opVersion},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestPolicyValidator_ConflictingVersionsAcrossLevels ensures that a policy attached at
+// API level and operation level with versions resolving differently is rejected.
+func TestPolicyValidator_ConflictingVersionsAcrossLevels(t *testing.T) {
+	policyDefs := map[string]models.PolicyDefinition{
+		"MultiVersionPolicy|v1.0.0": {Name: "MultiVersionPolicy", Version: "v1.0.0"},
+		"MultiVersionPolicy|v2.0.0": {Name: "MultiVersionPolicy", Version: "v2.0.0"},
+	}
+	validator := NewPolicyValidator(policyDefs)
+
+	errors := validator.ValidateRestAPIPolicies(newLevelVersionTestAPI("v1", "v2"))
+	if len(errors) != 1 {
+		t.Fatalf("Expected 1 validation error, got %d: %v", len(errors), errors)
+	}
+	if errors[0].Field != "spec.operations[0].policies[0].version" {
+		t.Errorf("Expected error on spec.operations[0].policies[0].version, got %s", errors[0].Field)
+	}
+	if !strings.Contains(errors[0].Message, "v2.0.0") || !strings.Contains(errors[0].Message, "v1.0.0") {
+		t.Errorf("Expected error to name both resolved versions, got: %s", errors[0].Message)
+	}
+
+	// An empty version resolving to the same version as the other level is not a conflict
+	if errors := validator.ValidateRestAPIPolicies(newLevelVersionTestAPI("v2", "")); len(errors) > 0 {
+		t.Errorf("Expected no validation errors for matching versions, got %d: %v", len(errors), errors)
+	}
+}
+
 // TestPolicyValidator_FullSemverRejected ensures that full semantic version (e.g. v1.0.0)
 // in API policy refs is rejected; only major-only (e.g. v1) is allowed.
 func TestPolicyValidator_FullSemverRejected(t *testing.T) {
@@ -980,3 +1118,98 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestPolicyValidator_ReplaceDefinitions(t *testing.T) {
+	validator := NewPolicyValidator(map[string]models.PolicyDefinition{
+		"RateLimit|v1.0.0": {Name: "RateLimit", Version: "v1.0.0"},
+	})
+
+	_, errs := validator.validatePolicyRef("Cors", "v1", "spec.policies[0]")
+	assert.NotEmpty(t, errs, "policy should be unknown before the reload")
+
+	validator.ReplaceDefinitions(map[string]models.PolicyDefinition{
+		"RateLimit|v1.0.0": {Name: "RateLimit", Version: "v1.0.0"},
+		"Cors|v1.2.0":      {Name: "Cors", Version: "v1.2.0"},
+	})
+
+	def, errs := validator.validatePolicyRef("Cors", "v1", "spec.policies[0]")
+	assert.Empty(t, errs)
+	assert.Equal(t, "v1.2.0", def.Version)
+}
+
+func TestValidatePolicyDefinitions(t *testing.T) {
+	valid := map[string]models.PolicyDefinition{
+		"RateLimit|v1.0.0": {
+			Name:       "RateLimit",
+			Version:    "v1.0.0",
+			Parameters: &map[string]interface{}{"type": "object"},
+		},
+	}
+	assert.NoError(t, ValidatePolicyDefinitions(valid))
+
+	invalid := map[string]models.PolicyDefinition{
+		"RateLimit|v1.0.0": {
+			Name:             "RateLimit",
+			Version:          "v1.0.0",
+			SystemParameters: &map[string]interface{}{"type": 12},
+		},
+	}
+	err := ValidatePolicyDefinitions(invalid)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "systemParameters"))
+}
+
+func TestPolicyValidator_InvalidExecutionCondition(t *testing.T) {
+	policyDefs := map[string]models.PolicyDefinition{
+		"RateLimiting|v1.0.0": {Name: "RateLimiting", Version: "v1.0.0"},
+	}
+	validator := NewPolicyValidator(policyDefs)
+
+	tests := []struct {
+		name      string
+		condition string
+		wantErr   string
+	}{
+		{name: "syntax error", condition: `request.Method == `, wantErr: "Syntax error"},
+		{name: "type mismatch", condition: `response.ResponseStatus == "500"`, wantErr: "no matching overload"},
+		{name: "non-boolean result", condition: `request.Path`, wantErr: "must return boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := tt.condition
+			apiConfig := &api.RestAPI{
+				Spec: api.APIConfigData{
+					Operations: []api.Operation{
+						{
+							Policies: &[]api.Policy{
+								{Name: "RateLimiting", Version: "v1", ExecutionCondition: &condition},
+							},
+						},
+					},
+				},
+			}
+
+			errors := validator.ValidateRestAPIPolicies(apiConfig)
+
+			assert.Len(t, errors, 1)
+			assert.Equal(t, "spec.operations[0].policies[0].executionCondition", errors[0].Field)
+			assert.Contains(t, errors[0].Message, "RateLimiting")
+			assert.Contains(t, errors[0].Message, tt.condition)
+			assert.Contains(t, errors[0].Message, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateExecutionCondition_EngineFunctions(t *testing.T) {
+	conditions := []string{
+		`!('selected_provider' in request.Metadata) || request.Metadata['selected_provider'] == 'openai'`,
+		`processing.phase == "response_headers" && response.ResponseStatus >= 500`,
+		`ipInRanges(request.ClientIP, ["10.0.0.0/8", "192.168.0.0/16"])`,
+		`jwt("roles").contains("admin") && jwtSub() != ""`,
+		`"orders" in jwtAud()`,
+	}
+	for _, condition := range conditions {
+		assert.NoError(t, ValidateExecutionCondition(condition), condition)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"reflect"
+
+	"github.com/wso2/api-platform/common/redact"
+)
+
+// secretTag marks a config field holding a secret, as `secret:"true"`. Redacted
+// masks every field so marked; new secret fields only need the tag.
+const secretTag = "secret"
+
+// Redacted returns a deep copy of the config that is safe to log: a non-empty
+// secret string is replaced by a placeholder, any other secret field is cleared,
+// and unexported fields, which hold secrets loaded from files, are left out.
+func (c *Config) Redacted() *Config {
+	if c == nil {
+		return nil
+	}
+	out := redactedValue(reflect.ValueOf(c).Elem()).Interface().(Config)
+	return &out
+}
+
+// redactedValue returns a copy of v with the secret fields of every struct in it masked.
+func redactedValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Tag.Get(secretTag) == "true" {
+				out.Field(i).Set(maskedValue(v.Field(i)))
+				continue
+			}
+			out.Field(i).Set(redactedValue(v.Field(i)))
+		}
+		return out
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(redactedValue(v.Elem()))
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(redactedValue(v.Elem()))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(redactedValue(v.Index(i)))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), redactedValue(iter.Value()))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// maskedValue returns the masked form of a secret field value: the placeholder
+// for a non-empty string, so the summary still shows the secret is set, and the
+// zero value otherwise.
+func maskedValue(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.String && v.Len() > 0 {
+		return reflect.ValueOf(redact.RedactedPlaceholder).Convert(v.Type())
+	}
+	return reflect.Zero(v.Type())
+}
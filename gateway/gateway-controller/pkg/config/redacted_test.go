@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/common/redact"
+)
+
+func TestConfig_Redacted(t *testing.T) {
+	secrets := []string{
+		"cp-registration-token",
+		"apim-client-secret",
+		"apim-password",
+		"admin-password-hash",
+		"postgres://gw:db-password@db:5432/gateway",
+		"db-password",
+		"legacy-db-password",
+		"moesif-application-id",
+		"redis-password",
+		"vault-token",
+		"default-policy-api-key",
+		"webhook-signing-secret",
+	}
+
+	cfg := validConfig()
+	cfg.Controller.ControlPlane.Host = "cp.example.com"
+	cfg.Controller.ControlPlane.Token = "cp-registration-token"
+	cfg.Controller.ControlPlane.ApimOAuth2ClientSecret = "apim-client-secret"
+	cfg.Controller.ControlPlane.ApimOAuth2Password = "apim-password"
+	cfg.Controller.Auth.Basic.Users = []AuthUser{{Username: "admin", Password: "admin-password-hash", PasswordHashed: true}}
+	cfg.Controller.Storage.Database = &DatabaseConfig{DSN: "postgres://gw:db-password@db:5432/gateway", Password: "db-password"}
+	cfg.Controller.Storage.Postgres.Password = "legacy-db-password"
+	cfg.Analytics.Publishers.Moesif.ApplicationID = "moesif-application-id"
+	cfg.PolicyConfigurations = map[string]interface{}{
+		"ratelimit_v1": map[string]interface{}{"redis": map[string]interface{}{"password": "redis-password"}},
+	}
+	cfg.PolicyEngine = map[string]interface{}{"secrets": map[string]interface{}{"vault": map[string]interface{}{"token": "vault-token"}}}
+	cfg.Controller.DefaultPolicies = []DefaultPolicyConfig{
+		{Name: "semantic-cache", Params: map[string]interface{}{"apiKey": "default-policy-api-key"}},
+	}
+	cfg.APIKey.Webhook.secret = []byte("webhook-signing-secret")
+
+	redacted := cfg.Redacted()
+
+	// Neither the JSON nor the text form of the summary carries a secret
+	jsonSummary, err := json.Marshal(redacted)
+	require.NoError(t, err)
+	textSummary := fmt.Sprintf("%+v", *redacted)
+	for _, secret := range secrets {
+		assert.NotContains(t, string(jsonSummary), secret)
+		assert.NotContains(t, textSummary, secret)
+	}
+
+	// Secret strings that are set show as set; other fields are kept
+	assert.Equal(t, redact.RedactedPlaceholder, redacted.Controller.ControlPlane.Token)
+	assert.Equal(t, redact.RedactedPlaceholder, redacted.Controller.Auth.Basic.Users[0].Password)
+	assert.Equal(t, redact.RedactedPlaceholder, redacted.Controller.Storage.Database.DSN)
+	assert.Empty(t, redacted.Controller.ControlPlane.ApimOAuth2Username, "unset secrets stay empty")
+	assert.Empty(t, redacted.PolicyConfigurations)
+	assert.Empty(t, redacted.Controller.DefaultPolicies[0].Params)
+	assert.Equal(t, "cp.example.com", redacted.Controller.ControlPlane.Host)
+	assert.Equal(t, "admin", redacted.Controller.Auth.Basic.Users[0].Username)
+	assert.Equal(t, "semantic-cache", redacted.Controller.DefaultPolicies[0].Name)
+	assert.Equal(t, cfg.Controller.Server, redacted.Controller.Server)
+
+	// The config itself is left untouched
+	assert.Equal(t, "cp-registration-token", cfg.Controller.ControlPlane.Token)
+	assert.Equal(t, "db-password", cfg.Controller.Storage.Database.Password)
+	assert.Equal(t, "default-policy-api-key", cfg.Controller.DefaultPolicies[0].Params["apiKey"])
+	assert.Equal(t, []byte("webhook-signing-secret"), cfg.APIKey.Webhook.Secret())
+}
+
+// TestConfig_SecretFieldsAreTagged guards against a new secret field being logged in
+// the clear: a string field named like a secret must carry the secret tag, or hold
+// the path of a file rather than the secret itself.
+func TestConfig_SecretFieldsAreTagged(t *testing.T) {
+	secretName := regexp.MustCompile(`(?i)(password|token|secret|dsn)`)
+	seen := map[reflect.Type]bool{}
+	var walk func(t reflect.Type, path string)
+	var untagged []string
+	walk = func(typ reflect.Type, path string) {
+		switch typ.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			walk(typ.Elem(), path)
+			return
+		case reflect.Struct:
+		default:
+			return
+		}
+		if seen[typ] {
+			return
+		}
+		seen[typ] = true
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := path + "." + field.Name
+			if field.Tag.Get(secretTag) == "true" {
+				continue
+			}
+			if field.Type.Kind() == reflect.String && secretName.MatchString(field.Name) &&
+				!strings.HasSuffix(field.Name, "File") && !strings.HasSuffix(field.Name, "Path") {
+				untagged = append(untagged, fieldPath)
+			}
+			walk(field.Type, fieldPath)
+		}
+	}
+	walk(reflect.TypeOf(Config{}), "Config")
+
+	assert.Empty(t, untagged, "secret fields must be tagged `secret:\"true\"`")
+}
@@ -21,6 +21,7 @@ package config
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 )
@@ -31,6 +32,28 @@ type ValidationError struct {
 	Message string `json:"message"`
 }
 
+// pointerEscaper escapes a JSON pointer reference token (RFC 6901).
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Pointer returns Field as a JSON pointer (RFC 6901) into the submitted
+// configuration, e.g. spec.operations[0].path becomes
+// /spec/operations/0/path. Errors about the configuration as a whole point
+// at the document root ("").
+func (e ValidationError) Pointer() string {
+	if e.Field == "config" {
+		return ""
+	}
+	segments := strings.FieldsFunc(e.Field, func(r rune) bool {
+		return r == '.' || r == '[' || r == ']'
+	})
+	var b strings.Builder
+	for _, segment := range segments {
+		b.WriteByte('/')
+		b.WriteString(pointerEscaper.Replace(segment))
+	}
+	return b.String()
+}
+
 // Validator is an interface for validating configurations
 // This allows for different validation strategies (API, LLM, MCP, etc.)
 // Each validator implementation handles different configuration types using type switching
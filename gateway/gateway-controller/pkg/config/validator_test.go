@@ -27,6 +27,24 @@ import (
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 )
 
+func TestValidationError_Pointer(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"config", ""},
+		{"kind", "/kind"},
+		{"spec.context", "/spec/context"},
+		{"spec.operations[0].path", "/spec/operations/0/path"},
+		{"spec.upstreamDefinitions[1].upstreams[0].url", "/spec/upstreamDefinitions/1/upstreams/0/url"},
+		{"spec.operations[2].policies[0].params.rules.1.path", "/spec/operations/2/policies/0/params/rules/1/path"},
+		{"spec.policies[0].params.a/b~c", "/spec/policies/0/params/a~1b~0c"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ValidationError{Field: tt.field}.Pointer(), tt.field)
+	}
+}
+
 func TestValidator_URLFriendlyName(t *testing.T) {
 	validator := NewAPIValidator()
 
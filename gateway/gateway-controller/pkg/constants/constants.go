@@ -91,16 +91,26 @@ const (
 	TLSVersionOrderTLS13 = 3
 
 	// External Processor (ext_proc) Filter
-	ExtProcFilterName                = "api_platform.policy_engine.envoy.filters.http.ext_proc"
-	ExtProcConfigType                = "type.googleapis.com/envoy.extensions.filters.http.ext_proc.v3.ExternalProcessor"
-	ExtProcMetadataNamespace         = ExtProcFilterName
-	ExtProcRouteCacheActionDefault   = "DEFAULT"
-	ExtProcRouteCacheActionRetain    = "RETAIN"
-	ExtProcRouteCacheActionClear     = "CLEAR"
-	ExtProcHeaderModeDefault         = "DEFAULT"
-	ExtProcHeaderModeSend            = "SEND"
-	ExtProcHeaderModeSkip            = "SKIP"
-	ExtProcRequestAttributeRouteName = "xds.route_name"
+	ExtProcFilterName                    = "api_platform.policy_engine.envoy.filters.http.ext_proc"
+	ExtProcConfigType                    = "type.googleapis.com/envoy.extensions.filters.http.ext_proc.v3.ExternalProcessor"
+	ExtProcMetadataNamespace             = ExtProcFilterName
+	ExtProcRouteCacheActionDefault       = "DEFAULT"
+	ExtProcRouteCacheActionRetain        = "RETAIN"
+	ExtProcRouteCacheActionClear         = "CLEAR"
+	ExtProcHeaderModeDefault             = "DEFAULT"
+	ExtProcHeaderModeSend                = "SEND"
+	ExtProcHeaderModeSkip                = "SKIP"
+	ExtProcRequestAttributeRouteName     = "xds.route_name"
+	ExtProcRequestAttributeSourceAddress = "source.address"
+
+	// RetryPolicyName is the policy whose backoffBase parameter configures the
+	// route's Envoy retry back-off. The policy itself sets the retry headers.
+	RetryPolicyName = "retry"
+
+	// MaxRouteRetryAttempts caps resilience.retries.attempts.
+	MaxRouteRetryAttempts = 10
+	// DefaultRouteRetryOn is the Envoy retry_on used when resilience.retries omits retryOn.
+	DefaultRouteRetryOn = "connect-failure,refused-stream,gateway-error"
 
 	// Policy Engine
 	PolicyEngineClusterName       = "api-platform/policy-engine"
@@ -131,6 +141,10 @@ const (
 	// Routes can be configured with cluster_header to read this header and select the target cluster
 	TargetUpstreamHeader = "x-target-upstream"
 
+	// SkipControlPlanePushHeader lets a management API caller deploy an API to this
+	// gateway without the controller replicating the change to the control plane
+	SkipControlPlanePushHeader = "X-Skip-ControlPlane-Push"
+
 	// UpstreamDefinitionClusterPrefix is the prefix used for clusters created from upstreamDefinitions
 	// Cluster names follow the format: upstream_<definition_name>
 	UpstreamDefinitionClusterPrefix = "upstream_"
@@ -165,8 +179,9 @@ const (
 		"      value: '%s'\n"
 
 	// API Key constants
-	APIKeyPrefix = "apip_"
-	APIKeyLen    = 32 // Length of the random part of the API key in bytes
+	APIKeyPrefix          = "apip_" // Default prefix for generated API keys
+	APIKeyPrefixMaxLength = 32
+	APIKeyLen             = 32 // Length of the random part of the API key in bytes
 
 	// API Key length constants
 	DefaultMinAPIKeyLength = 36
@@ -177,9 +192,21 @@ const (
 	APIKeyNameMaxLength  = 63
 	DisplayNameMaxLength = 100
 
+	// MaxAPIKeysPerBulkRequest caps the number of keys created by one bulk API key request
+	MaxAPIKeysPerBulkRequest = 500
+
+	// DefaultAPIKeyCreationRateLimitWindow is the default sliding window of the API key creation rate limit
+	DefaultAPIKeyCreationRateLimitWindow = time.Hour
+	// DefaultAPIKeyExpirySweepInterval is how often expired API keys are revoked by default
+	DefaultAPIKeyExpirySweepInterval = 5 * time.Minute
+
 	// HashingAlgorithm constants
 	HashingAlgorithmSHA256 = "sha256"
 
+	// API key issuance modes
+	APIKeyModeStateful = "stateful" // Random keys validated by hash lookup
+	APIKeyModeSigned   = "signed"   // Self-describing keys validated by signature
+
 	// System policy constants
 	ANALYTICS_SYSTEM_POLICY_NAME    = "wso2_apip_sys_analytics"
 	ANALYTICS_SYSTEM_POLICY_VERSION = "v1"
@@ -191,6 +218,10 @@ const (
 	ResilienceDurationPattern = `^\d+(\.\d+)?(ms|s|m|h)$`
 )
 
+// APIKeyLastUsedUpdateInterval is the minimum time between persisted last-used
+// timestamp updates for a single API key, bounding write amplification on hot keys.
+const APIKeyLastUsedUpdateInterval = time.Minute
+
 // DP->CP artifact push timing. The bottom-up (DP->CP) push waits for the local deployment
 // to be reflected in the in-memory store before pushing to the control plane.
 const (
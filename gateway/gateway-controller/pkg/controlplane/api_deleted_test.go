@@ -197,6 +197,10 @@ func (m *mockStorageForDeletion) SaveAPIKey(key *models.APIKey) error {
 	return nil
 }
 
+func (m *mockStorageForDeletion) SaveAPIKeys(keys []*models.APIKey) error {
+	return nil
+}
+
 func (m *mockStorageForDeletion) UpsertAPIKey(key *models.APIKey) error {
 	return nil
 }
@@ -351,6 +355,19 @@ func (m *mockStorageForDeletion) UpdateAPIKey(key *models.APIKey) error {
 	return nil
 }
 
+func (m *mockStorageForDeletion) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+func (m *mockStorageForDeletion) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *mockStorageForDeletion) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	return nil, nil
+}
+func (m *mockStorageForDeletion) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	return false, nil
+}
+
 func (m *mockStorageForDeletion) DeleteAPIKey(apiID string) error {
 	return nil
 }
@@ -374,6 +391,10 @@ func (m *mockStorageForDeletion) GetAPIKeysByAPI(apiID string) ([]*models.APIKey
 	return nil, nil
 }
 
+func (m *mockStorageForDeletion) ListAPIKeysByAPI(apiID string, filter storage.APIKeyListFilter) ([]*models.APIKey, int, error) {
+	return nil, 0, nil
+}
+
 func (m *mockStorageForDeletion) GetAllAPIKeys() ([]*models.APIKey, error) {
 	return nil, nil
 }
@@ -28,6 +28,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
+	"math/rand/v2"
 	"net/http"
 	"strings"
 	"sync"
@@ -43,6 +45,7 @@ import (
 	"github.com/wso2/api-platform/common/webhooksecret"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/lazyresourcexds"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/policyxds"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
@@ -88,6 +91,7 @@ type ConnectionState struct {
 	LastHeartbeat  int64           // Unix timestamp of last pong received (atomic)
 	RetryCount     int             // Consecutive retry attempts
 	NextRetryDelay time.Duration   // Backoff delay for next retry
+	Probing        bool            // Backoff gave up and the client is slow-probing the control plane
 	GatewayID      string          // Gateway UUID from connection.ack
 	ConnectionID   string          // Connection UUID from connection.ack
 	mu             sync.RWMutex    // Protects state transitions
@@ -456,7 +460,7 @@ func (c *Client) Connect() error {
 
 	// Transition to connected state
 	c.setState(Connected)
-	c.state.RetryCount = 0 // Reset retry count on successful connection
+	c.resetBackoff()
 
 	c.logger.Info("Control plane connection established",
 		slog.String("gateway_id", c.state.GatewayID),
@@ -1171,18 +1175,20 @@ func (c *Client) connectionLoop() {
 				c.exitOnPermanentFailure("websocket connect rejected by control plane", err)
 			}
 
-			c.logger.Warn("Connection failed, will retry",
-				slog.Any("error", err),
-				slog.Duration("retry_delay", c.state.NextRetryDelay),
-				slog.Int("retry_count", c.state.RetryCount),
-			)
-
 			c.setState(Reconnecting)
+			metrics.ControlPlaneReconnectionsTotal.Inc()
 			c.state.RetryCount++
 
 			// Calculate next retry delay with exponential backoff
 			c.calculateNextRetryDelay()
 
+			c.logger.Warn("Connection failed, will retry",
+				slog.Any("error", err),
+				slog.Duration("retry_delay", c.state.NextRetryDelay),
+				slog.Int("retry_count", c.state.RetryCount),
+				slog.Bool("probing", c.state.Probing),
+			)
+
 			// Wait before retry
 			select {
 			case <-time.After(c.state.NextRetryDelay):
@@ -1203,6 +1209,7 @@ func (c *Client) connectionLoop() {
 		}
 
 		c.setState(Reconnecting)
+		metrics.ControlPlaneReconnectionsTotal.Inc()
 	}
 }
 
@@ -3348,42 +3355,67 @@ func (c *Client) handleApplicationUpdatedEvent(event map[string]interface{}) {
 	logger.Info("Successfully processed application updated event", slog.Int("mapping_count", len(resolvedMappings)))
 }
 
-// calculateNextRetryDelay calculates the next retry delay with exponential backoff and jitter
+// defaultReconnectMultiplier is used when the configured reconnect multiplier is unset.
+const defaultReconnectMultiplier = 2.0
+
+// calculateNextRetryDelay calculates the next retry delay using exponential backoff with
+// full jitter. Once RetryCount reaches ReconnectFailureThreshold the client stops backing
+// off and probes the control plane every ReconnectProbeInterval instead.
 func (c *Client) calculateNextRetryDelay() {
-	// Exponential backoff with bounded doubling to avoid overflow before capping.
-	baseDelay := c.config.ReconnectInitial
-	retries := c.state.RetryCount
-	if retries < 0 {
-		retries = 0
-	}
-	for i := 0; i < retries; i++ {
-		if baseDelay >= c.config.ReconnectMax {
-			baseDelay = c.config.ReconnectMax
-			break
-		}
-		if baseDelay > c.config.ReconnectMax/2 {
-			baseDelay = c.config.ReconnectMax
-			break
+	threshold := c.config.ReconnectFailureThreshold
+	if threshold > 0 && c.state.RetryCount >= threshold && c.config.ReconnectProbeInterval > 0 {
+		if !c.state.Probing {
+			c.logger.Warn("Control plane still unreachable, switching to slow probing",
+				slog.Int("consecutive_failures", c.state.RetryCount),
+				slog.Duration("probe_interval", c.config.ReconnectProbeInterval),
+			)
 		}
-		baseDelay *= 2
+		c.state.Probing = true
+		c.state.NextRetryDelay = c.config.ReconnectProbeInterval
+		recordBackoffState(c.state.NextRetryDelay, true)
+		return
 	}
 
-	// Cap at maximum
-	if baseDelay > c.config.ReconnectMax {
-		baseDelay = c.config.ReconnectMax
+	// Full jitter: wait a uniformly random duration up to the ceiling so gateways that
+	// lost the control plane together do not reconnect in lockstep. ReconnectInitial is
+	// kept as a floor to avoid tight retry loops.
+	ceiling := c.backoffCeiling(c.state.RetryCount)
+	delay := min(c.config.ReconnectInitial, ceiling)
+	if spread := ceiling - delay; spread > 0 {
+		delay += rand.N(spread + 1)
 	}
+	c.state.NextRetryDelay = delay
+	recordBackoffState(delay, false)
+}
 
-	// Add jitter (±25%)
-	jitter := time.Duration(float64(baseDelay) * 0.25 * (2*float64(time.Now().UnixNano()%100)/100 - 1))
-	c.state.NextRetryDelay = baseDelay + jitter
+// backoffCeiling returns min(ReconnectMax, ReconnectInitial * ReconnectMultiplier^retries).
+func (c *Client) backoffCeiling(retries int) time.Duration {
+	multiplier := c.config.ReconnectMultiplier
+	if multiplier < 1 {
+		multiplier = defaultReconnectMultiplier
+	}
+	if retries < 0 {
+		retries = 0
+	}
 
-	// Ensure it doesn't go negative or exceed max
-	if c.state.NextRetryDelay < 0 {
-		c.state.NextRetryDelay = c.config.ReconnectInitial
+	ceiling := float64(c.config.ReconnectInitial) * math.Pow(multiplier, float64(retries))
+	if math.IsInf(ceiling, 0) || ceiling > float64(c.config.ReconnectMax) {
+		return c.config.ReconnectMax
 	}
-	if c.state.NextRetryDelay > c.config.ReconnectMax {
-		c.state.NextRetryDelay = c.config.ReconnectMax
+	return time.Duration(ceiling)
+}
+
+// resetBackoff clears the retry state after a successful connection.
+func (c *Client) resetBackoff() {
+	if c.state.Probing {
+		c.logger.Info("Control plane reachable again, leaving slow probing",
+			slog.Int("consecutive_failures", c.state.RetryCount),
+		)
 	}
+	c.state.RetryCount = 0
+	c.state.Probing = false
+	c.state.NextRetryDelay = c.config.ReconnectInitial
+	recordBackoffState(0, false)
 }
 
 // handleSubscriptionCreatedEvent processes subscription.created events from platform-api.
@@ -3692,6 +3724,41 @@ func (c *Client) setStateNoLock(newState State) {
 			slog.String("to", newState.String()),
 		)
 	}
+	recordConnectionState(newState)
+}
+
+// recordConnectionState sets the connection state gauge to 1 for the current
+// state and 0 for every other state.
+func recordConnectionState(current State) {
+	for _, s := range []State{Disconnected, Connecting, Connected, Reconnecting} {
+		value := 0.0
+		if s == current {
+			value = 1
+		}
+		metrics.ControlPlaneConnectionState.WithLabelValues(s.String()).Set(value)
+	}
+}
+
+// recordBackoffState publishes the delay before the next reconnection attempt and
+// whether the client has fallen back to slow probing.
+func recordBackoffState(delay time.Duration, probing bool) {
+	metrics.ControlPlaneReconnectDelaySeconds.Set(delay.Seconds())
+	probingValue := 0.0
+	if probing {
+		probingValue = 1
+	}
+	metrics.ControlPlaneReconnectProbing.Set(probingValue)
+}
+
+// recordPushMetrics records the final outcome of a DP->CP push and its total
+// duration, including retries.
+func recordPushMetrics(start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metrics.ControlPlanePushTotal.WithLabelValues(result).Inc()
+	metrics.ControlPlanePushDurationSeconds.Observe(time.Since(start).Seconds())
 }
 
 // isShuttingDown checks if the client is shutting down
@@ -3768,6 +3835,8 @@ func (c *Client) pushArtifactWithRetry(apiID string, apiConfig *models.StoredCon
 
 	var cpArtifactID string
 	var lastErr error
+	start := time.Now()
+	defer func() { recordPushMetrics(start, lastErr) }()
 
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		cpArtifactID, lastErr = c.apiUtilsService.PushArtifact(apiID, apiConfig, deploymentID)
@@ -3831,6 +3900,8 @@ func (c *Client) pushArtifactsWithRetry(configs []*models.StoredConfig) (*utils.
 
 	var resp *utils.ImportArtifactsResponse
 	var lastErr error
+	start := time.Now()
+	defer func() { recordPushMetrics(start, lastErr) }()
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		resp, lastErr = c.apiUtilsService.PushArtifacts(configs)
 		if lastErr == nil {
@@ -33,10 +33,15 @@ import (
 	"github.com/wso2/api-platform/common/eventhub"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
 
+func init() {
+	metrics.Init()
+}
+
 type publishedControlPlaneEvent struct {
 	gatewayID string
 	event     eventhub.Event
@@ -438,9 +443,9 @@ func TestClient_calculateNextRetryDelay(t *testing.T) {
 	// Test exponential backoff
 	client.state.RetryCount = 3
 	client.calculateNextRetryDelay()
-	// Should be around 8 seconds (1s * 2^3) ± 25% jitter
-	if client.state.NextRetryDelay < 6*time.Second || client.state.NextRetryDelay > 10*time.Second {
-		t.Errorf("NextRetryDelay = %v, expected around 8s", client.state.NextRetryDelay)
+	// Should be full jitter between the initial delay and 8 seconds (1s * 2^3)
+	if client.state.NextRetryDelay < 1*time.Second || client.state.NextRetryDelay > 8*time.Second {
+		t.Errorf("NextRetryDelay = %v, expected between 1s and 8s", client.state.NextRetryDelay)
 	}
 
 	// Test cap at maximum
@@ -778,35 +783,22 @@ func TestClient_ConnectionLoop_RetryBackoff(t *testing.T) {
 	t.Run("Retry delay increases with retries", func(t *testing.T) {
 		client := createTestClient(t)
 
-		// Set initial state
-		client.state.NextRetryDelay = 2 * time.Second
-		client.state.RetryCount = 0
-
-		delays := []time.Duration{}
+		// The jittered delay is random, so check the ceiling it is drawn from grows
+		previous := time.Duration(0)
 		for i := 0; i < 5; i++ {
-			client.calculateNextRetryDelay()
-			delays = append(delays, client.state.NextRetryDelay)
-			client.state.RetryCount++ // Increment retry count for exponential growth
-		}
-
-		// Due to jitter, we check that delays generally trend upward or hit the max
-		// At least one delay should be larger than the initial
-		foundIncrease := false
-		for _, d := range delays {
-			if d > 2*time.Second {
-				foundIncrease = true
-				break
+			ceiling := client.backoffCeiling(i)
+			if ceiling <= previous {
+				t.Errorf("Ceiling at retry %d did not grow: %v <= %v", i, ceiling, previous)
 			}
-		}
-
-		if !foundIncrease {
-			t.Errorf("Expected at least one delay to be larger than initial 2s, got: %v", delays)
-		}
+			previous = ceiling
 
-		// Verify no delay exceeds max
-		for i, d := range delays {
-			if d > client.config.ReconnectMax {
-				t.Errorf("Delay at index %d exceeded max: %v > %v", i, d, client.config.ReconnectMax)
+			client.state.RetryCount = i
+			client.calculateNextRetryDelay()
+			if client.state.NextRetryDelay > ceiling {
+				t.Errorf("Delay at retry %d exceeded ceiling: %v > %v", i, client.state.NextRetryDelay, ceiling)
+			}
+			if client.state.NextRetryDelay > client.config.ReconnectMax {
+				t.Errorf("Delay at retry %d exceeded max: %v > %v", i, client.state.NextRetryDelay, client.config.ReconnectMax)
 			}
 		}
 	})
@@ -906,6 +898,70 @@ func TestClient_CalculateNextRetryDelay_CappedAtMax(t *testing.T) {
 	})
 }
 
+func TestClient_BackoffCeiling_Multiplier(t *testing.T) {
+	client := createTestClientWithConfig(t, config.ControlPlaneConfig{
+		Host:                "control-plane.example.com",
+		Token:               "test-token",
+		ReconnectInitial:    1 * time.Second,
+		ReconnectMax:        1 * time.Minute,
+		ReconnectMultiplier: 3,
+	})
+
+	tests := []struct {
+		retries int
+		want    time.Duration
+	}{
+		{retries: 0, want: 1 * time.Second},
+		{retries: 1, want: 3 * time.Second},
+		{retries: 2, want: 9 * time.Second},
+		{retries: 3, want: 27 * time.Second},
+		{retries: 4, want: 1 * time.Minute},
+		{retries: 5000, want: 1 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := client.backoffCeiling(tt.retries); got != tt.want {
+			t.Errorf("backoffCeiling(%d) = %v, want %v", tt.retries, got, tt.want)
+		}
+	}
+}
+
+func TestClient_CalculateNextRetryDelay_SlowProbe(t *testing.T) {
+	client := createTestClientWithConfig(t, config.ControlPlaneConfig{
+		Host:                      "control-plane.example.com",
+		Token:                     "test-token",
+		ReconnectInitial:          1 * time.Second,
+		ReconnectMax:              30 * time.Second,
+		ReconnectFailureThreshold: 3,
+		ReconnectProbeInterval:    10 * time.Minute,
+	})
+
+	client.state.RetryCount = 2
+	client.calculateNextRetryDelay()
+	if client.state.Probing {
+		t.Error("Probing should not start before the failure threshold")
+	}
+	if client.state.NextRetryDelay > client.config.ReconnectMax {
+		t.Errorf("NextRetryDelay = %v, should be capped at %v", client.state.NextRetryDelay, client.config.ReconnectMax)
+	}
+
+	client.state.RetryCount = 3
+	client.calculateNextRetryDelay()
+	if !client.state.Probing {
+		t.Error("Probing should start once the failure threshold is reached")
+	}
+	if client.state.NextRetryDelay != 10*time.Minute {
+		t.Errorf("NextRetryDelay = %v, want probe interval 10m", client.state.NextRetryDelay)
+	}
+
+	client.resetBackoff()
+	if client.state.Probing || client.state.RetryCount != 0 {
+		t.Errorf("resetBackoff() left probing=%v retry_count=%d", client.state.Probing, client.state.RetryCount)
+	}
+	if client.state.NextRetryDelay != client.config.ReconnectInitial {
+		t.Errorf("NextRetryDelay = %v after reset, want %v", client.state.NextRetryDelay, client.config.ReconnectInitial)
+	}
+}
+
 // mockPolicyManagerForCP is a simple mock for testing policy removal in control plane client
 type mockPolicyManagerForCP struct {
 	removePolicyErr error
@@ -423,7 +423,7 @@ func (c *Client) processSyncStatusUpdates(deployments []models.ControlPlaneDeplo
 				)
 			}
 		} else {
-			if err := c.store.Update(cfg); err != nil {
+			if err := c.store.UpdateWithCorrelationID(cfg, correlationID); err != nil {
 				c.logger.Error("Failed to update config in memory store during sync",
 					slog.String("artifact_id", dep.ArtifactID),
 					slog.Any("error", err),
@@ -89,7 +89,7 @@ func (l *EventListener) handleAPICreateOrUpdate(event eventhub.Event) {
 	existing, _ := l.store.Get(entityID)
 	if existing != nil {
 		// Update existing config
-		if err := l.store.Update(storedConfig); err != nil {
+		if err := l.store.UpdateWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to update API configuration in memory store",
 				slog.String("api_id", entityID),
 				slog.Any("error", err))
@@ -97,7 +97,7 @@ func (l *EventListener) handleAPICreateOrUpdate(event eventhub.Event) {
 		}
 	} else {
 		// Add new config
-		if err := l.store.Add(storedConfig); err != nil {
+		if err := l.store.AddWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to add API configuration to memory store",
 				slog.String("api_id", entityID),
 				slog.Any("error", err))
@@ -136,7 +136,7 @@ func (l *EventListener) handleAPIDelete(event eventhub.Event) {
 	}
 
 	// Remove from in-memory store
-	if err := l.store.Delete(entityID); err != nil {
+	if err := l.store.DeleteWithCorrelationID(entityID, event.EventID); err != nil {
 		if !storage.IsNotFoundError(err) {
 			l.logger.Error("Failed to delete API from memory store",
 				slog.String("api_id", entityID),
@@ -229,6 +229,11 @@ func (l *EventListener) updatePoliciesForAPI(cfg *models.StoredConfig, correlati
 		return
 	}
 
+	if cfg.Kind == models.KindGrpcApi {
+		// gRPC APIs do not carry policies; their routes bypass the policy engine
+		return
+	}
+
 	if err := l.policyManager.UpsertAPIConfig(cfg); err != nil {
 		l.logger.Error("Failed to upsert runtime config from replica sync",
 			slog.String("api_id", cfg.UUID),
@@ -53,6 +53,41 @@ func TestHandleEvent_APICreate_AddsConfigFromDB(t *testing.T) {
 	assert.Equal(t, cfg.DisplayName, stored.DisplayName)
 }
 
+func TestHandleEvent_APICreate_EmitsChangeToSubscribers(t *testing.T) {
+	store := storage.NewConfigStore()
+	db := setupSQLiteDBForEventListenerTests(t)
+	cfg := testRestStoredConfig("api-feed-id", "feed-api", "Feed API", "v1.0.0", models.StateDeployed)
+	require.NoError(t, db.SaveConfig(cfg))
+
+	sub, err := store.SubscribeChanges()
+	require.NoError(t, err)
+	defer store.UnsubscribeChanges(sub)
+
+	listener := &EventListener{
+		store:  store,
+		db:     db,
+		logger: newTestLogger(),
+	}
+
+	listener.handleEvent(eventhub.Event{
+		EventType: eventhub.EventTypeAPI,
+		Action:    "CREATE",
+		EntityID:  cfg.UUID,
+		EventID:   "corr-api-deploy",
+	})
+
+	select {
+	case change := <-sub.Changes():
+		assert.Equal(t, storage.ChangeResourceAPI, change.Resource)
+		assert.Equal(t, storage.ChangeAdded, change.Action)
+		assert.Equal(t, cfg.UUID, change.ID)
+		assert.Equal(t, "feed-api", change.Name)
+		assert.Equal(t, "corr-api-deploy", change.CorrelationID)
+	default:
+		t.Fatal("deploying an API must emit a change to subscribers")
+	}
+}
+
 func TestHandleEvent_APIUpdate_RefreshesExistingConfigFromDB(t *testing.T) {
 	store := storage.NewConfigStore()
 	db := setupSQLiteDBForEventListenerTests(t)
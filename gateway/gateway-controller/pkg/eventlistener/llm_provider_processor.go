@@ -99,14 +99,14 @@ func (l *EventListener) handleLLMProviderCreateOrUpdate(event eventhub.Event) {
 	// normal in-memory routing, xDS, and policy update pipelines.
 	existing, _ := l.store.Get(entityID)
 	if existing != nil {
-		if err := l.store.Update(storedConfig); err != nil {
+		if err := l.store.UpdateWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to update LLM provider in memory store",
 				slog.String("provider_id", entityID),
 				slog.Any("error", err))
 			return
 		}
 	} else {
-		if err := l.store.Add(storedConfig); err != nil {
+		if err := l.store.AddWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to add LLM provider to memory store",
 				slog.String("provider_id", entityID),
 				slog.Any("error", err))
@@ -169,14 +169,14 @@ func (l *EventListener) handleLLMProxyCreateOrUpdate(event eventhub.Event) {
 
 	existing, _ := l.store.Get(entityID)
 	if existing != nil {
-		if err := l.store.Update(storedConfig); err != nil {
+		if err := l.store.UpdateWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to update LLM proxy in memory store",
 				slog.String("proxy_id", entityID),
 				slog.Any("error", err))
 			return
 		}
 	} else {
-		if err := l.store.Add(storedConfig); err != nil {
+		if err := l.store.AddWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to add LLM proxy to memory store",
 				slog.String("proxy_id", entityID),
 				slog.Any("error", err))
@@ -207,7 +207,7 @@ func (l *EventListener) handleLLMProviderDelete(event eventhub.Event) {
 		return
 	}
 
-	if err := l.store.Delete(entityID); err != nil && !storage.IsNotFoundError(err) {
+	if err := l.store.DeleteWithCorrelationID(entityID, event.EventID); err != nil && !storage.IsNotFoundError(err) {
 		l.logger.Error("Failed to delete LLM provider from memory store",
 			slog.String("provider_id", entityID),
 			slog.Any("error", err))
@@ -273,7 +273,7 @@ func (l *EventListener) handleLLMProxyDelete(event eventhub.Event) {
 		return
 	}
 
-	if err := l.store.Delete(entityID); err != nil && !storage.IsNotFoundError(err) {
+	if err := l.store.DeleteWithCorrelationID(entityID, event.EventID); err != nil && !storage.IsNotFoundError(err) {
 		l.logger.Error("Failed to delete LLM proxy from memory store",
 			slog.String("proxy_id", entityID),
 			slog.Any("error", err))
@@ -80,14 +80,14 @@ func (l *EventListener) handleMCPProxyCreateOrUpdate(event eventhub.Event) {
 
 	existing, _ := l.store.Get(entityID)
 	if existing != nil {
-		if err := l.store.Update(storedConfig); err != nil {
+		if err := l.store.UpdateWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to update MCP proxy in memory store",
 				slog.String("proxy_id", entityID),
 				slog.Any("error", err))
 			return
 		}
 	} else {
-		if err := l.store.Add(storedConfig); err != nil {
+		if err := l.store.AddWithCorrelationID(storedConfig, event.EventID); err != nil {
 			l.logger.Error("Failed to add MCP proxy to memory store",
 				slog.String("proxy_id", entityID),
 				slog.Any("error", err))
@@ -112,7 +112,7 @@ func (l *EventListener) handleMCPProxyDelete(event eventhub.Event) {
 
 	existingConfig, _ := l.store.Get(entityID)
 
-	if err := l.store.Delete(entityID); err != nil && !storage.IsNotFoundError(err) {
+	if err := l.store.DeleteWithCorrelationID(entityID, event.EventID); err != nil && !storage.IsNotFoundError(err) {
 		l.logger.Error("Failed to delete MCP proxy from memory store",
 			slog.String("proxy_id", entityID),
 			slog.Any("error", err))
@@ -130,7 +130,7 @@ func (g *ImmutableGW) LoadArtifacts(log *slog.Logger) error {
 			pass1 = append(pass1, a)
 		case models.KindLlmProvider:
 			pass2 = append(pass2, a)
-		case models.KindRestApi, models.KindWebSubApi, models.KindLlmProxy, models.KindMcp:
+		case models.KindRestApi, models.KindWebSubApi, models.KindGrpcApi, models.KindLlmProxy, models.KindMcp:
 			pass3 = append(pass3, a)
 		default:
 			return fmt.Errorf("artifact %s has unsupported kind %q", path, envelope.Kind)
@@ -168,12 +168,14 @@ func (g *ImmutableGW) applyArtifact(path, kind, contentType string, data []byte,
 		}); err != nil {
 			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
 		}
-	case models.KindRestApi, models.KindWebSubApi:
+	case models.KindRestApi, models.KindWebSubApi, models.KindGrpcApi:
 		if _, err := g.restAPIService.Create(restapi.CreateParams{
 			Body:        data,
 			ContentType: contentType,
 			Kind:        kind,
 			Logger:      log,
+			// The control plane has no gRPC API kind
+			SkipControlPlanePush: kind == models.KindGrpcApi,
 		}); err != nil {
 			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
 		}
@@ -67,6 +67,7 @@ type Gauge interface {
 type GaugeVec interface {
 	WithLabelValues(labels ...string) Gauge
 	With(prometheus.Labels) Gauge
+	DeleteLabelValues(labels ...string) bool
 }
 
 // GaugeFunc wraps prometheus.GaugeFunc for callback-based gauges
@@ -112,8 +113,9 @@ func (noopGauge) Sub(float64) {}
 // noopGaugeVec is a no-operation gauge vector that returns noop gauges
 type noopGaugeVec struct{}
 
-func (noopGaugeVec) WithLabelValues(...string) Gauge { return safeNoopGauge }
-func (noopGaugeVec) With(prometheus.Labels) Gauge    { return safeNoopGauge }
+func (noopGaugeVec) WithLabelValues(...string) Gauge  { return safeNoopGauge }
+func (noopGaugeVec) With(prometheus.Labels) Gauge     { return safeNoopGauge }
+func (noopGaugeVec) DeleteLabelValues(...string) bool { return false }
 
 // safeNoopGaugeFunc returns a singleton noop GaugeFunc that's safe to use
 func safeNoopGaugeFunc() GaugeFunc {
@@ -122,9 +124,9 @@ func safeNoopGaugeFunc() GaugeFunc {
 
 // Safe singleton instances - these are ALWAYS safe to return from factory functions
 var (
-	safeNoopCounter    Counter    = noopCounter{}
-	safeNoopHistogram  Histogram  = noopHistogram{}
-	safeNoopGauge     Gauge      = noopGauge{}
+	safeNoopCounter   Counter   = noopCounter{}
+	safeNoopHistogram Histogram = noopHistogram{}
+	safeNoopGauge     Gauge     = noopGauge{}
 )
 
 // Wrapper types to adapt prometheus types to our interfaces
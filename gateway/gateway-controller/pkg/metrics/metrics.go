@@ -46,6 +46,7 @@ var (
 	SnapshotSize                      GaugeVec
 	PolicyEngineSnapshotSize          GaugeVec
 	TranslationErrorsTotal            CounterVec
+	XDSSnapshotRollbacksTotal         CounterVec
 	RoutesPerAPI                      Histogram
 
 	XDSClientsConnected      GaugeVec
@@ -62,17 +63,28 @@ var (
 	CertificatesTotal          GaugeVec
 	CertificateOperationsTotal CounterVec
 	CertificateExpirySeconds   GaugeVec
+	CertificateExpiryDays      GaugeVec
+	CertificateRevoked         GaugeVec
 	SDSUpdatesTotal            CounterVec
 
-	PoliciesTotal               GaugeVec
-	PolicyChainLength           HistogramVec
-	PolicySnapshotUpdatesTotal  CounterVec
-	PolicyValidationErrorsTotal CounterVec
+	APIKeysExpiredTotal Counter
+
+	JWKSFetchFailuresTotal Counter
+
+	PoliciesTotal                GaugeVec
+	PolicyChainLength            HistogramVec
+	PolicySnapshotUpdatesTotal   CounterVec
+	PolicyValidationErrorsTotal  CounterVec
+	PolicyDefinitionReloadsTotal CounterVec
 
 	ControlPlaneConnectionState       GaugeVec
 	ControlPlaneReconnectionsTotal    Counter
 	ControlPlaneEventsSentTotal       CounterVec
 	ControlPlaneMessageLatencySeconds Histogram
+	ControlPlanePushTotal             CounterVec
+	ControlPlanePushDurationSeconds   Histogram
+	ControlPlaneReconnectDelaySeconds Gauge
+	ControlPlaneReconnectProbing      Gauge
 
 	HTTPRequestsTotal          CounterVec
 	HTTPRequestDurationSeconds HistogramVec
@@ -192,6 +204,15 @@ func initMetrics() {
 		[]string{"error_type"},
 	)
 
+	XDSSnapshotRollbacksTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "xds_snapshot_rollbacks_total",
+			Help:      "Total number of rejected xDS snapshots where the previous snapshot was kept",
+		},
+		[]string{"reason"},
+	)
+
 	RoutesPerAPI = newHistogram(
 		prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -311,6 +332,24 @@ func initMetrics() {
 		[]string{"cert_id", "cert_name"},
 	)
 
+	CertificateExpiryDays = newGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "certificate_expiry_days",
+			Help:      "Days until the certificate expires (negative once expired)",
+		},
+		[]string{"cert_id", "cert_name"},
+	)
+
+	CertificateRevoked = newGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "certificate_revoked",
+			Help:      "Whether the last OCSP check reported the certificate as revoked (1) or not (0)",
+		},
+		[]string{"cert_id", "cert_name"},
+	)
+
 	SDSUpdatesTotal = newCounterVec(
 		prometheus.CounterOpts{
 			Namespace: namespace,
@@ -320,6 +359,22 @@ func initMetrics() {
 		[]string{"status"},
 	)
 
+	APIKeysExpiredTotal = newCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "api_keys_expired_total",
+			Help:      "Total number of API keys revoked by the expiry sweeper",
+		},
+	)
+
+	JWKSFetchFailuresTotal = newCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "jwks_fetch_failures_total",
+			Help:      "Total number of failed JWKS fetches for management API JWT authentication",
+		},
+	)
+
 	PoliciesTotal = newGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -357,6 +412,15 @@ func initMetrics() {
 		[]string{"error_type"},
 	)
 
+	PolicyDefinitionReloadsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_definition_reloads_total",
+			Help:      "Total number of policy definition hot reloads",
+		},
+		[]string{"status"},
+	)
+
 	ControlPlaneConnectionState = newGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -392,6 +456,40 @@ func initMetrics() {
 		},
 	)
 
+	ControlPlanePushTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "control_plane_push_total",
+			Help:      "Total number of artifact pushes to the control plane",
+		},
+		[]string{"result"},
+	)
+
+	ControlPlanePushDurationSeconds = newHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "control_plane_push_duration_seconds",
+			Help:      "Duration of artifact pushes to the control plane, including retries",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0},
+		},
+	)
+
+	ControlPlaneReconnectDelaySeconds = newGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "control_plane_reconnect_delay_seconds",
+			Help:      "Delay before the next control plane reconnection attempt (0 when connected)",
+		},
+	)
+
+	ControlPlaneReconnectProbing = newGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "control_plane_reconnect_probing",
+			Help:      "Whether control plane reconnection has fallen back to slow probing (1=probing, 0=backoff)",
+		},
+	)
+
 	HTTPRequestsTotal = newCounterVec(
 		prometheus.CounterOpts{
 			Namespace: namespace,
@@ -694,6 +792,7 @@ func initRegistry() {
 	registerGaugeVec(SnapshotSize)
 	registerGaugeVec(PolicyEngineSnapshotSize)
 	registerCounterVec(TranslationErrorsTotal)
+	registerCounterVec(XDSSnapshotRollbacksTotal)
 	registerHistogram(RoutesPerAPI)
 
 	registerGaugeVec(XDSClientsConnected)
@@ -710,17 +809,27 @@ func initRegistry() {
 	registerGaugeVec(CertificatesTotal)
 	registerCounterVec(CertificateOperationsTotal)
 	registerGaugeVec(CertificateExpirySeconds)
+	registerGaugeVec(CertificateExpiryDays)
+	registerGaugeVec(CertificateRevoked)
 	registerCounterVec(SDSUpdatesTotal)
 
+	registerCounter(APIKeysExpiredTotal)
+	registerCounter(JWKSFetchFailuresTotal)
+
 	registerGaugeVec(PoliciesTotal)
 	registerHistogramVec(PolicyChainLength)
 	registerCounterVec(PolicySnapshotUpdatesTotal)
 	registerCounterVec(PolicyValidationErrorsTotal)
+	registerCounterVec(PolicyDefinitionReloadsTotal)
 
 	registerGaugeVec(ControlPlaneConnectionState)
 	registerCounter(ControlPlaneReconnectionsTotal)
 	registerCounterVec(ControlPlaneEventsSentTotal)
 	registerHistogram(ControlPlaneMessageLatencySeconds)
+	registerCounterVec(ControlPlanePushTotal)
+	registerHistogram(ControlPlanePushDurationSeconds)
+	registerGauge(ControlPlaneReconnectDelaySeconds)
+	registerGauge(ControlPlaneReconnectProbing)
 
 	registerCounterVec(HTTPRequestsTotal)
 	registerHistogramVec(HTTPRequestDurationSeconds)
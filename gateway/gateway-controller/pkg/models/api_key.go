@@ -54,6 +54,14 @@ type APIKey struct {
 	// Issuer identifies the developer portal that provisioned this key; nil if not provided
 	Issuer *string `json:"issuer,omitempty" db:"issuer"`
 
+	// Operations is the operation scope of the key: "*", or a JSON array of "METHOD /path"
+	// entries (see apikey.MatchOperation). Empty means the key is not scoped to operations.
+	Operations string `json:"operations,omitempty" db:"operations"`
+
+	// LastUsedAt records when the key was last successfully validated; nil if never used.
+	// Writes are throttled, so the value may lag actual usage by up to a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" db:"last_used_at"`
+
 	// ETag identifies the current state of the API key. Derived deterministically from
 	// (artifact_uuid, name, updated_at) by the control plane. Not persisted — used for
 	// EventHub event correlation only.
@@ -77,3 +85,12 @@ func (ak *APIKey) IsValid() bool {
 func (ak *APIKey) IsExpired() bool {
 	return ak.ExpiresAt != nil && time.Now().After(*ak.ExpiresAt)
 }
+
+// OperationScope returns the operation scope stored and published for the key; keys not
+// scoped to operations have the scope "*".
+func (ak *APIKey) OperationScope() string {
+	if ak.Operations == "" {
+		return "*"
+	}
+	return ak.Operations
+}
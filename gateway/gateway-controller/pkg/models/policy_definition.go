@@ -27,4 +27,5 @@ type PolicyDefinition struct {
 	Parameters       *map[string]interface{} `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 	SystemParameters *map[string]interface{} `json:"systemParameters,omitempty" yaml:"systemParameters,omitempty"`
 	ManagedBy        string                  `json:"managedBy" yaml:"managedBy,omitempty"`
+	DependsOn        []string                `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"` // Policies that must run before this one in a chain
 }
@@ -71,7 +71,15 @@ type Route struct {
 	Vhost           string // "" = default vhost
 	AutoHostRewrite bool
 	Timeout         *RouteTimeout
+	Retry           *RouteRetry // nil leaves retries to the retry policy, if any
 	Upstream        RouteUpstream
+	// UpgradeType is the connection upgrade allowed on the route (e.g. "websocket").
+	// Empty means the route serves plain HTTP only.
+	UpgradeType string
+	// MaxRequestBytes and MaxResponseBytes bound the request and response bodies on the
+	// route, in bytes. Zero means no per-API limit.
+	MaxRequestBytes  int64
+	MaxResponseBytes int64
 	// Order is the operation/rule index from the source API spec. It is used as the
 	// Gateway-API "earlier-rule-wins" tie-break when two routes share the same match
 	// precedence (same path, method, and header-match count). Routes are emitted in
@@ -89,6 +97,14 @@ type RouteTimeout struct {
 	IdleTimeout *time.Duration // route idle timeout -> RouteAction.IdleTimeout
 }
 
+// RouteRetry holds the resolved retries block of a route. The operation-level block
+// replaces the API-level one.
+type RouteRetry struct {
+	Attempts      int
+	PerTryTimeout *time.Duration
+	RetryOn       string // comma-separated Envoy retry_on conditions
+}
+
 // RouteUpstream links a route to its upstream cluster.
 type RouteUpstream struct {
 	ClusterKey       string // key into UpstreamClusters map
@@ -100,6 +116,16 @@ type RouteUpstream struct {
 	// the policy engine as the route's single default upstream field, regardless
 	// of which slot it is.
 	Default *policyenginev1.UpstreamInfo
+
+	// WeightedClusters splits the route's traffic across clusters by weight. When set,
+	// it takes precedence over ClusterKey and cluster_header routing.
+	WeightedClusters []WeightedCluster
+}
+
+// WeightedCluster is one weighted share of a route's traffic.
+type WeightedCluster struct {
+	ClusterKey string // key into UpstreamClusters map
+	Weight     int
 }
 
 // PolicyChain is an ordered list of policies for a route.
@@ -117,11 +143,32 @@ type Policy struct {
 
 // UpstreamCluster represents an Envoy cluster with its endpoints.
 type UpstreamCluster struct {
-	Name           string // upstream definition name; "" for the main/sandbox slot clusters
-	BasePath       string
-	Endpoints      []Endpoint
-	TLS            *UpstreamTLS
-	ConnectTimeout *time.Duration // ConnectTimeout is the per-upstream TCP connect timeout
+	Name             string // upstream definition name; "" for the main/sandbox slot clusters
+	BasePath         string
+	Endpoints        []Endpoint
+	TLS              *UpstreamTLS
+	ConnectTimeout   *time.Duration    // ConnectTimeout is the per-upstream TCP connect timeout
+	HealthCheck      *HealthCheck      // active health check; nil disables it
+	OutlierDetection *OutlierDetection // passive ejection of failing hosts; nil disables it
+}
+
+// OutlierDetection ejects an upstream cluster's hosts after consecutive 5xx responses.
+type OutlierDetection struct {
+	Consecutive5xx     int
+	Interval           time.Duration
+	BaseEjectionTime   time.Duration
+	MaxEjectionPercent int
+}
+
+// HealthCheck is an active HTTP health check of an upstream cluster's hosts.
+type HealthCheck struct {
+	Path               string
+	Host               string // Host header sent with probes
+	Interval           time.Duration
+	Timeout            time.Duration
+	UnhealthyThreshold int
+	HealthyThreshold   int
+	ExpectedStatuses   []int // empty means Envoy's default of 200 only
 }
 
 // Endpoint is a single upstream host:port target.
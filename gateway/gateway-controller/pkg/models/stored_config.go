@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 )
 
@@ -39,6 +40,7 @@ const (
 	KindLlmProxy            ArtifactKind = "LlmProxy"
 	KindLlmProvider         ArtifactKind = "LlmProvider"
 	KindLlmProviderTemplate ArtifactKind = "LlmProviderTemplate"
+	KindGrpcApi             ArtifactKind = "GrpcApi"
 )
 
 // DesiredState represents the intended deployment state of an API configuration.
@@ -135,6 +137,8 @@ func apiVersionOf(cfg any) string {
 		return string(sc.ApiVersion)
 	case api.MCPProxyConfiguration:
 		return string(sc.ApiVersion)
+	case grpcapi.GrpcAPI:
+		return sc.ApiVersion
 	}
 	return ""
 }
@@ -159,6 +163,11 @@ func (c *StoredConfig) GetContext() (string, error) {
 			return strings.ReplaceAll(*sc.Spec.Context, "$version", c.Version), nil
 		}
 		return "", nil
+	case grpcapi.GrpcAPI:
+		if sc.Spec.Context != nil {
+			return strings.ReplaceAll(*sc.Spec.Context, "$version", c.Version), nil
+		}
+		return "", nil
 	}
 	return "", fmt.Errorf("unsupported source configuration type: %T", c.SourceConfiguration)
 }
@@ -176,6 +185,8 @@ func (c *StoredConfig) GetMetadata() *api.Metadata {
 	switch cfg := c.Configuration.(type) {
 	case api.RestAPI:
 		return &cfg.Metadata
+	case grpcapi.GrpcAPI:
+		return &cfg.Metadata
 	}
 	return nil
 }
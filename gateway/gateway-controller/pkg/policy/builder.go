@@ -51,14 +51,22 @@ func RegisterEventGatewayPolicyChainBuilder(fn func(cfg *models.StoredConfig, ro
 // - APIServer handlers (REST API path) - TODO: Refactor this to use the implementation
 // - main.go startup (loading existing configs)
 //
-// Policy execution order: System Policies -> API Level Policies -> Operation Level Policies
-// Each level does not override the previous one; policies are executed in the given order.
+// Policy execution order: System Policies -> Default Policies -> API Level Policies ->
+// Operation Level Policies
+// Each level does not override the previous one; policies are executed in the given order,
+// except that a policy is moved after the policies its definition declares in dependsOn.
+// An operation-level policy with the same name and version as an API-level policy takes
+// the API-level policy's place, so that it runs once per request. Default policies, from
+// the controller's default_policies, give way to the API instead: a default is left out
+// of a route whose API or operation lists a policy of the same name, and of an API that
+// names it in excludedDefaultPolicies.
 func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.RouterConfig, systemConfig *config.Config, policyDefinitions map[string]models.PolicyDefinition) *models.StoredPolicyConfig {
 	// Pre-compute latest version index once for all ResolvePolicyVersion calls in this function.
 	latestVersions := config.BuildLatestVersionIndex(policyDefinitions)
 
 	// Collect API-level policies (validate policy version exists, pass major-only to engine)
 	apiPolicies := make(map[string]policyenginev1.PolicyInstance)
+	apiDependsOn := make(map[string][]string)
 	if cfg.GetPolicies() != nil {
 		for _, p := range *cfg.GetPolicies() {
 			resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, p.Name, p.Version)
@@ -67,6 +75,7 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 				continue
 			}
 			apiPolicies[p.Name] = ConvertAPIPolicyToModel(p, policyv1alpha.LevelAPI, versionutil.MajorVersion(resolved))
+			apiDependsOn[p.Name] = policyDefinitions[p.Name+"|"+resolved].DependsOn
 		}
 	}
 
@@ -75,13 +84,26 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 	switch cfgTyped := cfg.Configuration.(type) {
 	case api.RestAPI:
 		apiData := cfgTyped.Spec
+		defaults := resolveDefaultPolicies(cfg.UUID, apiData, systemConfig, policyDefinitions, latestVersions)
 		for _, op := range apiData.Operations {
 			var finalPolicies []policyenginev1.PolicyInstance
+			dependsOn := make(map[string][]string, len(apiDependsOn))
+			for name, deps := range apiDependsOn {
+				dependsOn[name] = deps
+			}
+
+			// Policy execution order: Default Policies -> API Level Policies -> Operation Level Policies
+			// Start with the default policies the operation does not list itself
+			for _, d := range defaults {
+				if config.ListsPolicy(op.Policies, d.instance.Name) {
+					continue
+				}
+				finalPolicies = append(finalPolicies, d.instance)
+				dependsOn[d.instance.Name] = d.dependsOn
+			}
 
-			// Policy execution order: API Level Policies -> Operation Level Policies
-			// Start with API-level policies
+			// Then API-level policies
 			if apiData.Policies != nil {
-				finalPolicies = make([]policyenginev1.PolicyInstance, 0, len(*apiData.Policies))
 				for _, p := range *apiData.Policies {
 					// Only append if the policy was successfully resolved (exists in apiPolicies map)
 					if v, ok := apiPolicies[p.Name]; ok {
@@ -90,7 +112,9 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 				}
 			}
 
-			// Append operation-level policies (they don't override, just execute after API-level)
+			// Append operation-level policies (they execute after API-level); a policy with
+			// the same name and version as an API-level policy replaces it instead
+			apiLevelCount := len(finalPolicies)
 			if op.Policies != nil && len(*op.Policies) > 0 {
 				for _, opPolicy := range *op.Policies {
 					resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, opPolicy.Name, opPolicy.Version)
@@ -98,10 +122,15 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 						slog.Error("Failed to resolve policy version for operation-level policy", "policy_name", opPolicy.Name, "operation_method", op.Method, "operation_path", op.Path, "error", err)
 						continue
 					}
-					finalPolicies = append(finalPolicies, ConvertAPIPolicyToModel(opPolicy, policyv1alpha.LevelRoute, versionutil.MajorVersion(resolved)))
+					finalPolicies = utils.MergeOperationPolicy(finalPolicies, apiLevelCount,
+						ConvertAPIPolicyToModel(opPolicy, policyv1alpha.LevelRoute, versionutil.MajorVersion(resolved)))
+					dependsOn[opPolicy.Name] = append(dependsOn[opPolicy.Name], policyDefinitions[opPolicy.Name+"|"+resolved].DependsOn...)
 				}
 			}
 
+			// Run each policy after the policies it depends on
+			finalPolicies = orderByDependencies(finalPolicies, dependsOn, string(op.EffectiveMethod()), op.EffectivePath())
+
 			// Determine effective vhosts
 			effectiveMainVHost := routerConfig.VHosts.Main.Default
 			effectiveSandboxVHost := routerConfig.VHosts.Sandbox.Default
@@ -114,7 +143,12 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 				}
 			}
 
-			vhosts := []string{effectiveMainVHost}
+			// A sandbox-only API (empty main upstream) has no main vhost route to attach
+			// a chain to, so only the sandbox vhost is used
+			var vhosts []string
+			if config.UpstreamConfigured(&apiData.Upstream.Main) || !config.UpstreamConfigured(apiData.Upstream.Sandbox) {
+				vhosts = append(vhosts, effectiveMainVHost)
+			}
 			if apiData.Upstream.Sandbox != nil {
 				vhosts = append(vhosts, effectiveSandboxVHost)
 			}
@@ -173,6 +207,28 @@ func DerivePolicyFromAPIConfig(cfg *models.StoredConfig, routerConfig *config.Ro
 	}
 }
 
+// orderByDependencies reorders a chain so that every policy runs after the policies
+// it depends on. Validation rejects APIs whose chains have a dependency cycle; if
+// one is found here anyway, the cycle is logged and positional order is kept.
+func orderByDependencies(policies []policyenginev1.PolicyInstance, dependsOn map[string][]string, method, path string) []policyenginev1.PolicyInstance {
+	names := make([]string, len(policies))
+	for i, p := range policies {
+		names[i] = p.Name
+	}
+	order, err := config.OrderPolicyChain(names, func(name string) []string { return dependsOn[name] })
+	if err != nil {
+		slog.Error("Failed to order policies by dependencies; keeping declared order",
+			"operation_method", method, "operation_path", path, "error", err)
+		return policies
+	}
+
+	ordered := make([]policyenginev1.PolicyInstance, len(policies))
+	for i, idx := range order {
+		ordered[i] = policies[idx]
+	}
+	return ordered
+}
+
 // ConvertAPIPolicyToModel converts generated api.Policy to policyenginev1.PolicyInstance
 func ConvertAPIPolicyToModel(p api.Policy, attachedTo policyv1alpha.Level, resolvedVersion string) policyenginev1.PolicyInstance {
 	paramsMap := make(map[string]interface{})
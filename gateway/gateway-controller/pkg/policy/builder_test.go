@@ -27,6 +27,7 @@ import (
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/xds"
 )
 
 // ptr is a helper to get a pointer to a string literal.
@@ -142,6 +143,55 @@ func TestDerivePolicyFromAPIConfig_SandboxVhosts(t *testing.T) {
 	}
 }
 
+// TestDerivePolicyFromAPIConfig_UpstreamRouteKeys verifies that chains are derived only
+// for the vhosts of configured upstreams, so a sandbox-only API gets no main route.
+func TestDerivePolicyFromAPIConfig_UpstreamRouteKeys(t *testing.T) {
+	mainKey := xds.GenerateRouteName("GET", "/test", "1.0.0", "/hello", "main.local")
+	sandboxKey := xds.GenerateRouteName("GET", "/test", "1.0.0", "/hello", "sandbox.local")
+
+	tests := []struct {
+		name     string
+		main     api.Upstream
+		sandbox  *api.Upstream
+		wantKeys []string
+	}{
+		{
+			name:     "main only",
+			main:     api.Upstream{Url: ptr("http://backend:8080")},
+			wantKeys: []string{mainKey},
+		},
+		{
+			name:     "sandbox only",
+			sandbox:  &api.Upstream{Url: ptr("http://sandbox-backend:8080")},
+			wantKeys: []string{sandboxKey},
+		},
+		{
+			name:     "main and sandbox",
+			main:     api.Upstream{Url: ptr("http://backend:8080")},
+			sandbox:  &api.Upstream{Ref: ptr("my-upstream-def")},
+			wantKeys: []string{mainKey, sandboxKey},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := makeStoredConfig(t, tc.sandbox)
+			restAPI := cfg.Configuration.(api.RestAPI)
+			restAPI.Spec.Upstream.Main = tc.main
+			cfg.Configuration = restAPI
+
+			result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, policyDefs)
+
+			require.NotNil(t, result)
+			var keys []string
+			for _, r := range result.Configuration.Routes {
+				keys = append(keys, r.RouteKey)
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
+
 // TestDerivePolicyFromAPIConfig_EmptyVersionResolvesToLatest verifies that an API-level
 // policy with an empty version string is resolved to the latest available version
 // and included in the policy chain.
@@ -256,3 +306,228 @@ func TestDerivePolicyFromAPIConfig_UnknownPolicySkipped(t *testing.T) {
 	result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, defs)
 	assert.Nil(t, result, "expected nil result when all policies are unresolvable")
 }
+
+// makeDependencyConfig builds a RestApi StoredConfig with the given API-level and
+// operation-level policies on a single operation.
+func makeDependencyConfig(apiPolicies, opPolicies []api.Policy) *models.StoredConfig {
+	apiConfig := api.RestAPI{
+		Kind:     api.RestAPIKindRestApi,
+		Metadata: api.Metadata{Name: "test-api"},
+		Spec: api.APIConfigData{
+			DisplayName: "Test API",
+			Context:     "/test",
+			Version:     "1.0.0",
+			Operations: []api.Operation{{
+				Method:   api.Ptr(api.OperationMethod("GET")),
+				Path:     api.Ptr("/hello"),
+				Policies: &opPolicies,
+			}},
+			Policies: &apiPolicies,
+			Upstream: struct {
+				Main    api.Upstream  `json:"main" yaml:"main"`
+				Sandbox *api.Upstream `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
+			}{Main: api.Upstream{Url: ptr("http://backend:8080")}},
+		},
+	}
+	return &models.StoredConfig{
+		UUID:                "test-api",
+		Kind:                string(api.RestAPIKindRestApi),
+		Configuration:       apiConfig,
+		SourceConfiguration: apiConfig,
+	}
+}
+
+func chainNames(result *models.StoredPolicyConfig) []string {
+	var names []string
+	for _, p := range result.Configuration.Routes[0].Policies {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// TestDerivePolicyFromAPIConfig_OrdersByDependsOn verifies that an API-level policy
+// depending on an operation-level policy is moved after it, while unrelated policies
+// keep their positional order.
+func TestDerivePolicyFromAPIConfig_OrdersByDependsOn(t *testing.T) {
+	defs := map[string]models.PolicyDefinition{
+		"transform|v1.0.0": {Name: "transform", Version: "v1.0.0", DependsOn: []string{"jwt-auth"}},
+		"log|v1.0.0":       {Name: "log", Version: "v1.0.0"},
+		"jwt-auth|v1.0.0":  {Name: "jwt-auth", Version: "v1.0.0"},
+		"cors|v1.0.0":      {Name: "cors", Version: "v1.0.0"},
+	}
+	cfg := makeDependencyConfig(
+		[]api.Policy{{Name: "transform", Version: "v1"}, {Name: "log", Version: "v1"}},
+		[]api.Policy{{Name: "jwt-auth", Version: "v1"}, {Name: "cors", Version: "v1"}},
+	)
+
+	result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, defs)
+
+	require.NotNil(t, result)
+	assert.Equal(t, []string{"log", "jwt-auth", "transform", "cors"}, chainNames(result))
+}
+
+// TestDerivePolicyFromAPIConfig_DependencyCycleKeepsOrder verifies that a chain whose
+// dependencies form a cycle is not reordered.
+func TestDerivePolicyFromAPIConfig_DependencyCycleKeepsOrder(t *testing.T) {
+	defs := map[string]models.PolicyDefinition{
+		"a|v1.0.0": {Name: "a", Version: "v1.0.0", DependsOn: []string{"b"}},
+		"b|v1.0.0": {Name: "b", Version: "v1.0.0", DependsOn: []string{"a"}},
+	}
+	cfg := makeDependencyConfig(
+		[]api.Policy{{Name: "a", Version: "v1"}},
+		[]api.Policy{{Name: "b", Version: "v1"}},
+	)
+
+	result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, defs)
+
+	require.NotNil(t, result)
+	assert.Equal(t, []string{"a", "b"}, chainNames(result))
+}
+
+// TestDerivePolicyFromAPIConfig_MergesSamePolicyAcrossLevels verifies that an operation-level
+// policy with the same name and version as an API-level policy replaces it in place, while
+// one with another version is kept alongside it.
+func TestDerivePolicyFromAPIConfig_MergesSamePolicyAcrossLevels(t *testing.T) {
+	defs := map[string]models.PolicyDefinition{
+		"set-headers|v1.0.0": {Name: "set-headers", Version: "v1.0.0"},
+		"set-headers|v2.0.0": {Name: "set-headers", Version: "v2.0.0"},
+		"log|v1.0.0":         {Name: "log", Version: "v1.0.0"},
+	}
+	opParams := map[string]interface{}{"header": "operation"}
+
+	t.Run("same version", func(t *testing.T) {
+		cfg := makeDependencyConfig(
+			[]api.Policy{{Name: "set-headers", Version: "v1"}, {Name: "log", Version: "v1"}},
+			[]api.Policy{{Name: "set-headers", Version: "v1", Params: &opParams}},
+		)
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"set-headers", "log"}, chainNames(result))
+		assert.Equal(t, "operation", result.Configuration.Routes[0].Policies[0].Parameters["header"])
+	})
+
+	t.Run("conflicting versions", func(t *testing.T) {
+		cfg := makeDependencyConfig(
+			[]api.Policy{{Name: "set-headers", Version: "v1"}},
+			[]api.Policy{{Name: "set-headers", Version: "v2"}},
+		)
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), &config.Config{}, defs)
+
+		require.NotNil(t, result)
+		policies := result.Configuration.Routes[0].Policies
+		require.Len(t, policies, 2)
+		assert.Equal(t, "v1", policies[0].Version)
+		assert.Equal(t, "v2", policies[1].Version)
+	})
+}
+
+// defaultPoliciesConfig returns a controller configuration with the given default policies.
+func defaultPoliciesConfig(defaults ...config.DefaultPolicyConfig) *config.Config {
+	return &config.Config{Controller: config.Controller{DefaultPolicies: defaults}}
+}
+
+// TestDerivePolicyFromAPIConfig_DefaultPolicies verifies that the controller default
+// policies run ahead of the API's own policies, and give way to a policy of the same
+// name listed by the API or an operation, or named in excludedDefaultPolicies.
+func TestDerivePolicyFromAPIConfig_DefaultPolicies(t *testing.T) {
+	defs := map[string]models.PolicyDefinition{
+		"cors|v1.0.0":       {Name: "cors", Version: "v1.0.0"},
+		"log|v1.0.0":        {Name: "log", Version: "v1.0.0"},
+		"rate-limit|v1.0.0": {Name: "rate-limit", Version: "v1.0.0"},
+		"jwt-auth|v1.0.0":   {Name: "jwt-auth", Version: "v1.0.0"},
+	}
+	systemConfig := defaultPoliciesConfig(
+		config.DefaultPolicyConfig{Name: "cors", Version: "v1", Params: map[string]interface{}{"origin": "default"}},
+		config.DefaultPolicyConfig{Name: "log"},
+	)
+	apiParams := map[string]interface{}{"origin": "api"}
+
+	t.Run("inherited", func(t *testing.T) {
+		cfg := makeDependencyConfig(
+			[]api.Policy{{Name: "jwt-auth", Version: "v1"}},
+			[]api.Policy{{Name: "rate-limit", Version: "v1"}},
+		)
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), systemConfig, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"cors", "log", "jwt-auth", "rate-limit"}, chainNames(result))
+		policies := result.Configuration.Routes[0].Policies
+		assert.Equal(t, "default", policies[0].Parameters["origin"])
+		assert.Equal(t, "v1", policies[1].Version, "an empty default version resolves to the latest")
+	})
+
+	t.Run("inherited without API policies", func(t *testing.T) {
+		cfg := makeDependencyConfig(nil, nil)
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), systemConfig, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"cors", "log"}, chainNames(result))
+	})
+
+	t.Run("API policy overrides default", func(t *testing.T) {
+		cfg := makeDependencyConfig(
+			[]api.Policy{{Name: "jwt-auth", Version: "v1"}, {Name: "cors", Version: "v1", Params: &apiParams}},
+			nil,
+		)
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), systemConfig, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"log", "jwt-auth", "cors"}, chainNames(result))
+		assert.Equal(t, "api", result.Configuration.Routes[0].Policies[2].Parameters["origin"])
+	})
+
+	t.Run("operation policy overrides default", func(t *testing.T) {
+		cfg := makeDependencyConfig(nil, []api.Policy{{Name: "cors", Version: "v1", Params: &apiParams}})
+		spec := cfg.Configuration.(api.RestAPI)
+		spec.Spec.Operations = append(spec.Spec.Operations, api.Operation{
+			Method: api.Ptr(api.OperationMethod("POST")),
+			Path:   api.Ptr("/hello"),
+		})
+		cfg.Configuration = spec
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), systemConfig, defs)
+
+		require.NotNil(t, result)
+		require.Len(t, result.Configuration.Routes, 2)
+		chains := map[string][]string{}
+		for _, route := range result.Configuration.Routes {
+			var names []string
+			for _, p := range route.Policies {
+				names = append(names, p.Name)
+			}
+			chains[route.RouteKey] = names
+		}
+		getKey := xds.GenerateRouteName("GET", "/test", "1.0.0", "/hello", "main.local")
+		postKey := xds.GenerateRouteName("POST", "/test", "1.0.0", "/hello", "main.local")
+		assert.Equal(t, []string{"log", "cors"}, chains[getKey])
+		assert.Equal(t, []string{"cors", "log"}, chains[postKey], "other operations keep the default")
+	})
+
+	t.Run("excluded by the API", func(t *testing.T) {
+		cfg := makeDependencyConfig([]api.Policy{{Name: "jwt-auth", Version: "v1"}}, nil)
+		spec := cfg.Configuration.(api.RestAPI)
+		spec.Spec.ExcludedDefaultPolicies = &[]string{"cors"}
+		cfg.Configuration = spec
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), systemConfig, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"log", "jwt-auth"}, chainNames(result))
+	})
+
+	t.Run("unknown default skipped", func(t *testing.T) {
+		cfg := makeDependencyConfig([]api.Policy{{Name: "jwt-auth", Version: "v1"}}, nil)
+		unknown := defaultPoliciesConfig(config.DefaultPolicyConfig{Name: "missing", Version: "v1"})
+
+		result := DerivePolicyFromAPIConfig(cfg, testRouterConfig(), unknown, defs)
+
+		require.NotNil(t, result)
+		assert.Equal(t, []string{"jwt-auth"}, chainNames(result))
+	})
+}
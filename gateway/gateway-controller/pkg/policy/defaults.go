@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"log/slog"
+
+	versionutil "github.com/wso2/api-platform/common/version"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	policyv1alpha "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	policyenginev1 "github.com/wso2/api-platform/sdk/core/policyengine"
+)
+
+// defaultPolicy is a resolved controller default policy.
+type defaultPolicy struct {
+	instance  policyenginev1.PolicyInstance
+	dependsOn []string
+}
+
+// resolveDefaultPolicies resolves the controller default policies that apply
+// to an API. Defaults whose version cannot be resolved are left out.
+func resolveDefaultPolicies(apiID string, apiData api.APIConfigData, systemConfig *config.Config,
+	policyDefinitions map[string]models.PolicyDefinition, latestVersions map[string]string) []defaultPolicy {
+	if systemConfig == nil {
+		return nil
+	}
+
+	var defaults []defaultPolicy
+	for _, p := range systemConfig.Controller.DefaultPoliciesFor(apiData) {
+		resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, p.Name, p.Version)
+		if err != nil {
+			slog.Error("Failed to resolve policy version for default policy", "api_id", apiID, "policy_name", p.Name, "error", err)
+			continue
+		}
+		defaults = append(defaults, defaultPolicy{
+			instance:  ConvertAPIPolicyToModel(p, policyv1alpha.LevelAPI, versionutil.MajorVersion(resolved)),
+			dependsOn: policyDefinitions[p.Name+"|"+resolved].DependsOn,
+		})
+	}
+	return defaults
+}
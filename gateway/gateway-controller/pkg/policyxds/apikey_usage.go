@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policyxds
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/wso2/api-platform/common/apikey"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// APIKeyUsageRecorder persists API key usage reported by policy engines.
+type APIKeyUsageRecorder interface {
+	RecordAPIKeyUsage(artifactUUID, keyUUID string, usedAt time.Time) error
+}
+
+// WithAPIKeyUsageRecorder serves API key usage reports from policy engines on the
+// policy xDS server and hands them to recorder.
+func WithAPIKeyUsageRecorder(recorder APIKeyUsageRecorder) ServerOption {
+	return func(s *Server) {
+		s.apiKeyUsageRecorder = recorder
+	}
+}
+
+// apiKeyUsageService receives the usage policy engines collect while validating API keys.
+type apiKeyUsageService struct {
+	usagepb.UnimplementedAPIKeyUsageServiceServer
+
+	recorder APIKeyUsageRecorder
+	logger   *slog.Logger
+}
+
+// ReportUsage records each key in a usage report. Reports that cannot be fully
+// recorded fail with Unavailable so the engine retries them; recording is
+// idempotent, so keys that were recorded are unaffected by the retry.
+func (s *apiKeyUsageService) ReportUsage(ctx context.Context, report *usagepb.UsageReport) (*emptypb.Empty, error) {
+	keys := report.GetKeys()
+	if len(keys) > apikey.MaxUsageReportKeys {
+		return nil, status.Errorf(codes.InvalidArgument, "API key usage report exceeds %d keys", apikey.MaxUsageReportKeys)
+	}
+
+	failed := 0
+	for _, usage := range keys {
+		if usage.GetApiId() == "" || usage.GetKeyId() == "" || !usage.GetLastUsedAt().IsValid() {
+			continue
+		}
+		usedAt := usage.GetLastUsedAt().AsTime()
+		if err := s.recorder.RecordAPIKeyUsage(usage.GetApiId(), usage.GetKeyId(), usedAt); err != nil {
+			failed++
+			s.logger.Warn("Failed to record API key usage",
+				slog.String("api_id", usage.GetApiId()),
+				slog.String("api_key_id", usage.GetKeyId()),
+				slog.Any("error", err))
+		}
+	}
+	if failed > 0 {
+		return nil, status.Error(codes.Unavailable, "failed to record API key usage")
+	}
+
+	s.logger.Debug("Recorded API key usage report", slog.Int("key_count", len(keys)))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policyxds
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/apikey"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type recordedUsage struct {
+	artifactUUID string
+	keyUUID      string
+	usedAt       time.Time
+}
+
+type fakeUsageRecorder struct {
+	recorded []recordedUsage
+	err      error
+}
+
+func (r *fakeUsageRecorder) RecordAPIKeyUsage(artifactUUID, keyUUID string, usedAt time.Time) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.recorded = append(r.recorded, recordedUsage{artifactUUID: artifactUUID, keyUUID: keyUUID, usedAt: usedAt})
+	return nil
+}
+
+// newUsageClient serves the API key usage service over an in-memory listener.
+func newUsageClient(t *testing.T, recorder APIKeyUsageRecorder) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	usagepb.RegisterAPIKeyUsageServiceServer(grpcServer, &apiKeyUsageService{
+		recorder: recorder,
+		logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func reportUsage(conn *grpc.ClientConn, keys ...*usagepb.KeyUsage) error {
+	_, err := usagepb.NewAPIKeyUsageServiceClient(conn).ReportUsage(context.Background(), &usagepb.UsageReport{Keys: keys})
+	return err
+}
+
+func TestAPIKeyUsageService_ReportUsage(t *testing.T) {
+	recorder := &fakeUsageRecorder{}
+	conn := newUsageClient(t, recorder)
+	usedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err := reportUsage(conn,
+		&usagepb.KeyUsage{ApiId: "api-1", KeyId: "key-1", LastUsedAt: timestamppb.New(usedAt)},
+		&usagepb.KeyUsage{ApiId: "api-1", KeyId: "", LastUsedAt: timestamppb.New(usedAt)},
+		&usagepb.KeyUsage{ApiId: "api-2", KeyId: "key-2"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, []recordedUsage{{artifactUUID: "api-1", keyUUID: "key-1", usedAt: usedAt}}, recorder.recorded)
+}
+
+func TestAPIKeyUsageService_ReportUsageErrors(t *testing.T) {
+	usedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("recorder failure is retryable", func(t *testing.T) {
+		conn := newUsageClient(t, &fakeUsageRecorder{err: errors.New("database is locked")})
+		err := reportUsage(conn, &usagepb.KeyUsage{ApiId: "api-1", KeyId: "key-1", LastUsedAt: timestamppb.New(usedAt)})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.NotContains(t, err.Error(), "database is locked")
+	})
+
+	t.Run("oversized report is rejected", func(t *testing.T) {
+		recorder := &fakeUsageRecorder{}
+		conn := newUsageClient(t, recorder)
+		keys := make([]*usagepb.KeyUsage, apikey.MaxUsageReportKeys+1)
+		for i := range keys {
+			keys[i] = &usagepb.KeyUsage{ApiId: "api-1", KeyId: "key", LastUsedAt: timestamppb.New(usedAt)}
+		}
+		err := reportUsage(conn, keys...)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, recorder.recorded)
+	})
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policyxds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// errCallerUnauthenticated is returned to callers that fail authorization. It is
+// the same for every reason so callers cannot probe why they were refused.
+var errCallerUnauthenticated = status.Error(codes.Unauthenticated, "unauthorized")
+
+// WithClientCA requires policy engines to present a certificate issued by the CA in
+// caFile. It applies to every service on the server, the xDS stream as well as the
+// API key usage reports, and needs WithTLS.
+func WithClientCA(caFile string) ServerOption {
+	return func(s *Server) {
+		s.clientCAFile = caFile
+	}
+}
+
+// serverCredentials builds the server's TLS credentials. When a client CA is set,
+// callers must complete the handshake with a certificate it verifies.
+func (s *Server) serverCredentials() (credentials.TransportCredentials, error) {
+	if s.clientCAFile == "" {
+		return credentials.NewServerTLSFromFile(s.tlsConfig.CertFile, s.tlsConfig.KeyFile)
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.tlsConfig.CertFile, s.tlsConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(s.clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", s.clientCAFile)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// authorizeCaller admits a call when client certificates are not required, or when
+// the caller's certificate was verified against the client CA during the handshake.
+// The handshake already refuses unverified callers; the check keeps every service
+// closed should the transport credentials ever be changed.
+func (s *Server) authorizeCaller(ctx context.Context, method string) error {
+	if s.clientCAFile == "" {
+		return nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if ok {
+		if info, isTLS := p.AuthInfo.(credentials.TLSInfo); isTLS && len(info.State.VerifiedChains) > 0 {
+			return nil
+		}
+	}
+	attrs := []any{slog.String("method", method)}
+	if ok && p.Addr != nil {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+	s.logger.Warn("Rejected policy xDS caller without a verified client certificate", attrs...)
+	return errCallerUnauthenticated
+}
+
+func (s *Server) unaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.authorizeCaller(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) streamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorizeCaller(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
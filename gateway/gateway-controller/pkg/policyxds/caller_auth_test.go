@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policyxds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestServer_AuthorizeCaller(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	addr := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 7), Port: 41000}
+	withPeer := func(authInfo credentials.AuthInfo) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: authInfo})
+	}
+	verified := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}}
+
+	t.Run("no client CA admits any caller", func(t *testing.T) {
+		s := &Server{logger: logger}
+		assert.NoError(t, s.authorizeCaller(context.Background(), "/method"))
+	})
+
+	s := &Server{logger: logger, clientCAFile: "/certs/policy-engine-ca.crt"}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		ok   bool
+	}{
+		{name: "verified client certificate", ctx: withPeer(verified), ok: true},
+		{name: "no peer", ctx: context.Background()},
+		{name: "plaintext connection", ctx: withPeer(nil)},
+		{name: "TLS without client certificate", ctx: withPeer(credentials.TLSInfo{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.authorizeCaller(tt.ctx, "/method")
+			if tt.ok {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			assert.Equal(t, "unauthorized", status.Convert(err).Message())
+		})
+	}
+}
+
+func TestServer_AuthInterceptorGuardsUsageReports(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := &Server{logger: logger, clientCAFile: "/certs/policy-engine-ca.crt"}
+	recorder := &fakeUsageRecorder{}
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.unaryAuthInterceptor),
+		grpc.ChainStreamInterceptor(s.streamAuthInterceptor))
+	usagepb.RegisterAPIKeyUsageServiceServer(grpcServer, &apiKeyUsageService{recorder: recorder, logger: logger})
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	err = reportUsage(conn, &usagepb.KeyUsage{ApiId: "api-1", KeyId: "key-1", LastUsedAt: timestamppb.New(time.Now())})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Empty(t, recorder.recorded)
+}
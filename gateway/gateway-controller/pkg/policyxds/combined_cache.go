@@ -497,6 +497,10 @@ func (c *CombinedCache) handleCombinedResponses(watcherID int64, policyResponseC
 
 // CreateDeltaWatch creates a delta watch for incremental xDS updates
 // Implements cache.ConfigWatcher interface
+//
+// Delta watches cover a single type URL, so the underlying cache replies on the
+// stream's channel directly. LinearCache versions delta resources by content
+// hash, so only resources that changed since the client's last ACK are sent.
 func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscription cache.Subscription, responseChan chan cache.DeltaResponse) (func(), error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -517,7 +521,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 		if deltaWatcher, ok := c.policyCache.(interface {
 			CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 		}); ok {
-			policyCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "policy", responseChan))
+			policyCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 			if err != nil {
 				return nil, fmt.Errorf("create policy delta watch: %w", err)
 			}
@@ -527,7 +531,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 			if deltaWatcher, ok := c.routeConfigCache.(interface {
 				CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 			}); ok {
-				routeConfigCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "routeconfig", responseChan))
+				routeConfigCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 				if err != nil {
 					return nil, fmt.Errorf("create route config delta watch: %w", err)
 				}
@@ -537,7 +541,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 		if deltaWatcher, ok := c.apiKeyCache.(interface {
 			CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 		}); ok {
-			apiKeyCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "apikey", responseChan))
+			apiKeyCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 			if err != nil {
 				return nil, fmt.Errorf("create api key delta watch: %w", err)
 			}
@@ -546,7 +550,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 		if deltaWatcher, ok := c.lazyResourceCache.(interface {
 			CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 		}); ok {
-			lazyResourceCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "lazyresource", responseChan))
+			lazyResourceCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 			if err != nil {
 				return nil, fmt.Errorf("create lazy resource delta watch: %w", err)
 			}
@@ -555,7 +559,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 		if deltaWatcher, ok := c.subscriptionCache.(interface {
 			CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 		}); ok {
-			subscriptionCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "subscription", responseChan))
+			subscriptionCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 			if err != nil {
 				return nil, fmt.Errorf("create subscription delta watch: %w", err)
 			}
@@ -565,7 +569,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 			if deltaWatcher, ok := c.eventChannelCache.(interface {
 				CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 			}); ok {
-				eventChannelCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "eventchannel", responseChan))
+				eventChannelCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 				if err != nil {
 					return nil, fmt.Errorf("create event channel delta watch: %w", err)
 				}
@@ -576,7 +580,7 @@ func (c *CombinedCache) CreateDeltaWatch(request *cache.DeltaRequest, subscripti
 			if deltaWatcher, ok := c.webhookSecretCache.(interface {
 				CreateDeltaWatch(*cache.DeltaRequest, cache.Subscription, chan cache.DeltaResponse) (func(), error)
 			}); ok {
-				webhookSecretCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, c.createDeltaResponseHandler(watcherID, "webhooksecret", responseChan))
+				webhookSecretCancel, err = deltaWatcher.CreateDeltaWatch(request, subscription, responseChan)
 				if err != nil {
 					return nil, fmt.Errorf("create webhook secret delta watch: %w", err)
 				}
@@ -718,33 +722,6 @@ func (c *CombinedCache) Fetch(ctx context.Context, request *cache.Request) (cach
 	return cache.NewTestRawResponse(request, "0", nil), nil
 }
 
-// createDeltaResponseHandler creates a response handler for delta responses
-func (c *CombinedCache) createDeltaResponseHandler(watcherID int64, cacheType string, mainResponseChan chan cache.DeltaResponse) chan cache.DeltaResponse {
-	responseChan := make(chan cache.DeltaResponse, 1)
-
-	go func() {
-		for response := range responseChan {
-			c.logger.Debug("Received delta response from cache",
-				slog.Int64("watcher_id", watcherID),
-				slog.String("cache_type", cacheType))
-
-			// Forward the delta response to the main response channel
-			select {
-			case mainResponseChan <- response:
-				c.logger.Debug("Forwarded delta response from combined cache",
-					slog.Int64("watcher_id", watcherID),
-					slog.String("cache_type", cacheType))
-			default:
-				c.logger.Warn("Failed to forward delta response, channel full",
-					slog.Int64("watcher_id", watcherID),
-					slog.String("cache_type", cacheType))
-			}
-		}
-	}()
-
-	return responseChan
-}
-
 // cancelWatch cancels a watch by ID
 func (c *CombinedCache) cancelWatch(watcherID int64) {
 	c.mu.Lock()
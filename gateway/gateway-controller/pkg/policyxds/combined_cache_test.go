@@ -27,6 +27,7 @@ import (
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
 	"github.com/stretchr/testify/assert"
@@ -686,51 +687,30 @@ func TestCombinedCache_HandleCombinedResponses(t *testing.T) {
 	})
 }
 
-func TestCombinedCache_CreateDeltaResponseHandler(t *testing.T) {
+func TestCombinedCache_ResponseDelivery(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-	t.Run("forwards delta responses", func(t *testing.T) {
-		policyCache := newMockCache()
-		apiKeyCache := newMockCache()
-		lazyResourceCache := newMockCache()
-		subscriptionCache := newMockCache()
-		cc := NewCombinedCache(policyCache, apiKeyCache, lazyResourceCache, subscriptionCache, nil, nil, nil, logger).(*CombinedCache)
+	t.Run("delta responses are delivered on the stream channel", func(t *testing.T) {
+		policyCache := cache.NewLinearCache(PolicyChainTypeURL)
+		resource, err := toAnyResource(map[string]interface{}{"route_key": "r1"}, PolicyChainTypeURL)
+		if err != nil {
+			t.Fatalf("failed to build resource: %v", err)
+		}
+		policyCache.SetResources(map[string]types.Resource{"r1": resource})
 
-		mainResponseChan := make(chan cache.DeltaResponse, 1)
-		responseChan := cc.createDeltaResponseHandler(1, "test", mainResponseChan)
+		cc := NewCombinedCache(policyCache, newMockCache(), newMockCache(), newMockCache(), nil, nil, nil, logger).(*CombinedCache)
 
-		// Send a delta response
-		responseChan <- &cache.RawDeltaResponse{}
+		request := &cache.DeltaRequest{TypeUrl: PolicyChainTypeURL, ResourceNamesSubscribe: []string{"*"}}
+		responseChan := make(chan cache.DeltaResponse, 1)
+		_, err = cc.CreateDeltaWatch(request, newDeltaSubscription(request), responseChan)
+		assert.NoError(t, err)
 
-		// Should receive it in main channel
 		select {
-		case <-mainResponseChan:
-			// Good
+		case resp := <-responseChan:
+			assert.Contains(t, resp.GetReturnedResources(), "r1")
 		case <-time.After(200 * time.Millisecond):
 			t.Fatal("timeout waiting for response")
 		}
-
-		close(responseChan)
-	})
-
-	t.Run("warns on timeout sending delta response", func(t *testing.T) {
-		policyCache := newMockCache()
-		apiKeyCache := newMockCache()
-		lazyResourceCache := newMockCache()
-		subscriptionCache := newMockCache()
-		cc := NewCombinedCache(policyCache, apiKeyCache, lazyResourceCache, subscriptionCache, nil, nil, nil, logger).(*CombinedCache)
-
-		// Create a channel with no buffer to block
-		mainResponseChan := make(chan cache.DeltaResponse)
-		responseChan := cc.createDeltaResponseHandler(1, "test", mainResponseChan)
-
-		// Send a delta response (this should trigger timeout warning)
-		responseChan <- &cache.RawDeltaResponse{}
-
-		// Give it time to timeout
-		time.Sleep(150 * time.Millisecond)
-
-		close(responseChan)
 	})
 
 	t.Run("handles policy response timeout", func(t *testing.T) {
@@ -140,6 +140,12 @@ func (pm *PolicyManager) UpdateEventChannelSnapshot() error {
 	return nil
 }
 
+// GetRouteVersions returns the per-route policy chain hash and the version at
+// which each route last changed.
+func (pm *PolicyManager) GetRouteVersions() map[string]RouteVersion {
+	return pm.snapshotManager.GetRouteVersions()
+}
+
 // GetResourceVersion returns the current resource version used for xDS updates.
 func (pm *PolicyManager) GetResourceVersion() int64 {
 	if pm.runtimeStore != nil {
@@ -19,10 +19,14 @@
 package policyxds
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
@@ -150,6 +154,167 @@ func TestTranslator_TranslateRuntimeConfigs(t *testing.T) {
 	})
 }
 
+func TestSnapshotManager_DeltaPushesOnlyChangedRoute(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	snapshotManager := NewSnapshotManager(logger)
+	runtimeStore := storage.NewRuntimeConfigStore()
+	snapshotManager.SetRuntimeStore(runtimeStore)
+
+	usersKey := "GET|/api/v1/users|localhost"
+	ordersKey := "GET|/api/v1/orders|localhost"
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{
+			Kind:    "RestApi",
+			Handle:  "test-handle",
+			Version: "v1",
+		},
+		Context:             "/api",
+		PolicyChainResolver: "route-key",
+		Routes: map[string]*models.Route{
+			usersKey:  {Method: "GET", Path: "/api/v1/users", Vhost: "localhost"},
+			ordersKey: {Method: "GET", Path: "/api/v1/orders", Vhost: "localhost"},
+		},
+		PolicyChains: map[string]*models.PolicyChain{
+			usersKey:  {Policies: []models.Policy{{Name: "rate-limit", Version: "v1"}}},
+			ordersKey: {Policies: []models.Policy{{Name: "rate-limit", Version: "v1"}}},
+		},
+	}
+	runtimeStore.Set("RestApi:test-handle", rdc)
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+
+	policyCache := snapshotManager.GetPolicyCache()
+	request := &cache.DeltaRequest{TypeUrl: PolicyChainTypeURL, ResourceNamesSubscribe: []string{"*"}}
+	sub := stream.NewDeltaSubscription(request.GetResourceNamesSubscribe(), nil, nil, false)
+
+	// Initial wildcard request returns every route and records the versions the client now holds.
+	responses := make(chan cache.DeltaResponse, 1)
+	if _, err := policyCache.CreateDeltaWatch(request, &sub, responses); err != nil {
+		t.Fatalf("CreateDeltaWatch failed: %v", err)
+	}
+	var initial cache.DeltaResponse
+	select {
+	case initial = <-responses:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for initial delta response")
+	}
+	if got := len(initial.GetReturnedResources()); got != 2 {
+		t.Fatalf("Expected 2 resources in initial response, got %d", got)
+	}
+	sub.SetReturnedResources(initial.GetReturnedResources())
+
+	// Follow-up watch as sent after ACKing the initial response.
+	ack := &cache.DeltaRequest{TypeUrl: PolicyChainTypeURL, ResponseNonce: "1"}
+	responses = make(chan cache.DeltaResponse, 1)
+	cancel, err := policyCache.CreateDeltaWatch(ack, &sub, responses)
+	if err != nil {
+		t.Fatalf("CreateDeltaWatch failed: %v", err)
+	}
+	if cancel == nil {
+		t.Fatal("Expected the watch to stay open until resources change")
+	}
+	defer cancel()
+
+	// Re-applying identical state must not push anything.
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+	select {
+	case <-responses:
+		t.Fatal("Expected no delta response for an unchanged snapshot")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	rdc.PolicyChains[usersKey] = &models.PolicyChain{Policies: []models.Policy{{Name: "rate-limit", Version: "v2"}}}
+	runtimeStore.Set("RestApi:test-handle", rdc)
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+
+	select {
+	case resp := <-responses:
+		out, err := resp.GetDeltaDiscoveryResponse()
+		if err != nil {
+			t.Fatalf("GetDeltaDiscoveryResponse failed: %v", err)
+		}
+		if len(out.Resources) != 1 || out.Resources[0].Name != usersKey {
+			names := make([]string, 0, len(out.Resources))
+			for _, r := range out.Resources {
+				names = append(names, r.Name)
+			}
+			t.Errorf("Expected only %q to be pushed, got %v", usersKey, names)
+		}
+		if len(out.RemovedResources) != 0 {
+			t.Errorf("Expected no removed resources, got %v", out.RemovedResources)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for delta response")
+	}
+}
+
+func TestSnapshotManager_RouteVersions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	snapshotManager := NewSnapshotManager(logger)
+	runtimeStore := storage.NewRuntimeConfigStore()
+	snapshotManager.SetRuntimeStore(runtimeStore)
+
+	usersKey := "GET|/api/v1/users|localhost"
+	ordersKey := "GET|/api/v1/orders|localhost"
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{Kind: "RestApi", Handle: "test-handle", Version: "v1"},
+		Routes: map[string]*models.Route{
+			usersKey:  {Method: "GET", Path: "/api/v1/users", Vhost: "localhost"},
+			ordersKey: {Method: "GET", Path: "/api/v1/orders", Vhost: "localhost"},
+		},
+		PolicyChains: map[string]*models.PolicyChain{
+			usersKey:  {Policies: []models.Policy{{Name: "rate-limit", Version: "v1"}}},
+			ordersKey: {Policies: []models.Policy{{Name: "rate-limit", Version: "v1"}}},
+		},
+	}
+	runtimeStore.Set("RestApi:test-handle", rdc)
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+
+	initial := snapshotManager.GetRouteVersions()
+	if len(initial) != 2 {
+		t.Fatalf("Expected 2 tracked routes, got %d", len(initial))
+	}
+	if initial[usersKey].Hash == "" || initial[usersKey].Version != initial[ordersKey].Version {
+		t.Errorf("Expected hashed routes at the same initial version, got %+v and %+v",
+			initial[usersKey], initial[ordersKey])
+	}
+
+	rdc.PolicyChains[usersKey] = &models.PolicyChain{Policies: []models.Policy{{Name: "rate-limit", Version: "v2"}}}
+	runtimeStore.Set("RestApi:test-handle", rdc)
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+
+	updated := snapshotManager.GetRouteVersions()
+	if updated[ordersKey] != initial[ordersKey] {
+		t.Errorf("Unchanged route should keep its version, was %+v, now %+v", initial[ordersKey], updated[ordersKey])
+	}
+	if updated[usersKey].Hash == initial[usersKey].Hash {
+		t.Error("Changed route should get a new hash")
+	}
+	if updated[usersKey].Version <= initial[usersKey].Version {
+		t.Errorf("Changed route should get a newer version, was %d, now %d",
+			initial[usersKey].Version, updated[usersKey].Version)
+	}
+
+	delete(rdc.Routes, ordersKey)
+	delete(rdc.PolicyChains, ordersKey)
+	runtimeStore.Set("RestApi:test-handle", rdc)
+	if err := snapshotManager.UpdateSnapshot(context.Background()); err != nil {
+		t.Fatalf("UpdateSnapshot failed: %v", err)
+	}
+	if _, ok := snapshotManager.GetRouteVersions()[ordersKey]; ok {
+		t.Error("Removed route should no longer be tracked")
+	}
+}
+
 func TestPolicyManager_UpsertAndDeleteAPIConfig(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
 	snapshotManager := NewSnapshotManager(logger)
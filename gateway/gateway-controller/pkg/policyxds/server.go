@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeyxds"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/lazyresourcexds"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/subscriptionxds"
@@ -35,7 +36,6 @@ import (
 	discoverygrpc "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -59,6 +59,8 @@ type Server struct {
 	webhookSecretSnapshotMgr WebhookSecretCacheProvider
 	port                     int
 	tlsConfig                *TLSConfig
+	clientCAFile             string
+	apiKeyUsageRecorder      APIKeyUsageRecorder
 	onFirstConnect           chan struct{}
 	logger                   *slog.Logger
 }
@@ -119,11 +121,20 @@ func NewServer(snapshotManager *SnapshotManager, apiKeySnapshotMgr *apikeyxds.AP
 			MinTime:             5 * time.Second,
 			PermitWithoutStream: true,
 		}),
+		// Every service on the server, ADS and API key usage reports alike, is
+		// authorized the same way
+		grpc.ChainUnaryInterceptor(s.unaryAuthInterceptor),
+		grpc.ChainStreamInterceptor(s.streamAuthInterceptor),
+	}
+
+	if s.clientCAFile != "" && !s.tlsConfig.Enabled {
+		logger.Error("Client certificate authentication requires TLS on the Policy xDS server")
+		panic("policy xDS server: client CA configured without TLS")
 	}
 
 	// Add TLS credentials if enabled
 	if s.tlsConfig.Enabled {
-		creds, err := credentials.NewServerTLSFromFile(s.tlsConfig.CertFile, s.tlsConfig.KeyFile)
+		creds, err := s.serverCredentials()
 		if err != nil {
 			logger.Error("Failed to load TLS credentials", slog.Any("error", err))
 			panic(err)
@@ -131,7 +142,8 @@ func NewServer(snapshotManager *SnapshotManager, apiKeySnapshotMgr *apikeyxds.AP
 		grpcOpts = append(grpcOpts, grpc.Creds(creds))
 		logger.Info("TLS enabled for Policy xDS server",
 			slog.String("cert_file", s.tlsConfig.CertFile),
-			slog.String("key_file", s.tlsConfig.KeyFile))
+			slog.String("key_file", s.tlsConfig.KeyFile),
+			slog.Bool("client_cert_required", s.clientCAFile != ""))
 	}
 
 	grpcServer := grpc.NewServer(grpcOpts...)
@@ -160,6 +172,14 @@ func NewServer(snapshotManager *SnapshotManager, apiKeySnapshotMgr *apikeyxds.AP
 	// Register ADS (Aggregated Discovery Service) for policy distribution
 	discoverygrpc.RegisterAggregatedDiscoveryServiceServer(grpcServer, xdsServer)
 
+	// Accept API key usage reports from policy engines on the same (TLS) listener
+	if s.apiKeyUsageRecorder != nil {
+		usagepb.RegisterAPIKeyUsageServiceServer(grpcServer, &apiKeyUsageService{
+			recorder: s.apiKeyUsageRecorder,
+			logger:   logger,
+		})
+	}
+
 	s.grpcServer = grpcServer
 	s.xdsServer = xdsServer
 
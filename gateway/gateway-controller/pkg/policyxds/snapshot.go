@@ -20,6 +20,8 @@ package policyxds
 
 import (
 	"context"
+	"crypto/sha3"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -29,6 +31,7 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -62,6 +65,17 @@ type SnapshotManager struct {
 	nodeID            string
 	mu                sync.RWMutex
 	translator        *Translator
+
+	// routeVersions tracks, per route key, the hash of the policy chain
+	// resource last pushed and the snapshot version at which it changed.
+	routeVersions map[string]RouteVersion
+}
+
+// RouteVersion is the policy chain hash of a single route and the snapshot
+// version at which that hash last changed.
+type RouteVersion struct {
+	Hash    string
+	Version int64
 }
 
 // NewSnapshotManager creates a new policy snapshot manager with LinearCaches for custom type URLs.
@@ -86,6 +100,7 @@ func NewSnapshotManager(logger *slog.Logger) *SnapshotManager {
 		logger:            logger,
 		nodeID:            "policy-node",
 		translator:        NewTranslator(logger),
+		routeVersions:     make(map[string]RouteVersion),
 	}
 }
 
@@ -120,6 +135,19 @@ func (sm *SnapshotManager) GetPolicyCache() cache.Cache {
 	return sm.policyCache
 }
 
+// GetRouteVersions returns a copy of the per-route policy chain hashes and the
+// version at which each last changed.
+func (sm *SnapshotManager) GetRouteVersions() map[string]RouteVersion {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	versions := make(map[string]RouteVersion, len(sm.routeVersions))
+	for key, rv := range sm.routeVersions {
+		versions[key] = rv
+	}
+	return versions
+}
+
 // UpdateSnapshot generates new xDS snapshots from all RuntimeDeployConfigs.
 func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context) error {
 	sm.mu.Lock()
@@ -149,7 +177,12 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context) error {
 	for key, res := range policyResources {
 		policyById[key] = res
 	}
-	sm.policyCache.SetResources(policyById)
+	// Only routes whose policy chain hash changed get a new version. The runtime
+	// store version is only advanced by this method, under sm.mu.
+	policyChanged, policyRemoved := sm.trackRouteVersions(policyById, sm.runtimeStore.GetResourceVersion()+1)
+	if len(policyChanged) > 0 || len(policyRemoved) > 0 {
+		sm.policyCache.SetResources(policyById)
+	}
 
 	// Update route config cache
 	routeResources, _ := resourcesMap[RouteConfigTypeURL]
@@ -157,7 +190,16 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context) error {
 	for key, res := range routeResources {
 		routeById[key] = res
 	}
-	sm.routeCache.SetResources(routeById)
+	routeChanged, routeRemoved := diffResources(sm.routeCache.GetResources(), routeById)
+	if len(routeChanged) > 0 || len(routeRemoved) > 0 {
+		sm.routeCache.SetResources(routeById)
+	}
+
+	sm.logger.Debug("Computed policy resource diff",
+		slog.Int("policy_changed", len(policyChanged)),
+		slog.Int("policy_removed", len(policyRemoved)),
+		slog.Int("route_changed", len(routeChanged)),
+		slog.Int("route_removed", len(routeRemoved)))
 
 	// Update event channel config cache from WebSubApi configs
 	if sm.configStore != nil && sm.translator.eventChannelHooks != nil {
@@ -380,6 +422,20 @@ func (t *Translator) createRouteConfigResource(
 		data["default_upstream_cluster"] = route.Upstream.DefaultCluster
 	}
 
+	// Upgraded connections carry no request/response body the policy engine can buffer,
+	// so it skips body phases on routes that allow an upgrade.
+	if route.UpgradeType != "" {
+		data["upgrade_type"] = route.UpgradeType
+	}
+
+	// Per-API body limits, enforced by the policy engine on policies that read bodies.
+	if route.MaxRequestBytes > 0 {
+		data["max_request_bytes"] = route.MaxRequestBytes
+	}
+	if route.MaxResponseBytes > 0 {
+		data["max_response_bytes"] = route.MaxResponseBytes
+	}
+
 	// This route's own compiled-in upstream (whichever slot it belongs to) — a single,
 	// always-present field for the policy engine, regardless of main/sandbox.
 	if route.Upstream.Default != nil {
@@ -418,8 +474,10 @@ func toAnyResource(data map[string]interface{}, typeURL string) (types.Resource,
 		return nil, fmt.Errorf("failed to create struct: %w", err)
 	}
 
-	anyMsg, err := anypb.New(dataStruct)
-	if err != nil {
+	// Marshal deterministically so unchanged configs produce identical bytes;
+	// both diffResources and the delta cache's content hashing rely on this.
+	anyMsg := &anypb.Any{}
+	if err := anypb.MarshalFrom(anyMsg, dataStruct, proto.MarshalOptions{Deterministic: true}); err != nil {
 		return nil, fmt.Errorf("failed to create Any message: %w", err)
 	}
 
@@ -453,3 +511,58 @@ func (a slogAdapter) Warnf(format string, args ...interface{}) {
 func (a slogAdapter) Errorf(format string, args ...interface{}) {
 	a.logger.Error(fmt.Sprintf(format, args...))
 }
+
+// trackRouteVersions hashes each policy chain resource, records version against
+// the routes whose hash changed and forgets routes that are gone. It returns the
+// changed and removed route keys. Must be called with sm.mu held.
+func (sm *SnapshotManager) trackRouteVersions(resources map[string]types.Resource, version int64) (changed, removed []string) {
+	for key, res := range resources {
+		hash, err := hashResource(res)
+		if err != nil {
+			sm.logger.Warn("Failed to hash policy chain resource",
+				slog.String("route_key", key),
+				slog.Any("error", err))
+		}
+		if prev, ok := sm.routeVersions[key]; ok && err == nil && prev.Hash == hash {
+			continue
+		}
+		sm.routeVersions[key] = RouteVersion{Hash: hash, Version: version}
+		changed = append(changed, key)
+	}
+	for key := range sm.routeVersions {
+		if _, ok := resources[key]; !ok {
+			delete(sm.routeVersions, key)
+			removed = append(removed, key)
+		}
+	}
+	return changed, removed
+}
+
+// hashResource returns the hex encoded SHA3-256 hash of the deterministic wire
+// encoding of res.
+func hashResource(res types.Resource) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+	sum := sha3.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// diffResources compares the resources currently held by a cache with the next
+// set and returns the names that were added or modified and the names that were
+// removed. The caches are only updated when this reports a change, so delta
+// clients receive just the affected route keys and no-op updates are not pushed.
+func diffResources(current, next map[string]types.Resource) (changed, removed []string) {
+	for name, res := range next {
+		if prev, ok := current[name]; !ok || !proto.Equal(prev, res) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range current {
+		if _, ok := next[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	return changed, removed
+}
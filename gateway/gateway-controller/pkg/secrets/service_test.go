@@ -25,6 +25,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -190,6 +191,7 @@ func (m *minimalStorage) GetLLMProviderTemplateByHandle(handle string) (*models.
 	return nil, nil
 }
 func (m *minimalStorage) SaveAPIKey(apiKey *models.APIKey) error              { return nil }
+func (m *minimalStorage) SaveAPIKeys(apiKeys []*models.APIKey) error          { return nil }
 func (m *minimalStorage) UpsertAPIKey(apiKey *models.APIKey) error            { return nil }
 func (m *minimalStorage) GetAPIKeyByID(id string) (*models.APIKey, error)     { return nil, nil }
 func (m *minimalStorage) GetAPIKeyByUUID(uuid string) (*models.APIKey, error) { return nil, nil }
@@ -197,6 +199,9 @@ func (m *minimalStorage) GetAPIKeyByKey(key string) (*models.APIKey, error)   {
 func (m *minimalStorage) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) {
 	return nil, nil
 }
+func (m *minimalStorage) ListAPIKeysByAPI(apiId string, filter storage.APIKeyListFilter) ([]*models.APIKey, int, error) {
+	return nil, 0, nil
+}
 func (m *minimalStorage) GetAllAPIKeys() ([]*models.APIKey, error) { return nil, nil }
 func (m *minimalStorage) GetAPIKeysByApplicationUUID(applicationUUID string) ([]*models.APIKey, error) {
 	return nil, nil
@@ -205,6 +210,18 @@ func (m *minimalStorage) GetAPIKeysByAPIAndName(apiId, name string) (*models.API
 	return nil, nil
 }
 func (m *minimalStorage) UpdateAPIKey(apiKey *models.APIKey) error { return nil }
+func (m *minimalStorage) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+func (m *minimalStorage) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *minimalStorage) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	return nil, nil
+}
+func (m *minimalStorage) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	return false, nil
+}
 func (m *minimalStorage) DeleteAPIKey(key string) error            { return nil }
 func (m *minimalStorage) RemoveAPIKeysAPI(apiId string) error      { return nil }
 func (m *minimalStorage) RemoveAPIKeyAPIAndName(apiId, name string) error {
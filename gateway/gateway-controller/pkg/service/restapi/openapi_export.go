@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package restapi
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+// OpenAPI extensions carrying gateway-specific configuration in exported documents
+const (
+	openAPIExtPolicies  = "x-wso2-policies"
+	openAPIExtUpstream  = "x-wso2-upstream"
+	openAPIExtPathMatch = "x-wso2-path-match"
+
+	exportedOpenAPIVersion = "3.0.3"
+)
+
+// ExportOpenAPI renders a REST API as an OpenAPI 3.0 document. It is built from the source
+// configuration, so secret template expressions are exported unresolved.
+func (s *RestAPIService) ExportOpenAPI(handle string) (*openapi3.T, error) {
+	result, err := s.GetByHandle(handle)
+	if err != nil {
+		return nil, err
+	}
+	restAPI, ok := result.Config.SourceConfiguration.(api.RestAPI)
+	if !ok {
+		return nil, fmt.Errorf("RestAPI '%s' has an unexpected configuration type %T", handle, result.Config.SourceConfiguration)
+	}
+	return exportOpenAPI(restAPI), nil
+}
+
+// exportOpenAPI maps the API context to the server URL and each operation to a path item.
+// Operations that differ only in header matchers share a method and path; the first one is
+// exported.
+func exportOpenAPI(restAPI api.RestAPI) *openapi3.T {
+	spec := restAPI.Spec
+	doc := &openapi3.T{
+		OpenAPI: exportedOpenAPIVersion,
+		Info: &openapi3.Info{
+			Title:   spec.DisplayName,
+			Version: spec.Version,
+		},
+		Servers: openapi3.Servers{{
+			URL:         strings.ReplaceAll(spec.Context, "$version", spec.Version),
+			Description: "API context on the gateway",
+		}},
+		Paths:      openapi3.NewPaths(),
+		Extensions: map[string]any{},
+	}
+
+	upstream := map[string]any{"main": exportUpstream(spec.Upstream.Main)}
+	if spec.Upstream.Sandbox != nil {
+		upstream["sandbox"] = exportUpstream(*spec.Upstream.Sandbox)
+	}
+	doc.Extensions[openAPIExtUpstream] = upstream
+	if spec.Policies != nil && len(*spec.Policies) > 0 {
+		doc.Extensions[openAPIExtPolicies] = *spec.Policies
+	}
+
+	for _, op := range spec.Operations {
+		method := strings.ToUpper(op.EffectiveMethod())
+		path := op.EffectivePath()
+		if method == "" || path == "" {
+			continue
+		}
+
+		item := doc.Paths.Value(path)
+		if item == nil {
+			item = &openapi3.PathItem{}
+			doc.Paths.Set(path, item)
+		}
+		if item.GetOperation(method) != nil {
+			continue
+		}
+		item.SetOperation(method, exportOperation(op, path))
+	}
+	return doc
+}
+
+func exportOperation(op api.Operation, path string) *openapi3.Operation {
+	operation := openapi3.NewOperation()
+	operation.Responses = openapi3.NewResponses(
+		openapi3.WithName("default", openapi3.NewResponse().WithDescription("Response from the upstream")))
+
+	for _, match := range importPathParamRegex.FindAllStringSubmatch(path, -1) {
+		operation.AddParameter(openapi3.NewPathParameter(match[1]).WithSchema(openapi3.NewStringSchema()))
+	}
+
+	if op.Policies != nil && len(*op.Policies) > 0 {
+		operation.Extensions = map[string]any{openAPIExtPolicies: *op.Policies}
+	}
+	if matchType := op.EffectivePathMatchType(); matchType == string(api.OperationPathMatchTypePathPrefix) {
+		if operation.Extensions == nil {
+			operation.Extensions = map[string]any{}
+		}
+		operation.Extensions[openAPIExtPathMatch] = matchType
+	}
+	return operation
+}
+
+// exportUpstream reports an upstream by URL, by upstream definition reference or by its
+// weighted targets. Credentials embedded in URLs are dropped.
+func exportUpstream(upstream api.Upstream) map[string]any {
+	out := map[string]any{}
+	if upstream.Url != nil {
+		out["url"] = stripUserinfo(*upstream.Url)
+	}
+	if upstream.Ref != nil {
+		out["ref"] = *upstream.Ref
+	}
+	if upstream.Targets != nil {
+		targets := make([]map[string]any, 0, len(*upstream.Targets))
+		for _, target := range *upstream.Targets {
+			targets = append(targets, map[string]any{"url": stripUserinfo(target.Url), "weight": target.Weight})
+		}
+		out["targets"] = targets
+	}
+	return out
+}
+
+func stripUserinfo(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil && u.User != nil {
+		u.User = nil
+		return u.String()
+	}
+	return rawURL
+}
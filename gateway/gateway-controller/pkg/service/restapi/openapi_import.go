@@ -0,0 +1,412 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+)
+
+// MaxOpenAPIImportSize is the maximum accepted size of an imported OpenAPI document (5MB)
+const MaxOpenAPIImportSize = 5 * 1024 * 1024
+
+// Policies suggested for OpenAPI security schemes
+const (
+	jwtAuthPolicyName   = "jwt-auth"
+	basicAuthPolicyName = "basic-auth"
+	authPolicyVersion   = "v1"
+)
+
+// importOperationMethods lists the HTTP methods a RestAPI operation supports, in output order
+var importOperationMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+var (
+	importVersionRegex    = regexp.MustCompile(`v?\d+(\.\d+)?(\.\d+)?`)
+	importPathParamRegex  = regexp.MustCompile(`\{([^{}]*)\}`)
+	invalidParamCharRegex = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	invalidNameCharRegex  = regexp.MustCompile(`[^a-zA-Z0-9\-_\. ]+`)
+	invalidHandleRunRegex = regexp.MustCompile(`[^a-z0-9.]+`)
+)
+
+// ImportOpenAPIResult holds the RestAPI draft generated from an OpenAPI document.
+type ImportOpenAPIResult struct {
+	API api.RestAPIRequest
+	// Warnings lists parts of the document that were not mapped or need review before deploying
+	Warnings []string
+}
+
+// ImportOpenAPI converts an OpenAPI 3.x document (JSON or YAML) into a RestAPI draft. Documents
+// produced by ExportOpenAPI keep their policies and upstream. The draft is not validated or
+// deployed. External $refs are not followed, so importing never fetches
+// remote content.
+func (s *RestAPIService) ImportOpenAPI(body []byte) (*ImportOpenAPIResult, error) {
+	return importOpenAPI(body)
+}
+
+func importOpenAPI(body []byte) (*ImportOpenAPIResult, error) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = false
+	doc, err := loader.LoadFromData(body)
+	if err != nil {
+		return nil, &ParseError{Cause: err}
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, &ParseError{Cause: fmt.Errorf("unsupported OpenAPI version %q; only OpenAPI 3.x is supported", doc.OpenAPI)}
+	}
+	if err := doc.Validate(context.Background(), openapi3.DisableExamplesValidation()); err != nil {
+		return nil, &ParseError{Cause: err}
+	}
+
+	imp := &openAPIImporter{doc: doc, warned: make(map[string]bool)}
+	draft, err := imp.build()
+	if err != nil {
+		return nil, err
+	}
+	return &ImportOpenAPIResult{API: draft, Warnings: imp.warnings}, nil
+}
+
+// openAPIImporter accumulates warnings while mapping a single document
+type openAPIImporter struct {
+	doc      *openapi3.T
+	warnings []string
+	warned   map[string]bool
+}
+
+func (imp *openAPIImporter) warn(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if imp.warned[msg] {
+		return
+	}
+	imp.warned[msg] = true
+	imp.warnings = append(imp.warnings, msg)
+}
+
+func (imp *openAPIImporter) build() (api.RestAPIRequest, error) {
+	title := strings.TrimSpace(imp.doc.Info.Title)
+	version := imp.version()
+	upstreamURL, basePath := imp.server()
+
+	apiContext := strings.TrimSuffix(basePath, "/")
+	if apiContext == "" {
+		apiContext = "/" + handleSlug(title, "api")
+	}
+
+	spec := api.APIConfigData{
+		DisplayName: imp.displayName(title),
+		Version:     version,
+		Context:     apiContext,
+	}
+	if upstreamURL != "" {
+		spec.Upstream.Main.Url = &upstreamURL
+	}
+
+	apiPolicies, ok := imp.extensionPolicies(imp.doc.Extensions, "the document")
+	if !ok {
+		apiPolicies = imp.securityPolicies(imp.doc.Security, "the API")
+	}
+	if len(apiPolicies) > 0 {
+		spec.Policies = &apiPolicies
+	}
+
+	operations, err := imp.operations(apiPolicies)
+	if err != nil {
+		return api.RestAPIRequest{}, err
+	}
+	spec.Operations = operations
+
+	return api.RestAPIRequest{
+		ApiVersion: api.RestAPIRequestApiVersionGatewayApiPlatformWso2Comv1,
+		Kind:       api.RestAPIRequestKindRestApi,
+		Metadata: api.Metadata{
+			Name: handleSlug(title, "api") + "-" + handleSlug(version, "v1"),
+		},
+		Spec: spec,
+	}, nil
+}
+
+// version returns info.version when it is a version the gateway accepts, otherwise the first
+// version-like part of it
+func (imp *openAPIImporter) version() string {
+	raw := strings.TrimSpace(imp.doc.Info.Version)
+	version := importVersionRegex.FindString(raw)
+	if version == "" {
+		imp.warn("info.version %q is not a semantic version; defaulted to v1.0", raw)
+		return "v1.0"
+	}
+	if version != raw {
+		imp.warn("info.version %q shortened to %q", raw, version)
+	}
+	return version
+}
+
+func (imp *openAPIImporter) displayName(title string) string {
+	name := strings.Join(strings.Fields(invalidNameCharRegex.ReplaceAllString(title, " ")), " ")
+	if len(name) > 100 {
+		name = strings.TrimSpace(name[:100])
+	}
+	if name == "" {
+		name = "Imported API"
+	}
+	if name != title {
+		imp.warn("info.title %q adjusted to display name %q", title, name)
+	}
+	return name
+}
+
+// server returns the upstream URL and base path of the first server, substituting variable
+// defaults. An upstream exported in x-wso2-upstream takes precedence over the server URL.
+func (imp *openAPIImporter) server() (string, string) {
+	upstream := imp.extensionUpstream()
+	missing := func(format string, args ...any) {
+		if upstream == "" {
+			imp.warn(format+"; set spec.upstream.main.url before deploying", args...)
+		}
+	}
+
+	if len(imp.doc.Servers) == 0 || imp.doc.Servers[0] == nil {
+		missing("the document declares no servers")
+		return upstream, ""
+	}
+	if len(imp.doc.Servers) > 1 {
+		imp.warn("only the first of %d servers was used", len(imp.doc.Servers))
+	}
+
+	server := imp.doc.Servers[0]
+	raw := server.URL
+	for name, variable := range server.Variables {
+		if variable != nil {
+			raw = strings.ReplaceAll(raw, "{"+name+"}", variable.Default)
+		}
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		missing("server URL %q could not be parsed", server.URL)
+		return upstream, ""
+	}
+	if upstream != "" {
+		return upstream, u.Path
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		missing("server URL %q is not an absolute http(s) URL", server.URL)
+		return "", u.Path
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), u.Path
+}
+
+// extensionUpstream returns the main upstream URL from x-wso2-upstream, if present
+func (imp *openAPIImporter) extensionUpstream() string {
+	value, ok := imp.doc.Extensions[openAPIExtUpstream]
+	if !ok {
+		return ""
+	}
+	var upstream struct {
+		Main struct {
+			URL string `json:"url"`
+		} `json:"main"`
+	}
+	if err := decodeExtension(value, &upstream); err != nil {
+		imp.warn("%s could not be read: %v", openAPIExtUpstream, err)
+		return ""
+	}
+	u, err := url.Parse(upstream.Main.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return upstream.Main.URL
+}
+
+// extensionPolicies returns the policies exported in x-wso2-policies, if present
+func (imp *openAPIImporter) extensionPolicies(extensions map[string]any, where string) ([]api.Policy, bool) {
+	value, ok := extensions[openAPIExtPolicies]
+	if !ok {
+		return nil, false
+	}
+	var policies []api.Policy
+	if err := decodeExtension(value, &policies); err != nil {
+		imp.warn("%s on %s could not be read: %v", openAPIExtPolicies, where, err)
+		return nil, false
+	}
+	return policies, true
+}
+
+// operations maps every path and method to an operation, in path order
+func (imp *openAPIImporter) operations(apiPolicies []api.Policy) ([]api.Operation, error) {
+	paths := imp.doc.Paths.Map()
+	keys := make([]string, 0, len(paths))
+	for path := range paths {
+		keys = append(keys, path)
+	}
+	sort.Strings(keys)
+
+	var operations []api.Operation
+	for _, rawPath := range keys {
+		item := paths[rawPath]
+		if item == nil {
+			continue
+		}
+		path := imp.operationPath(rawPath)
+		for method, op := range map[string]*openapi3.Operation{"TRACE": item.Trace, "CONNECT": item.Connect} {
+			if op != nil {
+				imp.warn("%s %s skipped; the gateway does not route %s requests", method, rawPath, method)
+			}
+		}
+		for _, method := range importOperationMethods {
+			op := item.GetOperation(method)
+			if op == nil {
+				continue
+			}
+			operationMethod := api.OperationMethod(method)
+			operation := api.Operation{
+				Method: &operationMethod,
+				Path:   stringPtr(path),
+			}
+			if policies := imp.operationPolicies(method, rawPath, op, apiPolicies); len(policies) > 0 {
+				operation.Policies = &policies
+			}
+			operations = append(operations, operation)
+		}
+	}
+	if len(operations) == 0 {
+		return nil, &ParseError{Cause: errors.New("the document defines no operations")}
+	}
+	return operations, nil
+}
+
+// operationPath rewrites path template parameters to the {param} form the gateway accepts
+func (imp *openAPIImporter) operationPath(rawPath string) string {
+	return importPathParamRegex.ReplaceAllStringFunc(rawPath, func(param string) string {
+		name := param[1 : len(param)-1]
+		fixed := invalidParamCharRegex.ReplaceAllString(name, "_")
+		if fixed == "" {
+			fixed = "param"
+		}
+		if fixed != name {
+			imp.warn("path parameter {%s} in %s renamed to {%s}", name, rawPath, fixed)
+		}
+		return "{" + fixed + "}"
+	})
+}
+
+// operationPolicies returns policies for an operation whose security differs from the API's
+func (imp *openAPIImporter) operationPolicies(method, rawPath string, op *openapi3.Operation, apiPolicies []api.Policy) []api.Policy {
+	where := method + " " + rawPath
+	if policies, ok := imp.extensionPolicies(op.Extensions, where); ok {
+		return policies
+	}
+	if op.Security == nil {
+		return nil
+	}
+	if len(*op.Security) == 0 {
+		if len(apiPolicies) > 0 {
+			imp.warn("%s is public in the document but inherits the API authentication policies", where)
+		}
+		return nil
+	}
+	policies := imp.securityPolicies(*op.Security, where)
+	if reflect.DeepEqual(policies, apiPolicies) {
+		return nil
+	}
+	return policies
+}
+
+// securityPolicies maps the first alternative of a security requirement list to authentication
+// policies
+func (imp *openAPIImporter) securityPolicies(requirements openapi3.SecurityRequirements, where string) []api.Policy {
+	if len(requirements) == 0 {
+		return nil
+	}
+	if len(requirements) > 1 {
+		imp.warn("%s accepts %d alternative security requirements; only the first was mapped", where, len(requirements))
+	}
+
+	names := make([]string, 0, len(requirements[0]))
+	for name := range requirements[0] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var policies []api.Policy
+	for _, name := range names {
+		if policy, ok := imp.securityPolicy(name); ok {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+// securityPolicy suggests the authentication policy for a security scheme
+func (imp *openAPIImporter) securityPolicy(name string) (api.Policy, bool) {
+	var scheme *openapi3.SecurityScheme
+	if imp.doc.Components != nil {
+		if ref := imp.doc.Components.SecuritySchemes[name]; ref != nil {
+			scheme = ref.Value
+		}
+	}
+	if scheme == nil {
+		imp.warn("security scheme '%s' is not defined in components.securitySchemes", name)
+		return api.Policy{}, false
+	}
+
+	switch {
+	case scheme.Type == "apiKey" && (scheme.In == "header" || scheme.In == "query"):
+		params := map[string]interface{}{"key": scheme.Name, "in": scheme.In}
+		return api.Policy{Name: constants.API_KEY_AUTH_POLICY_NAME, Version: authPolicyVersion, Params: &params}, true
+	case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "bearer"), scheme.Type == "oauth2", scheme.Type == "openIdConnect":
+		imp.warn("security scheme '%s' mapped to %s; configure its key managers before deploying", name, jwtAuthPolicyName)
+		return api.Policy{Name: jwtAuthPolicyName, Version: authPolicyVersion}, true
+	case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "basic"):
+		imp.warn("security scheme '%s' mapped to %s; configure its credentials before deploying", name, basicAuthPolicyName)
+		return api.Policy{Name: basicAuthPolicyName, Version: authPolicyVersion}, true
+	default:
+		imp.warn("security scheme '%s' (%s) has no matching policy and was skipped", name, scheme.Type)
+		return api.Policy{}, false
+	}
+}
+
+// decodeExtension converts a decoded extension value into out
+func decodeExtension(value any, out any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// handleSlug lowercases s into a handle segment of letters, digits, dots and hyphens
+func handleSlug(s, fallback string) string {
+	slug := strings.Trim(invalidHandleRunRegex.ReplaceAllString(strings.ToLower(s), "-"), "-.")
+	if slug == "" {
+		return fallback
+	}
+	return slug
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package restapi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+const petstoreOpenAPI = `openapi: 3.0.3
+info:
+  title: Swagger Petstore
+  version: 1.0.0
+servers:
+  - url: https://{host}/v1
+    variables:
+      host:
+        default: petstore.example.com
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: A list of pets
+    post:
+      operationId: createPet
+      security:
+        - bearerAuth: []
+      responses:
+        "201":
+          description: Pet created
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: showPetById
+      responses:
+        "200":
+          description: A pet
+    delete:
+      operationId: deletePet
+      responses:
+        "204":
+          description: Pet deleted
+  /health:
+    get:
+      operationId: health
+      security: []
+      responses:
+        "200":
+          description: Healthy
+security:
+  - petstoreKey: []
+components:
+  securitySchemes:
+    petstoreKey:
+      type: apiKey
+      in: header
+      name: X-Petstore-Key
+    bearerAuth:
+      type: http
+      scheme: bearer
+`
+
+func TestImportOpenAPI_Petstore(t *testing.T) {
+	result, err := importOpenAPI([]byte(petstoreOpenAPI))
+	require.NoError(t, err)
+
+	draft := result.API
+	assert.Equal(t, api.RestAPIRequestKindRestApi, draft.Kind)
+	assert.Equal(t, api.RestAPIRequestApiVersionGatewayApiPlatformWso2Comv1, draft.ApiVersion)
+	assert.Equal(t, "swagger-petstore-1.0.0", draft.Metadata.Name)
+	assert.Equal(t, "Swagger Petstore", draft.Spec.DisplayName)
+	assert.Equal(t, "1.0.0", draft.Spec.Version)
+	assert.Equal(t, "/v1", draft.Spec.Context)
+	require.NotNil(t, draft.Spec.Upstream.Main.Url)
+	assert.Equal(t, "https://petstore.example.com/v1", *draft.Spec.Upstream.Main.Url)
+
+	type route struct{ method, path string }
+	var routes []route
+	for _, op := range draft.Spec.Operations {
+		routes = append(routes, route{op.EffectiveMethod(), op.EffectivePath()})
+	}
+	assert.Equal(t, []route{
+		{"GET", "/health"},
+		{"GET", "/pets"},
+		{"POST", "/pets"},
+		{"GET", "/pets/{petId}"},
+		{"DELETE", "/pets/{petId}"},
+	}, routes)
+
+	// The global security requirement becomes an API-level api-key-auth policy
+	require.NotNil(t, draft.Spec.Policies)
+	require.Len(t, *draft.Spec.Policies, 1)
+	apiPolicy := (*draft.Spec.Policies)[0]
+	assert.Equal(t, "api-key-auth", apiPolicy.Name)
+	assert.Equal(t, "v1", apiPolicy.Version)
+	require.NotNil(t, apiPolicy.Params)
+	assert.Equal(t, map[string]interface{}{"key": "X-Petstore-Key", "in": "header"}, *apiPolicy.Params)
+
+	// An operation with its own requirement gets its own policy; the rest inherit the API's
+	createPet := draft.Spec.Operations[2]
+	require.NotNil(t, createPet.Policies)
+	assert.Equal(t, "jwt-auth", (*createPet.Policies)[0].Name)
+	for i, op := range draft.Spec.Operations {
+		if i != 2 {
+			assert.Nil(t, op.Policies, "%s %s", op.EffectiveMethod(), op.EffectivePath())
+		}
+	}
+
+	assert.Contains(t, result.Warnings, "security scheme 'bearerAuth' mapped to jwt-auth; configure its key managers before deploying")
+	assert.Contains(t, result.Warnings, "GET /health is public in the document but inherits the API authentication policies")
+
+	// The draft passes the same validation as a hand-written configuration
+	assert.Empty(t, config.NewAPIValidator().Validate(api.RestAPI{
+		ApiVersion: api.RestAPIApiVersion(draft.ApiVersion),
+		Kind:       api.RestAPIKind(draft.Kind),
+		Metadata:   draft.Metadata,
+		Spec:       draft.Spec,
+	}))
+}
+
+func TestImportOpenAPI_JSONDocument(t *testing.T) {
+	doc := `{
+	  "openapi": "3.1.0",
+	  "info": {"title": "Orders (internal)", "version": "v2 beta"},
+	  "paths": {
+	    "/orders/{order-id}": {
+	      "parameters": [{"name": "order-id", "in": "path", "required": true, "schema": {"type": "string"}}],
+	      "get": {"responses": {"200": {"description": "ok"}}}
+	    }
+	  }
+	}`
+
+	result, err := importOpenAPI([]byte(doc))
+	require.NoError(t, err)
+
+	draft := result.API
+	assert.Equal(t, "Orders internal", draft.Spec.DisplayName)
+	assert.Equal(t, "v2", draft.Spec.Version)
+	assert.Equal(t, "orders-internal-v2", draft.Metadata.Name)
+	// Without servers the context comes from the title and the upstream is left for the user
+	assert.Equal(t, "/orders-internal", draft.Spec.Context)
+	assert.Nil(t, draft.Spec.Upstream.Main.Url)
+	require.Len(t, draft.Spec.Operations, 1)
+	assert.Equal(t, "/orders/{order_id}", draft.Spec.Operations[0].EffectivePath())
+
+	joined := strings.Join(result.Warnings, "\n")
+	assert.Contains(t, joined, "set spec.upstream.main.url")
+	assert.Contains(t, joined, "path parameter {order-id} in /orders/{order-id} renamed to {order_id}")
+	assert.Contains(t, joined, `info.version "v2 beta" shortened to "v2"`)
+}
+
+func TestImportOpenAPI_Rejects(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{name: "not a document", doc: "::not yaml::"},
+		{name: "swagger 2.0", doc: "swagger: \"2.0\"\ninfo:\n  title: Old\n  version: 1.0.0\npaths: {}\n"},
+		{name: "no operations", doc: "openapi: 3.0.3\ninfo:\n  title: Empty\n  version: 1.0.0\npaths: {}\n"},
+		{
+			name: "external reference",
+			doc: "openapi: 3.0.3\ninfo:\n  title: Remote\n  version: 1.0.0\npaths:\n  /pets:\n" +
+				"    $ref: 'https://example.com/pets.yaml'\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := importOpenAPI([]byte(tt.doc))
+			require.Error(t, err)
+			var parseErr *ParseError
+			assert.True(t, errors.As(err, &parseErr))
+		})
+	}
+}
@@ -159,6 +159,8 @@ type CreateParams struct {
 	CorrelationID string
 	Kind          string
 	Logger        *slog.Logger
+	// SkipControlPlanePush deploys the API locally without replicating it to the control plane.
+	SkipControlPlanePush bool
 }
 
 // Create deploys a new REST API configuration.
@@ -183,7 +185,7 @@ func (s *RestAPIService) Create(params CreateParams) (*CreateResult, error) {
 		return nil, err
 	}
 
-	if !result.IsStale {
+	if !result.IsStale && s.shouldPushToControlPlane(params.SkipControlPlanePush, result.StoredConfig.UUID, log) {
 		// Trigger bottom-up sync immediately if connected and control plane type is on-prem
 		if s.controlPlaneClient != nil && s.controlPlaneClient.IsConnected() && s.controlPlaneClient.IsOnPrem() &&
 			s.systemConfig.Controller.ControlPlane.DeploymentSyncEnabled {
@@ -282,6 +284,8 @@ type UpdateParams struct {
 	ContentType   string
 	CorrelationID string
 	Logger        *slog.Logger
+	// SkipControlPlanePush updates the API locally without replicating it to the control plane.
+	SkipControlPlanePush bool
 }
 
 // Update modifies an existing REST API configuration.
@@ -334,6 +338,9 @@ func (s *RestAPIService) Update(params UpdateParams) (*UpdateResult, error) {
 	if err := s.validateArtifactConflicts(models.KindRestApi, existing.UUID, renderedConfig.Spec.DisplayName, renderedConfig.Spec.Version, existing.Handle); err != nil {
 		return nil, err
 	}
+	if err := utils.ValidateRestAPIContextConflict(s.db, s.routerConfig, existing.UUID, renderedConfig); err != nil {
+		return nil, err
+	}
 
 	// Update stored configuration
 	now := time.Now()
@@ -362,20 +369,22 @@ func (s *RestAPIService) Update(params UpdateParams) (*UpdateResult, error) {
 
 	s.publishEvent(eventhub.EventTypeAPI, "UPDATE", existing.UUID, params.CorrelationID, log)
 
-	// Trigger bottom-up sync if enabled and connected
-	if existing.Origin == models.OriginGatewayAPI && s.controlPlaneClient != nil && s.controlPlaneClient.IsConnected() &&
-		s.controlPlaneClient.IsOnPrem() && s.systemConfig.Controller.ControlPlane.DeploymentSyncEnabled {
-		go func() {
-			if err := s.controlPlaneClient.SyncArtifactsToOnPremAPIM(s.controlPlaneClient.GetAPIMConfig()); err != nil {
-				log.Error("Failed to sync API to on-prem APIM", slog.Any("error", err))
-			}
-		}()
-	}
+	if existing.Origin == models.OriginGatewayAPI && s.shouldPushToControlPlane(params.SkipControlPlanePush, existing.UUID, log) {
+		// Trigger bottom-up sync if enabled and connected
+		if s.controlPlaneClient != nil && s.controlPlaneClient.IsConnected() &&
+			s.controlPlaneClient.IsOnPrem() && s.systemConfig.Controller.ControlPlane.DeploymentSyncEnabled {
+			go func() {
+				if err := s.controlPlaneClient.SyncArtifactsToOnPremAPIM(s.controlPlaneClient.GetAPIMConfig()); err != nil {
+					log.Error("Failed to sync API to on-prem APIM", slog.Any("error", err))
+				}
+			}()
+		}
 
-	// Push to control plane asynchronously if connected
-	if existing.Origin == models.OriginGatewayAPI && s.controlPlaneClient != nil &&
-		s.controlPlaneClient.IsConnected() && s.systemConfig.Controller.ControlPlane.DeploymentSyncEnabled {
-		go s.waitForDeploymentAndPush(existing.UUID, params.CorrelationID, existing.DeployedAt, log)
+		// Push to control plane asynchronously if connected
+		if s.controlPlaneClient != nil && s.controlPlaneClient.IsConnected() &&
+			s.systemConfig.Controller.ControlPlane.DeploymentSyncEnabled {
+			go s.waitForDeploymentAndPush(existing.UUID, params.CorrelationID, existing.DeployedAt, log)
+		}
 	}
 
 	log.Info("API configuration updated",
@@ -488,6 +497,23 @@ func (s *RestAPIService) updatePolicyForConfig(cfg *models.StoredConfig, log *sl
 	}
 }
 
+// shouldPushToControlPlane reports whether a locally created or updated API should be
+// replicated to the control plane. Pushes are skipped when the caller asked for it or
+// when control_plane.push_local_changes is disabled.
+func (s *RestAPIService) shouldPushToControlPlane(skipRequested bool, configID string, log *slog.Logger) bool {
+	if skipRequested {
+		log.Debug("Skipping control plane push as requested by the caller",
+			slog.String("config_id", configID))
+		return false
+	}
+	if !s.systemConfig.Controller.ControlPlane.PushLocalChanges {
+		log.Debug("Skipping control plane push as push_local_changes is disabled",
+			slog.String("config_id", configID))
+		return false
+	}
+	return true
+}
+
 // waitForDeploymentAndPush waits for API deployment to complete and pushes it to the control plane.
 //
 // minDeployedAt is the DeployedAt of the deployment this push was triggered for.
@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/wso2/api-platform/common/netguard"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+// maxProbedUpstreamURLs bounds the probes run for one API, which can list
+// many weighted targets.
+const maxProbedUpstreamURLs = 16
+
+// defaultUpstreamProbeTimeout applies when no probe timeout is configured.
+const defaultUpstreamProbeTimeout = 3 * time.Second
+
+// probeUnreachable is the only reason reported for a failed probe; the cause is
+// logged, so the response does not reveal anything about the controller's network.
+const probeUnreachable = "unreachable"
+
+type upstreamProbeTarget struct {
+	upstream api.UpstreamProbeResultUpstream
+	url      string
+}
+
+// UpstreamProbeEnabled reports whether upstreams are probed when a request
+// does not say.
+func (s *RestAPIService) UpstreamProbeEnabled() bool {
+	return s.systemConfig.Controller.Server.UpstreamProbe.Enabled
+}
+
+// ProbeUpstreams checks whether the main and sandbox upstreams of a deployed
+// REST API accept connections, sending a HEAD request (or a GET when HEAD is
+// not allowed) to every upstream URL. It only reports: an unreachable
+// upstream does not affect the deployment, since air-gapped upstreams may be
+// reachable from the router but not from the controller. Upstreams on
+// loopback, private, link-local or metadata addresses are never probed and
+// are reported as unreachable.
+func (s *RestAPIService) ProbeUpstreams(ctx context.Context, cfg *models.StoredConfig) []api.UpstreamProbeResult {
+	var restAPI *api.RestAPI
+	switch c := cfg.Configuration.(type) {
+	case api.RestAPI:
+		restAPI = &c
+	case *api.RestAPI:
+		restAPI = c
+	}
+	if restAPI == nil {
+		return nil
+	}
+
+	targets := upstreamProbeTargets(restAPI.Spec)
+	timeout := s.systemConfig.Controller.Server.UpstreamProbe.Timeout
+	if timeout <= 0 {
+		timeout = defaultUpstreamProbeTimeout
+	}
+	client := newUpstreamProbeClient(timeout)
+	logger := s.logger.With(slog.String("api_id", cfg.UUID))
+	results := make([]api.UpstreamProbeResult, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = probeUpstream(ctx, client, target, logger)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// upstreamProbeTargets lists the URLs of the main and sandbox upstreams,
+// resolving references to upstream definitions.
+func upstreamProbeTargets(spec api.APIConfigData) []upstreamProbeTarget {
+	var targets []upstreamProbeTarget
+	add := func(upstream api.UpstreamProbeResultUpstream, rawURL string) {
+		if len(targets) < maxProbedUpstreamURLs {
+			targets = append(targets, upstreamProbeTarget{upstream: upstream, url: strings.TrimSpace(rawURL)})
+		}
+	}
+	addUpstream := func(name api.UpstreamProbeResultUpstream, up *api.Upstream) {
+		switch {
+		case up.Url != nil && strings.TrimSpace(*up.Url) != "":
+			add(name, *up.Url)
+		case up.Targets != nil && len(*up.Targets) > 0:
+			for _, t := range *up.Targets {
+				add(name, t.Url)
+			}
+		case up.Ref != nil && spec.UpstreamDefinitions != nil:
+			for _, def := range *spec.UpstreamDefinitions {
+				if def.Name != strings.TrimSpace(*up.Ref) {
+					continue
+				}
+				basePath := ""
+				if def.BasePath != nil {
+					basePath = *def.BasePath
+				}
+				for _, u := range def.Upstreams {
+					add(name, strings.TrimSuffix(strings.TrimSpace(u.Url), "/")+basePath)
+				}
+			}
+		}
+	}
+
+	addUpstream(api.Main, &spec.Upstream.Main)
+	if spec.Upstream.Sandbox != nil {
+		addUpstream(api.Sandbox, spec.Upstream.Sandbox)
+	}
+	return targets
+}
+
+// newUpstreamProbeClient returns a client that connects directly, as the
+// router does, and reports redirects instead of following them. Its dialer
+// refuses denied addresses, so a host that resolves differently when dialed is
+// still not reached.
+func newUpstreamProbeClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         netguard.Dialer(timeout).DialContext,
+			TLSHandshakeTimeout: timeout,
+			DisableKeepAlives:   true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func probeUpstream(ctx context.Context, client *http.Client, target upstreamProbeTarget,
+	logger *slog.Logger) api.UpstreamProbeResult {
+	result := api.UpstreamProbeResult{Upstream: target.upstream}
+
+	u, err := url.Parse(target.url)
+	if err != nil {
+		// The URL may hold credentials; do not echo what could not be parsed.
+		result.Error = stringPtr("invalid URL")
+		return result
+	}
+	// Report the URL without credentials or query parameters, which may hold
+	// secrets.
+	result.Url = (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path}).String()
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result.Error = stringPtr("invalid URL")
+		return result
+	}
+
+	if err := netguard.CheckHost(ctx, u.Hostname()); err != nil {
+		logger.Warn("Upstream not probed",
+			slog.String("upstream", string(target.upstream)), slog.String("url", result.Url),
+			slog.Any("error", err))
+		result.Error = stringPtr(probeUnreachable)
+		return result
+	}
+
+	start := time.Now()
+	statusCode, err := sendProbe(ctx, client, http.MethodHead, u.String())
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = sendProbe(ctx, client, http.MethodGet, u.String())
+	}
+	latency := time.Since(start).Milliseconds()
+	result.LatencyMs = &latency
+
+	if err != nil {
+		logger.Info("Upstream probe failed",
+			slog.String("upstream", string(target.upstream)), slog.String("url", result.Url),
+			slog.String("reason", describeProbeError(err)), slog.Any("error", err))
+		result.Error = stringPtr(probeUnreachable)
+		return result
+	}
+	result.Reachable = true
+	result.StatusCode = &statusCode
+	return result
+}
+
+func sendProbe(ctx context.Context, client *http.Client, method, target string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	// Only the status is of interest; closing without reading the body drops
+	// the connection.
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// describeProbeError classifies a failed probe for the controller log. An
+// upstream whose certificate the controller does not trust still accepted the
+// connection, and the router may trust it.
+func describeProbeError(err error) string {
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	var recordErr tls.RecordHeaderError
+
+	switch {
+	case errors.As(err, &certErr), errors.As(err, &unknownAuthority),
+		errors.As(err, &hostnameErr), errors.As(err, &invalidCert):
+		return "TLS certificate is not trusted by the gateway controller"
+	case errors.Is(err, netguard.ErrDeniedDestination):
+		return "destination address is not allowed"
+	case errors.As(err, &dnsErr):
+		return "host not found"
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return "connection refused"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "timed out"
+	case errors.As(err, &recordErr):
+		return "TLS handshake failed"
+	}
+	return "connection failed"
+}
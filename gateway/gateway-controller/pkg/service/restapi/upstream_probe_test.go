@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package restapi
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+func TestUpstreamProbeTargets(t *testing.T) {
+	var spec api.APIConfigData
+	spec.Upstream.Main = api.Upstream{Ref: api.Ptr("books-backend")}
+	spec.Upstream.Sandbox = &api.Upstream{Targets: &[]api.UpstreamTarget{
+		{Url: "http://books-v1:8080", Weight: 90},
+		{Url: "http://books-v2:8080", Weight: 10},
+	}}
+	spec.UpstreamDefinitions = &[]api.UpstreamDefinition{{
+		Name:     "books-backend",
+		BasePath: api.Ptr("/api/v2"),
+		Upstreams: []struct {
+			Url    string `json:"url" yaml:"url"`
+			Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+		}{{Url: "http://books:8080/"}},
+	}}
+
+	assert.Equal(t, []upstreamProbeTarget{
+		{upstream: api.Main, url: "http://books:8080/api/v2"},
+		{upstream: api.Sandbox, url: "http://books-v1:8080"},
+		{upstream: api.Sandbox, url: "http://books-v2:8080"},
+	}, upstreamProbeTargets(spec))
+}
+
+func TestProbeUpstream_InvalidURL(t *testing.T) {
+	client := newUpstreamProbeClient(time.Second)
+
+	result := probeUpstream(context.Background(), client, upstreamProbeTarget{upstream: api.Main, url: "ftp://books:21/list?key=secret"}, slog.Default())
+	assert.False(t, result.Reachable)
+	assert.Equal(t, "ftp://books:21/list", result.Url)
+	require.NotNil(t, result.Error)
+	assert.Equal(t, "invalid URL", *result.Error)
+
+	result = probeUpstream(context.Background(), client, upstreamProbeTarget{upstream: api.Main, url: "http://books:%zz"}, slog.Default())
+	assert.False(t, result.Reachable)
+	assert.Empty(t, result.Url, "unparsable URLs are not echoed")
+}
+
+func TestProbeUpstream_DeniedDestinationsAreNotProbed(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	client := newUpstreamProbeClient(time.Second)
+	for name, url := range map[string]string{
+		"loopback":           server.URL,
+		"loopback hostname":  "http://localhost:8080/books",
+		"private":            "http://10.0.0.5:8080/books",
+		"private 172.16/12":  "https://172.20.1.1/books",
+		"private 192.168/16": "http://192.168.1.20/books",
+		"link-local":         "http://169.254.10.20/books",
+		"link-local IPv6":    "http://[fe80::1]:8080/books",
+		"metadata":           "http://169.254.169.254/latest/meta-data/",
+	} {
+		t.Run(name, func(t *testing.T) {
+			result := probeUpstream(context.Background(), client, upstreamProbeTarget{upstream: api.Main, url: url}, slog.Default())
+			assert.False(t, result.Reachable)
+			require.NotNil(t, result.Error)
+			assert.Equal(t, probeUnreachable, *result.Error, "the reason is not reported to the caller")
+			assert.Nil(t, result.StatusCode)
+			assert.Nil(t, result.LatencyMs, "no probe is sent")
+		})
+	}
+	assert.Zero(t, hits.Load())
+}
+
+func TestUpstreamProbeClient_RefusesDeniedAddressesAtDialTime(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	// A host that passed the pre-check but resolves to a denied address when dialed
+	_, err := sendProbe(context.Background(), newUpstreamProbeClient(time.Second), http.MethodHead, server.URL)
+	require.Error(t, err)
+	assert.Equal(t, "destination address is not allowed", describeProbeError(err))
+	assert.Zero(t, hits.Load())
+}
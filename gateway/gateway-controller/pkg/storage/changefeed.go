@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+const (
+	// maxChangeSubscribers bounds the number of concurrent change feed
+	// subscribers, e.g. connected event stream clients.
+	maxChangeSubscribers = 32
+
+	// changeSubscriberBuffer is the number of changes buffered for each
+	// subscriber. Changes that arrive while the buffer is full are dropped.
+	changeSubscriberBuffer = 256
+)
+
+// ErrTooManySubscribers is returned when the change feed already has
+// maxChangeSubscribers subscribers.
+var ErrTooManySubscribers = errors.New("too many change feed subscribers")
+
+// ChangeSubscription receives the changes recorded after it was created.
+// Recording never waits for a subscriber: when its buffer is full the change
+// is dropped and counted, and the subscriber can catch up through
+// ChangesSince.
+type ChangeSubscription struct {
+	changes chan ConfigChange
+	dropped atomic.Int64
+}
+
+// Changes returns the channel the changes are delivered on. It is closed when
+// the subscription is cancelled.
+func (s *ChangeSubscription) Changes() <-chan ConfigChange {
+	return s.changes
+}
+
+// Dropped returns the number of changes dropped because the subscriber did
+// not keep up.
+func (s *ChangeSubscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+func (s *ChangeSubscription) deliver(change ConfigChange) {
+	select {
+	case s.changes <- change:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// SubscribeChanges starts delivering recorded changes to a new subscription.
+// Callers must cancel it with UnsubscribeChanges.
+func (cs *ConfigStore) SubscribeChanges() (*ChangeSubscription, error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	if len(cs.changes.subscribers) >= maxChangeSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+	sub := &ChangeSubscription{changes: make(chan ConfigChange, changeSubscriberBuffer)}
+	cs.changes.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// UnsubscribeChanges stops delivering changes to sub and closes its channel.
+func (cs *ConfigStore) UnsubscribeChanges(sub *ChangeSubscription) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	if _, ok := cs.changes.subscribers[sub]; !ok {
+		return
+	}
+	delete(cs.changes.subscribers, sub)
+	close(sub.changes)
+}
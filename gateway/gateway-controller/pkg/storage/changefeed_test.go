@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigStore_SubscribeChanges(t *testing.T) {
+	cs := NewConfigStore()
+	require.NoError(t, cs.Add(newChangeLogTestConfig("before", "before")))
+
+	sub, err := cs.SubscribeChanges()
+	require.NoError(t, err)
+
+	require.NoError(t, cs.AddWithCorrelationID(newChangeLogTestConfig("id-1", "petstore"), "corr-1"))
+	require.NoError(t, cs.DeleteWithCorrelationID("id-1", "corr-2"))
+
+	added := <-sub.Changes()
+	assert.Equal(t, "id-1", added.ID)
+	assert.Equal(t, ChangeAdded, added.Action)
+	assert.Equal(t, "corr-1", added.CorrelationID)
+	removed := <-sub.Changes()
+	assert.Equal(t, ChangeRemoved, removed.Action)
+	assert.Equal(t, "corr-2", removed.CorrelationID)
+
+	cs.UnsubscribeChanges(sub)
+	_, open := <-sub.Changes()
+	assert.False(t, open, "unsubscribing closes the channel")
+	cs.UnsubscribeChanges(sub)
+}
+
+func TestConfigStore_SlowSubscriberDropsChanges(t *testing.T) {
+	cs := NewConfigStore()
+	slow, err := cs.SubscribeChanges()
+	require.NoError(t, err)
+	defer cs.UnsubscribeChanges(slow)
+	fast, err := cs.SubscribeChanges()
+	require.NoError(t, err)
+	defer cs.UnsubscribeChanges(fast)
+
+	for i := 0; i < changeSubscriberBuffer+3; i++ {
+		cs.RecordChange(ChangeResourceCertificate, "cert", "", ChangeModified, "")
+		if i < changeSubscriberBuffer {
+			<-fast.Changes()
+		}
+	}
+
+	assert.Equal(t, int64(3), slow.Dropped())
+	assert.Len(t, slow.Changes(), changeSubscriberBuffer)
+	assert.Equal(t, int64(1), (<-slow.Changes()).Version, "the oldest changes are kept")
+	assert.Equal(t, int64(0), fast.Dropped())
+	assert.Len(t, fast.Changes(), 3)
+}
+
+func TestConfigStore_SubscribersAreBounded(t *testing.T) {
+	cs := NewConfigStore()
+	var subs []*ChangeSubscription
+	for i := 0; i < maxChangeSubscribers; i++ {
+		sub, err := cs.SubscribeChanges()
+		require.NoError(t, err)
+		subs = append(subs, sub)
+	}
+
+	_, err := cs.SubscribeChanges()
+	assert.ErrorIs(t, err, ErrTooManySubscribers)
+
+	cs.UnsubscribeChanges(subs[0])
+	_, err = cs.SubscribeChanges()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ChangeResource is the kind of resource a ConfigChange refers to.
+type ChangeResource string
+
+const (
+	ChangeResourceAPI         ChangeResource = "api"
+	ChangeResourcePolicy      ChangeResource = "policy"
+	ChangeResourceCertificate ChangeResource = "certificate"
+)
+
+// ChangeAction is how a resource changed.
+type ChangeAction string
+
+const (
+	ChangeAdded    ChangeAction = "added"
+	ChangeModified ChangeAction = "modified"
+	ChangeRemoved  ChangeAction = "removed"
+)
+
+// maxRetainedChanges bounds the change log. Clients that fall further behind
+// must fetch the full config dump again.
+const maxRetainedChanges = 4096
+
+// ErrChangesExpired is returned when the changes since the requested version
+// are no longer retained, or the version was issued before the controller
+// restarted.
+var ErrChangesExpired = errors.New("changes since the requested version are no longer available")
+
+// ConfigChange is one mutation of the gateway configuration.
+type ConfigChange struct {
+	// Version is the resource version the mutation produced.
+	Version   int64
+	Timestamp time.Time
+	Resource  ChangeResource
+	// Kind is the configuration kind of an API (e.g. RestApi).
+	Kind   string
+	ID     string
+	Name   string
+	Action ChangeAction
+	// CorrelationID identifies the request that triggered the mutation, if
+	// known.
+	CorrelationID string
+}
+
+// changeLog records mutations under a monotonic resource version and passes
+// them on to its subscribers. It is not safe for concurrent use; ConfigStore
+// guards it with its lock.
+type changeLog struct {
+	version int64
+	changes []ConfigChange
+	// dropped is the last change evicted from the log, if any.
+	dropped     *ConfigChange
+	subscribers map[*ChangeSubscription]struct{}
+	now         func() time.Time
+}
+
+func newChangeLog() changeLog {
+	return changeLog{subscribers: make(map[*ChangeSubscription]struct{}), now: time.Now}
+}
+
+func (l *changeLog) record(resource ChangeResource, kind, id, name string, action ChangeAction, correlationID string) int64 {
+	l.version++
+	if len(l.changes) == maxRetainedChanges {
+		dropped := l.changes[0]
+		l.dropped = &dropped
+		copy(l.changes, l.changes[1:])
+		l.changes = l.changes[:len(l.changes)-1]
+	}
+	change := ConfigChange{
+		Version:       l.version,
+		Timestamp:     l.now(),
+		Resource:      resource,
+		Kind:          kind,
+		ID:            id,
+		Name:          name,
+		Action:        action,
+		CorrelationID: correlationID,
+	}
+	l.changes = append(l.changes, change)
+	for sub := range l.subscribers {
+		sub.deliver(change)
+	}
+	return l.version
+}
+
+// since returns the changes made after version.
+func (l *changeLog) since(version int64) ([]ConfigChange, error) {
+	if version < 0 || version > l.version || (l.dropped != nil && version < l.dropped.Version) {
+		return nil, ErrChangesExpired
+	}
+	i := sort.Search(len(l.changes), func(i int) bool { return l.changes[i].Version > version })
+	return l.changes[i:], nil
+}
+
+// sinceTime returns the changes made after t and the version they start
+// after.
+func (l *changeLog) sinceTime(t time.Time) (int64, []ConfigChange, error) {
+	if l.dropped != nil && l.dropped.Timestamp.After(t) {
+		return 0, nil, ErrChangesExpired
+	}
+	i := sort.Search(len(l.changes), func(i int) bool { return l.changes[i].Timestamp.After(t) })
+	switch {
+	case i > 0:
+		return l.changes[i-1].Version, l.changes[i:], nil
+	case l.dropped != nil:
+		return l.dropped.Version, l.changes, nil
+	}
+	return 0, l.changes, nil
+}
+
+// NetChanges collapses changes to one entry per resource, describing how each
+// resource differs between the start and the end of the changes: a resource
+// added and then modified is reported as added, one added and then removed is
+// left out. Entries carry the version, timestamp and name of their last
+// change and are ordered by version.
+func NetChanges(changes []ConfigChange) []ConfigChange {
+	type resourceKey struct {
+		resource ChangeResource
+		id       string
+	}
+	first := make(map[resourceKey]ChangeAction)
+	last := make(map[resourceKey]ConfigChange)
+	for _, c := range changes {
+		k := resourceKey{c.Resource, c.ID}
+		if _, ok := first[k]; !ok {
+			first[k] = c.Action
+		}
+		last[k] = c
+	}
+
+	result := make([]ConfigChange, 0, len(last))
+	for k, c := range last {
+		switch {
+		case first[k] == ChangeAdded && c.Action == ChangeRemoved:
+			continue
+		case first[k] == ChangeAdded:
+			c.Action = ChangeAdded
+		case c.Action == ChangeRemoved:
+		default:
+			c.Action = ChangeModified
+		}
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result
+}
+
+// RecordChange records a mutation of a resource kept outside the store, such
+// as a policy definition or certificate, and returns the new resource version.
+// correlationID identifies the request that triggered it and may be empty.
+func (cs *ConfigStore) RecordChange(resource ChangeResource, id, name string, action ChangeAction, correlationID string) int64 {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.changes.record(resource, "", id, name, action, correlationID)
+}
+
+// GetResourceVersion returns the version of the last recorded mutation.
+func (cs *ConfigStore) GetResourceVersion() int64 {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	return cs.changes.version
+}
+
+// ChangesSince returns the mutations made after version, together with the
+// current resource version. It returns ErrChangesExpired when they are no
+// longer retained.
+func (cs *ConfigStore) ChangesSince(version int64) ([]ConfigChange, int64, error) {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	changes, err := cs.changes.since(version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return append([]ConfigChange(nil), changes...), cs.changes.version, nil
+}
+
+// ChangesSinceTime returns the mutations made after t, the version they start
+// after and the current resource version. It returns ErrChangesExpired when
+// they are no longer retained.
+func (cs *ConfigStore) ChangesSinceTime(t time.Time) ([]ConfigChange, int64, int64, error) {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	since, changes, err := cs.changes.sinceTime(t)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return append([]ConfigChange(nil), changes...), since, cs.changes.version, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func newChangeLogTestConfig(id, handle string) *models.StoredConfig {
+	return &models.StoredConfig{
+		UUID:        id,
+		Kind:        "RestApi",
+		Handle:      handle,
+		DisplayName: handle,
+		Version:     "v1.0",
+	}
+}
+
+// action returns the action of the net change to the resource with id.
+func action(changes []ConfigChange, resource ChangeResource, id string) ChangeAction {
+	for _, c := range changes {
+		if c.Resource == resource && c.ID == id {
+			return c.Action
+		}
+	}
+	return ""
+}
+
+func TestConfigStore_ChangesRecordMutations(t *testing.T) {
+	cs := NewConfigStore()
+	assert.Equal(t, int64(0), cs.GetResourceVersion())
+
+	require.NoError(t, cs.Add(newChangeLogTestConfig("id-1", "petstore")))
+	require.NoError(t, cs.Update(newChangeLogTestConfig("id-1", "petstore")))
+	require.NoError(t, cs.Delete("id-1"))
+	cs.RecordChange(ChangeResourceCertificate, "cert-1", "ca", ChangeAdded, "")
+
+	changes, current, err := cs.ChangesSince(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), current)
+	require.Len(t, changes, 4)
+
+	want := []struct {
+		resource ChangeResource
+		action   ChangeAction
+	}{
+		{ChangeResourceAPI, ChangeAdded},
+		{ChangeResourceAPI, ChangeModified},
+		{ChangeResourceAPI, ChangeRemoved},
+		{ChangeResourceCertificate, ChangeAdded},
+	}
+	for i, w := range want {
+		assert.Equal(t, int64(i+1), changes[i].Version)
+		assert.Equal(t, w.resource, changes[i].Resource)
+		assert.Equal(t, w.action, changes[i].Action)
+	}
+	assert.Equal(t, "RestApi", changes[0].Kind)
+	assert.Equal(t, "id-1", changes[0].ID)
+	assert.Equal(t, "petstore", changes[0].Name)
+}
+
+func TestConfigStore_FailedMutationsAreNotRecorded(t *testing.T) {
+	cs := NewConfigStore()
+	require.NoError(t, cs.Add(newChangeLogTestConfig("id-1", "petstore")))
+
+	assert.Error(t, cs.Add(newChangeLogTestConfig("id-2", "petstore")))
+	assert.Error(t, cs.Update(newChangeLogTestConfig("missing", "other")))
+	assert.Error(t, cs.Delete("missing"))
+
+	assert.Equal(t, int64(1), cs.GetResourceVersion())
+}
+
+func TestNetChanges(t *testing.T) {
+	cs := NewConfigStore()
+	require.NoError(t, cs.Add(newChangeLogTestConfig("existing", "existing")))
+	require.NoError(t, cs.Add(newChangeLogTestConfig("deleted", "deleted")))
+	since := cs.GetResourceVersion()
+
+	// created and then updated: added
+	require.NoError(t, cs.Add(newChangeLogTestConfig("created", "created")))
+	require.NoError(t, cs.Update(newChangeLogTestConfig("created", "created")))
+	// created and then deleted: no change
+	require.NoError(t, cs.Add(newChangeLogTestConfig("transient", "transient")))
+	require.NoError(t, cs.Delete("transient"))
+	// updated twice: modified
+	require.NoError(t, cs.Update(newChangeLogTestConfig("existing", "existing")))
+	require.NoError(t, cs.Update(newChangeLogTestConfig("existing", "existing")))
+	// updated and then deleted: removed
+	require.NoError(t, cs.Update(newChangeLogTestConfig("deleted", "deleted")))
+	require.NoError(t, cs.Delete("deleted"))
+
+	changes, _, err := cs.ChangesSince(since)
+	require.NoError(t, err)
+	net := NetChanges(changes)
+
+	require.Len(t, net, 3)
+	assert.Equal(t, ChangeAdded, action(net, ChangeResourceAPI, "created"))
+	assert.Equal(t, ChangeModified, action(net, ChangeResourceAPI, "existing"))
+	assert.Equal(t, ChangeRemoved, action(net, ChangeResourceAPI, "deleted"))
+	assert.Equal(t, cs.GetResourceVersion(), net[len(net)-1].Version, "entries are ordered by version")
+}
+
+func TestConfigStore_ChangesSinceTime(t *testing.T) {
+	cs := NewConfigStore()
+	clock := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	cs.changes.now = func() time.Time { return clock }
+
+	cs.RecordChange(ChangeResourcePolicy, "basic-auth|v1.0.0", "basic-auth", ChangeAdded, "")
+	clock = clock.Add(time.Minute)
+	cs.RecordChange(ChangeResourcePolicy, "cors|v1.0.0", "cors", ChangeAdded, "")
+
+	changes, since, current, err := cs.ChangesSinceTime(clock.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), since)
+	assert.Equal(t, int64(2), current)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "cors|v1.0.0", changes[0].ID)
+
+	changes, since, _, err = cs.ChangesSinceTime(clock)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), since)
+	assert.Empty(t, changes)
+}
+
+func TestConfigStore_ChangesExpire(t *testing.T) {
+	cs := NewConfigStore()
+	start := time.Now()
+	for i := 0; i < maxRetainedChanges+2; i++ {
+		cs.RecordChange(ChangeResourceCertificate, "cert", "", ChangeModified, "")
+	}
+
+	_, _, err := cs.ChangesSince(0)
+	assert.ErrorIs(t, err, ErrChangesExpired)
+	_, _, _, err = cs.ChangesSinceTime(start.Add(-time.Second))
+	assert.ErrorIs(t, err, ErrChangesExpired)
+
+	changes, _, err := cs.ChangesSince(2)
+	require.NoError(t, err)
+	assert.Len(t, changes, maxRetainedChanges)
+
+	// Versions from before a restart are ahead of the new counter.
+	_, _, err = cs.ChangesSince(cs.GetResourceVersion() + 1)
+	assert.ErrorIs(t, err, ErrChangesExpired)
+}
@@ -68,6 +68,14 @@ CREATE TABLE IF NOT EXISTS mcp_proxies (
     FOREIGN KEY(gateway_id, uuid) REFERENCES artifacts(gateway_id, uuid) ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS grpc_apis (
+    uuid TEXT NOT NULL,
+    gateway_id TEXT NOT NULL,
+    configuration TEXT NOT NULL,
+    PRIMARY KEY (gateway_id, uuid),
+    FOREIGN KEY(gateway_id, uuid) REFERENCES artifacts(gateway_id, uuid) ON DELETE CASCADE
+);
+
 -- Table for custom TLS certificates
 CREATE TABLE IF NOT EXISTS certificates (
     uuid TEXT NOT NULL,
@@ -113,6 +121,8 @@ CREATE TABLE IF NOT EXISTS api_keys (
     source TEXT NOT NULL DEFAULT 'local',
     external_ref_id TEXT NULL,
     issuer TEXT NULL DEFAULT NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    operations TEXT NOT NULL DEFAULT '*',
     UNIQUE (gateway_id, artifact_uuid, name),
     UNIQUE (gateway_id, uuid),
     PRIMARY KEY (gateway_id, api_key)
@@ -121,6 +131,17 @@ CREATE TABLE IF NOT EXISTS api_keys (
 CREATE INDEX IF NOT EXISTS idx_api_key_status ON api_keys(status);
 CREATE INDEX IF NOT EXISTS idx_created_by ON api_keys(created_by);
 
+-- Hashes of removed or regenerated local API keys, published to the policy
+-- engines so signed API keys stay rejected until they would have expired.
+CREATE TABLE IF NOT EXISTS api_key_revocations (
+    gateway_id TEXT NOT NULL,
+    api_key TEXT NOT NULL,
+    artifact_uuid TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (gateway_id, api_key)
+);
+
 -- Subscription plans table (organization-scoped rate/billing plans)
 CREATE TABLE IF NOT EXISTS subscription_plans (
     uuid TEXT NOT NULL,
@@ -100,6 +100,15 @@ CREATE TABLE dbo.mcp_proxies (
     FOREIGN KEY(gateway_id, uuid) REFERENCES dbo.artifacts(gateway_id, uuid) ON DELETE CASCADE
 );
 
+IF OBJECT_ID(N'dbo.grpc_apis', N'U') IS NULL
+CREATE TABLE dbo.grpc_apis (
+    uuid NVARCHAR(64) NOT NULL,
+    gateway_id NVARCHAR(64) NOT NULL,
+    configuration NVARCHAR(MAX) NOT NULL,
+    PRIMARY KEY (gateway_id, uuid),
+    FOREIGN KEY(gateway_id, uuid) REFERENCES dbo.artifacts(gateway_id, uuid) ON DELETE CASCADE
+);
+
 -- Table for custom TLS certificates
 IF OBJECT_ID(N'dbo.certificates', N'U') IS NULL
 CREATE TABLE dbo.certificates (
@@ -148,6 +157,8 @@ CREATE TABLE dbo.api_keys (
     source NVARCHAR(64) NOT NULL DEFAULT 'local',
     external_ref_id NVARCHAR(255) NULL,
     issuer NVARCHAR(255) NULL DEFAULT NULL,
+    last_used_at DATETIME2(7) NULL,
+    operations NVARCHAR(MAX) NOT NULL DEFAULT '*',
     PRIMARY KEY (gateway_id, api_key),
     CONSTRAINT uq_api_keys_artifact_name UNIQUE (gateway_id, artifact_uuid, name),
     CONSTRAINT uq_api_keys_uuid UNIQUE (gateway_id, uuid)
@@ -158,6 +169,18 @@ CREATE INDEX idx_api_key_status ON dbo.api_keys(status);
 IF NOT EXISTS (SELECT 1 FROM sys.indexes WHERE name = N'idx_created_by' AND object_id = OBJECT_ID(N'dbo.api_keys'))
 CREATE INDEX idx_created_by ON dbo.api_keys(created_by);
 
+-- Hashes of removed or regenerated local API keys, published to the policy
+-- engines so signed API keys stay rejected until they would have expired.
+IF OBJECT_ID(N'dbo.api_key_revocations', N'U') IS NULL
+CREATE TABLE dbo.api_key_revocations (
+    gateway_id NVARCHAR(64) NOT NULL,
+    api_key NVARCHAR(255) NOT NULL,
+    artifact_uuid NVARCHAR(64) NOT NULL,
+    expires_at DATETIME2(7) NULL,
+    revoked_at DATETIME2(7) NOT NULL,
+    PRIMARY KEY (gateway_id, api_key)
+);
+
 -- Subscription plans table (organization-scoped rate/billing plans)
 IF OBJECT_ID(N'dbo.subscription_plans', N'U') IS NULL
 CREATE TABLE dbo.subscription_plans (
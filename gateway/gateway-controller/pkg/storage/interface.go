@@ -20,10 +20,23 @@ package storage
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 )
 
+// APIKeyListFilter narrows and paginates an API key listing.
+type APIKeyListFilter struct {
+	// CreatedBy restricts results to keys created by this user. Empty matches all users.
+	CreatedBy string
+	// Status restricts results to keys in this status. Empty matches all statuses.
+	Status models.APIKeyStatus
+	// Limit is the maximum number of keys to return. Zero or negative returns all.
+	Limit int
+	// Offset is the number of matching keys to skip.
+	Offset int
+}
+
 // Storage is the interface for persisting API configurations.
 //
 // # Design Philosophy
@@ -204,6 +217,12 @@ type Storage interface {
 	// Implementations should ensure this operation is atomic (all-or-nothing).
 	SaveAPIKey(apiKey *models.APIKey) error
 
+	// SaveAPIKeys persists a batch of new API keys atomically.
+	//
+	// Returns an error wrapping ErrConflict, and saves none of the keys, if any key's name or
+	// value already exists or is repeated within the batch.
+	SaveAPIKeys(apiKeys []*models.APIKey) error
+
 	// UpsertAPIKey inserts or updates an API key identified by (gateway_id, artifact_uuid, name).
 	//
 	// If a key with the same name already exists for the artifact, it is updated only when the
@@ -235,12 +254,25 @@ type Storage interface {
 	// Used for listing API keys associated with an API.
 	GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error)
 
+	// ListAPIKeysByAPI retrieves one page of API keys for a specific API, newest first,
+	// together with the total number of keys matching the filter.
+	//
+	// Filtering and pagination are applied by the backend so large key sets are not
+	// loaded into memory. Used for the API key list endpoints.
+	ListAPIKeysByAPI(apiId string, filter APIKeyListFilter) ([]*models.APIKey, int, error)
+
 	// GetAllAPIKeys retrieves all active API keys from the database.
 	//
 	// Returns an empty slice if no active API keys exist.
 	// Used for loading active API keys into memory on startup.
 	GetAllAPIKeys() ([]*models.APIKey, error)
 
+	// ListExpiredAPIKeys retrieves the active API keys whose expiry time is at or before now.
+	//
+	// Returns an empty slice if no active API key has expired.
+	// Used by the API key expiry sweeper.
+	ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error)
+
 	// GetAPIKeysByApplicationUUID retrieves all active API keys mapped to an application UUID.
 	//
 	// Returns an empty slice if no active API keys exist for the application.
@@ -258,6 +290,20 @@ type Storage interface {
 	// Implementations should ensure this operation is atomic and thread-safe.
 	UpdateAPIKey(apiKey *models.APIKey) error
 
+	// RevokeActiveAPIKey sets the status of the given key to revoked and its updated_at to
+	// apiKey.UpdatedAt, provided the stored key is still active and holds apiKey.APIKey.
+	//
+	// Returns whether the key was revoked by this call; a key that is missing, already
+	// revoked or regenerated meanwhile is not an error.
+	RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error)
+
+	// TouchAPIKeyLastUsed sets last_used_at of the active key with the given artifact and UUID
+	// to usedAt, unless the stored value is already after staleBefore. updated_at is left
+	// untouched.
+	//
+	// Returns whether the key was updated; a missing key is not an error.
+	TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error)
+
 	// DeleteAPIKey removes an API key by its key value.
 	//
 	// Returns an error if the API key does not exist.
@@ -287,6 +333,11 @@ type Storage interface {
 	// has already identified the stale keys, avoiding a redundant NOT IN query.
 	DeleteAPIKeysByUUIDs(uuids []string) error
 
+	// ListAPIKeyRevocations returns the stored hashes of removed or regenerated local API
+	// keys that had not expired by now. Methods that delete a local key or replace its
+	// hash, including DeleteConfig, record the old hash in the same operation.
+	ListAPIKeyRevocations(now time.Time) ([]string, error)
+
 	// ========================================
 	// Subscription Plan Methods
 	// ========================================
@@ -20,6 +20,7 @@ package storage
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -50,6 +51,10 @@ type ConfigStore struct {
 
 	// Labels storage
 	labelsByAPI map[string]map[string]string // Key: API handle (metadata.name) → Value: labels map
+
+	// changes versions every mutation for the config dump diff and event
+	// stream
+	changes changeLog
 }
 
 // NewConfigStore creates a new in-memory config store
@@ -64,11 +69,18 @@ func NewConfigStore() *ConfigStore {
 		templateIdByHandle: make(map[string]string),
 		apiKeysByAPI:       make(map[string]map[string]*models.APIKey),
 		labelsByAPI:        make(map[string]map[string]string),
+		changes:            newChangeLog(),
 	}
 }
 
 // Add stores a new configuration in memory
 func (cs *ConfigStore) Add(cfg *models.StoredConfig) error {
+	return cs.AddWithCorrelationID(cfg, "")
+}
+
+// AddWithCorrelationID stores a new configuration in memory and tags the
+// recorded change with the correlation ID of the request that triggered it.
+func (cs *ConfigStore) AddWithCorrelationID(cfg *models.StoredConfig, correlationID string) error {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
@@ -102,11 +114,19 @@ func (cs *ConfigStore) Add(cfg *models.StoredConfig) error {
 			return err
 		}
 	}
+	cs.changes.record(ChangeResourceAPI, cfg.Kind, cfg.UUID, cfg.Handle, ChangeAdded, correlationID)
 	return nil
 }
 
 // Update modifies an existing configuration in memory
 func (cs *ConfigStore) Update(cfg *models.StoredConfig) error {
+	return cs.UpdateWithCorrelationID(cfg, "")
+}
+
+// UpdateWithCorrelationID modifies an existing configuration in memory and
+// tags the recorded change with the correlation ID of the request that
+// triggered it.
+func (cs *ConfigStore) UpdateWithCorrelationID(cfg *models.StoredConfig, correlationID string) error {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
@@ -177,6 +197,7 @@ func (cs *ConfigStore) Update(cfg *models.StoredConfig) error {
 		}
 	}
 
+	cs.changes.record(ChangeResourceAPI, cfg.Kind, cfg.UUID, cfg.Handle, ChangeModified, correlationID)
 	return nil
 }
 
@@ -188,6 +209,12 @@ func (cs *ConfigStore) SetWebSubTopicUpdater(fn func(cfg *models.StoredConfig, t
 
 // Delete removes a configuration from memory
 func (cs *ConfigStore) Delete(id string) error {
+	return cs.DeleteWithCorrelationID(id, "")
+}
+
+// DeleteWithCorrelationID removes a configuration from memory and tags the
+// recorded change with the correlation ID of the request that triggered it.
+func (cs *ConfigStore) DeleteWithCorrelationID(id, correlationID string) error {
 	cs.mu.Lock()
 	defer cs.mu.Unlock()
 
@@ -204,6 +231,7 @@ func (cs *ConfigStore) Delete(id string) error {
 	delete(cs.configs, id)
 	// Remove from labels map
 	delete(cs.labelsByAPI, cfg.Handle)
+	cs.changes.record(ChangeResourceAPI, cfg.Kind, cfg.UUID, cfg.Handle, ChangeRemoved, correlationID)
 	return nil
 }
 
@@ -532,6 +560,53 @@ func (cs *ConfigStore) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) {
 	return result, nil
 }
 
+// ListAPIKeysByAPI retrieves a filtered page of API keys for a specific API
+func (cs *ConfigStore) ListAPIKeysByAPI(apiId string, filter APIKeyListFilter) ([]*models.APIKey, int, error) {
+	apiKeys, err := cs.GetAPIKeysByAPI(apiId)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, total := FilterAPIKeys(apiKeys, filter)
+	return page, total, nil
+}
+
+// FilterAPIKeys applies an APIKeyListFilter to an in-memory slice of API keys.
+// Keys are ordered newest first (ties broken by UUID) to match the database
+// backends. It returns the requested page and the total number of matches.
+func FilterAPIKeys(apiKeys []*models.APIKey, filter APIKeyListFilter) ([]*models.APIKey, int) {
+	matched := make([]*models.APIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if filter.CreatedBy != "" && apiKey.CreatedBy != filter.CreatedBy {
+			continue
+		}
+		if filter.Status != "" && apiKey.Status != filter.Status {
+			continue
+		}
+		matched = append(matched, apiKey)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.After(matched[j].CreatedAt)
+		}
+		return matched[i].UUID < matched[j].UUID
+	})
+
+	total := len(matched)
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= total {
+		return []*models.APIKey{}, total
+	}
+	end := total
+	if filter.Limit > 0 && offset+filter.Limit < total {
+		end = offset + filter.Limit
+	}
+	return matched[offset:end], total
+}
+
 // CountActiveAPIKeysByUserAndAPI counts active API keys for a specific user and API
 func (cs *ConfigStore) CountActiveAPIKeysByUserAndAPI(apiId, userID string) (int, error) {
 	cs.mu.RLock()
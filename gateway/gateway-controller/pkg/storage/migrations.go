@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/sqlite
+var sqliteMigrationsFS embed.FS
+
+const (
+	sqliteMigrationsDir = "migrations/sqlite"
+
+	// baselineSchemaVersion is the version written by gateway-controller-db.sql.
+	// Databases created before the migration runner existed are at this version.
+	baselineSchemaVersion = 4
+)
+
+// migration is a single ordered, up-only schema change.
+type migration struct {
+	version int
+	name    string
+	sql     string
+}
+
+// RunMigrations brings a SQLite database up to the latest schema version.
+// A fresh database receives the baseline schema; an existing one is upgraded
+// by applying every embedded migration newer than the recorded version.
+// Applied versions are tracked in the schema_migrations table.
+func RunMigrations(db *sql.DB, logger *slog.Logger) error {
+	migrations, err := loadMigrations(sqliteMigrationsFS, sqliteMigrationsDir)
+	if err != nil {
+		return err
+	}
+	return runMigrations(db, logger, migrations)
+}
+
+// loadMigrations reads NNNN_description.sql files from dir, ordered by version.
+// Versions must be contiguous starting right after the baseline.
+func loadMigrations(fsys fs.FS, dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	var migrations []migration
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name %q: expected NNNN_description.sql", entry.Name())
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+		migrations = append(migrations, migration{
+			version: version,
+			name:    strings.TrimSuffix(entry.Name(), ".sql"),
+			sql:     string(content),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	expected := baselineSchemaVersion + 1
+	for _, m := range migrations {
+		if m.version != expected {
+			return nil, fmt.Errorf("missing schema migration: expected version %d, found %s", expected, m.name)
+		}
+		expected++
+	}
+	return migrations, nil
+}
+
+// latestSchemaVersion returns the version the database is at once all
+// migrations have been applied.
+func latestSchemaVersion(migrations []migration) int {
+	if len(migrations) == 0 {
+		return baselineSchemaVersion
+	}
+	return migrations[len(migrations)-1].version
+}
+
+func runMigrations(db *sql.DB, logger *slog.Logger, migrations []migration) error {
+	expected := latestSchemaVersion(migrations)
+
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	current, err := currentMigrationVersion(db)
+	if err != nil {
+		return err
+	}
+
+	if current == 0 {
+		// No recorded migrations: either a fresh database or one created before
+		// schema_migrations existed, which only tracked PRAGMA user_version.
+		var userVersion int
+		if err := db.QueryRow("PRAGMA user_version").Scan(&userVersion); err != nil {
+			return fmt.Errorf("failed to query schema version: %w", err)
+		}
+
+		switch userVersion {
+		case 0:
+			logger.Info("Initializing database schema", slog.Int("version", baselineSchemaVersion))
+			if err := applyMigration(db, migration{version: baselineSchemaVersion, name: "baseline", sql: schemaSQL}); err != nil {
+				return err
+			}
+		case baselineSchemaVersion:
+			logger.Info("Adopting existing database schema into schema_migrations",
+				slog.Int("version", baselineSchemaVersion))
+			if err := recordMigration(db, migration{version: baselineSchemaVersion, name: "baseline"}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported schema version %d, expected %d; delete the database to recreate", userVersion, expected)
+		}
+		current = baselineSchemaVersion
+	}
+
+	if current > expected {
+		return fmt.Errorf("database schema version %d is newer than the latest supported version %d; "+
+			"the database was written by a newer release", current, expected)
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		logger.Info("Applying schema migration",
+			slog.Int("version", m.version),
+			slog.String("name", m.name))
+		if err := applyMigration(db, m); err != nil {
+			return err
+		}
+		current = m.version
+	}
+
+	if current != expected {
+		return fmt.Errorf("schema version mismatch: database is at version %d, expected %d", current, expected)
+	}
+
+	logger.Info("Database schema up to date", slog.Int("version", current))
+	return nil
+}
+
+func currentMigrationVersion(db *sql.DB) (int, error) {
+	var version sql.NullInt64
+	if err := db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to query applied schema migrations: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// applyMigration runs a migration and records it in a single transaction so a
+// failed migration leaves neither partial DDL nor a bookkeeping row behind.
+func applyMigration(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", m.name, err)
+	}
+	if _, err := tx.Exec(m.sql); err != nil {
+		return rollbackMigration(tx, fmt.Errorf("failed to apply migration %s: %w", m.name, err))
+	}
+	if err := recordMigration(tx, m); err != nil {
+		return rollbackMigration(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", m.name, err)
+	}
+	return nil
+}
+
+type migrationExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func recordMigration(e migrationExecer, m migration) error {
+	if _, err := e.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", m.version, m.name); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", m.name, err)
+	}
+	// Keep PRAGMA user_version in step for tooling that inspects it directly.
+	if _, err := e.Exec(fmt.Sprintf("PRAGMA user_version = %d", m.version)); err != nil {
+		return fmt.Errorf("failed to set schema version for migration %s: %w", m.name, err)
+	}
+	return nil
+}
+
+func rollbackMigration(tx *sql.Tx, err error) error {
+	if rbErr := tx.Rollback(); rbErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to roll back migration: %w", rbErr))
+	}
+	return err
+}
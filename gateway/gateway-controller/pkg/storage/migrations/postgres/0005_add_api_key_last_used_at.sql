@@ -0,0 +1,2 @@
+-- Records when an API key was last successfully validated (NULL if never used).
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ NULL;
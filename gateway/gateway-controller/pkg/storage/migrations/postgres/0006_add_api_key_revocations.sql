@@ -0,0 +1,11 @@
+-- Hashes of removed or regenerated local API keys. Signed API keys can be
+-- validated without their api_keys row, so these are published to the policy
+-- engines until the key would have expired anyway.
+CREATE TABLE IF NOT EXISTS api_key_revocations (
+    gateway_id TEXT NOT NULL,
+    api_key TEXT NOT NULL,
+    artifact_uuid TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (gateway_id, api_key)
+);
@@ -0,0 +1,8 @@
+-- Per-resource table for gRPC APIs (kind "GrpcApi").
+CREATE TABLE IF NOT EXISTS grpc_apis (
+    uuid TEXT NOT NULL,
+    gateway_id TEXT NOT NULL,
+    configuration TEXT NOT NULL,
+    PRIMARY KEY (gateway_id, uuid),
+    FOREIGN KEY(gateway_id, uuid) REFERENCES artifacts(gateway_id, uuid) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Records when an API key was last successfully validated (NULL if never used).
+ALTER TABLE api_keys ADD COLUMN last_used_at TIMESTAMP NULL;
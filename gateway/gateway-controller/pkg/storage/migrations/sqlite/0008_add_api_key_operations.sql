@@ -0,0 +1,2 @@
+-- Operations of the API an API key can access, as "*" or a JSON array of "METHOD /path" entries.
+ALTER TABLE api_keys ADD COLUMN operations TEXT NOT NULL DEFAULT '*';
@@ -0,0 +1,3 @@
+-- Records when an API key was last successfully validated (NULL if never used).
+IF COL_LENGTH(N'dbo.api_keys', N'last_used_at') IS NULL
+    ALTER TABLE dbo.api_keys ADD last_used_at DATETIME2(7) NULL;
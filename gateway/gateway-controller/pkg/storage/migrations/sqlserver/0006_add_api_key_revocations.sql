@@ -0,0 +1,12 @@
+-- Hashes of removed or regenerated local API keys. Signed API keys can be
+-- validated without their api_keys row, so these are published to the policy
+-- engines until the key would have expired anyway.
+IF OBJECT_ID(N'dbo.api_key_revocations', N'U') IS NULL
+CREATE TABLE dbo.api_key_revocations (
+    gateway_id NVARCHAR(64) NOT NULL,
+    api_key NVARCHAR(255) NOT NULL,
+    artifact_uuid NVARCHAR(64) NOT NULL,
+    expires_at DATETIME2(7) NULL,
+    revoked_at DATETIME2(7) NOT NULL,
+    PRIMARY KEY (gateway_id, api_key)
+);
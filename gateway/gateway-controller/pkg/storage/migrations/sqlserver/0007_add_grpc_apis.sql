@@ -0,0 +1,9 @@
+-- Per-resource table for gRPC APIs (kind "GrpcApi").
+IF OBJECT_ID(N'dbo.grpc_apis', N'U') IS NULL
+CREATE TABLE dbo.grpc_apis (
+    uuid NVARCHAR(64) NOT NULL,
+    gateway_id NVARCHAR(64) NOT NULL,
+    configuration NVARCHAR(MAX) NOT NULL,
+    PRIMARY KEY (gateway_id, uuid),
+    FOREIGN KEY(gateway_id, uuid) REFERENCES dbo.artifacts(gateway_id, uuid) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+-- Operations of the API an API key can access, as "*" or a JSON array of "METHOD /path" entries.
+IF COL_LENGTH(N'dbo.api_keys', N'operations') IS NULL
+    ALTER TABLE dbo.api_keys ADD operations NVARCHAR(MAX) NOT NULL DEFAULT '*';
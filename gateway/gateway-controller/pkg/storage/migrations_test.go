@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"gotest.tools/v3/assert"
+)
+
+func openRawSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "migrations.db")
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?_foreign_keys=ON")
+	assert.NilError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func appliedMigrationVersions(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+	rows, err := db.Query("SELECT version FROM schema_migrations ORDER BY version")
+	assert.NilError(t, err)
+	defer rows.Close()
+
+	var versions []int
+	for rows.Next() {
+		var v int
+		assert.NilError(t, rows.Scan(&v))
+		versions = append(versions, v)
+	}
+	assert.NilError(t, rows.Err())
+	return versions
+}
+
+func TestLoadMigrations_EmbeddedSetIsContiguous(t *testing.T) {
+	migrations, err := loadMigrations(sqliteMigrationsFS, sqliteMigrationsDir)
+	assert.NilError(t, err)
+	for i, m := range migrations {
+		assert.Equal(t, m.version, baselineSchemaVersion+i+1)
+	}
+}
+
+// Postgres and SQL Server schemas are provisioned by the operator, so every SQLite
+// migration needs an upgrade script of the same name for each of them.
+func TestUpgradeScriptsMatchSQLiteMigrations(t *testing.T) {
+	sqliteMigrations, err := loadMigrations(sqliteMigrationsFS, sqliteMigrationsDir)
+	assert.NilError(t, err)
+
+	for _, dir := range []string{"migrations/postgres", "migrations/sqlserver"} {
+		scripts, err := loadMigrations(os.DirFS("."), dir)
+		assert.NilError(t, err)
+		assert.Equal(t, len(scripts), len(sqliteMigrations), "upgrade scripts in %s", dir)
+		for i, m := range sqliteMigrations {
+			assert.Equal(t, scripts[i].name, m.name, "upgrade script in %s", dir)
+		}
+	}
+}
+
+func TestLoadMigrations_DetectsGap(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/0005_first.sql": {Data: []byte("SELECT 1;")},
+		"m/0007_third.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	_, err := loadMigrations(fsys, "m")
+	assert.ErrorContains(t, err, "missing schema migration: expected version 6, found 0007_third")
+}
+
+func TestLoadMigrations_RejectsInvalidFileName(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/add_column.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	_, err := loadMigrations(fsys, "m")
+	assert.ErrorContains(t, err, "invalid migration file name")
+}
+
+func TestRunMigrations_FreshDatabaseAppliesBaselineAndUpgrades(t *testing.T) {
+	db := openRawSQLiteDB(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	fsys := fstest.MapFS{
+		"m/0005_add_widget.sql": {Data: []byte("ALTER TABLE api_keys ADD COLUMN widget TEXT;")},
+	}
+	migrations, err := loadMigrations(fsys, "m")
+	assert.NilError(t, err)
+
+	assert.NilError(t, runMigrations(db, logger, migrations))
+	assert.DeepEqual(t, appliedMigrationVersions(t, db), []int{4, 5})
+
+	var userVersion int
+	assert.NilError(t, db.QueryRow("PRAGMA user_version").Scan(&userVersion))
+	assert.Equal(t, userVersion, 5)
+
+	_, err = db.Exec("SELECT widget FROM api_keys")
+	assert.NilError(t, err)
+
+	// Re-running is a no-op
+	assert.NilError(t, runMigrations(db, logger, migrations))
+	assert.DeepEqual(t, appliedMigrationVersions(t, db), []int{4, 5})
+}
+
+func TestRunMigrations_AdoptsLegacyDatabase(t *testing.T) {
+	db := openRawSQLiteDB(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// A database created before schema_migrations existed
+	_, err := db.Exec(schemaSQL)
+	assert.NilError(t, err)
+
+	assert.NilError(t, runMigrations(db, logger, nil))
+	assert.DeepEqual(t, appliedMigrationVersions(t, db), []int{baselineSchemaVersion})
+}
+
+func TestRunMigrations_FailedMigrationIsRolledBack(t *testing.T) {
+	db := openRawSQLiteDB(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	migrations := []migration{
+		{version: 5, name: "0005_broken", sql: "ALTER TABLE missing_table ADD COLUMN x TEXT;"},
+	}
+
+	err := runMigrations(db, logger, migrations)
+	assert.ErrorContains(t, err, "failed to apply migration 0005_broken")
+	assert.DeepEqual(t, appliedMigrationVersions(t, db), []int{baselineSchemaVersion})
+}
@@ -142,3 +142,42 @@ func TestPostgresStorage_SaveLLMProviderTemplate_UniqueConstraintError(t *testin
 	err := pg.SaveLLMProviderTemplate(conflictingTemplate)
 	assert.Assert(t, errors.Is(err, ErrConflict))
 }
+
+func TestPostgresStorage_SaveAPIKey_DuplicateValueConflict(t *testing.T) {
+	pg := setupTestPostgresStorage(t)
+	defer pg.Close()
+
+	cfg := createTestStoredConfig()
+	assert.NilError(t, pg.SaveConfig(cfg))
+	t.Cleanup(func() {
+		if err := pg.DeleteConfig(cfg.UUID); err != nil && !IsNotFoundError(err) {
+			t.Errorf("cleanup DeleteConfig(%s): %v", cfg.UUID, err)
+		}
+	})
+
+	first := createTestAPIKey()
+	first.ArtifactUUID = cfg.UUID
+	assert.NilError(t, pg.SaveAPIKey(first))
+	t.Cleanup(func() {
+		if err := pg.RemoveAPIKeyAPIAndName(first.ArtifactUUID, first.Name); err != nil && !IsNotFoundError(err) {
+			t.Errorf("cleanup RemoveAPIKeyAPIAndName(%s, %s): %v", first.ArtifactUUID, first.Name, err)
+		}
+	})
+
+	// A second key carrying the same hashed value must surface ErrConflict so
+	// that APIKeyService can regenerate the key and retry the save.
+	second := createTestAPIKey()
+	second.ArtifactUUID = cfg.UUID
+	second.APIKey = first.APIKey
+
+	err := pg.SaveAPIKey(second)
+	assert.Assert(t, errors.Is(err, ErrConflict))
+
+	second.APIKey = first.APIKey + "-regenerated"
+	assert.NilError(t, pg.SaveAPIKey(second))
+	t.Cleanup(func() {
+		if err := pg.RemoveAPIKeyAPIAndName(second.ArtifactUUID, second.Name); err != nil && !IsNotFoundError(err) {
+			t.Errorf("cleanup RemoveAPIKeyAPIAndName(%s, %s): %v", second.ArtifactUUID, second.Name, err)
+		}
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
@@ -287,6 +288,8 @@ func kindToResourceTable(kind string) (string, error) {
 		return "llm_proxies", nil
 	case "Mcp":
 		return "mcp_proxies", nil
+	case "GrpcApi":
+		return "grpc_apis", nil
 	default:
 		if table, ok := extraResourceTables[kind]; ok {
 			return table, nil
@@ -306,7 +309,7 @@ var extraResourceTables = map[string]string{}
 // builtinResourceTables lists the per-kind tables core defines natively.
 // GetAllConfigs unions these with every table in extraResourceTables so
 // cross-kind listing also covers kinds registered by an external module.
-var builtinResourceTables = []string{"rest_apis", "llm_providers", "llm_proxies", "mcp_proxies"}
+var builtinResourceTables = []string{"rest_apis", "llm_providers", "llm_proxies", "mcp_proxies", "grpc_apis"}
 
 // RegisterKindResourceTable registers the resource table name for an artifact
 // kind not known to core. Intended to be called from an init() (or equivalent
@@ -330,7 +333,7 @@ func RegisterKindUnmarshaler(kind string, fn func(cfg *models.StoredConfig, json
 }
 
 // unmarshalSourceConfig unmarshals JSON into the correct typed struct for the given kind.
-// RestApi rows can populate Configuration directly because the stored
+// RestApi and GrpcApi rows can populate Configuration directly because the stored
 // payload is already the deployable shape. LLM provider/proxy rows only restore
 // SourceConfiguration; their derived RestAPI form is rebuilt later by the
 // deployment/event-listener layer once templates and policies are available.
@@ -370,6 +373,13 @@ func unmarshalSourceConfig(cfg *models.StoredConfig, jsonData string) error {
 			return fmt.Errorf("failed to unmarshal source configuration: %w", err)
 		}
 		cfg.SourceConfiguration = config
+	case "GrpcApi":
+		var config grpcapi.GrpcAPI
+		if err := json.Unmarshal([]byte(jsonData), &config); err != nil {
+			return fmt.Errorf("failed to unmarshal configuration: %w", err)
+		}
+		cfg.SourceConfiguration = config
+		cfg.Configuration = config
 	default:
 		if fn, ok := kindUnmarshalers[cfg.Kind]; ok {
 			return fn(cfg, jsonData)
@@ -764,6 +774,12 @@ func (s *sqlStore) DeleteConfig(id string) error {
 		return fmt.Errorf("failed to delete subscriptions for configuration: %w", err)
 	}
 
+	if err := s.recordAPIKeyRevocations(tx, `artifact_uuid = ?`, id); err != nil {
+		metrics.DatabaseOperationsTotal.WithLabelValues("delete", table, "error").Inc()
+		metrics.StorageErrorsTotal.WithLabelValues("delete", "cleanup_api_keys_error").Inc()
+		return fmt.Errorf("failed to revoke API keys for configuration: %w", err)
+	}
+
 	if _, err := tx.ExecQ(`DELETE FROM api_keys WHERE gateway_id = ? AND artifact_uuid = ?`, s.gatewayId, id); err != nil {
 		metrics.DatabaseOperationsTotal.WithLabelValues("delete", table, "error").Inc()
 		metrics.StorageErrorsTotal.WithLabelValues("delete", "cleanup_api_keys_error").Inc()
@@ -2102,55 +2118,68 @@ func (s *sqlStore) SaveAPIKey(apiKey *models.APIKey) error {
 		}
 	}()
 
+	if err := s.insertAPIKey(tx, apiKey); err != nil {
+		s.rollbackTx(tx, "failed to save API key")
+		return err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logger.Info("API key inserted successfully",
+		slog.String("name", apiKey.Name),
+		slog.String("created_by", apiKey.CreatedBy))
+
+	return nil
+}
+
+// SaveAPIKeys persists a batch of new API keys in a single transaction.
+// If any key conflicts with an existing key or another key in the batch, none are saved.
+func (s *sqlStore) SaveAPIKeys(apiKeys []*models.APIKey) error {
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			s.rollbackTx(tx, "panic while saving API keys")
+			panic(p)
+		}
+	}()
+
+	for _, apiKey := range apiKeys {
+		if err := s.insertAPIKey(tx, apiKey); err != nil {
+			s.rollbackTx(tx, "failed to save API key batch")
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.logger.Info("API keys inserted successfully", slog.Int("count", len(apiKeys)))
+
+	return nil
+}
+
+// insertAPIKey inserts a new API key within tx, failing with ErrConflict when the
+// artifact already has a key with the same name or the key value already exists.
+func (s *sqlStore) insertAPIKey(tx *sqlStoreTx, apiKey *models.APIKey) error {
 	// First, check if an API key with the same artifact_uuid and name exists
 	checkQuery := `SELECT uuid FROM api_keys WHERE artifact_uuid = ? AND name = ? AND gateway_id = ?`
 	var existingUUID string
-	err = tx.QueryRowQ(checkQuery, apiKey.ArtifactUUID, apiKey.Name, s.gatewayId).Scan(&existingUUID)
+	err := tx.QueryRowQ(checkQuery, apiKey.ArtifactUUID, apiKey.Name, s.gatewayId).Scan(&existingUUID)
 
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		s.rollbackTx(tx, "failed to check existing API key")
 		return fmt.Errorf("failed to check existing API key: %w", err)
 	}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		// No existing record, insert new API key
-		insertQuery := `
-			INSERT INTO api_keys (
-				uuid, gateway_id, name, api_key, masked_api_key, artifact_uuid, status,
-				created_at, created_by, updated_at, expires_at,
-				source, external_ref_id, issuer
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-		`
-
-		_, err := tx.ExecQ(insertQuery,
-			apiKey.UUID,
-			s.gatewayId,
-			apiKey.Name,
-			apiKey.APIKey,
-			apiKey.MaskedAPIKey,
-			apiKey.ArtifactUUID,
-			apiKey.Status,
-			apiKey.CreatedAt,
-			apiKey.CreatedBy,
-			apiKey.UpdatedAt,
-			apiKey.ExpiresAt,
-			apiKey.Source,
-			apiKey.ExternalRefId,
-			apiKey.Issuer,
-		)
-
-		if err != nil {
-			s.rollbackTx(tx, "failed to insert API key")
-			// Check for unique constraint violation on api_key field
-			if s.isUniqueViolation(err) {
-				return fmt.Errorf("%w: API key value already exists", ErrConflict)
-			}
-			return fmt.Errorf("failed to insert API key: %w", err)
-		}
-
-	} else {
+	if err == nil {
 		// Existing record found, return conflict error that API Key name already exists
-		s.rollbackTx(tx, "api key name already exists for API")
 		s.logger.Error("API key name already exists for the API",
 			slog.String("name", apiKey.Name),
 			slog.String("artifact_uuid", apiKey.ArtifactUUID),
@@ -2158,14 +2187,40 @@ func (s *sqlStore) SaveAPIKey(apiKey *models.APIKey) error {
 		return fmt.Errorf("%w: API key name already exists for the API: %s", ErrConflict, apiKey.Name)
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+	// No existing record, insert new API key
+	insertQuery := `
+		INSERT INTO api_keys (
+			uuid, gateway_id, name, api_key, masked_api_key, artifact_uuid, status,
+			created_at, created_by, updated_at, expires_at,
+			source, external_ref_id, issuer, last_used_at, operations
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
 
-	s.logger.Info("API key inserted successfully",
-		slog.String("name", apiKey.Name),
-		slog.String("created_by", apiKey.CreatedBy))
+	_, err = tx.ExecQ(insertQuery,
+		apiKey.UUID,
+		s.gatewayId,
+		apiKey.Name,
+		apiKey.APIKey,
+		apiKey.MaskedAPIKey,
+		apiKey.ArtifactUUID,
+		apiKey.Status,
+		apiKey.CreatedAt,
+		apiKey.CreatedBy,
+		apiKey.UpdatedAt,
+		apiKey.ExpiresAt,
+		apiKey.Source,
+		apiKey.ExternalRefId,
+		apiKey.Issuer,
+		apiKey.LastUsedAt,
+		apiKey.OperationScope(),
+	)
+	if err != nil {
+		// Check for unique constraint violation on api_key field
+		if s.isUniqueViolation(err) {
+			return fmt.Errorf("%w: API key value already exists", ErrConflict)
+		}
+		return fmt.Errorf("failed to insert API key: %w", err)
+	}
 
 	return nil
 }
@@ -2182,17 +2237,21 @@ func (s *sqlStore) UpsertAPIKey(apiKey *models.APIKey) error {
 	// racing event that already wrote a newer record is never overwritten.
 	// On update: source/issuer keep the existing value when already set, and
 	// external_ref_id falls back to the existing value when the incoming is NULL.
+	if err := s.recordAPIKeyRevocations(s, `artifact_uuid = ? AND name = ? AND api_key <> ? AND updated_at < ?`,
+		apiKey.ArtifactUUID, apiKey.Name, apiKey.APIKey, apiKey.UpdatedAt); err != nil {
+		return err
+	}
 	_, err := s.upsert(s, upsertSpec{
 		table: "api_keys",
 		columns: []string{
 			"uuid", "gateway_id", "name", "api_key", "masked_api_key", "artifact_uuid", "status",
 			"created_at", "created_by", "updated_at", "expires_at",
-			"source", "external_ref_id", "issuer",
+			"source", "external_ref_id", "issuer", "last_used_at", "operations",
 		},
 		insertValues: []interface{}{
 			apiKey.UUID, s.gatewayId, apiKey.Name, apiKey.APIKey, apiKey.MaskedAPIKey, apiKey.ArtifactUUID, apiKey.Status,
 			apiKey.CreatedAt, apiKey.CreatedBy, apiKey.UpdatedAt, apiKey.ExpiresAt,
-			apiKey.Source, apiKey.ExternalRefId, apiKey.Issuer,
+			apiKey.Source, apiKey.ExternalRefId, apiKey.Issuer, apiKey.LastUsedAt, apiKey.OperationScope(),
 		},
 		keyColumns: []string{"gateway_id", "artifact_uuid", "name"},
 		keyValues:  []interface{}{s.gatewayId, apiKey.ArtifactUUID, apiKey.Name},
@@ -2206,10 +2265,11 @@ func (s *sqlStore) UpsertAPIKey(apiKey *models.APIKey) error {
 			"source = CASE WHEN source != '' THEN source ELSE ? END",
 			"external_ref_id = COALESCE(?, external_ref_id)",
 			"issuer = CASE WHEN issuer != '' THEN issuer ELSE ? END",
+			"operations = ?",
 		},
 		setValues: []interface{}{
 			apiKey.UUID, apiKey.APIKey, apiKey.MaskedAPIKey, apiKey.Status, apiKey.UpdatedAt, apiKey.ExpiresAt,
-			apiKey.Source, apiKey.ExternalRefId, apiKey.Issuer,
+			apiKey.Source, apiKey.ExternalRefId, apiKey.Issuer, apiKey.OperationScope(),
 		},
 		guard:       "updated_at < ?",
 		guardValues: []interface{}{apiKey.UpdatedAt},
@@ -2233,7 +2293,7 @@ func (s *sqlStore) GetAPIKeyByID(id string) (*models.APIKey, error) {
 	query := `
 		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
 		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
-		       ak.issuer, app.application_uuid, app.application_name
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
 		FROM api_keys ak
 		LEFT JOIN application_api_keys aak
 		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
@@ -2246,6 +2306,7 @@ func (s *sqlStore) GetAPIKeyByID(id string) (*models.APIKey, error) {
 	var expiresAt sql.NullTime
 	var externalRefId sql.NullString
 	var issuer sql.NullString
+	var lastUsedAt sql.NullTime
 	var applicationID sql.NullString
 	var applicationName sql.NullString
 
@@ -2263,6 +2324,8 @@ func (s *sqlStore) GetAPIKeyByID(id string) (*models.APIKey, error) {
 		&apiKey.Source,
 		&externalRefId,
 		&issuer,
+		&lastUsedAt,
+		&apiKey.Operations,
 		&applicationID,
 		&applicationName,
 	)
@@ -2283,6 +2346,9 @@ func (s *sqlStore) GetAPIKeyByID(id string) (*models.APIKey, error) {
 	if issuer.Valid {
 		apiKey.Issuer = &issuer.String
 	}
+	if lastUsedAt.Valid {
+		apiKey.LastUsedAt = &lastUsedAt.Time
+	}
 	if applicationID.Valid {
 		apiKey.ApplicationID = applicationID.String
 	}
@@ -2298,7 +2364,7 @@ func (s *sqlStore) GetAPIKeyByUUID(uuid string) (*models.APIKey, error) {
 	query := `
 		SELECT uuid, name, api_key, masked_api_key, artifact_uuid, status,
 		       created_at, created_by, updated_at, expires_at, source, external_ref_id,
-		       issuer
+		       issuer, last_used_at, operations
 		FROM api_keys
 		WHERE uuid = ? AND gateway_id = ?
 	`
@@ -2307,6 +2373,7 @@ func (s *sqlStore) GetAPIKeyByUUID(uuid string) (*models.APIKey, error) {
 	var expiresAt sql.NullTime
 	var externalRefId sql.NullString
 	var issuer sql.NullString
+	var lastUsedAt sql.NullTime
 
 	err := s.queryRow(query, uuid, s.gatewayId).Scan(
 		&apiKey.UUID,
@@ -2322,6 +2389,8 @@ func (s *sqlStore) GetAPIKeyByUUID(uuid string) (*models.APIKey, error) {
 		&apiKey.Source,
 		&externalRefId,
 		&issuer,
+		&lastUsedAt,
+		&apiKey.Operations,
 	)
 
 	if err != nil {
@@ -2340,6 +2409,9 @@ func (s *sqlStore) GetAPIKeyByUUID(uuid string) (*models.APIKey, error) {
 	if issuer.Valid {
 		apiKey.Issuer = &issuer.String
 	}
+	if lastUsedAt.Valid {
+		apiKey.LastUsedAt = &lastUsedAt.Time
+	}
 
 	return &apiKey, nil
 }
@@ -2349,7 +2421,7 @@ func (s *sqlStore) GetAPIKeyByKey(key string) (*models.APIKey, error) {
 	query := `
 		SELECT uuid, name, api_key, masked_api_key, artifact_uuid, status,
 		       created_at, created_by, updated_at, expires_at, source, external_ref_id,
-		       issuer
+		       issuer, last_used_at, operations
 		FROM api_keys
 		WHERE api_key = ? AND gateway_id = ?
 	`
@@ -2358,6 +2430,7 @@ func (s *sqlStore) GetAPIKeyByKey(key string) (*models.APIKey, error) {
 	var expiresAt sql.NullTime
 	var externalRefId sql.NullString
 	var issuer sql.NullString
+	var lastUsedAt sql.NullTime
 
 	err := s.queryRow(query, key, s.gatewayId).Scan(
 		&apiKey.UUID,
@@ -2373,6 +2446,8 @@ func (s *sqlStore) GetAPIKeyByKey(key string) (*models.APIKey, error) {
 		&apiKey.Source,
 		&externalRefId,
 		&issuer,
+		&lastUsedAt,
+		&apiKey.Operations,
 	)
 
 	if err != nil {
@@ -2392,6 +2467,9 @@ func (s *sqlStore) GetAPIKeyByKey(key string) (*models.APIKey, error) {
 	if issuer.Valid {
 		apiKey.Issuer = &issuer.String
 	}
+	if lastUsedAt.Valid {
+		apiKey.LastUsedAt = &lastUsedAt.Time
+	}
 
 	return &apiKey, nil
 }
@@ -2401,7 +2479,7 @@ func (s *sqlStore) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) {
 	query := `
 		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
 		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
-		       ak.issuer, app.application_uuid, app.application_name
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
 		FROM api_keys ak
 		LEFT JOIN application_api_keys aak
 		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
@@ -2420,12 +2498,88 @@ func (s *sqlStore) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) {
 	return s.scanAPIKeyRows(rows)
 }
 
+// ListAPIKeysByAPI retrieves a filtered page of API keys for a specific API
+func (s *sqlStore) ListAPIKeysByAPI(apiId string, filter APIKeyListFilter) ([]*models.APIKey, int, error) {
+	where := "ak.artifact_uuid = ? AND ak.gateway_id = ?"
+	args := []interface{}{apiId, s.gatewayId}
+	if filter.CreatedBy != "" {
+		where += " AND ak.created_by = ?"
+		args = append(args, filter.CreatedBy)
+	}
+	if filter.Status != "" {
+		where += " AND ak.status = ?"
+		args = append(args, string(filter.Status))
+	}
+
+	var total int
+	if err := s.queryRow("SELECT COUNT(*) FROM api_keys ak WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count API keys: %w", err)
+	}
+
+	query := `
+		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
+		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
+		FROM api_keys ak
+		LEFT JOIN application_api_keys aak
+		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
+		LEFT JOIN applications app
+		  ON app.application_uuid = aak.application_uuid AND app.gateway_id = aak.gateway_id
+		WHERE ` + where + `
+		ORDER BY ak.created_at DESC, ak.uuid ASC`
+	pageClause, pageArgs := s.paginationClause(filter.Limit, filter.Offset)
+	query += pageClause
+	args = append(args, pageArgs...)
+
+	rows, err := s.query(query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query API keys: %w", err)
+	}
+	defer rows.Close()
+
+	apiKeys, err := s.scanAPIKeyRows(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return apiKeys, total, nil
+}
+
+// paginationClause returns the dialect-specific LIMIT/OFFSET suffix for an
+// ordered query. SQL Server has no LIMIT and uses OFFSET ... FETCH instead.
+func (s *sqlStore) paginationClause(limit, offset int) (string, []interface{}) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 && offset == 0 {
+		return "", nil
+	}
+
+	switch s.backendName {
+	case "sqlserver":
+		if limit <= 0 {
+			return " OFFSET ? ROWS", []interface{}{offset}
+		}
+		return " OFFSET ? ROWS FETCH NEXT ? ROWS ONLY", []interface{}{offset, limit}
+	case "postgres":
+		if limit <= 0 {
+			return " OFFSET ?", []interface{}{offset}
+		}
+		return " LIMIT ? OFFSET ?", []interface{}{limit, offset}
+	default:
+		// SQLite requires a LIMIT before OFFSET; -1 means unbounded.
+		if limit <= 0 {
+			limit = -1
+		}
+		return " LIMIT ? OFFSET ?", []interface{}{limit, offset}
+	}
+}
+
 // GetAPIKeysByApplicationUUID retrieves all active API keys mapped to a specific application UUID.
 func (s *sqlStore) GetAPIKeysByApplicationUUID(applicationUUID string) ([]*models.APIKey, error) {
 	query := `
 		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
 		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
-		       ak.issuer, app.application_uuid, app.application_name
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
 		FROM api_keys ak
 		INNER JOIN application_api_keys aak
 		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
@@ -2449,7 +2603,7 @@ func (s *sqlStore) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey, e
 	query := `
 		SELECT uuid, name, api_key, masked_api_key, artifact_uuid, status,
 		       created_at, created_by, updated_at, expires_at, source, external_ref_id,
-		       issuer
+		       issuer, last_used_at, operations
 		FROM api_keys
 		WHERE artifact_uuid = ? AND name = ? AND gateway_id = ?
 	`
@@ -2458,6 +2612,7 @@ func (s *sqlStore) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey, e
 	var expiresAt sql.NullTime
 	var externalRefId sql.NullString
 	var issuer sql.NullString
+	var lastUsedAt sql.NullTime
 
 	err := s.queryRow(query, apiId, name, s.gatewayId).Scan(
 		&apiKey.UUID,
@@ -2473,6 +2628,8 @@ func (s *sqlStore) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey, e
 		&apiKey.Source,
 		&externalRefId,
 		&issuer,
+		&lastUsedAt,
+		&apiKey.Operations,
 	)
 
 	if err != nil {
@@ -2492,6 +2649,9 @@ func (s *sqlStore) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey, e
 	if issuer.Valid {
 		apiKey.Issuer = &issuer.String
 	}
+	if lastUsedAt.Valid {
+		apiKey.LastUsedAt = &lastUsedAt.Time
+	}
 
 	return &apiKey, nil
 }
@@ -2513,10 +2673,16 @@ func (s *sqlStore) UpdateAPIKey(apiKey *models.APIKey) error {
 		}
 	}()
 
+	if err := s.recordAPIKeyRevocations(tx, `artifact_uuid = ? AND name = ? AND api_key <> ?`,
+		apiKey.ArtifactUUID, apiKey.Name, apiKey.APIKey); err != nil {
+		s.rollbackTx(tx, "failed to record replaced API key")
+		return err
+	}
+
 	updateQuery := `
 			UPDATE api_keys
 			SET api_key = ?, masked_api_key = ?, status = ?, created_by = ?, updated_at = ?, expires_at = ?,
-			    source = ?, external_ref_id = ?
+			    source = ?, external_ref_id = ?, last_used_at = ?, operations = ?
 			WHERE artifact_uuid = ? AND name = ? AND gateway_id = ?
 		`
 
@@ -2529,6 +2695,8 @@ func (s *sqlStore) UpdateAPIKey(apiKey *models.APIKey) error {
 		apiKey.ExpiresAt,
 		apiKey.Source,
 		apiKey.ExternalRefId,
+		apiKey.LastUsedAt,
+		apiKey.OperationScope(),
 		apiKey.ArtifactUUID,
 		apiKey.Name,
 		s.gatewayId,
@@ -2556,18 +2724,143 @@ func (s *sqlStore) UpdateAPIKey(apiKey *models.APIKey) error {
 	return nil
 }
 
-// DeleteAPIKey removes an API key by its key value
-func (s *sqlStore) DeleteAPIKey(key string) error {
-	query := `DELETE FROM api_keys WHERE api_key = ? AND gateway_id = ?`
+// TouchAPIKeyLastUsed records usage of an active API key without a read-modify-write, so
+// concurrent usage reports and key updates cannot overwrite each other.
+func (s *sqlStore) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	query := `
+		UPDATE api_keys
+		SET last_used_at = ?
+		WHERE uuid = ? AND artifact_uuid = ? AND gateway_id = ? AND status = ?
+		  AND (last_used_at IS NULL OR last_used_at <= ?)
+	`
 
-	result, err := s.exec(query, key, s.gatewayId)
+	result, err := s.exec(query, usedAt, uuid, artifactUUID, s.gatewayId, models.APIKeyStatusActive, staleBefore)
 	if err != nil {
-		return fmt.Errorf("failed to delete API key: %w", err)
+		return false, fmt.Errorf("failed to update API key last-used timestamp: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// RevokeActiveAPIKey marks the key revoked if it is still active and still holds the hash it
+// was read with, so concurrent revocations and a racing regenerate are not overwritten.
+func (s *sqlStore) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	query := `
+		UPDATE api_keys
+		SET status = ?, updated_at = ?
+		WHERE uuid = ? AND artifact_uuid = ? AND gateway_id = ? AND status = ? AND api_key = ?
+	`
+
+	result, err := s.exec(query, models.APIKeyStatusRevoked, apiKey.UpdatedAt, apiKey.UUID,
+		apiKey.ArtifactUUID, s.gatewayId, models.APIKeyStatusActive, apiKey.APIKey)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// recordAPIKeyRevocations copies the hashes of the unexpired local API keys matched by
+// where (a predicate over api_keys) into api_key_revocations. It must run
+// before those rows are deleted or their hashes replaced: signed keys validate without
+// their row, so the published revocation list is the only thing that rejects them.
+// Recording a key that ends up surviving is harmless, since engines consult the list
+// only for keys they do not hold.
+func (s *sqlStore) recordAPIKeyRevocations(e rowExecer, where string, args ...interface{}) error {
+	now := time.Now().UTC()
+	if _, err := e.ExecQ(`DELETE FROM api_key_revocations WHERE gateway_id = ? AND expires_at <= ?`,
+		s.gatewayId, now); err != nil {
+		return fmt.Errorf("failed to prune expired API key revocations: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_key_revocations (gateway_id, api_key, artifact_uuid, expires_at, revoked_at)
+		SELECT gateway_id, api_key, artifact_uuid, expires_at, ?
+		FROM api_keys
+		WHERE gateway_id = ? AND source = ? AND (expires_at IS NULL OR expires_at > ?)
+		  AND NOT EXISTS (
+		    SELECT 1 FROM api_key_revocations r
+		    WHERE r.gateway_id = api_keys.gateway_id AND r.api_key = api_keys.api_key
+		  )
+		  AND ` + where
+	queryArgs := append([]interface{}{now, s.gatewayId, "local", now}, args...)
+	if _, err := e.ExecQ(query, queryArgs...); err != nil && !s.isUniqueViolation(err) {
+		return fmt.Errorf("failed to record API key revocations: %w", err)
+	}
+	return nil
+}
+
+// ListAPIKeyRevocations returns the hashes of revoked local API keys that had not expired by now.
+func (s *sqlStore) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	query := `
+		SELECT api_key FROM api_key_revocations
+		WHERE gateway_id = ? AND (expires_at IS NULL OR expires_at > ?)
+		ORDER BY api_key
+	`
+
+	rows, err := s.query(query, s.gatewayId, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API key revocations: %w", err)
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, fmt.Errorf("failed to scan API key revocation: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, rows.Err()
+}
+
+// deleteAPIKeys records the matched keys as revoked and deletes them in one transaction,
+// returning the number of deleted rows.
+func (s *sqlStore) deleteAPIKeys(where string, args ...interface{}) (int64, error) {
+	tx, err := s.begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			s.rollbackTx(tx, "delete API keys transaction not committed")
+		}
+	}()
+
+	if err := s.recordAPIKeyRevocations(tx, where, args...); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecQ(`DELETE FROM api_keys WHERE gateway_id = ? AND `+where,
+		append([]interface{}{s.gatewayId}, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+	return rows, nil
+}
+
+// DeleteAPIKey removes an API key by its key value
+func (s *sqlStore) DeleteAPIKey(key string) error {
+	rows, err := s.deleteAPIKeys(`api_key = ?`, key)
+	if err != nil {
+		return fmt.Errorf("failed to delete API key: %w", err)
 	}
 
 	if rows == 0 {
@@ -2581,9 +2874,7 @@ func (s *sqlStore) DeleteAPIKey(key string) error {
 
 // RemoveAPIKeysAPI removes an API keys by artifact_uuid
 func (s *sqlStore) RemoveAPIKeysAPI(apiId string) error {
-	query := `DELETE FROM api_keys WHERE artifact_uuid = ? AND gateway_id = ?`
-
-	_, err := s.exec(query, apiId, s.gatewayId)
+	_, err := s.deleteAPIKeys(`artifact_uuid = ?`, apiId)
 	if err != nil {
 		return fmt.Errorf("failed to remove API keys for API: %w", err)
 	}
@@ -2596,18 +2887,11 @@ func (s *sqlStore) RemoveAPIKeysAPI(apiId string) error {
 
 // RemoveAPIKeyAPIAndName removes an API key by its artifact_uuid and name
 func (s *sqlStore) RemoveAPIKeyAPIAndName(apiId, name string) error {
-	query := `DELETE FROM api_keys WHERE artifact_uuid = ? AND name = ? AND gateway_id = ?`
-
-	result, err := s.exec(query, apiId, name, s.gatewayId)
+	rows, err := s.deleteAPIKeys(`artifact_uuid = ? AND name = ?`, apiId, name)
 	if err != nil {
 		return fmt.Errorf("failed to remove API key: %w", err)
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
 	if rows == 0 {
 		return fmt.Errorf("%w: API key not found", ErrNotFound)
 	}
@@ -2766,7 +3050,7 @@ func (s *sqlStore) GetAllAPIKeys() ([]*models.APIKey, error) {
 	query := `
 		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
 		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
-		       ak.issuer, app.application_uuid, app.application_name
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
 		FROM api_keys ak
 		LEFT JOIN application_api_keys aak
 		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
@@ -2785,6 +3069,30 @@ func (s *sqlStore) GetAllAPIKeys() ([]*models.APIKey, error) {
 	return s.scanAPIKeyRows(rows)
 }
 
+// ListExpiredAPIKeys retrieves the active API keys whose expiry time is at or before now
+func (s *sqlStore) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	query := `
+		SELECT ak.uuid, ak.name, ak.api_key, ak.masked_api_key, ak.artifact_uuid, ak.status,
+		       ak.created_at, ak.created_by, ak.updated_at, ak.expires_at, ak.source, ak.external_ref_id,
+		       ak.issuer, ak.last_used_at, ak.operations, app.application_uuid, app.application_name
+		FROM api_keys ak
+		LEFT JOIN application_api_keys aak
+		  ON aak.api_key_id = ak.uuid AND aak.gateway_id = ak.gateway_id
+		LEFT JOIN applications app
+		  ON app.application_uuid = aak.application_uuid AND app.gateway_id = aak.gateway_id
+		WHERE ak.status = 'active' AND ak.gateway_id = ? AND ak.expires_at IS NOT NULL AND ak.expires_at <= ?
+		ORDER BY ak.expires_at
+	`
+
+	rows, err := s.query(query, s.gatewayId, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired API keys: %w", err)
+	}
+	defer rows.Close()
+
+	return s.scanAPIKeyRows(rows)
+}
+
 // scanAPIKeyRows scans rows from a query that returns API key columns
 func (s *sqlStore) scanAPIKeyRows(rows *sql.Rows) ([]*models.APIKey, error) {
 	var apiKeys []*models.APIKey
@@ -2794,6 +3102,7 @@ func (s *sqlStore) scanAPIKeyRows(rows *sql.Rows) ([]*models.APIKey, error) {
 		var expiresAt sql.NullTime
 		var externalRefId sql.NullString
 		var issuer sql.NullString
+		var lastUsedAt sql.NullTime
 		var applicationID sql.NullString
 		var applicationName sql.NullString
 
@@ -2811,6 +3120,8 @@ func (s *sqlStore) scanAPIKeyRows(rows *sql.Rows) ([]*models.APIKey, error) {
 			&apiKey.Source,
 			&externalRefId,
 			&issuer,
+			&lastUsedAt,
+			&apiKey.Operations,
 			&applicationID,
 			&applicationName,
 		)
@@ -2829,6 +3140,9 @@ func (s *sqlStore) scanAPIKeyRows(rows *sql.Rows) ([]*models.APIKey, error) {
 		if issuer.Valid {
 			apiKey.Issuer = &issuer.String
 		}
+		if lastUsedAt.Valid {
+			apiKey.LastUsedAt = &lastUsedAt.Time
+		}
 		if applicationID.Valid {
 			apiKey.ApplicationID = applicationID.String
 		}
@@ -3579,16 +3893,11 @@ func (s *sqlStore) DeleteAPIKeysByUUIDs(uuids []string) error {
 	if len(uuids) == 0 {
 		return nil
 	}
-	placeholders := make([]string, len(uuids))
-	args := make([]interface{}, 0, len(uuids)+1)
-	args = append(args, s.gatewayId)
-	for i, id := range uuids {
-		placeholders[i] = "?"
+	args := make([]interface{}, 0, len(uuids))
+	for _, id := range uuids {
 		args = append(args, id)
 	}
-	query := fmt.Sprintf(`DELETE FROM api_keys WHERE gateway_id = ? AND uuid IN (%s)`,
-		strings.Join(placeholders, ","))
-	_, err := s.exec(query, args...)
+	_, err := s.deleteAPIKeys(fmt.Sprintf(`uuid IN (%s)`, repeatPlaceholders(len(uuids))), args...)
 	if err != nil {
 		return fmt.Errorf("failed to delete API keys by UUIDs: %w", err)
 	}
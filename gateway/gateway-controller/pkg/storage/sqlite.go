@@ -58,8 +58,8 @@ func newSQLiteStorage(dbPath string, logger *slog.Logger) (*SQLiteStorage, error
 		logger: logger,
 	}
 
-	// Initialize schema if needed
-	if err := storage.initSchema(); err != nil {
+	// Create or upgrade the schema
+	if err := RunMigrations(db, logger); err != nil {
 		if closeErr := db.Close(); closeErr != nil {
 			return nil, fmt.Errorf("failed to initialize schema: %w", errors.Join(err, closeErr))
 		}
@@ -73,30 +73,6 @@ func newSQLiteStorage(dbPath string, logger *slog.Logger) (*SQLiteStorage, error
 	return storage, nil
 }
 
-const currentSchemaVersion = 4
-
-// initSchema creates the database schema if it doesn't exist
-func (s *SQLiteStorage) initSchema() error {
-	var version int
-	err := s.db.QueryRow("PRAGMA user_version").Scan(&version)
-	if err != nil {
-		return fmt.Errorf("failed to query schema version: %w", err)
-	}
-
-	if version == 0 {
-		s.logger.Info("Initializing database schema", slog.Int("version", currentSchemaVersion))
-		if _, err := s.db.Exec(schemaSQL); err != nil {
-			return fmt.Errorf("failed to create schema: %w", err)
-		}
-		s.logger.Info("Database schema initialized successfully")
-	} else if version != currentSchemaVersion {
-		return fmt.Errorf("unsupported schema version %d, expected %d; delete the database to recreate", version, currentSchemaVersion)
-	}
-
-	s.logger.Info("Database schema up to date", slog.Int("version", currentSchemaVersion))
-	return nil
-}
-
 func isSQLiteUniqueConstraintError(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed:")
 }
@@ -24,6 +24,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -78,7 +79,7 @@ func TestSQLiteStorage_SchemaInitialization(t *testing.T) {
 	var version int
 	err = storage.db.QueryRow("PRAGMA user_version").Scan(&version)
 	assert.NilError(t, err)
-	assert.Equal(t, version, 4) // Current schema version
+	assert.Equal(t, version, 8) // Current schema version
 
 	// Verify tables exist
 	tables := []string{
@@ -87,6 +88,7 @@ func TestSQLiteStorage_SchemaInitialization(t *testing.T) {
 		"llm_providers",
 		"llm_proxies",
 		"mcp_proxies",
+		"grpc_apis",
 		"certificates",
 		"llm_provider_templates",
 		"api_keys",
@@ -119,15 +121,36 @@ func TestSQLiteStorage_RejectsUnsupportedSchemaVersion(t *testing.T) {
 	assert.NilError(t, err)
 	storage := store.(*sqlStore)
 
-	// Set schema version to an unsupported value
-	_, err = storage.db.Exec("PRAGMA user_version = 5")
+	// Simulate a pre-migration-runner database at an unknown version
+	_, err = storage.db.Exec("DROP TABLE schema_migrations")
+	assert.NilError(t, err)
+	_, err = storage.db.Exec("PRAGMA user_version = 3")
 	assert.NilError(t, err)
 	storage.db.Close()
 
 	// Reopen — should fail with unsupported version error
 	_, err = NewStorage(BackendConfig{Type: "sqlite", SQLitePath: dbPath}, logger)
 	assert.Assert(t, err != nil)
-	assert.ErrorContains(t, err, "failed to initialize schema: unsupported schema version 5, expected 4; delete the database to recreate")
+	assert.ErrorContains(t, err, "failed to initialize schema: unsupported schema version 3, expected 8; delete the database to recreate")
+}
+
+func TestSQLiteStorage_RejectsNewerSchemaVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test_downgrade.db")
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	store, err := NewStorage(BackendConfig{Type: "sqlite", SQLitePath: dbPath}, logger)
+	assert.NilError(t, err)
+	storage := store.(*sqlStore)
+
+	// Simulate a database last written by a newer release
+	_, err = storage.db.Exec("INSERT INTO schema_migrations (version, name) VALUES (99, '0099_future')")
+	assert.NilError(t, err)
+	storage.db.Close()
+
+	_, err = NewStorage(BackendConfig{Type: "sqlite", SQLitePath: dbPath}, logger)
+	assert.Assert(t, err != nil)
+	assert.ErrorContains(t, err, "database schema version 99 is newer than the latest supported version")
 }
 
 func TestSQLiteStorage_DeleteConfig_NotFound(t *testing.T) {
@@ -754,6 +777,36 @@ func TestSQLiteStorage_GetAPIKeyByID_Success(t *testing.T) {
 	assert.Equal(t, retrieved.APIKey, apiKey.APIKey)
 }
 
+func TestSQLiteStorage_APIKeyOperations(t *testing.T) {
+	storage := setupTestStorage(t)
+	defer storage.db.Close()
+
+	config := createTestStoredConfig()
+	assert.NilError(t, storage.SaveConfig(config))
+
+	// Keys saved without operations are not scoped
+	apiKey := createTestAPIKey()
+	apiKey.ArtifactUUID = config.UUID
+	assert.NilError(t, storage.SaveAPIKey(apiKey))
+	retrieved, err := storage.GetAPIKeyByID(apiKey.UUID)
+	assert.NilError(t, err)
+	assert.Equal(t, retrieved.Operations, "*")
+
+	retrieved.Operations = `["GET /users"]`
+	assert.NilError(t, storage.UpdateAPIKey(retrieved))
+	keys, err := storage.GetAPIKeysByAPI(config.UUID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(keys), 1)
+	assert.Equal(t, keys[0].Operations, `["GET /users"]`)
+
+	retrieved.Operations = `["POST /users"]`
+	retrieved.UpdatedAt = retrieved.UpdatedAt.Add(time.Second)
+	assert.NilError(t, storage.UpsertAPIKey(retrieved))
+	upserted, err := storage.GetAPIKeysByAPIAndName(config.UUID, apiKey.Name)
+	assert.NilError(t, err)
+	assert.Equal(t, upserted.Operations, `["POST /users"]`)
+}
+
 func TestSQLiteStorage_GetAPIKeyByKey_NotFound(t *testing.T) {
 	storage := setupTestStorage(t)
 	defer storage.db.Close()
@@ -837,6 +890,104 @@ func TestSQLiteStorage_GetAPIKeysByAPI_Success(t *testing.T) {
 	assert.Assert(t, keyIDs["0000-key2-0000-000000000000"])
 }
 
+func TestSQLiteStorage_SaveAPIKeys_AllOrNothing(t *testing.T) {
+	storage := setupTestStorage(t)
+	defer storage.db.Close()
+
+	config := createTestStoredConfig()
+	err := storage.SaveConfig(config)
+	assert.NilError(t, err)
+
+	newKey := func(name string) *models.APIKey {
+		apiKey := createTestAPIKey()
+		apiKey.UUID = name + "-uuid"
+		apiKey.Name = name
+		apiKey.APIKey = "hash-" + name
+		apiKey.ArtifactUUID = config.UUID
+		return apiKey
+	}
+
+	err = storage.SaveAPIKeys([]*models.APIKey{newKey("bulk-a"), newKey("bulk-b")})
+	assert.NilError(t, err)
+
+	// The last key repeats an existing name, so the whole batch is rejected
+	err = storage.SaveAPIKeys([]*models.APIKey{newKey("bulk-c"), newKey("bulk-d"), newKey("bulk-a")})
+	assert.Assert(t, errors.Is(err, ErrConflict))
+
+	keys, err := storage.GetAPIKeysByAPI(config.UUID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(keys), 2)
+	for _, key := range keys {
+		assert.Assert(t, key.Name == "bulk-a" || key.Name == "bulk-b", "unexpected key %s", key.Name)
+	}
+}
+
+func TestSQLiteStorage_ListAPIKeysByAPI_FiltersAndPaginates(t *testing.T) {
+	storage := setupTestStorage(t)
+	defer storage.db.Close()
+
+	cfg := createTestStoredConfig()
+	assert.NilError(t, storage.SaveConfig(cfg))
+
+	base := time.Now().Add(-time.Hour)
+	save := func(createdBy string, status models.APIKeyStatus, age time.Duration) *models.APIKey {
+		key := createTestAPIKey()
+		key.ArtifactUUID = cfg.UUID
+		key.CreatedBy = createdBy
+		key.Status = status
+		key.CreatedAt = base.Add(-age)
+		assert.NilError(t, storage.SaveAPIKey(key))
+		return key
+	}
+
+	newest := save("alice", models.APIKeyStatusActive, 0)
+	middle := save("alice", models.APIKeyStatusActive, time.Minute)
+	oldest := save("alice", models.APIKeyStatusActive, 2*time.Minute)
+	revoked := save("alice", models.APIKeyStatusRevoked, 3*time.Minute)
+	save("bob", models.APIKeyStatusActive, 4*time.Minute)
+
+	// Scoped to a creator and status, ordered newest first
+	keys, total, err := storage.ListAPIKeysByAPI(cfg.UUID, APIKeyListFilter{
+		CreatedBy: "alice",
+		Status:    models.APIKeyStatusActive,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 3)
+	assert.Equal(t, len(keys), 3)
+	assert.Equal(t, keys[0].UUID, newest.UUID)
+	assert.Equal(t, keys[1].UUID, middle.UUID)
+	assert.Equal(t, keys[2].UUID, oldest.UUID)
+
+	// Page through the same result set; total reflects all matches
+	keys, total, err = storage.ListAPIKeysByAPI(cfg.UUID, APIKeyListFilter{
+		CreatedBy: "alice",
+		Status:    models.APIKeyStatusActive,
+		Limit:     2,
+		Offset:    1,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 3)
+	assert.Equal(t, len(keys), 2)
+	assert.Equal(t, keys[0].UUID, middle.UUID)
+	assert.Equal(t, keys[1].UUID, oldest.UUID)
+
+	// Offset without a limit returns the remainder
+	keys, _, err = storage.ListAPIKeysByAPI(cfg.UUID, APIKeyListFilter{Status: models.APIKeyStatusActive, Offset: 3})
+	assert.NilError(t, err)
+	assert.Equal(t, len(keys), 1)
+	assert.Equal(t, keys[0].CreatedBy, "bob")
+
+	keys, total, err = storage.ListAPIKeysByAPI(cfg.UUID, APIKeyListFilter{Status: models.APIKeyStatusRevoked})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 1)
+	assert.Equal(t, keys[0].UUID, revoked.UUID)
+
+	// An empty filter returns every key for the artifact
+	_, total, err = storage.ListAPIKeysByAPI(cfg.UUID, APIKeyListFilter{})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 5)
+}
+
 func TestLoadAPIKeysFromDatabase_Success(t *testing.T) {
 	storage := setupTestStorage(t)
 	defer storage.db.Close()
@@ -971,6 +1122,53 @@ func TestSQLiteStorage_CountActiveAPIKeysByUserAndAPI_Success(t *testing.T) {
 	assert.Equal(t, count, 1)
 }
 
+func TestSQLiteStorage_RecordsAPIKeyRevocations(t *testing.T) {
+	storage := setupTestStorage(t)
+	defer storage.db.Close()
+
+	config := createTestStoredConfig()
+	assert.NilError(t, storage.SaveConfig(config))
+
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour)
+	newKey := func(source string, expiresAt *time.Time) *models.APIKey {
+		k := createTestAPIKey()
+		k.ArtifactUUID = config.UUID
+		k.Source = source
+		k.ExpiresAt = expiresAt
+		assert.NilError(t, storage.SaveAPIKey(k))
+		return k
+	}
+	regenerated := newKey("local", nil)
+	removed := newKey("local", nil)
+	expired := newKey("local", &past)
+	external := newKey("external", nil)
+	deletedWithAPI := newKey("local", nil)
+
+	oldHash := regenerated.APIKey
+	regenerated.APIKey = "regenerated-hash"
+	assert.NilError(t, storage.UpdateAPIKey(regenerated))
+	assert.NilError(t, storage.RemoveAPIKeyAPIAndName(config.UUID, removed.Name))
+
+	hashes, err := storage.ListAPIKeyRevocations(now)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hashes, sortedStrings(oldHash, removed.APIKey))
+
+	assert.NilError(t, storage.DeleteConfig(config.UUID))
+
+	hashes, err = storage.ListAPIKeyRevocations(now)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hashes, sortedStrings(oldHash, removed.APIKey, regenerated.APIKey, deletedWithAPI.APIKey))
+	for _, h := range hashes {
+		assert.Assert(t, h != expired.APIKey && h != external.APIKey)
+	}
+}
+
+func sortedStrings(values ...string) []string {
+	sort.Strings(values)
+	return values
+}
+
 func TestSQLiteStorage_SaveSubscription_AllowsUndeployedAPI(t *testing.T) {
 	storage := setupTestStorage(t)
 	defer storage.db.Close()
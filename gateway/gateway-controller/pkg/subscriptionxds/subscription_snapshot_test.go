@@ -24,10 +24,12 @@ import (
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
 
 // MockStorage implements the storage.Storage interface for testing.
@@ -109,12 +111,16 @@ func (m *MockStorage) GetLLMProviderTemplateByHandle(handle string) (*models.Sto
 	return nil, nil
 }
 func (m *MockStorage) SaveAPIKey(apiKey *models.APIKey) error                 { return nil }
+func (m *MockStorage) SaveAPIKeys(apiKeys []*models.APIKey) error             { return nil }
 func (m *MockStorage) UpsertAPIKey(apiKey *models.APIKey) error               { return nil }
 func (m *MockStorage) GetAPIKeyByID(id string) (*models.APIKey, error)        { return nil, nil }
 func (m *MockStorage) GetAPIKeyByUUID(uuid string) (*models.APIKey, error)    { return nil, nil }
 func (m *MockStorage) GetAPIKeyByKey(key string) (*models.APIKey, error)      { return nil, nil }
 func (m *MockStorage) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) { return nil, nil }
-func (m *MockStorage) GetAllAPIKeys() ([]*models.APIKey, error)               { return nil, nil }
+func (m *MockStorage) ListAPIKeysByAPI(apiId string, filter storage.APIKeyListFilter) ([]*models.APIKey, int, error) {
+	return nil, 0, nil
+}
+func (m *MockStorage) GetAllAPIKeys() ([]*models.APIKey, error) { return nil, nil }
 func (m *MockStorage) GetAPIKeysByApplicationUUID(applicationUUID string) ([]*models.APIKey, error) {
 	return nil, nil
 }
@@ -122,6 +128,18 @@ func (m *MockStorage) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey
 	return nil, nil
 }
 func (m *MockStorage) UpdateAPIKey(apiKey *models.APIKey) error        { return nil }
+func (m *MockStorage) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+func (m *MockStorage) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *MockStorage) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	return nil, nil
+}
+func (m *MockStorage) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	return false, nil
+}
 func (m *MockStorage) DeleteAPIKey(key string) error                   { return nil }
 func (m *MockStorage) RemoveAPIKeysAPI(apiId string) error             { return nil }
 func (m *MockStorage) RemoveAPIKeyAPIAndName(apiId, name string) error { return nil }
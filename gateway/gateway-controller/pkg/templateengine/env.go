@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package templateengine
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// envReferencePattern matches ${VAR} and ${ENV:VAR}.
+var envReferencePattern = regexp.MustCompile(`\$\{(?:ENV:)?([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// envInterpolatedFields lists the spec fields in which ${VAR} references are
+// resolved, as paths into the spec. "*" matches any key or array index. Other
+// fields are left as written, so policy parameters and the like may contain
+// ${...} literally.
+var envInterpolatedFields = [][]string{
+	{"upstream", "*", "url"},
+	{"upstream", "*", "targets", "*", "url"},
+	{"upstream", "url"},
+	{"upstream", "targets", "*", "url"},
+	{"upstreamDefinitions", "*", "basePath"},
+	{"upstreamDefinitions", "*", "upstreams", "*", "url"},
+	{"vhosts", "*"},
+}
+
+var (
+	envAllowlistMu sync.RWMutex
+	envAllowlist   map[string]struct{}
+)
+
+// SetEnvAllowlist sets the environment variables that ${VAR} references may
+// resolve. References to any other variable fail, so a configuration cannot
+// read arbitrary controller environment such as credentials.
+func SetEnvAllowlist(names []string) {
+	allowed := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		allowed[name] = struct{}{}
+	}
+	envAllowlistMu.Lock()
+	envAllowlist = allowed
+	envAllowlistMu.Unlock()
+}
+
+func envAllowed(name string) bool {
+	envAllowlistMu.RLock()
+	defer envAllowlistMu.RUnlock()
+	_, ok := envAllowlist[name]
+	return ok
+}
+
+// EnvVarError reports a ${VAR} reference that cannot be resolved.
+type EnvVarError struct {
+	Field  string
+	Name   string
+	Reason string
+}
+
+func (e *EnvVarError) Error() string {
+	return fmt.Sprintf("environment variable %q referenced in spec.%s %s", e.Name, e.Field, e.Reason)
+}
+
+// interpolateEnv resolves ${VAR} references in the interpolated fields of a
+// rendered spec. Resolved values are inserted literally.
+func interpolateEnv(val any, path []string) (any, error) {
+	switch v := val.(type) {
+	case string:
+		if !strings.Contains(v, "${") || !isEnvInterpolatedField(path) {
+			return v, nil
+		}
+		return expandEnv(v, path)
+
+	case map[string]any:
+		for key, child := range v {
+			expanded, err := interpolateEnv(child, append(path[:len(path):len(path)], key))
+			if err != nil {
+				return nil, err
+			}
+			v[key] = expanded
+		}
+		return v, nil
+
+	case []any:
+		for i, child := range v {
+			expanded, err := interpolateEnv(child, append(path[:len(path):len(path)], strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			v[i] = expanded
+		}
+		return v, nil
+	}
+	return val, nil
+}
+
+func isEnvInterpolatedField(path []string) bool {
+	for _, field := range envInterpolatedFields {
+		if len(field) != len(path) {
+			continue
+		}
+		matched := true
+		for i, segment := range field {
+			if segment != "*" && segment != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func expandEnv(s string, path []string) (string, error) {
+	var expandErr error
+	expanded := envReferencePattern.ReplaceAllStringFunc(s, func(ref string) string {
+		if expandErr != nil {
+			return ref
+		}
+		name := envReferencePattern.FindStringSubmatch(ref)[1]
+		if !envAllowed(name) {
+			expandErr = &EnvVarError{Field: strings.Join(path, "."), Name: name, Reason: "is not in the allowlist"}
+			return ref
+		}
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			expandErr = &EnvVarError{Field: strings.Join(path, "."), Name: name, Reason: "is not set"}
+			return ref
+		}
+		return value
+	})
+	if expandErr != nil {
+		return "", expandErr
+	}
+	return expanded, nil
+}
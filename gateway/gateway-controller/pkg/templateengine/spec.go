@@ -53,15 +53,16 @@ func (e *RenderError) Unwrap() error {
 	return e.Cause
 }
 
-// RenderSpec renders template expressions in the spec of a StoredConfig.
-// It renders cfg.Configuration in place, leaving cfg.SourceConfiguration untouched (as stored in DB).
+// RenderSpec renders template expressions in the spec of a StoredConfig and
+// resolves ${VAR} references to allowlisted environment variables. It renders cfg.Configuration in place, leaving cfg.SourceConfiguration untouched (as stored in DB).
 // cfg.SensitiveValues is populated with tracked resolved secret values for redaction.
 //
 // Callers must ensure cfg.Configuration holds the value to render before calling. For the deployment
 // flow, both Configuration and SourceConfiguration are equal at the call site. For LLM configs in the
 // event listener, hydration runs first and sets Configuration to a RestAPI, which is then rendered here.
 //
-// Returns *RenderError if rendering fails (e.g. missing secret, malformed template).
+// Returns *RenderError if rendering fails (e.g. missing secret, malformed template,
+// unset environment variable).
 func RenderSpec(cfg *models.StoredConfig, secretResolver funcs.SecretResolver, logger *slog.Logger) error {
 	if cfg.Configuration == nil {
 		return nil
@@ -119,6 +120,13 @@ func renderSpec(config any, secretResolver funcs.SecretResolver, logger *slog.Lo
 		return nil, fmt.Errorf("failed to render spec templates: %w", err)
 	}
 
+	// Resolve ${VAR} references after the templates, so values taken from the
+	// environment are never evaluated as templates.
+	renderedSpec, err = interpolateEnv(renderedSpec, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Replace spec in the map with the rendered version.
 	configMap["spec"] = renderedSpec
 
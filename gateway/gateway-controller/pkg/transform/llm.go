@@ -53,6 +53,11 @@ func NewLLMTransformer(
 	}
 }
 
+// SetPolicyDefinitions swaps the policy definitions used to resolve policy versions.
+func (t *LLMTransformer) SetPolicyDefinitions(policyDefinitions map[string]models.PolicyDefinition) {
+	t.restTransformer.SetPolicyDefinitions(policyDefinitions)
+}
+
 // Transform converts a StoredConfig (LLM Provider or LLM Proxy) into RuntimeDeployConfig.
 func (t *LLMTransformer) Transform(cfg *models.StoredConfig) (*models.RuntimeDeployConfig, error) {
 	// Step 1: Obtain the RestAPI representation.
@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	commonconstants "github.com/wso2/api-platform/common/constants"
@@ -41,6 +42,7 @@ import (
 type RestAPITransformer struct {
 	routerConfig      *config.RouterConfig
 	systemConfig      *config.Config
+	mu                sync.RWMutex // Guards the definitions swapped in by SetPolicyDefinitions
 	policyDefinitions map[string]models.PolicyDefinition
 	latestVersions    map[string]string // pre-computed policyName -> latest full semver
 }
@@ -59,6 +61,22 @@ func NewRestAPITransformer(
 	}
 }
 
+// SetPolicyDefinitions swaps the policy definitions used to resolve policy versions.
+func (t *RestAPITransformer) SetPolicyDefinitions(policyDefinitions map[string]models.PolicyDefinition) {
+	latestVersions := config.BuildLatestVersionIndex(policyDefinitions)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.policyDefinitions = policyDefinitions
+	t.latestVersions = latestVersions
+}
+
+// definitions returns the current policy definitions and their latest-version index
+func (t *RestAPITransformer) definitions() (map[string]models.PolicyDefinition, map[string]string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.policyDefinitions, t.latestVersions
+}
+
 // Transform converts a StoredConfig with RestAPI configuration into a RuntimeDeployConfig.
 // extractProjectID reads project ID from the annotation (preferred) then falls back to the
 // deprecated bare label, logging a warning if only the label is present.
@@ -105,8 +123,9 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 		SensitiveValues:     cfg.SensitiveValues,
 	}
 
-	// Collect validated API-level policies
+	// Collect validated API-level policies and the controller default policies that apply
 	apiPolicies := t.collectAPIPolicies(apiData.Policies)
+	defaultPolicies := t.collectDefaultPolicies(apiData)
 
 	// Determine effective vhosts. vhosts.main may carry several production hostnames separated
 	// by ";" (e.g. when a Gateway-API HTTPRoute attaches to multiple listener hostnames); every
@@ -123,19 +142,26 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 		}
 	}
 
+	// Determine vhosts to create routes for.
+	// Sandbox is active when a sandbox upstream is configured via either url or ref. A
+	// sandbox-only API leaves the main upstream empty and gets no main vhost routes.
+	hasSandbox := config.UpstreamConfigured(apiData.Upstream.Sandbox)
+	hasMain := !hasSandbox || config.UpstreamConfigured(&apiData.Upstream.Main)
+	if !hasMain {
+		mainVhosts = nil
+	}
+
 	// Build main upstream cluster
-	mainUpstream, err := t.addUpstreamCluster(rdc, "main", &apiData.Upstream.Main, apiData.UpstreamDefinitions)
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve main upstream: %w", err)
+	mainUpstream := &upstreamClusterResult{}
+	if hasMain {
+		var err error
+		mainUpstream, err = t.addUpstreamCluster(rdc, "main", &apiData.Upstream.Main, apiData.UpstreamDefinitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve main upstream: %w", err)
+		}
 	}
 	mainUpstreamInfo := mainUpstream.UpstreamInfo()
 
-	// Determine vhosts to create routes for.
-	// Sandbox is active when a sandbox upstream is configured via either url or ref.
-	hasSandbox := apiData.Upstream.Sandbox != nil &&
-		((apiData.Upstream.Sandbox.Url != nil && strings.TrimSpace(*apiData.Upstream.Sandbox.Url) != "") ||
-			(apiData.Upstream.Sandbox.Ref != nil && strings.TrimSpace(*apiData.Upstream.Sandbox.Ref) != ""))
-
 	// Check if dynamic cluster selection should be used. Enabled whenever the API has named
 	// upstream definitions (so a policy can select one) OR a sandbox upstream (so a policy can
 	// redirect between the API's own main/sandbox slots). Must mirror pkg/xds/translator.go's
@@ -177,6 +203,15 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 		return nil, fmt.Errorf("invalid API-level resilience: %w", err)
 	}
 
+	// Per-API body limits apply to every route of the API; zero means no limit.
+	var maxRequestBytes, maxResponseBytes int64
+	if apiData.MaxRequestBytes != nil {
+		maxRequestBytes = *apiData.MaxRequestBytes
+	}
+	if apiData.MaxResponseBytes != nil {
+		maxResponseBytes = *apiData.MaxResponseBytes
+	}
+
 	// Build routes and policy chains for each operation
 	for i, op := range apiData.Operations {
 		// Operation-level resilience overrides API-level (per field); nil leaves the
@@ -186,6 +221,10 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 			return nil, fmt.Errorf("invalid resilience for operation %s %s: %w", op.EffectiveMethod(), op.EffectivePath(), err)
 		}
 		routeTimeout := buildRouteTimeout(opTimeout, apiTimeout, opIdleTimeout, apiIdleTimeout)
+		routeRetry, err := xds.ResolveRouteRetry(apiData.Resilience, op.Resilience)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resilience for operation %s %s: %w", op.EffectiveMethod(), op.EffectivePath(), err)
+		}
 
 		vhosts := append([]string{}, mainVhosts...)
 		if hasSandbox {
@@ -211,26 +250,31 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 			// to the policy engine as the route's compiled-in upstream, regardless of slot.
 			routeMainInfo := mainUpstreamInfo
 			rdcRoute := &models.Route{
-				Method:          method,
-				Path:            xds.ConstructFullPath(apiData.Context, apiData.Version, opPath),
-				OperationPath:   opPath,
-				Vhost:           vhost,
-				AutoHostRewrite: mainAutoHostRewrite,
-				MatchHeaders:    headerMatches,
-				PathMatchType:   pathMatchType,
-				Order:           i,
-				Timeout:         routeTimeout,
+				Method:           method,
+				Path:             xds.ConstructFullPath(apiData.Context, apiData.Version, opPath),
+				OperationPath:    opPath,
+				Vhost:            vhost,
+				AutoHostRewrite:  mainAutoHostRewrite,
+				MatchHeaders:     headerMatches,
+				PathMatchType:    pathMatchType,
+				Order:            i,
+				Timeout:          routeTimeout,
+				Retry:            routeRetry,
+				UpgradeType:      xds.ResolveUpgradeType(op, &apiData.Upstream.Main),
+				MaxRequestBytes:  maxRequestBytes,
+				MaxResponseBytes: maxResponseBytes,
 				Upstream: models.RouteUpstream{
 					ClusterKey:       mainUpstream.ClusterKey,
-					UseClusterHeader: useClusterHeader,
+					UseClusterHeader: useClusterHeader && len(mainUpstream.Weighted) == 0,
 					DefaultCluster:   defaultCluster,
 					Default:          &routeMainInfo,
+					WeightedClusters: mainUpstream.Weighted,
 				},
 			}
 			rdc.Routes[routeKey] = rdcRoute
 
-			// Build policy chain: API-level + operation-level + system policies
-			chain := t.buildPolicyChain(apiPolicies, apiData.Policies, op.Policies)
+			// Build policy chain: default + API-level + operation-level + system policies
+			chain := t.buildPolicyChain(defaultPolicies, apiPolicies, apiData.Policies, op.Policies)
 			injected := utils.InjectSystemPolicies(chain, t.systemConfig, nil)
 			rdc.PolicyChains[routeKey] = sdkChainToModel(injected)
 		}
@@ -312,10 +356,12 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 			routeKey := xds.GenerateRouteNameWithDiscriminator(op.EffectiveMethod(), apiData.Context, apiData.Version, op.EffectivePath(), effectiveSandboxVHost, discriminator)
 			if r, exists := rdc.Routes[routeKey]; exists {
 				r.Upstream.ClusterKey = sbUpstream.ClusterKey
+				r.Upstream.WeightedClusters = sbUpstream.Weighted
 				// Mirror main on sandbox routes: cluster_header lets a dynamic-endpoint policy
 				// divert sandbox traffic, defaulting to the sandbox cluster when none does.
-				r.Upstream.UseClusterHeader = useClusterHeader
-				if useClusterHeader {
+				// Weighted upstreams split traffic themselves and opt out.
+				r.Upstream.UseClusterHeader = useClusterHeader && len(sbUpstream.Weighted) == 0
+				if r.Upstream.UseClusterHeader {
 					// Use ClusterKey (the name Envoy knows the cluster by), not
 					// EnvoyClusterName — see the main-cluster default above.
 					r.Upstream.DefaultCluster = sbUpstream.ClusterKey
@@ -327,6 +373,7 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 				routeSbInfo := sbUpstreamInfo
 				r.Upstream.Default = &routeSbInfo
 				r.AutoHostRewrite = sbAutoHostRewrite
+				r.UpgradeType = xds.ResolveUpgradeType(op, apiData.Upstream.Sandbox)
 			}
 		}
 	}
@@ -403,8 +450,9 @@ func (t *RestAPITransformer) collectAPIPolicies(policies *[]api.Policy) map[stri
 	if policies == nil {
 		return result
 	}
+	policyDefinitions, latestVersions := t.definitions()
 	for _, p := range *policies {
-		resolved, err := config.ResolvePolicyVersion(t.policyDefinitions, t.latestVersions, p.Name, p.Version)
+		resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, p.Name, p.Version)
 		if err != nil {
 			slog.Error("Failed to resolve policy version for API-level policy", "policy_name", p.Name, "error", err)
 			continue
@@ -414,14 +462,41 @@ func (t *RestAPITransformer) collectAPIPolicies(policies *[]api.Policy) map[stri
 	return result
 }
 
-// buildPolicyChain builds a merged list: API-level + operation-level policies (SDK format).
+// collectDefaultPolicies resolves the controller default policies that apply to the API
+// into SDK format, in configuration order.
+func (t *RestAPITransformer) collectDefaultPolicies(apiData api.APIConfigData) []policyenginev1.PolicyInstance {
+	if t.systemConfig == nil {
+		return nil
+	}
+	var result []policyenginev1.PolicyInstance
+	policyDefinitions, latestVersions := t.definitions()
+	for _, p := range t.systemConfig.Controller.DefaultPoliciesFor(apiData) {
+		resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, p.Name, p.Version)
+		if err != nil {
+			slog.Error("Failed to resolve policy version for default policy", "policy_name", p.Name, "error", err)
+			continue
+		}
+		result = append(result, convertAPIPolicyToSDK(p, policyv1alpha.LevelAPI, versionutil.MajorVersion(resolved)))
+	}
+	return result
+}
+
+// buildPolicyChain builds a merged list: default + API-level + operation-level policies (SDK
+// format). A default policy is left out of an operation that lists a policy of the same name.
 func (t *RestAPITransformer) buildPolicyChain(
+	defaultPolicies []policyenginev1.PolicyInstance,
 	apiPolicies map[string]policyenginev1.PolicyInstance,
 	specPolicies *[]api.Policy,
 	opPolicies *[]api.Policy,
 ) []policyenginev1.PolicyInstance {
 	var result []policyenginev1.PolicyInstance
 
+	for _, d := range defaultPolicies {
+		if !config.ListsPolicy(opPolicies, d.Name) {
+			result = append(result, d)
+		}
+	}
+
 	// API-level policies (in spec order, validated via apiPolicies map)
 	if specPolicies != nil {
 		for _, p := range *specPolicies {
@@ -431,15 +506,19 @@ func (t *RestAPITransformer) buildPolicyChain(
 		}
 	}
 
-	// Operation-level policies
+	// Operation-level policies; one with the same name and version as an API-level
+	// policy replaces it
+	apiLevelCount := len(result)
 	if opPolicies != nil {
+		policyDefinitions, latestVersions := t.definitions()
 		for _, opPol := range *opPolicies {
-			resolved, err := config.ResolvePolicyVersion(t.policyDefinitions, t.latestVersions, opPol.Name, opPol.Version)
+			resolved, err := config.ResolvePolicyVersion(policyDefinitions, latestVersions, opPol.Name, opPol.Version)
 			if err != nil {
 				slog.Error("Failed to resolve operation-level policy version", "policy_name", opPol.Name, "error", err)
 				continue
 			}
-			result = append(result, convertAPIPolicyToSDK(opPol, policyv1alpha.LevelRoute, versionutil.MajorVersion(resolved)))
+			result = utils.MergeOperationPolicy(result, apiLevelCount,
+				convertAPIPolicyToSDK(opPol, policyv1alpha.LevelRoute, versionutil.MajorVersion(resolved)))
 		}
 	}
 
@@ -458,6 +537,9 @@ type upstreamClusterResult struct {
 	BasePath string
 	// URL is the resolved upstream origin (scheme://host[:port], no path — see BasePath).
 	URL string
+	// Weighted lists the clusters of a weighted upstream with their shares of traffic. The
+	// fields above then describe the target with the largest weight.
+	Weighted []models.WeightedCluster
 }
 
 // UpstreamInfo converts the resolved cluster result into the shared wire shape
@@ -477,6 +559,10 @@ func (t *RestAPITransformer) addUpstreamCluster(
 	up *api.Upstream,
 	upstreamDefinitions *[]api.UpstreamDefinition,
 ) (*upstreamClusterResult, error) {
+	if up != nil && up.Targets != nil {
+		return t.addWeightedUpstreamClusters(rdc, upstreamName, *up.Targets, up.HealthCheck, up.OutlierDetection)
+	}
+
 	rawURL, refBasePath, err := resolveUpstreamURL(upstreamName, up, upstreamDefinitions)
 	if err != nil {
 		return nil, err
@@ -513,6 +599,15 @@ func (t *RestAPITransformer) addUpstreamCluster(
 		connectTimeout = ct
 	}
 
+	healthCheck, err := resolveHealthCheck(up.HealthCheck, parsedURL.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("%s upstream: %w", upstreamName, err)
+	}
+	outlierDetection, err := resolveOutlierDetection(up.OutlierDetection)
+	if err != nil {
+		return nil, fmt.Errorf("%s upstream: %w", upstreamName, err)
+	}
+
 	clusterKey := fmt.Sprintf("upstream_%s_%s_%d", upstreamName, parsedURL.Hostname(), port)
 
 	rdc.UpstreamClusters[clusterKey] = &models.UpstreamCluster{
@@ -521,8 +616,10 @@ func (t *RestAPITransformer) addUpstreamCluster(
 			Host: parsedURL.Hostname(),
 			Port: port,
 		}},
-		TLS:            &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
-		ConnectTimeout: connectTimeout,
+		TLS:              &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
+		ConnectTimeout:   connectTimeout,
+		HealthCheck:      healthCheck,
+		OutlierDetection: outlierDetection,
 	}
 
 	return &upstreamClusterResult{
@@ -533,6 +630,128 @@ func (t *RestAPITransformer) addUpstreamCluster(
 	}, nil
 }
 
+// addWeightedUpstreamClusters adds a cluster per weighted target. Targets with a zero weight
+// get a cluster but no share of the traffic. The validator guarantees the targets share a
+// path, so the route rewrite derived from the result's BasePath holds for every target.
+func (t *RestAPITransformer) addWeightedUpstreamClusters(
+	rdc *models.RuntimeDeployConfig,
+	upstreamName string,
+	targets []api.UpstreamTarget,
+	healthCheckCfg *api.UpstreamHealthCheck,
+	outlierDetectionCfg *api.UpstreamOutlierDetection,
+) (*upstreamClusterResult, error) {
+	outlierDetection, err := resolveOutlierDetection(outlierDetectionCfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s upstream: %w", upstreamName, err)
+	}
+	var primary *upstreamClusterResult
+	primaryWeight := -1
+	weighted := make([]models.WeightedCluster, 0, len(targets))
+	for i, target := range targets {
+		parsedURL, err := url.Parse(strings.TrimSpace(target.Url))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s upstream target %d URL: %w", upstreamName, i, err)
+		}
+		if parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+			return nil, fmt.Errorf("invalid %s upstream target %d URL: must include host and http/https scheme", upstreamName, i)
+		}
+
+		port := ResolvePort(parsedURL)
+		basePath := parsedURL.Path
+		if basePath == "" {
+			basePath = "/"
+		}
+		healthCheck, err := resolveHealthCheck(healthCheckCfg, parsedURL.Hostname())
+		if err != nil {
+			return nil, fmt.Errorf("%s upstream: %w", upstreamName, err)
+		}
+		clusterKey := fmt.Sprintf("upstream_%s_%s_%d", upstreamName, parsedURL.Hostname(), port)
+		rdc.UpstreamClusters[clusterKey] = &models.UpstreamCluster{
+			BasePath: basePath,
+			Endpoints: []models.Endpoint{{
+				Host: parsedURL.Hostname(),
+				Port: port,
+			}},
+			TLS:              &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
+			HealthCheck:      healthCheck,
+			OutlierDetection: outlierDetection,
+		}
+
+		if target.Weight > 0 {
+			weighted = append(weighted, models.WeightedCluster{ClusterKey: clusterKey, Weight: target.Weight})
+		}
+		if target.Weight > primaryWeight {
+			primaryWeight = target.Weight
+			primary = &upstreamClusterResult{
+				ClusterKey:       clusterKey,
+				EnvoyClusterName: sanitizeEnvoyClusterName(parsedURL.Host, parsedURL.Scheme),
+				BasePath:         basePath,
+				URL:              fmt.Sprintf("%s://%s", parsedURL.Scheme, parsedURL.Host),
+			}
+		}
+	}
+	if len(weighted) == 0 {
+		return nil, fmt.Errorf("%s upstream has no target with a positive weight", upstreamName)
+	}
+	primary.Weighted = weighted
+	return primary, nil
+}
+
+// resolveHealthCheck converts an upstream health check block into the cluster model, filling
+// in defaults. Probes carry the upstream host in the Host header. A nil block yields nil.
+func resolveHealthCheck(hc *api.UpstreamHealthCheck, host string) (*models.HealthCheck, error) {
+	if hc == nil {
+		return nil, nil
+	}
+	interval, timeout, err := config.HealthCheckDurations(hc)
+	if err != nil {
+		return nil, err
+	}
+	resolved := &models.HealthCheck{
+		Path:               hc.Path,
+		Host:               host,
+		Interval:           interval,
+		Timeout:            timeout,
+		UnhealthyThreshold: config.DefaultHealthCheckUnhealthyThreshold,
+		HealthyThreshold:   config.DefaultHealthCheckHealthyThreshold,
+	}
+	if hc.UnhealthyThreshold != nil {
+		resolved.UnhealthyThreshold = *hc.UnhealthyThreshold
+	}
+	if hc.HealthyThreshold != nil {
+		resolved.HealthyThreshold = *hc.HealthyThreshold
+	}
+	if hc.ExpectedStatuses != nil {
+		resolved.ExpectedStatuses = append([]int(nil), *hc.ExpectedStatuses...)
+	}
+	return resolved, nil
+}
+
+// resolveOutlierDetection converts an upstream outlier detection block into the cluster model,
+// filling in defaults. A nil block yields nil.
+func resolveOutlierDetection(od *api.UpstreamOutlierDetection) (*models.OutlierDetection, error) {
+	if od == nil {
+		return nil, nil
+	}
+	interval, baseEjectionTime, err := config.OutlierDetectionDurations(od)
+	if err != nil {
+		return nil, err
+	}
+	resolved := &models.OutlierDetection{
+		Consecutive5xx:     config.DefaultOutlierConsecutive5xx,
+		Interval:           interval,
+		BaseEjectionTime:   baseEjectionTime,
+		MaxEjectionPercent: config.DefaultOutlierMaxEjectionPercent,
+	}
+	if od.Consecutive5xx != nil {
+		resolved.Consecutive5xx = *od.Consecutive5xx
+	}
+	if od.MaxEjectionPercent != nil {
+		resolved.MaxEjectionPercent = *od.MaxEjectionPercent
+	}
+	return resolved, nil
+}
+
 // sanitizeEnvoyClusterName computes the Envoy cluster name from a URL host and scheme,
 // matching the sanitizeClusterName logic in pkg/xds/translator.go.
 func sanitizeEnvoyClusterName(host, scheme string) string {
@@ -19,21 +19,34 @@
 package transform
 
 import (
+	"context"
+	"io"
+	"log/slog"
 	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/xds"
 )
 
 // ptrStr is a helper to get a pointer to a string literal.
 func ptrStr(s string) *string { return &s }
 
+// ptrInt is a helper to get a pointer to an int literal.
+func ptrInt(i int) *int { return &i }
+
 // testRouterCfg returns a minimal RouterConfig for transformer tests.
 func testRouterCfg() *config.RouterConfig {
 	return &config.RouterConfig{
@@ -159,6 +172,98 @@ func TestRestAPITransformer_ResiliencePrecedence(t *testing.T) {
 	})
 }
 
+func TestRestAPITransformer_RetryPrecedence(t *testing.T) {
+	const routeKey = "GET|/test/hello|main.local"
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	apiRetries := &api.Resilience{Retries: &api.ResilienceRetries{Attempts: 2}}
+
+	t.Run("API-level retries use the default conditions", func(t *testing.T) {
+		rdc, err := transformer.Transform(makeRestAPIStoredConfigWithResilience(apiRetries, nil))
+		require.NoError(t, err)
+		assert.Equal(t, &models.RouteRetry{Attempts: 2, RetryOn: constants.DefaultRouteRetryOn}, rdc.Routes[routeKey].Retry)
+	})
+
+	t.Run("operation-level block replaces API-level", func(t *testing.T) {
+		perTry := 20 * time.Second
+		cfg := makeRestAPIStoredConfigWithResilience(apiRetries, &api.Resilience{Retries: &api.ResilienceRetries{
+			Attempts:      1,
+			PerTryTimeout: ptrStr("20s"),
+			RetryOn:       &[]api.ResilienceRetriesRetryOn{api.N5xx, api.Reset},
+		}})
+		rdc, err := transformer.Transform(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, &models.RouteRetry{Attempts: 1, PerTryTimeout: &perTry, RetryOn: "5xx,reset"}, rdc.Routes[routeKey].Retry)
+	})
+
+	t.Run("operation timeout alone keeps API-level retries", func(t *testing.T) {
+		rdc, err := transformer.Transform(makeRestAPIStoredConfigWithResilience(apiRetries, &api.Resilience{Timeout: ptrStr("120s")}))
+		require.NoError(t, err)
+		require.NotNil(t, rdc.Routes[routeKey].Retry)
+		assert.Equal(t, 2, rdc.Routes[routeKey].Retry.Attempts)
+	})
+
+	t.Run("zero attempts turn inherited retries off", func(t *testing.T) {
+		cfg := makeRestAPIStoredConfigWithResilience(apiRetries, &api.Resilience{Retries: &api.ResilienceRetries{Attempts: 0}})
+		rdc, err := transformer.Transform(cfg)
+		require.NoError(t, err)
+		assert.Nil(t, rdc.Routes[routeKey].Retry)
+	})
+}
+
+// TestRestAPITransformer_OperationTimeoutSnapshot verifies that an operation-level timeout and
+// retries reach its Envoy route while the other operations keep the API-level timeout.
+func TestRestAPITransformer_OperationTimeoutSnapshot(t *testing.T) {
+	metrics.Init()
+	routerCfg := testRouterCfg()
+	routerCfg.LuaScriptPath = "../../lua/request_transformation.lua"
+	cfg := makeRestAPIStoredConfigWithResilience(&api.Resilience{Timeout: ptrStr("30s")}, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Operations = append(restAPI.Spec.Operations, api.Operation{
+		Method: api.Ptr(api.OperationMethod("POST")),
+		Path:   ptrStr("/chat/completions"),
+		Resilience: &api.Resilience{
+			Timeout: ptrStr("120s"),
+			Retries: &api.ResilienceRetries{Attempts: 2, PerTryTimeout: ptrStr("60s")},
+		},
+	})
+	cfg.Configuration = restAPI
+	cfg.DesiredState = models.StateDeployed
+
+	store := storage.NewConfigStore()
+	require.NoError(t, store.Add(cfg))
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	sm := xds.NewSnapshotManager(store, logger, routerCfg, nil, &config.Config{Router: *routerCfg})
+	sm.GetTranslator().SetTransformers(map[string]models.ConfigTransformer{
+		string(api.RestAPIKindRestApi): NewRestAPITransformer(routerCfg, &config.Config{}, map[string]models.PolicyDefinition{}),
+	})
+	require.NoError(t, sm.UpdateSnapshot(context.Background(), "test-corr"))
+
+	snap, err := sm.GetCache().GetSnapshot("router-node")
+	require.NoError(t, err)
+
+	actions := map[string]*route.RouteAction{}
+	for _, res := range snap.GetResources(resource.RouteType) {
+		for _, vh := range res.(*route.RouteConfiguration).GetVirtualHosts() {
+			for _, r := range vh.GetRoutes() {
+				actions[r.GetName()] = r.GetRoute()
+			}
+		}
+	}
+
+	chat := actions["POST|/test/chat/completions|main.local"]
+	require.NotNil(t, chat)
+	assert.Equal(t, 120*time.Second, chat.GetTimeout().AsDuration())
+	require.NotNil(t, chat.GetRetryPolicy())
+	assert.Equal(t, uint32(2), chat.GetRetryPolicy().GetNumRetries().GetValue())
+	assert.Equal(t, constants.DefaultRouteRetryOn, chat.GetRetryPolicy().GetRetryOn())
+	assert.Equal(t, 60*time.Second, chat.GetRetryPolicy().GetPerTryTimeout().AsDuration())
+
+	hello := actions["GET|/test/hello|main.local"]
+	require.NotNil(t, hello)
+	assert.Equal(t, 30*time.Second, hello.GetTimeout().AsDuration())
+	assert.Nil(t, hello.GetRetryPolicy())
+}
+
 // findPolicyInChain returns true if any policy chain for the given route key
 // contains a policy with the given name.
 func findPolicyInChain(rdc *models.RuntimeDeployConfig, routeKey, policyName string) bool {
@@ -242,6 +347,47 @@ func TestRestAPITransformer_UnknownPolicySkipped(t *testing.T) {
 		"expected unknown-policy to be excluded from the policy chain")
 }
 
+// TestRestAPITransformer_DefaultPolicies verifies that the controller default policies
+// lead the policy chain, give way to an operation-level policy of the same name, and are
+// left out of an API that excludes them.
+func TestRestAPITransformer_DefaultPolicies(t *testing.T) {
+	defs := map[string]models.PolicyDefinition{
+		"cors|v1.0.0":     {Name: "cors", Version: "v1.0.0"},
+		"log|v1.0.0":      {Name: "log", Version: "v1.0.0"},
+		"jwt-auth|v1.0.0": {Name: "jwt-auth", Version: "v1.0.0"},
+	}
+	systemConfig := &config.Config{Controller: config.Controller{DefaultPolicies: []config.DefaultPolicyConfig{
+		{Name: "cors", Version: "v1"},
+		{Name: "log", Version: "v1"},
+	}}}
+	transformer := NewRestAPITransformer(testRouterCfg(), systemConfig, defs)
+	routeKey := "GET|/test/hello|main.local"
+
+	chainNames := func(rdc *models.RuntimeDeployConfig) []string {
+		var names []string
+		for _, p := range rdc.PolicyChains[routeKey].Policies {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	rdc, err := transformer.Transform(makeRestAPIStoredConfig([]api.Policy{{Name: "jwt-auth", Version: "v1"}}, nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cors", "log", "jwt-auth"}, chainNames(rdc))
+
+	rdc, err = transformer.Transform(makeRestAPIStoredConfig(nil, []api.Policy{{Name: "cors", Version: "v1"}}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"log", "cors"}, chainNames(rdc))
+
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.ExcludedDefaultPolicies = &[]string{"log"}
+	cfg.Configuration = restAPI
+	rdc, err = transformer.Transform(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cors"}, chainNames(rdc))
+}
+
 // TestRestAPITransformer_LatestVersionIndexBuiltOnConstruction verifies that the
 // pre-computed index is populated when the transformer is constructed, meaning
 // repeated Transform calls resolve without re-scanning definitions.
@@ -470,6 +616,80 @@ func TestRestAPITransformer_SandboxRouteClusterHeader(t *testing.T) {
 	})
 }
 
+// TestRestAPITransformer_SandboxOnlyAPI verifies that an API with only a sandbox upstream
+// gets routes and chains on the sandbox vhost and no main route or main cluster.
+func TestRestAPITransformer_SandboxOnlyAPI(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Upstream.Main = api.Upstream{}
+	restAPI.Spec.Upstream.Sandbox = &api.Upstream{Url: ptrStr("http://sandbox-backend:9080")}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	assert.Len(t, rdc.Routes, 1)
+	r, exists := rdc.Routes["GET|/test/hello|sandbox.local"]
+	require.True(t, exists, "sandbox route should exist")
+	assert.Equal(t, "upstream_sandbox_sandbox-backend_9080", r.Upstream.ClusterKey)
+	assert.Contains(t, rdc.PolicyChains, "GET|/test/hello|sandbox.local")
+	assert.Equal(t, []string{"upstream_sandbox_sandbox-backend_9080"}, upstreamClusterKeys(rdc))
+}
+
+// TestRestAPITransformer_WebSocketUpgrade verifies that an operation's upgrade takes
+// precedence and that sandbox routes inherit the sandbox upstream's upgrade, not main's.
+func TestRestAPITransformer_WebSocketUpgrade(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Operations = append(restAPI.Spec.Operations, api.Operation{
+		Method:  api.Ptr(api.OperationMethodGET),
+		Path:    api.Ptr("/socket"),
+		Upgrade: api.Ptr(api.OperationUpgradeWebsocket),
+	})
+	restAPI.Spec.Upstream.Sandbox = &api.Upstream{
+		Url:     ptrStr("http://sandbox-backend:9080"),
+		Upgrade: api.Ptr(api.UpstreamUpgradeWebsocket),
+	}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	want := map[string]string{
+		"GET|/test/hello|main.local":     "",
+		"GET|/test/socket|main.local":    "websocket",
+		"GET|/test/hello|sandbox.local":  "websocket",
+		"GET|/test/socket|sandbox.local": "websocket",
+	}
+	for routeKey, upgradeType := range want {
+		r, exists := rdc.Routes[routeKey]
+		require.True(t, exists, "route %s should exist", routeKey)
+		assert.Equal(t, upgradeType, r.UpgradeType, routeKey)
+	}
+}
+
+func TestRestAPITransformer_BodyLimits(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.MaxRequestBytes = api.Ptr(int64(4096))
+	restAPI.Spec.MaxResponseBytes = api.Ptr(int64(8192))
+	restAPI.Spec.Upstream.Sandbox = &api.Upstream{Url: ptrStr("http://sandbox-backend:9080")}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	for _, routeKey := range []string{"GET|/test/hello|main.local", "GET|/test/hello|sandbox.local"} {
+		r, exists := rdc.Routes[routeKey]
+		require.True(t, exists, "route %s should exist", routeKey)
+		assert.Equal(t, int64(4096), r.MaxRequestBytes, routeKey)
+		assert.Equal(t, int64(8192), r.MaxResponseBytes, routeKey)
+	}
+}
+
 // TestRestAPITransformer_DefaultClusterReferencesRealCluster guards against the cluster-header
 // fallback pointing at a non-existent cluster. translateRuntimeConfig names Envoy clusters by the
 // rdc.UpstreamClusters map key, so every route's DefaultCluster (used when no policy sets the
@@ -911,3 +1131,181 @@ func TestRestAPITransformer_ConnectTimeoutFromDefinition(t *testing.T) {
 		}
 	})
 }
+
+// TestRestAPITransformer_WeightedUpstreamSnapshot verifies that a 90/10 weighted upstream is
+// served by two clusters that the route splits traffic across.
+func TestRestAPITransformer_WeightedUpstreamSnapshot(t *testing.T) {
+	metrics.Init()
+	routerCfg := testRouterCfg()
+	routerCfg.LuaScriptPath = "../../lua/request_transformation.lua"
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Upstream.Main = api.Upstream{Targets: &[]api.UpstreamTarget{
+		{Url: "http://orders-v1:8080/api", Weight: 90},
+		{Url: "https://orders-v2:8443/api", Weight: 10},
+	}}
+	cfg.Configuration = restAPI
+	cfg.DesiredState = models.StateDeployed
+
+	store := storage.NewConfigStore()
+	require.NoError(t, store.Add(cfg))
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	sm := xds.NewSnapshotManager(store, logger, routerCfg, nil, &config.Config{Router: *routerCfg})
+	sm.GetTranslator().SetTransformers(map[string]models.ConfigTransformer{
+		string(api.RestAPIKindRestApi): NewRestAPITransformer(routerCfg, &config.Config{}, map[string]models.PolicyDefinition{}),
+	})
+	require.NoError(t, sm.UpdateSnapshot(context.Background(), "test-corr"))
+
+	snap, err := sm.GetCache().GetSnapshot("router-node")
+	require.NoError(t, err)
+
+	var weighted *route.WeightedCluster
+	for _, res := range snap.GetResources(resource.RouteType) {
+		for _, vh := range res.(*route.RouteConfiguration).GetVirtualHosts() {
+			for _, r := range vh.GetRoutes() {
+				if r.GetName() == "GET|/test/hello|main.local" {
+					weighted = r.GetRoute().GetWeightedClusters()
+				}
+			}
+		}
+	}
+	require.NotNil(t, weighted, "route must split traffic across weighted clusters")
+	shares := map[string]uint32{}
+	for _, c := range weighted.GetClusters() {
+		shares[c.GetName()] = c.GetWeight().GetValue()
+	}
+	assert.Equal(t, map[string]uint32{
+		"upstream_main_orders-v1_8080": 90,
+		"upstream_main_orders-v2_8443": 10,
+	}, shares)
+
+	clusters := snap.GetResources(resource.ClusterType)
+	for name := range shares {
+		assert.Contains(t, clusters, name, "weighted cluster %s must be in the snapshot", name)
+	}
+	assert.NotEmpty(t, clusters["upstream_main_orders-v2_8443"].(*cluster.Cluster).GetTransportSocketMatches(),
+		"the https target must be dialed over TLS")
+	assert.Empty(t, clusters["upstream_main_orders-v1_8080"].(*cluster.Cluster).GetTransportSocketMatches())
+}
+
+// TestRestAPITransformer_WeightedUpstreamSkipsClusterHeader verifies that routes served by
+// weighted targets split traffic themselves, while the sandbox routes of the same API keep
+// dynamic cluster selection.
+func TestRestAPITransformer_WeightedUpstreamSkipsClusterHeader(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.Upstream.Main = api.Upstream{Targets: &[]api.UpstreamTarget{
+		{Url: "http://orders-v1:8080", Weight: 100},
+		{Url: "http://orders-v2:8080", Weight: 0},
+	}}
+	restAPI.Spec.Upstream.Sandbox = &api.Upstream{Url: ptrStr("http://sandbox-backend:9080")}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	mainRoute := rdc.Routes["GET|/test/hello|main.local"]
+	require.NotNil(t, mainRoute)
+	assert.False(t, mainRoute.Upstream.UseClusterHeader)
+	// A zero-weight target keeps its cluster but gets no share of the traffic
+	assert.Equal(t, []models.WeightedCluster{{ClusterKey: "upstream_main_orders-v1_8080", Weight: 100}},
+		mainRoute.Upstream.WeightedClusters)
+	assert.Contains(t, rdc.UpstreamClusters, "upstream_main_orders-v2_8080")
+	assert.Equal(t, "upstream_main_orders-v1_8080", mainRoute.Upstream.ClusterKey)
+
+	sandboxRoute := rdc.Routes["GET|/test/hello|sandbox.local"]
+	require.NotNil(t, sandboxRoute)
+	assert.True(t, sandboxRoute.Upstream.UseClusterHeader)
+	assert.Empty(t, sandboxRoute.Upstream.WeightedClusters)
+}
+
+// TestRestAPITransformer_HealthCheck verifies that an upstream health check reaches the
+// upstream cluster with defaults filled in, and that omitting it leaves the cluster without one.
+func TestRestAPITransformer_HealthCheck(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+	const clusterKey = "upstream_main_backend_8080"
+
+	t.Run("defaults fill unset fields", func(t *testing.T) {
+		cfg := makeRestAPIStoredConfig(nil, nil)
+		restAPI := cfg.Configuration.(api.RestAPI)
+		restAPI.Spec.Upstream.Main.HealthCheck = &api.UpstreamHealthCheck{
+			Path:     "/healthz",
+			Interval: ptrStr("30s"),
+		}
+		cfg.Configuration = restAPI
+
+		rdc, err := transformer.Transform(cfg)
+		require.NoError(t, err)
+		require.Contains(t, rdc.UpstreamClusters, clusterKey)
+		assert.Equal(t, &models.HealthCheck{
+			Path:               "/healthz",
+			Host:               "backend",
+			Interval:           30 * time.Second,
+			Timeout:            config.DefaultHealthCheckTimeout,
+			UnhealthyThreshold: config.DefaultHealthCheckUnhealthyThreshold,
+			HealthyThreshold:   config.DefaultHealthCheckHealthyThreshold,
+		}, rdc.UpstreamClusters[clusterKey].HealthCheck)
+	})
+
+	t.Run("omitted block leaves health checking off", func(t *testing.T) {
+		rdc, err := transformer.Transform(makeRestAPIStoredConfig(nil, nil))
+		require.NoError(t, err)
+		require.Contains(t, rdc.UpstreamClusters, clusterKey)
+		assert.Nil(t, rdc.UpstreamClusters[clusterKey].HealthCheck)
+	})
+}
+
+// TestRestAPITransformer_OutlierDetection verifies that upstream outlier detection reaches every
+// cluster of the upstream with defaults filled in, and that omitting it leaves it off.
+func TestRestAPITransformer_OutlierDetection(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, map[string]models.PolicyDefinition{})
+
+	t.Run("defaults fill unset fields", func(t *testing.T) {
+		cfg := makeRestAPIStoredConfig(nil, nil)
+		restAPI := cfg.Configuration.(api.RestAPI)
+		restAPI.Spec.Upstream.Main.OutlierDetection = &api.UpstreamOutlierDetection{
+			Consecutive5xx: ptrInt(3),
+		}
+		cfg.Configuration = restAPI
+
+		rdc, err := transformer.Transform(cfg)
+		require.NoError(t, err)
+		require.Contains(t, rdc.UpstreamClusters, "upstream_main_backend_8080")
+		assert.Equal(t, &models.OutlierDetection{
+			Consecutive5xx:     3,
+			Interval:           config.DefaultOutlierInterval,
+			BaseEjectionTime:   config.DefaultOutlierBaseEjectionTime,
+			MaxEjectionPercent: config.DefaultOutlierMaxEjectionPercent,
+		}, rdc.UpstreamClusters["upstream_main_backend_8080"].OutlierDetection)
+	})
+
+	t.Run("weighted targets share the configuration", func(t *testing.T) {
+		cfg := makeRestAPIStoredConfig(nil, nil)
+		restAPI := cfg.Configuration.(api.RestAPI)
+		restAPI.Spec.Upstream.Main = api.Upstream{
+			Targets: &[]api.UpstreamTarget{
+				{Url: "http://stable:8080", Weight: 90},
+				{Url: "http://canary:8080", Weight: 10},
+			},
+			OutlierDetection: &api.UpstreamOutlierDetection{BaseEjectionTime: ptrStr("1m")},
+		}
+		cfg.Configuration = restAPI
+
+		rdc, err := transformer.Transform(cfg)
+		require.NoError(t, err)
+		for _, key := range []string{"upstream_main_stable_8080", "upstream_main_canary_8080"} {
+			require.Contains(t, rdc.UpstreamClusters, key)
+			od := rdc.UpstreamClusters[key].OutlierDetection
+			require.NotNil(t, od, key)
+			assert.Equal(t, time.Minute, od.BaseEjectionTime, key)
+		}
+	})
+
+	t.Run("omitted block leaves outlier detection off", func(t *testing.T) {
+		rdc, err := transformer.Transform(makeRestAPIStoredConfig(nil, nil))
+		require.NoError(t, err)
+		require.Contains(t, rdc.UpstreamClusters, "upstream_main_backend_8080")
+		assert.Nil(t, rdc.UpstreamClusters["upstream_main_backend_8080"].OutlierDetection)
+	})
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// ValidateRestAPIContextConflict rejects a REST API whose context and version are already
+// used by another REST API on one of its vhosts; both would be routed under the same base
+// path. Reusing a context with a different version is allowed. currentID is the ID of the
+// configuration being updated, or empty on create. The returned error wraps
+// storage.ErrConflict and names the API that owns the context.
+func ValidateRestAPIContextConflict(db storage.Storage, routerCfg *config.RouterConfig, currentID string, restAPI api.RestAPI) error {
+	existing, err := db.GetAllConfigsByKind(models.KindRestApi)
+	if err != nil {
+		return fmt.Errorf("failed to check existing RestApi context conflict: %w", err)
+	}
+
+	context := normalizeAPIContext(restAPI.Spec.Context)
+	vhosts := restAPIVhosts(restAPI, routerCfg)
+	for _, cfg := range existing {
+		if cfg == nil || cfg.UUID == currentID {
+			continue
+		}
+		other, ok := cfg.Configuration.(api.RestAPI)
+		if !ok || other.Spec.Version != restAPI.Spec.Version || normalizeAPIContext(other.Spec.Context) != context {
+			continue
+		}
+		for vhost := range restAPIVhosts(other, routerCfg) {
+			if _, shared := vhosts[vhost]; shared {
+				return fmt.Errorf("%w: context '%s' with version '%s' is already used by API '%s'",
+					storage.ErrConflict, restAPI.Spec.Context, restAPI.Spec.Version, cfg.Handle)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeAPIContext drops a trailing slash so "/weather" and "/weather/" compare equal
+func normalizeAPIContext(context string) string {
+	if trimmed := strings.TrimRight(context, "/"); trimmed != "" {
+		return trimmed
+	}
+	return "/"
+}
+
+// restAPIVhosts returns the set of vhosts an API is served on, with gateway default
+// sentinels resolved. An API without vhosts maps to the empty vhost when no router
+// configuration is available.
+func restAPIVhosts(restAPI api.RestAPI, routerCfg *config.RouterConfig) map[string]struct{} {
+	// resolveVhostSentinels rewrites the vhosts in place; work on a copy so the caller's
+	// configuration keeps its sentinels
+	if restAPI.Spec.Vhosts != nil {
+		vhostsCopy := *restAPI.Spec.Vhosts
+		restAPI.Spec.Vhosts = &vhostsCopy
+	}
+	var cfg any = restAPI
+	if err := resolveVhostSentinels(&cfg, routerCfg); err == nil {
+		restAPI = cfg.(api.RestAPI)
+	}
+
+	vhosts := map[string]struct{}{}
+	if restAPI.Spec.Vhosts == nil {
+		vhosts[""] = struct{}{}
+		return vhosts
+	}
+	for _, vhost := range strings.Split(restAPI.Spec.Vhosts.Main, ";") {
+		vhosts[strings.ToLower(strings.TrimSpace(vhost))] = struct{}{}
+	}
+	if restAPI.Spec.Vhosts.Sandbox != nil {
+		vhosts[strings.ToLower(strings.TrimSpace(*restAPI.Spec.Vhosts.Sandbox))] = struct{}{}
+	}
+	return vhosts
+}
@@ -30,6 +30,7 @@ import (
 
 	commonconstants "github.com/wso2/api-platform/common/constants"
 	"github.com/wso2/api-platform/common/eventhub"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
@@ -44,7 +45,7 @@ import (
 type APIDeploymentParams struct {
 	Data          []byte        // Raw configuration data (YAML/JSON)
 	ContentType   string        // Content type for parsing
-	Kind          string        // API kind: "RestApi" or "WebSubApi"
+	Kind          string        // API kind: "RestApi", "GrpcApi" or "WebSubApi"
 	APIID         string        // API ID (if provided, used for updates; if empty, generates new UUID)
 	DeploymentID  string        // Platform deployment ID (empty for gateway-api origin)
 	Origin        models.Origin // Origin of the deployment: "control_plane" or "gateway_api"
@@ -77,6 +78,7 @@ type APIDeploymentService struct {
 	snapshotManager *xds.SnapshotManager
 	parser          *config.Parser
 	validator       config.Validator
+	grpcValidator   *config.GrpcAPIValidator
 	routerConfig    *config.RouterConfig
 	httpClient      *http.Client
 	eventHub        eventhub.EventHub
@@ -130,6 +132,7 @@ func NewAPIDeploymentService(
 		snapshotManager: snapshotManager,
 		parser:          config.NewParser(),
 		validator:       validator,
+		grpcValidator:   config.NewGrpcAPIValidator(),
 		httpClient:      &http.Client{Timeout: 10 * time.Second},
 		routerConfig:    routerConfig,
 		eventHub:        eventHub,
@@ -236,6 +239,15 @@ func (s *APIDeploymentService) DeployAPIConfiguration(params APIDeploymentParams
 		kind = string(restConfig.Kind)
 		parsedConfig = restConfig
 		annotationArtifactID = annotationValue(restConfig.Metadata.Annotations, commonconstants.AnnotationArtifactID)
+	case grpcapi.KindGrpcApi:
+		var grpcConfig grpcapi.GrpcAPI
+		if err := s.parser.Parse(params.Data, params.ContentType, &grpcConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse configuration: %w", err)
+		}
+		handle = grpcConfig.Metadata.Name
+		kind = grpcConfig.Kind
+		parsedConfig = grpcConfig
+		annotationArtifactID = annotationValue(grpcConfig.Metadata.Annotations, commonconstants.AnnotationArtifactID)
 	default:
 		if fn, ok := kindDeployParsers[resolvedKind]; ok {
 			var err error
@@ -245,7 +257,7 @@ func (s *APIDeploymentService) DeployAPIConfiguration(params APIDeploymentParams
 			}
 			break
 		}
-		return nil, fmt.Errorf("unsupported resource kind %q: must be \"RestApi\"", resolvedKind)
+		return nil, fmt.Errorf("unsupported resource kind %q: must be \"RestApi\" or \"GrpcApi\"", resolvedKind)
 	}
 
 	// Resolve API ID: explicit param > artifact-id annotation > auto-generate
@@ -328,6 +340,14 @@ func (s *APIDeploymentService) DeployAPIConfiguration(params APIDeploymentParams
 			s.logValidationErrors(params.Logger, apiID, apiName, validationErrors)
 			return nil, &ValidationErrorListError{Errors: validationErrors}
 		}
+	case grpcapi.GrpcAPI:
+		apiName = c.Spec.DisplayName
+		apiVersion = c.Spec.Version
+		validationErrors := s.grpcValidator.Validate(&c)
+		if len(validationErrors) > 0 {
+			s.logValidationErrors(params.Logger, apiID, apiName, validationErrors)
+			return nil, &ValidationErrorListError{Errors: validationErrors}
+		}
 	default:
 		if fn, ok := kindConfigValidators[kind]; ok {
 			var validationErrors []config.ValidationError
@@ -346,6 +366,19 @@ func (s *APIDeploymentService) DeployAPIConfiguration(params APIDeploymentParams
 	if err := s.validateArtifactConflicts(kind, apiID, apiName, apiVersion, handle); err != nil {
 		return nil, err
 	}
+	if restAPI, ok := storedCfg.Configuration.(api.RestAPI); ok {
+		if err := ValidateRestAPIContextConflict(s.db, s.routerConfig, apiID, restAPI); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reject routes that another deployed configuration already serves, rather than
+	// letting the snapshot update drop this configuration after it has been persisted
+	if s.snapshotManager != nil {
+		if err := s.snapshotManager.CheckRouteConflicts(storedCfg); err != nil {
+			return nil, err
+		}
+	}
 
 	// Compute WebSub topic diff BEFORE persisting — ConfigStore.Add populates TopicManager,
 	// so GetTopicsForUpdate must run while the store still has the old state. Runs against
@@ -683,6 +716,13 @@ func resolveVhostSentinels(cfg *any, routerCfg *config.RouterConfig) error {
 			}
 		}
 		*cfg = c
+	case grpcapi.GrpcAPI:
+		// gRPC APIs are served on the main vhost only
+		if c.Spec.Vhost == nil || *c.Spec.Vhost == constants.VHostGatewayDefault {
+			main := routerCfg.VHosts.Main.Default
+			c.Spec.Vhost = &main
+			*cfg = c
+		}
 	default:
 		if fn, ok := kindVhostSentinelResolvers[fmt.Sprintf("%T", c)]; ok {
 			resolved, err := fn(c, routerCfg)
@@ -28,12 +28,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/xds"
 )
 
 func TestNewAPIDeploymentService(t *testing.T) {
@@ -459,6 +461,172 @@ spec:
 	})
 }
 
+func TestDeployAPIConfiguration_ContextConflict(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	routerCfg := &config.RouterConfig{
+		VHosts: config.VHostsConfig{
+			Main: config.VHostEntry{Default: "localhost"},
+		},
+	}
+	service := newTestAPIDeploymentService(storage.NewConfigStore(), newTestMockDB(), nil, config.NewAPIValidator(), routerCfg)
+
+	deploy := func(name, version, context, vhosts string) (*APIDeploymentResult, error) {
+		return service.DeployAPIConfiguration(APIDeploymentParams{
+			Data: []byte(`
+apiVersion: gateway.api-platform.wso2.com/v1
+kind: RestApi
+metadata:
+  name: ` + name + `
+spec:
+  displayName: ` + name + `
+  version: ` + version + `
+  context: ` + context + vhosts + `
+  upstream:
+    main:
+      url: https://example.com
+  operations:
+    - method: GET
+      path: /items
+`),
+			ContentType:   "application/yaml",
+			CorrelationID: "test-corr",
+			Origin:        models.OriginGatewayAPI,
+			Logger:        logger,
+		})
+	}
+
+	_, err := deploy("weather-v1", "v1.0", "/weather/$version", "")
+	require.NoError(t, err)
+
+	t.Run("rejects the same context and version", func(t *testing.T) {
+		_, err := deploy("weather-copy", "v1.0", "/weather/$version", "")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, storage.ErrConflict)
+		assert.Contains(t, err.Error(), "already used by API 'weather-v1'")
+	})
+
+	t.Run("rejects the same context on the explicit default vhost", func(t *testing.T) {
+		_, err := deploy("weather-localhost", "v1.0", "/weather/$version", "\n  vhosts:\n    main: localhost")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, storage.ErrConflict)
+	})
+
+	t.Run("allows the same context with another version", func(t *testing.T) {
+		_, err := deploy("weather-v2", "v2.0", "/weather/$version", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("allows the same context and version on another vhost", func(t *testing.T) {
+		_, err := deploy("weather-partner", "v1.0", "/weather/$version", "\n  vhosts:\n    main: partner.example.com")
+		require.NoError(t, err)
+	})
+}
+
+func TestDeployAPIConfiguration_RouteConflictRejected(t *testing.T) {
+	metrics.Init()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	routerCfg := &config.RouterConfig{
+		ListenerPort: 8080,
+		VHosts: config.VHostsConfig{
+			Main:    config.VHostEntry{Default: "localhost"},
+			Sandbox: config.VHostEntry{Default: "sandbox.localhost"},
+		},
+	}
+	store := storage.NewConfigStore()
+	db := newTestMockDB()
+	snapshotManager := xds.NewSnapshotManager(store, logger, routerCfg, nil, &config.Config{Router: *routerCfg})
+	service := newTestAPIDeploymentService(store, db, snapshotManager, config.NewAPIValidator(), routerCfg)
+
+	deploy := func(name, version string) (*APIDeploymentResult, error) {
+		return service.DeployAPIConfiguration(APIDeploymentParams{
+			Data: []byte(`
+apiVersion: gateway.api-platform.wso2.com/v1
+kind: RestApi
+metadata:
+  name: ` + name + `
+spec:
+  displayName: ` + name + `
+  version: ` + version + `
+  context: /shared
+  upstream:
+    main:
+      url: https://example.com
+  operations:
+    - method: GET
+      path: /items
+`),
+			ContentType:   "application/yaml",
+			CorrelationID: "test-corr",
+			Origin:        models.OriginGatewayAPI,
+			Logger:        logger,
+		})
+	}
+
+	first, err := deploy("first-api", "v1.0")
+	require.NoError(t, err)
+	// The event listener adds deployed configurations to the store
+	require.NoError(t, store.Add(first.StoredConfig))
+
+	// A different version passes the context check, but a context without $version still
+	// yields the same route paths
+	_, err = deploy("second-api", "v2.0")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, storage.ErrConflict)
+	assert.Contains(t, err.Error(), "already served by RestApi 'first-api'")
+}
+
+func TestDeployAPIConfiguration_GrpcAPI(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	routerCfg := &config.RouterConfig{
+		VHosts: config.VHostsConfig{
+			Main: config.VHostEntry{Default: "localhost"},
+		},
+	}
+	service := newTestAPIDeploymentService(storage.NewConfigStore(), newTestMockDB(), nil, config.NewAPIValidator(), routerCfg)
+
+	deploy := func(upstreamURL string) (*APIDeploymentResult, error) {
+		return service.DeployAPIConfiguration(APIDeploymentParams{
+			Data: []byte(`
+apiVersion: gateway.api-platform.wso2.com/v1
+kind: GrpcApi
+metadata:
+  name: greeter
+spec:
+  displayName: Greeter
+  version: v1.0
+  upstream:
+    url: ` + upstreamURL + `
+  services:
+    - name: helloworld.Greeter
+      methods: [SayHello]
+  reflection: true
+`),
+			ContentType:   "application/yaml",
+			CorrelationID: "test-corr",
+			Origin:        models.OriginGatewayAPI,
+			Logger:        logger,
+		})
+	}
+
+	result, err := deploy("http://greeter:50051")
+	require.NoError(t, err)
+	cfg := result.StoredConfig
+	assert.Equal(t, models.KindGrpcApi, cfg.Kind)
+	assert.Equal(t, "greeter", cfg.Handle)
+	assert.Equal(t, "Greeter", cfg.DisplayName)
+	assert.Equal(t, "v1.0", cfg.Version)
+
+	grpcCfg, ok := cfg.SourceConfiguration.(grpcapi.GrpcAPI)
+	require.True(t, ok, "expected grpcapi.GrpcAPI, got %T", cfg.SourceConfiguration)
+	require.NotNil(t, grpcCfg.Spec.Vhost)
+	assert.Equal(t, "localhost", *grpcCfg.Spec.Vhost, "omitted vhost resolves to the main default")
+
+	_, err = deploy("grpc://greeter:50051")
+	var validationErr *ValidationErrorListError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "spec.upstream.url", validationErr.Errors[0].Field)
+}
+
 func TestDeployAPIConfiguration_UnsupportedKind(t *testing.T) {
 	store := storage.NewConfigStore()
 	validator := config.NewAPIValidator()
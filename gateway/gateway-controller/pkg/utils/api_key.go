@@ -34,6 +34,7 @@ import (
 
 	"github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/common/eventhub"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeywebhook"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 
 	commonmodels "github.com/wso2/api-platform/common/models"
@@ -65,6 +66,16 @@ type APIKeyCreationParams struct {
 	// UpdatedAt is the last-updated timestamp from the platform API. When set (external
 	// event path), this value is used instead of time.Now().
 	UpdatedAt *time.Time
+	// RateLimited counts the creation towards the user's creation rate limit. Only the REST
+	// API path sets it; keys pushed by the control plane are never throttled.
+	RateLimited bool
+}
+
+// isExternalKeyInjection reports whether the key is supplied by the caller rather than generated:
+// either pre-computed hashes (from platform API event) or a plain-text API key (from REST API).
+func (p *APIKeyCreationParams) isExternalKeyInjection() bool {
+	return (p.ApiKeyHashes != nil && strings.TrimSpace(*p.ApiKeyHashes) != "") ||
+		(p.Request.ApiKey != nil && strings.TrimSpace(*p.Request.ApiKey) != "")
 }
 
 // APIKeyCreationResult contains the result of API key creation.
@@ -133,6 +144,9 @@ type ListAPIKeyParams struct {
 	Kind          string                    // Artifact kind (e.g. RestApi, LlmProvider, LlmProxy); defaults to RestApi if empty
 	Handle        string                    // API handle/ID
 	User          *commonmodels.AuthContext // User who initiated the request
+	Status        models.APIKeyStatus       // Status filter; defaults to active if empty
+	Limit         int                       // Maximum number of keys to return; 0 returns all
+	Offset        int                       // Number of matching keys to skip
 	CorrelationID string                    // Correlation ID for tracking
 	Logger        *slog.Logger              // Logger instance
 }
@@ -148,6 +162,15 @@ type ParsedAPIKey struct {
 	ID     string
 }
 
+// Errors returned by API key operations. Callers match them with errors.Is.
+var (
+	// ErrAPIKeyLimitExceeded is returned when a user would exceed the per-user API key limit for an API.
+	ErrAPIKeyLimitExceeded = errors.New("API key limit exceeded")
+
+	// ErrInvalidAPIKeyRequest is returned when an API key request fails validation.
+	ErrInvalidAPIKeyRequest = errors.New("invalid API key request")
+)
+
 // XDSManager interface for API key operations
 type XDSManager interface {
 	StoreAPIKey(apiId, apiName, apiVersion string, apiKey *models.APIKey, correlationID string) error
@@ -164,6 +187,12 @@ type APIKeyService struct {
 	apiKeyConfig *config.APIKeyConfig // Configuration for API keys
 	eventHub     eventhub.EventHub
 	gatewayID    string
+	// signedKeyCodec issues signed keys when api_key.mode is "signed"; nil in stateful mode
+	signedKeyCodec *apikey.SignedAPIKeyCodec
+	// creationLimiter caps API key creation attempts per user; nil when the limit is disabled
+	creationLimiter *apiKeyCreationLimiter
+	// webhook is notified of lifecycle operations; nil when the webhook is disabled
+	webhook *apikeywebhook.Notifier
 }
 
 // NewAPIKeyService creates a new API key generation service
@@ -174,13 +203,30 @@ func NewAPIKeyService(store *storage.ConfigStore, db storage.Storage, xdsManager
 	}
 	trimmedGatewayID := requireReplicaSyncWiring("APIKeyService", eventHub, gatewayID)
 
+	var signedKeyCodec *apikey.SignedAPIKeyCodec
+	if apiKeyConfig != nil && apiKeyConfig.Mode == constants.APIKeyModeSigned {
+		// The secret is loaded and length-checked during config validation
+		codec, err := apikey.NewSignedAPIKeyCodec(apiKeyConfig.Prefix, apiKeyConfig.SigningSecret())
+		if err != nil {
+			panic(fmt.Sprintf("APIKeyService requires a valid signing secret in signed mode: %v", err))
+		}
+		signedKeyCodec = codec
+	}
+
+	var creationLimiter *apiKeyCreationLimiter
+	if apiKeyConfig != nil {
+		creationLimiter = newAPIKeyCreationLimiter(apiKeyConfig.CreationRateLimit.MaxKeys, apiKeyConfig.CreationRateLimit.Window)
+	}
+
 	return &APIKeyService{
-		store:        store,
-		db:           db,
-		xdsManager:   xdsManager,
-		apiKeyConfig: apiKeyConfig,
-		eventHub:     eventHub,
-		gatewayID:    trimmedGatewayID,
+		store:           store,
+		db:              db,
+		xdsManager:      xdsManager,
+		apiKeyConfig:    apiKeyConfig,
+		eventHub:        eventHub,
+		gatewayID:       trimmedGatewayID,
+		signedKeyCodec:  signedKeyCodec,
+		creationLimiter: creationLimiter,
 	}
 }
 
@@ -256,15 +302,23 @@ func (s *APIKeyService) CreateAPIKey(params APIKeyCreationParams) (*APIKeyCreati
 	)
 
 	// Determine operation type for context-aware messaging
-	// External key injection occurs when either pre-computed hashes (from platform API event)
-	// or a plain-text API key (from REST API) is provided.
-	isExternalKeyInjection := (params.ApiKeyHashes != nil && strings.TrimSpace(*params.ApiKeyHashes) != "") ||
-		(params.Request.ApiKey != nil && strings.TrimSpace(*params.Request.ApiKey) != "")
+	isExternalKeyInjection := params.isExternalKeyInjection()
 	operationType := "generate"
 	if isExternalKeyInjection {
 		operationType = "register"
 	}
 
+	// Every REST attempt counts towards the creation rate limit, so rejected requests cannot
+	// be used to probe without cost
+	if params.RateLimited {
+		if err := s.creationLimiter.allow(user.UserID, 1); err != nil {
+			logger.Warn("API key creation rate limit exceeded",
+				slog.String("operation", operationType+"_key"),
+				slog.Any("error", err))
+			return nil, err
+		}
+	}
+
 	// Validate that API exists
 	kind := params.Kind
 	if kind == "" {
@@ -345,6 +399,7 @@ func (s *APIKeyService) CreateAPIKey(params APIKeyCreationParams) (*APIKeyCreati
 
 	apiId := config.UUID
 	s.publishAPIKeyEvent("CREATE", apiId, apiKey.UUID, params.CorrelationID, logger)
+	s.webhook.Notify(apikeywebhook.EventTypeCreated, params.Handle, apiKey.Name, user.UserID)
 
 	// Build response following the generated schema
 	result.Response = s.buildAPIKeyResponse(apiKey, params.Handle, plainAPIKey, isExternalKeyInjection)
@@ -404,6 +459,30 @@ func RegisterKindDisplayNameVersionExtractor(resourceKind string, fn KindDisplay
 	kindDisplayNameVersionExtractors[resourceKind] = fn
 }
 
+// revokeStoredAPIKey marks an active API key as revoked, publishes its removal from the
+// policy engine, notifies webhook subscribers on behalf of actor and removes the key from
+// the database. The status change is conditional, so when the key was already revoked or
+// regenerated by someone else this returns false and nothing is published.
+func (s *APIKeyService) revokeStoredAPIKey(apiKey *models.APIKey, apiHandle, actor, correlationID string,
+	logger *slog.Logger) (bool, error) {
+	apiKey.UpdatedAt = time.Now()
+	revoked, err := s.db.RevokeActiveAPIKey(apiKey)
+	if err != nil || !revoked {
+		return false, err
+	}
+	apiKey.Status = models.APIKeyStatusRevoked
+
+	s.publishAPIKeyEvent("DELETE", apiKey.ArtifactUUID, apiKey.UUID, correlationID, logger)
+	s.webhook.Notify(apikeywebhook.EventTypeRevoked, apiHandle, apiKey.Name, actor)
+	// Remove the API key from database (complete removal)
+	// Note: This is cleanup only - the revocation is already complete
+	if err := s.db.RemoveAPIKeyAPIAndName(apiKey.ArtifactUUID, apiKey.Name); err != nil {
+		logger.Warn("Failed to remove API key from database, but revocation was successful",
+			slog.Any("error", err))
+	}
+	return true, nil
+}
+
 // RevokeAPIKey handles the API key revocation process
 // TODO: checks if the index created in policy engine is removed
 func (s *APIKeyService) RevokeAPIKey(params APIKeyRevocationParams) (*APIKeyRevocationResult, error) {
@@ -485,25 +564,11 @@ func (s *APIKeyService) RevokeAPIKey(params APIKeyRevocationParams) (*APIKeyRevo
 		}
 
 		// At this point, all validations passed, proceed with actual revocation
-		// Set status to revoked and update timestamp
-		apiKey.Status = models.APIKeyStatusRevoked
-		apiKey.UpdatedAt = time.Now()
-
-		// Update the API key status in the database
-		if err := s.db.UpdateAPIKey(apiKey); err != nil {
+		if _, err := s.revokeStoredAPIKey(apiKey, params.Handle, user.UserID, params.CorrelationID, logger); err != nil {
 			logger.Error("Failed to update API key status in database",
 				slog.Any("error", err))
 			return nil, fmt.Errorf("failed to revoke API key: %w", err)
 		}
-
-		apiId := config.UUID
-		s.publishAPIKeyEvent("DELETE", apiId, apiKey.UUID, params.CorrelationID, logger)
-		// Remove the API key from database (complete removal)
-		// Note: This is cleanup only - the revocation is already complete
-		if err := s.db.RemoveAPIKeyAPIAndName(config.UUID, apiKey.Name); err != nil {
-			logger.Warn("Failed to remove API key from database, but revocation was successful",
-				slog.Any("error", err))
-		}
 	}
 
 	logger.Info("API key revoked successfully",
@@ -648,6 +713,7 @@ func (s *APIKeyService) UpdateAPIKey(params APIKeyUpdateParams) (*APIKeyUpdateRe
 
 	apiId := config.UUID
 	s.publishAPIKeyEvent("UPDATE", apiId, updatedKey.UUID, params.CorrelationID, logger)
+	s.webhook.Notify(apikeywebhook.EventTypeUpdated, params.Handle, updatedKey.Name, user.UserID)
 
 	// Build response
 	// If API key was updated, use the new masked value; otherwise use existing
@@ -660,14 +726,15 @@ func (s *APIKeyService) UpdateAPIKey(params APIKeyUpdateParams) (*APIKeyUpdateRe
 			Status:  "success",
 			Message: responseMessage,
 			ApiKey: &api.APIKey{
-				Name:      updatedKey.Name,
-				ApiKey:    responseAPIKey,
-				ApiId:     params.Handle,
-				Status:    api.APIKeyStatus(updatedKey.Status),
-				CreatedAt: updatedKey.CreatedAt,
-				CreatedBy: updatedKey.CreatedBy,
-				ExpiresAt: updatedKey.ExpiresAt,
-				Source:    api.APIKeySource(updatedKey.Source),
+				Name:       updatedKey.Name,
+				ApiKey:     responseAPIKey,
+				ApiId:      params.Handle,
+				Status:     api.APIKeyStatus(updatedKey.Status),
+				CreatedAt:  updatedKey.CreatedAt,
+				CreatedBy:  updatedKey.CreatedBy,
+				ExpiresAt:  updatedKey.ExpiresAt,
+				Source:     api.APIKeySource(updatedKey.Source),
+				Operations: apiKeyOperations(updatedKey),
 			},
 		},
 	}
@@ -818,6 +885,7 @@ func (s *APIKeyService) RegenerateAPIKey(params APIKeyRegenerationParams) (*APIK
 
 	apiId := config.UUID
 	s.publishAPIKeyEvent("UPDATE", apiId, regeneratedKey.UUID, params.CorrelationID, logger)
+	s.webhook.Notify(apikeywebhook.EventTypeRegenerated, params.Handle, regeneratedKey.Name, user.UserID)
 
 	// Build and return the response
 	result.Response = s.buildAPIKeyResponse(regeneratedKey, params.Handle, plainAPIKey, false)
@@ -860,7 +928,25 @@ func (s *APIKeyService) ListAPIKeys(params ListAPIKeyParams) (*ListAPIKeyResult,
 		return nil, fmt.Errorf("failed to retrieve API configuration for handle '%s': %w", params.Handle, err)
 	}
 
-	apiKeys, err := s.db.GetAPIKeysByAPI(config.UUID)
+	if user == nil {
+		return nil, fmt.Errorf("failed to filter API keys: user authentication required")
+	}
+
+	filter := storage.APIKeyListFilter{
+		Status: params.Status,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}
+	if filter.Status == "" {
+		filter.Status = models.APIKeyStatusActive
+	}
+	// Non-admin users can only see keys they created; scope the query itself so
+	// pagination and the total count reflect only the caller's keys.
+	if !s.isAdmin(user) {
+		filter.CreatedBy = user.UserID
+	}
+
+	apiKeys, totalCount, err := s.db.ListAPIKeysByAPI(config.UUID, filter)
 	if err != nil {
 		logger.Error("Failed to get API keys from database",
 			slog.Any("error", err),
@@ -869,8 +955,8 @@ func (s *APIKeyService) ListAPIKeys(params ListAPIKeyParams) (*ListAPIKeyResult,
 		return nil, fmt.Errorf("failed to retrieve API keys: %w", err)
 	}
 
-	// Filter API keys by the requesting user (only show keys created by this user)
-	// and only active keys
+	// Re-check ownership on the returned page so visibility never depends solely
+	// on the storage backend honouring the filter
 	userAPIKeys, err := s.filterAPIKeysByUser(user, apiKeys, logger)
 	if err != nil {
 		logger.Error("Failed to filter API keys by user",
@@ -881,17 +967,9 @@ func (s *APIKeyService) ListAPIKeys(params ListAPIKeyParams) (*ListAPIKeyResult,
 		return nil, fmt.Errorf("failed to filter API keys: %w", err)
 	}
 
-	// Filter only active API keys
-	var activeUserAPIKeys []*models.APIKey
-	for _, apiKey := range userAPIKeys {
-		if apiKey.Status == models.APIKeyStatusActive {
-			activeUserAPIKeys = append(activeUserAPIKeys, apiKey)
-		}
-	}
-
 	// Build response API keys
-	var responseAPIKeys []api.APIKey
-	for _, key := range activeUserAPIKeys {
+	responseAPIKeys := make([]api.APIKey, 0, len(userAPIKeys))
+	for _, key := range userAPIKeys {
 		// Return masked API key for display purposes
 		responseAPIKey := api.APIKey{
 			Name:          key.Name,
@@ -903,13 +981,14 @@ func (s *APIKeyService) ListAPIKeys(params ListAPIKeyParams) (*ListAPIKeyResult,
 			ExpiresAt:     key.ExpiresAt,
 			Source:        api.APIKeySource(key.Source),
 			ExternalRefId: key.ExternalRefId,
+			LastUsedAt:    key.LastUsedAt,
+			Operations:    apiKeyOperations(key),
 		}
 		responseAPIKeys = append(responseAPIKeys, responseAPIKey)
 	}
 
 	// Build the list response
 	status := "success"
-	totalCount := len(responseAPIKeys)
 
 	result := &ListAPIKeyResult{
 		Response: api.APIKeyListResponse{
@@ -919,15 +998,41 @@ func (s *APIKeyService) ListAPIKeys(params ListAPIKeyParams) (*ListAPIKeyResult,
 		},
 	}
 
+	if filter.Limit > 0 {
+		if next := max(filter.Offset, 0) + len(apiKeys); next < totalCount {
+			result.Response.NextOffset = &next
+		}
+	}
+
 	logger.Info("API keys listed successfully",
 		slog.String("handle", params.Handle),
 		slog.String("user", user.UserID),
+		slog.String("status_filter", string(filter.Status)),
+		slog.Int("returned_count", len(responseAPIKeys)),
 		slog.Int("total_count", totalCount),
 		slog.String("correlation_id", params.CorrelationID))
 
 	return result, nil
 }
 
+// RecordAPIKeyUsage persists that a policy engine accepted the key with the given UUID for
+// the given artifact at usedAt. Writes are throttled to one per
+// constants.APIKeyLastUsedUpdateInterval per key; updated_at is left untouched so usage does
+// not reorder replica sync events. Unknown and inactive keys are ignored.
+func (s *APIKeyService) RecordAPIKeyUsage(artifactUUID, keyUUID string, usedAt time.Time) error {
+	// Usage times come from the engine's clock; never record one in the future.
+	if now := time.Now(); usedAt.After(now) {
+		usedAt = now
+	}
+	usedAt = usedAt.UTC()
+
+	staleBefore := usedAt.Add(-constants.APIKeyLastUsedUpdateInterval)
+	if _, err := s.db.TouchAPIKeyLastUsed(artifactUUID, keyUUID, usedAt, staleBefore); err != nil {
+		return fmt.Errorf("failed to persist API key last-used timestamp: %w", err)
+	}
+	return nil
+}
+
 // createAPIKeyFromRequest creates a new API key from a request.
 // Handles both local key generation (creates new random key) and external key injection
 // (uses provided key from external platforms).
@@ -972,9 +1077,12 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		// External key injection via platform API event: pre-computed hashes provided, store directly
 		hash, err := extractSHA256Hash(strings.TrimSpace(*apiKeyHashes))
 		if err != nil {
-			return nil, fmt.Errorf("invalid apiKeyHashes: %w", err)
+			return nil, fmt.Errorf("%w: invalid apiKeyHashes: %w", ErrInvalidAPIKeyRequest, err)
+		}
+		hashedAPIKeyValue, err = s.pepperAPIKeyHash(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash API key: %w", err)
 		}
-		hashedAPIKeyValue = hash
 		// Use the masked key sent by the platform API
 		if request.MaskedApiKey != nil {
 			maskedAPIKeyValue = strings.TrimSpace(*request.MaskedApiKey)
@@ -982,19 +1090,8 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		source = "external"
 		isExternalKey = true
 	} else {
-		// Local key generation: generate new random key with our standard format
-		// Format: apip_{64_hex_chars} (32 bytes → hex encoded)
-		plainAPIKeyValue, err = s.generateAPIKeyValue()
-		if err != nil {
-			return nil, err
-		}
-		// Hash the API key for storage and policy engine
-		hashedAPIKeyValue, err = s.hashAPIKey(plainAPIKeyValue)
-		if err != nil {
-			return nil, fmt.Errorf("failed to hash API key: %w", err)
-		}
-		// Generate masked API key for display purposes
-		maskedAPIKeyValue = s.maskAPIKey(plainAPIKeyValue)
+		// Local key generation happens once the expiry is resolved below,
+		// since signed keys embed it
 		source = "local"
 		isExternalKey = false
 	}
@@ -1005,7 +1102,7 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		// User provided a name
 		name = strings.TrimSpace(*request.Name)
 		if err := ValidateAPIKeyName(name); err != nil {
-			return nil, fmt.Errorf("invalid name: %w", err)
+			return nil, fmt.Errorf("%w: invalid name: %w", ErrInvalidAPIKeyRequest, err)
 		}
 	} else {
 		// Generate unique URL-safe name: use handle + short ID portion as base
@@ -1018,6 +1115,16 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		}
 	}
 
+	// Resolve the operation scope; keys are not scoped to operations unless requested
+	var requestedOperations []string
+	if request.Operations != nil {
+		requestedOperations = *request.Operations
+	}
+	operations, err := apikey.FormatOperations(requestedOperations)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid operations: %w", ErrInvalidAPIKeyRequest, err)
+	}
+
 	now := time.Now()
 
 	// Calculate expiration time
@@ -1041,7 +1148,7 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		case api.APIKeyCreationRequestExpiresInUnitMonths:
 			timeDuration *= 30 * 24 * time.Hour // Approximate month as 30 days
 		default:
-			return nil, fmt.Errorf("unsupported expiration unit: %s", request.ExpiresIn.Unit)
+			return nil, fmt.Errorf("%w: unsupported expiration unit: %s", ErrInvalidAPIKeyRequest, request.ExpiresIn.Unit)
 		}
 		expiry := now.Add(timeDuration)
 		expiresAt = &expiry
@@ -1049,8 +1156,23 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 
 	// Validate that expiresAt is in the future
 	if expiresAt != nil && expiresAt.Before(now) {
-		return nil, fmt.Errorf("API key expiration time must be in the future, got: %s (current time: %s)",
-			expiresAt.Format(time.RFC3339), now.Format(time.RFC3339))
+		return nil, fmt.Errorf("%w: API key expiration time must be in the future, got: %s (current time: %s)",
+			ErrInvalidAPIKeyRequest, expiresAt.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	if !isExternalKey {
+		// Format: apip_{64_hex_chars} (32 bytes → hex encoded), or a signed key in signed mode
+		plainAPIKeyValue, err = s.generateLocalAPIKeyValue(keyUUID, config.UUID, operations, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		// Hash the API key for storage and policy engine
+		hashedAPIKeyValue, err = s.hashAPIKey(plainAPIKeyValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash API key: %w", err)
+		}
+		// Generate masked API key for display purposes
+		maskedAPIKeyValue = s.maskAPIKey(plainAPIKeyValue)
 	}
 
 	keyCreatedAt := now
@@ -1074,6 +1196,7 @@ func (s *APIKeyService) createAPIKeyFromRequest(handle string, request *api.APIK
 		UpdatedAt:    keyUpdatedAt,
 		ExpiresAt:    expiresAt,
 		Source:       source, // "local" or "external"
+		Operations:   operations,
 	}
 
 	// Set external reference fields if provided
@@ -1127,6 +1250,16 @@ func (s *APIKeyService) buildAPIKeyResponse(key *models.APIKey, handle string, p
 		remainingQuota = &remaining
 	}
 
+	return api.APIKeyCreationResponse{
+		Status:               "success",
+		Message:              message,
+		RemainingApiKeyQuota: remainingQuota,
+		ApiKey:               apiKeyDetails(key, handle, plainAPIKey, isExternalKeyInjection),
+	}
+}
+
+// apiKeyDetails builds the API key representation returned on creation
+func apiKeyDetails(key *models.APIKey, handle string, plainAPIKey string, isExternalKeyInjection bool) *api.APIKey {
 	// Use plainAPIKey for response if available, otherwise don't return the key
 	var responseAPIKey *string
 	if plainAPIKey != "" && !isExternalKeyInjection {
@@ -1138,23 +1271,26 @@ func (s *APIKeyService) buildAPIKeyResponse(key *models.APIKey, handle string, p
 		responseAPIKey = nil
 	}
 
-	return api.APIKeyCreationResponse{
-		Status:               "success",
-		Message:              message,
-		RemainingApiKeyQuota: remainingQuota,
-		ApiKey: &api.APIKey{
-			Name:      key.Name,
-			ApiKey:    responseAPIKey, // Return plain key only for locally generated keys
-			ApiId:     handle,
-			Status:    api.APIKeyStatus(key.Status),
-			CreatedAt: key.CreatedAt,
-			CreatedBy: key.CreatedBy,
-			ExpiresAt: key.ExpiresAt,
-			Source:    api.APIKeySource(key.Source),
-		},
+	return &api.APIKey{
+		Name:       key.Name,
+		ApiKey:     responseAPIKey, // Return plain key only for locally generated keys
+		ApiId:      handle,
+		Status:     api.APIKeyStatus(key.Status),
+		CreatedAt:  key.CreatedAt,
+		CreatedBy:  key.CreatedBy,
+		ExpiresAt:  key.ExpiresAt,
+		Source:     api.APIKeySource(key.Source),
+		LastUsedAt: key.LastUsedAt,
+		Operations: apiKeyOperations(key),
 	}
 }
 
+// apiKeyOperations returns the operation scope of a key as the entries shown in responses
+func apiKeyOperations(key *models.APIKey) *[]string {
+	operations := apikey.ListOperations(key.OperationScope())
+	return &operations
+}
+
 // updateAPIKeyFromRequest updates an existing API key with a specific provided value
 // Only mutable fields (displayName, api_key value, expiration) can be updated
 // Immutable fields (name, source, createdAt, createdBy) are preserved from existing key
@@ -1180,11 +1316,15 @@ func (s *APIKeyService) updateAPIKeyFromRequest(existingKey *models.APIKey, requ
 		}
 		maskedAPIKeyValue = s.maskAPIKey(plainKey)
 	} else {
-		// Pre-computed hashes from platform API event: store directly
-		hashedAPIKeyValue, err = extractSHA256Hash(strings.TrimSpace(*apiKeyHashes))
+		// Pre-computed hashes from platform API event: pepper and store
+		hash, err := extractSHA256Hash(strings.TrimSpace(*apiKeyHashes))
 		if err != nil {
 			return nil, fmt.Errorf("invalid apiKeyHashes: %w", err)
 		}
+		hashedAPIKeyValue, err = s.pepperAPIKeyHash(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash API key: %w", err)
+		}
 		if request.MaskedApiKey != nil {
 			maskedAPIKeyValue = strings.TrimSpace(*request.MaskedApiKey)
 		}
@@ -1234,6 +1374,15 @@ func (s *APIKeyService) updateAPIKeyFromRequest(existingKey *models.APIKey, requ
 			expiresAt.Format(time.RFC3339), now.Format(time.RFC3339))
 	}
 
+	// Keep the existing operation scope unless the request replaces it
+	operations := existingKey.Operations
+	if request.Operations != nil {
+		operations, err = apikey.FormatOperations(*request.Operations)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid operations: %w", ErrInvalidAPIKeyRequest, err)
+		}
+	}
+
 	keyUpdatedAt := now
 	if updatedAt != nil {
 		keyUpdatedAt = *updatedAt
@@ -1251,6 +1400,7 @@ func (s *APIKeyService) updateAPIKeyFromRequest(existingKey *models.APIKey, requ
 		UpdatedAt:    keyUpdatedAt,
 		ExpiresAt:    expiresAt,
 		Source:       existingKey.Source, // Preserve source from original key.
+		Operations:   operations,
 	}
 
 	return updatedKey, nil
@@ -1259,21 +1409,6 @@ func (s *APIKeyService) updateAPIKeyFromRequest(existingKey *models.APIKey, requ
 // regenerateAPIKey creates a new API key for regeneration based on existing key and request parameters
 func (s *APIKeyService) regenerateAPIKey(existingKey *models.APIKey, request api.APIKeyRegenerationRequest,
 	user string, logger *slog.Logger) (*models.APIKey, error) {
-	// Generate new API key value
-	plainAPIKeyValue, err := s.generateAPIKeyValue()
-	if err != nil {
-		return nil, err
-	}
-
-	// Hash the new API key for storage
-	hashedAPIKeyValue, err := s.hashAPIKey(plainAPIKeyValue)
-	if err != nil {
-		return nil, fmt.Errorf("failed to hash regenerated API key: %w", err)
-	}
-
-	// Generate masked API key for display purposes
-	maskedAPIKeyValue := s.maskAPIKey(plainAPIKeyValue)
-
 	now := time.Now()
 
 	// Determine expiration settings based on request and existing key
@@ -1324,6 +1459,22 @@ func (s *APIKeyService) regenerateAPIKey(existingKey *models.APIKey, request api
 			expiresAt.Format(time.RFC3339), now.Format(time.RFC3339))
 	}
 
+	// Generate new API key value; signed keys embed the expiry resolved above
+	plainAPIKeyValue, err := s.generateLocalAPIKeyValue(existingKey.UUID, existingKey.ArtifactUUID,
+		existingKey.Operations, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash the new API key for storage
+	hashedAPIKeyValue, err := s.hashAPIKey(plainAPIKeyValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash regenerated API key: %w", err)
+	}
+
+	// Generate masked API key for display purposes
+	maskedAPIKeyValue := s.maskAPIKey(plainAPIKeyValue)
+
 	// Create the regenerated API key
 	regeneratedKey := &models.APIKey{
 		UUID:         existingKey.UUID,
@@ -1337,6 +1488,7 @@ func (s *APIKeyService) regenerateAPIKey(existingKey *models.APIKey, request api
 		UpdatedAt:    now,
 		ExpiresAt:    expiresAt,
 		Source:       existingKey.Source, // Preserve source from original key
+		Operations:   existingKey.Operations,
 	}
 
 	// Temporarily store the plain key for response generation
@@ -1458,7 +1610,47 @@ func (s *APIKeyService) generateAPIKeyValue() (string, error) {
 	if _, err := rand.Read(randomBytes); err != nil {
 		return "", fmt.Errorf("failed to generate random bytes: %w", err)
 	}
-	return constants.APIKeyPrefix + hex.EncodeToString(randomBytes), nil
+	return s.apiKeyPrefix() + hex.EncodeToString(randomBytes), nil
+}
+
+// generateLocalAPIKeyValue issues a new key value for a locally generated key,
+// signing it when signed mode is enabled and falling back to a random key otherwise.
+func (s *APIKeyService) generateLocalAPIKeyValue(keyID, artifactUUID, operations string, expiresAt *time.Time) (string, error) {
+	if s.signedKeyCodec != nil {
+		return s.generateSignedAPIKeyValue(keyID, artifactUUID, operations, expiresAt)
+	}
+	return s.generateAPIKeyValue()
+}
+
+// generateSignedAPIKeyValue issues a signed key that carries its key ID, API ID, operation
+// scope and expiry so that it can be verified without a store lookup.
+func (s *APIKeyService) generateSignedAPIKeyValue(keyID, artifactUUID, operations string, expiresAt *time.Time) (string, error) {
+	if s.signedKeyCodec == nil {
+		return "", fmt.Errorf("signed API key mode is not configured")
+	}
+	claims := apikey.SignedAPIKeyClaims{
+		KeyID: keyID,
+		APIID: artifactUUID,
+	}
+	if operations != "" && operations != "*" {
+		claims.Operations = operations
+	}
+	if expiresAt != nil {
+		claims.ExpiresAt = expiresAt.Unix()
+	}
+	signedKey, err := s.signedKeyCodec.Sign(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign API key: %w", err)
+	}
+	return signedKey, nil
+}
+
+// apiKeyPrefix returns the configured prefix for generated keys, falling back to the default.
+func (s *APIKeyService) apiKeyPrefix() string {
+	if s.apiKeyConfig != nil && s.apiKeyConfig.Prefix != "" {
+		return s.apiKeyConfig.Prefix
+	}
+	return constants.APIKeyPrefix
 }
 
 // maskAPIKey returns an 8-character masked representation of the API key:
@@ -1495,15 +1687,32 @@ func extractSHA256Hash(apiKeyHashes string) (string, error) {
 }
 
 // hashAPIKey securely hashes an API key using the configured algorithm
-// Returns the hashed API key that should be stored in database and policy engine
-// If hashing is disabled, returns the plain API key
+// Returns the hashed API key that should be stored in database and policy engine:
+// the SHA-256 hash, keyed with the active pepper when one is configured
 func (s *APIKeyService) hashAPIKey(plainAPIKey string) (string, error) {
 	if plainAPIKey == "" {
 		return "", fmt.Errorf("API key cannot be empty")
 	}
 
 	// Only SHA256 is supported
-	return s.hashAPIKeyWithSHA256(plainAPIKey)
+	hash, err := s.hashAPIKeyWithSHA256(plainAPIKey)
+	if err != nil {
+		return "", err
+	}
+	return s.pepperAPIKeyHash(hash)
+}
+
+// pepperAPIKeyHash keys a SHA-256 API key hash with the active pepper.
+// Returns the hash unchanged when no pepper is configured
+func (s *APIKeyService) pepperAPIKeyHash(sha256Hash string) (string, error) {
+	if s.apiKeyConfig == nil || s.apiKeyConfig.ActivePepper == "" {
+		return sha256Hash, nil
+	}
+	pepper, ok := s.apiKeyConfig.Pepper(s.apiKeyConfig.ActivePepper)
+	if !ok {
+		return "", fmt.Errorf("active pepper %q is not loaded", s.apiKeyConfig.ActivePepper)
+	}
+	return apikey.PepperAPIKeyHash(s.apiKeyConfig.ActivePepper, pepper, sha256Hash), nil
 }
 
 // hashAPIKeyWithSHA256 hashes an API key using plain SHA-256 (no salt)
@@ -1541,10 +1750,28 @@ func (s *APIKeyService) compareAPIKeys(providedAPIKey, storedAPIKey string) bool
 	hash := hasher.Sum(nil)
 	computedHash := hex.EncodeToString(hash)
 
+	// Key it with the pepper the stored hash was made with; a hash naming a pepper
+	// that is no longer configured never matches
+	if pepperID, ok := apikey.PepperIDOf(storedAPIKey); ok {
+		if s.apiKeyConfig == nil {
+			return false
+		}
+		pepper, ok := s.apiKeyConfig.Pepper(pepperID)
+		if !ok {
+			return false
+		}
+		computedHash = apikey.PepperAPIKeyHash(pepperID, pepper, computedHash)
+	}
+
 	// Constant-time comparison with stored hash
 	return subtle.ConstantTimeCompare([]byte(computedHash), []byte(storedAPIKey)) == 1
 }
 
+// SetWebhookNotifier sets the notifier that receives API key lifecycle events
+func (s *APIKeyService) SetWebhookNotifier(notifier *apikeywebhook.Notifier) {
+	s.webhook = notifier
+}
+
 // SetHashingConfig allows updating the hashing configuration at runtime
 func (s *APIKeyService) SetHashingConfig(config *config.APIKeyConfig) {
 	s.apiKeyConfig = config
@@ -1580,8 +1807,8 @@ func (s *APIKeyService) enforceAPIKeyLimit(apiId, userID string, logger *slog.Lo
 			slog.String("user_id", userID),
 			slog.Int("current_count", currentCount),
 			slog.Int("max_allowed", maxAllowed))
-		return fmt.Errorf("API key limit exceeded: user has %d active keys, maximum allowed is %d",
-			currentCount, maxAllowed)
+		return fmt.Errorf("%w: user has %d active keys, maximum allowed is %d",
+			ErrAPIKeyLimitExceeded, currentCount, maxAllowed)
 	}
 
 	logger.Debug("API key limit check passed",
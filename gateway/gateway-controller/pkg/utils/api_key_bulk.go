@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeywebhook"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// APIKeyBulkCreationResult contains the per-item outcome of a bulk API key creation
+type APIKeyBulkCreationResult struct {
+	Response api.APIKeyBulkCreationResponse // Response following the generated schema
+}
+
+// apiKeyQuotaKey identifies the scope of the per-user API key limit
+type apiKeyQuotaKey struct {
+	apiID  string
+	userID string
+}
+
+// CreateAPIKeysBulk creates a batch of API keys in a single transaction.
+// Each item is handled like CreateAPIKey, but the per-user API key limit is enforced across the
+// whole batch and either all keys are saved or none are.
+//
+// When the batch is rejected because of one item (validation, limit, missing API or a repeated
+// name), the returned result reports that item as failed together with the error. A conflict
+// raised by the database is returned with every item reported as not created. Rate-limited
+// items only count towards the creation rate limit once the whole batch has been validated.
+func (s *APIKeyService) CreateAPIKeysBulk(params []APIKeyCreationParams) (*APIKeyBulkCreationResult, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("%w: at least one API key is required", ErrInvalidAPIKeyRequest)
+	}
+	if len(params) > constants.MaxAPIKeysPerBulkRequest {
+		return nil, fmt.Errorf("%w: at most %d API keys can be created in one request, got %d",
+			ErrInvalidAPIKeyRequest, constants.MaxAPIKeysPerBulkRequest, len(params))
+	}
+
+	logger := params[0].Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	correlationID := params[0].CorrelationID
+	logger = logger.With(slog.String("correlation_id", correlationID), slog.Int("count", len(params)))
+
+	results := make([]api.APIKeyBulkCreationItemResult, len(params))
+	for i := range results {
+		results[i] = api.APIKeyBulkCreationItemResult{Index: i, Status: api.NotCreated}
+	}
+	reject := func(index int, err error) (*APIKeyBulkCreationResult, error) {
+		message := err.Error()
+		results[index].Status = api.Failed
+		results[index].Message = &message
+		logger.Warn("Bulk API key creation rejected",
+			slog.Int("index", index),
+			slog.Any("error", err))
+		return &APIKeyBulkCreationResult{Response: api.APIKeyBulkCreationResponse{
+			Status:  "error",
+			Message: fmt.Sprintf("API key at index %d could not be created; no keys were created", index),
+			Results: results,
+		}}, err
+	}
+
+	configs := make(map[string]*models.StoredConfig)
+	activeCounts := make(map[apiKeyQuotaKey]int)
+	batchCounts := make(map[apiKeyQuotaKey]int)
+	names := make(map[[2]string]struct{})
+	apiKeys := make([]*models.APIKey, len(params))
+	maxAllowed := s.apiKeyConfig.APIKeysPerUserPerAPI
+
+	for i := range params {
+		p := &params[i]
+		kind := p.Kind
+		if kind == "" {
+			kind = models.KindRestApi
+		}
+
+		configKey := kind + "/" + p.Handle
+		config, ok := configs[configKey]
+		if !ok {
+			var err error
+			config, err = s.getAPIConfigByHandle(kind, p.Handle)
+			if err != nil {
+				if storage.IsNotFoundError(err) {
+					return reject(i, fmt.Errorf("%w: API configuration handle '%s' not found", storage.ErrNotFound, p.Handle))
+				}
+				logger.Error("Failed to retrieve API configuration for bulk API key creation",
+					slog.String("handle", p.Handle),
+					slog.Any("error", err))
+				return nil, fmt.Errorf("failed to retrieve API configuration for handle '%s': %w", p.Handle, err)
+			}
+			configs[configKey] = config
+		}
+
+		// Keys already held and keys earlier in the batch both count towards the limit
+		quotaKey := apiKeyQuotaKey{apiID: config.UUID, userID: p.User.UserID}
+		activeCount, ok := activeCounts[quotaKey]
+		if !ok {
+			var err error
+			activeCount, err = s.getCurrentAPIKeyCount(config.UUID, p.User.UserID)
+			if err != nil {
+				logger.Error("Failed to count API keys for bulk API key creation",
+					slog.String("api_id", config.UUID),
+					slog.Any("error", err))
+				return nil, err
+			}
+			activeCounts[quotaKey] = activeCount
+		}
+		if activeCount+batchCounts[quotaKey] >= maxAllowed {
+			return reject(i, fmt.Errorf("%w: user has %d active keys and %d earlier in this request, maximum allowed is %d",
+				ErrAPIKeyLimitExceeded, activeCount, batchCounts[quotaKey], maxAllowed))
+		}
+		batchCounts[quotaKey]++
+
+		apiKey, err := s.createAPIKeyFromRequest(p.Handle, &p.Request, p.User.UserID, config, p.UUID, p.ApiKeyHashes, p.CreatedAt, p.UpdatedAt)
+		if err != nil {
+			if errors.Is(err, ErrInvalidAPIKeyRequest) {
+				return reject(i, err)
+			}
+			logger.Error("Failed to generate API key for bulk API key creation",
+				slog.Int("index", i),
+				slog.Any("error", err))
+			return nil, fmt.Errorf("failed to generate API key: %w", err)
+		}
+
+		nameKey := [2]string{config.UUID, apiKey.Name}
+		if _, exists := names[nameKey]; exists {
+			return reject(i, fmt.Errorf("%w: API key name '%s' is repeated in the request", storage.ErrConflict, apiKey.Name))
+		}
+		names[nameKey] = struct{}{}
+		apiKeys[i] = apiKey
+	}
+
+	// Each requested key counts towards its user's creation rate limit
+	attempts := make(map[string]int)
+	for i := range params {
+		if params[i].RateLimited {
+			attempts[params[i].User.UserID]++
+		}
+	}
+	for userID, count := range attempts {
+		if err := s.creationLimiter.allow(userID, count); err != nil {
+			logger.Warn("API key creation rate limit exceeded",
+				slog.String("user_id", userID),
+				slog.Any("error", err))
+			return nil, err
+		}
+	}
+
+	if err := s.db.SaveAPIKeys(apiKeys); err != nil {
+		if storage.IsConflictError(err) {
+			logger.Warn("Bulk API key creation conflicts with existing API keys", slog.Any("error", err))
+			return &APIKeyBulkCreationResult{Response: api.APIKeyBulkCreationResponse{
+				Status:  "error",
+				Message: "An API key name or value already exists; no keys were created",
+				Results: results,
+			}}, err
+		}
+		logger.Error("Failed to save API keys to database", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to save API keys to database: %w", err)
+	}
+
+	for i, apiKey := range apiKeys {
+		plainAPIKey := apiKey.PlainAPIKey // Store plain API key for response
+		apiKey.PlainAPIKey = ""           // Clear plain API key from the struct for security
+
+		s.publishAPIKeyEvent("CREATE", apiKey.ArtifactUUID, apiKey.UUID, params[i].CorrelationID, logger)
+		s.webhook.Notify(apikeywebhook.EventTypeCreated, params[i].Handle, apiKey.Name, params[i].User.UserID)
+
+		results[i].Status = api.Created
+		results[i].ApiKey = apiKeyDetails(apiKey, params[i].Handle, plainAPIKey, params[i].isExternalKeyInjection())
+	}
+
+	response := api.APIKeyBulkCreationResponse{
+		Status:  "success",
+		Message: fmt.Sprintf("%d API keys created successfully", len(apiKeys)),
+		Results: results,
+	}
+	// The remaining quota is only meaningful when the batch targets one API for one user
+	if len(batchCounts) == 1 {
+		for quotaKey, created := range batchCounts {
+			remaining := max(maxAllowed-activeCounts[quotaKey]-created, 0)
+			response.RemainingApiKeyQuota = &remaining
+		}
+	}
+
+	logger.Info("API keys successfully created in bulk")
+
+	return &APIKeyBulkCreationResult{Response: response}, nil
+}
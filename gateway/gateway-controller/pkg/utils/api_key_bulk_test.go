@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonmodels "github.com/wso2/api-platform/common/models"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+type bulkAPIKeyFixture struct {
+	service *APIKeyService
+	db      storage.Storage
+	apiID   string
+	handle  string
+	logger  *slog.Logger
+}
+
+func newBulkAPIKeyFixture(t *testing.T, limit int) *bulkAPIKeyFixture {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := newTestSQLiteStorage(t, logger)
+	cfg := newTestStoredRESTConfig("db-bulk-keys", "partners-api")
+	require.NoError(t, db.SaveConfig(cfg))
+
+	apiKeyConfig := &config.APIKeyConfig{
+		APIKeysPerUserPerAPI: limit,
+		Algorithm:            constants.HashingAlgorithmSHA256,
+	}
+	return &bulkAPIKeyFixture{
+		service: newTestAPIKeyService(storage.NewConfigStore(), db, nil, apiKeyConfig),
+		db:      db,
+		apiID:   cfg.UUID,
+		handle:  cfg.Handle,
+		logger:  logger,
+	}
+}
+
+func (f *bulkAPIKeyFixture) params(userID string, names ...string) []APIKeyCreationParams {
+	params := make([]APIKeyCreationParams, len(names))
+	for i, name := range names {
+		request := api.APIKeyCreationRequest{}
+		if name != "" {
+			request.Name = &name
+		}
+		params[i] = APIKeyCreationParams{
+			Handle:        f.handle,
+			Request:       request,
+			User:          &commonmodels.AuthContext{UserID: userID, Roles: []string{"consumer"}},
+			CorrelationID: "corr-bulk",
+			Logger:        f.logger,
+		}
+	}
+	return params
+}
+
+func (f *bulkAPIKeyFixture) keyCount(t *testing.T) int {
+	t.Helper()
+	keys, err := f.db.GetAPIKeysByAPI(f.apiID)
+	require.NoError(t, err)
+	return len(keys)
+}
+
+func TestCreateAPIKeysBulk_CreatesAllKeys(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 5)
+
+	result, err := f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-a", "", "partner-c"))
+	require.NoError(t, err)
+
+	require.Len(t, result.Response.Results, 3)
+	for i, item := range result.Response.Results {
+		assert.Equal(t, i, item.Index)
+		assert.Equal(t, api.Created, item.Status)
+		require.NotNil(t, item.ApiKey)
+		require.NotNil(t, item.ApiKey.ApiKey)
+	}
+	assert.Equal(t, "partner-a", result.Response.Results[0].ApiKey.Name)
+	require.NotNil(t, result.Response.RemainingApiKeyQuota)
+	assert.Equal(t, 2, *result.Response.RemainingApiKeyQuota)
+	assert.Equal(t, 3, f.keyCount(t))
+}
+
+func TestCreateAPIKeysBulk_LimitSpansTheBatch(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 3)
+	require.NoError(t, f.db.SaveAPIKey(newTestStoredAPIKey(f.apiID, "existing-key", "partner-admin", "local")))
+
+	// One existing key plus three new keys exceeds the limit of three at the last item
+	result, err := f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-a", "partner-b", "partner-c"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAPIKeyLimitExceeded))
+
+	require.NotNil(t, result)
+	assert.Equal(t, api.NotCreated, result.Response.Results[0].Status)
+	assert.Equal(t, api.NotCreated, result.Response.Results[1].Status)
+	assert.Equal(t, api.Failed, result.Response.Results[2].Status)
+	assert.Equal(t, 1, f.keyCount(t))
+
+	// Other users have their own quota
+	_, err = f.service.CreateAPIKeysBulk(f.params("another-admin", "partner-a", "partner-b", "partner-c"))
+	require.NoError(t, err)
+}
+
+func TestCreateAPIKeysBulk_RollsBackOnConflict(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 10)
+	require.NoError(t, f.db.SaveAPIKey(newTestStoredAPIKey(f.apiID, "partner-b", "someone-else", "local")))
+
+	result, err := f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-a", "partner-b", "partner-c"))
+	require.Error(t, err)
+	assert.True(t, storage.IsConflictError(err))
+
+	require.NotNil(t, result)
+	for _, item := range result.Response.Results {
+		assert.Equal(t, api.NotCreated, item.Status)
+	}
+	assert.Equal(t, 1, f.keyCount(t), "keys saved before the conflict must be rolled back")
+}
+
+func TestCreateAPIKeysBulk_RejectsRepeatedNames(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 10)
+
+	result, err := f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-a", "partner-a"))
+	require.Error(t, err)
+	assert.True(t, storage.IsConflictError(err))
+	assert.Equal(t, api.Failed, result.Response.Results[1].Status)
+	assert.Equal(t, 0, f.keyCount(t))
+}
+
+func TestCreateAPIKeysBulk_RejectsInvalidBatches(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 10)
+
+	_, err := f.service.CreateAPIKeysBulk(nil)
+	assert.True(t, errors.Is(err, ErrInvalidAPIKeyRequest))
+
+	tooMany := f.params("partner-admin", make([]string, constants.MaxAPIKeysPerBulkRequest+1)...)
+	_, err = f.service.CreateAPIKeysBulk(tooMany)
+	assert.True(t, errors.Is(err, ErrInvalidAPIKeyRequest))
+
+	result, err := f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-a", "Invalid Name"))
+	assert.True(t, errors.Is(err, ErrInvalidAPIKeyRequest))
+	assert.Equal(t, api.Failed, result.Response.Results[1].Status)
+
+	missing := f.params("partner-admin", "partner-a")
+	missing[0].Handle = "missing-api"
+	_, err = f.service.CreateAPIKeysBulk(missing)
+	assert.True(t, storage.IsNotFoundError(err))
+
+	assert.Equal(t, 0, f.keyCount(t))
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+)
+
+// apiKeyExpirySweeperActor is reported as the actor of revocations made by the expiry sweeper
+const apiKeyExpirySweeperActor = "system:expiry-sweeper"
+
+// RevokeExpiredAPIKeys revokes every active API key whose expiry time is at or before now
+// through the same path as RevokeAPIKey, so the key is published as removed and deleted.
+// Revocation is conditional on the key still being active, so replicas sweeping the same
+// database concurrently revoke, publish and notify each key once. Returns the number of
+// keys this call revoked. Keys that fail to revoke are logged and left for the next run.
+func (s *APIKeyService) RevokeExpiredAPIKeys(now time.Time, logger *slog.Logger) (int, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	apiKeys, err := s.db.ListExpiredAPIKeys(now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired API keys: %w", err)
+	}
+
+	correlationID := "api-key-expiry-" + uuid.NewString()
+	revoked := 0
+	for _, apiKey := range apiKeys {
+		apiHandle := apiKey.ArtifactUUID
+		if cfg, err := s.getArtifactConfigByID(apiKey.ArtifactUUID); err == nil {
+			apiHandle = cfg.Handle
+		}
+
+		ok, err := s.revokeStoredAPIKey(apiKey, apiHandle, apiKeyExpirySweeperActor, correlationID, logger)
+		if err != nil {
+			logger.Error("Failed to revoke expired API key",
+				slog.String("api_id", apiKey.ArtifactUUID),
+				slog.String("api_key_id", apiKey.UUID),
+				slog.Any("error", err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		metrics.APIKeysExpiredTotal.Inc()
+		revoked++
+
+		logger.Info("Revoked expired API key",
+			slog.String("api_id", apiKey.ArtifactUUID),
+			slog.String("api_key_name", apiKey.Name),
+			slog.Time("expires_at", *apiKey.ExpiresAt))
+	}
+
+	return revoked, nil
+}
+
+// APIKeyExpirySweeper periodically revokes API keys that are past their expiry time, so
+// expired keys are removed from the policy engine rather than only rejected on use.
+type APIKeyExpirySweeper struct {
+	service  *APIKeyService
+	interval time.Duration
+	logger   *slog.Logger
+	now      func() time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewAPIKeyExpirySweeper creates a sweeper that runs every interval.
+func NewAPIKeyExpirySweeper(service *APIKeyService, interval time.Duration, logger *slog.Logger) *APIKeyExpirySweeper {
+	return &APIKeyExpirySweeper{
+		service:  service,
+		interval: interval,
+		logger:   logger.With(slog.String("component", "api_key_expiry_sweeper")),
+		now:      time.Now,
+	}
+}
+
+// Start runs an initial sweep and keeps sweeping every interval until Stop is called.
+func (w *APIKeyExpirySweeper) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.sweep()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the background sweep and waits for an in-flight sweep to finish.
+func (w *APIKeyExpirySweeper) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
+
+func (w *APIKeyExpirySweeper) sweep() {
+	revoked, err := w.service.RevokeExpiredAPIKeys(w.now(), w.logger)
+	if err != nil {
+		w.logger.Error("API key expiry sweep failed", slog.Any("error", err))
+		return
+	}
+	if revoked > 0 {
+		w.logger.Info("API key expiry sweep completed", slog.Int("revoked", revoked))
+	}
+}
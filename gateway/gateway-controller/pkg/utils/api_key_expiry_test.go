@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/apikey"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+func newExpiryTestService(t *testing.T) (*APIKeyService, storage.Storage, *mockLLMEventHub, string) {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := newTestSQLiteStorage(t, logger)
+	cfg := newTestStoredRESTConfig("db-expiring-keys", "expiring-api")
+	require.NoError(t, db.SaveConfig(cfg))
+
+	hub := &mockLLMEventHub{}
+	service := NewAPIKeyService(storage.NewConfigStore(), db, nil, &config.APIKeyConfig{
+		APIKeysPerUserPerAPI: 10,
+		Algorithm:            constants.HashingAlgorithmSHA256,
+	}, hub, testGatewayID)
+	return service, db, hub, cfg.UUID
+}
+
+func saveKeyExpiringAt(t *testing.T, db storage.Storage, apiID, name string, expiresAt *time.Time) {
+	t.Helper()
+	key := newTestStoredAPIKey(apiID, name, "alice", "local")
+	key.ExpiresAt = expiresAt
+	require.NoError(t, db.SaveAPIKey(key))
+}
+
+func TestRevokeExpiredAPIKeys(t *testing.T) {
+	service, db, hub, apiID := newExpiryTestService(t)
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	saveKeyExpiringAt(t, db, apiID, "expired-key", &past)
+	saveKeyExpiringAt(t, db, apiID, "valid-key", &future)
+	saveKeyExpiringAt(t, db, apiID, "no-expiry-key", nil)
+
+	expired, err := db.GetAPIKeysByAPIAndName(apiID, "expired-key")
+	require.NoError(t, err)
+
+	revoked, err := service.RevokeExpiredAPIKeys(now, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+
+	// Like a manual revocation, the expired key is removed from the database
+	_, err = db.GetAPIKeysByAPIAndName(apiID, "expired-key")
+	assert.True(t, storage.IsNotFoundError(err), "expected expired key to be removed, got %v", err)
+	for _, name := range []string{"valid-key", "no-expiry-key"} {
+		key, err := db.GetAPIKeysByAPIAndName(apiID, name)
+		require.NoError(t, err)
+		assert.Equal(t, models.APIKeyStatusActive, key.Status, name)
+	}
+
+	// The revocation is published so every replica removes the key from the policy engine
+	require.Len(t, hub.publishedEvents, 1)
+	event := hub.publishedEvents[0].event
+	assert.Equal(t, "DELETE", event.Action)
+	assert.Equal(t, apikey.BuildAPIKeyEntityID(apiID, expired.UUID), event.EntityID)
+
+	// Revoked keys are not swept again
+	revoked, err = service.RevokeExpiredAPIKeys(now, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, revoked)
+	assert.Len(t, hub.publishedEvents, 1)
+}
+
+func TestRevokeExpiredAPIKeys_RevokesEachKeyOnce(t *testing.T) {
+	service, db, hub, apiID := newExpiryTestService(t)
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	saveKeyExpiringAt(t, db, apiID, "expired-key", &past)
+
+	// Another replica listed the key before this one revoked it
+	stale, err := db.ListExpiredAPIKeys(now)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+
+	revoked, err := service.RevokeExpiredAPIKeys(now, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, revoked)
+
+	ok, err := service.revokeStoredAPIKey(stale[0], "expiring-api", apiKeyExpirySweeperActor, "corr", slog.Default())
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Len(t, hub.publishedEvents, 1)
+}
+
+func TestRevokeExpiredAPIKeys_SkipsRegeneratedKey(t *testing.T) {
+	service, db, hub, apiID := newExpiryTestService(t)
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	saveKeyExpiringAt(t, db, apiID, "expired-key", &past)
+
+	stale, err := db.ListExpiredAPIKeys(now)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+
+	// The key is regenerated between listing and revoking
+	regenerated := *stale[0]
+	future := now.Add(time.Hour)
+	regenerated.APIKey = "regenerated-hash"
+	regenerated.ExpiresAt = &future
+	require.NoError(t, db.UpdateAPIKey(&regenerated))
+
+	ok, err := service.revokeStoredAPIKey(stale[0], "expiring-api", apiKeyExpirySweeperActor, "corr", slog.Default())
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, hub.publishedEvents)
+
+	key, err := db.GetAPIKeysByAPIAndName(apiID, "expired-key")
+	require.NoError(t, err)
+	assert.Equal(t, models.APIKeyStatusActive, key.Status)
+}
+
+func TestAPIKeyExpirySweeper(t *testing.T) {
+	service, db, hub, apiID := newExpiryTestService(t)
+	past := time.Now().Add(-time.Hour)
+	saveKeyExpiringAt(t, db, apiID, "expired-key", &past)
+
+	sweeper := NewAPIKeyExpirySweeper(service, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	sweeper.Start()
+	require.Eventually(t, func() bool {
+		_, err := db.GetAPIKeysByAPIAndName(apiID, "expired-key")
+		return storage.IsNotFoundError(err)
+	}, 5*time.Second, 10*time.Millisecond)
+	sweeper.Stop()
+
+	assert.Len(t, hub.publishedEvents, 1)
+}
@@ -19,12 +19,118 @@
 package utils
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 )
 
+// newPepperedAPIKeyConfig returns an API key config with the given peppers loaded from files
+func newPepperedAPIKeyConfig(t *testing.T, activePepper string, peppers map[string]string) *config.APIKeyConfig {
+	t.Helper()
+	dir := t.TempDir()
+	cfg := &config.APIKeyConfig{ActivePepper: activePepper}
+	for id, secret := range peppers {
+		path := filepath.Join(dir, id)
+		if err := os.WriteFile(path, []byte(secret), 0o600); err != nil {
+			t.Fatalf("Failed to write pepper file: %v", err)
+		}
+		cfg.Peppers = append(cfg.Peppers, config.APIKeyPepperConfig{ID: id, File: path})
+	}
+	if err := cfg.LoadPeppers(); err != nil {
+		t.Fatalf("Failed to load peppers: %v", err)
+	}
+	return cfg
+}
+
+func TestPepperedAPIKeyHashing(t *testing.T) {
+	plainKey := "apip_test123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	service := &APIKeyService{apiKeyConfig: newPepperedAPIKeyConfig(t, "p1",
+		map[string]string{"p1": "pepper-one-0123456789abcdef012345"})}
+
+	hashed, err := service.hashAPIKey(plainKey)
+	if err != nil {
+		t.Fatalf("Failed to hash API key with pepper: %v", err)
+	}
+	// The stored hash, which is also what the policy engine receives, is peppered
+	unpepperedHash := apikey.ComputeAPIKeyHash(plainKey)
+	if !strings.HasPrefix(hashed, "$pepper$p1$") || strings.Contains(hashed, unpepperedHash) {
+		t.Errorf("Stored hash should be keyed with pepper p1, got %s", hashed)
+	}
+	if !service.compareAPIKeys(plainKey, hashed) {
+		t.Error("Peppered validation should succeed with correct plain key")
+	}
+	if service.compareAPIKeys(plainKey+"x", hashed) {
+		t.Error("Peppered validation should fail with incorrect plain key")
+	}
+
+	// Without the pepper the stored hash is useless for verification
+	unpeppered := &APIKeyService{apiKeyConfig: &config.APIKeyConfig{}}
+	if unpeppered.compareAPIKeys(plainKey, hashed) {
+		t.Error("Peppered hash should not verify when the pepper is not configured")
+	}
+
+	// Hashes computed by the control plane are peppered before they are stored
+	fromControlPlane, err := service.pepperAPIKeyHash(unpepperedHash)
+	if err != nil {
+		t.Fatalf("Failed to pepper SHA-256 hash: %v", err)
+	}
+	if fromControlPlane != hashed {
+		t.Errorf("Peppering the control plane hash should give the stored hash, got %s", fromControlPlane)
+	}
+}
+
+func TestPepperRotation(t *testing.T) {
+	plainKey := "apip_test123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	peppers := map[string]string{
+		"p1": "pepper-one-0123456789abcdef012345",
+		"p2": "pepper-two-0123456789abcdef012345",
+	}
+
+	// Keys hashed before peppering and under the first pepper
+	service := &APIKeyService{apiKeyConfig: &config.APIKeyConfig{}}
+	legacyHash, err := service.hashAPIKey(plainKey)
+	if err != nil {
+		t.Fatalf("Failed to hash API key: %v", err)
+	}
+	service.SetHashingConfig(newPepperedAPIKeyConfig(t, "p1", map[string]string{"p1": peppers["p1"]}))
+	p1Hash, err := service.hashAPIKey(plainKey)
+	if err != nil {
+		t.Fatalf("Failed to hash API key: %v", err)
+	}
+
+	// Rotate: p2 becomes active while p1 stays configured for existing hashes
+	service.SetHashingConfig(newPepperedAPIKeyConfig(t, "p2", peppers))
+	p2Hash, err := service.hashAPIKey(plainKey)
+	if err != nil {
+		t.Fatalf("Failed to hash API key: %v", err)
+	}
+	if !strings.HasPrefix(p2Hash, "$pepper$p2$") {
+		t.Errorf("New hashes should use the active pepper, got %s", p2Hash)
+	}
+	for name, stored := range map[string]string{"legacy": legacyHash, "p1": p1Hash, "p2": p2Hash} {
+		if !service.compareAPIKeys(plainKey, stored) {
+			t.Errorf("%s hash should verify after rotation", name)
+		}
+	}
+	// Retiring p1 stops hashes made with it from verifying
+	service.SetHashingConfig(newPepperedAPIKeyConfig(t, "p2", map[string]string{"p2": peppers["p2"]}))
+	if service.compareAPIKeys(plainKey, p1Hash) {
+		t.Error("Hash made with a retired pepper should not verify")
+	}
+	if !service.compareAPIKeys(plainKey, p2Hash) {
+		t.Error("Hash made with the active pepper should verify")
+	}
+
+	// A hash claiming the right pepper ID but made with another pepper does not verify
+	if service.compareAPIKeys(plainKey, strings.Replace(p1Hash, "$pepper$p1$", "$pepper$p2$", 1)) {
+		t.Error("Hash should only verify with the pepper it was made with")
+	}
+}
 
 func TestSHA256APIKeyHashing(t *testing.T) {
 	// Create service with SHA256 hashing configuration
@@ -182,7 +288,6 @@ func TestAPIKeyHashingDefaultBehaviorDeterminism(t *testing.T) {
 		t.Error("Default algorithm should produce SHA256 hashes, not plain keys")
 	}
 
-
 	// Both should validate correctly against the same plain key
 	if !service.compareAPIKeys(plainKey, result1) {
 		t.Error("First SHA256 hash should validate correctly")
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrAPIKeyCreationRateLimited is returned when a user creates API keys faster than the configured rate
+var ErrAPIKeyCreationRateLimited = errors.New("API key creation rate limit exceeded")
+
+// APIKeyRateLimitError reports a rejected API key creation and when the user may try again
+type APIKeyRateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *APIKeyRateLimitError) Error() string {
+	return fmt.Sprintf("%s; retry after %s", ErrAPIKeyCreationRateLimited, e.RetryAfter.Round(time.Second))
+}
+
+func (e *APIKeyRateLimitError) Unwrap() error {
+	return ErrAPIKeyCreationRateLimited
+}
+
+// apiKeyCreationLimiter tracks API key creations per user in memory over a sliding window.
+// Counts are per controller replica and reset on restart.
+type apiKeyCreationLimiter struct {
+	mu        sync.Mutex
+	maxKeys   int
+	window    time.Duration
+	now       func() time.Time
+	creations map[string][]time.Time
+	lastSweep time.Time
+}
+
+// newAPIKeyCreationLimiter returns a limiter allowing maxKeys creations per user within window,
+// or nil when the limit is disabled
+func newAPIKeyCreationLimiter(maxKeys int, window time.Duration) *apiKeyCreationLimiter {
+	if maxKeys <= 0 || window <= 0 {
+		return nil
+	}
+	return &apiKeyCreationLimiter{
+		maxKeys:   maxKeys,
+		window:    window,
+		now:       time.Now,
+		creations: make(map[string][]time.Time),
+	}
+}
+
+// allow records n creations for the user if they fit within the window. Rejected attempts are not
+// recorded. A nil limiter allows everything.
+func (l *apiKeyCreationLimiter) allow(userID string, n int) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	cutoff := now.Add(-l.window)
+	l.sweep(now, cutoff)
+
+	recent := pruneCreations(l.creations[userID], cutoff)
+	if len(recent)+n > l.maxKeys {
+		l.creations[userID] = recent
+		// The user may retry once enough recorded creations have left the window
+		retryAfter := l.window
+		if drop := len(recent) + n - l.maxKeys; drop <= len(recent) {
+			retryAfter = recent[drop-1].Add(l.window).Sub(now)
+		}
+		return &APIKeyRateLimitError{RetryAfter: retryAfter}
+	}
+
+	for range n {
+		recent = append(recent, now)
+	}
+	l.creations[userID] = recent
+	return nil
+}
+
+// sweep drops users with no creations inside the window, at most once per window
+func (l *apiKeyCreationLimiter) sweep(now, cutoff time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+	l.lastSweep = now
+	for userID, creations := range l.creations {
+		if len(pruneCreations(creations, cutoff)) == 0 {
+			delete(l.creations, userID)
+		}
+	}
+}
+
+// pruneCreations drops creation times at or before cutoff; times are kept in ascending order
+func pruneCreations(creations []time.Time, cutoff time.Time) []time.Time {
+	i := 0
+	for i < len(creations) && !creations[i].After(cutoff) {
+		i++
+	}
+	return creations[i:]
+}
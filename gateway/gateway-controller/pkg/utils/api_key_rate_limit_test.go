@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// fakeClock is a manually advanced clock for the creation limiter
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestCreationLimiter(maxKeys int, window time.Duration) (*apiKeyCreationLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := newAPIKeyCreationLimiter(maxKeys, window)
+	limiter.now = clock.Now
+	return limiter, clock
+}
+
+func TestAPIKeyCreationLimiter_RejectsOverLimitWithinWindow(t *testing.T) {
+	limiter, clock := newTestCreationLimiter(3, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.allow("alice", 1))
+		clock.advance(10 * time.Minute)
+	}
+
+	err := limiter.allow("alice", 1)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAPIKeyCreationRateLimited))
+	var rateLimitErr *APIKeyRateLimitError
+	require.True(t, errors.As(err, &rateLimitErr))
+	// The first creation leaves the window 30 minutes from now
+	assert.Equal(t, 30*time.Minute, rateLimitErr.RetryAfter)
+
+	// Other users are tracked separately
+	require.NoError(t, limiter.allow("bob", 1))
+}
+
+func TestAPIKeyCreationLimiter_SlidesAndResets(t *testing.T) {
+	limiter, clock := newTestCreationLimiter(2, time.Hour)
+
+	require.NoError(t, limiter.allow("alice", 1))
+	clock.advance(40 * time.Minute)
+	require.NoError(t, limiter.allow("alice", 1))
+	require.Error(t, limiter.allow("alice", 1))
+
+	// Rejected attempts are not recorded, so the first creation frees a slot once it leaves the window
+	clock.advance(20*time.Minute - time.Second)
+	require.Error(t, limiter.allow("alice", 1))
+	clock.advance(time.Second)
+	require.NoError(t, limiter.allow("alice", 1))
+	require.Error(t, limiter.allow("alice", 1))
+
+	// After a full window without creations the limit resets
+	clock.advance(time.Hour)
+	require.NoError(t, limiter.allow("alice", 2))
+}
+
+func TestAPIKeyCreationLimiter_Batches(t *testing.T) {
+	limiter, clock := newTestCreationLimiter(5, time.Hour)
+
+	require.NoError(t, limiter.allow("alice", 3))
+	clock.advance(time.Minute)
+
+	var rateLimitErr *APIKeyRateLimitError
+	require.True(t, errors.As(limiter.allow("alice", 3), &rateLimitErr))
+	assert.Equal(t, 59*time.Minute, rateLimitErr.RetryAfter)
+	require.NoError(t, limiter.allow("alice", 2))
+
+	// A batch larger than the limit can never fit
+	require.True(t, errors.As(limiter.allow("bob", 6), &rateLimitErr))
+	assert.Equal(t, time.Hour, rateLimitErr.RetryAfter)
+}
+
+func TestAPIKeyCreationLimiter_Disabled(t *testing.T) {
+	assert.Nil(t, newAPIKeyCreationLimiter(0, time.Hour))
+	assert.Nil(t, newAPIKeyCreationLimiter(5, 0))
+
+	var limiter *apiKeyCreationLimiter
+	assert.NoError(t, limiter.allow("alice", 100))
+}
+
+func TestAPIKeyCreationLimiter_SweepsIdleUsers(t *testing.T) {
+	limiter, clock := newTestCreationLimiter(1, time.Hour)
+
+	require.NoError(t, limiter.allow("alice", 1))
+	require.NoError(t, limiter.allow("bob", 1))
+	clock.advance(2 * time.Hour)
+	require.NoError(t, limiter.allow("carol", 1))
+
+	assert.NotContains(t, limiter.creations, "alice")
+	assert.NotContains(t, limiter.creations, "bob")
+	assert.Contains(t, limiter.creations, "carol")
+}
+
+// rateLimited marks params as coming from the REST API, the only path the limit applies to
+func rateLimited(params []APIKeyCreationParams) []APIKeyCreationParams {
+	for i := range params {
+		params[i].RateLimited = true
+	}
+	return params
+}
+
+func newRateLimitedAPIKeyFixture(t *testing.T, maxKeys int) (*bulkAPIKeyFixture, *fakeClock) {
+	t.Helper()
+	f := newBulkAPIKeyFixture(t, 100)
+	f.service = newTestAPIKeyService(storage.NewConfigStore(), f.db, nil, &config.APIKeyConfig{
+		APIKeysPerUserPerAPI: 100,
+		Algorithm:            constants.HashingAlgorithmSHA256,
+		CreationRateLimit:    config.APIKeyCreationRateLimitConfig{MaxKeys: maxKeys, Window: time.Hour},
+	})
+	clock := &fakeClock{now: time.Now()}
+	f.service.creationLimiter.now = clock.Now
+	return f, clock
+}
+
+func TestCreateAPIKey_CreationRateLimit(t *testing.T) {
+	f, clock := newRateLimitedAPIKeyFixture(t, 2)
+
+	for _, name := range []string{"partner-a", "partner-b"} {
+		_, err := f.service.CreateAPIKey(rateLimited(f.params("partner-admin", name))[0])
+		require.NoError(t, err)
+	}
+
+	_, err := f.service.CreateAPIKey(rateLimited(f.params("partner-admin", "partner-c"))[0])
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAPIKeyCreationRateLimited))
+	assert.Equal(t, 2, f.keyCount(t))
+
+	// Bulk creation shares the same budget
+	_, err = f.service.CreateAPIKeysBulk(rateLimited(f.params("partner-admin", "partner-d")))
+	assert.True(t, errors.Is(err, ErrAPIKeyCreationRateLimited))
+
+	clock.advance(time.Hour + time.Second)
+	_, err = f.service.CreateAPIKey(rateLimited(f.params("partner-admin", "partner-c"))[0])
+	require.NoError(t, err)
+	assert.Equal(t, 3, f.keyCount(t))
+}
+
+func TestCreateAPIKey_ControlPlaneKeysAreNotRateLimited(t *testing.T) {
+	f, _ := newRateLimitedAPIKeyFixture(t, 1)
+
+	_, err := f.service.CreateAPIKey(rateLimited(f.params("partner-admin", "partner-a"))[0])
+	require.NoError(t, err)
+
+	// Keys pushed by the control plane arrive without the REST flag, even for a throttled user
+	for _, name := range []string{"partner-b", "partner-c"} {
+		_, err := f.service.CreateAPIKey(f.params("partner-admin", name)[0])
+		require.NoError(t, err)
+	}
+	_, err = f.service.CreateAPIKeysBulk(f.params("partner-admin", "partner-d", "partner-e"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, f.keyCount(t))
+}
+
+func TestCreateAPIKeysBulk_RejectedBatchDoesNotUseRateLimit(t *testing.T) {
+	f, _ := newRateLimitedAPIKeyFixture(t, 2)
+
+	_, err := f.service.CreateAPIKeysBulk(rateLimited(f.params("partner-admin", "partner-a", "Invalid Name")))
+	assert.True(t, errors.Is(err, ErrInvalidAPIKeyRequest))
+
+	// The whole budget is still available after the rejected batch
+	_, err = f.service.CreateAPIKeysBulk(rateLimited(f.params("partner-admin", "partner-a", "partner-b")))
+	require.NoError(t, err)
+	assert.Equal(t, 2, f.keyCount(t))
+}
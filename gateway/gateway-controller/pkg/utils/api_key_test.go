@@ -22,11 +22,13 @@ import (
 	"io"
 	"log/slog"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/api-platform/common/apikey"
 	commonmodels "github.com/wso2/api-platform/common/models"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
@@ -212,6 +214,57 @@ func TestGenerateAPIKeyValue(t *testing.T) {
 	})
 }
 
+func TestGenerateAPIKeyValue_ConfiguredPrefix(t *testing.T) {
+	service := &APIKeyService{
+		apiKeyConfig: &config.APIKeyConfig{
+			Algorithm: constants.HashingAlgorithmSHA256,
+			Prefix:    "acme_",
+		},
+	}
+
+	key, err := service.generateAPIKeyValue()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "acme_"))
+	assert.Len(t, key, len("acme_")+(constants.APIKeyLen*2))
+}
+
+func TestGenerateLocalAPIKeyValue_SignedMode(t *testing.T) {
+	codec, err := apikey.NewSignedAPIKeyCodec("acme_", []byte("0123456789abcdef0123456789abcdef"))
+	assert.NoError(t, err)
+	service := &APIKeyService{
+		apiKeyConfig: &config.APIKeyConfig{
+			Algorithm: constants.HashingAlgorithmSHA256,
+			Prefix:    "acme_",
+			Mode:      constants.APIKeyModeSigned,
+		},
+		signedKeyCodec: codec,
+	}
+
+	expiresAt := time.Now().Add(time.Hour)
+	key, err := service.generateLocalAPIKeyValue("key-uuid", "api-uuid", "", &expiresAt)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "acme_"))
+
+	claims, err := apikey.NewSignedAPIKeyVerifier(codec, nil).Verify("api-uuid", key, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "key-uuid", claims.KeyID)
+	assert.Equal(t, expiresAt.Unix(), claims.ExpiresAt)
+	assert.Empty(t, claims.Operations)
+
+	// Scoped keys carry their operations
+	key, err = service.generateLocalAPIKeyValue("key-uuid", "api-uuid", `["GET /pets"]`, &expiresAt)
+	assert.NoError(t, err)
+	claims, err = apikey.NewSignedAPIKeyVerifier(codec, nil).Verify("api-uuid", key, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `["GET /pets"]`, claims.Operations)
+
+	// Without a codec the service falls back to random keys
+	service.signedKeyCodec = nil
+	key, err = service.generateLocalAPIKeyValue("key-uuid", "api-uuid", "", &expiresAt)
+	assert.NoError(t, err)
+	assert.Len(t, key, len("acme_")+(constants.APIKeyLen*2))
+}
+
 func TestGenerateShortUniqueID(t *testing.T) {
 	service := &APIKeyService{
 		apiKeyConfig: &config.APIKeyConfig{
@@ -534,6 +587,20 @@ func TestCompareAPIKeys(t *testing.T) {
 		result := service.compareAPIKeys(plainKey, hash)
 		assert.True(t, result)
 	})
+
+	t.Run("Key issued under a previous prefix still matches", func(t *testing.T) {
+		legacyKey, err := service.generateAPIKeyValue()
+		assert.NoError(t, err)
+		hash, err := service.hashAPIKeyWithSHA256(legacyKey)
+		assert.NoError(t, err)
+
+		service.SetHashingConfig(&config.APIKeyConfig{
+			Algorithm: constants.HashingAlgorithmSHA256,
+			Prefix:    "acme_",
+		})
+
+		assert.True(t, service.compareAPIKeys(legacyKey, hash))
+	})
 }
 
 func TestHashAPIKey_EmptyKey(t *testing.T) {
@@ -825,6 +892,68 @@ func TestRegenerateAPIKey_ConfigLookup(t *testing.T) {
 	}
 }
 
+func TestCreateAPIKey_StoresOperations(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db := newTestSQLiteStorage(t, logger)
+	cfg := newTestStoredRESTConfig("db-scoped-keys", "users-api")
+	if err := db.SaveConfig(cfg); err != nil {
+		t.Fatalf("failed to seed config in database: %v", err)
+	}
+
+	service := newTestAPIKeyService(storage.NewConfigStore(), db, nil, newTestAPIKeyConfig())
+	user := &commonmodels.AuthContext{UserID: "creator-user", Roles: []string{"developer"}}
+	name := "scoped-key"
+	operations := []string{"get /users", "GET /users/{id}"}
+
+	result, err := service.CreateAPIKey(APIKeyCreationParams{
+		Handle:  cfg.Handle,
+		Request: api.APIKeyCreationRequest{Name: &name, Operations: &operations},
+		User:    user,
+		Logger:  logger,
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey returned error: %v", err)
+	}
+	assert.Equal(t, []string{"GET /users", "GET /users/{id}"}, *result.Response.ApiKey.Operations)
+
+	saved, err := db.GetAPIKeysByAPIAndName(cfg.UUID, name)
+	if err != nil {
+		t.Fatalf("failed to read saved API key: %v", err)
+	}
+	assert.Equal(t, `["GET /users","GET /users/{id}"]`, saved.Operations)
+
+	// Regeneration keeps the operation scope
+	if _, err := service.RegenerateAPIKey(APIKeyRegenerationParams{
+		Handle:     cfg.Handle,
+		APIKeyName: name,
+		User:       user,
+		Logger:     logger,
+	}); err != nil {
+		t.Fatalf("RegenerateAPIKey returned error: %v", err)
+	}
+	regenerated, err := db.GetAPIKeysByAPIAndName(cfg.UUID, name)
+	if err != nil {
+		t.Fatalf("failed to read regenerated API key: %v", err)
+	}
+	assert.Equal(t, saved.Operations, regenerated.Operations)
+
+	// Keys created without operations are not scoped
+	unscoped, err := service.CreateAPIKey(APIKeyCreationParams{Handle: cfg.Handle, User: user, Logger: logger})
+	if err != nil {
+		t.Fatalf("CreateAPIKey returned error: %v", err)
+	}
+	assert.Equal(t, []string{"*"}, *unscoped.Response.ApiKey.Operations)
+
+	invalid := []string{"GET users"}
+	_, err = service.CreateAPIKey(APIKeyCreationParams{
+		Handle:  cfg.Handle,
+		Request: api.APIKeyCreationRequest{Operations: &invalid},
+		User:    user,
+		Logger:  logger,
+	})
+	assert.ErrorIs(t, err, ErrInvalidAPIKeyRequest)
+}
+
 func TestRevokeAPIKey_ConfigLookup(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	store := storage.NewConfigStore()
@@ -913,6 +1042,151 @@ func TestListAPIKeys_UsesDatabaseLookup(t *testing.T) {
 	}
 }
 
+func TestListAPIKeys_PaginatesAfterUserScoping(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := storage.NewConfigStore()
+	db := newTestSQLiteStorage(t, logger)
+	cfg := newTestStoredRESTConfig("db-page-keys", "orders-api")
+
+	if err := db.SaveConfig(cfg); err != nil {
+		t.Fatalf("failed to seed config in database: %v", err)
+	}
+
+	now := time.Now()
+	for i, createdBy := range []string{"creator-user", "other-user", "creator-user", "creator-user"} {
+		key := newTestStoredAPIKey(cfg.UUID, "key-"+strconv.Itoa(i), createdBy, "local")
+		key.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+		if err := db.SaveAPIKey(key); err != nil {
+			t.Fatalf("failed to seed API key %q in database: %v", key.Name, err)
+		}
+	}
+
+	service := newTestAPIKeyService(store, db, nil, newTestAPIKeyConfig())
+	user := &commonmodels.AuthContext{
+		UserID: "creator-user",
+		Roles:  []string{"developer"},
+	}
+
+	result, err := service.ListAPIKeys(ListAPIKeyParams{
+		Handle:        cfg.Handle,
+		User:          user,
+		Limit:         2,
+		CorrelationID: "corr-list-page",
+		Logger:        logger,
+	})
+	if err != nil {
+		t.Fatalf("ListAPIKeys returned error: %v", err)
+	}
+	keys := *result.Response.ApiKeys
+	if len(keys) != 2 || keys[0].Name != "key-0" || keys[1].Name != "key-2" {
+		t.Fatalf("expected first page [key-0 key-2], got %+v", keys)
+	}
+	if *result.Response.TotalCount != 3 {
+		t.Fatalf("expected total count 3, got %d", *result.Response.TotalCount)
+	}
+	if result.Response.NextOffset == nil || *result.Response.NextOffset != 2 {
+		t.Fatalf("expected next offset 2, got %v", result.Response.NextOffset)
+	}
+
+	result, err = service.ListAPIKeys(ListAPIKeyParams{
+		Handle:        cfg.Handle,
+		User:          user,
+		Limit:         2,
+		Offset:        2,
+		CorrelationID: "corr-list-page",
+		Logger:        logger,
+	})
+	if err != nil {
+		t.Fatalf("ListAPIKeys returned error: %v", err)
+	}
+	keys = *result.Response.ApiKeys
+	if len(keys) != 1 || keys[0].Name != "key-3" {
+		t.Fatalf("expected last page [key-3], got %+v", keys)
+	}
+	if result.Response.NextOffset != nil {
+		t.Fatalf("expected no next offset on the last page, got %d", *result.Response.NextOffset)
+	}
+}
+
+func TestRecordAPIKeyUsage_UpdatesLastUsedAt(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	store := storage.NewConfigStore()
+	db := newTestSQLiteStorage(t, logger)
+	cfg := newTestStoredRESTConfig("db-last-used", "inventory-api")
+
+	if err := db.SaveConfig(cfg); err != nil {
+		t.Fatalf("failed to seed config in database: %v", err)
+	}
+
+	service := newTestAPIKeyService(store, db, nil, newTestAPIKeyConfig())
+	key := newTestStoredAPIKey(cfg.UUID, "usage-key", "creator-user", "local")
+	if err := db.SaveAPIKey(key); err != nil {
+		t.Fatalf("failed to seed API key in database: %v", err)
+	}
+
+	fresh, err := db.GetAPIKeyByID(key.UUID)
+	if err != nil {
+		t.Fatalf("failed to load API key: %v", err)
+	}
+	if fresh.LastUsedAt != nil {
+		t.Fatalf("expected freshly created key to have nil LastUsedAt, got %v", *fresh.LastUsedAt)
+	}
+
+	usedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	if err := service.RecordAPIKeyUsage(cfg.UUID, key.UUID, usedAt); err != nil {
+		t.Fatalf("RecordAPIKeyUsage returned error: %v", err)
+	}
+	used, err := db.GetAPIKeyByID(key.UUID)
+	if err != nil {
+		t.Fatalf("failed to load API key: %v", err)
+	}
+	if used.LastUsedAt == nil || !used.LastUsedAt.Equal(usedAt) {
+		t.Fatalf("expected LastUsedAt %v, got %v", usedAt, used.LastUsedAt)
+	}
+	if !used.UpdatedAt.Equal(fresh.UpdatedAt) {
+		t.Fatalf("expected usage to leave UpdatedAt unchanged, got %v want %v", used.UpdatedAt, fresh.UpdatedAt)
+	}
+
+	// Usage inside the throttle window, or older than the stored value, does not write again
+	for _, at := range []time.Time{usedAt.Add(constants.APIKeyLastUsedUpdateInterval / 2), usedAt.Add(-time.Hour)} {
+		if err := service.RecordAPIKeyUsage(cfg.UUID, key.UUID, at); err != nil {
+			t.Fatalf("RecordAPIKeyUsage returned error: %v", err)
+		}
+		again, err := db.GetAPIKeyByID(key.UUID)
+		if err != nil {
+			t.Fatalf("failed to load API key: %v", err)
+		}
+		if !again.LastUsedAt.Equal(usedAt) {
+			t.Fatalf("expected throttled LastUsedAt %v, got %v", usedAt, *again.LastUsedAt)
+		}
+	}
+
+	// Once the window has elapsed the timestamp advances
+	later := usedAt.Add(constants.APIKeyLastUsedUpdateInterval)
+	if err := service.RecordAPIKeyUsage(cfg.UUID, key.UUID, later); err != nil {
+		t.Fatalf("RecordAPIKeyUsage returned error: %v", err)
+	}
+	advanced, err := db.GetAPIKeyByID(key.UUID)
+	if err != nil {
+		t.Fatalf("failed to load API key: %v", err)
+	}
+	if !advanced.LastUsedAt.Equal(later) {
+		t.Fatalf("expected LastUsedAt %v, got %v", later, *advanced.LastUsedAt)
+	}
+
+	// Usage reported against a different artifact is ignored
+	if err := service.RecordAPIKeyUsage("other-artifact", key.UUID, later.Add(time.Hour)); err != nil {
+		t.Fatalf("RecordAPIKeyUsage returned error: %v", err)
+	}
+	unchanged, err := db.GetAPIKeyByID(key.UUID)
+	if err != nil {
+		t.Fatalf("failed to load API key: %v", err)
+	}
+	if !unchanged.LastUsedAt.Equal(later) {
+		t.Fatalf("expected usage for another artifact to be ignored, got %v", *unchanged.LastUsedAt)
+	}
+}
+
 func TestCreateAPIKey_LimitUsesDatabaseCount(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	store := storage.NewConfigStore()
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonmodels "github.com/wso2/api-platform/common/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/apikeywebhook"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+// webhookRecorder collects the bodies delivered to a test webhook endpoint
+type webhookRecorder struct {
+	mu     sync.Mutex
+	bodies []string
+}
+
+func (r *webhookRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.bodies = append(r.bodies, string(body))
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (r *webhookRecorder) events(t *testing.T) []apikeywebhook.Event {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := make([]apikeywebhook.Event, len(r.bodies))
+	for i, body := range r.bodies {
+		require.NoError(t, json.Unmarshal([]byte(body), &events[i]))
+	}
+	return events
+}
+
+func TestAPIKeyWebhook_CreateAndRevoke(t *testing.T) {
+	f := newBulkAPIKeyFixture(t, 10)
+	rec := &webhookRecorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	notifier := apikeywebhook.NewNotifier(config.APIKeyWebhookConfig{
+		URL:       server.URL,
+		Timeout:   time.Second,
+		QueueSize: 10,
+	}, f.logger)
+	notifier.Start()
+	f.service.SetWebhookNotifier(notifier)
+
+	created, err := f.service.CreateAPIKey(f.params("alice", "partner-key")[0])
+	require.NoError(t, err)
+	require.NotNil(t, created.Response.ApiKey)
+	require.NotNil(t, created.Response.ApiKey.ApiKey)
+	plainAPIKey := *created.Response.ApiKey.ApiKey
+
+	_, err = f.service.RevokeAPIKey(APIKeyRevocationParams{
+		Handle:        f.handle,
+		APIKeyName:    "partner-key",
+		User:          &commonmodels.AuthContext{UserID: "alice", Roles: []string{"consumer"}},
+		CorrelationID: "corr-revoke",
+		Logger:        f.logger,
+	})
+	require.NoError(t, err)
+
+	// Stop drains the queue, so every event has been delivered once it returns
+	notifier.Stop()
+
+	events := rec.events(t)
+	require.Len(t, events, 2)
+	assert.Equal(t, apikeywebhook.EventTypeCreated, events[0].Type)
+	assert.Equal(t, apikeywebhook.EventTypeRevoked, events[1].Type)
+	for _, event := range events {
+		assert.Equal(t, f.handle, event.APIID)
+		assert.Equal(t, "partner-key", event.APIKeyName)
+		assert.Equal(t, "alice", event.Actor)
+		assert.False(t, event.Timestamp.IsZero())
+	}
+
+	// The plaintext key never leaves the controller
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	for _, body := range rec.bodies {
+		assert.False(t, strings.Contains(body, plainAPIKey))
+	}
+}
+
+func TestAPIKeyWebhook_ExpirySweeperReportsRevocations(t *testing.T) {
+	service, db, _, apiID := newExpiryTestService(t)
+	rec := &webhookRecorder{}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	notifier := apikeywebhook.NewNotifier(config.APIKeyWebhookConfig{
+		URL:       server.URL,
+		Timeout:   time.Second,
+		QueueSize: 10,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	notifier.Start()
+	service.SetWebhookNotifier(notifier)
+
+	past := time.Now().Add(-time.Minute)
+	saveKeyExpiringAt(t, db, apiID, "expired-key", &past)
+	revoked, err := service.RevokeExpiredAPIKeys(time.Now(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, revoked)
+	notifier.Stop()
+
+	events := rec.events(t)
+	require.Len(t, events, 1)
+	assert.Equal(t, apikeywebhook.EventTypeRevoked, events[0].Type)
+	assert.Equal(t, "expiring-api", events[0].APIID)
+	assert.Equal(t, "expired-key", events[0].APIKeyName)
+	assert.Equal(t, apiKeyExpirySweeperActor, events[0].Actor)
+}
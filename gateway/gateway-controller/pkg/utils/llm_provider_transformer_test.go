@@ -174,6 +174,43 @@ func TestTransform_MinimalProvider(t *testing.T) {
 	assert.Equal(t, "https://api.openai.com", *spec.Upstream.Main.Url)
 }
 
+func TestTransform_UpstreamOutlierDetection(t *testing.T) {
+	transformer, _ := setupTestTransformer(t)
+	consecutive5xx := 3
+
+	provider := &api.LLMProviderConfiguration{
+		ApiVersion: "gateway.api-platform.wso2.com/v1",
+		Kind:       "LlmProvider",
+		Metadata:   api.Metadata{Name: "openai-provider"},
+		Spec: api.LLMProviderConfigData{
+			DisplayName: "flaky-provider",
+			Version:     "v1.0",
+			Template:    "openai",
+			Upstream: api.LLMProviderConfigData_Upstream{
+				Url: stringPtr("https://api.openai.com"),
+				OutlierDetection: &api.UpstreamOutlierDetection{
+					Consecutive5xx:   &consecutive5xx,
+					BaseEjectionTime: stringPtr("1m"),
+				},
+			},
+			AccessControl: api.LLMAccessControl{
+				Mode: api.AllowAll,
+			},
+		},
+	}
+
+	result, err := transformer.Transform(provider, &api.RestAPI{})
+	require.NoError(t, err)
+
+	// The provider's outlier detection carries over to the main upstream of the REST API
+	od := result.Spec.Upstream.Main.OutlierDetection
+	require.NotNil(t, od)
+	require.NotNil(t, od.Consecutive5xx)
+	assert.Equal(t, 3, *od.Consecutive5xx)
+	require.NotNil(t, od.BaseEjectionTime)
+	assert.Equal(t, "1m", *od.BaseEjectionTime)
+}
+
 func TestTransform_FullProvider(t *testing.T) {
 	transformer, _ := setupTestTransformer(t)
 
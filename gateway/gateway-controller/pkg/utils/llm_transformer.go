@@ -423,6 +423,8 @@ func (t *LLMProviderTransformer) transformProvider(provider *api.LLMProviderConf
 			Url: provider.Spec.Upstream.Url,
 		}
 	}
+	spec.Upstream.Main.HealthCheck = provider.Spec.Upstream.HealthCheck
+	spec.Upstream.Main.OutlierDetection = provider.Spec.Upstream.OutlierDetection
 	spec.UpstreamDefinitions = provider.Spec.UpstreamDefinitions
 	if provider.Spec.Vhost != nil {
 		spec.Vhosts = &struct {
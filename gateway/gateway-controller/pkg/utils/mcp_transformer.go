@@ -130,6 +130,7 @@ func applyMCPResilience(ops []api.Operation, userRes *api.Resilience) {
 				res.Timeout = userRes.Timeout
 			}
 			res.IdleTimeout = userRes.IdleTimeout
+			res.Retries = userRes.Retries
 		}
 		ops[i].Resilience = res
 	}
@@ -164,6 +165,8 @@ func (t *MCPTransformer) Transform(input any, output *api.RestAPI) (*api.RestAPI
 			Url: mcpConfig.Spec.Upstream.Url,
 		}
 	}
+	apiData.Upstream.Main.HealthCheck = mcpConfig.Spec.Upstream.HealthCheck
+	apiData.Upstream.Main.OutlierDetection = mcpConfig.Spec.Upstream.OutlierDetection
 	apiData.UpstreamDefinitions = mcpConfig.Spec.UpstreamDefinitions
 
 	// Process policies
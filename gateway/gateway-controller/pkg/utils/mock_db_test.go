@@ -20,6 +20,7 @@ package utils
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
@@ -147,8 +148,9 @@ func (m *testMockDB) GetLLMProviderTemplateByHandle(handle string) (*models.Stor
 	return nil, storage.ErrNotFound
 }
 
-func (m *testMockDB) SaveAPIKey(key *models.APIKey) error   { return nil }
-func (m *testMockDB) UpsertAPIKey(key *models.APIKey) error { return nil }
+func (m *testMockDB) SaveAPIKey(key *models.APIKey) error     { return nil }
+func (m *testMockDB) SaveAPIKeys(keys []*models.APIKey) error { return nil }
+func (m *testMockDB) UpsertAPIKey(key *models.APIKey) error   { return nil }
 func (m *testMockDB) GetAPIKeyByID(id string) (*models.APIKey, error) {
 	return nil, storage.ErrNotFound
 }
@@ -159,14 +161,29 @@ func (m *testMockDB) GetAPIKeyByKey(key string) (*models.APIKey, error) {
 	return nil, storage.ErrNotFound
 }
 func (m *testMockDB) GetAPIKeysByAPI(apiId string) ([]*models.APIKey, error) { return nil, nil }
-func (m *testMockDB) GetAllAPIKeys() ([]*models.APIKey, error)               { return nil, nil }
+func (m *testMockDB) ListAPIKeysByAPI(apiId string, filter storage.APIKeyListFilter) ([]*models.APIKey, int, error) {
+	return nil, 0, nil
+}
+func (m *testMockDB) GetAllAPIKeys() ([]*models.APIKey, error) { return nil, nil }
 func (m *testMockDB) GetAPIKeysByApplicationUUID(applicationUUID string) ([]*models.APIKey, error) {
 	return nil, nil
 }
 func (m *testMockDB) GetAPIKeysByAPIAndName(apiId, name string) (*models.APIKey, error) {
 	return nil, storage.ErrNotFound
 }
-func (m *testMockDB) UpdateAPIKey(key *models.APIKey) error     { return nil }
+func (m *testMockDB) UpdateAPIKey(key *models.APIKey) error { return nil }
+func (m *testMockDB) TouchAPIKeyLastUsed(artifactUUID, uuid string, usedAt, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+func (m *testMockDB) ListAPIKeyRevocations(now time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *testMockDB) ListExpiredAPIKeys(now time.Time) ([]*models.APIKey, error) {
+	return nil, nil
+}
+func (m *testMockDB) RevokeActiveAPIKey(apiKey *models.APIKey) (bool, error) {
+	return true, nil
+}
 func (m *testMockDB) DeleteAPIKey(key string) error             { return nil }
 func (m *testMockDB) DeleteAPIKeysByUUIDs(uuids []string) error { return nil }
 func (m *testMockDB) ListAPIKeysForArtifactsNotIn(artifactUUIDs []string, keyUUIDs []string) ([]*models.APIKey, error) {
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"log/slog"
+
+	policyenginev1 "github.com/wso2/api-platform/sdk/core/policyengine"
+)
+
+// MergeOperationPolicy adds an operation-level policy to a chain whose first
+// apiLevelCount entries are the API-level policies. Policies are matched by name
+// and version: an operation-level policy matching an API-level one replaces it in
+// place, so the policy runs once with the operation's parameters. Instances carry
+// the major version, which resolves to a single definition, so this is the same
+// as matching on the resolved version.
+//
+// A policy whose name matches an API-level policy with another version is
+// appended; the policy validator rejects such configurations before deployment.
+func MergeOperationPolicy(chain []policyenginev1.PolicyInstance, apiLevelCount int, policy policyenginev1.PolicyInstance) []policyenginev1.PolicyInstance {
+	for i := 0; i < apiLevelCount && i < len(chain); i++ {
+		if chain[i].Name != policy.Name {
+			continue
+		}
+		if chain[i].Version == policy.Version {
+			chain[i] = policy
+			return chain
+		}
+		slog.Warn("Operation-level policy version conflicts with API-level policy",
+			"policy_name", policy.Name,
+			"api_level_version", chain[i].Version,
+			"operation_level_version", policy.Version)
+	}
+	return append(chain, policy)
+}
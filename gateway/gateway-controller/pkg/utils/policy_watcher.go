@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+// policyDefinitionReloadDebounce coalesces the burst of events produced by editors
+// and ConfigMap volume updates into a single reload
+const policyDefinitionReloadDebounce = 500 * time.Millisecond
+
+// PolicyDefinitionWatcher reloads policy definitions when files in the definitions
+// directory change. A reload only takes effect if the full new set loads and validates,
+// so a broken file leaves the previously loaded definitions in place.
+type PolicyDefinitionWatcher struct {
+	dir      string
+	loader   *PolicyLoader
+	onReload func(map[string]models.PolicyDefinition)
+	debounce time.Duration
+	logger   *slog.Logger
+}
+
+// NewPolicyDefinitionWatcher creates a watcher for the given definitions directory.
+// onReload receives each successfully loaded and validated set of definitions.
+func NewPolicyDefinitionWatcher(dir string, loader *PolicyLoader,
+	onReload func(map[string]models.PolicyDefinition), logger *slog.Logger) *PolicyDefinitionWatcher {
+	return &PolicyDefinitionWatcher{
+		dir:      dir,
+		loader:   loader,
+		onReload: onReload,
+		debounce: policyDefinitionReloadDebounce,
+		logger:   logger,
+	}
+}
+
+// Start begins watching the definitions directory until ctx is cancelled
+func (w *PolicyDefinitionWatcher) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create policy definitions watcher: %w", err)
+	}
+	if err := w.watchTree(watcher, w.dir); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	w.logger.Info("Watching policy definitions for changes", slog.String("directory", w.dir))
+	go w.run(ctx, watcher)
+	return nil
+}
+
+// Reload loads the definitions directory, validates the result and hands it to onReload
+func (w *PolicyDefinitionWatcher) Reload() error {
+	// The loader treats a missing directory as an empty set; never swap that in
+	if _, err := os.Stat(w.dir); err != nil {
+		return w.reloadFailed(fmt.Errorf("policy definitions directory is not accessible: %w", err))
+	}
+	policyDefinitions, err := w.loader.LoadPoliciesFromDirectory(w.dir)
+	if err != nil {
+		return w.reloadFailed(err)
+	}
+	if err := config.ValidatePolicyDefinitions(policyDefinitions); err != nil {
+		return w.reloadFailed(err)
+	}
+
+	w.onReload(policyDefinitions)
+	metrics.PolicyDefinitionReloadsTotal.WithLabelValues("success").Inc()
+	w.logger.Info("Policy definitions reloaded", slog.Int("count", len(policyDefinitions)))
+	return nil
+}
+
+func (w *PolicyDefinitionWatcher) reloadFailed(err error) error {
+	metrics.PolicyDefinitionReloadsTotal.WithLabelValues("failure").Inc()
+	w.logger.Error("Policy definitions reload rejected; keeping previously loaded definitions",
+		slog.String("directory", w.dir),
+		slog.Any("error", err))
+	return err
+}
+
+func (w *PolicyDefinitionWatcher) run(ctx context.Context, watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			// Newly created sub-directories must be watched explicitly
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := w.watchTree(watcher, event.Name); err != nil {
+						w.logger.Warn("Failed to watch new policy definitions directory",
+							slog.String("directory", event.Name),
+							slog.Any("error", err))
+					}
+				}
+			}
+			timer.Reset(w.debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			w.logger.Warn("Policy definitions watcher error", slog.Any("error", err))
+		case <-timer.C:
+			_ = w.Reload()
+		}
+	}
+}
+
+// watchTree adds the directory and all of its sub-directories to the watcher,
+// matching the recursive walk done by LoadPoliciesFromDirectory
+func (w *PolicyDefinitionWatcher) watchTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("failed to watch policy definitions directory %s: %w", path, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func writePolicyFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test policy file: %v", err)
+	}
+}
+
+func TestPolicyDefinitionWatcher_Reload(t *testing.T) {
+	metrics.Init()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tempDir := t.TempDir()
+	writePolicyFile(t, tempDir, "policy1.yaml", "name: TestPolicy1\nversion: v1.0.0\n")
+
+	var reloaded map[string]models.PolicyDefinition
+	reloads := 0
+	watcher := NewPolicyDefinitionWatcher(tempDir, NewPolicyLoader(logger), func(defs map[string]models.PolicyDefinition) {
+		reloaded = defs
+		reloads++
+	}, logger)
+
+	if err := watcher.Reload(); err != nil {
+		t.Fatalf("Expected reload to succeed: %v", err)
+	}
+	if _, ok := reloaded["TestPolicy1|v1.0.0"]; !ok || reloads != 1 {
+		t.Fatalf("Expected TestPolicy1 to be handed to the reload callback, got %v", reloaded)
+	}
+
+	// A broken file must not replace the previously loaded set
+	writePolicyFile(t, tempDir, "broken.yaml", "name: Broken\nversion: not-a-version\n")
+	if err := watcher.Reload(); err == nil {
+		t.Fatalf("Expected reload with an invalid policy file to fail")
+	}
+
+	// Neither must a definition whose parameter schema does not compile
+	if err := os.Remove(filepath.Join(tempDir, "broken.yaml")); err != nil {
+		t.Fatalf("Failed to remove broken policy file: %v", err)
+	}
+	writePolicyFile(t, tempDir, "schema.yaml", "name: BadSchema\nversion: v1.0.0\nparameters:\n  type: 12\n")
+	if err := watcher.Reload(); err == nil {
+		t.Fatalf("Expected reload with an invalid parameter schema to fail")
+	}
+
+	// Nor an unreadable definitions directory
+	missing := NewPolicyDefinitionWatcher(filepath.Join(tempDir, "missing"), NewPolicyLoader(logger),
+		func(map[string]models.PolicyDefinition) { reloads++ }, logger)
+	if err := missing.Reload(); err == nil {
+		t.Fatalf("Expected reload of a missing directory to fail")
+	}
+
+	if reloads != 1 {
+		t.Errorf("Expected rejected reloads to skip the callback, got %d callback invocations", reloads)
+	}
+}
+
+func TestPolicyDefinitionWatcher_ReloadsOnChange(t *testing.T) {
+	metrics.Init()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tempDir := t.TempDir()
+	writePolicyFile(t, tempDir, "policy1.yaml", "name: TestPolicy1\nversion: v1.0.0\n")
+
+	reloaded := make(chan map[string]models.PolicyDefinition, 1)
+	watcher := NewPolicyDefinitionWatcher(tempDir, NewPolicyLoader(logger), func(defs map[string]models.PolicyDefinition) {
+		select {
+		case reloaded <- defs:
+		default:
+		}
+	}, logger)
+	watcher.debounce = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := watcher.Start(ctx); err != nil {
+		t.Fatalf("Failed to start watcher: %v", err)
+	}
+
+	// Definitions added in a new sub-directory are picked up as well
+	subDir := filepath.Join(tempDir, "custom")
+	if err := os.Mkdir(subDir, 0755); err != nil {
+		t.Fatalf("Failed to create sub-directory: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	writePolicyFile(t, subDir, "policy2.yaml", "name: TestPolicy2\nversion: v1.0.0\n")
+
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case defs := <-reloaded:
+			if _, ok := defs["TestPolicy2|v1.0.0"]; ok {
+				return
+			}
+		case <-deadline:
+			t.Fatalf("Timed out waiting for policy definitions to be reloaded")
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// createBufferFilter creates the buffer HTTP filter. It is disabled by default and only
+// runs on routes that carry a per-route buffer config, i.e. routes of APIs that set
+// maxRequestBytes. The listener-level limit is a placeholder required by the filter.
+func (t *Translator) createBufferFilter() (*hcm.HttpFilter, error) {
+	bufferAny, err := anypb.New(&bufferv3.Buffer{
+		MaxRequestBytes: wrapperspb.UInt32(t.routerConfig.HTTPListener.PerConnectionBufferLimitBytes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal buffer filter config: %w", err)
+	}
+	return &hcm.HttpFilter{
+		Name:     wellknown.Buffer,
+		Disabled: true,
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: bufferAny,
+		},
+	}, nil
+}
+
+// applyMaxRequestBytes enables the buffer filter on a route with the given request body
+// limit. Envoy buffers the request up to the limit before it reaches the policy engine
+// and rejects larger bodies with 413. Routes allowing a connection upgrade are left
+// alone: the upgrade request never ends its stream, so buffering would stall it.
+func applyMaxRequestBytes(r *route.Route, maxRequestBytes int64, upgradeType string) error {
+	if maxRequestBytes <= 0 || upgradeType != "" {
+		return nil
+	}
+	bufferAny, err := anypb.New(&bufferv3.BufferPerRoute{
+		Override: &bufferv3.BufferPerRoute_Buffer{
+			Buffer: &bufferv3.Buffer{MaxRequestBytes: wrapperspb.UInt32(uint32(maxRequestBytes))},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal buffer per-route config: %w", err)
+	}
+	if r.TypedPerFilterConfig == nil {
+		r.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	r.TypedPerFilterConfig[wellknown.Buffer] = bufferAny
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBufferFilter_DisabledByDefault(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	filter, err := translator.createBufferFilter()
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.Buffer, filter.GetName())
+	assert.True(t, filter.GetDisabled(), "the buffer filter must only run on routes that enable it")
+}
+
+func TestApplyMaxRequestBytes(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       int64
+		upgradeType string
+		wantBuffer  bool
+	}{
+		{name: "limit enables the buffer filter", limit: 2048, wantBuffer: true},
+		{name: "no limit leaves the route alone"},
+		{name: "upgrade route is never buffered", limit: 2048, upgradeType: "websocket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &route.Route{Name: "GET|/chat/v1.0/messages|"}
+			require.NoError(t, applyMaxRequestBytes(r, tt.limit, tt.upgradeType))
+
+			bufferAny, ok := r.GetTypedPerFilterConfig()[wellknown.Buffer]
+			if !tt.wantBuffer {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			perRoute := &bufferv3.BufferPerRoute{}
+			require.NoError(t, bufferAny.UnmarshalTo(perRoute))
+			assert.Equal(t, uint32(tt.limit), perRoute.GetBuffer().GetMaxRequestBytes().GetValue())
+		})
+	}
+}
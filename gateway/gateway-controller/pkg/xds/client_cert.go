@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// applyClientCertificateRequest makes the HTTPS listener request (but not require) a
+// client certificate. Envoy only asks for one when a trusted CA is configured, so the
+// same CA bundle used for upstreams is referenced; ACCEPT_UNTRUSTED lets the handshake
+// succeed regardless, leaving verification against each API's own trusted CAs to the
+// mtls-auth policy. The TLS handshake still proves the client holds the private key.
+func (t *Translator) applyClientCertificateRequest(common *tlsv3.CommonTlsContext) {
+	if !t.routerConfig.DownstreamTLS.RequestClientCertificate {
+		return
+	}
+
+	acceptUntrusted := &tlsv3.CertificateValidationContext{
+		TrustChainVerification: tlsv3.CertificateValidationContext_ACCEPT_UNTRUSTED,
+	}
+	if t.hasUpstreamCABundle() {
+		common.ValidationContextType = &tlsv3.CommonTlsContext_CombinedValidationContext{
+			CombinedValidationContext: &tlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+				DefaultValidationContext: acceptUntrusted,
+				ValidationContextSdsSecretConfig: &tlsv3.SdsSecretConfig{
+					Name:      SecretNameUpstreamCA,
+					SdsConfig: sdsConfigSource(),
+				},
+			},
+		}
+		return
+	}
+	if t.routerConfig.Upstream.TLS.TrustedCertPath != "" {
+		acceptUntrusted.TrustedCa = &core.DataSource{
+			Specifier: &core.DataSource_Filename{Filename: t.routerConfig.Upstream.TLS.TrustedCertPath},
+		}
+	}
+	common.ValidationContextType = &tlsv3.CommonTlsContext_ValidationContext{ValidationContext: acceptUntrusted}
+}
+
+// applyClientCertDetails forwards the client certificate presented over TLS to the
+// policy engine in the x-forwarded-client-cert header. SANITIZE_SET drops any XFCC
+// header sent by the client, so the header is only ever what Envoy itself observed.
+func (t *Translator) applyClientCertDetails(manager *hcm.HttpConnectionManager) {
+	if !t.routerConfig.DownstreamTLS.RequestClientCertificate {
+		return
+	}
+	manager.ForwardClientCertDetails = hcm.HttpConnectionManager_SANITIZE_SET
+	manager.SetCurrentClientCertDetails = &hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+		Subject: wrapperspb.Bool(true),
+		Cert:    true,
+		Chain:   true,
+		Dns:     true,
+		Uri:     true,
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"testing"
+
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClientCertTranslator(t *testing.T, requestClientCert bool) *Translator {
+	t.Helper()
+	routerCfg := testRouterConfig()
+	routerCfg.DownstreamTLS.RequestClientCertificate = requestClientCert
+	routerCfg.Upstream.TLS.TrustedCertPath = "/etc/ssl/certs/ca-certificates.crt"
+	cfg := testConfig()
+	cfg.Router = *routerCfg
+	translator := NewTranslator(createTestLogger(), routerCfg, nil, cfg)
+
+	sdsManager := NewSDSSecretManager(nil, nil, "router-node", createTestLogger())
+	certPEM, keyPEM := selfSignedKeyPair(t)
+	require.NoError(t, sdsManager.SetDownstreamCertificate(certPEM, keyPEM))
+	translator.SetSDSSecretManager(sdsManager)
+	return translator
+}
+
+func TestClientCertificate_DisabledByDefault(t *testing.T) {
+	translator := newClientCertTranslator(t, false)
+
+	tlsContext, err := translator.createDownstreamTLSContext()
+	require.NoError(t, err)
+	assert.Nil(t, tlsContext.GetCommonTlsContext().GetValidationContextType())
+
+	lis, _, err := translator.createListener(nil, false)
+	require.NoError(t, err)
+	manager := extractHCM(t, lis)
+	assert.Equal(t, hcm.HttpConnectionManager_SANITIZE, manager.GetForwardClientCertDetails(),
+		"a client-supplied x-forwarded-client-cert header must be stripped")
+	assert.Nil(t, manager.GetSetCurrentClientCertDetails())
+}
+
+func TestClientCertificate_RequestedAndForwarded(t *testing.T) {
+	translator := newClientCertTranslator(t, true)
+
+	tlsContext, err := translator.createDownstreamTLSContext()
+	require.NoError(t, err)
+	assert.False(t, tlsContext.GetRequireClientCertificate().GetValue(), "clients without a certificate must still connect")
+	validation := tlsContext.GetCommonTlsContext().GetValidationContext()
+	require.NotNil(t, validation)
+	assert.Equal(t, tlsv3.CertificateValidationContext_ACCEPT_UNTRUSTED, validation.GetTrustChainVerification())
+	assert.Equal(t, "/etc/ssl/certs/ca-certificates.crt", validation.GetTrustedCa().GetFilename())
+
+	lis, _, err := translator.createListener(nil, false)
+	require.NoError(t, err)
+	manager := extractHCM(t, lis)
+	assert.Equal(t, hcm.HttpConnectionManager_SANITIZE_SET, manager.GetForwardClientCertDetails())
+	details := manager.GetSetCurrentClientCertDetails()
+	require.NotNil(t, details)
+	assert.True(t, details.GetCert())
+	assert.True(t, details.GetChain())
+	assert.True(t, details.GetSubject().GetValue())
+	assert.True(t, details.GetDns())
+	assert.True(t, details.GetUri())
+}
@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	extproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	transcoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// grpcReflectionServices are the gRPC server reflection services passed through to
+// the backend when a gRPC API enables reflection.
+var grpcReflectionServices = []string{
+	"grpc.reflection.v1.ServerReflection",
+	"grpc.reflection.v1alpha.ServerReflection",
+}
+
+// translateGrpcAPIConfig translates a gRPC API into routes on its vhost: one per
+// exposed method (or per service when all methods are exposed), the reflection
+// services when reflection is enabled and, when a descriptor is configured, a
+// transcoding route for JSON/HTTP requests under the API context. Transcoded
+// requests are rewritten to /<service>/<method> and matched again against the
+// method routes. All routes target a single HTTP/2 cluster for the backend.
+func (t *Translator) translateGrpcAPIConfig(cfg *models.StoredConfig) ([]*route.Route, []*cluster.Cluster, error) {
+	grpcCfg, ok := cfg.Configuration.(grpcapi.GrpcAPI)
+	if !ok {
+		return nil, nil, fmt.Errorf("configuration is not a GrpcAPI")
+	}
+	spec := grpcCfg.Spec
+
+	upstreamCluster, err := t.createGrpcCluster(spec.Upstream.Url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vhost := t.routerConfig.VHosts.Main.Default
+	if spec.Vhost != nil && *spec.Vhost != "" {
+		vhost = *spec.Vhost
+	}
+
+	routes := make([]*route.Route, 0)
+	for _, service := range spec.Services {
+		if service.Methods == nil {
+			r, err := t.createGrpcRoute(cfg, "/"+service.Name+"/", true, upstreamCluster.Name, vhost)
+			if err != nil {
+				return nil, nil, err
+			}
+			routes = append(routes, r)
+			continue
+		}
+		for _, method := range *service.Methods {
+			r, err := t.createGrpcRoute(cfg, "/"+service.Name+"/"+method, false, upstreamCluster.Name, vhost)
+			if err != nil {
+				return nil, nil, err
+			}
+			routes = append(routes, r)
+		}
+	}
+
+	if spec.ReflectionEnabled() {
+		for _, service := range grpcReflectionServices {
+			r, err := t.createGrpcRoute(cfg, "/"+service+"/", true, upstreamCluster.Name, vhost)
+			if err != nil {
+				return nil, nil, err
+			}
+			// Reflection is a long-lived bidirectional stream
+			r.GetRoute().Timeout = durationpb.New(0)
+			routes = append(routes, r)
+		}
+	}
+
+	if spec.Descriptor != nil {
+		r, err := t.createGrpcTranscodingRoute(cfg, &spec, upstreamCluster.Name, vhost)
+		if err != nil {
+			return nil, nil, err
+		}
+		routes = append(routes, r)
+	}
+
+	return routes, []*cluster.Cluster{upstreamCluster}, nil
+}
+
+// createGrpcCluster creates the HTTP/2 cluster of a gRPC backend. Clusters are named
+// apart from REST clusters for the same host, which speak HTTP/1.1.
+func (t *Translator) createGrpcCluster(rawURL string) (*cluster.Cluster, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream URL: %w", err)
+	}
+	if parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid upstream URL: must include host and http/https scheme")
+	}
+
+	c := t.createCluster("grpc_"+t.sanitizeClusterName(parsedURL.Host, parsedURL.Scheme), parsedURL, nil, nil)
+	c.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+
+	// gRPC over TLS needs h2 negotiated through ALPN
+	for _, match := range c.TransportSocketMatches {
+		tlsContext := &tlsv3.UpstreamTlsContext{}
+		if err := match.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+			return nil, fmt.Errorf("failed to read upstream TLS context: %w", err)
+		}
+		if tlsContext.CommonTlsContext == nil {
+			tlsContext.CommonTlsContext = &tlsv3.CommonTlsContext{}
+		}
+		tlsContext.CommonTlsContext.AlpnProtocols = []string{constants.ALPNProtocolHTTP2}
+		tlsAny, err := anypb.New(tlsContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal upstream TLS context: %w", err)
+		}
+		match.TransportSocket.ConfigType = &core.TransportSocket_TypedConfig{TypedConfig: tlsAny}
+	}
+
+	return c, nil
+}
+
+// createGrpcRoute creates a route for gRPC requests to path: an exact method path,
+// or a service path prefix ending in "/" when prefix is set. The policy engine is
+// skipped as gRPC APIs do not carry policies.
+func (t *Translator) createGrpcRoute(cfg *models.StoredConfig, path string, prefix bool, clusterName, vhost string) (*route.Route, error) {
+	keyPath := path
+	if prefix {
+		keyPath = path + "*"
+	}
+	routeName := GenerateRouteName("POST", "", "", keyPath, vhost)
+
+	r := &route.Route{
+		Name: routeName,
+		Match: &route.RouteMatch{
+			Headers: []*route.HeaderMatcher{{
+				Name: ":method",
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: &matcher.StringMatcher{
+						MatchPattern: &matcher.StringMatcher_Exact{
+							Exact: "POST",
+						},
+					},
+				},
+			}},
+			// Only requests with a gRPC content type match
+			Grpc: &route.RouteMatch_GrpcRouteMatchOptions{},
+		},
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{
+					Cluster: clusterName,
+				},
+				Timeout:     t.routeTimeoutOrDefault(nil, t.routerConfig.Upstream.Timeouts.RouteTimeoutMs),
+				IdleTimeout: t.routeTimeoutOrDefault(nil, t.routerConfig.Upstream.Timeouts.RouteIdleTimeoutMs),
+				// Honour the deadline a client sends in the grpc-timeout header
+				MaxStreamDuration: &route.RouteAction_MaxStreamDuration{
+					GrpcTimeoutHeaderMax: durationpb.New(0),
+				},
+				HostRewriteSpecifier: &route.RouteAction_AutoHostRewrite{
+					AutoHostRewrite: wrapperspb.Bool(true),
+				},
+			},
+		},
+	}
+	if prefix {
+		r.Match.PathSpecifier = &route.RouteMatch_Prefix{Prefix: path}
+	} else {
+		r.Match.PathSpecifier = &route.RouteMatch_Path{Path: path}
+	}
+
+	if err := t.setGrpcRouteMetadata(r, cfg, "", path, "POST", vhost); err != nil {
+		return nil, err
+	}
+	extProcDisabledAny, err := anypb.New(&extproc.ExtProcPerRoute{
+		Override: &extproc.ExtProcPerRoute_Disabled{Disabled: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ExtProcPerRoute for gRPC route: %w", err)
+	}
+	r.TypedPerFilterConfig = map[string]*anypb.Any{
+		constants.ExtProcFilterName: extProcDisabledAny,
+	}
+	return r, nil
+}
+
+// createGrpcTranscodingRoute creates the route for JSON/HTTP requests under the API
+// context. It enables the gRPC-JSON transcoder with the API's descriptor; requests
+// that do not map to a method of the exposed services are rejected.
+func (t *Translator) createGrpcTranscodingRoute(cfg *models.StoredConfig, spec *grpcapi.GrpcAPIData, clusterName, vhost string) (*route.Route, error) {
+	transcoder := &transcoderv3.GrpcJsonTranscoder{
+		ConvertGrpcStatus: true,
+		RequestValidationOptions: &transcoderv3.GrpcJsonTranscoder_RequestValidationOptions{
+			RejectUnknownMethod: true,
+		},
+	}
+	switch {
+	case spec.Descriptor.File != nil && *spec.Descriptor.File != "":
+		transcoder.DescriptorSet = &transcoderv3.GrpcJsonTranscoder_ProtoDescriptor{
+			ProtoDescriptor: *spec.Descriptor.File,
+		}
+	case spec.Descriptor.Inline != nil && *spec.Descriptor.Inline != "":
+		descriptorBin, err := base64.StdEncoding.DecodeString(*spec.Descriptor.Inline)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inline descriptor: %w", err)
+		}
+		transcoder.DescriptorSet = &transcoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: descriptorBin,
+		}
+	default:
+		return nil, fmt.Errorf("descriptor must specify either 'file' or 'inline'")
+	}
+	for _, service := range spec.Services {
+		transcoder.Services = append(transcoder.Services, service.Name)
+	}
+	transcoderAny, err := anypb.New(transcoder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal gRPC-JSON transcoder config: %w", err)
+	}
+	extProcDisabledAny, err := anypb.New(&extproc.ExtProcPerRoute{
+		Override: &extproc.ExtProcPerRoute_Disabled{Disabled: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ExtProcPerRoute for transcoding route: %w", err)
+	}
+
+	context := ""
+	if spec.Context != nil {
+		context = *spec.Context
+	}
+	contextWithVersion := ConstructFullPath(context, spec.Version, "")
+	routeName := GenerateRouteName("*", context, spec.Version, "/*", vhost)
+
+	r := &route.Route{
+		Name: routeName,
+		Match: &route.RouteMatch{
+			PathSpecifier: &route.RouteMatch_SafeRegex{
+				SafeRegex: &matcher.RegexMatcher{
+					Regex: "^" + regexp.QuoteMeta(contextWithVersion) + "(?:/.*)?$",
+				},
+			},
+		},
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{
+					Cluster: clusterName,
+				},
+				Timeout:     t.routeTimeoutOrDefault(nil, t.routerConfig.Upstream.Timeouts.RouteTimeoutMs),
+				IdleTimeout: t.routeTimeoutOrDefault(nil, t.routerConfig.Upstream.Timeouts.RouteIdleTimeoutMs),
+				HostRewriteSpecifier: &route.RouteAction_AutoHostRewrite{
+					AutoHostRewrite: wrapperspb.Bool(true),
+				},
+			},
+		},
+		TypedPerFilterConfig: map[string]*anypb.Any{
+			wellknown.GRPCJSONTranscoder: transcoderAny,
+			constants.ExtProcFilterName:  extProcDisabledAny,
+		},
+	}
+	if err := t.setGrpcRouteMetadata(r, cfg, contextWithVersion, "/*", "*", vhost); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// setGrpcRouteMetadata attaches the wso2.route metadata used by access logs and analytics.
+func (t *Translator) setGrpcRouteMetadata(r *route.Route, cfg *models.StoredConfig, context, path, method, vhost string) error {
+	metaMap := map[string]interface{}{
+		"route_name":  r.Name,
+		"api_id":      cfg.UUID,
+		"api_name":    cfg.DisplayName,
+		"api_version": cfg.Version,
+		"api_context": context,
+		"path":        path,
+		"method":      method,
+		"vhost":       vhost,
+		"api_kind":    cfg.Kind,
+	}
+	if projectID := extractProjectIDFromConfig(cfg); projectID != "" {
+		metaMap["project_id"] = projectID
+	}
+	metaStruct, err := structpb.NewStruct(metaMap)
+	if err != nil {
+		return fmt.Errorf("failed to build route metadata: %w", err)
+	}
+	r.Metadata = &core.Metadata{FilterMetadata: map[string]*structpb.Struct{
+		"wso2.route": metaStruct,
+	}}
+	return nil
+}
+
+// createGrpcJSONTranscoderFilter creates the gRPC-JSON transcoder HTTP filter. It is
+// disabled by default and only runs on routes that carry a per-route transcoder
+// config, i.e. the transcoding routes of gRPC APIs. The listener-level config has no
+// services, which leaves the filter inactive on its own.
+func (t *Translator) createGrpcJSONTranscoderFilter() (*hcm.HttpFilter, error) {
+	transcoderAny, err := anypb.New(&transcoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &transcoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: []byte{},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal gRPC-JSON transcoder config: %w", err)
+	}
+	return &hcm.HttpFilter{
+		Name:     wellknown.GRPCJSONTranscoder,
+		Disabled: true,
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: transcoderAny,
+		},
+	}, nil
+}
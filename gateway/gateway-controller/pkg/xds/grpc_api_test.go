@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"encoding/base64"
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	transcoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/grpcapi"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func makeGrpcAPI(uuid, name string, spec grpcapi.GrpcAPIData) *models.StoredConfig {
+	cfg := grpcapi.GrpcAPI{
+		ApiVersion: grpcapi.ApiVersionV1,
+		Kind:       grpcapi.KindGrpcApi,
+		Metadata:   api.Metadata{Name: name},
+		Spec:       spec,
+	}
+	return &models.StoredConfig{
+		UUID:                uuid,
+		Kind:                models.KindGrpcApi,
+		Handle:              name,
+		DisplayName:         spec.DisplayName,
+		Version:             spec.Version,
+		DesiredState:        models.StateDeployed,
+		Configuration:       cfg,
+		SourceConfiguration: cfg,
+	}
+}
+
+func routesByName(routes []*route.Route) map[string]*route.Route {
+	byName := make(map[string]*route.Route, len(routes))
+	for _, r := range routes {
+		byName[r.GetName()] = r
+	}
+	return byName
+}
+
+func TestTranslateGrpcAPIConfig_MethodRoutingAndReflection(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	cfg := makeGrpcAPI("uuid-grpc-1", "greeter", grpcapi.GrpcAPIData{
+		DisplayName: "greeter",
+		Version:     "v1",
+		Upstream:    grpcapi.GrpcUpstream{Url: "http://greeter:50051"},
+		Services: []grpcapi.GrpcService{
+			{Name: "helloworld.Greeter", Methods: &[]string{"SayHello"}},
+			{Name: "helloworld.Admin"},
+		},
+		Reflection: api.Ptr(true),
+	})
+
+	routes, clusters, err := translator.translateGrpcAPIConfig(cfg)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, "grpc_cluster_http_greeter_50051", clusters[0].GetName())
+	assert.NotNil(t, clusters[0].GetHttp2ProtocolOptions(), "gRPC cluster must speak HTTP/2")
+
+	byName := routesByName(routes)
+	require.Len(t, byName, 4)
+
+	method := byName["POST|/helloworld.Greeter/SayHello|localhost"]
+	require.NotNil(t, method)
+	assert.Equal(t, "/helloworld.Greeter/SayHello", method.GetMatch().GetPath())
+	assert.NotNil(t, method.GetMatch().GetGrpc())
+	assert.Equal(t, clusters[0].GetName(), method.GetRoute().GetCluster())
+	assert.Contains(t, method.GetTypedPerFilterConfig(), constants.ExtProcFilterName)
+	assert.NotContains(t, method.GetTypedPerFilterConfig(), wellknown.GRPCJSONTranscoder)
+
+	service := byName["POST|/helloworld.Admin/*|localhost"]
+	require.NotNil(t, service)
+	assert.Equal(t, "/helloworld.Admin/", service.GetMatch().GetPrefix())
+
+	for _, name := range []string{
+		"POST|/grpc.reflection.v1.ServerReflection/*|localhost",
+		"POST|/grpc.reflection.v1alpha.ServerReflection/*|localhost",
+	} {
+		reflection := byName[name]
+		require.NotNil(t, reflection, name)
+		assert.Zero(t, reflection.GetRoute().GetTimeout().AsDuration(), "reflection streams must not time out")
+	}
+}
+
+func TestTranslateGrpcAPIConfig_Transcoding(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	descriptor := []byte{0x0a, 0x03, 'a', 'b', 'c'}
+	cfg := makeGrpcAPI("uuid-grpc-2", "greeter", grpcapi.GrpcAPIData{
+		DisplayName: "greeter",
+		Version:     "v1",
+		Context:     api.Ptr("/greeter/$version"),
+		Vhost:       api.Ptr("grpc.example.com"),
+		Upstream:    grpcapi.GrpcUpstream{Url: "https://greeter:443"},
+		Services:    []grpcapi.GrpcService{{Name: "helloworld.Greeter"}},
+		Descriptor:  &grpcapi.ProtoDescriptor{Inline: api.Ptr(base64.StdEncoding.EncodeToString(descriptor))},
+	})
+
+	routes, clusters, err := translator.translateGrpcAPIConfig(cfg)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+
+	// TLS upstreams negotiate h2 through ALPN
+	require.Len(t, clusters[0].GetTransportSocketMatches(), 1)
+	tlsContext := &tlsv3.UpstreamTlsContext{}
+	require.NoError(t, clusters[0].GetTransportSocketMatches()[0].GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext))
+	assert.Equal(t, []string{constants.ALPNProtocolHTTP2}, tlsContext.GetCommonTlsContext().GetAlpnProtocols())
+
+	byName := routesByName(routes)
+	require.Len(t, byName, 2)
+	assert.Contains(t, byName, "POST|/helloworld.Greeter/*|grpc.example.com")
+
+	transcoding := byName["*|/greeter/v1/*|grpc.example.com"]
+	require.NotNil(t, transcoding)
+	assert.Equal(t, `^/greeter/v1(?:/.*)?$`, transcoding.GetMatch().GetSafeRegex().GetRegex())
+	assert.Nil(t, transcoding.GetMatch().GetGrpc())
+
+	transcoderAny := transcoding.GetTypedPerFilterConfig()[wellknown.GRPCJSONTranscoder]
+	require.NotNil(t, transcoderAny)
+	transcoder := &transcoderv3.GrpcJsonTranscoder{}
+	require.NoError(t, transcoderAny.UnmarshalTo(transcoder))
+	assert.Equal(t, descriptor, transcoder.GetProtoDescriptorBin())
+	assert.Equal(t, []string{"helloworld.Greeter"}, transcoder.GetServices())
+	assert.True(t, transcoder.GetRequestValidationOptions().GetRejectUnknownMethod())
+}
+
+func TestTranslateConfigs_GrpcAPIAddsDisabledTranscoderFilter(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	filter, err := translator.createGrpcJSONTranscoderFilter()
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.GRPCJSONTranscoder, filter.GetName())
+	assert.True(t, filter.GetDisabled(), "the transcoder must only run on routes that enable it")
+
+	cfg := makeGrpcAPI("uuid-grpc-3", "greeter", grpcapi.GrpcAPIData{
+		DisplayName: "greeter",
+		Version:     "v1",
+		Upstream:    grpcapi.GrpcUpstream{Url: "http://greeter:50051"},
+		Services:    []grpcapi.GrpcService{{Name: "helloworld.Greeter"}},
+	})
+	_, failures, err := translator.translateConfigs([]*models.StoredConfig{cfg}, "")
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"time"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+// retryBackOffMaxFactor caps the exponential back-off at this multiple of the
+// base interval, matching Envoy's default.
+const retryBackOffMaxFactor = 10
+
+// routeRetryPolicy returns the route retry policy combining the route's retries block with
+// the back-off of the retry policy in its chain. Either may be absent; nil means the route
+// has no retry policy.
+func routeRetryPolicy(retry *models.RouteRetry, chain *models.PolicyChain) *route.RetryPolicy {
+	policy := retryPolicyFromChain(chain)
+	if retry == nil {
+		return policy
+	}
+	if policy == nil {
+		policy = &route.RetryPolicy{}
+	}
+	policy.RetryOn = retry.RetryOn
+	policy.NumRetries = wrapperspb.UInt32(uint32(retry.Attempts))
+	if retry.PerTryTimeout != nil && *retry.PerTryTimeout > 0 {
+		policy.PerTryTimeout = durationpb.New(*retry.PerTryTimeout)
+	}
+	return policy
+}
+
+// retryPolicyFromChain returns the route retry policy for a chain that contains
+// the retry policy, or nil. Retries are enabled per request by the policy through
+// the x-envoy-retry-* headers; the route only contributes the back-off, which
+// Envoy cannot take from a request header. With no retry_on set on the route,
+// requests the policy does not opt in are never retried.
+func retryPolicyFromChain(chain *models.PolicyChain) *route.RetryPolicy {
+	if chain == nil {
+		return nil
+	}
+	for _, p := range chain.Policies {
+		if p.Name != constants.RetryPolicyName {
+			continue
+		}
+		raw, ok := p.Params["backoffBase"].(string)
+		if !ok {
+			return nil
+		}
+		base, err := time.ParseDuration(raw)
+		if err != nil || base < time.Millisecond {
+			return nil
+		}
+		return &route.RetryPolicy{
+			RetryBackOff: &route.RetryPolicy_RetryBackOff{
+				BaseInterval: durationpb.New(base),
+				MaxInterval:  durationpb.New(base * retryBackOffMaxFactor),
+			},
+		}
+	}
+	return nil
+}
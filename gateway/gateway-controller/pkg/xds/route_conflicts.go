@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// routeOwners maps each route key to the configuration serving it. Route keys are
+// built from method, context, version, path and vhost (see GenerateRouteName), so
+// two configurations exposing the same path on the same vhost produce the same key
+// and one would silently replace the other in the route table.
+type routeOwners map[string]*models.StoredConfig
+
+// claim records cfg as the owner of its routes, or returns a conflict error naming
+// the configuration that already serves one of them; nothing is recorded then.
+// Routes without a route key (e.g. the catch-all) are not tracked.
+func (o routeOwners) claim(cfg *models.StoredConfig, routes []*route.Route) error {
+	for _, r := range routes {
+		if !isRouteKey(r.GetName()) {
+			continue
+		}
+		if owner, ok := o[r.GetName()]; ok && owner.UUID != cfg.UUID {
+			return fmt.Errorf("%w: route %s is already served by %s '%s'",
+				storage.ErrConflict, r.GetName(), owner.Kind, owner.Handle)
+		}
+	}
+	for _, r := range routes {
+		if isRouteKey(r.GetName()) {
+			o[r.GetName()] = cfg
+		}
+	}
+	return nil
+}
+
+// isRouteKey reports whether a route name is a "METHOD|PATH|VHOST" route key, with
+// an optional header-match discriminator segment.
+func isRouteKey(name string) bool {
+	return len(strings.Split(name, "|")) >= 3
+}
+
+// sortByCreation returns configs ordered by creation time, oldest first. Ties are
+// broken by ID so the order is stable across snapshots.
+func sortByCreation(configs []*models.StoredConfig) []*models.StoredConfig {
+	sorted := append([]*models.StoredConfig(nil), configs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+		}
+		return sorted[i].UUID < sorted[j].UUID
+	})
+	return sorted
+}
+
+// CheckRouteConflicts reports whether deploying cfg would produce a route that is
+// already served by another deployed configuration. cfg replaces any stored
+// configuration with the same ID. The returned error wraps storage.ErrConflict.
+func (sm *SnapshotManager) CheckRouteConflicts(cfg *models.StoredConfig) error {
+	if cfg.DesiredState == models.StateUndeployed {
+		return nil
+	}
+
+	configs := make([]*models.StoredConfig, 0)
+	for _, existing := range sm.store.GetAll() {
+		if existing.UUID != cfg.UUID {
+			configs = append(configs, existing)
+		}
+	}
+	allConfigs := append(configs, cfg)
+
+	owners := make(routeOwners)
+	for _, existing := range sortByCreation(configs) {
+		if existing.DesiredState == models.StateUndeployed {
+			continue
+		}
+		routes, _, err := sm.translator.translateConfig(sm.logger, existing, allConfigs)
+		if err != nil {
+			// Configurations that do not translate are left out of the snapshot anyway
+			continue
+		}
+		// Conflicts among already deployed configurations are reported by UpdateSnapshot
+		_ = owners.claim(existing, routes)
+	}
+
+	routes, _, err := sm.translator.translateConfig(sm.logger, cfg, allConfigs)
+	if err != nil {
+		// Reported by the snapshot update that deploys cfg
+		return nil
+	}
+	return owners.claim(cfg, routes)
+}
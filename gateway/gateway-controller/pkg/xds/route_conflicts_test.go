@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+func TestCheckRouteConflicts_SameContextAndPathRejected(t *testing.T) {
+	metrics.Init()
+	store := storage.NewConfigStore()
+	first := makeRestAPI("uuid-api-1", "api-one", "/shared")
+	if err := store.Add(first); err != nil {
+		t.Fatalf("Add api-one: %v", err)
+	}
+	sm := NewSnapshotManager(store, createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	second := makeRestAPI("uuid-api-2", "api-two", "/shared")
+	err := sm.CheckRouteConflicts(second)
+	if !errors.Is(err, storage.ErrConflict) {
+		t.Fatalf("expected storage.ErrConflict, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "api-one") {
+		t.Errorf("expected error to name the API serving the route, got %q", err)
+	}
+
+	// Redeploying the same API and deploying on another context do not conflict
+	if err := sm.CheckRouteConflicts(makeRestAPI("uuid-api-1", "api-one", "/shared")); err != nil {
+		t.Errorf("redeploy of the same API: unexpected error %v", err)
+	}
+	if err := sm.CheckRouteConflicts(makeRestAPI("uuid-api-3", "api-three", "/other")); err != nil {
+		t.Errorf("distinct context: unexpected error %v", err)
+	}
+}
+
+func TestUpdateSnapshot_RouteConflictFailsLaterConfig(t *testing.T) {
+	metrics.Init()
+	store := storage.NewConfigStore()
+	first := makeRestAPI("uuid-api-b", "api-one", "/shared")
+	first.CreatedAt = time.Now().Add(-time.Minute)
+	second := makeRestAPI("uuid-api-a", "api-two", "/shared")
+	second.CreatedAt = time.Now()
+	for _, cfg := range []*models.StoredConfig{first, second} {
+		if err := store.Add(cfg); err != nil {
+			t.Fatalf("Add %s: %v", cfg.Handle, err)
+		}
+	}
+	sm := NewSnapshotManager(store, createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	// With no previous snapshot the conflicting config is left out of the first one
+	if err := sm.UpdateSnapshot(context.Background(), ""); err != nil {
+		t.Fatalf("UpdateSnapshot: %v", err)
+	}
+	assertSnapshotContainsAPIs(t, sm, []string{"/shared"})
+
+	err := sm.UpdateSnapshot(context.Background(), "corr-1")
+	var snapErr *SnapshotError
+	if !errors.As(err, &snapErr) {
+		t.Fatalf("expected *SnapshotError, got %v", err)
+	}
+	if len(snapErr.Failures) != 1 || snapErr.Failures[0].ConfigID != second.UUID {
+		t.Fatalf("Failures = %+v, want only %s", snapErr.Failures, second.UUID)
+	}
+	if !errors.Is(snapErr.Failures[0].Err, storage.ErrConflict) {
+		t.Errorf("expected a conflict error, got %v", snapErr.Failures[0].Err)
+	}
+}
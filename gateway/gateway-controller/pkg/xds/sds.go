@@ -19,8 +19,10 @@
 package xds
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log/slog"
+	"sync"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -32,6 +34,8 @@ import (
 const (
 	// SecretNameUpstreamCA is the name of the SDS secret for upstream CA certificates
 	SecretNameUpstreamCA = "upstream_ca_bundle"
+	// SecretNameDownstreamCert is the name of the SDS secret for the HTTPS listener certificate
+	SecretNameDownstreamCert = "downstream_listener_cert"
 )
 
 // SDSSecretManager manages SDS secrets for TLS certificates
@@ -40,6 +44,9 @@ type SDSSecretManager struct {
 	certStore *certstore.CertStore
 	logger    *slog.Logger
 	nodeID    string
+
+	mu             sync.RWMutex
+	downstreamCert *tlsv3.Secret
 }
 
 // NewSDSSecretManager creates a new SDS secret manager
@@ -110,6 +117,64 @@ func (sm *SDSSecretManager) GetSecret() (types.Resource, error) {
 	return secret, nil
 }
 
+// SetDownstreamCertificate replaces the HTTPS listener certificate served over SDS.
+// The new certificate reaches Envoy with the next snapshot update.
+func (sm *SDSSecretManager) SetDownstreamCertificate(certPEM, keyPEM []byte) error {
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return fmt.Errorf("invalid downstream certificate key pair: %w", err)
+	}
+
+	secret := &tlsv3.Secret{
+		Name: SecretNameDownstreamCert,
+		Type: &tlsv3.Secret_TlsCertificate{
+			TlsCertificate: &tlsv3.TlsCertificate{
+				CertificateChain: &core.DataSource{
+					Specifier: &core.DataSource_InlineBytes{InlineBytes: certPEM},
+				},
+				PrivateKey: &core.DataSource{
+					Specifier: &core.DataSource_InlineBytes{InlineBytes: keyPEM},
+				},
+			},
+		},
+	}
+
+	sm.mu.Lock()
+	sm.downstreamCert = secret
+	sm.mu.Unlock()
+	return nil
+}
+
+// HasDownstreamCertificate reports whether an HTTPS listener certificate is served over SDS
+func (sm *SDSSecretManager) HasDownstreamCertificate() bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.downstreamCert != nil
+}
+
+// GetSecrets returns every SDS secret to include in the xDS snapshot: the upstream CA
+// bundle when the cert store has certificates, and the HTTPS listener certificate when set.
+func (sm *SDSSecretManager) GetSecrets() ([]types.Resource, error) {
+	var secrets []types.Resource
+	if sm.certStore != nil && len(sm.certStore.GetCombinedCertificates()) > 0 {
+		secret, err := sm.GetSecret()
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+
+	sm.mu.RLock()
+	if sm.downstreamCert != nil {
+		secrets = append(secrets, sm.downstreamCert)
+	}
+	sm.mu.RUnlock()
+
+	if len(secrets) == 0 {
+		return nil, fmt.Errorf("no SDS secrets available")
+	}
+	return secrets, nil
+}
+
 // GetNodeID returns the node ID for SDS clients
 func (sm *SDSSecretManager) GetNodeID() string {
 	return sm.nodeID
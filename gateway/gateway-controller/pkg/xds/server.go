@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"log/slog"
@@ -37,7 +38,9 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
 // Server is the xDS gRPC server
@@ -45,12 +48,13 @@ type Server struct {
 	grpcServer      *grpc.Server
 	xdsServer       server.Server
 	snapshotManager *SnapshotManager
+	callbacks       *serverCallbacks
 	port            int
 	logger          *slog.Logger
 }
 
 // NewServer creates a new xDS server
-func NewServer(snapshotManager *SnapshotManager, sdsSecretManager *SDSSecretManager, port int, logger *slog.Logger, onFirstConnect chan struct{}) *Server {
+func NewServer(snapshotManager *SnapshotManager, port int, logger *slog.Logger, onFirstConnect chan struct{}) *Server {
 	grpcServer := grpc.NewServer(
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Time:    30 * time.Second,
@@ -74,16 +78,15 @@ func NewServer(snapshotManager *SnapshotManager, sdsSecretManager *SDSSecretMana
 	routeservice.RegisterRouteDiscoveryServiceServer(grpcServer, xdsServer)
 	listenerservice.RegisterListenerDiscoveryServiceServer(grpcServer, xdsServer)
 
-	// Register SDS service (shares the same cache and server as main xDS)
-	if sdsSecretManager != nil {
-		secretservice.RegisterSecretDiscoveryServiceServer(grpcServer, xdsServer)
-		logger.Info("SDS service registered on main xDS server")
-	}
+	// Register SDS service (shares the same cache and server as main xDS). It is
+	// always registered because secrets can be added after startup.
+	secretservice.RegisterSecretDiscoveryServiceServer(grpcServer, xdsServer)
 
 	return &Server{
 		grpcServer:      grpcServer,
 		xdsServer:       xdsServer,
 		snapshotManager: snapshotManager,
+		callbacks:       callbacks,
 		port:            port,
 		logger:          logger,
 	}
@@ -105,6 +108,14 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// StartDraining makes the server refuse new xDS streams, so routers connect
+// to another controller, while streams that are already open keep receiving
+// updates until Stop.
+func (s *Server) StartDraining() {
+	s.logger.Info("Draining xDS server; new streams are refused")
+	s.callbacks.draining.Store(true)
+}
+
 // Stop gracefully stops the xDS server
 func (s *Server) Stop() {
 	s.logger.Info("Stopping xDS server")
@@ -119,8 +130,12 @@ type serverCallbacks struct {
 	onFirstConnect   chan struct{}
 	firstConnectOnce sync.Once
 	pendingNonces    map[int64]string // stream_id -> last sent nonce
+	draining         atomic.Bool
 }
 
+// errDraining is returned to streams opened while the server drains.
+var errDraining = status.Error(codes.Unavailable, "xDS server is shutting down")
+
 func NewServerCallbacks(logger *slog.Logger, onFirstConnect chan struct{}) *serverCallbacks {
 	return &serverCallbacks{
 		logger:         logger,
@@ -131,6 +146,10 @@ func NewServerCallbacks(logger *slog.Logger, onFirstConnect chan struct{}) *serv
 }
 
 func (cb *serverCallbacks) OnStreamOpen(ctx context.Context, id int64, typ string) error {
+	if cb.draining.Load() {
+		cb.logger.Info("Refusing xDS stream while draining", slog.Int64("stream_id", id))
+		return errDraining
+	}
 	cb.logger.Info("xDS stream opened", slog.Int64("stream_id", id), slog.String("type", typ))
 	return nil
 }
@@ -232,6 +251,9 @@ func (cb *serverCallbacks) OnFetchResponse(req *discoverygrpc.DiscoveryRequest,
 }
 
 func (cb *serverCallbacks) OnDeltaStreamOpen(ctx context.Context, id int64, typ string) error {
+	if cb.draining.Load() {
+		return errDraining
+	}
 	return nil
 }
 
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerCallbacks_RefuseStreamsWhileDraining(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := &Server{callbacks: NewServerCallbacks(logger, nil), logger: logger}
+
+	assert.NoError(t, s.callbacks.OnStreamOpen(context.Background(), 1, ""))
+	assert.NoError(t, s.callbacks.OnDeltaStreamOpen(context.Background(), 2, ""))
+
+	s.StartDraining()
+	err := s.callbacks.OnStreamOpen(context.Background(), 3, "")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	err = s.callbacks.OnDeltaStreamOpen(context.Background(), 4, "")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
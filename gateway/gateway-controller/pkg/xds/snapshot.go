@@ -20,17 +20,22 @@ package xds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	xdslog "github.com/envoyproxy/go-control-plane/pkg/log"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 )
 
@@ -92,7 +97,35 @@ func NewSnapshotManager(store *storage.ConfigStore, logger *slog.Logger, routerC
 
 // SetSDSSecretManager sets the SDS secret manager
 func (sm *SnapshotManager) SetSDSSecretManager(sdsSecretManager *SDSSecretManager) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
 	sm.sdsSecretManager = sdsSecretManager
+	sm.translator.SetSDSSecretManager(sdsSecretManager)
+}
+
+// RefreshSecrets reloads the SDS secrets after certificates change and pushes a
+// new snapshot so Envoy picks up the TLS material without a restart. When no SDS
+// secret manager was set at startup, one backed by the translator's cert store is
+// created on first use.
+func (sm *SnapshotManager) RefreshSecrets(ctx context.Context, correlationID string) error {
+	sm.mu.Lock()
+	if sm.sdsSecretManager == nil {
+		certStore := sm.translator.GetCertStore()
+		if certStore == nil {
+			sm.mu.Unlock()
+			return fmt.Errorf("certificate store not configured")
+		}
+		sm.sdsSecretManager = NewSDSSecretManager(certStore, sm.cache, sm.nodeID, sm.logger)
+		sm.translator.SetSDSSecretManager(sm.sdsSecretManager)
+		sm.logger.Info("SDS secret manager initialized for certificates added after startup")
+	}
+	sdsSecretManager := sm.sdsSecretManager
+	sm.mu.Unlock()
+
+	if err := sdsSecretManager.UpdateSecrets(); err != nil {
+		return fmt.Errorf("failed to update SDS secrets: %w", err)
+	}
+	return sm.UpdateSnapshot(ctx, correlationID)
 }
 
 // SetStatusCallback sets the callback for status updates
@@ -100,8 +133,33 @@ func (sm *SnapshotManager) SetStatusCallback(callback StatusUpdateCallback) {
 	sm.statusCallback = callback
 }
 
+// SnapshotError is returned by UpdateSnapshot when a newly built snapshot is
+// rejected. The previously applied snapshot is left in the cache untouched.
+type SnapshotError struct {
+	// Reason is a short machine-readable cause, matching the error_type label of
+	// the translation error metric (e.g. "translation_failed").
+	Reason string
+	// Failures lists the configurations that failed to translate, if any.
+	Failures []TranslationFailure
+	Err      error
+}
+
+func (e *SnapshotError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SnapshotError) Unwrap() error {
+	return e.Err
+}
+
 // UpdateSnapshot generates a new xDS snapshot from all configurations and updates the cache
 // The correlationID parameter is optional and used for request tracing in logs
+//
+// The update is atomic: the new snapshot is fully built and validated before it
+// replaces the current one. If any configuration fails to translate or the
+// snapshot is inconsistent, the previous snapshot is kept and a *SnapshotError
+// is returned. When no snapshot has been applied yet there is nothing to keep,
+// so configurations that fail to translate are left out instead.
 func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context, correlationID string) error {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
@@ -123,33 +181,49 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context, correlationID str
 		sm.afterGetAll()
 	}
 
-	resources, err := sm.translator.TranslateConfigs(configs, correlationID)
+	_, err := sm.cache.GetSnapshot(sm.nodeID)
+	hasPrevious := err == nil
+
+	resources, failures, err := sm.translator.translateConfigs(configs, correlationID)
 	if err != nil {
-		log.Error("Failed to translate configurations", slog.Any("error", err))
-		metrics.SnapshotGenerationTotal.WithLabelValues("main", "error", trigger).Inc()
-		metrics.TranslationErrorsTotal.WithLabelValues("translation_failed").Inc()
-		// Mark all pending configs as failed
-		if sm.statusCallback != nil {
-			for _, cfg := range configs {
-				sm.statusCallback(cfg.UUID, false, correlationID)
-			}
+		return sm.rejectSnapshot(log, configs, correlationID, trigger, &SnapshotError{
+			Reason: "translation_failed",
+			Err:    fmt.Errorf("failed to translate configurations: %w", err),
+		})
+	}
+	if len(failures) > 0 {
+		errs := make([]error, 0, len(failures))
+		for _, f := range failures {
+			errs = append(errs, fmt.Errorf("%s %q (%s): %w", f.Kind, f.Handle, f.ConfigID, f.Err))
+		}
+		snapErr := &SnapshotError{
+			Reason:   "translation_failed",
+			Failures: failures,
+			Err:      fmt.Errorf("failed to translate configurations: %w", errors.Join(errs...)),
 		}
-		return fmt.Errorf("failed to translate configurations: %w", err)
+		if hasPrevious {
+			return sm.rejectSnapshot(log, configs, correlationID, trigger, snapErr)
+		}
+		log.Warn("No previous snapshot to keep, applying snapshot without failed configurations",
+			slog.Int("failed_configs", len(failures)),
+			slog.Any("error", snapErr))
+		metrics.TranslationErrorsTotal.WithLabelValues("translation_failed").Add(float64(len(failures)))
 	}
 
 	// Add SDS secrets if SDS secret manager is configured
 	if sm.sdsSecretManager != nil {
-		secret, err := sm.sdsSecretManager.GetSecret()
+		secrets, err := sm.sdsSecretManager.GetSecrets()
 		if err != nil {
-			log.Warn("Failed to get SDS secret, continuing without it", slog.Any("error", err))
+			log.Warn("Failed to get SDS secrets, continuing without them", slog.Any("error", err))
 		} else {
-			resources[resource.SecretType] = []types.Resource{secret}
-			log.Debug("Added SDS secret to snapshot", slog.String("secret_name", SecretNameUpstreamCA))
+			resources[resource.SecretType] = secrets
+			log.Debug("Added SDS secrets to snapshot", slog.Int("secret_count", len(secrets)))
 		}
 	}
 
-	// Increment snapshot version
-	version := sm.store.IncrementSnapshotVersion()
+	// The version is only committed once the snapshot has been applied, so a
+	// rejected snapshot does not leave a gap in the version sequence.
+	version := sm.store.GetSnapshotVersion() + 1
 
 	// Create new snapshot
 	snapshot, err := cache.NewSnapshot(
@@ -157,45 +231,34 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context, correlationID str
 		resources,
 	)
 	if err != nil {
-		log.Error("Failed to create snapshot", slog.Any("error", err))
-		metrics.SnapshotGenerationTotal.WithLabelValues("main", "error", trigger).Inc()
-		metrics.TranslationErrorsTotal.WithLabelValues("snapshot_create_failed").Inc()
-		// Mark all pending configs as failed
-		if sm.statusCallback != nil {
-			for _, cfg := range configs {
-				sm.statusCallback(cfg.UUID, false, correlationID)
-			}
-		}
-		return fmt.Errorf("failed to create snapshot: %w", err)
+		return sm.rejectSnapshot(log, configs, correlationID, trigger, &SnapshotError{
+			Reason: "snapshot_create_failed",
+			Err:    fmt.Errorf("failed to create snapshot: %w", err),
+		})
 	}
 
 	// Validate snapshot consistency
 	if err := snapshot.Consistent(); err != nil {
-		log.Error("Snapshot is inconsistent", slog.Any("error", err))
-		metrics.SnapshotGenerationTotal.WithLabelValues("main", "error", trigger).Inc()
-		metrics.TranslationErrorsTotal.WithLabelValues("snapshot_inconsistent").Inc()
-		// Mark all pending configs as failed
-		if sm.statusCallback != nil {
-			for _, cfg := range configs {
-				sm.statusCallback(cfg.UUID, false, correlationID)
-			}
-		}
-		return fmt.Errorf("snapshot is inconsistent: %w", err)
+		return sm.rejectSnapshot(log, configs, correlationID, trigger, &SnapshotError{
+			Reason: "snapshot_inconsistent",
+			Err:    fmt.Errorf("snapshot is inconsistent: %w", err),
+		})
+	}
+	if err := validateClusterReferences(resources); err != nil {
+		return sm.rejectSnapshot(log, configs, correlationID, trigger, &SnapshotError{
+			Reason: "snapshot_inconsistent",
+			Err:    fmt.Errorf("snapshot is inconsistent: %w", err),
+		})
 	}
 
 	// Update cache with new snapshot
 	if err := sm.cache.SetSnapshot(ctx, sm.nodeID, snapshot); err != nil {
-		log.Error("Failed to set snapshot", slog.Any("error", err))
-		metrics.SnapshotGenerationTotal.WithLabelValues("main", "error", trigger).Inc()
-		metrics.TranslationErrorsTotal.WithLabelValues("cache_set_failed").Inc()
-		// Mark all pending configs as failed
-		if sm.statusCallback != nil {
-			for _, cfg := range configs {
-				sm.statusCallback(cfg.UUID, false, correlationID)
-			}
-		}
-		return fmt.Errorf("failed to set snapshot: %w", err)
+		return sm.rejectSnapshot(log, configs, correlationID, trigger, &SnapshotError{
+			Reason: "cache_set_failed",
+			Err:    fmt.Errorf("failed to set snapshot: %w", err),
+		})
 	}
+	sm.store.IncrementSnapshotVersion()
 
 	log.Info("Updated xDS snapshot",
 		slog.Int64("version", version),
@@ -222,16 +285,88 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context, correlationID str
 		metrics.RoutesPerAPI.Observe(routesPerAPI)
 	}
 
-	// Mark all successfully deployed configs
+	// Mark deployed configs; those left out of a first snapshot are reported as failed.
 	if sm.statusCallback != nil {
+		failed := make(map[string]bool, len(failures))
+		for _, f := range failures {
+			failed[f.ConfigID] = true
+		}
 		for _, cfg := range configs {
-			sm.statusCallback(cfg.UUID, true, correlationID)
+			sm.statusCallback(cfg.UUID, !failed[cfg.UUID], correlationID)
 		}
 	}
 
 	return nil
 }
 
+// rejectSnapshot records a rejected snapshot, marks all configs as failed and
+// returns snapErr. The snapshot currently in the cache is not modified.
+func (sm *SnapshotManager) rejectSnapshot(log *slog.Logger, configs []*models.StoredConfig, correlationID, trigger string, snapErr *SnapshotError) error {
+	log.Error("Rejected xDS snapshot, keeping previous snapshot",
+		slog.String("reason", snapErr.Reason),
+		slog.Any("error", snapErr.Err))
+	metrics.SnapshotGenerationTotal.WithLabelValues("main", "error", trigger).Inc()
+	metrics.TranslationErrorsTotal.WithLabelValues(snapErr.Reason).Inc()
+	metrics.XDSSnapshotRollbacksTotal.WithLabelValues(snapErr.Reason).Inc()
+	// Mark all pending configs as failed
+	if sm.statusCallback != nil {
+		for _, cfg := range configs {
+			sm.statusCallback(cfg.UUID, false, correlationID)
+		}
+	}
+	return snapErr
+}
+
+// validateClusterReferences checks that every cluster a route forwards to is
+// part of the snapshot. snapshot.Consistent only covers listener to route and
+// cluster to endpoint references.
+func validateClusterReferences(resources map[resource.Type][]types.Resource) error {
+	clusters := make(map[string]struct{}, len(resources[resource.ClusterType]))
+	for _, res := range resources[resource.ClusterType] {
+		if c, ok := res.(*clusterv3.Cluster); ok {
+			clusters[c.GetName()] = struct{}{}
+		}
+	}
+
+	var missing []string
+	check := func(routeName, clusterName string) {
+		if _, ok := clusters[clusterName]; !ok {
+			missing = append(missing, fmt.Sprintf("%s -> %s", routeName, clusterName))
+		}
+	}
+	for _, res := range resources[resource.RouteType] {
+		rc, ok := res.(*routev3.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		for _, vh := range rc.GetVirtualHosts() {
+			for _, r := range vh.GetRoutes() {
+				action := r.GetRoute()
+				if action == nil {
+					continue
+				}
+				if name := action.GetCluster(); name != "" {
+					check(r.GetName(), name)
+				}
+				for _, wc := range action.GetWeightedClusters().GetClusters() {
+					check(r.GetName(), wc.GetName())
+				}
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("routes reference undefined clusters: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// HasSnapshot reports whether at least one snapshot has been pushed to the cache.
+func (sm *SnapshotManager) HasSnapshot() bool {
+	_, err := sm.cache.GetSnapshot(sm.nodeID)
+	return err == nil
+}
+
 // GetCache returns the snapshot cache for use by xDS server
 func (sm *SnapshotManager) GetCache() cache.SnapshotCache {
 	return sm.cache
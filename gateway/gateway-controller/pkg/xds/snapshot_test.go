@@ -20,12 +20,15 @@ package xds
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
@@ -180,3 +183,184 @@ func assertSnapshotContainsAPIs(t *testing.T, sm *SnapshotManager, expectedConte
 		}
 	}
 }
+
+func TestUpdateSnapshot_KeepsPreviousSnapshotOnTranslationFailure(t *testing.T) {
+	metrics.Init()
+	store := storage.NewConfigStore()
+	if err := store.Add(makeRestAPI("uuid-api-1", "api-one", "/api-one")); err != nil {
+		t.Fatalf("Add api-one: %v", err)
+	}
+
+	sm := NewSnapshotManager(store, createTestLogger(), testRouterConfig(), nil, testConfig())
+	var failed []string
+	sm.SetStatusCallback(func(configID string, success bool, correlationID string) {
+		if !success {
+			failed = append(failed, configID)
+		}
+	})
+
+	if err := sm.UpdateSnapshot(context.Background(), "corr-1"); err != nil {
+		t.Fatalf("initial UpdateSnapshot: %v", err)
+	}
+	good, err := sm.GetCache().GetSnapshot("router-node")
+	if err != nil {
+		t.Fatalf("GetSnapshot: %v", err)
+	}
+	goodVersion := good.GetVersion(resource.RouteType)
+
+	bad := &models.StoredConfig{
+		UUID:          "uuid-api-bad",
+		Kind:          models.KindRestApi,
+		Handle:        "api-bad",
+		DesiredState:  models.StateDeployed,
+		Configuration: "invalid-configuration",
+	}
+	if err := store.Add(bad); err != nil {
+		t.Fatalf("Add api-bad: %v", err)
+	}
+
+	err = sm.UpdateSnapshot(context.Background(), "corr-2")
+	var snapErr *SnapshotError
+	if !errors.As(err, &snapErr) {
+		t.Fatalf("expected *SnapshotError, got %v", err)
+	}
+	if snapErr.Reason != "translation_failed" {
+		t.Errorf("Reason = %q, want translation_failed", snapErr.Reason)
+	}
+	if len(snapErr.Failures) != 1 || snapErr.Failures[0].ConfigID != bad.UUID {
+		t.Errorf("Failures = %+v, want only %s", snapErr.Failures, bad.UUID)
+	}
+	if len(failed) == 0 {
+		t.Error("expected status callback to report failure")
+	}
+
+	current, err := sm.GetCache().GetSnapshot("router-node")
+	if err != nil {
+		t.Fatalf("GetSnapshot: %v", err)
+	}
+	if got := current.GetVersion(resource.RouteType); got != goodVersion {
+		t.Errorf("snapshot version = %s, want previous %s", got, goodVersion)
+	}
+	assertSnapshotContainsAPIs(t, sm, []string{"/api-one"})
+	if got := store.GetSnapshotVersion(); got != 1 {
+		t.Errorf("snapshot version counter = %d, want 1", got)
+	}
+}
+
+func TestUpdateSnapshot_FirstSnapshotSkipsFailedConfigs(t *testing.T) {
+	metrics.Init()
+	store := storage.NewConfigStore()
+	if err := store.Add(makeRestAPI("uuid-api-1", "api-one", "/api-one")); err != nil {
+		t.Fatalf("Add api-one: %v", err)
+	}
+	if err := store.Add(&models.StoredConfig{
+		UUID:          "uuid-api-bad",
+		Kind:          models.KindRestApi,
+		Handle:        "api-bad",
+		DesiredState:  models.StateDeployed,
+		Configuration: "invalid-configuration",
+	}); err != nil {
+		t.Fatalf("Add api-bad: %v", err)
+	}
+
+	sm := NewSnapshotManager(store, createTestLogger(), testRouterConfig(), nil, testConfig())
+	status := make(map[string]bool)
+	sm.SetStatusCallback(func(configID string, success bool, correlationID string) {
+		status[configID] = success
+	})
+
+	if err := sm.UpdateSnapshot(context.Background(), ""); err != nil {
+		t.Fatalf("UpdateSnapshot: %v", err)
+	}
+	assertSnapshotContainsAPIs(t, sm, []string{"/api-one"})
+	if !status["uuid-api-1"] || status["uuid-api-bad"] {
+		t.Errorf("unexpected deployment status: %v", status)
+	}
+}
+
+func TestValidateClusterReferences(t *testing.T) {
+	routeTo := func(clusterName string) *route.Route {
+		return &route.Route{
+			Name: "GET|/r|localhost",
+			Action: &route.Route_Route{Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{Cluster: clusterName},
+			}},
+		}
+	}
+	resources := func(clusterName string) map[resource.Type][]types.Resource {
+		return map[resource.Type][]types.Resource{
+			resource.ClusterType: {&cluster.Cluster{Name: "backend"}},
+			resource.RouteType: {&route.RouteConfiguration{
+				Name:         "local_route",
+				VirtualHosts: []*route.VirtualHost{{Name: "localhost", Routes: []*route.Route{routeTo(clusterName)}}},
+			}},
+		}
+	}
+
+	if err := validateClusterReferences(resources("backend")); err != nil {
+		t.Errorf("expected defined cluster to validate, got %v", err)
+	}
+	err := validateClusterReferences(resources("missing"))
+	if err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("expected undefined cluster error, got %v", err)
+	}
+}
+
+func TestUpdateSnapshot_WebSocketRouteUpgradeConfig(t *testing.T) {
+	metrics.Init()
+	store := storage.NewConfigStore()
+	cfg := makeRestAPI("uuid-ws", "chat", "/chat")
+	restCfg := cfg.Configuration.(api.RestAPI)
+	restCfg.Spec.Operations = append(restCfg.Spec.Operations, api.Operation{
+		Method:  api.Ptr(api.OperationMethodGET),
+		Path:    api.Ptr("/socket"),
+		Upgrade: api.Ptr(api.OperationUpgradeWebsocket),
+	})
+	cfg.Configuration = restCfg
+	cfg.SourceConfiguration = restCfg
+	if err := store.Add(cfg); err != nil {
+		t.Fatalf("Add chat: %v", err)
+	}
+
+	sm := NewSnapshotManager(store, createTestLogger(), testRouterConfig(), nil, testConfig())
+	if err := sm.UpdateSnapshot(context.Background(), ""); err != nil {
+		t.Fatalf("UpdateSnapshot: %v", err)
+	}
+	snap, err := sm.GetCache().GetSnapshot("router-node")
+	if err != nil {
+		t.Fatalf("GetSnapshot: %v", err)
+	}
+
+	routes := make(map[string]*route.Route)
+	for _, res := range snap.GetResources(resource.RouteType) {
+		routeCfg, ok := res.(*route.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		for _, vh := range routeCfg.GetVirtualHosts() {
+			for _, r := range vh.GetRoutes() {
+				routes[r.GetName()] = r
+			}
+		}
+	}
+
+	socket := routes["GET|/chat/socket|localhost"]
+	if socket == nil {
+		t.Fatalf("websocket route missing from snapshot; got %d routes", len(routes))
+	}
+	upgrades := socket.GetRoute().GetUpgradeConfigs()
+	if len(upgrades) != 1 || upgrades[0].GetUpgradeType() != "websocket" {
+		t.Errorf("upgrade configs = %v, want a single websocket upgrade", upgrades)
+	}
+	if got := socket.GetRoute().GetTimeout().AsDuration(); got != 0 {
+		t.Errorf("websocket route timeout = %s, want 0 (disabled)", got)
+	}
+
+	plain := routes["GET|/chat/resource|localhost"]
+	if plain == nil {
+		t.Fatal("plain route missing from snapshot")
+	}
+	if upgrades := plain.GetRoute().GetUpgradeConfigs(); len(upgrades) != 0 {
+		t.Errorf("plain route upgrade configs = %v, want none", upgrades)
+	}
+}
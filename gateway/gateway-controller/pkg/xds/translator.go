@@ -95,6 +95,7 @@ type Translator struct {
 	config            *config.Config
 	transformers      map[string]models.ConfigTransformer // kind → transformer (optional)
 	eventGatewayHooks EventGatewayXDSHooks                // optional, set by an event-gateway-controller binary
+	sdsSecretManager  *SDSSecretManager                   // optional, serves the HTTPS listener certificate over SDS
 }
 
 // resolvedTimeout represents parsed timeout values for an upstream.
@@ -117,12 +118,12 @@ func NewTranslator(logger *slog.Logger, routerConfig *config.RouterConfig, db st
 			routerConfig.Upstream.TLS.TrustedCertPath,
 		)
 
-		// Load certificates at initialization
+		// Load certificates at initialization. The store is kept even when it is
+		// empty so certificates uploaded later through the API can be served over SDS.
 		if _, err := cs.LoadCertificates(); err != nil {
-			logger.Warn("Failed to initialize certificate store, will use system certs only",
+			logger.Warn("No certificates loaded into certificate store, will use system certs until certificates are added",
 				slog.String("custom_certs_path", routerConfig.Upstream.TLS.CustomCertsPath),
 				slog.Any("error", err))
-			cs = nil // Don't use cert store if initialization failed
 		}
 	}
 
@@ -213,6 +214,23 @@ func (t *Translator) GetCertStore() *certstore.CertStore {
 	return t.certStore
 }
 
+// SetSDSSecretManager sets the SDS secret manager used to reference the HTTPS
+// listener certificate instead of reading it from downstream_tls cert_path/key_path
+func (t *Translator) SetSDSSecretManager(sdsSecretManager *SDSSecretManager) {
+	t.sdsSecretManager = sdsSecretManager
+}
+
+// hasUpstreamCABundle reports whether the cert store holds certificates to serve
+// as the upstream CA bundle over SDS
+func (t *Translator) hasUpstreamCABundle() bool {
+	return t.certStore != nil && len(t.certStore.GetCombinedCertificates()) > 0
+}
+
+// hasSDSDownstreamCertificate reports whether the HTTPS listener certificate is served over SDS
+func (t *Translator) hasSDSDownstreamCertificate() bool {
+	return t.sdsSecretManager != nil && t.sdsSecretManager.HasDownstreamCertificate()
+}
+
 // appendMCPResourcePathToBackend reports whether the legacy behaviour of appending the
 // "/mcp" resource path to the MCP backend upstream is enabled via configuration. When
 // enabled, MCP "/mcp" routes fall through to the standard rewrite (which preserves the
@@ -255,10 +273,14 @@ func (t *Translator) translateRuntimeConfig(rdc *models.RuntimeDeployConfig) ([]
 				parsedURL.Scheme = "https"
 			}
 			c := t.createCluster(clusterName, parsedURL, nil, connectTimeout)
+			c.HealthChecks = createHealthChecks(uc.HealthCheck)
+			c.OutlierDetection = createOutlierDetection(uc.OutlierDetection)
 			clusters = append(clusters, c)
 			continue
 		}
 		c := t.createWeightedCluster(clusterName, uc.Endpoints, uc.TLS, connectTimeout)
+		c.HealthChecks = createHealthChecks(uc.HealthCheck)
+		c.OutlierDetection = createOutlierDetection(uc.OutlierDetection)
 		clusters = append(clusters, c)
 	}
 
@@ -278,7 +300,11 @@ func (t *Translator) translateRuntimeConfig(rdc *models.RuntimeDeployConfig) ([]
 		return routeKeys[i] < routeKeys[j]
 	})
 	for _, routeKey := range routeKeys {
-		r := t.createRouteFromRDC(routeKey, rdc.Routes[routeKey], rdc)
+		rdcRoute := rdc.Routes[routeKey]
+		r := t.createRouteFromRDC(routeKey, rdcRoute, rdc)
+		if err := applyMaxRequestBytes(r, rdcRoute.MaxRequestBytes, rdcRoute.UpgradeType); err != nil {
+			return nil, nil, err
+		}
 		routes = append(routes, r)
 	}
 
@@ -326,11 +352,25 @@ func (t *Translator) createRouteFromRDC(routeKey string, rdcRoute *models.Route,
 		Route: &route.RouteAction{
 			Timeout:     t.routeTimeoutOrDefault(routeResilienceTimeout, t.routerConfig.Upstream.Timeouts.RouteTimeoutMs),
 			IdleTimeout: t.routeTimeoutOrDefault(routeResilienceIdle, t.routerConfig.Upstream.Timeouts.RouteIdleTimeoutMs),
+			RetryPolicy: routeRetryPolicy(rdcRoute.Retry, rdc.PolicyChains[routeKey]),
 		},
 	}
+	applyUpgrade(routeAction.Route, rdcRoute.UpgradeType, routeResilienceTimeout != nil)
 
-	// Set cluster specifier
-	if rdcRoute.Upstream.UseClusterHeader {
+	// Set cluster specifier. Weighted upstreams split traffic across their clusters and
+	// take precedence over dynamic cluster selection.
+	if len(rdcRoute.Upstream.WeightedClusters) > 0 {
+		weighted := make([]*route.WeightedCluster_ClusterWeight, 0, len(rdcRoute.Upstream.WeightedClusters))
+		for _, wc := range rdcRoute.Upstream.WeightedClusters {
+			weighted = append(weighted, &route.WeightedCluster_ClusterWeight{
+				Name:   wc.ClusterKey,
+				Weight: &wrapperspb.UInt32Value{Value: uint32(wc.Weight)},
+			})
+		}
+		routeAction.Route.ClusterSpecifier = &route.RouteAction_WeightedClusters{
+			WeightedClusters: &route.WeightedCluster{Clusters: weighted},
+		}
+	} else if rdcRoute.Upstream.UseClusterHeader {
 		routeAction.Route.ClusterSpecifier = &route.RouteAction_ClusterHeader{
 			ClusterHeader: constants.TargetUpstreamHeader,
 		}
@@ -516,6 +556,45 @@ func (t *Translator) setMatchPathSpecifier(m *route.RouteMatch, fullPath, operat
 	}
 }
 
+// createHealthChecks maps an upstream health check onto Envoy active HTTP health checking.
+// Each expected status becomes a single-code range; without any, Envoy accepts only 200.
+func createHealthChecks(hc *models.HealthCheck) []*core.HealthCheck {
+	if hc == nil {
+		return nil
+	}
+	httpCheck := &core.HealthCheck_HttpHealthCheck{
+		Path: hc.Path,
+		Host: hc.Host,
+	}
+	for _, status := range hc.ExpectedStatuses {
+		httpCheck.ExpectedStatuses = append(httpCheck.ExpectedStatuses,
+			&typev3.Int64Range{Start: int64(status), End: int64(status) + 1})
+	}
+	return []*core.HealthCheck{{
+		Timeout:            durationpb.New(hc.Timeout),
+		Interval:           durationpb.New(hc.Interval),
+		UnhealthyThreshold: wrapperspb.UInt32(uint32(hc.UnhealthyThreshold)),
+		HealthyThreshold:   wrapperspb.UInt32(uint32(hc.HealthyThreshold)),
+		HealthChecker: &core.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: httpCheck,
+		},
+	}}
+}
+
+// createOutlierDetection maps upstream outlier detection onto Envoy's consecutive 5xx
+// ejection. Gateway failures are not tracked separately since they already count as 5xx.
+func createOutlierDetection(od *models.OutlierDetection) *cluster.OutlierDetection {
+	if od == nil {
+		return nil
+	}
+	return &cluster.OutlierDetection{
+		Consecutive_5Xx:    wrapperspb.UInt32(uint32(od.Consecutive5xx)),
+		Interval:           durationpb.New(od.Interval),
+		BaseEjectionTime:   durationpb.New(od.BaseEjectionTime),
+		MaxEjectionPercent: wrapperspb.UInt32(uint32(od.MaxEjectionPercent)),
+	}
+}
+
 func (t *Translator) createWeightedCluster(
 	name string,
 	endpoints []models.Endpoint,
@@ -618,12 +697,32 @@ func (t *Translator) createWeightedCluster(
 	return c
 }
 
+// TranslationFailure describes a single configuration that could not be
+// translated into Envoy resources.
+type TranslationFailure struct {
+	ConfigID string
+	Kind     string
+	Handle   string
+	Err      error
+}
+
 // TranslateConfigs translates all API configurations to Envoy resources
 // The correlationID parameter is optional and used for request tracing in logs
+// Configurations that fail to translate are logged and left out of the result.
 func (t *Translator) TranslateConfigs(
 	configs []*models.StoredConfig,
 	correlationID string,
 ) (map[resource.Type][]types.Resource, error) {
+	resources, _, err := t.translateConfigs(configs, correlationID)
+	return resources, err
+}
+
+// translateConfigs is TranslateConfigs, additionally reporting the configurations
+// that were left out because they failed to translate.
+func (t *Translator) translateConfigs(
+	configs []*models.StoredConfig,
+	correlationID string,
+) (map[resource.Type][]types.Resource, []TranslationFailure, error) {
 	// Create a logger with correlation ID if provided
 	log := t.logger
 	if correlationID != "" {
@@ -638,8 +737,12 @@ func (t *Translator) TranslateConfigs(
 	// All API routes are consolidated into one virtual host to avoid wildcard domain conflicts
 	allRoutes := make([]*route.Route, 0)
 	clusterMap := make(map[string]*cluster.Cluster)
+	var failures []TranslationFailure
 
-	for _, cfg := range configs {
+	// Configurations claim their routes in creation order, so when two of them produce
+	// the same route key the one deployed first keeps serving it.
+	owners := make(routeOwners)
+	for _, cfg := range sortByCreation(configs) {
 		// Skip undeployed APIs - they should not appear in xDS routes
 		if cfg.DesiredState == models.StateUndeployed {
 			log.Debug("Skipping undeployed API in xDS translation",
@@ -653,48 +756,18 @@ func (t *Translator) TranslateConfigs(
 		// while ensuring existing deployed APIs are not overridden when deploying new ones.
 
 		// Create routes and clusters for this API
-		var routesList []*route.Route
-		var clusterList []*cluster.Cluster
-		var err error
-
-		// Try RuntimeDeployConfig transformer path first (produces minimal metadata routes)
-		if transformer, ok := t.transformers[cfg.Kind]; ok {
-			rdc, transformErr := transformer.Transform(cfg)
-			if transformErr != nil {
-				log.Error("Failed to transform config via RuntimeDeployConfig, falling back to legacy path",
-					slog.String("id", cfg.UUID),
-					slog.String("kind", cfg.Kind),
-					slog.Any("error", transformErr))
-				// Fall through to legacy path
-			} else {
-				routesList, clusterList, err = t.translateRuntimeConfig(rdc)
-				if err != nil {
-					log.Error("Failed to translate RuntimeDeployConfig",
-						slog.String("id", cfg.UUID),
-						slog.Any("error", err))
-					continue
-				}
-			}
+		routesList, clusterList, err := t.translateConfig(log, cfg, configs)
+		if err != nil {
+			failures = append(failures, TranslationFailure{ConfigID: cfg.UUID, Kind: cfg.Kind, Handle: cfg.Handle, Err: err})
+			continue
 		}
-
-		// Legacy path: direct translation from StoredConfig (WebSubApi, or fallback)
-		if routesList == nil {
-			if cfg.Kind == "WebSubApi" {
-				if t.eventGatewayHooks == nil {
-					err = fmt.Errorf("WebSubApi configured but event-gateway support is not compiled into this binary")
-				} else {
-					routesList, clusterList, err = t.eventGatewayHooks.TranslateWebSubAPI(t, cfg, configs)
-				}
-			} else {
-				routesList, clusterList, err = t.translateAPIConfig(cfg, configs)
-			}
-			if err != nil {
-				log.Error("Failed to translate config",
-					slog.String("id", cfg.UUID),
-					slog.String("displayName", cfg.DisplayName),
-					slog.Any("error", err))
-				continue
-			}
+		if err := owners.claim(cfg, routesList); err != nil {
+			log.Error("Route conflicts with another configuration",
+				slog.String("id", cfg.UUID),
+				slog.String("displayName", cfg.DisplayName),
+				slog.Any("error", err))
+			failures = append(failures, TranslationFailure{ConfigID: cfg.UUID, Kind: cfg.Kind, Handle: cfg.Handle, Err: err})
+			continue
 		}
 
 		allRoutes = append(allRoutes, routesList...)
@@ -735,7 +808,7 @@ func (t *Translator) TranslateConfigs(
 			Override: &extproc.ExtProcPerRoute_Disabled{Disabled: true},
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal ExtProcPerRoute for catch-all route: %w", err)
+			return nil, nil, fmt.Errorf("failed to marshal ExtProcPerRoute for catch-all route: %w", err)
 		}
 		routes = append(routes, &route.Route{
 			Name: "no-api-found",
@@ -791,7 +864,7 @@ func (t *Translator) TranslateConfigs(
 	// Always create the HTTP listener, even with no APIs deployed
 	httpListener, routeConfig, err := t.createListener(virtualHosts, false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP listener: %w", err)
+		return nil, nil, fmt.Errorf("failed to create HTTP listener: %w", err)
 	}
 	listeners = append(listeners, httpListener)
 	sharedRouteConfig = routeConfig // Save route config for RDS
@@ -803,7 +876,7 @@ func (t *Translator) TranslateConfigs(
 		httpsListener, _, err := t.createListener(virtualHosts, true)
 		if err != nil {
 			log.Error("Failed to create HTTPS listener", slog.Any("error", err))
-			return nil, fmt.Errorf("failed to create HTTPS listener: %w", err)
+			return nil, nil, fmt.Errorf("failed to create HTTPS listener: %w", err)
 		}
 		log.Info("HTTPS listener created successfully",
 			slog.String("listener_name", httpsListener.GetName()))
@@ -841,7 +914,7 @@ func (t *Translator) TranslateConfigs(
 	if t.eventGatewayHooks != nil {
 		hubClusters, hubListeners, err := t.eventGatewayHooks.BuildHubResources(t, t.routerConfig.HTTPSEnabled)
 		if err != nil {
-			return nil, fmt.Errorf("failed to build event-gateway hub resources: %w", err)
+			return nil, nil, fmt.Errorf("failed to build event-gateway hub resources: %w", err)
 		}
 		for _, c := range hubClusters {
 			clusters = append(clusters, c)
@@ -851,9 +924,9 @@ func (t *Translator) TranslateConfigs(
 		}
 	}
 
-	// Add SDS cluster if cert store is enabled
+	// Add SDS cluster if any TLS context references an SDS secret
 	// This cluster allows Envoy to fetch certificates from the SDS service
-	if t.certStore != nil {
+	if t.hasUpstreamCABundle() || t.hasSDSDownstreamCertificate() {
 		sdsCluster := t.createSDSCluster()
 		clusters = append(clusters, sdsCluster)
 	}
@@ -886,7 +959,7 @@ func (t *Translator) TranslateConfigs(
 		}
 	}
 
-	return resources, nil
+	return resources, failures, nil
 }
 
 // getVHostDomains returns Envoy domain patterns for a resolved vhost.
@@ -934,6 +1007,58 @@ func (t *Translator) getVHostDomains(effectiveVHost string) []string {
 	return appendDomainPatterns(out, effectiveVHost)
 }
 
+// translateConfig translates a single configuration of any kind into its routes and
+// clusters. allConfigs is passed through to kinds that reference other configurations.
+func (t *Translator) translateConfig(log *slog.Logger, cfg *models.StoredConfig, allConfigs []*models.StoredConfig) ([]*route.Route, []*cluster.Cluster, error) {
+	var routesList []*route.Route
+	var clusterList []*cluster.Cluster
+	var err error
+
+	// Try RuntimeDeployConfig transformer path first (produces minimal metadata routes)
+	if transformer, ok := t.transformers[cfg.Kind]; ok {
+		rdc, transformErr := transformer.Transform(cfg)
+		if transformErr != nil {
+			log.Error("Failed to transform config via RuntimeDeployConfig, falling back to legacy path",
+				slog.String("id", cfg.UUID),
+				slog.String("kind", cfg.Kind),
+				slog.Any("error", transformErr))
+			// Fall through to legacy path
+		} else {
+			routesList, clusterList, err = t.translateRuntimeConfig(rdc)
+			if err != nil {
+				log.Error("Failed to translate RuntimeDeployConfig",
+					slog.String("id", cfg.UUID),
+					slog.Any("error", err))
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Legacy path: direct translation from StoredConfig (WebSubApi, GrpcApi, or fallback)
+	if routesList == nil {
+		if cfg.Kind == "WebSubApi" {
+			if t.eventGatewayHooks == nil {
+				err = fmt.Errorf("WebSubApi configured but event-gateway support is not compiled into this binary")
+			} else {
+				routesList, clusterList, err = t.eventGatewayHooks.TranslateWebSubAPI(t, cfg, allConfigs)
+			}
+		} else if cfg.Kind == models.KindGrpcApi {
+			routesList, clusterList, err = t.translateGrpcAPIConfig(cfg)
+		} else {
+			routesList, clusterList, err = t.translateAPIConfig(cfg, allConfigs)
+		}
+		if err != nil {
+			log.Error("Failed to translate config",
+				slog.String("id", cfg.UUID),
+				slog.String("displayName", cfg.DisplayName),
+				slog.Any("error", err))
+			return nil, nil, err
+		}
+	}
+
+	return routesList, clusterList, nil
+}
+
 // translateAPIConfig translates a single API configuration
 func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*models.StoredConfig) ([]*route.Route, []*cluster.Cluster, error) {
 	restCfg, ok := cfg.Configuration.(api.RestAPI)
@@ -1022,6 +1147,13 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 
 		r := t.createRoute(cfg.UUID, apiData.DisplayName, apiData.Version, apiData.Context, op.EffectiveMethod(), op.EffectivePath(),
 			mainClusterName, parsedMainURL.Path, effectiveMainVHost, cfg.Kind, templateHandle, providerName, apiData.Upstream.Main.HostRewrite, apiProjectID, opTimeoutCfg, useClusterHeader, upstreamDefPaths)
+		upgradeType := ResolveUpgradeType(op, &apiData.Upstream.Main)
+		applyUpgrade(r.GetRoute(), upgradeType, opTimeoutCfg != nil && opTimeoutCfg.Route != nil)
+		if apiData.MaxRequestBytes != nil {
+			if err := applyMaxRequestBytes(r, *apiData.MaxRequestBytes, upgradeType); err != nil {
+				return nil, nil, err
+			}
+		}
 		mainRoutesList = append(mainRoutesList, r)
 	}
 	routesList = append(routesList, mainRoutesList...)
@@ -1054,6 +1186,13 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 
 			r := t.createRoute(cfg.UUID, apiData.DisplayName, apiData.Version, apiData.Context, op.EffectiveMethod(), op.EffectivePath(),
 				sbClusterName, parsedSbURL.Path, effectiveSandboxVHost, cfg.Kind, templateHandle, providerName, apiData.Upstream.Sandbox.HostRewrite, apiProjectID, opTimeoutCfg, useClusterHeader, upstreamDefPaths)
+			upgradeType := ResolveUpgradeType(op, apiData.Upstream.Sandbox)
+			applyUpgrade(r.GetRoute(), upgradeType, opTimeoutCfg != nil && opTimeoutCfg.Route != nil)
+			if apiData.MaxRequestBytes != nil {
+				if err := applyMaxRequestBytes(r, *apiData.MaxRequestBytes, upgradeType); err != nil {
+					return nil, nil, err
+				}
+			}
 			sbRoutesList = append(sbRoutesList, r)
 		}
 		routesList = append(routesList, sbRoutesList...)
@@ -1201,6 +1340,14 @@ func (t *Translator) createListener(virtualHosts []*route.VirtualHost, isHTTPS b
 	// Build HTTP filters chain
 	httpFilters := make([]*hcm.HttpFilter, 0)
 
+	// Add buffer filter ahead of ext_proc so per-API request body limits are enforced
+	// before the body reaches the policy engine
+	bufferFilter, err := t.createBufferFilter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create buffer filter: %w", err)
+	}
+	httpFilters = append(httpFilters, bufferFilter)
+
 	// Add ext_proc filter for policy engine
 	extProcFilter, err := t.createExtProcFilter()
 	if err != nil {
@@ -1214,6 +1361,12 @@ func (t *Translator) createListener(virtualHosts []*route.VirtualHost, isHTTPS b
 	}
 	httpFilters = append(httpFilters, luaFilter)
 
+	transcoderFilter, err := t.createGrpcJSONTranscoderFilter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create gRPC-JSON transcoder filter: %w", err)
+	}
+	httpFilters = append(httpFilters, transcoderFilter)
+
 	// Add router filter (must be last)
 	httpFilters = append(httpFilters, &hcm.HttpFilter{
 		Name: wellknown.Router,
@@ -1271,6 +1424,8 @@ func (t *Translator) createListener(virtualHosts []*route.VirtualHost, isHTTPS b
 		manager.Tracing = tracingConfig
 	}
 
+	t.applyClientCertDetails(manager)
+
 	pbst, err := anypb.New(manager)
 	if err != nil {
 		return nil, nil, err
@@ -2207,27 +2362,10 @@ func (t *Translator) createUpstreamTLSContext(certificate []byte, address string
 		// 3. Configured trusted cert path (system certs only)
 		// 4. If none provided, Envoy falls back to system default trust store
 
-		if t.certStore != nil {
+		if t.hasUpstreamCABundle() {
 			// Use SDS to dynamically fetch certificates
 			// This is more efficient than inlining certificates in every cluster config
-			sdsConfig := &core.ConfigSource{
-				ResourceApiVersion: core.ApiVersion_V3,
-				ConfigSourceSpecifier: &core.ConfigSource_ApiConfigSource{
-					ApiConfigSource: &core.ApiConfigSource{
-						ApiType:             core.ApiConfigSource_GRPC,
-						TransportApiVersion: core.ApiVersion_V3,
-						GrpcServices: []*core.GrpcService{
-							{
-								TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
-									EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
-										ClusterName: "sds_cluster",
-									},
-								},
-							},
-						},
-					},
-				},
-			}
+			sdsConfig := sdsConfigSource()
 
 			upstreamTLSContext.CommonTlsContext.ValidationContextType = &tlsv3.CommonTlsContext_CombinedValidationContext{
 				CombinedValidationContext: &tlsv3.CommonTlsContext_CombinedCertificateValidationContext{
@@ -2301,31 +2439,64 @@ func (t *Translator) createUpstreamTLSContext(certificate []byte, address string
 	return upstreamTLSContext
 }
 
+// sdsConfigSource returns the config source Envoy uses to fetch SDS secrets from the controller
+func sdsConfigSource() *core.ConfigSource {
+	return &core.ConfigSource{
+		ResourceApiVersion: core.ApiVersion_V3,
+		ConfigSourceSpecifier: &core.ConfigSource_ApiConfigSource{
+			ApiConfigSource: &core.ApiConfigSource{
+				ApiType:             core.ApiConfigSource_GRPC,
+				TransportApiVersion: core.ApiVersion_V3,
+				GrpcServices: []*core.GrpcService{
+					{
+						TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+								ClusterName: "sds_cluster",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // createDownstreamTLSContext creates a downstream TLS context for HTTPS listeners
 func (t *Translator) createDownstreamTLSContext() (*tlsv3.DownstreamTlsContext, error) {
-	// Read certificate and key files
-	certBytes, err := os.ReadFile(t.routerConfig.DownstreamTLS.CertPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read certificate file: %w", err)
-	}
+	commonTLSContext := &tlsv3.CommonTlsContext{}
+
+	if t.hasSDSDownstreamCertificate() {
+		// Reference the managed (e.g. ACME-issued) certificate over SDS so it can be
+		// rotated without touching the listener
+		commonTLSContext.TlsCertificateSdsSecretConfigs = []*tlsv3.SdsSecretConfig{{
+			Name:      SecretNameDownstreamCert,
+			SdsConfig: sdsConfigSource(),
+		}}
+	} else {
+		// Read certificate and key files
+		certBytes, err := os.ReadFile(t.routerConfig.DownstreamTLS.CertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate file: %w", err)
+		}
 
-	keyBytes, err := os.ReadFile(t.routerConfig.DownstreamTLS.KeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read key file: %w", err)
-	}
+		keyBytes, err := os.ReadFile(t.routerConfig.DownstreamTLS.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
 
-	// Create TLS certificate configuration
-	tlsCert := &tlsv3.TlsCertificate{
-		CertificateChain: &core.DataSource{
-			Specifier: &core.DataSource_InlineBytes{
-				InlineBytes: certBytes,
+		// Create TLS certificate configuration
+		commonTLSContext.TlsCertificates = []*tlsv3.TlsCertificate{{
+			CertificateChain: &core.DataSource{
+				Specifier: &core.DataSource_InlineBytes{
+					InlineBytes: certBytes,
+				},
 			},
-		},
-		PrivateKey: &core.DataSource{
-			Specifier: &core.DataSource_InlineBytes{
-				InlineBytes: keyBytes,
+			PrivateKey: &core.DataSource{
+				Specifier: &core.DataSource_InlineBytes{
+					InlineBytes: keyBytes,
+				},
 			},
-		},
+		}}
 	}
 
 	// Parse cipher suites
@@ -2334,24 +2505,19 @@ func (t *Translator) createDownstreamTLSContext() (*tlsv3.DownstreamTlsContext,
 		cipherSuites = t.parseCipherSuites(t.routerConfig.DownstreamTLS.Ciphers)
 	}
 
-	// Create downstream TLS context
-	downstreamTLSContext := &tlsv3.DownstreamTlsContext{
-		CommonTlsContext: &tlsv3.CommonTlsContext{
-			TlsCertificates: []*tlsv3.TlsCertificate{tlsCert},
-			TlsParams: &tlsv3.TlsParameters{
-				TlsMinimumProtocolVersion: t.createTLSProtocolVersion(
-					t.routerConfig.DownstreamTLS.MinimumProtocolVersion,
-				),
-				TlsMaximumProtocolVersion: t.createTLSProtocolVersion(
-					t.routerConfig.DownstreamTLS.MaximumProtocolVersion,
-				),
-				CipherSuites: cipherSuites,
-			},
-			AlpnProtocols: []string{constants.ALPNProtocolHTTP2, constants.ALPNProtocolHTTP11},
-		},
+	commonTLSContext.TlsParams = &tlsv3.TlsParameters{
+		TlsMinimumProtocolVersion: t.createTLSProtocolVersion(
+			t.routerConfig.DownstreamTLS.MinimumProtocolVersion,
+		),
+		TlsMaximumProtocolVersion: t.createTLSProtocolVersion(
+			t.routerConfig.DownstreamTLS.MaximumProtocolVersion,
+		),
+		CipherSuites: cipherSuites,
 	}
+	commonTLSContext.AlpnProtocols = []string{constants.ALPNProtocolHTTP2, constants.ALPNProtocolHTTP11}
+	t.applyClientCertificateRequest(commonTLSContext)
 
-	return downstreamTLSContext, nil
+	return &tlsv3.DownstreamTlsContext{CommonTlsContext: commonTLSContext}, nil
 }
 
 // createTLSProtocolVersion converts string TLS version to Envoy TLS version enum
@@ -2846,7 +3012,10 @@ func (t *Translator) createExtProcFilter() (*hcm.HttpFilter, error) {
 		// Always allow mode override: the policy engine sets the per-request body mode
 		// (skip/buffered/streamed); without this Envoy would ignore it and never send bodies.
 		AllowModeOverride: true,
-		RequestAttributes: []string{constants.ExtProcRequestAttributeRouteName},
+		RequestAttributes: []string{
+			constants.ExtProcRequestAttributeRouteName,
+			constants.ExtProcRequestAttributeSourceAddress,
+		},
 		ProcessingMode: &extproc.ProcessingMode{
 			RequestHeaderMode: extproc.ProcessingMode_SEND,
 		},
@@ -2854,6 +3023,8 @@ func (t *Translator) createExtProcFilter() (*hcm.HttpFilter, error) {
 		MutationRules: &mutationrules.HeaderMutationRules{
 			DisallowSystem:  wrapperspb.Bool(false),
 			DisallowIsError: wrapperspb.Bool(true),
+			// Policies such as retry tune the router through x-envoy-* request headers
+			AllowEnvoy: wrapperspb.Bool(true),
 		},
 		MetadataOptions: &extproc.MetadataOptions{
 			ReceivingNamespaces: &extproc.MetadataOptions_MetadataNamespaces{
@@ -2953,6 +3124,38 @@ func ResolveResilience(r *api.Resilience) (timeout *time.Duration, idleTimeout *
 	return timeout, idleTimeout, nil
 }
 
+// ResolveRouteRetry returns the retries of a route: the operation-level block when present,
+// otherwise the API-level one. Zero attempts turn retries off and yield nil.
+func ResolveRouteRetry(apiResilience, opResilience *api.Resilience) (*models.RouteRetry, error) {
+	var retries *api.ResilienceRetries
+	if opResilience != nil && opResilience.Retries != nil {
+		retries = opResilience.Retries
+	} else if apiResilience != nil {
+		retries = apiResilience.Retries
+	}
+	if retries == nil || retries.Attempts <= 0 {
+		return nil, nil
+	}
+
+	perTryTimeout, err := parseDurationAllowZero(retries.PerTryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resilience.retries.perTryTimeout: %w", err)
+	}
+	retryOn := constants.DefaultRouteRetryOn
+	if retries.RetryOn != nil && len(*retries.RetryOn) > 0 {
+		conditions := make([]string, 0, len(*retries.RetryOn))
+		for _, cond := range *retries.RetryOn {
+			conditions = append(conditions, string(cond))
+		}
+		retryOn = strings.Join(conditions, ",")
+	}
+	return &models.RouteRetry{
+		Attempts:      retries.Attempts,
+		PerTryTimeout: perTryTimeout,
+		RetryOn:       retryOn,
+	}, nil
+}
+
 // combineRouteResilience returns a resolvedTimeout for a single route, preserving the
 // upstream connect timeout from base and applying the effective route/idle timeouts
 // (operation-level overriding API-level, per field). It returns base unchanged when no
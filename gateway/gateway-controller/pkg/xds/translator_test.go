@@ -838,6 +838,155 @@ func TestTranslator_RouteResilienceTimeoutsFromRDC(t *testing.T) {
 	}
 }
 
+func TestTranslator_RouteRetryBackOffFromRDC(t *testing.T) {
+	logger := createTestLogger()
+	routerCfg := testRouterConfig()
+	cfg := testConfig()
+	translator := NewTranslator(logger, routerCfg, nil, cfg)
+
+	tests := []struct {
+		name     string
+		policies []models.Policy
+		wantBase time.Duration
+	}{
+		{name: "no retry policy", policies: []models.Policy{{Name: "set-headers", Version: "v1.0.0"}}},
+		{name: "retry policy with back-off", policies: []models.Policy{
+			{Name: "set-headers", Version: "v1.0.0"},
+			{Name: constants.RetryPolicyName, Version: "v1.0.0", Params: map[string]interface{}{"backoffBase": "100ms"}},
+		}, wantBase: 100 * time.Millisecond},
+		{name: "invalid back-off ignored", policies: []models.Policy{
+			{Name: constants.RetryPolicyName, Version: "v1.0.0", Params: map[string]interface{}{"backoffBase": "soon"}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routeKey := "GET|/api/v1.0/items|"
+			rdc := &models.RuntimeDeployConfig{
+				UpstreamClusters: map[string]*models.UpstreamCluster{
+					"main": {Endpoints: []models.Endpoint{{Host: "echo", Port: 80}}},
+				},
+				PolicyChains: map[string]*models.PolicyChain{
+					routeKey: {Policies: tt.policies},
+				},
+			}
+			rdcRoute := &models.Route{
+				Method:          "GET",
+				Path:            "/api/v1.0/items",
+				OperationPath:   "/items",
+				AutoHostRewrite: true,
+				Upstream:        models.RouteUpstream{ClusterKey: "main"},
+			}
+			r := translator.createRouteFromRDC(routeKey, rdcRoute, rdc)
+			require.NotNil(t, r)
+
+			retryPolicy := r.GetRoute().GetRetryPolicy()
+			if tt.wantBase == 0 {
+				assert.Nil(t, retryPolicy)
+				return
+			}
+			require.NotNil(t, retryPolicy)
+			// Retries are only enabled per request by the policy's headers
+			assert.Empty(t, retryPolicy.GetRetryOn())
+			assert.Equal(t, tt.wantBase, retryPolicy.GetRetryBackOff().GetBaseInterval().AsDuration())
+			assert.Equal(t, 10*tt.wantBase, retryPolicy.GetRetryBackOff().GetMaxInterval().AsDuration())
+		})
+	}
+}
+
+func TestTranslator_RouteRetriesFromRDC(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	perTry := 5 * time.Second
+	routeKey := "GET|/api/v1.0/items|"
+
+	build := func(retry *models.RouteRetry, policies []models.Policy) *route.RetryPolicy {
+		rdc := &models.RuntimeDeployConfig{
+			UpstreamClusters: map[string]*models.UpstreamCluster{
+				"main": {Endpoints: []models.Endpoint{{Host: "echo", Port: 80}}},
+			},
+			PolicyChains: map[string]*models.PolicyChain{routeKey: {Policies: policies}},
+		}
+		rdcRoute := &models.Route{
+			Method:        "GET",
+			Path:          "/api/v1.0/items",
+			OperationPath: "/items",
+			Retry:         retry,
+			Upstream:      models.RouteUpstream{ClusterKey: "main"},
+		}
+		r := translator.createRouteFromRDC(routeKey, rdcRoute, rdc)
+		require.NotNil(t, r)
+		return r.GetRoute().GetRetryPolicy()
+	}
+
+	t.Run("retries block sets the route retry policy", func(t *testing.T) {
+		policy := build(&models.RouteRetry{Attempts: 3, PerTryTimeout: &perTry, RetryOn: "5xx,reset"}, nil)
+		require.NotNil(t, policy)
+		require.NoError(t, policy.Validate())
+		assert.Equal(t, "5xx,reset", policy.GetRetryOn())
+		assert.Equal(t, uint32(3), policy.GetNumRetries().GetValue())
+		assert.Equal(t, perTry, policy.GetPerTryTimeout().AsDuration())
+		assert.Nil(t, policy.GetRetryBackOff())
+	})
+
+	t.Run("retry policy back-off is kept", func(t *testing.T) {
+		policy := build(&models.RouteRetry{Attempts: 1, RetryOn: "gateway-error"}, []models.Policy{
+			{Name: constants.RetryPolicyName, Version: "v1.0.0", Params: map[string]interface{}{"backoffBase": "50ms"}},
+		})
+		require.NotNil(t, policy)
+		assert.Equal(t, "gateway-error", policy.GetRetryOn())
+		assert.Equal(t, uint32(1), policy.GetNumRetries().GetValue())
+		assert.Nil(t, policy.GetPerTryTimeout())
+		assert.Equal(t, 50*time.Millisecond, policy.GetRetryBackOff().GetBaseInterval().AsDuration())
+	})
+}
+
+func TestTranslator_RouteUpgradeFromRDC(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+	explicit := 30 * time.Second
+
+	tests := []struct {
+		name        string
+		upgradeType string
+		timeout     *models.RouteTimeout
+		wantUpgrade bool
+		wantTimeout time.Duration
+	}{
+		{name: "plain route keeps the default timeout", wantTimeout: 60 * time.Second},
+		{name: "websocket route disables the route timeout", upgradeType: "websocket", wantUpgrade: true},
+		{name: "websocket route keeps an explicit timeout", upgradeType: "websocket", wantUpgrade: true,
+			timeout: &models.RouteTimeout{Timeout: &explicit}, wantTimeout: explicit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdc := &models.RuntimeDeployConfig{
+				UpstreamClusters: map[string]*models.UpstreamCluster{
+					"main": {Endpoints: []models.Endpoint{{Host: "echo", Port: 80}}},
+				},
+			}
+			rdcRoute := &models.Route{
+				Method:        "GET",
+				Path:          "/chat/v1.0/socket",
+				OperationPath: "/socket",
+				Timeout:       tt.timeout,
+				UpgradeType:   tt.upgradeType,
+				Upstream:      models.RouteUpstream{ClusterKey: "main"},
+			}
+			r := translator.createRouteFromRDC("GET|/chat/v1.0/socket|", rdcRoute, rdc)
+			require.NotNil(t, r)
+
+			upgrades := r.GetRoute().GetUpgradeConfigs()
+			if tt.wantUpgrade {
+				require.Len(t, upgrades, 1)
+				assert.Equal(t, "websocket", upgrades[0].GetUpgradeType())
+			} else {
+				assert.Empty(t, upgrades)
+			}
+			assert.Equal(t, tt.wantTimeout, r.GetRoute().GetTimeout().AsDuration(), "route timeout")
+		})
+	}
+}
+
 // TestTranslator_MCPUpstreamRewriteFromRDC verifies the MCP "/mcp"-not-appended behavior on the
 // RuntimeDeployConfig path (createRouteFromRDC), which the policy/runtime xDS pipeline uses.
 func TestTranslator_MCPUpstreamRewriteFromRDC(t *testing.T) {
@@ -2131,6 +2280,27 @@ func TestTranslator_CreateDownstreamTLSContext_NoCert(t *testing.T) {
 	assert.Nil(t, tlsContext)
 }
 
+func TestTranslator_CreateDownstreamTLSContext_SDSCertificate(t *testing.T) {
+	logger := createTestLogger()
+	routerCfg := testRouterConfig()
+	cfg := testConfig()
+	translator := NewTranslator(logger, routerCfg, nil, cfg)
+
+	sdsManager := NewSDSSecretManager(nil, nil, "router-node", logger)
+	certPEM, keyPEM := selfSignedKeyPair(t)
+	require.NoError(t, sdsManager.SetDownstreamCertificate(certPEM, keyPEM))
+	translator.SetSDSSecretManager(sdsManager)
+
+	// No cert files are configured, so the listener must reference the SDS secret
+	tlsContext, err := translator.createDownstreamTLSContext()
+	require.NoError(t, err)
+	common := tlsContext.GetCommonTlsContext()
+	assert.Empty(t, common.GetTlsCertificates(), "private key must not be inlined in the listener")
+	require.Len(t, common.GetTlsCertificateSdsSecretConfigs(), 1)
+	assert.Equal(t, SecretNameDownstreamCert, common.GetTlsCertificateSdsSecretConfigs()[0].GetName())
+	assert.Equal(t, []string{constants.ALPNProtocolHTTP2, constants.ALPNProtocolHTTP11}, common.GetAlpnProtocols())
+}
+
 func TestTranslator_CreateRoute_Basic(t *testing.T) {
 	logger := createTestLogger()
 	routerCfg := testRouterConfig()
@@ -2537,3 +2707,108 @@ func TestTranslator_TranslateRuntimeConfig_AppliesConnectTimeout(t *testing.T) {
 	assert.Equal(t, 5*time.Second, got["without_timeout"],
 		"a nil connect timeout must fall back to the router global default (5s), not be dropped to zero")
 }
+
+// TestTranslator_TranslateRuntimeConfig_HealthCheck verifies that an upstream health check is
+// emitted as Envoy active HTTP health checking, and that clusters without one get none.
+func TestTranslator_TranslateRuntimeConfig_HealthCheck(t *testing.T) {
+	translator := createTestTranslator()
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{UUID: "u", Kind: "RestApi"},
+		Routes:   map[string]*models.Route{},
+		UpstreamClusters: map[string]*models.UpstreamCluster{
+			"with_health_check": {
+				BasePath:  "/",
+				Endpoints: []models.Endpoint{{Host: "backend", Port: 8080}},
+				TLS:       &models.UpstreamTLS{},
+				HealthCheck: &models.HealthCheck{
+					Path:               "/healthz",
+					Host:               "backend",
+					Interval:           15 * time.Second,
+					Timeout:            2 * time.Second,
+					UnhealthyThreshold: 4,
+					HealthyThreshold:   1,
+					ExpectedStatuses:   []int{200, 204},
+				},
+			},
+			"without_health_check": {
+				BasePath:  "/",
+				Endpoints: []models.Endpoint{{Host: "backend2", Port: 8080}},
+				TLS:       &models.UpstreamTLS{},
+			},
+		},
+	}
+
+	_, clusters, err := translator.translateRuntimeConfig(rdc)
+	require.NoError(t, err)
+
+	byName := map[string]*cluster.Cluster{}
+	for _, c := range clusters {
+		byName[c.GetName()] = c
+	}
+	require.Contains(t, byName, "with_health_check")
+	require.Contains(t, byName, "without_health_check")
+	assert.Empty(t, byName["without_health_check"].GetHealthChecks())
+
+	healthChecks := byName["with_health_check"].GetHealthChecks()
+	require.Len(t, healthChecks, 1)
+	hc := healthChecks[0]
+	require.NoError(t, hc.Validate())
+	assert.Equal(t, 15*time.Second, hc.GetInterval().AsDuration())
+	assert.Equal(t, 2*time.Second, hc.GetTimeout().AsDuration())
+	assert.Equal(t, uint32(4), hc.GetUnhealthyThreshold().GetValue())
+	assert.Equal(t, uint32(1), hc.GetHealthyThreshold().GetValue())
+
+	httpCheck := hc.GetHttpHealthCheck()
+	require.NotNil(t, httpCheck)
+	assert.Equal(t, "/healthz", httpCheck.GetPath())
+	assert.Equal(t, "backend", httpCheck.GetHost())
+	require.Len(t, httpCheck.GetExpectedStatuses(), 2)
+	assert.Equal(t, int64(200), httpCheck.GetExpectedStatuses()[0].GetStart())
+	assert.Equal(t, int64(201), httpCheck.GetExpectedStatuses()[0].GetEnd())
+	assert.Equal(t, int64(204), httpCheck.GetExpectedStatuses()[1].GetStart())
+}
+
+func TestTranslator_TranslateRuntimeConfig_OutlierDetection(t *testing.T) {
+	translator := createTestTranslator()
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{UUID: "u", Kind: "RestApi"},
+		Routes:   map[string]*models.Route{},
+		UpstreamClusters: map[string]*models.UpstreamCluster{
+			"with_outlier_detection": {
+				BasePath:  "/",
+				Endpoints: []models.Endpoint{{Host: "provider", Port: 443}},
+				TLS:       &models.UpstreamTLS{Enabled: true},
+				OutlierDetection: &models.OutlierDetection{
+					Consecutive5xx:     3,
+					Interval:           5 * time.Second,
+					BaseEjectionTime:   time.Minute,
+					MaxEjectionPercent: 50,
+				},
+			},
+			"without_outlier_detection": {
+				BasePath:  "/",
+				Endpoints: []models.Endpoint{{Host: "backend", Port: 8080}},
+				TLS:       &models.UpstreamTLS{},
+			},
+		},
+	}
+
+	_, clusters, err := translator.translateRuntimeConfig(rdc)
+	require.NoError(t, err)
+
+	byName := map[string]*cluster.Cluster{}
+	for _, c := range clusters {
+		byName[c.GetName()] = c
+	}
+	require.Contains(t, byName, "with_outlier_detection")
+	require.Contains(t, byName, "without_outlier_detection")
+	assert.Nil(t, byName["without_outlier_detection"].GetOutlierDetection())
+
+	od := byName["with_outlier_detection"].GetOutlierDetection()
+	require.NotNil(t, od)
+	require.NoError(t, od.Validate())
+	assert.Equal(t, uint32(3), od.GetConsecutive_5Xx().GetValue())
+	assert.Equal(t, 5*time.Second, od.GetInterval().AsDuration())
+	assert.Equal(t, time.Minute, od.GetBaseEjectionTime().AsDuration())
+	assert.Equal(t, uint32(50), od.GetMaxEjectionPercent().GetValue())
+}
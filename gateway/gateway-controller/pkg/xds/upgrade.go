@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+)
+
+// ResolveUpgradeType returns the connection upgrade allowed on an operation's route:
+// the operation's own upgrade when set, otherwise the upgrade of the upstream slot
+// (main or sandbox) serving the route. An empty string means no upgrade.
+func ResolveUpgradeType(op api.Operation, upstream *api.Upstream) string {
+	if op.Upgrade != nil {
+		return string(*op.Upgrade)
+	}
+	if upstream != nil && upstream.Upgrade != nil {
+		return string(*upstream.Upgrade)
+	}
+	return ""
+}
+
+// applyUpgrade enables the given upgrade on a route action. The HTTP connection manager
+// has no upgrades of its own, so only routes flagged here accept upgraded connections.
+// Envoy's route timeout spans the whole stream, which for an upgraded connection is its
+// lifetime, so it is disabled unless the API or operation configured one explicitly;
+// the idle timeout still closes abandoned connections.
+func applyUpgrade(action *route.RouteAction, upgradeType string, timeoutConfigured bool) {
+	if upgradeType == "" {
+		return
+	}
+	action.UpgradeConfigs = []*route.RouteAction_UpgradeConfig{{UpgradeType: upgradeType}}
+	if !timeoutConfigured {
+		action.Timeout = durationpb.New(0)
+	}
+}
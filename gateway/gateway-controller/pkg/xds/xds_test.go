@@ -19,11 +19,20 @@
 package xds
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"io"
 	"log/slog"
+	"math/big"
 	"testing"
+	"time"
 
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	commonconstants "github.com/wso2/api-platform/common/constants"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/certstore"
@@ -288,6 +297,73 @@ func TestSDSSecretManager_UpdateSecrets_EmptyCertStore(t *testing.T) {
 	}
 }
 
+// selfSignedKeyPair returns a PEM encoded self-signed certificate and key for tests.
+func selfSignedKeyPair(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	// TODO(pqc): migrate — test fixture mirroring the ECDSA certificates issued over ACME.
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "api.example.com"},
+		DNSNames:     []string{"api.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestSDSSecretManager_DownstreamCertificate(t *testing.T) {
+	logger := createTestLogger()
+	testCache := cache.NewSnapshotCache(false, cache.IDHash{}, &slogAdapter{logger: logger})
+	manager := NewSDSSecretManager(nil, testCache, "test-node", logger)
+
+	if _, err := manager.GetSecrets(); err == nil {
+		t.Error("GetSecrets() with no secrets should return error")
+	}
+
+	if err := manager.SetDownstreamCertificate([]byte("not a cert"), []byte("not a key")); err == nil {
+		t.Error("SetDownstreamCertificate() should reject an invalid key pair")
+	}
+	if manager.HasDownstreamCertificate() {
+		t.Error("HasDownstreamCertificate() should be false after a rejected certificate")
+	}
+
+	certPEM, keyPEM := selfSignedKeyPair(t)
+	if err := manager.SetDownstreamCertificate(certPEM, keyPEM); err != nil {
+		t.Fatalf("SetDownstreamCertificate() returned error: %v", err)
+	}
+	if !manager.HasDownstreamCertificate() {
+		t.Error("HasDownstreamCertificate() should be true after a certificate is set")
+	}
+
+	secrets, err := manager.GetSecrets()
+	if err != nil {
+		t.Fatalf("GetSecrets() returned error: %v", err)
+	}
+	if len(secrets) != 1 {
+		t.Fatalf("GetSecrets() returned %d secrets, want 1", len(secrets))
+	}
+	secret, ok := secrets[0].(*tlsv3.Secret)
+	if !ok || secret.GetName() != SecretNameDownstreamCert {
+		t.Fatalf("GetSecrets() returned %v, want secret %q", secrets[0], SecretNameDownstreamCert)
+	}
+	if string(secret.GetTlsCertificate().GetPrivateKey().GetInlineBytes()) != string(keyPEM) {
+		t.Error("downstream secret does not carry the private key")
+	}
+}
+
 func TestSecretNameUpstreamCA(t *testing.T) {
 	if SecretNameUpstreamCA != "upstream_ca_bundle" {
 		t.Errorf("SecretNameUpstreamCA = %q, want %q", SecretNameUpstreamCA, "upstream_ca_bundle")
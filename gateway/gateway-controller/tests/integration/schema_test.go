@@ -104,7 +104,7 @@ func TestSchemaInitialization(t *testing.T) {
 		var version int
 		err := rawDB.QueryRow("PRAGMA user_version").Scan(&version)
 		assert.NoError(t, err)
-		assert.Equal(t, 4, version, "Schema version should be 4")
+		assert.Equal(t, 8, version, "Schema version should be 8")
 	})
 
 	// Verify artifacts table exists
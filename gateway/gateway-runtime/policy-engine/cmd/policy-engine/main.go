@@ -34,8 +34,10 @@ import (
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
 
+	commonapikey "github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/admin"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/configsecrets"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
@@ -46,6 +48,9 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/tracing"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/utils"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/xdsclient"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+	"github.com/wso2/api-platform/sdk/core/utils/semanticcache"
 )
 
 // Version information (set via ldflags during build)
@@ -59,6 +64,7 @@ var (
 	configFile       = flag.String("config", "", "Path to configuration file (required)")
 	policyChainsFile = flag.String("policy-chains-file", "", "Path to policy chains file (enables file mode)")
 	xdsServerAddr    = flag.String("xds-server", "", "xDS server address (e.g., localhost:18000)")
+	validateConfig   = flag.Bool("validate-config", false, "Validate the configuration file and exit without starting the policy engine")
 )
 
 type noOpXDSSyncStatusProvider struct{}
@@ -83,6 +89,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *validateConfig {
+		os.Exit(runValidateConfig(os.Stdout, *configFile))
+	}
+
 	// Load configuration from file
 	cfg, err := config.Load(*configFile)
 	if err != nil {
@@ -94,6 +104,13 @@ func main() {
 	// This must be done before any metrics are used to ensure no-op behavior when disabled
 	metrics.SetEnabled(cfg.PolicyEngine.Metrics.Enabled)
 	metrics.Init() // Initialize metrics immediately so they're available throughout the codebase
+	// Policies calling external providers report their latency through the SDK
+	resilience.SetLatencyObserver(func(provider, outcome string, d time.Duration) {
+		metrics.ProviderCallDurationSeconds.WithLabelValues(provider, outcome).Observe(d.Seconds())
+	})
+	semanticcache.SetLookupObserver(func(result string) {
+		metrics.SemanticCacheLookupsTotal.WithLabelValues(result).Inc()
+	})
 
 	// Apply flag overrides
 	applyFlagOverrides(cfg)
@@ -140,12 +157,42 @@ func main() {
 	k := kernel.NewKernel()
 	reg := registry.GetRegistry()
 
+	// Resolve secret:// references in the config before policies see it through ${config}
+	secretsProvider, err := configsecrets.NewProvider(cfg.PolicyEngine.Secrets)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create secrets provider", "error", err)
+		os.Exit(1)
+	}
+	secretsRefresher := configsecrets.NewRefresher(cfg.PolicyEngine.RawConfig, secretsProvider)
+	resolvedConfig, secretValues, err := secretsRefresher.Resolve(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to resolve config secrets", "error", err)
+		os.Exit(1)
+	}
+
 	// Set config in registry for ${config} CEL resolution
-	if err := reg.SetConfig(cfg.PolicyEngine.RawConfig); err != nil {
+	if err := reg.SetConfig(resolvedConfig); err != nil {
 		slog.ErrorContext(ctx, "Failed to set config in registry", "error", err)
 		os.Exit(1)
 	}
-	slog.InfoContext(ctx, "Config set in registry for ${config} CEL resolution")
+	reg.SetConfigSecrets(secretValues)
+	slog.InfoContext(ctx, "Config set in registry for ${config} CEL resolution",
+		"secrets", len(secretValues), "secrets_provider", cfg.PolicyEngine.Secrets.Provider)
+
+	// API key hashes from the controller may be keyed with these peppers
+	commonapikey.GetAPIkeyStoreInstance().SetPeppers(cfg.PolicyEngine.APIKey.PepperSecrets())
+	// Log API key validation decisions with the engine logger
+	commonapikey.GetAPIkeyStoreInstance().SetLogger(logger.With("component", "apikey_store"))
+
+	// Verify signed API keys that have not reached the engine yet
+	if secret := cfg.PolicyEngine.APIKey.SigningSecret(); secret != nil {
+		codec, err := commonapikey.NewSignedAPIKeyCodec(cfg.PolicyEngine.APIKey.Prefix, secret)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to initialize signed API key verification", "error", err)
+			os.Exit(1)
+		}
+		commonapikey.GetAPIkeyStoreInstance().SetSignedAPIKeyCodec(codec)
+	}
 
 	// Initialize CEL evaluator
 	celEvaluator, err := cel.NewCELEvaluator()
@@ -153,6 +200,8 @@ func main() {
 		slog.ErrorContext(ctx, "Failed to create CEL evaluator", "error", err)
 		os.Exit(1)
 	}
+	// Policies that template values from expressions share the chain executor's evaluator
+	policy.SetExpressionEvaluator(celEvaluator)
 
 	// Get tracer for chain executor - will be NoOp if tracing is disabled
 	serviceName := cfg.PolicyEngine.TracingServiceName
@@ -162,6 +211,19 @@ func main() {
 
 	// Initialize chain executor
 	chainExecutor := executor.NewChainExecutor(reg, celEvaluator, otel.Tracer(serviceName))
+	policyOverrides, err := executor.NewPolicyOverrides(cfg.PolicyEngine.Admin.PolicyOverridesFile)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load policy overrides", "error", err)
+		os.Exit(1)
+	}
+	if disabled := policyOverrides.Disabled(); len(disabled) > 0 {
+		slog.WarnContext(ctx, "Policies disabled by runtime override", "policies", disabled)
+	}
+	chainExecutor.SetPolicyOverrides(policyOverrides)
+	chainExecutor.SetPolicyRouteLabel(cfg.PolicyEngine.Metrics.PolicyRouteLabel)
+	chainExecutor.SetTimeouts(cfg.PolicyEngine.Executor.ChainTimeout, cfg.PolicyEngine.Executor.PolicyTimeout,
+		cfg.PolicyEngine.Executor.OnTimeout == config.FailureActionSkip)
+	chainExecutor.SetSkipOnPanic(cfg.PolicyEngine.Executor.OnPanic == config.FailureActionSkip)
 
 	// Policy registration happens automatically via Builder-generated plugin_registry.go
 	slog.InfoContext(ctx, "Policies registered via Builder-generated code")
@@ -171,6 +233,7 @@ func main() {
 
 	// Initialize configuration source based on mode
 	var xdsClient *xdsclient.Client
+	var rebuildPolicyChains func(context.Context) error
 	var xdsSyncStatusProvider admin.XDSSyncStatusProvider = noOpXDSSyncStatusProvider{}
 	var healthProvider admin.HealthProvider = alwaysHealthyProvider{}
 	switch cfg.PolicyEngine.ConfigMode.Mode {
@@ -186,14 +249,19 @@ func main() {
 		}
 		xdsSyncStatusProvider = xdsClient
 		healthProvider = xdsClient
+		rebuildPolicyChains = xdsClient.RebuildPolicyChains
 		defer xdsClient.Stop()
 		slog.InfoContext(ctx, "xDS client started successfully")
 
 	case "file":
-		if err := initializeFileConfig(ctx, cfg, k, reg); err != nil {
+		configLoader, err := initializeFileConfig(ctx, cfg, k, reg)
+		if err != nil {
 			slog.ErrorContext(ctx, "Failed to load file configuration", "error", err)
 			os.Exit(1)
 		}
+		rebuildPolicyChains = func(context.Context) error {
+			return configLoader.LoadFromFile(cfg.PolicyEngine.FileConfig.Path)
+		}
 		slog.InfoContext(ctx, "File configuration loaded successfully")
 
 	default:
@@ -201,6 +269,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Re-resolve config secrets periodically; when one rotates, rebuild the policy
+	// chains so policies are instantiated with the new value
+	go secretsRefresher.Run(ctx, cfg.PolicyEngine.Secrets.RefreshInterval,
+		func(ctx context.Context, resolved map[string]interface{}, values []string) error {
+			if err := reg.SetConfig(resolved); err != nil {
+				return err
+			}
+			reg.SetConfigSecrets(values)
+			return rebuildPolicyChains(ctx)
+		})
+
 	// Create and start ext_proc gRPC server
 	extprocServer := kernel.NewExternalProcessorServer(k, chainExecutor, cfg.TracingConfig, cfg.PolicyEngine.TracingServiceName)
 
@@ -256,7 +335,7 @@ func main() {
 			sm := pythonbridge.GetStreamManager()
 			pythonHealthChecker = pythonbridge.NewPythonHealthAdapter(sm)
 		}
-		adminServer = admin.NewServer(&cfg.PolicyEngine.Admin, k, reg, xdsSyncStatusProvider, healthProvider, pythonHealthChecker)
+		adminServer = admin.NewServer(&cfg.PolicyEngine.Admin, k, reg, xdsSyncStatusProvider, healthProvider, pythonHealthChecker, celEvaluator, policyOverrides)
 		go func() {
 			if err := adminServer.Start(ctx); err != nil {
 				slog.ErrorContext(ctx, "Admin server error", "error", err)
@@ -267,6 +346,9 @@ func main() {
 	// Start metrics HTTP server if enabled
 	var metricsServer *metrics.Server
 	if cfg.PolicyEngine.Metrics.Enabled {
+		if err := metrics.RegisterCollector(kernel.NewPolicyStateCollector(k)); err != nil {
+			slog.WarnContext(ctx, "Failed to register policy state metrics", "error", err)
+		}
 		metricsServer = metrics.NewServer(&cfg.PolicyEngine.Metrics)
 		go func() {
 			if err := metricsServer.Start(ctx); err != nil {
@@ -401,6 +483,8 @@ func initializeXDSClient(ctx context.Context, cfg *config.Config, serverAddr str
 		TLSCertPath:           cfg.PolicyEngine.XDS.TLS.CertPath,
 		TLSKeyPath:            cfg.PolicyEngine.XDS.TLS.KeyPath,
 		TLSCAPath:             cfg.PolicyEngine.XDS.TLS.CAPath,
+
+		APIKeyUsageReportInterval: cfg.PolicyEngine.APIKey.UsageReportInterval,
 	}
 
 	client, err := xdsclient.NewClient(xdsConfig, k, reg)
@@ -415,14 +499,19 @@ func initializeXDSClient(ctx context.Context, cfg *config.Config, serverAddr str
 	return client, nil
 }
 
-// initializeFileConfig loads policy chains from a static YAML file
-func initializeFileConfig(ctx context.Context, cfg *config.Config, k *kernel.Kernel, reg *registry.PolicyRegistry) error {
+// initializeFileConfig loads policy chains from a YAML file and reloads them
+// whenever the file changes
+func initializeFileConfig(ctx context.Context, cfg *config.Config, k *kernel.Kernel, reg *registry.PolicyRegistry) (*kernel.ConfigLoader, error) {
 	slog.InfoContext(ctx, "Loading file-based configuration", "path", cfg.PolicyEngine.FileConfig.Path)
 
 	configLoader := kernel.NewConfigLoader(k, reg)
 	if err := configLoader.LoadFromFile(cfg.PolicyEngine.FileConfig.Path); err != nil {
-		return fmt.Errorf("failed to load configuration from file: %w", err)
+		return nil, fmt.Errorf("failed to load configuration from file: %w", err)
+	}
+
+	if err := configLoader.WatchFile(ctx, cfg.PolicyEngine.FileConfig.Path); err != nil {
+		return nil, fmt.Errorf("failed to watch configuration file: %w", err)
 	}
 
-	return nil
+	return configLoader, nil
 }
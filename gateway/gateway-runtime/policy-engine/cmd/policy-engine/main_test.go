@@ -229,10 +229,11 @@ func TestInitializeFileConfig_EmptyFile(t *testing.T) {
 		},
 	}
 
-	err = initializeFileConfig(context.Background(), cfg, k, reg)
+	configLoader, err := initializeFileConfig(context.Background(), cfg, k, reg)
 
 	// Empty file should load successfully
 	assert.NoError(t, err)
+	assert.NotNil(t, configLoader)
 }
 
 func TestInitializeFileConfig_FileNotFound(t *testing.T) {
@@ -247,7 +248,7 @@ func TestInitializeFileConfig_FileNotFound(t *testing.T) {
 		},
 	}
 
-	err := initializeFileConfig(context.Background(), cfg, k, reg)
+	_, err := initializeFileConfig(context.Background(), cfg, k, reg)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to load configuration from file")
@@ -271,7 +272,7 @@ func TestInitializeFileConfig_InvalidYAML(t *testing.T) {
 		},
 	}
 
-	err = initializeFileConfig(context.Background(), cfg, k, reg)
+	_, err = initializeFileConfig(context.Background(), cfg, k, reg)
 
 	assert.Error(t, err)
 }
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"io"
+
+	"github.com/wso2/api-platform/common/configcheck"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/configsecrets"
+)
+
+// runValidateConfig loads and validates the configuration file at path without
+// starting the policy engine, writes the result to w and returns the exit code.
+// The secrets provider is created but no secret is fetched.
+func runValidateConfig(w io.Writer, path string) int {
+	return configcheck.Run(w, path, func(path string) error {
+		cfg, err := config.Load(path)
+		if err != nil {
+			return err
+		}
+		_, err = configsecrets.NewProvider(cfg.PolicyEngine.Secrets)
+		return err
+	})
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/common/configcheck"
+)
+
+func TestRunValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		errMsg   string
+	}{
+		{
+			name: "valid config",
+			contents: `
+[policy_engine.logging]
+level = "info"
+`,
+		},
+		{
+			name: "malformed toml",
+			contents: `
+[policy_engine.logging
+level = "info"
+`,
+			errMsg: "failed to load config file",
+		},
+		{
+			name: "file mode without a path",
+			contents: `
+[policy_engine.config_mode]
+mode = "file"
+`,
+			errMsg: "file_config.path",
+		},
+		{
+			name: "xDS TLS without a key",
+			contents: `
+[policy_engine.config_mode]
+mode = "xds"
+
+[policy_engine.xds.tls]
+enabled = true
+cert_path = "/certs/client.crt"
+`,
+			errMsg: "xds.tls.key_path is required when TLS is enabled",
+		},
+		{
+			name: "unknown secrets provider",
+			contents: `
+[policy_engine.secrets]
+provider = "keychain"
+`,
+			errMsg: "policy_engine.secrets.provider must be one of",
+		},
+		{
+			name: "vault secrets provider without a token",
+			contents: `
+[policy_engine.secrets]
+provider = "vault"
+
+[policy_engine.secrets.vault]
+address = "https://vault.example.com:8200"
+`,
+			errMsg: "vault token is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VAULT_TOKEN", "")
+			path := filepath.Join(t.TempDir(), "config.toml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.contents), 0o600))
+
+			var out bytes.Buffer
+			code := runValidateConfig(&out, path)
+
+			var report configcheck.Report
+			require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+			assert.Equal(t, path, report.Config)
+			if tt.errMsg == "" {
+				assert.Equal(t, 0, code)
+				assert.True(t, report.Valid)
+				assert.Empty(t, report.Errors)
+				return
+			}
+			assert.Equal(t, 1, code)
+			assert.False(t, report.Valid)
+			require.Len(t, report.Errors, 1)
+			assert.Contains(t, report.Errors[0], tt.errMsg)
+		})
+	}
+}
@@ -19,6 +19,7 @@
 package admin
 
 import (
+	"sort"
 	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
@@ -150,3 +151,51 @@ func dumpLazyResources() LazyResourcesDump {
 		ResourcesByType: resourcesByType,
 	}
 }
+
+// dumpChain describes the chain registered for routeKey. Route metadata is
+// included when the route has a RouteConfig.
+func dumpChain(routeKey string, chain *registry.PolicyChain, routeConfig *kernel.RouteConfig) ChainEntry {
+	entry := ChainEntry{
+		RouteKey:                  routeKey,
+		RequiresRequestBody:       chain.RequiresRequestBody,
+		RequiresResponseBody:      chain.RequiresResponseBody,
+		SupportsRequestStreaming:  chain.SupportsRequestStreaming,
+		SupportsResponseStreaming: chain.SupportsResponseStreaming,
+		HasExecutionConditions:    chain.HasExecutionConditions,
+		Policies:                  make([]ChainPolicy, 0, len(chain.PolicySpecs)),
+	}
+	if routeConfig != nil {
+		entry.APIName = routeConfig.Metadata.APIName
+		entry.APIVersion = routeConfig.Metadata.APIVersion
+		entry.Context = routeConfig.Metadata.Context
+		entry.OperationPath = routeConfig.Metadata.OperationPath
+		entry.Vhost = routeConfig.Metadata.Vhost
+	}
+	for _, spec := range chain.PolicySpecs {
+		entry.Policies = append(entry.Policies, ChainPolicy{
+			Name:               spec.Name,
+			Version:            spec.Version,
+			Enabled:            spec.Enabled,
+			ExecutionCondition: spec.ExecutionCondition,
+		})
+	}
+	return entry
+}
+
+// dumpChains describes every registered chain, sorted by route key.
+func dumpChains(k *kernel.Kernel) []ChainEntry {
+	routes := k.DumpRoutes()
+	configs := k.DumpRouteConfigs()
+
+	keys := make([]string, 0, len(routes))
+	for key := range routes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]ChainEntry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, dumpChain(key, routes[key], configs[key]))
+	}
+	return entries
+}
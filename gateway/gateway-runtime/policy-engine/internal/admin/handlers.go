@@ -20,12 +20,18 @@ package admin
 
 import (
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/wso2/api-platform/common/redact"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 )
 
 // XDSSyncStatusProvider exposes the latest ACKed policy chain version.
@@ -70,6 +76,11 @@ func (h *ConfigDumpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Obtain a consistent snapshot of routes and sensitive values in one lock acquisition so
 	// the redaction list always matches the routes shown in the dump (same xDS generation).
 	routes, rawSecrets := h.kernel.DumpRoutesAndSensitiveValues()
+	if h.registry != nil {
+		// Values policy parameters take from ${config...} references, and values secret://
+		// references in the config resolved to, are secrets as well
+		rawSecrets = append(rawSecrets, h.registry.SensitiveValues()...)
+	}
 
 	dump := DumpConfig(routes, h.kernel, h.registry, h.getPolicyChainVersion())
 
@@ -142,6 +153,221 @@ func (h *XDSSyncStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// PolicyStateHandler handles GET /policy_state requests.
+type PolicyStateHandler struct {
+	kernel *kernel.Kernel
+}
+
+// NewPolicyStateHandler creates a new policy state handler.
+func NewPolicyStateHandler(k *kernel.Kernel) *PolicyStateHandler {
+	return &PolicyStateHandler{kernel: k}
+}
+
+// ServeHTTP implements http.Handler for policy state.
+func (h *PolicyStateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp := PolicyStateResponse{
+		Timestamp: time.Now(),
+		Policies:  []PolicyStateEntry{},
+	}
+	if h.kernel != nil {
+		for _, s := range h.kernel.DumpPolicyStates() {
+			resp.Policies = append(resp.Policies, PolicyStateEntry{
+				Route:   s.Route,
+				Policy:  s.PolicyName,
+				Version: s.PolicyVersion,
+				State:   s.State,
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// ChainsHandler handles GET /chains and GET /chains/{routeKey} requests.
+type ChainsHandler struct {
+	kernel *kernel.Kernel
+	xds    XDSSyncStatusProvider
+}
+
+// NewChainsHandler creates a new policy chains handler.
+func NewChainsHandler(k *kernel.Kernel, xds XDSSyncStatusProvider) *ChainsHandler {
+	return &ChainsHandler{kernel: k, xds: xds}
+}
+
+// ServeHTTP implements http.Handler for the policy chains dump. The route key
+// is the path after /chains/; route keys contain '|' and '/', so clients
+// should percent-encode it.
+func (h *ChainsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	routeKey, single := strings.CutPrefix(r.URL.Path, "/chains/")
+	if single && routeKey != "" {
+		var chain *registry.PolicyChain
+		if h.kernel != nil {
+			chain = h.kernel.GetPolicyChain(routeKey)
+		}
+		if chain == nil {
+			http.Error(w, "Policy chain not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(dumpChain(routeKey, chain, h.kernel.GetRouteConfig(routeKey)))
+		return
+	}
+
+	resp := ChainsResponse{
+		Timestamp: time.Now(),
+		Chains:    []ChainEntry{},
+	}
+	if h.xds != nil {
+		resp.PolicyChainVersion = h.xds.GetPolicyChainVersion()
+	}
+	if h.kernel != nil {
+		resp.Chains = dumpChains(h.kernel)
+	}
+	resp.TotalChains = len(resp.Chains)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// maxCELEvalRequestBytes bounds the POST /cel/eval request body.
+const maxCELEvalRequestBytes = 1 << 20
+
+// CELEvalHandler handles POST /cel/eval requests.
+type CELEvalHandler struct {
+	evaluator cel.CELEvaluator
+}
+
+// NewCELEvalHandler creates a new CEL evaluation handler backed by the
+// evaluator the chain executor uses.
+func NewCELEvalHandler(evaluator cel.CELEvaluator) *CELEvalHandler {
+	return &CELEvalHandler{evaluator: evaluator}
+}
+
+// ServeHTTP implements http.Handler for CEL evaluation. A malformed request is
+// a 400; compile and evaluation errors are reported in the 200 response.
+func (h *CELEvalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req CELEvalRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxCELEvalRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(req.Expression) == "" {
+		http.Error(w, "expression is required", http.StatusBadRequest)
+		return
+	}
+	if req.Phase == "" {
+		req.Phase = "request_headers"
+	}
+
+	result, err := h.evaluate(&req)
+	if errors.Is(err, errUnknownPhase) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := CELEvalResponse{Result: result, Phase: req.Phase}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+var errUnknownPhase = errors.New("phase must be one of request_headers, request_body, response_headers, response_body")
+
+// evaluate builds the policy context for the requested phase and evaluates the
+// expression against it.
+func (h *CELEvalHandler) evaluate(req *CELEvalRequest) (bool, error) {
+	shared := &policy.SharedContext{
+		RequestID: req.Request.RequestID,
+		Metadata:  req.Request.Metadata,
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = map[string]interface{}{}
+	}
+	requestHeaders := policy.NewHeaders(req.Request.Headers)
+	downstream := &policy.DownstreamContext{
+		ClientIP: req.Request.ClientIP,
+		Request:  &policy.DownstreamRequest{Headers: requestHeaders},
+	}
+
+	switch req.Phase {
+	case "request_headers":
+		return h.evaluator.EvaluateRequestHeaderCondition(req.Expression, &policy.RequestHeaderContext{
+			SharedContext: shared,
+			Headers:       requestHeaders,
+			Path:          req.Request.Path,
+			Method:        req.Request.Method,
+			Downstream:    downstream,
+		})
+	case "request_body":
+		return h.evaluator.EvaluateRequestBodyCondition(req.Expression, &policy.RequestContext{
+			SharedContext: shared,
+			Headers:       requestHeaders,
+			Body:          celEvalBody(req.Request.Body),
+			Path:          req.Request.Path,
+			Method:        req.Request.Method,
+			Downstream:    downstream,
+		})
+	case "response_headers":
+		return h.evaluator.EvaluateResponseHeaderCondition(req.Expression, &policy.ResponseHeaderContext{
+			SharedContext:   shared,
+			RequestHeaders:  requestHeaders,
+			RequestBody:     celEvalBody(req.Request.Body),
+			RequestPath:     req.Request.Path,
+			RequestMethod:   req.Request.Method,
+			ResponseHeaders: policy.NewHeaders(req.Response.Headers),
+			ResponseStatus:  req.Response.Status,
+			Downstream:      downstream,
+		})
+	case "response_body":
+		return h.evaluator.EvaluateResponseBodyCondition(req.Expression, &policy.ResponseContext{
+			SharedContext:   shared,
+			RequestHeaders:  requestHeaders,
+			RequestBody:     celEvalBody(req.Request.Body),
+			RequestPath:     req.Request.Path,
+			RequestMethod:   req.Request.Method,
+			ResponseHeaders: policy.NewHeaders(req.Response.Headers),
+			ResponseBody:    celEvalBody(req.Response.Body),
+			ResponseStatus:  req.Response.Status,
+			Downstream:      downstream,
+		})
+	default:
+		return false, errUnknownPhase
+	}
+}
+
+// celEvalBody converts a sample body into a fully received policy body; nil
+// means no body.
+func celEvalBody(body *string) *policy.Body {
+	if body == nil {
+		return nil
+	}
+	return &policy.Body{Content: []byte(*body), EndOfStream: true, Present: true}
+}
+
 // HealthHandler handles GET /health requests.
 type HealthHandler struct {
 	health       HealthProvider
@@ -196,3 +422,87 @@ func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(statusCode)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+// maxPolicyOverrideRequestBytes bounds the PUT /policy_overrides/{name} request body.
+const maxPolicyOverrideRequestBytes = 4 << 10
+
+// PolicyOverridesHandler handles GET /policy_overrides, which lists the
+// policies disabled at runtime, and PUT /policy_overrides/{name}, which
+// disables a policy in every chain or clears that override.
+type PolicyOverridesHandler struct {
+	overrides *executor.PolicyOverrides
+	registry  *registry.PolicyRegistry
+}
+
+// NewPolicyOverridesHandler creates a new policy overrides handler.
+func NewPolicyOverridesHandler(overrides *executor.PolicyOverrides, reg *registry.PolicyRegistry) *PolicyOverridesHandler {
+	return &PolicyOverridesHandler{overrides: overrides, registry: reg}
+}
+
+// ServeHTTP implements http.Handler for policy overrides.
+func (h *PolicyOverridesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, single := strings.CutPrefix(r.URL.Path, "/policy_overrides/")
+	switch {
+	case !single && r.Method == http.MethodGet:
+		h.writeOverrides(w)
+	case single && r.Method == http.MethodPut:
+		h.setEnabled(w, r, name)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *PolicyOverridesHandler) setEnabled(w http.ResponseWriter, r *http.Request, name string) {
+	if name == "" || strings.Contains(name, "/") {
+		http.Error(w, "Policy name is required", http.StatusBadRequest)
+		return
+	}
+
+	var req PolicyOverrideRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPolicyOverrideRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil || req.Enabled == nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	// An override for an unknown policy can still be cleared.
+	if !*req.Enabled && !h.policyRegistered(name) {
+		http.Error(w, "Policy not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.overrides.SetEnabled(name, *req.Enabled); err != nil {
+		slog.Error("Failed to update policy override", "policy", sanitizeLogValue(name), "error", err)
+		http.Error(w, "Failed to update policy override", http.StatusInternalServerError)
+		return
+	}
+	if *req.Enabled {
+		slog.Info("Cleared runtime override; policy follows its configuration", "policy", sanitizeLogValue(name))
+	} else {
+		slog.Warn("Policy disabled by runtime override; it is skipped in every chain", "policy", sanitizeLogValue(name))
+	}
+	h.writeOverrides(w)
+}
+
+// policyRegistered reports whether any version of the named policy is registered.
+func (h *PolicyOverridesHandler) policyRegistered(name string) bool {
+	if h.registry == nil {
+		return false
+	}
+	for _, def := range h.registry.DumpPolicies() {
+		if def.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *PolicyOverridesHandler) writeOverrides(w http.ResponseWriter) {
+	resp := PolicyOverridesResponse{
+		Timestamp:        time.Now(),
+		DisabledPolicies: h.overrides.Disabled(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
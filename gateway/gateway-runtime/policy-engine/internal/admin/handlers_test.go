@@ -20,12 +20,20 @@ package admin
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 )
@@ -86,6 +94,37 @@ func TestConfigDumpHandler_MethodNotAllowed(t *testing.T) {
 	}
 }
 
+// TestConfigDumpHandler_RedactsConfigReferencedParams verifies that values policy
+// parameters take from ${config...} references do not appear in the config dump.
+func TestConfigDumpHandler_RedactsConfigReferencedParams(t *testing.T) {
+	reg := &registry.PolicyRegistry{Policies: make(map[string]*registry.PolicyEntry)}
+	require.NoError(t, reg.SetConfig(map[string]interface{}{
+		"embedding": map[string]interface{}{"api_key": "sk-shared-secret"},
+	}))
+	require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "semantic-cache", Version: "v1.0.0"},
+		testutils.NewMockPolicyFactory("semantic-cache", "v1.0.0")))
+
+	impl, params, err := reg.GetInstance("semantic-cache", "v1", policy.PolicyMetadata{},
+		map[string]interface{}{"apiKey": "${config.embedding.api_key}", "threshold": "0.9"})
+	require.NoError(t, err)
+
+	k := kernel.NewKernel()
+	k.RegisterRoute("test-route", &registry.PolicyChain{
+		Policies: []policy.Policy{impl},
+		PolicySpecs: []policy.PolicySpec{
+			{Name: "semantic-cache", Version: "v1", Enabled: true, Parameters: policy.PolicyParameters{Raw: params}},
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	NewConfigDumpHandler(k, reg, nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/config_dump", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "sk-shared-secret")
+	assert.Contains(t, recorder.Body.String(), `"apiKey":"***REDACTED***"`)
+	assert.Contains(t, recorder.Body.String(), `"threshold":"0.9"`)
+}
+
 // TestNewConfigDumpHandler tests the NewConfigDumpHandler constructor
 func TestNewConfigDumpHandler(t *testing.T) {
 	handler := NewConfigDumpHandler(nil, nil, nil)
@@ -652,3 +691,320 @@ func TestHealthHandler_PythonError(t *testing.T) {
 	assert.NotEmpty(t, response.Timestamp)
 	assert.Equal(t, "python executor is unhealthy", response.Reason)
 }
+
+type mockStatefulPolicy struct{}
+
+func (p *mockStatefulPolicy) Mode() policy.ProcessingMode { return policy.ProcessingMode{} }
+
+func (p *mockStatefulPolicy) PolicyState() map[string]interface{} {
+	return map[string]interface{}{"state": "open", "failures": 5}
+}
+
+func TestPolicyStateHandler(t *testing.T) {
+	k := kernel.NewKernel()
+	k.RegisterRoute("route-1", &registry.PolicyChain{
+		Policies:    []policy.Policy{&mockStatefulPolicy{}},
+		PolicySpecs: []policy.PolicySpec{{Name: "circuit-breaker", Version: "v1.0.0"}},
+	})
+	handler := NewPolicyStateHandler(k)
+
+	req := httptest.NewRequest(http.MethodGet, "/policy_state", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var response PolicyStateResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.False(t, response.Timestamp.IsZero())
+	require.Len(t, response.Policies, 1)
+	assert.Equal(t, "route-1", response.Policies[0].Route)
+	assert.Equal(t, "circuit-breaker", response.Policies[0].Policy)
+	assert.Equal(t, "v1.0.0", response.Policies[0].Version)
+	assert.Equal(t, "open", response.Policies[0].State["state"])
+	assert.Equal(t, float64(5), response.Policies[0].State["failures"])
+}
+
+func TestPolicyStateHandler_Empty(t *testing.T) {
+	handler := NewPolicyStateHandler(kernel.NewKernel())
+
+	req := httptest.NewRequest(http.MethodGet, "/policy_state", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `[]`, mustPolicies(t, recorder.Body.Bytes()))
+}
+
+func TestPolicyStateHandler_MethodNotAllowed(t *testing.T) {
+	handler := NewPolicyStateHandler(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/policy_state", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func mustPolicies(t *testing.T, body []byte) string {
+	t.Helper()
+	var raw map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &raw))
+	return string(raw["policies"])
+}
+
+// newChainsTestKernel registers one route with a conditional policy.
+func newChainsTestKernel() *kernel.Kernel {
+	condition := "request.method == 'POST'"
+	k := kernel.NewKernel()
+	k.ApplyWholeRouteConfigs(map[string]*kernel.RouteConfig{
+		"petstore|/pets|POST": {Metadata: kernel.RouteMetadata{
+			APIName:       "PetStore",
+			APIVersion:    "v1",
+			Context:       "/petstore",
+			OperationPath: "/pets",
+			Vhost:         "default",
+		}},
+	})
+	k.ApplyWholeRoutes(map[string]*registry.PolicyChain{
+		"petstore|/pets|POST": {
+			PolicySpecs: []policy.PolicySpec{
+				{Name: "jwt-auth", Version: "v1.0.0", Enabled: true,
+					Parameters: policy.PolicyParameters{Raw: map[string]interface{}{"secret": "do-not-dump"}}},
+				{Name: "rate-limit", Version: "v1.2.0", Enabled: true, ExecutionCondition: &condition},
+			},
+			RequiresRequestBody:    true,
+			HasExecutionConditions: true,
+		},
+		"aaa|/health|GET": {},
+	})
+	return k
+}
+
+func TestChainsHandler_ListsChains(t *testing.T) {
+	handler := NewChainsHandler(newChainsTestKernel(), &mockXDSSyncProvider{version: "7"})
+
+	req := httptest.NewRequest(http.MethodGet, "/chains", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.NotContains(t, recorder.Body.String(), "do-not-dump", "policy parameters must not be dumped")
+
+	var response ChainsResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "7", response.PolicyChainVersion)
+	assert.Equal(t, 2, response.TotalChains)
+	require.Len(t, response.Chains, 2)
+	assert.Equal(t, "aaa|/health|GET", response.Chains[0].RouteKey, "sorted by route key")
+
+	chain := response.Chains[1]
+	assert.Equal(t, "petstore|/pets|POST", chain.RouteKey)
+	assert.Equal(t, "PetStore", chain.APIName)
+	assert.Equal(t, "/petstore", chain.Context)
+	assert.True(t, chain.RequiresRequestBody)
+	assert.True(t, chain.HasExecutionConditions)
+	require.Len(t, chain.Policies, 2)
+	assert.Equal(t, "jwt-auth", chain.Policies[0].Name)
+	assert.Nil(t, chain.Policies[0].ExecutionCondition)
+	assert.Equal(t, "v1.2.0", chain.Policies[1].Version)
+	require.NotNil(t, chain.Policies[1].ExecutionCondition)
+	assert.Equal(t, "request.method == 'POST'", *chain.Policies[1].ExecutionCondition)
+}
+
+func TestChainsHandler_SingleRouteThroughServer(t *testing.T) {
+	cfg := &config.AdminConfig{AllowedIPs: []string{"*"}}
+	server := NewServer(cfg, newChainsTestKernel(), &registry.PolicyRegistry{}, nil, nil, nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/chains/"+url.PathEscape("petstore|/pets|POST"), nil)
+	recorder := httptest.NewRecorder()
+	server.httpServer.Handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var chain ChainEntry
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &chain))
+	assert.Equal(t, "petstore|/pets|POST", chain.RouteKey)
+	assert.Len(t, chain.Policies, 2)
+}
+
+func TestChainsHandler_UnknownRoute(t *testing.T) {
+	handler := NewChainsHandler(newChainsTestKernel(), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/chains/missing", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestChainsHandler_EmptyAndMethodNotAllowed(t *testing.T) {
+	handler := NewChainsHandler(nil, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/chains", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"chains":[]`)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/chains", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+// postCELEval sends body to a CEL evaluation handler backed by the production evaluator.
+func postCELEval(t *testing.T, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	evaluator, err := cel.NewCELEvaluator()
+	require.NoError(t, err)
+	handler := NewCELEvalHandler(evaluator)
+
+	req := httptest.NewRequest(http.MethodPost, "/cel/eval", strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestCELEvalHandler_Evaluates(t *testing.T) {
+	request := `"request": {
+		"method": "POST",
+		"path": "/petstore/v1/pets?limit=10",
+		"headers": {"X-Tenant": ["acme"], "content-type": ["application/json"]},
+		"client_ip": "10.1.2.3"
+	}`
+	tests := []struct {
+		name       string
+		expression string
+		phase      string
+		extra      string
+		want       bool
+	}{
+		{name: "method", expression: `request.Method == "POST"`, want: true},
+		{name: "path", expression: `request.Path.startsWith("/petstore/v2")`, want: false},
+		{name: "header names are lower-cased", expression: `request.Headers["x-tenant"][0] == "acme"`, want: true},
+		{name: "combined", expression: `request.Method == "POST" && "content-type" in request.Headers`, want: true},
+		{name: "phase defaults to request_headers", expression: `processing.phase == "request_headers"`, want: true},
+		{
+			name:       "response status",
+			expression: `processing.phase == "response_headers" && response.ResponseStatus >= 500`,
+			phase:      "response_headers",
+			extra:      `, "response": {"status": 503, "headers": {"retry-after": ["5"]}}`,
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := fmt.Sprintf(`{"expression": %q, "phase": %q, %s%s}`, tt.expression, tt.phase, request, tt.extra)
+			recorder := postCELEval(t, body)
+
+			require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+			var resp CELEvalResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Empty(t, resp.Error)
+			assert.Equal(t, tt.want, resp.Result)
+		})
+	}
+}
+
+func TestCELEvalHandler_ReportsErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		errMsg     string
+	}{
+		{name: "compile error", expression: `request.Method ==`, errMsg: "failed to compile CEL expression"},
+		{name: "undeclared variable", expression: `unknownVar == "x"`, errMsg: "failed to compile CEL expression"},
+		{name: "non-boolean result", expression: `request.Path`, errMsg: "must return boolean"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := postCELEval(t, fmt.Sprintf(`{"expression": %q, "request": {"path": "/x"}}`, tt.expression))
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var resp CELEvalResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.False(t, resp.Result)
+			assert.Contains(t, resp.Error, tt.errMsg)
+		})
+	}
+}
+
+func TestCELEvalHandler_BadRequests(t *testing.T) {
+	for name, body := range map[string]string{
+		"malformed json":     `{"expression": `,
+		"unknown field":      `{"expression": "true", "headers": {}}`,
+		"missing expression": `{"request": {"method": "GET"}}`,
+		"unknown phase":      `{"expression": "true", "phase": "on_connect"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, postCELEval(t, body).Code)
+		})
+	}
+
+	handler := NewCELEvalHandler(nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/cel/eval", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+// putPolicyOverride sends PUT /policy_overrides/{name} through an admin server.
+func putPolicyOverride(server *Server, name, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPut, "/policy_overrides/"+name, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	server.httpServer.Handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestPolicyOverridesHandler_DisableAndClear(t *testing.T) {
+	reg := &registry.PolicyRegistry{Policies: map[string]*registry.PolicyEntry{
+		"guardrail:v1": {Definition: &policy.PolicyDefinition{Name: "guardrail", Version: "v1.0.0"}},
+	}}
+	overrides, err := executor.NewPolicyOverrides("")
+	require.NoError(t, err)
+	server := NewServer(&config.AdminConfig{AllowedIPs: []string{"*"}}, nil, reg, nil, nil, nil, nil, overrides)
+
+	recorder := putPolicyOverride(server, "guardrail", `{"enabled": false}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var resp PolicyOverridesResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"guardrail"}, resp.DisabledPolicies)
+	assert.True(t, overrides.IsDisabled("guardrail"))
+
+	recorder = httptest.NewRecorder()
+	server.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/policy_overrides", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"disabled_policies":["guardrail"]`)
+
+	recorder = putPolicyOverride(server, "guardrail", `{"enabled": true}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"disabled_policies":[]`)
+	assert.False(t, overrides.IsDisabled("guardrail"))
+}
+
+func TestPolicyOverridesHandler_InvalidRequests(t *testing.T) {
+	reg := &registry.PolicyRegistry{Policies: map[string]*registry.PolicyEntry{
+		"guardrail:v1": {Definition: &policy.PolicyDefinition{Name: "guardrail", Version: "v1.0.0"}},
+	}}
+	overrides, err := executor.NewPolicyOverrides("")
+	require.NoError(t, err)
+	server := NewServer(&config.AdminConfig{AllowedIPs: []string{"*"}}, nil, reg, nil, nil, nil, nil, overrides)
+
+	assert.Equal(t, http.StatusNotFound, putPolicyOverride(server, "unknown", `{"enabled": false}`).Code)
+	assert.Equal(t, http.StatusBadRequest, putPolicyOverride(server, "guardrail", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, putPolicyOverride(server, "guardrail", `{"enabled": "no"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, putPolicyOverride(server, "", `{"enabled": false}`).Code)
+
+	recorder := httptest.NewRecorder()
+	server.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/policy_overrides/guardrail", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Empty(t, overrides.Disabled())
+}
+
+func TestPolicyOverridesHandler_BlockedByIPAllowlist(t *testing.T) {
+	overrides, err := executor.NewPolicyOverrides("")
+	require.NoError(t, err)
+	server := NewServer(&config.AdminConfig{AllowedIPs: []string{"10.0.0.1"}}, nil, &registry.PolicyRegistry{}, nil, nil, nil, nil, overrides)
+
+	assert.Equal(t, http.StatusForbidden, putPolicyOverride(server, "guardrail", `{"enabled": false}`).Code)
+	assert.Empty(t, overrides.Disabled())
+}
@@ -29,7 +29,9 @@ import (
 	"time"
 
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 )
 
@@ -39,16 +41,32 @@ type Server struct {
 	httpServer *http.Server
 }
 
-// NewServer creates a new admin server
-func NewServer(cfg *config.AdminConfig, k *kernel.Kernel, reg *registry.PolicyRegistry, xds XDSSyncStatusProvider, health HealthProvider, pythonHealth PythonHealthChecker) *Server {
+// NewServer creates a new admin server. celEvaluator backs POST /cel/eval and
+// should be the evaluator the chain executor uses; overrides backs
+// /policy_overrides and should be the chain executor's overrides. Each
+// endpoint is not registered when its dependency is nil.
+func NewServer(cfg *config.AdminConfig, k *kernel.Kernel, reg *registry.PolicyRegistry, xds XDSSyncStatusProvider, health HealthProvider, pythonHealth PythonHealthChecker, celEvaluator cel.CELEvaluator, overrides *executor.PolicyOverrides) *Server {
 	mux := http.NewServeMux()
 
 	// Register handlers
 	configDumpHandler := NewConfigDumpHandler(k, reg, xds)
 	xdsSyncHandler := NewXDSSyncStatusHandler(xds)
+	policyStateHandler := NewPolicyStateHandler(k)
+	chainsHandler := NewChainsHandler(k, xds)
 	healthHandler := NewHealthHandler(health, pythonHealth)
 	mux.Handle("/config_dump", ipWhitelistMiddleware(cfg.AllowedIPs, configDumpHandler))
 	mux.Handle("/xds_sync_status", ipWhitelistMiddleware(cfg.AllowedIPs, xdsSyncHandler))
+	mux.Handle("/policy_state", ipWhitelistMiddleware(cfg.AllowedIPs, policyStateHandler))
+	mux.Handle("/chains", ipWhitelistMiddleware(cfg.AllowedIPs, chainsHandler))
+	mux.Handle("/chains/", ipWhitelistMiddleware(cfg.AllowedIPs, chainsHandler))
+	if celEvaluator != nil {
+		mux.Handle("/cel/eval", ipWhitelistMiddleware(cfg.AllowedIPs, NewCELEvalHandler(celEvaluator)))
+	}
+	if overrides != nil {
+		policyOverridesHandler := NewPolicyOverridesHandler(overrides, reg)
+		mux.Handle("/policy_overrides", ipWhitelistMiddleware(cfg.AllowedIPs, policyOverridesHandler))
+		mux.Handle("/policy_overrides/", ipWhitelistMiddleware(cfg.AllowedIPs, policyOverridesHandler))
+	}
 	// Health endpoint is registered without IP whitelist so Docker/k8s health probes can reach it
 	mux.Handle("/health", healthHandler)
 
@@ -46,6 +46,20 @@ type XDSSyncStatusResponse struct {
 	PolicyChainVersion string    `json:"policy_chain_version"`
 }
 
+// PolicyStateResponse is the response payload for GET /policy_state.
+type PolicyStateResponse struct {
+	Timestamp time.Time          `json:"timestamp"`
+	Policies  []PolicyStateEntry `json:"policies"`
+}
+
+// PolicyStateEntry is the runtime state reported by one policy on a route.
+type PolicyStateEntry struct {
+	Route   string                 `json:"route"`
+	Policy  string                 `json:"policy"`
+	Version string                 `json:"version"`
+	State   map[string]interface{} `json:"state"`
+}
+
 // HealthResponse is the response payload for GET /health.
 type HealthResponse struct {
 	Status    string `json:"status"`
@@ -129,3 +143,88 @@ type PolicySpec struct {
 	ExecutionCondition *string                `json:"execution_condition"`
 	Parameters         map[string]interface{} `json:"parameters"`
 }
+
+// ChainsResponse is the response payload for GET /chains.
+type ChainsResponse struct {
+	Timestamp          time.Time    `json:"timestamp"`
+	PolicyChainVersion string       `json:"policy_chain_version"`
+	TotalChains        int          `json:"total_chains"`
+	Chains             []ChainEntry `json:"chains"`
+}
+
+// ChainEntry describes the policy chain loaded for a single route, as the
+// kernel executes it. Policy parameters are left out; use /config_dump for
+// those.
+type ChainEntry struct {
+	RouteKey                  string        `json:"route_key"`
+	APIName                   string        `json:"api_name,omitempty"`
+	APIVersion                string        `json:"api_version,omitempty"`
+	Context                   string        `json:"context,omitempty"`
+	OperationPath             string        `json:"operation_path,omitempty"`
+	Vhost                     string        `json:"vhost,omitempty"`
+	RequiresRequestBody       bool          `json:"requires_request_body"`
+	RequiresResponseBody      bool          `json:"requires_response_body"`
+	SupportsRequestStreaming  bool          `json:"supports_request_streaming"`
+	SupportsResponseStreaming bool          `json:"supports_response_streaming"`
+	HasExecutionConditions    bool          `json:"has_execution_conditions"`
+	Policies                  []ChainPolicy `json:"policies"`
+}
+
+// ChainPolicy is one policy in a chain, in execution order.
+type ChainPolicy struct {
+	Name               string  `json:"name"`
+	Version            string  `json:"version"`
+	Enabled            bool    `json:"enabled"`
+	ExecutionCondition *string `json:"execution_condition"`
+}
+
+// CELEvalRequest is the request payload for POST /cel/eval. The request and
+// response documents are mapped onto the same policy contexts the kernel
+// builds, so an expression evaluates exactly as it would for live traffic in
+// the given phase.
+type CELEvalRequest struct {
+	Expression string `json:"expression"`
+	// Phase is one of request_headers (default), request_body,
+	// response_headers or response_body.
+	Phase    string              `json:"phase,omitempty"`
+	Request  CELEvalHTTPRequest  `json:"request"`
+	Response CELEvalHTTPResponse `json:"response"`
+}
+
+// CELEvalHTTPRequest holds the sample request attributes.
+type CELEvalHTTPRequest struct {
+	Method    string                 `json:"method"`
+	Path      string                 `json:"path"`
+	Headers   map[string][]string    `json:"headers"`
+	Body      *string                `json:"body,omitempty"`
+	ClientIP  string                 `json:"client_ip,omitempty"`
+	RequestID string                 `json:"request_id,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CELEvalHTTPResponse holds the sample response attributes, used in the
+// response phases.
+type CELEvalHTTPResponse struct {
+	Status  int                 `json:"status"`
+	Headers map[string][]string `json:"headers"`
+	Body    *string             `json:"body,omitempty"`
+}
+
+// CELEvalResponse is the response payload for POST /cel/eval. Error holds the
+// compile or evaluation error; Result is false whenever Error is set.
+type CELEvalResponse struct {
+	Result bool   `json:"result"`
+	Phase  string `json:"phase"`
+	Error  string `json:"error,omitempty"`
+}
+
+// PolicyOverridesResponse is the response payload for GET /policy_overrides.
+type PolicyOverridesResponse struct {
+	Timestamp        time.Time `json:"timestamp"`
+	DisabledPolicies []string  `json:"disabled_policies"`
+}
+
+// PolicyOverrideRequest is the request payload for PUT /policy_overrides/{name}.
+type PolicyOverrideRequest struct {
+	Enabled *bool `json:"enabled"`
+}
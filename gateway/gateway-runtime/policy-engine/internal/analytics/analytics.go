@@ -59,6 +59,10 @@ const (
 	DefaultAnalyticsPublisher = "default"
 	// MoesifAnalyticsPublisher represents the Moesif analytics publisher.
 	MoesifAnalyticsPublisher = "moesif"
+	// OTLPAnalyticsPublisher represents the OTLP logs analytics publisher.
+	OTLPAnalyticsPublisher = "otlp"
+	// WebhookAnalyticsPublisher represents the HTTP/JSON webhook analytics publisher.
+	WebhookAnalyticsPublisher = "webhook"
 
 	// HeaderKeys represents the header keys.
 	RequestHeadersKey  = "request_headers"
@@ -88,13 +92,15 @@ type Analytics struct {
 	cfg *config.Config
 	// publishers represents the publishers.
 	publishers []analytics_publisher.Publisher
+	// redactor masks sensitive headers and payload fields in each event.
+	redactor *redactor
 }
 
 // NewAnalytics creates a new instance of Analytics. Publishers are assembled from
 // each independently-configured consumer of the collected data: the analytics
-// consumer ([analytics], e.g. Moesif) and the traffic-logging consumer
-// ([traffic_logging], stdout JSON). Both rely on the collector being enabled to
-// receive any events.
+// consumer ([analytics], e.g. Moesif, OTLP or a webhook) and the traffic-logging
+// consumer ([traffic_logging], stdout JSON). Both rely on the collector being
+// enabled to receive any events.
 func NewAnalytics(cfg *config.Config) *Analytics {
 	analyticsCfg := cfg.Analytics
 	publishers := make([]analytics_publisher.Publisher, 0)
@@ -107,11 +113,30 @@ func NewAnalytics(cfg *config.Config) *Analytics {
 					publishers = append(publishers, publisher)
 					slog.Info("Moesif publisher added")
 				}
+			case OTLPAnalyticsPublisher:
+				publisher := analytics_publisher.NewOTLP(&analyticsCfg.Publishers.OTLP)
+				if publisher != nil {
+					publishers = append(publishers, publisher)
+					slog.Info("OTLP publisher added")
+				}
+			case WebhookAnalyticsPublisher:
+				publisher := analytics_publisher.NewWebhook(&analyticsCfg.Publishers.Webhook)
+				if publisher != nil {
+					publishers = append(publishers, publisher)
+					slog.Info("Webhook publisher added")
+				}
 			default:
 				slog.Warn("Unknown publisher type", "type", publisherName)
 			}
 		}
 	}
+	// Analytics publishers see only the events kept by sampling.
+	if len(publishers) > 0 {
+		publishers = []analytics_publisher.Publisher{&sampledPublisher{
+			sampler:    sampler{cfg: analyticsCfg.Sampling},
+			publishers: publishers,
+		}}
+	}
 
 	// Traffic logging is a standalone consumer, independent of analytics.
 	if cfg.TrafficLogging.Enabled {
@@ -125,6 +150,7 @@ func NewAnalytics(cfg *config.Config) *Analytics {
 	return &Analytics{
 		cfg:        cfg,
 		publishers: publishers,
+		redactor:   newRedactor(analyticsCfg.Redaction, cfg.PolicyEngine.APIKey.Prefix),
 	}
 }
 
@@ -308,7 +334,10 @@ func (c *Analytics) prepareAnalyticEvent(logEntry *v3.HTTPAccessLogEntry) *dto.E
 
 	// prepare metaInfo
 	metaInfo := dto.MetaInfo{}
-	if logEntry.GetCommonProperties().GetStreamId() != "" {
+	if correlationID := keyValuePairsFromMetadata[CorrelationIDKey]; correlationID != "" {
+		// Set by the policy engine so analytics share the correlation ID with logs.
+		metaInfo.CorrelationID = correlationID
+	} else if logEntry.GetCommonProperties().GetStreamId() != "" {
 		metaInfo.CorrelationID = logEntry.GetCommonProperties().GetStreamId()
 	} else {
 		metaInfo.CorrelationID = logEntry.GetRequest().RequestId
@@ -482,24 +511,28 @@ func (c *Analytics) prepareAnalyticEvent(logEntry *v3.HTTPAccessLogEntry) *dto.E
 		event.Properties["requestSize"] = request.GetRequestBodyBytes()
 	}
 
-	//Adding request and response headers for the analytics event
+	//Adding request and response headers for the analytics event, with sensitive values masked
 	if requestHeaders, exists := keyValuePairsFromMetadata[RequestHeadersKey]; exists {
-		event.Properties[dto.PropKeyRequestHeaders] = requestHeaders
+		if redacted, ok := c.redactor.redactHeaders(requestHeaders); ok {
+			event.Properties[dto.PropKeyRequestHeaders] = redacted
+		}
 	}
 	if responseHeaders, exists := keyValuePairsFromMetadata[ResponseHeadersKey]; exists {
-		event.Properties[dto.PropKeyResponseHeaders] = responseHeaders
+		if redacted, ok := c.redactor.redactHeaders(responseHeaders); ok {
+			event.Properties[dto.PropKeyResponseHeaders] = redacted
+		}
 	}
 
 	// Optionally attach request and response payloads when enabled via the collector.
 	if c.cfg.Collector.RequestBody {
 		if requestPayload, ok := keyValuePairsFromMetadata[dto.PropKeyRequestPayload]; ok && requestPayload != "" {
-			event.Properties[dto.PropKeyRequestPayload] = requestPayload
+			event.Properties[dto.PropKeyRequestPayload] = c.redactor.redactPayload(requestPayload)
 			slog.Debug("Analytics request payload captured", "size_bytes", len(requestPayload))
 		}
 	}
 	if c.cfg.Collector.ResponseBody {
 		if responsePayload, ok := keyValuePairsFromMetadata[dto.PropKeyResponsePayload]; ok && responsePayload != "" {
-			event.Properties[dto.PropKeyResponsePayload] = responsePayload
+			event.Properties[dto.PropKeyResponsePayload] = c.redactor.redactPayload(responsePayload)
 			slog.Debug("Analytics response payload captured", "size_bytes", len(responsePayload))
 		}
 	}
@@ -962,6 +962,20 @@ func TestPrepareAnalyticEvent_WithCorrelationID(t *testing.T) {
 	assert.Equal(t, "stream-correlation-123", event.MetaInfo.CorrelationID)
 }
 
+func TestPrepareAnalyticEvent_CorrelationIDFromPolicyEnginePreferred(t *testing.T) {
+	analytics := NewAnalytics(&config.Config{})
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		CorrelationIDKey: "corr-789",
+	})
+	logEntry.CommonProperties.StreamId = "envoy-stream-1"
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	require.NotNil(t, event)
+	assert.Equal(t, "corr-789", event.MetaInfo.CorrelationID)
+}
+
 // =============================================================================
 // Helper Functions for Creating Test Log Entries
 // =============================================================================
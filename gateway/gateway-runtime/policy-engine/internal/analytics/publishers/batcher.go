@@ -0,0 +1,188 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+)
+
+// maxDrainedResponseBytes caps how much of a backend response body is read
+// before the connection is reused. The body itself is never logged.
+const maxDrainedResponseBytes = 64 * 1024
+
+// batcher queues records and hands them to send in batches, either when
+// batchSize records are waiting or every interval. Publish must never block
+// the access log stream, so records are dropped when the queue is full.
+type batcher[T any] struct {
+	name      string
+	queue     chan T
+	batchSize int
+	interval  time.Duration
+	send      func([]T) error
+	dropped   atomic.Uint64
+	done      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+// newBatcher starts a batcher whose background goroutine runs until close.
+func newBatcher[T any](name string, queueSize, batchSize int, interval time.Duration, send func([]T) error) *batcher[T] {
+	b := &batcher[T]{
+		name:      name,
+		queue:     make(chan T, queueSize),
+		batchSize: batchSize,
+		interval:  interval,
+		send:      send,
+		done:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// add queues a record, dropping it when the queue is full.
+func (b *batcher[T]) add(record T) {
+	select {
+	case b.queue <- record:
+	default:
+		metrics.AnalyticsEventsDroppedTotal.WithLabelValues(b.name).Inc()
+		if n := b.dropped.Add(1); n == 1 || n%1000 == 0 {
+			slog.Warn("Analytics event queue is full, dropping events", "publisher", b.name, "dropped", n)
+		}
+	}
+}
+
+func (b *batcher[T]) run() {
+	defer close(b.stopped)
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	batch := make([]T, 0, b.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		slog.Debug(fmt.Sprintf("Publishing %d events", len(batch)), "publisher", b.name)
+		if err := b.send(batch); err != nil {
+			slog.Error("Error publishing analytics events", "publisher", b.name, "events", len(batch), "error", err)
+		}
+		batch = make([]T, 0, b.batchSize)
+	}
+
+	for {
+		select {
+		case record := <-b.queue:
+			batch = append(batch, record)
+			if len(batch) >= b.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-b.done:
+			for {
+				select {
+				case record := <-b.queue:
+					batch = append(batch, record)
+					if len(batch) >= b.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// close flushes the queued records and stops the background goroutine. Safe to
+// call multiple times.
+func (b *batcher[T]) close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+	<-b.stopped
+}
+
+// httpSender posts encoded batches to a fixed endpoint.
+type httpSender struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+	// gzip compresses request bodies with Content-Encoding: gzip.
+	gzip bool
+}
+
+func newHTTPSender(url string, headers map[string]string, timeout time.Duration) *httpSender {
+	return &httpSender{
+		client:  &http.Client{Timeout: timeout},
+		url:     url,
+		headers: headers,
+	}
+}
+
+// post sends body to the endpoint. Configured header values (which usually
+// carry credentials) and the response body are kept out of returned errors.
+func (s *httpSender) post(contentType string, body []byte) error {
+	if s.gzip {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(body); err != nil {
+			return fmt.Errorf("failed to compress request: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("failed to compress request: %w", err)
+		}
+		body = buf.Bytes()
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if s.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return nil
+}
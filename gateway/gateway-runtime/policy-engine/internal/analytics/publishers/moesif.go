@@ -22,28 +22,47 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/moesif/moesifapi-go"
 	"github.com/moesif/moesifapi-go/models"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
 )
 
 const (
 	anonymous         = "anonymous"
 	userIDPropertyKey = "x-wso2-user-id"
+
+	// MoesifPublisherName labels the Moesif publisher in logs and metrics.
+	MoesifPublisherName = "moesif"
+
+	defaultMoesifBaseURL        = "https://api.moesif.net"
+	defaultMoesifEventQueueSize = 10000
+	defaultMoesifBatchSize      = 50
+	moesifRequestTimeout        = 10 * time.Second
 )
 
-// Moesif represents a Moesif publisher.
+// moesifBatchPath is the Moesif batch ingestion endpoint, relative to the base URL.
+const moesifBatchPath = "/v1/events/batch"
+
+// Moesif represents a Moesif publisher. Events are buffered in memory and sent
+// to Moesif as a gzip-compressed batch once batch_size events are waiting or
+// every publish_interval seconds. The buffer holds at most event_queue_size
+// events; while it is full new events are dropped (and counted) so that
+// publishing never blocks the access log stream.
 type Moesif struct {
 	cfg       *config.MoesifPublisherConfig
-	api       moesifapi.API
+	sender    *httpSender
 	events    []*models.EventModel
+	dropped   uint64
 	mu        sync.Mutex
+	flush     chan struct{}
 	done      chan struct{}
+	stopped   chan struct{}
 	closeOnce sync.Once
 }
 
@@ -71,59 +90,93 @@ func NewMoesif(moesifCfg *config.MoesifPublisherConfig) *Moesif {
 		moesifApplicationId = moesifCfg.ApplicationID
 	}
 
-	// Moesif Client Configs
-	eventQueueSize, batchSize, timerWakeupSeconds :=
-		moesifCfg.EventQueueSize,
-		moesifCfg.BatchSize,
-		moesifCfg.TimerWakeupSeconds
-
-	var apiEndpoint *string
-	if moesifCfg.BaseURL != "" {
-		apiEndpoint = &moesifCfg.BaseURL
+	cfg := *moesifCfg
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaultMoesifBaseURL
 	}
-	apiClient := moesifapi.NewAPI(moesifApplicationId, apiEndpoint, eventQueueSize, batchSize, timerWakeupSeconds)
+	if cfg.EventQueueSize <= 0 {
+		cfg.EventQueueSize = defaultMoesifEventQueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultMoesifBatchSize
+	}
+
+	sender := newHTTPSender(strings.TrimSuffix(cfg.BaseURL, "/")+moesifBatchPath,
+		map[string]string{"X-Moesif-Application-Id": moesifApplicationId}, moesifRequestTimeout)
+	sender.gzip = true
 	moesif := &Moesif{
-		cfg:    moesifCfg,
-		events: []*models.EventModel{},
-		api:    apiClient,
-		mu:     sync.Mutex{},
-		done:   make(chan struct{}),
-	}
-	go func() {
-		ticker := time.NewTicker(time.Duration(moesifCfg.PublishInterval) * time.Second)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-moesif.done:
-				return
-			case <-ticker.C:
-				moesif.mu.Lock()
-				if len(moesif.events) > 0 {
-					slog.Debug(fmt.Sprintf("Publishing %d events to Moesif", len(moesif.events)))
-					err := moesif.api.QueueEvents(moesif.events)
-					if err != nil {
-						slog.Error("Error publishing events to Moesif", "error", err)
-					}
-					moesif.events = []*models.EventModel{}
-				}
-				moesif.mu.Unlock()
+		cfg:     &cfg,
+		sender:  sender,
+		events:  make([]*models.EventModel, 0, cfg.BatchSize),
+		flush:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go moesif.run(time.Duration(cfg.PublishInterval) * time.Second)
+	return moesif
+}
+
+// run sends the buffered events whenever a batch fills up or the publish
+// interval elapses, until Close is called.
+func (m *Moesif) run(interval time.Duration) {
+	defer close(m.stopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			m.publishBatch()
+			return
+		case <-m.flush:
+			m.publishBatch()
+		case <-ticker.C:
+			m.publishBatch()
+		}
+	}
+}
+
+// publishBatch sends the buffered events in batches of at most batch_size. The
+// buffer is released before sending so Publish is never blocked on the network.
+func (m *Moesif) publishBatch() {
+	m.mu.Lock()
+	events := m.events
+	m.events = make([]*models.EventModel, 0, m.cfg.BatchSize)
+	m.mu.Unlock()
+
+	for len(events) > 0 {
+		n := min(len(events), m.cfg.BatchSize)
+		body, err := json.Marshal(events[:n])
+		if err != nil {
+			slog.Error("Failed to marshal events for Moesif", "error", err)
+		} else {
+			slog.Debug(fmt.Sprintf("Publishing %d events to Moesif", n))
+			if err := m.sender.post("application/json; charset=utf-8", body); err != nil {
+				slog.Error("Error publishing events to Moesif", "events", n, "error", err)
 			}
 		}
-	}()
-	return moesif
+		events = events[n:]
+	}
 }
 
-// Close stops the background publishing goroutine.
-// It should be called when the Moesif publisher is no longer needed.
-// Safe to call multiple times.
+// Close sends the buffered events and stops the background publishing
+// goroutine. It should be called when the Moesif publisher is no longer
+// needed. Safe to call multiple times.
 func (m *Moesif) Close() {
 	m.closeOnce.Do(func() {
 		if m.done != nil {
 			close(m.done)
+			<-m.stopped
 		}
 	})
 }
 
+// Dropped returns the number of events dropped because the buffer was full.
+func (m *Moesif) Dropped() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dropped
+}
+
 // Publish publishes an event to Moesif.
 func (m *Moesif) Publish(event *dto.Event) {
 	m.mu.Lock()
@@ -325,7 +378,21 @@ func (m *Moesif) Publish(event *dto.Event) {
 		UserId:   &userID,
 		Metadata: metadataMap,
 	}
+	if len(m.events) >= m.cfg.EventQueueSize {
+		m.dropped++
+		metrics.AnalyticsEventsDroppedTotal.WithLabelValues(MoesifPublisherName).Inc()
+		if m.dropped == 1 || m.dropped%1000 == 0 {
+			slog.Warn("Moesif event queue is full, dropping events", "dropped", m.dropped)
+		}
+		return
+	}
 	m.events = append(m.events, eventModel)
 	slog.Debug(fmt.Sprintf("Event added to the queue. Queue size: %d", len(m.events)))
-	slog.Debug("Events", "events", m.events)
+	if len(m.events) >= m.cfg.BatchSize {
+		// Wake the sender; a pending signal already covers this batch.
+		select {
+		case m.flush <- struct{}{}:
+		default:
+		}
+	}
 }
@@ -18,6 +18,9 @@
 package publishers
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
 	"os"
 	"sync"
 	"testing"
@@ -29,10 +32,11 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
 )
 
-// createTestMoesifWithoutAPI creates a Moesif publisher without a real API for testing Publish method.
-// The api field is nil, but Publish doesn't call api methods - it only queues events.
+// createTestMoesifWithoutAPI creates a Moesif publisher without a sender or background goroutine for
+// testing the Publish method, which only queues events.
 func createTestMoesifWithoutAPI() *Moesif {
 	return &Moesif{
 		cfg: &config.MoesifPublisherConfig{
@@ -42,7 +46,6 @@ func createTestMoesifWithoutAPI() *Moesif {
 			BatchSize:          10,
 			TimerWakeupSeconds: 1,
 		},
-		api:    nil, // Publish method doesn't call api methods
 		events: []*models.EventModel{},
 		mu:     sync.Mutex{},
 	}
@@ -482,3 +485,80 @@ func TestPublish_MetadataContainsAPIInfo(t *testing.T) {
 	assert.Equal(t, "api-123", metadata["apiId"])
 	assert.Equal(t, "project-123", metadata["projectId"])
 }
+
+// newTestMoesif starts a Moesif publisher that sends to server.
+func newTestMoesif(t *testing.T, server *recordingServer, publishInterval, queueSize, batchSize int) *Moesif {
+	t.Helper()
+	m := NewMoesif(&config.MoesifPublisherConfig{
+		ApplicationID:   "test-app-id",
+		BaseURL:         server.URL,
+		PublishInterval: publishInterval,
+		EventQueueSize:  queueSize,
+		BatchSize:       batchSize,
+	})
+	require.NotNil(t, m)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// decodeMoesifBatch gunzips and decodes a batch posted to the Moesif endpoint.
+func decodeMoesifBatch(t *testing.T, body []byte) []models.EventModel {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	require.NoError(t, err, "batch should be gzip-compressed")
+	var events []models.EventModel
+	require.NoError(t, json.NewDecoder(zr).Decode(&events))
+	return events
+}
+
+func TestMoesif_FlushesOnBatchSize(t *testing.T) {
+	server := newRecordingServer(t)
+	m := newTestMoesif(t, server, 60, 100, 2)
+
+	m.Publish(createBaseEvent())
+	m.Publish(createBaseEvent())
+
+	require.Eventually(t, func() bool {
+		bodies, _ := server.requests()
+		return len(bodies) == 1
+	}, 5*time.Second, 10*time.Millisecond, "a full batch should be sent without waiting for the interval")
+
+	bodies, headers := server.requests()
+	assert.Equal(t, moesifBatchPath, server.paths[0])
+	assert.Equal(t, "gzip", headers[0].Get("Content-Encoding"))
+	assert.Equal(t, "test-app-id", headers[0].Get("X-Moesif-Application-Id"))
+	events := decodeMoesifBatch(t, bodies[0])
+	require.Len(t, events, 2)
+	assert.Equal(t, "/resource", events[0].Request.Uri)
+}
+
+func TestMoesif_FlushesOnInterval(t *testing.T) {
+	server := newRecordingServer(t)
+	m := newTestMoesif(t, server, 1, 100, 50)
+
+	m.Publish(createBaseEvent())
+
+	require.Eventually(t, func() bool {
+		bodies, _ := server.requests()
+		return len(bodies) == 1
+	}, 5*time.Second, 20*time.Millisecond, "a partial batch should be sent when the interval elapses")
+	bodies, _ := server.requests()
+	assert.Len(t, decodeMoesifBatch(t, bodies[0]), 1)
+}
+
+func TestMoesif_DropsEventsWhenQueueIsFull(t *testing.T) {
+	metrics.SetEnabled(false)
+	metrics.Init()
+	server := newRecordingServer(t)
+	m := newTestMoesif(t, server, 60, 2, 50)
+
+	for i := 0; i < 5; i++ {
+		m.Publish(createBaseEvent())
+	}
+	assert.Equal(t, uint64(3), m.Dropped())
+
+	m.Close()
+	bodies, _ := server.requests()
+	require.Len(t, bodies, 1, "queued events should be sent on close")
+	assert.Len(t, decodeMoesifBatch(t, bodies[0]), 2)
+}
@@ -0,0 +1,182 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// otlpLogsPath is the OTLP/HTTP logs signal path, appended to the endpoint.
+	otlpLogsPath = "/v1/logs"
+	// otlpScopeName identifies the analytics publisher as the instrumentation scope.
+	otlpScopeName = "github.com/wso2/api-platform/gateway/policy-engine/analytics"
+	// otlpEventName is the event name of every exported log record.
+	otlpEventName = "api.request"
+)
+
+// OTLP is an analytics publisher that exports each event as an OpenTelemetry
+// log record over OTLP/HTTP (protobuf). The record body is the analytics record
+// JSON (see toAnalyticsRecord); the fields commonly filtered on are also set as
+// attributes.
+type OTLP struct {
+	sender   *httpSender
+	resource *resourcepb.Resource
+	batcher  *batcher[*logspb.LogRecord]
+}
+
+// NewOTLP creates a new OTLP logs publisher.
+func NewOTLP(otlpCfg *config.OTLPPublisherConfig) *OTLP {
+	if otlpCfg == nil {
+		slog.Error("OTLP config is nil")
+		return nil
+	}
+
+	endpoint := strings.TrimSuffix(otlpCfg.Endpoint, "/") + otlpLogsPath
+	o := &OTLP{
+		sender: newHTTPSender(endpoint, otlpCfg.Headers, time.Duration(otlpCfg.Timeout)*time.Second),
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{stringAttribute("service.name", otlpCfg.ServiceName)},
+		},
+	}
+	o.batcher = newBatcher("otlp", otlpCfg.EventQueueSize, otlpCfg.BatchSize,
+		time.Duration(otlpCfg.PublishInterval)*time.Second, o.send)
+	return o
+}
+
+// Publish queues an event to be exported with the next batch.
+func (o *OTLP) Publish(event *dto.Event) {
+	if event == nil {
+		return
+	}
+	record, err := toLogRecord(event)
+	if err != nil {
+		slog.Error("Failed to convert analytics event to an OTLP log record", "error", err)
+		return
+	}
+	o.batcher.add(record)
+}
+
+// Close exports the queued events and stops the background publishing
+// goroutine. Safe to call multiple times.
+func (o *OTLP) Close() {
+	o.batcher.close()
+}
+
+func (o *OTLP) send(records []*logspb.LogRecord) error {
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: o.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: records,
+			}},
+		}},
+	}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal export request: %w", err)
+	}
+	return o.sender.post("application/x-protobuf", body)
+}
+
+// toLogRecord maps an analytics event onto an OTLP log record. The severity
+// follows the response status: WARN for 4xx and ERROR for 5xx.
+func toLogRecord(event *dto.Event) (*logspb.LogRecord, error) {
+	record := toAnalyticsRecord(event)
+	body, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	logRecord := &logspb.LogRecord{
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		EventName:            otlpEventName,
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+	}
+	if !event.RequestTimestamp.IsZero() {
+		logRecord.TimeUnixNano = uint64(event.RequestTimestamp.UnixNano())
+	}
+	switch {
+	case record.Status >= 500:
+		logRecord.SeverityNumber, logRecord.SeverityText = logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, "ERROR"
+	case record.Status >= 400:
+		logRecord.SeverityNumber, logRecord.SeverityText = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+	}
+
+	attrs := []*commonpb.KeyValue{
+		stringAttribute("correlation.id", record.CorrelationID),
+		stringAttribute("user.id", fmt.Sprint(record.Properties["userId"])),
+	}
+	if record.Status != 0 {
+		attrs = append(attrs, intAttribute("http.response.status_code", int64(record.Status)))
+	}
+	if api := record.API; api != nil {
+		attrs = append(attrs,
+			stringAttribute("api.id", api.ID),
+			stringAttribute("api.name", api.Name),
+			stringAttribute("api.version", api.Version),
+			stringAttribute("api.context", api.Context),
+			stringAttribute("api.kind", api.Kind),
+		)
+	}
+	if op := record.Operation; op != nil {
+		attrs = append(attrs,
+			stringAttribute("http.request.method", op.Method),
+			stringAttribute("http.route", op.Path),
+		)
+	}
+	if app := record.Application; app != nil {
+		attrs = append(attrs, stringAttribute("application.id", app.ID))
+	}
+	if client := record.Client; client != nil {
+		attrs = append(attrs,
+			stringAttribute("client.address", client.IP),
+			stringAttribute("user_agent.original", client.UserAgent),
+		)
+	}
+	for _, attr := range attrs {
+		if s, ok := attr.Value.Value.(*commonpb.AnyValue_StringValue); ok && s.StringValue == "" {
+			continue
+		}
+		logRecord.Attributes = append(logRecord.Attributes, attr)
+	}
+	return logRecord, nil
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func intAttribute(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}}}
+}
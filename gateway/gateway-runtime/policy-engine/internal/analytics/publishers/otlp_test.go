@@ -0,0 +1,114 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// attributes flattens the string and int attributes of a log record.
+func attributes(record *logspb.LogRecord) map[string]interface{} {
+	attrs := make(map[string]interface{}, len(record.Attributes))
+	for _, kv := range record.Attributes {
+		switch v := kv.Value.Value.(type) {
+		case *commonpb.AnyValue_StringValue:
+			attrs[kv.Key] = v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			attrs[kv.Key] = v.IntValue
+		}
+	}
+	return attrs
+}
+
+func TestNewOTLP_NilConfig(t *testing.T) {
+	assert.Nil(t, NewOTLP(nil))
+}
+
+func TestOTLP_ExportsLogRecords(t *testing.T) {
+	server := newRecordingServer(t)
+	o := NewOTLP(&config.OTLPPublisherConfig{
+		Endpoint:        server.URL + "/",
+		Headers:         map[string]string{"X-Api-Key": "secret"},
+		ServiceName:     "gateway-eu",
+		PublishInterval: 60,
+		EventQueueSize:  10,
+		BatchSize:       10,
+		Timeout:         5,
+	})
+
+	ok := createBaseEvent()
+	failed := createBaseEvent()
+	failed.ProxyResponseCode = 503
+	o.Publish(ok)
+	o.Publish(failed)
+	o.Close()
+
+	bodies, headers := server.requests()
+	require.Len(t, bodies, 1)
+	assert.Equal(t, "application/x-protobuf", headers[0].Get("Content-Type"))
+	assert.Equal(t, "secret", headers[0].Get("X-Api-Key"))
+
+	var req collogspb.ExportLogsServiceRequest
+	require.NoError(t, proto.Unmarshal(bodies[0], &req))
+	require.Len(t, req.ResourceLogs, 1)
+	resource := req.ResourceLogs[0].Resource.Attributes
+	require.Len(t, resource, 1)
+	assert.Equal(t, "service.name", resource[0].Key)
+	assert.Equal(t, "gateway-eu", resource[0].Value.GetStringValue())
+
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 2)
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, records[0].SeverityNumber)
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, records[1].SeverityNumber)
+	assert.Equal(t, uint64(ok.RequestTimestamp.UnixNano()), records[0].TimeUnixNano)
+	assert.Equal(t, otlpEventName, records[0].EventName)
+
+	attrs := attributes(records[0])
+	assert.Equal(t, "corr-123", attrs["correlation.id"])
+	assert.Equal(t, "api-123", attrs["api.id"])
+	assert.Equal(t, "GET", attrs["http.request.method"])
+	assert.Equal(t, int64(200), attrs["http.response.status_code"])
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(records[0].Body.GetStringValue()), &body))
+	assert.Equal(t, "corr-123", body["correlationId"])
+}
+
+func TestOTLP_ServerPathIsLogsSignal(t *testing.T) {
+	paths := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+	}))
+	defer server.Close()
+
+	o := NewOTLP(&config.OTLPPublisherConfig{Endpoint: server.URL, PublishInterval: 60, EventQueueSize: 1, BatchSize: 1, Timeout: 5})
+	o.Publish(createBaseEvent())
+	o.Close()
+	assert.Equal(t, "/v1/logs", <-paths)
+}
@@ -0,0 +1,91 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+)
+
+// recordPropertyKeys are the event properties copied into the properties of an
+// analytics record. They mirror the metadata the Moesif publisher sends, so
+// every backend receives the same view of a request.
+var recordPropertyKeys = []string{
+	"aiMetadata",
+	"aiTokenUsage",
+	"mcpAnalytics",
+	"responseContentType",
+	"responseSize",
+	"requestSize",
+	"commonName",
+	"isEgress",
+	constants.LLMCostPropertyKey,
+	constants.GuardrailHitMetadataKey,
+	constants.GuardrailNameMetadataKey,
+}
+
+// toAnalyticsRecord maps an analytics event onto the record sent by the
+// webhook and OTLP publishers. It is the TrafficLogEvent shape with the headers
+// and payloads the collector captured (headers are only present when the
+// analytics-header-filter policy emitted them, as for Moesif), and the user,
+// subscription, AI and MCP analytics carried in properties.
+func toAnalyticsRecord(event *dto.Event) *TrafficLogEvent {
+	record := newTrafficLogEvent(event)
+
+	if raw, ok := event.Properties[dto.PropKeyRequestHeaders].(string); ok {
+		record.RequestHeaders = parseHeadersFromString(raw)
+	}
+	if raw, ok := event.Properties[dto.PropKeyResponseHeaders].(string); ok {
+		record.ResponseHeaders = parseHeadersFromString(raw)
+	}
+	if p, ok := event.Properties[dto.PropKeyRequestPayload].(string); ok {
+		record.RequestBody = p
+	}
+	if p, ok := event.Properties[dto.PropKeyResponsePayload].(string); ok {
+		record.ResponseBody = p
+	}
+
+	properties := make(map[string]interface{})
+	userID := anonymous
+	if uid, ok := event.Properties[userIDPropertyKey].(string); ok && uid != "" {
+		userID = uid
+	}
+	properties["userId"] = userID
+	for _, key := range recordPropertyKeys {
+		if value, ok := event.Properties[key]; ok && value != nil {
+			properties[key] = value
+		}
+	}
+	if s := event.Subscription; s != nil {
+		if s.BillingCustomerID != "" {
+			properties["billingCustomerId"] = s.BillingCustomerID
+		}
+		if s.BillingSubscriptionID != "" {
+			properties["billingSubscriptionId"] = s.BillingSubscriptionID
+		}
+		if s.Status != "" {
+			properties["subscriptionStatus"] = s.Status
+		}
+		if s.PlanName != "" {
+			properties["subscriptionPlanName"] = s.PlanName
+		}
+	}
+	record.Properties = properties
+
+	return record
+}
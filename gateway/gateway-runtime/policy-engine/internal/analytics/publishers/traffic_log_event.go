@@ -24,7 +24,8 @@ import (
 // trafficLogTimestampFormat is RFC 3339 with millisecond precision.
 const trafficLogTimestampFormat = "2006-01-02T15:04:05.000Z07:00"
 
-// TrafficLogEvent is the JSON shape written to stdout by the Log publisher.
+// TrafficLogEvent is the JSON shape written to stdout by the Log publisher, and
+// the record sent by the webhook and OTLP publishers (see toAnalyticsRecord).
 // It is intentionally separate from dto.Event (shaped for Moesif) so its field
 // names, schema, and presence rules can evolve independently. All string fields
 // carry omitempty so absent or unknown values produce no key rather than "".
@@ -84,10 +85,11 @@ type TrafficLogClient struct {
 	UserAgent string `json:"userAgent,omitempty"`
 }
 
-// toTrafficLogEvent translates a dto.Event and its directive into the
-// traffic-log-specific output shape, applying per-flow header filtering, header
-// masking, and payload truncation.
-func (l *Log) toTrafficLogEvent(event *dto.Event, dir *dto.TrafficLogDirective) *TrafficLogEvent {
+// newTrafficLogEvent maps the request-level fields of a dto.Event (API,
+// operation, target, application, client and latencies) onto the flat
+// TrafficLogEvent shape. Headers, payloads and properties are left to the
+// caller, since each publisher selects them differently.
+func newTrafficLogEvent(event *dto.Event) *TrafficLogEvent {
 	tl := &TrafficLogEvent{
 		Status:    event.ProxyResponseCode,
 		Latencies: event.TrafficLogLatencies,
@@ -152,6 +154,15 @@ func (l *Log) toTrafficLogEvent(event *dto.Event, dir *dto.TrafficLogDirective)
 		}
 	}
 
+	return tl
+}
+
+// toTrafficLogEvent translates a dto.Event and its directive into the
+// traffic-log-specific output shape, applying per-flow header filtering, header
+// masking, and payload truncation.
+func (l *Log) toTrafficLogEvent(event *dto.Event, dir *dto.TrafficLogDirective) *TrafficLogEvent {
+	tl := newTrafficLogEvent(event)
+
 	// fields.exclude only trims fields/sub-keys that the per-flow Headers/Payload
 	// booleans below already turned on — it is a subtractive projection over the
 	// enabled set, never an independent "log everything except X" switch. Setting
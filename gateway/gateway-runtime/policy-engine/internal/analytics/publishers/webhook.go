@@ -0,0 +1,72 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+// Webhook is an analytics publisher that POSTs batches of events to an HTTP
+// endpoint as a JSON array of analytics records (see toAnalyticsRecord).
+type Webhook struct {
+	sender  *httpSender
+	batcher *batcher[*TrafficLogEvent]
+}
+
+// NewWebhook creates a new webhook publisher.
+func NewWebhook(webhookCfg *config.WebhookPublisherConfig) *Webhook {
+	if webhookCfg == nil {
+		slog.Error("Webhook config is nil")
+		return nil
+	}
+
+	w := &Webhook{
+		sender: newHTTPSender(webhookCfg.URL, webhookCfg.Headers, time.Duration(webhookCfg.Timeout)*time.Second),
+	}
+	w.batcher = newBatcher("webhook", webhookCfg.EventQueueSize, webhookCfg.BatchSize,
+		time.Duration(webhookCfg.PublishInterval)*time.Second, w.send)
+	return w
+}
+
+// Publish queues an event to be posted with the next batch.
+func (w *Webhook) Publish(event *dto.Event) {
+	if event == nil {
+		return
+	}
+	w.batcher.add(toAnalyticsRecord(event))
+}
+
+// Close posts the queued events and stops the background publishing goroutine.
+// Safe to call multiple times.
+func (w *Webhook) Close() {
+	w.batcher.close()
+}
+
+func (w *Webhook) send(records []*TrafficLogEvent) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("failed to marshal events: %w", err)
+	}
+	return w.sender.post("application/json", body)
+}
@@ -0,0 +1,162 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package publishers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+)
+
+// recordingServer captures the requests posted to it.
+type recordingServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	bodies   [][]byte
+	headers  []http.Header
+	paths    []string
+	response int
+}
+
+func newRecordingServer(t *testing.T) *recordingServer {
+	t.Helper()
+	rs := &recordingServer{response: http.StatusOK}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rs.mu.Lock()
+		rs.bodies = append(rs.bodies, body)
+		rs.headers = append(rs.headers, r.Header.Clone())
+		rs.paths = append(rs.paths, r.URL.Path)
+		status := rs.response
+		rs.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(rs.Close)
+	return rs
+}
+
+func (rs *recordingServer) requests() ([][]byte, []http.Header) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.bodies, rs.headers
+}
+
+func webhookConfig(url string) *config.WebhookPublisherConfig {
+	return &config.WebhookPublisherConfig{
+		URL:             url,
+		Headers:         map[string]string{"Authorization": "Bearer secret"},
+		PublishInterval: 60,
+		EventQueueSize:  10,
+		BatchSize:       2,
+		Timeout:         5,
+	}
+}
+
+func TestNewWebhook_NilConfig(t *testing.T) {
+	assert.Nil(t, NewWebhook(nil))
+}
+
+func TestWebhook_PostsBatches(t *testing.T) {
+	server := newRecordingServer(t)
+	w := NewWebhook(webhookConfig(server.URL))
+
+	event := createBaseEvent()
+	event.Properties[dto.PropKeyRequestHeaders] = `{"x-request-id":"abc"}`
+	event.Properties["x-wso2-user-id"] = "alice"
+	event.Properties["aiTokenUsage"] = dto.AITokenUsage{}
+	event.Subscription = &dto.Subscription{PlanName: "Gold"}
+	for i := 0; i < 3; i++ {
+		w.Publish(event)
+	}
+	w.Publish(nil)
+	w.Close()
+	w.Close()
+
+	bodies, headers := server.requests()
+	require.Len(t, bodies, 2, "a full batch is posted immediately and the rest on close")
+	assert.Equal(t, "application/json", headers[0].Get("Content-Type"))
+	assert.Equal(t, "Bearer secret", headers[0].Get("Authorization"))
+
+	var first []map[string]interface{}
+	require.NoError(t, json.Unmarshal(bodies[0], &first))
+	require.Len(t, first, 2)
+	record := first[0]
+	assert.Equal(t, "corr-123", record["correlationId"])
+	assert.Equal(t, float64(200), record["status"])
+	assert.Equal(t, "test-api", record["api"].(map[string]interface{})["name"])
+	assert.Equal(t, "/resource", record["operation"].(map[string]interface{})["path"])
+	assert.Equal(t, "abc", record["requestHeaders"].(map[string]interface{})["x-request-id"])
+	properties := record["properties"].(map[string]interface{})
+	assert.Equal(t, "alice", properties["userId"])
+	assert.Equal(t, "Gold", properties["subscriptionPlanName"])
+	assert.Contains(t, properties, "aiTokenUsage")
+
+	var second []map[string]interface{}
+	require.NoError(t, json.Unmarshal(bodies[1], &second))
+	assert.Len(t, second, 1)
+}
+
+func TestWebhook_DropsEventsWhenQueueIsFull(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer server.Close()
+
+	metrics.SetEnabled(false)
+	metrics.Init()
+	cfg := webhookConfig(server.URL)
+	cfg.BatchSize = 1
+	cfg.EventQueueSize = 1
+	w := NewWebhook(cfg)
+	for i := 0; i < 10; i++ {
+		w.Publish(createBaseEvent())
+	}
+	assert.NotZero(t, w.batcher.dropped.Load(), "publish must not block when the backend is slow")
+	close(block)
+	w.Close()
+}
+
+func TestHTTPSender_ErrorOmitsResponseBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("invalid token Bearer secret"))
+	}))
+	defer server.Close()
+
+	err := newHTTPSender(server.URL, map[string]string{"Authorization": "Bearer secret"}, 0).post("application/json", []byte("[]"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	assert.NotContains(t, err.Error(), "secret")
+}
+
+func TestToAnalyticsRecord_AnonymousUser(t *testing.T) {
+	record := toAnalyticsRecord(createBaseEvent())
+	assert.Equal(t, "anonymous", record.Properties["userId"])
+	assert.Nil(t, record.RequestHeaders)
+	assert.Equal(t, "192.168.1.1", record.Client.IP)
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package analytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+// redactedValue replaces every redacted header value and body field.
+const redactedValue = "****"
+
+// defaultRedactedHeaders are masked in every event, whatever the configuration.
+var defaultRedactedHeaders = []string{
+	"authorization", "proxy-authorization", "cookie", "set-cookie",
+	"x-api-key", "api-key", "apikey", "x-jwt-assertion",
+}
+
+// defaultRedactedBodyPaths are masked at any depth of every JSON payload.
+var defaultRedactedBodyPaths = []string{
+	"password", "client_secret", "access_token", "refresh_token", "id_token",
+	"api_key", "apikey",
+}
+
+// defaultAPIKeyPrefix is matched when no API key prefix is configured.
+const defaultAPIKeyPrefix = "apip_"
+
+// apiKeyPatternFor returns a pattern matching API keys issued with any of the given
+// prefixes wherever they appear in a header value or payload, e.g. in a custom
+// header or a query string echoed in a body. A prefix starting with a word
+// character must start a word, so "wso2_apip_x" is not taken for an "apip_" key.
+func apiKeyPatternFor(prefixes []string) *regexp.Regexp {
+	alternatives := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		alternatives[i] = regexp.QuoteMeta(prefix)
+		if isWordChar(prefix[0]) {
+			alternatives[i] = `\b` + alternatives[i]
+		}
+	}
+	return regexp.MustCompile(`(?:` + strings.Join(alternatives, "|") + `)[A-Za-z0-9._~+/=-]+`)
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// redactor masks sensitive headers and body fields in the serialized headers
+// and payloads the analytics system policy attaches to an event.
+type redactor struct {
+	headers  map[string]bool
+	anyDepth map[string]bool
+	paths    [][]string
+	// apiKeyPrefixes and apiKeyPattern find API keys outside the redacted fields
+	apiKeyPrefixes []string
+	apiKeyPattern  *regexp.Regexp
+}
+
+// newRedactor returns a redactor for cfg that also masks API keys carrying one of
+// apiKeyPrefixes, or the default prefix when none is given.
+func newRedactor(cfg config.AnalyticsRedactionConfig, apiKeyPrefixes ...string) *redactor {
+	r := &redactor{headers: make(map[string]bool), anyDepth: make(map[string]bool)}
+	for _, prefix := range apiKeyPrefixes {
+		if prefix != "" {
+			r.apiKeyPrefixes = append(r.apiKeyPrefixes, prefix)
+		}
+	}
+	if len(r.apiKeyPrefixes) == 0 {
+		r.apiKeyPrefixes = []string{defaultAPIKeyPrefix}
+	}
+	r.apiKeyPattern = apiKeyPatternFor(r.apiKeyPrefixes)
+	for _, h := range append(append([]string{}, defaultRedactedHeaders...), cfg.Headers...) {
+		r.headers[strings.ToLower(strings.TrimSpace(h))] = true
+	}
+	for _, p := range append(append([]string{}, defaultRedactedBodyPaths...), cfg.BodyPaths...) {
+		if p == "" {
+			continue
+		}
+		segments := strings.Split(p, ".")
+		if len(segments) == 1 {
+			r.anyDepth[strings.ToLower(p)] = true
+		} else {
+			r.paths = append(r.paths, segments)
+		}
+	}
+	return r
+}
+
+// redactHeaders masks the configured headers and any API key in a JSON object
+// of headers. Headers that cannot be parsed are dropped rather than passed on
+// unredacted, so ok is false for them. Unchanged input is returned as is.
+func (r *redactor) redactHeaders(raw string) (redacted string, ok bool) {
+	var headers map[string]any
+	if err := json.Unmarshal([]byte(raw), &headers); err != nil {
+		return "", false
+	}
+	changed := false
+	for name, value := range headers {
+		if r.headers[strings.ToLower(name)] {
+			if value != redactedValue {
+				headers[name] = redactedValue
+				changed = true
+			}
+			continue
+		}
+		if v, c := r.redactAPIKeys(value); c {
+			headers[name] = v
+			changed = true
+		}
+	}
+	if !changed {
+		return raw, true
+	}
+	return marshalRedacted(headers)
+}
+
+// redactPayload masks the configured body paths and any API key in a payload.
+// Payloads that are not JSON only have API keys masked.
+func (r *redactor) redactPayload(raw string) string {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var body any
+	if err := decoder.Decode(&body); err != nil || decoder.More() {
+		return r.apiKeyPattern.ReplaceAllString(raw, redactedValue)
+	}
+	body, changed := r.redactNode(body, nil)
+	if !changed {
+		return raw
+	}
+	redacted, ok := marshalRedacted(body)
+	if !ok {
+		return ""
+	}
+	return redacted
+}
+
+// redactNode masks matching fields under node, whose location is path. Array
+// elements share the path of the array.
+func (r *redactor) redactNode(node any, path []string) (any, bool) {
+	switch v := node.(type) {
+	case map[string]any:
+		changed := false
+		for key, child := range v {
+			childPath := append(path[:len(path):len(path)], key)
+			if r.matches(childPath) {
+				if child != redactedValue {
+					v[key] = redactedValue
+					changed = true
+				}
+				continue
+			}
+			if redacted, c := r.redactNode(child, childPath); c {
+				v[key] = redacted
+				changed = true
+			}
+		}
+		return v, changed
+	case []any:
+		changed := false
+		for i, child := range v {
+			if redacted, c := r.redactNode(child, path); c {
+				v[i] = redacted
+				changed = true
+			}
+		}
+		return v, changed
+	}
+	return r.redactAPIKeys(node)
+}
+
+// matches reports whether the field at path is redacted. Field names compare
+// case-insensitively.
+func (r *redactor) matches(path []string) bool {
+	if r.anyDepth[strings.ToLower(path[len(path)-1])] {
+		return true
+	}
+	for _, p := range r.paths {
+		if len(p) != len(path) {
+			continue
+		}
+		match := true
+		for i := range p {
+			if !strings.EqualFold(p[i], path[i]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redactor) redactAPIKeys(value any) (any, bool) {
+	s, ok := value.(string)
+	if !ok || !r.mayContainAPIKey(s) {
+		return value, false
+	}
+	redacted := r.apiKeyPattern.ReplaceAllString(s, redactedValue)
+	return redacted, redacted != s
+}
+
+// mayContainAPIKey is a cheap check that skips the pattern for strings without any prefix.
+func (r *redactor) mayContainAPIKey(s string) bool {
+	for _, prefix := range r.apiKeyPrefixes {
+		if strings.Contains(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func marshalRedacted(v any) (string, bool) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", false
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package analytics
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+const testAPIKey = "apip_3f9a1c0b7d2e4f6a8b0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a"
+
+func decodeProperty(t *testing.T, event *dto.Event, key string) map[string]any {
+	t.Helper()
+	raw, ok := event.Properties[key].(string)
+	require.True(t, ok, "%s must be set", key)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw), &decoded))
+	return decoded
+}
+
+func TestPrepareAnalyticEvent_RedactsDefaultHeaders(t *testing.T) {
+	analytics := NewAnalytics(&config.Config{})
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		RequestHeadersKey: `{"Authorization":"Bearer eyJhbGciOi","Cookie":"session=abc","X-API-Key":"secret",` +
+			`"X-Custom-Key":"` + testAPIKey + `","Content-Type":"application/json"}`,
+		ResponseHeadersKey: `{"Set-Cookie":"session=def","X-Request-Id":"r-1"}`,
+	})
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	reqHeaders := decodeProperty(t, event, dto.PropKeyRequestHeaders)
+	assert.Equal(t, redactedValue, reqHeaders["Authorization"])
+	assert.Equal(t, redactedValue, reqHeaders["Cookie"])
+	assert.Equal(t, redactedValue, reqHeaders["X-API-Key"])
+	assert.Equal(t, redactedValue, reqHeaders["X-Custom-Key"], "apip_ API keys are masked under any header")
+	assert.Equal(t, "application/json", reqHeaders["Content-Type"])
+
+	respHeaders := decodeProperty(t, event, dto.PropKeyResponseHeaders)
+	assert.Equal(t, redactedValue, respHeaders["Set-Cookie"])
+	assert.Equal(t, "r-1", respHeaders["X-Request-Id"])
+}
+
+func TestPrepareAnalyticEvent_RedactsConfiguredHeaders(t *testing.T) {
+	analytics := NewAnalytics(&config.Config{
+		Analytics: config.AnalyticsConfig{
+			Redaction: config.AnalyticsRedactionConfig{Headers: []string{"X-Tenant-Secret"}},
+		},
+	})
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		RequestHeadersKey: `{"x-tenant-secret":"s3cr3t","authorization":"Basic dXNlcjpwYXNz"}`,
+	})
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	reqHeaders := decodeProperty(t, event, dto.PropKeyRequestHeaders)
+	assert.Equal(t, redactedValue, reqHeaders["x-tenant-secret"])
+	assert.Equal(t, redactedValue, reqHeaders["authorization"], "configured headers extend the defaults")
+}
+
+func TestPrepareAnalyticEvent_DropsUnparseableHeaders(t *testing.T) {
+	analytics := NewAnalytics(&config.Config{})
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		RequestHeadersKey: `Authorization: Bearer eyJhbGciOi`,
+	})
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	_, ok := event.Properties[dto.PropKeyRequestHeaders]
+	assert.False(t, ok, "headers that cannot be redacted must not be published")
+}
+
+func TestPrepareAnalyticEvent_RedactsPayloadFields(t *testing.T) {
+	analytics := NewAnalytics(&config.Config{
+		Collector: config.CollectorConfig{RequestBody: true, ResponseBody: true},
+		Analytics: config.AnalyticsConfig{
+			Redaction: config.AnalyticsRedactionConfig{BodyPaths: []string{"customer.ssn"}},
+		},
+	})
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		"request_payload": `{"username":"alice","password":"hunter2","customer":{"ssn":"123-45-6789","name":"Alice"},` +
+			`"ssn":"kept","items":[{"api_key":"k1","sku":"A-1"}],"note":"key ` + testAPIKey + ` used"}`,
+		"response_payload": `{"access_token":"tok","expires_in":3600}`,
+	})
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	req := decodeProperty(t, event, dto.PropKeyRequestPayload)
+	assert.Equal(t, "alice", req["username"])
+	assert.Equal(t, redactedValue, req["password"])
+	customer := req["customer"].(map[string]any)
+	assert.Equal(t, redactedValue, customer["ssn"])
+	assert.Equal(t, "Alice", customer["name"])
+	assert.Equal(t, "kept", req["ssn"], "dotted paths are anchored at the root")
+	item := req["items"].([]any)[0].(map[string]any)
+	assert.Equal(t, redactedValue, item["api_key"])
+	assert.Equal(t, "A-1", item["sku"])
+	assert.Equal(t, "key **** used", req["note"])
+
+	resp := decodeProperty(t, event, dto.PropKeyResponsePayload)
+	assert.Equal(t, redactedValue, resp["access_token"])
+	assert.Equal(t, float64(3600), resp["expires_in"])
+}
+
+func TestRedactPayload_NonJSONMasksAPIKeys(t *testing.T) {
+	r := newRedactor(config.AnalyticsRedactionConfig{})
+
+	assert.Equal(t, "api_key=**** &name=a", r.redactPayload("api_key="+testAPIKey+" &name=a"))
+	assert.Equal(t, "wso2_apip_sys_analytics", r.redactPayload("wso2_apip_sys_analytics"),
+		"only standalone apip_ tokens are API keys")
+}
+
+func TestRedactor_ConfiguredAPIKeyPrefix(t *testing.T) {
+	r := newRedactor(config.AnalyticsRedactionConfig{}, "acme-")
+	key := "acme-3f9a1c0b7d2e4f6a8b0c1d2e3f4a5b6c"
+
+	assert.Equal(t, "api_key=**** &name=a", r.redactPayload("api_key="+key+" &name=a"))
+	assert.JSONEq(t, `{"note":"key ****"}`, r.redactPayload(`{"note":"key `+key+`"}`))
+	assert.Equal(t, "api_key="+testAPIKey, r.redactPayload("api_key="+testAPIKey),
+		"only keys with the configured prefix are masked")
+
+	redacted, ok := r.redactHeaders(`{"X-Custom-Key":"` + key + `"}`)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"X-Custom-Key":"****"}`, redacted)
+}
+
+func TestPrepareAnalyticEvent_UsesConfiguredAPIKeyPrefix(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.PolicyEngine.APIKey.Prefix = "acme_"
+	analytics := NewAnalytics(cfg)
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		RequestHeadersKey: `{"X-Custom-Key":"acme_3f9a1c0b7d2e4f6a"}`,
+	})
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	reqHeaders := decodeProperty(t, event, dto.PropKeyRequestHeaders)
+	assert.Equal(t, redactedValue, reqHeaders["X-Custom-Key"])
+}
+
+func TestRedactPayload_UnchangedPayloadIsKeptVerbatim(t *testing.T) {
+	r := newRedactor(config.AnalyticsRedactionConfig{})
+
+	payload := `{ "key": "value", "n": 1.50 }`
+	assert.Equal(t, payload, r.redactPayload(payload))
+}
+
+func TestRedactPayload_PreservesNumbers(t *testing.T) {
+	r := newRedactor(config.AnalyticsRedactionConfig{})
+
+	assert.JSONEq(t, `{"password":"****","amount":12345678901234567890}`,
+		r.redactPayload(`{"password":"x","amount":12345678901234567890}`))
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package analytics
+
+import (
+	"hash/fnv"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	analytics_publisher "github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/publishers"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+)
+
+// sampler decides which analytics events are published.
+type sampler struct {
+	cfg config.AnalyticsSamplingConfig
+}
+
+// keep reports whether the event is published. Errors are always kept unless
+// the rule samples them, and events without a correlation ID are always kept
+// since they cannot be sampled consistently.
+func (s sampler) keep(event *dto.Event) bool {
+	rule := s.rule(event.API)
+	if rule.KeepOneIn <= 1 {
+		return true
+	}
+	if isErrorEvent(event) && !rule.SampleErrors {
+		return true
+	}
+	if event.MetaInfo == nil || event.MetaInfo.CorrelationID == "" {
+		return true
+	}
+	return sampleHash(event.MetaInfo.CorrelationID)%uint64(rule.KeepOneIn) == 0
+}
+
+// rule returns the API's sampling rule, matched by ID and then by name, or the
+// global rule.
+func (s sampler) rule(api *dto.ExtendedAPI) config.AnalyticsSamplingRule {
+	if api != nil && len(s.cfg.APIs) > 0 {
+		if rule, ok := s.cfg.APIs[api.APIID]; ok && api.APIID != "" {
+			return rule
+		}
+		if rule, ok := s.cfg.APIs[api.APIName]; ok && api.APIName != "" {
+			return rule
+		}
+	}
+	return s.cfg.AnalyticsSamplingRule
+}
+
+func isErrorEvent(event *dto.Event) bool {
+	return event.ProxyResponseCode == 0 || event.ProxyResponseCode >= 400
+}
+
+// sampleHash maps a correlation ID to a uniformly distributed value. FNV-1a is
+// stable across processes; the finalizer spreads IDs that differ only in their
+// last characters.
+func sampleHash(correlationID string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(correlationID))
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// sampledPublisher hands the events kept by its sampler to the analytics
+// publishers, so the decision is made once per event however many publishers
+// are enabled.
+type sampledPublisher struct {
+	sampler    sampler
+	publishers []analytics_publisher.Publisher
+}
+
+// Publish publishes the event when it is kept and counts the decision.
+func (p *sampledPublisher) Publish(event *dto.Event) {
+	apiName := ""
+	if event.API != nil {
+		apiName = event.API.APIName
+	}
+	if !p.sampler.keep(event) {
+		metrics.AnalyticsDroppedTotal.WithLabelValues(apiName).Inc()
+		return
+	}
+	metrics.AnalyticsSampledTotal.WithLabelValues(apiName).Inc()
+	for _, publisher := range p.publishers {
+		publisher.Publish(event)
+	}
+}
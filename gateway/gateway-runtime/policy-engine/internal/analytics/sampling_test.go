@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package analytics
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/dto"
+	analytics_publisher "github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/analytics/publishers"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+)
+
+func sampleEvent(apiID, correlationID string, status int) *dto.Event {
+	return &dto.Event{
+		API:               &dto.ExtendedAPI{API: dto.API{APIID: apiID, APIName: apiID + "-name"}},
+		MetaInfo:          &dto.MetaInfo{CorrelationID: correlationID},
+		ProxyResponseCode: status,
+	}
+}
+
+// correlationID returns the i-th of a fixed set of UUIDs, so the rate checks
+// below are deterministic.
+func correlationID(i int) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(strconv.Itoa(i))).String()
+}
+
+func samplingConfig(keepOneIn int) config.AnalyticsSamplingConfig {
+	return config.AnalyticsSamplingConfig{
+		AnalyticsSamplingRule: config.AnalyticsSamplingRule{KeepOneIn: keepOneIn},
+	}
+}
+
+func TestSampler_RateCorrectness(t *testing.T) {
+	for _, n := range []int{2, 10, 100} {
+		t.Run(fmt.Sprintf("keep one in %d", n), func(t *testing.T) {
+			s := sampler{cfg: samplingConfig(n)}
+			const total = 100000
+			kept := 0
+			for i := 0; i < total; i++ {
+				if s.keep(sampleEvent("api", correlationID(i), 200)) {
+					kept++
+				}
+			}
+			expected := float64(total) / float64(n)
+			assert.InDelta(t, expected, float64(kept), expected*0.1, "kept %d of %d", kept, total)
+		})
+	}
+}
+
+func TestSampler_SequentialCorrelationIDs(t *testing.T) {
+	s := sampler{cfg: samplingConfig(10)}
+	kept := 0
+	for i := 0; i < 10000; i++ {
+		if s.keep(sampleEvent("api", fmt.Sprintf("req-%d", i), 200)) {
+			kept++
+		}
+	}
+	assert.InDelta(t, 1000, kept, 100)
+}
+
+func TestSampler_DecisionIsStablePerRequest(t *testing.T) {
+	s := sampler{cfg: samplingConfig(4)}
+	for i := 0; i < 100; i++ {
+		id := correlationID(i)
+		first := s.keep(sampleEvent("api", id, 200))
+		for j := 0; j < 5; j++ {
+			assert.Equal(t, first, s.keep(sampleEvent("api", id, 200)))
+		}
+	}
+}
+
+func TestSampler_AlwaysKeepsErrors(t *testing.T) {
+	s := sampler{cfg: samplingConfig(1000)}
+	for _, status := range []int{0, 400, 401, 429, 500, 503} {
+		for i := 0; i < 100; i++ {
+			assert.True(t, s.keep(sampleEvent("api", correlationID(i), status)), "status %d must be kept", status)
+		}
+	}
+}
+
+func TestSampler_SampleErrors(t *testing.T) {
+	cfg := samplingConfig(10)
+	cfg.SampleErrors = true
+	s := sampler{cfg: cfg}
+	kept := 0
+	for i := 0; i < 10000; i++ {
+		if s.keep(sampleEvent("api", correlationID(i), 500)) {
+			kept++
+		}
+	}
+	assert.InDelta(t, 1000, kept, 150)
+}
+
+func TestSampler_KeepsAllByDefault(t *testing.T) {
+	for _, n := range []int{0, 1} {
+		s := sampler{cfg: samplingConfig(n)}
+		for i := 0; i < 100; i++ {
+			assert.True(t, s.keep(sampleEvent("api", correlationID(i), 200)))
+		}
+	}
+}
+
+func TestSampler_KeepsEventsWithoutCorrelationID(t *testing.T) {
+	s := sampler{cfg: samplingConfig(1000)}
+	assert.True(t, s.keep(sampleEvent("api", "", 200)))
+	assert.True(t, s.keep(&dto.Event{ProxyResponseCode: 200}))
+}
+
+func TestSampler_PerAPIRule(t *testing.T) {
+	cfg := samplingConfig(1000)
+	cfg.APIs = map[string]config.AnalyticsSamplingRule{
+		"orders":         {},
+		"inventory-name": {KeepOneIn: 1000, SampleErrors: true},
+	}
+	s := sampler{cfg: cfg}
+
+	keptOrders, keptInventoryErrors := 0, 0
+	for i := 0; i < 1000; i++ {
+		if s.keep(sampleEvent("orders", correlationID(i), 200)) {
+			keptOrders++
+		}
+		if s.keep(sampleEvent("inventory", correlationID(i), 500)) {
+			keptInventoryErrors++
+		}
+	}
+	assert.Equal(t, 1000, keptOrders, "an API rule replaces the global rule")
+	assert.Less(t, keptInventoryErrors, 20, "API rules are matched by name too")
+}
+
+func TestSampledPublisher(t *testing.T) {
+	metrics.SetEnabled(false)
+	metrics.Init()
+	first, second := &countingPublisher{}, &countingPublisher{}
+	p := &sampledPublisher{
+		sampler:    sampler{cfg: samplingConfig(10)},
+		publishers: []analytics_publisher.Publisher{first, second},
+	}
+	for i := 0; i < 1000; i++ {
+		p.Publish(sampleEvent("api", correlationID(i), 200))
+	}
+	p.Publish(sampleEvent("api", correlationID(-1), 502))
+
+	require.Greater(t, first.count, 1)
+	assert.Less(t, first.count, 200)
+	assert.Equal(t, first.count, second.count, "every publisher receives the same events")
+	assert.Equal(t, 502, first.last.ProxyResponseCode)
+}
+
+func TestNewAnalytics_TrafficLoggingIsNotSampled(t *testing.T) {
+	cfg := &config.Config{
+		TrafficLogging: config.TrafficLoggingConfig{Enabled: true},
+		Analytics: config.AnalyticsConfig{
+			Enabled:  true,
+			Sampling: samplingConfig(1000),
+		},
+	}
+
+	analytics := NewAnalytics(cfg)
+
+	require.Len(t, analytics.publishers, 1)
+	_, sampled := analytics.publishers[0].(*sampledPublisher)
+	assert.False(t, sampled)
+}
+
+type countingPublisher struct {
+	count int
+	last  *dto.Event
+}
+
+func (c *countingPublisher) Publish(event *dto.Event) {
+	c.count++
+	c.last = event
+}
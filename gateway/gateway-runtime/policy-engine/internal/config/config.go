@@ -23,7 +23,9 @@ import (
 	"log/slog"
 	"math"
 	"net/url"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-viper/mapstructure/v2"
@@ -31,6 +33,7 @@ import (
 	"github.com/knadh/koanf/providers/confmap"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
+	commonapikey "github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/common/collector"
 	"github.com/wso2/api-platform/common/configinterpolate"
 )
@@ -90,11 +93,76 @@ type AnalyticsConfig struct {
 	AllowPayloads    bool `koanf:"allow_payloads"`
 	SendRequestBody  bool `koanf:"send_request_body"`
 	SendResponseBody bool `koanf:"send_response_body"`
+	// AccessLogs writes the access log entries received over ALS to stdout or
+	// a file, independent of the enabled publishers.
+	AccessLogs AccessLogsSinkConfig `koanf:"access_logs"`
+	// Sampling thins the events handed to the enabled publishers. Traffic
+	// logging and the access log sink are not sampled.
+	Sampling AnalyticsSamplingConfig `koanf:"sampling"`
+	// Redaction masks sensitive headers and body fields in every collected
+	// event, on top of a built-in list that cannot be turned off.
+	Redaction AnalyticsRedactionConfig `koanf:"redaction"`
+}
+
+// AnalyticsRedactionConfig lists what is masked in collected events before they
+// reach any publisher. Both lists extend the built-in defaults. Values that look
+// like gateway API keys (prefixed "apip_") are always masked.
+type AnalyticsRedactionConfig struct {
+	// Headers are header names (case-insensitive) whose values are masked.
+	Headers []string `koanf:"headers"`
+	// BodyPaths are dotted JSON paths (e.g. "user.password") whose values are
+	// masked in JSON request and response payloads. A path without a dot
+	// matches that field at any depth; arrays are searched element by element.
+	BodyPaths []string `koanf:"body_paths"`
+}
+
+// AnalyticsSamplingConfig selects which analytics events are published. The
+// decision hashes the request's correlation ID, so it is the same for a request
+// wherever it is made. The zero value publishes every event.
+type AnalyticsSamplingConfig struct {
+	AnalyticsSamplingRule `koanf:",squash"`
+	// APIs overrides the rule per API, keyed by API ID or name. An API's rule
+	// replaces the global one entirely.
+	APIs map[string]AnalyticsSamplingRule `koanf:"apis"`
+}
+
+// AnalyticsSamplingRule is a sampling rule for analytics events.
+type AnalyticsSamplingRule struct {
+	// KeepOneIn publishes one in every KeepOneIn events (0 or 1 keeps all).
+	KeepOneIn int `koanf:"keep_one_in"`
+	// SampleErrors applies KeepOneIn to error responses (status >= 400) too.
+	// By default every error is published.
+	SampleErrors bool `koanf:"sample_errors"`
+}
+
+// Access log sink destinations accepted in analytics.access_logs.sink.
+const (
+	AccessLogsSinkNone   = ""
+	AccessLogsSinkStdout = "stdout"
+	AccessLogsSinkFile   = "file"
+)
+
+// AccessLogsSinkConfig configures a sink that writes every access log entry the
+// ALS server receives as a JSON line. It only sees entries while the collector
+// is running, i.e. when analytics or traffic logging is enabled; set
+// analytics.enabled_publishers to an empty list to get access logs without a
+// publisher.
+type AccessLogsSinkConfig struct {
+	// Sink selects the destination: "stdout", "file", or empty to disable.
+	Sink string `koanf:"sink"`
+	// FilePath is the absolute path of the log file when Sink is "file".
+	FilePath string `koanf:"file_path"`
+	// MaxSizeMB is the size in megabytes at which the file is rotated.
+	MaxSizeMB int `koanf:"max_size_mb"`
+	// MaxBackups is the number of rotated files kept (0 keeps all).
+	MaxBackups int `koanf:"max_backups"`
 }
 
 // AnalyticsPublishersConfig holds configuration for all analytics publishers
 type AnalyticsPublishersConfig struct {
-	Moesif MoesifPublisherConfig `koanf:"moesif"`
+	Moesif  MoesifPublisherConfig  `koanf:"moesif"`
+	OTLP    OTLPPublisherConfig    `koanf:"otlp"`
+	Webhook WebhookPublisherConfig `koanf:"webhook"`
 }
 
 // TrafficLoggingConfig holds configuration for the stdout traffic-logging feature,
@@ -140,12 +208,59 @@ type TrafficLoggingConfig struct {
 
 // MoesifPublisherConfig holds Moesif-specific configuration
 type MoesifPublisherConfig struct {
-	ApplicationID      string `koanf:"application_id"`
-	BaseURL            string `koanf:"moesif_base_url"`
-	PublishInterval    int    `koanf:"publish_interval"`
-	EventQueueSize     int    `koanf:"event_queue_size"`
-	BatchSize          int    `koanf:"batch_size"`
-	TimerWakeupSeconds int    `koanf:"timer_wakeup_seconds"`
+	ApplicationID string `koanf:"application_id"`
+	BaseURL       string `koanf:"moesif_base_url"`
+	// PublishInterval is the maximum time in seconds an event is buffered
+	// before it is sent.
+	PublishInterval int `koanf:"publish_interval"`
+	// EventQueueSize bounds the buffered events; while the buffer is full new
+	// events are dropped and counted in analytics_events_dropped_total.
+	EventQueueSize int `koanf:"event_queue_size"`
+	// BatchSize is the number of events sent in one gzip-compressed batch; a
+	// batch is sent as soon as this many events are buffered.
+	BatchSize int `koanf:"batch_size"`
+	// TimerWakeupSeconds is deprecated and ignored; batches are sent on
+	// BatchSize or PublishInterval.
+	TimerWakeupSeconds int `koanf:"timer_wakeup_seconds"`
+}
+
+// OTLPPublisherConfig holds configuration for the OTLP publisher, which exports
+// each event as an OpenTelemetry log record over OTLP/HTTP (protobuf).
+type OTLPPublisherConfig struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver (e.g.
+	// http://otel-collector:4318); records are posted to <endpoint>/v1/logs.
+	Endpoint string `koanf:"endpoint"`
+	// Headers are added to every export request (e.g. an authorization header).
+	Headers map[string]string `koanf:"headers"`
+	// ServiceName is reported as the service.name resource attribute.
+	ServiceName string `koanf:"service_name"`
+	// PublishInterval is the maximum time in seconds an event waits in the queue.
+	PublishInterval int `koanf:"publish_interval"`
+	// EventQueueSize bounds the events waiting to be exported; further events
+	// are dropped until the queue drains.
+	EventQueueSize int `koanf:"event_queue_size"`
+	// BatchSize is the number of events sent in one export request.
+	BatchSize int `koanf:"batch_size"`
+	// Timeout is the export request timeout in seconds.
+	Timeout int `koanf:"timeout"`
+}
+
+// WebhookPublisherConfig holds configuration for the webhook publisher, which
+// POSTs batches of events to an HTTP endpoint as a JSON array.
+type WebhookPublisherConfig struct {
+	// URL is the endpoint the events are posted to.
+	URL string `koanf:"url"`
+	// Headers are added to every request (e.g. an authorization header).
+	Headers map[string]string `koanf:"headers"`
+	// PublishInterval is the maximum time in seconds an event waits in the queue.
+	PublishInterval int `koanf:"publish_interval"`
+	// EventQueueSize bounds the events waiting to be posted; further events are
+	// dropped until the queue drains.
+	EventQueueSize int `koanf:"event_queue_size"`
+	// BatchSize is the number of events sent in one request.
+	BatchSize int `koanf:"batch_size"`
+	// Timeout is the request timeout in seconds.
+	Timeout int `koanf:"timeout"`
 }
 
 // Config represents the complete policy engine configuration
@@ -158,6 +273,9 @@ type PolicyEngine struct {
 	FileConfig     FileConfigConfig     `koanf:"file_config"`
 	Logging        LoggingConfig        `koanf:"logging"`
 	PythonExecutor PythonExecutorConfig `koanf:"python_executor"`
+	Executor       ExecutorConfig       `koanf:"executor"`
+	Secrets        SecretsConfig        `koanf:"secrets"`
+	APIKey         APIKeyConfig         `koanf:"api_key"`
 	// Tracing holds OpenTelemetry exporter configuration
 	TracingServiceName string `koanf:"tracing_service_name"`
 
@@ -174,19 +292,168 @@ type MetricsConfig struct {
 
 	// Port is the port for the metrics HTTP server
 	Port int `koanf:"port"`
+
+	// PolicyRouteLabel fills the route label of the per-policy execution
+	// metrics. Off by default, since one series per route and policy can
+	// grow large; the label is then empty.
+	PolicyRouteLabel bool `koanf:"policy_route_label"`
+}
+
+// Supported executor.on_timeout and executor.on_panic values: fail the
+// request closed, or skip the failed policy and run the rest of the chain.
+const (
+	FailureActionFail = "fail"
+	FailureActionSkip = "skip"
+)
+
+// ExecutorConfig bounds the execution of policy chains
+type ExecutorConfig struct {
+	// ChainTimeout is the deadline of each phase of a policy chain (request
+	// headers, request body, ...). Zero leaves chains unbounded.
+	ChainTimeout time.Duration `koanf:"chain_timeout"`
+
+	// PolicyTimeout is the deadline of a single policy. Zero leaves policies
+	// bounded only by the chain timeout.
+	PolicyTimeout time.Duration `koanf:"policy_timeout"`
+
+	// OnTimeout is what happens to a request when a policy times out: "fail"
+	// (default) fails it closed, "skip" skips the policy and runs the rest of
+	// the chain.
+	OnTimeout string `koanf:"on_timeout"`
+
+	// OnPanic is what happens to a request when a policy panics: "fail"
+	// (default) fails it closed, "skip" skips the policy and runs the rest of
+	// the chain.
+	OnPanic string `koanf:"on_panic"`
+}
+
+// Supported secrets.provider values.
+const (
+	SecretsProviderEnv   = "env"
+	SecretsProviderFile  = "file"
+	SecretsProviderVault = "vault"
+	SecretsProviderAWS   = "aws"
+)
+
+// APIKeyConfig holds the settings used for API keys
+type APIKeyConfig struct {
+	// Peppers are the server-side secrets the gateway controller keys stored API key
+	// hashes with (api_key.peppers of the controller). Every pepper still named by a
+	// stored hash must be listed, or the keys hashed with it do not validate.
+	Peppers []APIKeyPepperConfig `koanf:"peppers"`
+
+	// UsageReportInterval is how often the keys validated by the engine are reported to
+	// the gateway controller, which records them as the keys' last-used time. Zero
+	// disables reporting. Only used in xDS config mode.
+	UsageReportInterval time.Duration `koanf:"usage_report_interval"`
+
+	// SigningSecretFile is the secret the gateway controller signs API keys with in signed
+	// mode (api_key.signing_secret_file of the controller). When set, signed keys the engine
+	// has not received yet are verified from their signature and the revocation list.
+	SigningSecretFile string `koanf:"signing_secret_file"`
+
+	// Prefix is the prefix of the API keys the controller issues (api_key.prefix of the
+	// controller). It identifies signed keys and the keys masked in analytics events.
+	Prefix string `koanf:"prefix"`
+
+	// peppers holds the secrets read from the pepper files during validation, keyed by ID
+	peppers map[string][]byte
+	// signingSecret holds the secret read from SigningSecretFile during validation
+	signingSecret []byte
+}
+
+// APIKeyPepperConfig identifies a pepper and the file holding its secret
+type APIKeyPepperConfig struct {
+	ID   string `koanf:"id"`
+	File string `koanf:"file"` // must be readable by the owner only
+}
+
+// PepperSecrets returns the pepper secrets loaded during validation, keyed by ID
+func (c *APIKeyConfig) PepperSecrets() map[string][]byte {
+	return c.peppers
+}
+
+// SigningSecret returns the API key signing secret loaded during validation (nil if unset)
+func (c *APIKeyConfig) SigningSecret() []byte {
+	return c.signingSecret
+}
+
+// SecretsConfig selects where secret://name references in the config are
+// resolved from. They are resolved at startup, and again every RefreshInterval
+// so rotated secrets are picked up by the policies that reference them.
+type SecretsConfig struct {
+	// Provider is "env" (default, also when empty), "file", "vault" or "aws".
+	Provider string `koanf:"provider"`
+
+	// RefreshInterval is how often secrets are resolved again. Zero resolves
+	// them only at startup.
+	RefreshInterval time.Duration `koanf:"refresh_interval"`
+
+	Env   SecretsEnvConfig   `koanf:"env"`
+	File  SecretsFileConfig  `koanf:"file"`
+	Vault SecretsVaultConfig `koanf:"vault"`
+	AWS   SecretsAWSConfig   `koanf:"aws"`
+}
+
+// SecretsEnvConfig reads secrets from environment variables
+type SecretsEnvConfig struct {
+	// Prefix is prepended to the upper-cased secret name to form the variable name.
+	Prefix string `koanf:"prefix"`
+}
+
+// SecretsFileConfig reads secrets from files, one per secret
+type SecretsFileConfig struct {
+	// Dir is the directory holding the secret files, e.g. a mounted Kubernetes secret.
+	Dir string `koanf:"dir"`
+}
+
+// SecretsVaultConfig reads secrets from a HashiCorp Vault KV v2 secrets engine
+type SecretsVaultConfig struct {
+	// Address is the https URL of the Vault server.
+	Address string `koanf:"address"`
+	// Mount is the path of the KV v2 secrets engine.
+	Mount string `koanf:"mount"`
+	// Token authenticates to Vault; the VAULT_TOKEN environment variable when empty.
+	Token string `koanf:"token"`
+}
+
+// SecretsAWSConfig reads secrets from AWS Secrets Manager, authenticating with
+// the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables
+type SecretsAWSConfig struct {
+	// Region is the AWS region of the secrets.
+	Region string `koanf:"region"`
+	// Endpoint overrides the regional https endpoint of Secrets Manager.
+	Endpoint string `koanf:"endpoint"`
 }
 
+// Supported tracing.protocol values.
+const (
+	TracingProtocolGRPC = "otlp-grpc"
+	TracingProtocolHTTP = "otlp-http"
+)
+
 // TracingConfig holds OpenTelemetry tracing configuration
 type TracingConfig struct {
 	// Enabled toggles tracing on/off
 	Enabled bool `koanf:"enabled"`
 
-	// Endpoint is the OTLP gRPC endpoint (host:port)
+	// Protocol is the OTLP transport used to export spans: "otlp-grpc"
+	// (default) or "otlp-http" (protobuf over HTTP).
+	Protocol string `koanf:"protocol"`
+
+	// Endpoint is the OTLP endpoint, either host:port or a URL. For otlp-http a
+	// host:port endpoint is exported to the default /v1/traces path.
 	Endpoint string `koanf:"endpoint"`
 
+	// Headers are sent with every export request (e.g. an authorization header)
+	Headers map[string]string `koanf:"headers"`
+
 	// Insecure indicates whether to use an insecure connection (no TLS)
 	Insecure bool `koanf:"insecure"`
 
+	// TLS configures the exporter's TLS connection when Insecure is false
+	TLS TracingTLSConfig `koanf:"tls"`
+
 	// ServiceVersion is the service version reported to the tracing backend
 	ServiceVersion string `koanf:"service_version"`
 
@@ -202,6 +469,19 @@ type TracingConfig struct {
 	SamplingRate float64 `koanf:"sampling_rate"`
 }
 
+// TracingTLSConfig holds the TLS settings of the tracing exporter. When no CA
+// file is set the system roots are used; CertFile and KeyFile together enable
+// mutual TLS.
+type TracingTLSConfig struct {
+	// CAFile is a PEM bundle of CAs trusted to sign the collector's certificate
+	CAFile string `koanf:"ca_file"`
+	// CertFile and KeyFile are the PEM client certificate and private key
+	CertFile string `koanf:"cert_file"`
+	KeyFile  string `koanf:"key_file"`
+	// ServerName overrides the name verified against the collector's certificate
+	ServerName string `koanf:"server_name"`
+}
+
 // ServerConfig holds ext_proc server configuration
 type ServerConfig struct {
 	// Mode is the connection mode: "uds" (default) or "tcp"
@@ -248,6 +528,11 @@ type AdminConfig struct {
 
 	// Pprof gates the Go runtime profiling endpoints served on this admin server.
 	Pprof PprofConfig `koanf:"pprof"`
+
+	// PolicyOverridesFile is where policies disabled through the admin API are
+	// persisted, so they stay disabled across restarts. When empty, overrides
+	// are kept in memory only.
+	PolicyOverridesFile string `koanf:"policy_overrides_file"`
 }
 
 // PprofConfig gates the Go runtime profiling endpoints (net/http/pprof) served on
@@ -364,6 +649,13 @@ func Load(configPath string) (*Config, error) {
 		return nil, err
 	}
 
+	// Move deprecated flat shared-policy tables under [policy_configurations], so
+	// the struct and the ${config} view below only ever see the nested form.
+	k, err = nestLegacyPolicyConfigurations(k)
+	if err != nil {
+		return nil, err
+	}
+
 	// Unmarshal into pre-populated config struct with defaults
 	// Koanf will merge: fields from file/env overwrite defaults, unset fields keep defaults
 	if err := k.UnmarshalWithConf("", cfg, koanf.UnmarshalConf{
@@ -418,6 +710,57 @@ func interpolate(k *koanf.Koanf) (*koanf.Koanf, error) {
 	return out, nil
 }
 
+// policyConfigurationsKey is the table grouping settings shared by policies.
+const policyConfigurationsKey = "policy_configurations"
+
+// legacyPolicyConfigurationKeys are the top-level tables that held shared policy
+// settings before they were grouped under [policy_configurations]: embedding
+// provider, vector database and guardrail settings. They are still accepted, for
+// one release, and mapped to [policy_configurations.<key>].
+var legacyPolicyConfigurationKeys = []string{"embedding", "vector_db", "guardrails"}
+
+// nestLegacyPolicyConfigurations returns a koanf instance in which each deprecated
+// flat table is moved to its nested place under [policy_configurations], logging
+// a deprecation warning for each. A table set in both forms is an error rather
+// than a silent merge.
+func nestLegacyPolicyConfigurations(k *koanf.Koanf) (*koanf.Koanf, error) {
+	raw := k.Raw()
+	var moved []string
+	for _, key := range legacyPolicyConfigurationKeys {
+		if _, ok := raw[key]; ok {
+			moved = append(moved, key)
+		}
+	}
+	if len(moved) == 0 {
+		return k, nil
+	}
+
+	nested, _ := raw[policyConfigurationsKey].(map[string]interface{})
+	if nested == nil {
+		if _, ok := raw[policyConfigurationsKey]; ok {
+			return nil, fmt.Errorf("%s must be a table", policyConfigurationsKey)
+		}
+		nested = make(map[string]interface{})
+	}
+	for _, key := range moved {
+		if _, ok := nested[key]; ok {
+			return nil, fmt.Errorf("[%s] and [%s.%s] are both set; remove the deprecated [%s]",
+				key, policyConfigurationsKey, key, key)
+		}
+		slog.Warn(fmt.Sprintf("[%s] is deprecated and will be removed in the next release; move it to [%s.%s]",
+			key, policyConfigurationsKey, key))
+		nested[key] = raw[key]
+		delete(raw, key)
+	}
+	raw[policyConfigurationsKey] = nested
+
+	out := koanf.New(".")
+	if err := out.Load(confmap.Provider(raw, "."), nil); err != nil {
+		return nil, fmt.Errorf("failed to reload nested policy configurations: %w", err)
+	}
+	return out, nil
+}
+
 // defaultAccessLogsServiceConfig returns the default policy-engine ALS receiver tuning.
 // Shared by the collector (canonical) and the deprecated [analytics].access_logs_service
 // alias so a partial alias override migrates cleanly.
@@ -470,6 +813,10 @@ func defaultConfig() *Config {
 			FileConfig: FileConfigConfig{
 				Path: "",
 			},
+			APIKey: APIKeyConfig{
+				UsageReportInterval: 30 * time.Second,
+				Prefix:              "apip_",
+			},
 			Logging: LoggingConfig{
 				Level:  "info",
 				Format: "text",
@@ -482,6 +829,20 @@ func defaultConfig() *Config {
 				},
 				Timeout: 30 * time.Second,
 			},
+			Executor: ExecutorConfig{
+				OnTimeout: FailureActionFail,
+				OnPanic:   FailureActionFail,
+			},
+			Secrets: SecretsConfig{
+				Provider:        SecretsProviderEnv,
+				RefreshInterval: 5 * time.Minute,
+				Env: SecretsEnvConfig{
+					Prefix: "APIP_SECRET_",
+				},
+				Vault: SecretsVaultConfig{
+					Mount: "secret",
+				},
+			},
 			TracingServiceName: "policy-engine",
 		},
 		Collector: CollectorConfig{
@@ -512,6 +873,21 @@ func defaultConfig() *Config {
 					BatchSize:          50,
 					TimerWakeupSeconds: 3,
 				},
+				OTLP: OTLPPublisherConfig{
+					Headers:         map[string]string{},
+					ServiceName:     "policy-engine",
+					PublishInterval: 5,
+					EventQueueSize:  10000,
+					BatchSize:       50,
+					Timeout:         10,
+				},
+				Webhook: WebhookPublisherConfig{
+					Headers:         map[string]string{},
+					PublishInterval: 5,
+					EventQueueSize:  10000,
+					BatchSize:       50,
+					Timeout:         10,
+				},
 			},
 			GRPCEventServerCfg: map[string]interface{}{
 				"server_port":           18090,
@@ -525,10 +901,16 @@ func defaultConfig() *Config {
 			AllowPayloads:        false,
 			SendRequestBody:      false,
 			SendResponseBody:     false,
+			AccessLogs: AccessLogsSinkConfig{
+				MaxSizeMB:  100,
+				MaxBackups: 5,
+			},
 		},
 		TracingConfig: TracingConfig{
 			Enabled:            false,
+			Protocol:           TracingProtocolGRPC,
 			Endpoint:           "otel-collector:4317",
+			Headers:            map[string]string{},
 			Insecure:           true,
 			ServiceVersion:     "1.0.0",
 			BatchTimeout:       1 * time.Second,
@@ -568,6 +950,36 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("policy_engine.python_executor.timeout must be positive")
 	}
 
+	// Validate API key config
+	if err := c.validateAPIKeyConfig(); err != nil {
+		return err
+	}
+
+	// Validate executor config
+	if c.PolicyEngine.Executor.ChainTimeout < 0 {
+		return fmt.Errorf("policy_engine.executor.chain_timeout cannot be negative")
+	}
+	if c.PolicyEngine.Executor.PolicyTimeout < 0 {
+		return fmt.Errorf("policy_engine.executor.policy_timeout cannot be negative")
+	}
+	switch c.PolicyEngine.Executor.OnTimeout {
+	case "", FailureActionFail, FailureActionSkip:
+	default:
+		return fmt.Errorf("policy_engine.executor.on_timeout must be '%s' or '%s', got: %s",
+			FailureActionFail, FailureActionSkip, c.PolicyEngine.Executor.OnTimeout)
+	}
+	switch c.PolicyEngine.Executor.OnPanic {
+	case "", FailureActionFail, FailureActionSkip:
+	default:
+		return fmt.Errorf("policy_engine.executor.on_panic must be '%s' or '%s', got: %s",
+			FailureActionFail, FailureActionSkip, c.PolicyEngine.Executor.OnPanic)
+	}
+
+	// Validate secrets config
+	if err := c.validateSecretsConfig(); err != nil {
+		return err
+	}
+
 	// Validate admin config
 	if c.PolicyEngine.Admin.Enabled {
 		if c.PolicyEngine.Admin.Port <= 0 || c.PolicyEngine.Admin.Port > 65535 {
@@ -632,6 +1044,17 @@ func (c *Config) Validate() error {
 	if err := c.validateTrafficLoggingConfig(); err != nil {
 		return err
 	}
+	if err := c.validateAccessLogsSinkConfig(); err != nil {
+		return err
+	}
+	if err := validateAnalyticsSamplingConfig(c.Analytics.Sampling); err != nil {
+		return err
+	}
+	for _, path := range c.Analytics.Redaction.BodyPaths {
+		if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+			return fmt.Errorf("invalid analytics.redaction.body_paths entry %q", path)
+		}
+	}
 	if c.Analytics.Enabled {
 		if err := c.validateAnalyticsConfig(); err != nil {
 			return fmt.Errorf("analytics configuration validation failed: %v", err)
@@ -641,6 +1064,9 @@ func (c *Config) Validate() error {
 		if c.TracingConfig.Endpoint == "" {
 			return fmt.Errorf("tracing.endpoint is required when tracing is enabled")
 		}
+		if err := c.validateTracingExporterConfig(); err != nil {
+			return err
+		}
 		if c.TracingConfig.BatchTimeout <= 0 {
 			return fmt.Errorf("tracing.batch_timeout must be positive")
 		}
@@ -655,6 +1081,101 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateTracingExporterConfig validates the OTLP protocol and TLS settings
+// of the tracing exporter.
+func (c *Config) validateTracingExporterConfig() error {
+	tc := c.TracingConfig
+	switch tc.Protocol {
+	case "", TracingProtocolGRPC, TracingProtocolHTTP:
+	default:
+		return fmt.Errorf("tracing.protocol must be %q or %q, got %q", TracingProtocolGRPC, TracingProtocolHTTP, tc.Protocol)
+	}
+	if strings.Contains(tc.Endpoint, "://") {
+		if !isHTTPURL(tc.Endpoint) {
+			return fmt.Errorf("tracing.endpoint must be host:port or an http or https URL, got %q", tc.Endpoint)
+		}
+		// The exporter derives TLS from a URL's scheme; it must agree with insecure.
+		if u, _ := url.Parse(tc.Endpoint); (u.Scheme == "http") != tc.Insecure {
+			return fmt.Errorf("tracing.endpoint scheme %q does not match tracing.insecure = %t", u.Scheme, tc.Insecure)
+		}
+	}
+	if (tc.TLS.CertFile == "") != (tc.TLS.KeyFile == "") {
+		return fmt.Errorf("tracing.tls.cert_file and tracing.tls.key_file must be set together")
+	}
+	if tc.Insecure && (tc.TLS.CAFile != "" || tc.TLS.CertFile != "") {
+		return fmt.Errorf("tracing.tls is set but tracing.insecure is true; set insecure = false to use TLS")
+	}
+	return nil
+}
+
+// validateSecretsConfig validates the secrets provider configuration
+func (c *Config) validateSecretsConfig() error {
+	secrets := c.PolicyEngine.Secrets
+	if secrets.RefreshInterval < 0 {
+		return fmt.Errorf("policy_engine.secrets.refresh_interval cannot be negative")
+	}
+	switch secrets.Provider {
+	case "", SecretsProviderEnv:
+	case SecretsProviderFile:
+		if secrets.File.Dir == "" {
+			return fmt.Errorf("policy_engine.secrets.file.dir is required when the secrets provider is 'file'")
+		}
+	case SecretsProviderVault:
+		if !strings.HasPrefix(secrets.Vault.Address, "https://") {
+			return fmt.Errorf("policy_engine.secrets.vault.address must be an https URL when the secrets provider is 'vault'")
+		}
+	case SecretsProviderAWS:
+		if secrets.AWS.Region == "" {
+			return fmt.Errorf("policy_engine.secrets.aws.region is required when the secrets provider is 'aws'")
+		}
+	default:
+		return fmt.Errorf("policy_engine.secrets.provider must be one of: %s, %s, %s, %s, got: %s",
+			SecretsProviderEnv, SecretsProviderFile, SecretsProviderVault, SecretsProviderAWS, secrets.Provider)
+	}
+	return nil
+}
+
+// validateAPIKeyConfig validates the API key settings and loads the pepper and signing secret files
+func (c *Config) validateAPIKeyConfig() error {
+	apiKey := &c.PolicyEngine.APIKey
+	apiKey.peppers = nil
+	apiKey.signingSecret = nil
+	if apiKey.UsageReportInterval < 0 {
+		return fmt.Errorf("policy_engine.api_key.usage_report_interval must not be negative, got: %s", apiKey.UsageReportInterval)
+	}
+	peppers := make(map[string][]byte, len(apiKey.Peppers))
+	for i, p := range apiKey.Peppers {
+		if !commonapikey.ValidPepperID(p.ID) {
+			return fmt.Errorf("policy_engine.api_key.peppers[%d].id must be 1-%d characters of letters, digits, '_' or '-', got: %q",
+				i, commonapikey.MaxPepperIDLength, p.ID)
+		}
+		if _, exists := peppers[p.ID]; exists {
+			return fmt.Errorf("policy_engine.api_key.peppers[%d].id %q is duplicated", i, p.ID)
+		}
+		if strings.TrimSpace(p.File) == "" {
+			return fmt.Errorf("policy_engine.api_key.peppers[%d].file is required", i)
+		}
+		secret, err := commonapikey.ReadSecretFile(p.File, "pepper")
+		if err != nil {
+			return fmt.Errorf("policy_engine.api_key.peppers[%d].file: %w", i, err)
+		}
+		peppers[p.ID] = secret
+	}
+	apiKey.peppers = peppers
+
+	if strings.TrimSpace(apiKey.SigningSecretFile) != "" {
+		if apiKey.Prefix == "" {
+			return fmt.Errorf("policy_engine.api_key.prefix is required when policy_engine.api_key.signing_secret_file is set")
+		}
+		secret, err := commonapikey.ReadSecretFile(apiKey.SigningSecretFile, "signing secret")
+		if err != nil {
+			return fmt.Errorf("policy_engine.api_key.signing_secret_file: %w", err)
+		}
+		apiKey.signingSecret = secret
+	}
+	return nil
+}
+
 // validateXDSConfig validates xDS configuration
 func (c *Config) validateXDSConfig() error {
 	if c.PolicyEngine.XDS.ConnectTimeout <= 0 {
@@ -799,6 +1320,28 @@ func (c *Config) validateAnalyticsConfig() error {
 						return fmt.Errorf("analytics.publishers.moesif.moesif_base_url must be a valid URL (e.g. https://api.moesif.net), got %q", moesifCfg.BaseURL)
 					}
 				}
+			case "otlp":
+				otlpCfg := c.Analytics.Publishers.OTLP
+				if otlpCfg.Endpoint == "" {
+					return fmt.Errorf("analytics.publishers.otlp.endpoint is required when otlp is enabled")
+				}
+				if !isHTTPURL(otlpCfg.Endpoint) {
+					return fmt.Errorf("analytics.publishers.otlp.endpoint must be an http or https URL (e.g. http://otel-collector:4318), got %q", otlpCfg.Endpoint)
+				}
+				if err := validateBatchSettings("otlp", otlpCfg.PublishInterval, otlpCfg.EventQueueSize, otlpCfg.BatchSize, otlpCfg.Timeout); err != nil {
+					return err
+				}
+			case "webhook":
+				webhookCfg := c.Analytics.Publishers.Webhook
+				if webhookCfg.URL == "" {
+					return fmt.Errorf("analytics.publishers.webhook.url is required when webhook is enabled")
+				}
+				if !isHTTPURL(webhookCfg.URL) {
+					return fmt.Errorf("analytics.publishers.webhook.url must be an http or https URL, got %q", webhookCfg.URL)
+				}
+				if err := validateBatchSettings("webhook", webhookCfg.PublishInterval, webhookCfg.EventQueueSize, webhookCfg.BatchSize, webhookCfg.Timeout); err != nil {
+					return err
+				}
 			default:
 				return fmt.Errorf("unknown publisher type in enabled_publishers: %s", publisherName)
 			}
@@ -807,6 +1350,73 @@ func (c *Config) validateAnalyticsConfig() error {
 	return nil
 }
 
+// isHTTPURL reports whether raw is an absolute http or https URL.
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validateBatchSettings validates the queueing settings shared by the batching
+// analytics publishers.
+func validateBatchSettings(publisher string, publishInterval, eventQueueSize, batchSize, timeout int) error {
+	if publishInterval <= 0 {
+		return fmt.Errorf("analytics.publishers.%s.publish_interval must be > 0 seconds, got %d", publisher, publishInterval)
+	}
+	if eventQueueSize <= 0 {
+		return fmt.Errorf("analytics.publishers.%s.event_queue_size must be > 0, got %d", publisher, eventQueueSize)
+	}
+	if batchSize <= 0 {
+		return fmt.Errorf("analytics.publishers.%s.batch_size must be > 0, got %d", publisher, batchSize)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("analytics.publishers.%s.timeout must be > 0 seconds, got %d", publisher, timeout)
+	}
+	return nil
+}
+
+// validateAccessLogsSinkConfig validates analytics.access_logs and warns when
+// the sink cannot receive entries because the collector is off.
+func (c *Config) validateAccessLogsSinkConfig() error {
+	al := c.Analytics.AccessLogs
+	switch al.Sink {
+	case AccessLogsSinkNone:
+		return nil
+	case AccessLogsSinkStdout:
+	case AccessLogsSinkFile:
+		if al.FilePath == "" {
+			return fmt.Errorf("analytics.access_logs.file_path is required when analytics.access_logs.sink is 'file'")
+		}
+		if !filepath.IsAbs(al.FilePath) || filepath.Clean(al.FilePath) != al.FilePath {
+			return fmt.Errorf("analytics.access_logs.file_path must be a clean absolute path, got %q", al.FilePath)
+		}
+		if al.MaxSizeMB <= 0 {
+			return fmt.Errorf("analytics.access_logs.max_size_mb must be > 0, got %d", al.MaxSizeMB)
+		}
+		if al.MaxBackups < 0 {
+			return fmt.Errorf("analytics.access_logs.max_backups must be >= 0, got %d", al.MaxBackups)
+		}
+	default:
+		return fmt.Errorf("invalid analytics.access_logs.sink: %s (must be stdout or file)", al.Sink)
+	}
+	if !c.IsCollectorEnabled() {
+		slog.Warn("analytics.access_logs.sink is set but neither analytics nor traffic_logging is enabled; no access logs will be received")
+	}
+	return nil
+}
+
+// validateAnalyticsSamplingConfig validates analytics.sampling.
+func validateAnalyticsSamplingConfig(cfg AnalyticsSamplingConfig) error {
+	if cfg.KeepOneIn < 0 {
+		return fmt.Errorf("analytics.sampling.keep_one_in must be >= 0, got %d", cfg.KeepOneIn)
+	}
+	for api, rule := range cfg.APIs {
+		if rule.KeepOneIn < 0 {
+			return fmt.Errorf("analytics.sampling.apis.%s.keep_one_in must be >= 0, got %d", api, rule.KeepOneIn)
+		}
+	}
+	return nil
+}
+
 // validateTrafficLoggingConfig validates the traffic-logging config and warns
 // about settings that have no effect.
 func (c *Config) validateTrafficLoggingConfig() error {
@@ -219,7 +219,237 @@ func TestValidate_ServerMode(t *testing.T) {
 	}
 }
 
+func TestValidate_APIKeyPeppers(t *testing.T) {
+	dir := t.TempDir()
+	pepperFile := filepath.Join(dir, "pepper")
+	require.NoError(t, os.WriteFile(pepperFile, []byte("pepper-one-0123456789abcdef012345\n"), 0o600))
+	openPepperFile := filepath.Join(dir, "open-pepper")
+	require.NoError(t, os.WriteFile(openPepperFile, []byte("pepper-one-0123456789abcdef012345"), 0o600))
+	require.NoError(t, os.Chmod(openPepperFile, 0o644))
+
+	tests := []struct {
+		name      string
+		peppers   []APIKeyPepperConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{name: "no peppers"},
+		{name: "pepper", peppers: []APIKeyPepperConfig{{ID: "2026-01", File: pepperFile}}},
+		{
+			name:      "invalid ID",
+			peppers:   []APIKeyPepperConfig{{ID: "bad id", File: pepperFile}},
+			expectErr: true,
+			errMsg:    "policy_engine.api_key.peppers[0].id must be",
+		},
+		{
+			name:      "duplicated ID",
+			peppers:   []APIKeyPepperConfig{{ID: "p1", File: pepperFile}, {ID: "p1", File: pepperFile}},
+			expectErr: true,
+			errMsg:    "policy_engine.api_key.peppers[1].id \"p1\" is duplicated",
+		},
+		{
+			name:      "missing file",
+			peppers:   []APIKeyPepperConfig{{ID: "p1"}},
+			expectErr: true,
+			errMsg:    "policy_engine.api_key.peppers[0].file is required",
+		},
+		{
+			name:      "file readable by others",
+			peppers:   []APIKeyPepperConfig{{ID: "p1", File: openPepperFile}},
+			expectErr: true,
+			errMsg:    "too permissive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.APIKey.Peppers = tt.peppers
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, cfg.PolicyEngine.APIKey.PepperSecrets(), len(tt.peppers))
+			for _, p := range tt.peppers {
+				assert.Equal(t, "pepper-one-0123456789abcdef012345", string(cfg.PolicyEngine.APIKey.PepperSecrets()[p.ID]))
+			}
+		})
+	}
+}
+
+func TestValidate_APIKeySigningSecret(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "signing-secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("0123456789abcdef0123456789abcdef\n"), 0o600))
+	openSecretFile := filepath.Join(dir, "open-signing-secret")
+	require.NoError(t, os.WriteFile(openSecretFile, []byte("0123456789abcdef0123456789abcdef"), 0o600))
+	require.NoError(t, os.Chmod(openSecretFile, 0o644))
+
+	cfg := validConfig()
+	require.NoError(t, cfg.Validate())
+	assert.Nil(t, cfg.PolicyEngine.APIKey.SigningSecret(), "signed keys are not verified by default")
+
+	cfg.PolicyEngine.APIKey.Prefix = "apip_"
+	cfg.PolicyEngine.APIKey.SigningSecretFile = secretFile
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", string(cfg.PolicyEngine.APIKey.SigningSecret()))
+
+	cfg.PolicyEngine.APIKey.SigningSecretFile = openSecretFile
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too permissive")
+
+	cfg.PolicyEngine.APIKey.SigningSecretFile = secretFile
+	cfg.PolicyEngine.APIKey.Prefix = ""
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy_engine.api_key.prefix is required")
+}
+
+func TestValidate_APIKeyUsageReportInterval(t *testing.T) {
+	cfg := validConfig()
+	cfg.PolicyEngine.APIKey.UsageReportInterval = 0
+	require.NoError(t, cfg.Validate(), "zero disables usage reporting")
+
+	cfg.PolicyEngine.APIKey.UsageReportInterval = -time.Second
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy_engine.api_key.usage_report_interval must not be negative")
+}
+
 // TestValidate_PythonExecutorConfig tests validation rules for PythonExecutorConfig
+func TestValidate_ExecutorConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		executor  ExecutorConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:     "unbounded by default",
+			executor: ExecutorConfig{},
+		},
+		{
+			name:     "chain and policy timeouts, skipping on timeout",
+			executor: ExecutorConfig{ChainTimeout: 5 * time.Second, PolicyTimeout: time.Second, OnTimeout: FailureActionSkip},
+		},
+		{
+			name:      "negative chain timeout",
+			executor:  ExecutorConfig{ChainTimeout: -time.Second},
+			expectErr: true,
+			errMsg:    "policy_engine.executor.chain_timeout cannot be negative",
+		},
+		{
+			name:      "negative policy timeout",
+			executor:  ExecutorConfig{PolicyTimeout: -time.Second},
+			expectErr: true,
+			errMsg:    "policy_engine.executor.policy_timeout cannot be negative",
+		},
+		{
+			name:      "invalid on_timeout",
+			executor:  ExecutorConfig{OnTimeout: "retry"},
+			expectErr: true,
+			errMsg:    "policy_engine.executor.on_timeout must be 'fail' or 'skip'",
+		},
+		{
+			name:     "skip on panic",
+			executor: ExecutorConfig{OnPanic: FailureActionSkip},
+		},
+		{
+			name:      "invalid on_panic",
+			executor:  ExecutorConfig{OnPanic: "ignore"},
+			expectErr: true,
+			errMsg:    "policy_engine.executor.on_panic must be 'fail' or 'skip'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.Executor = tt.executor
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_SecretsConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		secrets   SecretsConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:    "env by default",
+			secrets: SecretsConfig{},
+		},
+		{
+			name:    "file provider",
+			secrets: SecretsConfig{Provider: SecretsProviderFile, File: SecretsFileConfig{Dir: "/run/secrets"}},
+		},
+		{
+			name:      "file provider without dir",
+			secrets:   SecretsConfig{Provider: SecretsProviderFile},
+			expectErr: true,
+			errMsg:    "policy_engine.secrets.file.dir is required",
+		},
+		{
+			name:    "vault provider",
+			secrets: SecretsConfig{Provider: SecretsProviderVault, Vault: SecretsVaultConfig{Address: "https://vault:8200"}},
+		},
+		{
+			name:      "vault provider over plain http",
+			secrets:   SecretsConfig{Provider: SecretsProviderVault, Vault: SecretsVaultConfig{Address: "http://vault:8200"}},
+			expectErr: true,
+			errMsg:    "policy_engine.secrets.vault.address must be an https URL",
+		},
+		{
+			name:      "aws provider without region",
+			secrets:   SecretsConfig{Provider: SecretsProviderAWS},
+			expectErr: true,
+			errMsg:    "policy_engine.secrets.aws.region is required",
+		},
+		{
+			name:      "unknown provider",
+			secrets:   SecretsConfig{Provider: "keychain"},
+			expectErr: true,
+			errMsg:    "policy_engine.secrets.provider must be one of",
+		},
+		{
+			name:      "negative refresh interval",
+			secrets:   SecretsConfig{RefreshInterval: -time.Minute},
+			expectErr: true,
+			errMsg:    "policy_engine.secrets.refresh_interval cannot be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.Secrets = tt.secrets
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidate_PythonExecutorConfig(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -821,6 +1051,15 @@ func TestValidate_LoggingConfig(t *testing.T) {
 	}
 }
 
+// enableTracing sets a valid, enabled tracing configuration.
+func enableTracing(cfg *Config) {
+	cfg.TracingConfig.Enabled = true
+	cfg.TracingConfig.Endpoint = "otel-collector:4317"
+	cfg.TracingConfig.BatchTimeout = 1 * time.Second
+	cfg.TracingConfig.MaxExportBatchSize = 512
+	cfg.TracingConfig.SamplingRate = 1.0
+}
+
 // TestValidate_TracingConfig tests tracing configuration validation
 func TestValidate_TracingConfig(t *testing.T) {
 	tests := []struct {
@@ -918,6 +1157,65 @@ func TestValidate_TracingConfig(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "tracing enabled - otlp-http with https URL",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.Protocol = TracingProtocolHTTP
+				cfg.TracingConfig.Endpoint = "https://collector.example.com:4318"
+				cfg.TracingConfig.Insecure = false
+				cfg.TracingConfig.TLS.CAFile = "/etc/otel/ca.pem"
+			},
+			expectErr: false,
+		},
+		{
+			name: "tracing enabled - unknown protocol",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.Protocol = "zipkin"
+			},
+			expectErr: true,
+			errMsg:    "tracing.protocol must be",
+		},
+		{
+			name: "tracing enabled - non-http URL endpoint",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.Endpoint = "ftp://collector:4318"
+			},
+			expectErr: true,
+			errMsg:    "tracing.endpoint must be host:port or an http or https URL",
+		},
+		{
+			name: "tracing enabled - URL scheme disagrees with insecure",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.Protocol = TracingProtocolHTTP
+				cfg.TracingConfig.Endpoint = "http://collector:4318"
+				cfg.TracingConfig.Insecure = false
+			},
+			expectErr: true,
+			errMsg:    "does not match tracing.insecure",
+		},
+		{
+			name: "tracing enabled - cert file without key file",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.TLS.CertFile = "/etc/otel/client.crt"
+			},
+			expectErr: true,
+			errMsg:    "tracing.tls.cert_file and tracing.tls.key_file must be set together",
+		},
+		{
+			name: "tracing enabled - tls with insecure",
+			setup: func(cfg *Config) {
+				enableTracing(cfg)
+				cfg.TracingConfig.Insecure = true
+				cfg.TracingConfig.TLS.CAFile = "/etc/otel/ca.pem"
+			},
+			expectErr: true,
+			errMsg:    "tracing.tls is set but tracing.insecure is true",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1292,6 +1590,70 @@ func TestValidate_TrafficLogging(t *testing.T) {
 	})
 }
 
+func TestValidate_AccessLogsSink(t *testing.T) {
+	tests := []struct {
+		name   string
+		sink   AccessLogsSinkConfig
+		errMsg string
+	}{
+		{name: "disabled", sink: AccessLogsSinkConfig{}},
+		{name: "stdout", sink: AccessLogsSinkConfig{Sink: "stdout"}},
+		{name: "file", sink: AccessLogsSinkConfig{Sink: "file", FilePath: "/var/log/gateway/access.log", MaxSizeMB: 100, MaxBackups: 5}},
+		{name: "unknown sink", sink: AccessLogsSinkConfig{Sink: "syslog"}, errMsg: "invalid analytics.access_logs.sink"},
+		{name: "file without path", sink: AccessLogsSinkConfig{Sink: "file", MaxSizeMB: 100}, errMsg: "file_path is required"},
+		{name: "relative path", sink: AccessLogsSinkConfig{Sink: "file", FilePath: "logs/access.log", MaxSizeMB: 100}, errMsg: "clean absolute path"},
+		{name: "path traversal", sink: AccessLogsSinkConfig{Sink: "file", FilePath: "/var/log/../../etc/passwd", MaxSizeMB: 100}, errMsg: "clean absolute path"},
+		{name: "zero max size", sink: AccessLogsSinkConfig{Sink: "file", FilePath: "/var/log/access.log"}, errMsg: "max_size_mb"},
+		{name: "negative backups", sink: AccessLogsSinkConfig{Sink: "file", FilePath: "/var/log/access.log", MaxSizeMB: 1, MaxBackups: -1}, errMsg: "max_backups"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Analytics.AccessLogs = tt.sink
+			err := cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidate_AnalyticsSampling(t *testing.T) {
+	cfg := validConfig()
+	cfg.Analytics.Sampling = AnalyticsSamplingConfig{
+		AnalyticsSamplingRule: AnalyticsSamplingRule{KeepOneIn: 10},
+		APIs:                  map[string]AnalyticsSamplingRule{"orders": {KeepOneIn: 100, SampleErrors: true}},
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Analytics.Sampling.KeepOneIn = -1
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "analytics.sampling.keep_one_in")
+
+	cfg.Analytics.Sampling.KeepOneIn = 0
+	cfg.Analytics.Sampling.APIs["orders"] = AnalyticsSamplingRule{KeepOneIn: -5}
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "analytics.sampling.apis.orders.keep_one_in")
+}
+
+func TestValidate_AnalyticsRedactionBodyPaths(t *testing.T) {
+	cfg := validConfig()
+	cfg.Analytics.Redaction.BodyPaths = []string{"password", "customer.ssn"}
+	require.NoError(t, cfg.Validate())
+
+	for _, path := range []string{"", ".ssn", "customer.", "customer..ssn"} {
+		cfg.Analytics.Redaction.BodyPaths = []string{path}
+		err := cfg.Validate()
+		require.Error(t, err, "path %q", path)
+		assert.Contains(t, err.Error(), "analytics.redaction.body_paths")
+	}
+}
+
 // TestValidate_AnalyticsPublishers tests analytics publisher validation
 func TestValidate_AnalyticsPublishers(t *testing.T) {
 	tests := []struct {
@@ -1391,6 +1753,110 @@ func TestValidate_AnalyticsPublishers(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "otlp publisher - missing endpoint",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"otlp"}
+			},
+			expectErr: true,
+			errMsg:    "analytics.publishers.otlp.endpoint is required",
+		},
+		{
+			name: "otlp publisher - endpoint without http scheme",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"otlp"}
+				cfg.Analytics.Publishers.OTLP = OTLPPublisherConfig{Endpoint: "http://otel-collector:4318", PublishInterval: 5, EventQueueSize: 100, BatchSize: 10, Timeout: 10}
+				cfg.Analytics.Publishers.OTLP.Endpoint = "otel-collector:4317"
+			},
+			expectErr: true,
+			errMsg:    "must be an http or https URL",
+		},
+		{
+			name: "otlp publisher - invalid batch_size",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"otlp"}
+				cfg.Analytics.Publishers.OTLP = OTLPPublisherConfig{Endpoint: "http://otel-collector:4318", PublishInterval: 5, EventQueueSize: 100, BatchSize: 10, Timeout: 10}
+				cfg.Analytics.Publishers.OTLP.BatchSize = 0
+			},
+			expectErr: true,
+			errMsg:    "analytics.publishers.otlp.batch_size must be > 0",
+		},
+		{
+			name: "otlp publisher - valid config",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"otlp"}
+				cfg.Analytics.Publishers.OTLP = OTLPPublisherConfig{Endpoint: "http://otel-collector:4318", PublishInterval: 5, EventQueueSize: 100, BatchSize: 10, Timeout: 10}
+			},
+			expectErr: false,
+		},
+		{
+			name: "webhook publisher - missing url",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"webhook"}
+			},
+			expectErr: true,
+			errMsg:    "analytics.publishers.webhook.url is required",
+		},
+		{
+			name: "webhook publisher - invalid timeout",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"webhook"}
+				cfg.Analytics.Publishers.Webhook = WebhookPublisherConfig{URL: "https://collector.example.com/events", PublishInterval: 5, EventQueueSize: 100, BatchSize: 10, Timeout: 10}
+				cfg.Analytics.Publishers.Webhook.Timeout = 0
+			},
+			expectErr: true,
+			errMsg:    "analytics.publishers.webhook.timeout must be > 0",
+		},
+		{
+			name: "webhook publisher - valid config",
+			setup: func(cfg *Config) {
+				cfg.Analytics.Enabled = true
+				cfg.Collector.Server = AccessLogsServiceConfig{
+					ShutdownTimeout:       600 * time.Second,
+					ExtProcMaxMessageSize: 1000000,
+					ExtProcMaxHeaderLimit: 8192,
+				}
+				cfg.Analytics.EnabledPublishers = []string{"webhook"}
+				cfg.Analytics.Publishers.Webhook = WebhookPublisherConfig{URL: "https://collector.example.com/events", PublishInterval: 5, EventQueueSize: 100, BatchSize: 10, Timeout: 10}
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1478,6 +1944,25 @@ level = "info"
 	assert.Equal(t, "info", cfg.PolicyEngine.Logging.Level, "APIP_GW_ env must not override a token-free key")
 }
 
+func TestLoad_AnalyticsSampling(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	configContent := `
+[analytics.sampling]
+keep_one_in = 10
+
+[analytics.sampling.apis.orders]
+keep_one_in = 100
+sample_errors = true
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 10, cfg.Analytics.Sampling.KeepOneIn)
+	assert.False(t, cfg.Analytics.Sampling.SampleErrors)
+	assert.Equal(t, AnalyticsSamplingRule{KeepOneIn: 100, SampleErrors: true}, cfg.Analytics.Sampling.APIs["orders"])
+}
+
 // TestLoad_EmptyPath tests loading with empty path (defaults only)
 func TestLoad_EmptyPath(t *testing.T) {
 	cfg, err := Load("")
@@ -1587,6 +2072,96 @@ custom_key = "custom_value"
 	assert.NotEmpty(t, cfg.PolicyEngine.RawConfig)
 }
 
+// loadConfigContent writes content to a config file and loads it
+func loadConfigContent(t *testing.T, content string) (*Config, error) {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0o600))
+	return Load(configPath)
+}
+
+// TestLoad_LegacyFlatPolicyConfigurations tests that the deprecated flat shared-policy
+// tables load into the same config as their [policy_configurations] form
+func TestLoad_LegacyFlatPolicyConfigurations(t *testing.T) {
+	const nested = `
+[policy_configurations.ratelimit_v1]
+algorithm = "fixed-window"
+
+[policy_configurations.embedding]
+provider = "openai"
+endpoint = "https://api.openai.com/v1/embeddings"
+dimensions = 1536
+
+[policy_configurations.vector_db]
+provider = "redis"
+host = "redis"
+port = 6379
+
+[policy_configurations.guardrails]
+provider = "aws-bedrock"
+
+[[policy_configurations.guardrails.regions]]
+name = "us-east-1"
+`
+	const flat = `
+[policy_configurations.ratelimit_v1]
+algorithm = "fixed-window"
+
+[embedding]
+provider = "openai"
+endpoint = "https://api.openai.com/v1/embeddings"
+dimensions = 1536
+
+[vector_db]
+provider = "redis"
+host = "redis"
+port = 6379
+
+[guardrails]
+provider = "aws-bedrock"
+
+[[guardrails.regions]]
+name = "us-east-1"
+`
+
+	nestedCfg, err := loadConfigContent(t, nested)
+	require.NoError(t, err)
+	flatCfg, err := loadConfigContent(t, flat)
+	require.NoError(t, err)
+
+	assert.Equal(t, nestedCfg, flatCfg)
+	assert.NotContains(t, flatCfg.PolicyEngine.RawConfig, "embedding")
+	assert.Equal(t, "redis", flatCfg.PolicyConfigurations["vector_db"].(map[string]interface{})["host"])
+}
+
+// TestLoad_LegacyFlatPolicyConfigurationsWithoutNestedTable tests the mapping when the
+// config has no [policy_configurations] table of its own
+func TestLoad_LegacyFlatPolicyConfigurationsWithoutNestedTable(t *testing.T) {
+	cfg, err := loadConfigContent(t, `
+[embedding]
+provider = "openai"
+`)
+	require.NoError(t, err)
+
+	nested, ok := cfg.PolicyEngine.RawConfig["policy_configurations"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"provider": "openai"}, nested["embedding"])
+}
+
+// TestLoad_LegacyFlatPolicyConfigurationsConflict tests that a table set in both forms
+// is rejected rather than merged
+func TestLoad_LegacyFlatPolicyConfigurationsConflict(t *testing.T) {
+	_, err := loadConfigContent(t, `
+[embedding]
+provider = "openai"
+
+[policy_configurations.embedding]
+provider = "mistral"
+`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[embedding] and [policy_configurations.embedding] are both set")
+}
+
 // TestDefaultConfig tests that default configuration is valid
 func TestDefaultConfig(t *testing.T) {
 	cfg := defaultConfig()
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configsecrets resolves secret://name references in the policy engine
+// config against the configured secrets provider, at startup and periodically
+// afterwards so policies pick up rotated secrets.
+package configsecrets
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/wso2/api-platform/common/secrets"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+// resolveTimeout bounds one resolution pass over the config.
+const resolveTimeout = 30 * time.Second
+
+// NewProvider returns the secrets provider selected by cfg.
+func NewProvider(cfg config.SecretsConfig) (secrets.Provider, error) {
+	switch cfg.Provider {
+	case "", config.SecretsProviderEnv:
+		return secrets.EnvProvider{Prefix: cfg.Env.Prefix}, nil
+	case config.SecretsProviderFile:
+		return secrets.FileProvider{Dir: cfg.File.Dir}, nil
+	case config.SecretsProviderVault:
+		token := cfg.Vault.Token
+		if token == "" {
+			token = os.Getenv("VAULT_TOKEN")
+		}
+		return secrets.NewVaultProvider(secrets.VaultConfig{
+			Address: cfg.Vault.Address,
+			Token:   token,
+			Mount:   cfg.Vault.Mount,
+		})
+	case config.SecretsProviderAWS:
+		return secrets.NewAWSProvider(secrets.AWSConfig{
+			Region:   cfg.AWS.Region,
+			Endpoint: cfg.AWS.Endpoint,
+		})
+	default:
+		return nil, fmt.Errorf("unknown secrets provider: %s", cfg.Provider)
+	}
+}
+
+// ApplyFunc takes a freshly resolved config and the secret values resolved into it.
+type ApplyFunc func(ctx context.Context, resolved map[string]interface{}, values []string) error
+
+// Refresher resolves the secret references of a raw config.
+type Refresher struct {
+	raw      map[string]interface{}
+	provider secrets.Provider
+	last     map[string]interface{}
+}
+
+// NewRefresher returns a refresher of the secret references in raw.
+func NewRefresher(raw map[string]interface{}, provider secrets.Provider) *Refresher {
+	return &Refresher{raw: raw, provider: provider}
+}
+
+// HasRefs reports whether the config references any secret.
+func (r *Refresher) HasRefs() bool {
+	return secrets.HasRefs(r.raw)
+}
+
+// Resolve returns the config with its secret references resolved, and the
+// resolved values. It fails closed: an unresolved reference is an error.
+func (r *Refresher) Resolve(ctx context.Context) (map[string]interface{}, []string, error) {
+	if !r.HasRefs() {
+		r.last = r.raw
+		return r.raw, nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+	resolved, values, err := secrets.Resolve(ctx, r.raw, r.provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.last = resolved
+	return resolved, values, nil
+}
+
+// Run resolves the secret references every interval until ctx is done, and
+// calls apply whenever a resolved value changed. A failed pass keeps the
+// previous values in place. It returns at once when the config references no
+// secret or interval is not positive.
+func (r *Refresher) Run(ctx context.Context, interval time.Duration, apply ApplyFunc) {
+	if interval <= 0 || !r.HasRefs() {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.refresh(ctx, apply)
+		}
+	}
+}
+
+func (r *Refresher) refresh(ctx context.Context, apply ApplyFunc) {
+	previous := r.last
+	resolved, values, err := r.Resolve(ctx)
+	if err != nil {
+		// The error names the secret and where it is referenced, never its value
+		slog.WarnContext(ctx, "Failed to refresh config secrets, keeping the previous values", "error", err)
+		return
+	}
+	if reflect.DeepEqual(previous, resolved) {
+		return
+	}
+	slog.InfoContext(ctx, "Config secrets changed, applying the refreshed config", "secrets", len(values))
+	if err := apply(ctx, resolved, values); err != nil {
+		slog.ErrorContext(ctx, "Failed to apply refreshed config secrets", "error", err)
+		// Retry on the next pass
+		r.last = previous
+	}
+}
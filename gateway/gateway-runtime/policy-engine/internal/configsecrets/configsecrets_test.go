@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configsecrets
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/common/secrets"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+func TestNewProvider(t *testing.T) {
+	p, err := NewProvider(config.SecretsConfig{Env: config.SecretsEnvConfig{Prefix: "APIP_SECRET_"}})
+	require.NoError(t, err)
+	assert.Equal(t, secrets.EnvProvider{Prefix: "APIP_SECRET_"}, p)
+
+	p, err = NewProvider(config.SecretsConfig{Provider: config.SecretsProviderFile, File: config.SecretsFileConfig{Dir: "/run/secrets"}})
+	require.NoError(t, err)
+	assert.Equal(t, secrets.FileProvider{Dir: "/run/secrets"}, p)
+
+	t.Setenv("VAULT_TOKEN", "")
+	_, err = NewProvider(config.SecretsConfig{Provider: config.SecretsProviderVault, Vault: config.SecretsVaultConfig{Address: "https://vault:8200"}})
+	assert.ErrorContains(t, err, "vault token is required")
+
+	t.Setenv("VAULT_TOKEN", "root-token")
+	_, err = NewProvider(config.SecretsConfig{Provider: config.SecretsProviderVault, Vault: config.SecretsVaultConfig{Address: "https://vault:8200"}})
+	assert.NoError(t, err)
+}
+
+func TestRefresher_Resolve(t *testing.T) {
+	t.Setenv("APIP_SECRET_OPENAI_KEY", "sk-1")
+	raw := map[string]interface{}{"llm": map[string]interface{}{"api_key": "secret://openai-key"}}
+	r := NewRefresher(raw, secrets.EnvProvider{Prefix: "APIP_SECRET_"})
+	require.True(t, r.HasRefs())
+
+	resolved, values, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "sk-1", resolved["llm"].(map[string]interface{})["api_key"])
+	assert.Equal(t, []string{"sk-1"}, values)
+}
+
+func TestRefresher_ResolveWithoutRefs(t *testing.T) {
+	raw := map[string]interface{}{"llm": map[string]interface{}{"api_key": "inline"}}
+	r := NewRefresher(raw, nil)
+	assert.False(t, r.HasRefs())
+
+	resolved, values, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, raw, resolved)
+	assert.Empty(t, values)
+}
+
+func TestRefresher_RefreshAppliesOnlyChanges(t *testing.T) {
+	t.Setenv("APIP_SECRET_OPENAI_KEY", "sk-1")
+	raw := map[string]interface{}{"llm": map[string]interface{}{"api_key": "secret://openai-key"}}
+	r := NewRefresher(raw, secrets.EnvProvider{Prefix: "APIP_SECRET_"})
+	_, _, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+
+	var applied [][]string
+	apply := func(_ context.Context, _ map[string]interface{}, values []string) error {
+		applied = append(applied, values)
+		return nil
+	}
+
+	// Unchanged: nothing to apply
+	r.refresh(context.Background(), apply)
+	assert.Empty(t, applied)
+
+	// Rotated
+	t.Setenv("APIP_SECRET_OPENAI_KEY", "sk-2")
+	r.refresh(context.Background(), apply)
+	assert.Equal(t, [][]string{{"sk-2"}}, applied)
+
+	// Unresolvable: the previous values stay, nothing is applied
+	require.NoError(t, os.Unsetenv("APIP_SECRET_OPENAI_KEY"))
+	r.refresh(context.Background(), apply)
+	assert.Len(t, applied, 1)
+	assert.Equal(t, "sk-2", r.last["llm"].(map[string]interface{})["api_key"])
+}
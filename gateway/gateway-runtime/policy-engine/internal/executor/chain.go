@@ -67,6 +67,8 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 	hasExecutionConditions bool,
 ) (*RequestHeaderExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &RequestHeaderExecutionResult{
 		Results:        make([]RequestHeaderPolicyResult, 0, len(policyList)),
 		ShortCircuited: false,
@@ -96,7 +98,7 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 			continue
 		}
 
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			if span.IsRecording() {
 				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
 			}
@@ -120,6 +122,7 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 						span.RecordError(err)
 						span.SetStatus(codes.Error, "condition evaluation failed")
 					}
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -143,12 +146,41 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
-		action := headerPol.OnRequestHeaders(ctx, reqCtx, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.RequestHeaderAction {
+			return headerPol.OnRequestHeaders(ctx, reqCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, RequestHeaderPolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		// Apply header mutations to reqCtx so subsequent policies and CEL conditions see the mutated state
 		if mod, ok := action.(policy.UpstreamRequestHeaderModifications); ok {
@@ -186,6 +218,7 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 				span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
 			}
 			metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
+			c.recordPolicyShortCircuit(spec, route)
 			result.ShortCircuited = true
 			result.FinalAction = action
 			span.End()
@@ -224,6 +257,8 @@ type RequestExecutionResult struct {
 // hasExecutionConditions indicates if any policy in the chain has CEL conditions; when false, CEL evaluation is skipped entirely
 func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList []policy.Policy, reqCtx *policy.RequestContext, specs []policy.PolicySpec, api, route string, hasExecutionConditions bool) (*RequestExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &RequestExecutionResult{
 		Results:        make([]RequestPolicyResult, 0, len(policyList)),
 		ShortCircuited: false,
@@ -261,7 +296,7 @@ func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList [
 		}
 
 		// Check if policy is enabled
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			if span.IsRecording() {
 				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
 			}
@@ -286,6 +321,7 @@ func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList [
 						span.RecordError(err)
 						span.SetStatus(codes.Error, "condition evaluation failed")
 					}
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -312,13 +348,42 @@ func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList [
 		// across concurrent requests (nested maps/slices require a full deep copy).
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
 		slog.Debug("[body] calling OnRequestBody", "policy", spec.Name, "version", spec.Version, "route", route)
-		action := rp.OnRequestBody(ctx, reqCtx, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.RequestAction {
+			return rp.OnRequestBody(ctx, reqCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, RequestPolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		// Record policy execution metrics
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
@@ -347,6 +412,7 @@ func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList [
 					span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
 				}
 				metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
+				c.recordPolicyShortCircuit(spec, route)
 				result.ShortCircuited = true
 				result.FinalAction = action
 				span.End()
@@ -400,6 +466,8 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 	hasExecutionConditions bool,
 ) (*ResponseHeaderExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &ResponseHeaderExecutionResult{
 		Results: make([]ResponseHeaderPolicyResult, 0, len(policyList)),
 	}
@@ -429,7 +497,7 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 			continue
 		}
 
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			if span.IsRecording() {
 				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
 			}
@@ -446,6 +514,7 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 			if c.celEvaluator != nil {
 				conditionMet, err := c.celEvaluator.EvaluateResponseHeaderCondition(*spec.ExecutionCondition, respCtx)
 				if err != nil {
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -469,12 +538,41 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
-		action := headerPol.OnResponseHeaders(ctx, respCtx, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.ResponseHeaderAction {
+			return headerPol.OnResponseHeaders(ctx, respCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, ResponseHeaderPolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		// Apply header mutations to respCtx so subsequent policies and CEL conditions see the mutated state
 		if mod, ok := action.(policy.DownstreamResponseHeaderModifications); ok {
@@ -506,6 +604,7 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 				span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
 			}
 			metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
+			c.recordPolicyShortCircuit(spec, route)
 			result.ShortCircuited = true
 			result.FinalAction = action
 			span.End()
@@ -544,6 +643,8 @@ type ResponseExecutionResult struct {
 // hasExecutionConditions indicates if any policy in the chain has CEL conditions; when false, CEL evaluation is skipped entirely
 func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList []policy.Policy, respCtx *policy.ResponseContext, specs []policy.PolicySpec, api, route string, hasExecutionConditions bool) (*ResponseExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &ResponseExecutionResult{
 		Results: make([]ResponsePolicyResult, 0, len(policyList)),
 	}
@@ -582,7 +683,7 @@ func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList
 		}
 
 		// Check if policy is enabled
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			if span.IsRecording() {
 				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
 			}
@@ -607,6 +708,7 @@ func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList
 						span.RecordError(err)
 						span.SetStatus(codes.Error, "condition evaluation failed")
 					}
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -633,13 +735,42 @@ func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList
 		// across concurrent requests (nested maps/slices require a full deep copy).
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
 		slog.Debug("[body] calling OnResponseBody", "policy", spec.Name, "version", spec.Version, "route", route)
-		action := rp.OnResponseBody(ctx, respCtx, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.ResponseAction {
+			return rp.OnResponseBody(ctx, respCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, ResponsePolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		// Record policy execution metrics
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
@@ -668,6 +799,7 @@ func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList
 					span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
 				}
 				metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
+				c.recordPolicyShortCircuit(spec, route)
 				result.ShortCircuited = true
 				result.FinalAction = action
 				span.End()
@@ -718,6 +850,8 @@ func (c *ChainExecutor) ExecuteStreamingRequestPolicies(
 	hasExecutionConditions bool,
 ) (*StreamingRequestExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &StreamingRequestExecutionResult{
 		Results: make([]StreamingRequestPolicyResult, 0, len(policyList)),
 	}
@@ -751,7 +885,7 @@ func (c *ChainExecutor) ExecuteStreamingRequestPolicies(
 			continue
 		}
 
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", "disabled").Inc()
 			span.End()
 			result.Results = append(result.Results, StreamingRequestPolicyResult{
@@ -769,6 +903,7 @@ func (c *ChainExecutor) ExecuteStreamingRequestPolicies(
 						span.RecordError(err)
 						span.SetStatus(codes.Error, err.Error())
 					}
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -790,13 +925,42 @@ func (c *ChainExecutor) ExecuteStreamingRequestPolicies(
 
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
 		slog.Debug("[streaming] calling OnRequestBodyChunk", "policy", spec.Name, "version", spec.Version, "route", route, "end_of_stream", currentChunk.EndOfStream)
-		action := streamingPol.OnRequestBodyChunk(ctx, reqCtx, currentChunk, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.StreamingRequestAction {
+			return streamingPol.OnRequestBodyChunk(ctx, reqCtx, currentChunk, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, StreamingRequestPolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
 		metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
@@ -861,6 +1025,8 @@ func (c *ChainExecutor) ExecuteStreamingResponsePolicies(
 	hasExecutionConditions bool,
 ) (*StreamingResponseExecutionResult, error) {
 	startTime := time.Now()
+	ctx, cancel := c.chainContext(ctx)
+	defer cancel()
 	result := &StreamingResponseExecutionResult{
 		Results: make([]StreamingResponsePolicyResult, 0, len(policyList)),
 	}
@@ -894,7 +1060,7 @@ func (c *ChainExecutor) ExecuteStreamingResponsePolicies(
 			continue
 		}
 
-		if !spec.Enabled {
+		if !c.PolicyEnabled(ctx, spec) {
 			if span.IsRecording() {
 				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
 			}
@@ -915,6 +1081,7 @@ func (c *ChainExecutor) ExecuteStreamingResponsePolicies(
 						span.RecordError(err)
 						span.SetStatus(codes.Error, "condition evaluation failed")
 					}
+					c.recordPolicyError(spec, route, policyErrorCondition)
 					span.End()
 					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 				}
@@ -938,13 +1105,42 @@ func (c *ChainExecutor) ExecuteStreamingResponsePolicies(
 
 		params, err := deepCopyParams(spec.Parameters.Raw)
 		if err != nil {
+			c.recordPolicyError(spec, route, policyErrorParams)
 			span.End()
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
 		slog.Debug("[streaming] calling OnResponseBodyChunk", "policy", spec.Name, "version", spec.Version, "route", route, "end_of_stream", currentChunk.EndOfStream)
-		action := streamingPol.OnResponseBodyChunk(ctx, respCtx, currentChunk, params)
+		action, err := invokePolicy(ctx, spec, c.policyTimeout, func(ctx context.Context) policy.StreamingResponseAction {
+			return streamingPol.OnResponseBodyChunk(ctx, respCtx, currentChunk, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		c.recordPolicyDuration(spec, route, executionTime)
+		if err != nil {
+			errorType := policyErrorType(err)
+			c.recordPolicyError(spec, route, errorType)
+			if c.skipFailedPolicy(err) {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, errorType))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", errorType).Inc()
+				span.End()
+				result.Results = append(result.Results, StreamingResponsePolicyResult{
+					PolicyName:    spec.Name,
+					PolicyVersion: spec.Version,
+					Skipped:       true,
+					ExecutionTime: executionTime,
+				})
+				continue
+			}
+			if span.IsRecording() {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "policy execution failed")
+			}
+			span.End()
+			return nil, err
+		}
 
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
 		metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
@@ -1089,6 +1285,18 @@ type ChainExecutor struct {
 	registry     *registry.PolicyRegistry
 	celEvaluator CELEvaluator
 	tracer       trace.Tracer
+	overrides    *PolicyOverrides
+
+	// policyRouteLabel fills the route label of the per-policy metrics
+	policyRouteLabel bool
+
+	// chainTimeout and policyTimeout bound each phase of a chain and each
+	// policy; skipOnTimeout and skipOnPanic skip a policy that timed out or
+	// panicked instead of failing the request
+	chainTimeout  time.Duration
+	policyTimeout time.Duration
+	skipOnTimeout bool
+	skipOnPanic   bool
 }
 
 // CELEvaluator interface for condition evaluation
@@ -1110,6 +1318,26 @@ func NewChainExecutor(reg *registry.PolicyRegistry, celEvaluator CELEvaluator, t
 	}
 }
 
+// SetPolicyOverrides sets the runtime overrides that disable policies in
+// every chain.
+func (c *ChainExecutor) SetPolicyOverrides(overrides *PolicyOverrides) {
+	c.overrides = overrides
+}
+
+// PolicyEnabled reports whether the policy of spec runs: it must be enabled
+// in its configuration and not disabled by a runtime override.
+func (c *ChainExecutor) PolicyEnabled(ctx context.Context, spec policy.PolicySpec) bool {
+	if !spec.Enabled {
+		return false
+	}
+	if c.overrides.IsDisabled(spec.Name) {
+		slog.DebugContext(ctx, "Skipping policy disabled by runtime override",
+			"policy", spec.Name, "version", spec.Version)
+		return false
+	}
+	return true
+}
+
 // GetCELEvaluator returns the CEL evaluator used for condition evaluation.
 func (c *ChainExecutor) GetCELEvaluator() CELEvaluator {
 	return c.celEvaluator
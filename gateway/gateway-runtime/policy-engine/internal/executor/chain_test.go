@@ -20,11 +20,13 @@ package executor
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -1016,3 +1018,71 @@ func TestExecuteStreamingResponsePolicies_ModeFirstGating(t *testing.T) {
 		})
 	}
 }
+
+// templatedHeaderPolicy sets a request header from an expression, the way the
+// header-transform policy renders "${...}" values.
+type templatedHeaderPolicy struct {
+	name       string
+	expression string
+}
+
+func (p *templatedHeaderPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *templatedHeaderPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	value, err := policy.GetExpressionEvaluator().EvaluateRequestHeaderExpression(p.expression, reqCtx)
+	if err != nil {
+		return policy.UpstreamRequestHeaderModifications{HeadersToRemove: []string{p.name}}
+	}
+	s, _ := value.(string)
+	return policy.UpstreamRequestHeaderModifications{HeadersToSet: map[string]string{p.name: s}}
+}
+
+func TestExecuteRequestHeaderPolicies_ConditionalTemplatedHeader(t *testing.T) {
+	celEval, err := cel.NewCELEvaluator()
+	require.NoError(t, err)
+	policy.SetExpressionEvaluator(celEval)
+	t.Cleanup(func() { policy.SetExpressionEvaluator(nil) })
+
+	executor := NewChainExecutor(nil, celEval, noop.NewTracerProvider().Tracer("test"))
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"alice"}`)) + "."
+
+	tests := []struct {
+		name     string
+		method   string
+		wantUser []string
+	}{
+		{name: "condition true sets header", method: "POST", wantUser: []string{"alice"}},
+		{name: "condition false keeps header", method: "GET", wantUser: []string{"client-supplied"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx := &policy.RequestHeaderContext{
+				SharedContext: testutils.NewTestSharedContext(),
+				Headers: policy.NewHeaders(map[string][]string{
+					"authorization": {"Bearer " + token},
+					"x-user":        {"client-supplied"},
+				}),
+				Path:   "/orders",
+				Method: tt.method,
+			}
+
+			result, err := executor.ExecuteRequestHeaderPolicies(
+				context.Background(),
+				[]policy.Policy{&templatedHeaderPolicy{name: "x-user", expression: "jwtSub()"}},
+				reqCtx,
+				[]policy.PolicySpec{newPolicySpec("header-transform", "v1.0.0", true, testutils.PtrString("request.Method == 'POST'"))},
+				"api",
+				"route",
+				true,
+			)
+
+			require.NoError(t, err)
+			require.Len(t, result.Results, 1)
+			assert.Equal(t, tt.method != "POST", result.Results[0].Skipped)
+			assert.Equal(t, tt.wantUser, reqCtx.Headers.Get("x-user"))
+		})
+	}
+}
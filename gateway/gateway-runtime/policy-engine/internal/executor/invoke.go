@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// ErrPolicyTimeout is returned when a policy does not return before its
+// timeout or the deadline of its chain.
+var ErrPolicyTimeout = errors.New("policy timed out")
+
+// ErrPolicyPanic is returned when a policy panics.
+var ErrPolicyPanic = errors.New("policy panicked")
+
+// SetTimeouts bounds policy execution. chainTimeout is the deadline of one
+// phase of a chain and policyTimeout that of a single policy; zero leaves
+// either unbounded. A policy that misses its deadline fails the request,
+// unless skipOnTimeout is set, in which case it is skipped and the chain
+// goes on.
+func (c *ChainExecutor) SetTimeouts(chainTimeout, policyTimeout time.Duration, skipOnTimeout bool) {
+	c.chainTimeout = chainTimeout
+	c.policyTimeout = policyTimeout
+	c.skipOnTimeout = skipOnTimeout
+}
+
+// chainContext derives the context of one phase of a chain from the request
+// context, applying the chain timeout. An earlier deadline of the request
+// context is kept.
+func (c *ChainExecutor) chainContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.chainTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.chainTimeout)
+}
+
+// SetSkipOnPanic sets whether a policy that panics is skipped, letting the
+// rest of the chain run, rather than failing the request.
+func (c *ChainExecutor) SetSkipOnPanic(skip bool) {
+	c.skipOnPanic = skip
+}
+
+// skipFailedPolicy reports whether a policy that failed with err is skipped
+// rather than failing the request.
+func (c *ChainExecutor) skipFailedPolicy(err error) bool {
+	switch {
+	case errors.Is(err, ErrPolicyTimeout):
+		return c.skipOnTimeout
+	case errors.Is(err, ErrPolicyPanic):
+		return c.skipOnPanic
+	default:
+		return false
+	}
+}
+
+// policyErrorType returns the policy_execution_errors_total error type of err.
+func policyErrorType(err error) string {
+	switch {
+	case errors.Is(err, ErrPolicyTimeout):
+		return policyErrorTimeout
+	case errors.Is(err, ErrPolicyPanic):
+		return policyErrorPanic
+	default:
+		return policyErrorCanceled
+	}
+}
+
+// invokePolicy runs a policy callback with the given context, turning a panic
+// into ErrPolicyPanic so that one faulty policy affects only its own request
+// instead of crashing the engine. When the context has a deadline, or timeout is set, the
+// policy is abandoned once the deadline passes: its context is cancelled,
+// which aborts its provider calls, and its action is discarded when it
+// eventually returns.
+func invokePolicy[A any](ctx context.Context, spec policy.PolicySpec, timeout time.Duration, call func(context.Context) A) (A, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && timeout <= 0 {
+		return runPolicy(ctx, spec, call)
+	}
+
+	policyCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		policyCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	type outcome struct {
+		action A
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		action, err := runPolicy(policyCtx, spec, call)
+		done <- outcome{action, err}
+	}()
+
+	select {
+	case out := <-done:
+		return out.action, out.err
+	case <-policyCtx.Done():
+		var zero A
+		if errors.Is(policyCtx.Err(), context.DeadlineExceeded) {
+			slog.WarnContext(ctx, "Policy did not return before its deadline",
+				"policy", spec.Name, "version", spec.Version)
+			return zero, fmt.Errorf("%w: %s:%s", ErrPolicyTimeout, spec.Name, spec.Version)
+		}
+		return zero, fmt.Errorf("policy %s:%s aborted: %w", spec.Name, spec.Version, policyCtx.Err())
+	}
+}
+
+// runPolicy calls the policy, recovering from a panic. Panics are logged with
+// their stack and counted per policy.
+func runPolicy[A any](ctx context.Context, spec policy.PolicySpec, call func(context.Context) A) (action A, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			metrics.PanicRecoveriesTotal.WithLabelValues("policy").Inc()
+			metrics.PolicyPanicsTotal.WithLabelValues(spec.Name).Inc()
+			slog.ErrorContext(ctx, "Recovered from panic in policy",
+				"policy", spec.Name, "version", spec.Version, "panic", r, "stack", string(debug.Stack()))
+			err = fmt.Errorf("%w: %s:%s", ErrPolicyPanic, spec.Name, spec.Version)
+		}
+	}()
+	return call(ctx), nil
+}
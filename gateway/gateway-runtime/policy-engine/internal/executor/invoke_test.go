@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// sleepingRequestHeaderPolicy sleeps before returning. When honorCtx is set it
+// returns early once its context is done, as a policy waiting on a provider
+// call would; otherwise it keeps sleeping until release is closed.
+type sleepingRequestHeaderPolicy struct {
+	sleep     time.Duration
+	honorCtx  bool
+	release   chan struct{}
+	cancelled chan struct{}
+}
+
+func newSleepingPolicy(t *testing.T, sleep time.Duration, honorCtx bool) *sleepingRequestHeaderPolicy {
+	p := &sleepingRequestHeaderPolicy{
+		sleep:     sleep,
+		honorCtx:  honorCtx,
+		release:   make(chan struct{}),
+		cancelled: make(chan struct{}),
+	}
+	t.Cleanup(func() { close(p.release) })
+	return p
+}
+
+func (p *sleepingRequestHeaderPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *sleepingRequestHeaderPolicy) OnRequestHeaders(ctx context.Context, _ *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	done := ctx.Done()
+	if !p.honorCtx {
+		done = nil
+	}
+	select {
+	case <-time.After(p.sleep):
+	case <-done:
+		close(p.cancelled)
+	case <-p.release:
+	}
+	return policy.UpstreamRequestHeaderModifications{HeadersToSet: map[string]string{"x-slow": "done"}}
+}
+
+func newHeaderContext() *policy.RequestHeaderContext {
+	return &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+		Path:          "/test",
+		Method:        "GET",
+	}
+}
+
+func TestExecuteRequestHeaderPolicies_PolicyTimeout_FailsClosed(t *testing.T) {
+	_, errors, _ := recordPolicyMetrics(t)
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetTimeouts(0, 20*time.Millisecond, false)
+
+	slow := newSleepingPolicy(t, 10*time.Second, true)
+	next := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	specs := []policy.PolicySpec{
+		newPolicySpec("slow", "v1.0.0", true, nil),
+		newPolicySpec("next", "v1.0.0", true, nil),
+	}
+
+	start := time.Now()
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{slow, next}, newHeaderContext(), specs, "api", "route", false)
+	require.ErrorIs(t, err, ErrPolicyTimeout)
+	assert.Nil(t, result)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Zero(t, next.calls)
+	assert.Equal(t, 1, errors.count("slow", "v1.0.0", "", policyErrorTimeout))
+
+	select {
+	case <-slow.cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the context of the timed out policy was not cancelled")
+	}
+}
+
+func TestExecuteRequestHeaderPolicies_PolicyTimeout_Skipped(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetTimeouts(0, 20*time.Millisecond, true)
+
+	// The policy ignores its context, so it is abandoned rather than aborted.
+	slow := newSleepingPolicy(t, 10*time.Second, false)
+	next := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	specs := []policy.PolicySpec{
+		newPolicySpec("slow", "v1.0.0", true, nil),
+		newPolicySpec("next", "v1.0.0", true, nil),
+	}
+
+	reqCtx := newHeaderContext()
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{slow, next}, reqCtx, specs, "api", "route", false)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.True(t, result.Results[0].Skipped)
+	assert.Nil(t, result.Results[0].Action)
+	assert.False(t, result.Results[1].Skipped)
+	assert.Equal(t, 1, next.calls)
+	assert.Empty(t, reqCtx.Headers.Get("x-slow"), "the action of a timed out policy is discarded")
+}
+
+func TestExecuteRequestHeaderPolicies_ChainTimeout(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetTimeouts(50*time.Millisecond, 0, false)
+
+	// Each policy fits well within the chain deadline; together they do not.
+	fast := newSleepingPolicy(t, time.Millisecond, true)
+	slow := newSleepingPolicy(t, 10*time.Second, true)
+	specs := []policy.PolicySpec{
+		newPolicySpec("fast", "v1.0.0", true, nil),
+		newPolicySpec("slow", "v1.0.0", true, nil),
+	}
+
+	_, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{fast, slow}, newHeaderContext(), specs, "api", "route", false)
+	require.ErrorIs(t, err, ErrPolicyTimeout)
+	assert.Contains(t, err.Error(), "slow:v1.0.0")
+}
+
+func TestExecuteRequestHeaderPolicies_RequestDeadline(t *testing.T) {
+	// No timeouts are configured; the deadline of the request context applies.
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	slow := newSleepingPolicy(t, 10*time.Second, true)
+	_, err := executor.ExecuteRequestHeaderPolicies(ctx, []policy.Policy{slow}, newHeaderContext(),
+		[]policy.PolicySpec{newPolicySpec("slow", "v1.0.0", true, nil)}, "api", "route", false)
+	require.ErrorIs(t, err, ErrPolicyTimeout)
+}
+
+// recordPolicyPanics swaps policy_panics_total for a recorder for the
+// duration of the test.
+func recordPolicyPanics(t *testing.T) *recordedMetrics {
+	t.Helper()
+	orig := metrics.PolicyPanicsTotal
+	t.Cleanup(func() { metrics.PolicyPanicsTotal = orig })
+	panics := &recordedMetrics{samples: map[string]int{}}
+	metrics.PolicyPanicsTotal = recordingCounterVec{panics}
+	return panics
+}
+
+func TestExecuteRequestHeaderPolicies_PolicyPanic_FailsClosed(t *testing.T) {
+	panics := recordPolicyPanics(t)
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	// With a timeout the policy runs on its own goroutine, where an
+	// unrecovered panic would take the process down.
+	executor.SetTimeouts(0, time.Second, false)
+
+	next := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	specs := []policy.PolicySpec{
+		newPolicySpec("buggy", "v1.0.0", true, nil),
+		newPolicySpec("next", "v1.0.0", true, nil),
+	}
+	_, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{&panickingRequestHeaderPolicy{}, next}, newHeaderContext(), specs, "api", "route", false)
+	require.ErrorIs(t, err, ErrPolicyPanic)
+	assert.Zero(t, next.calls)
+	assert.Equal(t, 1, panics.count("buggy"))
+
+	// Other requests are unaffected.
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{next}, newHeaderContext(), specs[1:], "api", "route", false)
+	require.NoError(t, err)
+	assert.False(t, result.Results[0].Skipped)
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestExecuteRequestHeaderPolicies_PolicyPanic_Skipped(t *testing.T) {
+	panics := recordPolicyPanics(t)
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetSkipOnPanic(true)
+
+	next := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	specs := []policy.PolicySpec{
+		newPolicySpec("buggy", "v1.0.0", true, nil),
+		newPolicySpec("next", "v1.0.0", true, nil),
+	}
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{&panickingRequestHeaderPolicy{}, next}, newHeaderContext(), specs, "api", "route", false)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.True(t, result.Results[0].Skipped)
+	assert.False(t, result.Results[1].Skipped)
+	assert.Equal(t, 1, next.calls)
+	assert.Equal(t, 1, panics.count("buggy"))
+}
+
+func TestExecuteRequestHeaderPolicies_SkipOnPanic_TimeoutStillFails(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetTimeouts(0, 20*time.Millisecond, false)
+	executor.SetSkipOnPanic(true)
+
+	slow := newSleepingPolicy(t, 10*time.Second, true)
+	_, err := executor.ExecuteRequestHeaderPolicies(context.Background(), []policy.Policy{slow}, newHeaderContext(),
+		[]policy.PolicySpec{newPolicySpec("slow", "v1.0.0", true, nil)}, "api", "route", false)
+	require.ErrorIs(t, err, ErrPolicyTimeout)
+}
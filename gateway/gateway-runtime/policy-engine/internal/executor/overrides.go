@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// maxPolicyOverridesFileBytes bounds the persisted overrides file.
+const maxPolicyOverridesFileBytes = 1 << 20
+
+// PolicyOverrides holds the policies an operator has disabled at runtime.
+// A disabled policy is skipped in every chain, whatever its configuration,
+// until the override is cleared. Overrides are kept apart from the policy
+// chains, so config pushes do not reset them. When a path is set they are
+// also written to that file and loaded again on startup.
+type PolicyOverrides struct {
+	mu       sync.RWMutex
+	disabled map[string]struct{}
+	path     string
+}
+
+// policyOverridesFile is the persisted form of PolicyOverrides.
+type policyOverridesFile struct {
+	DisabledPolicies []string `json:"disabled_policies"`
+}
+
+// NewPolicyOverrides creates the overrides store, loading the overrides
+// persisted at path. An empty path keeps the overrides in memory only.
+func NewPolicyOverrides(path string) (*PolicyOverrides, error) {
+	o := &PolicyOverrides{disabled: make(map[string]struct{}), path: path}
+	if path == "" {
+		return o, nil
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return o, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open policy overrides file: %w", err)
+	}
+	defer f.Close()
+
+	var persisted policyOverridesFile
+	if err := json.NewDecoder(io.LimitReader(f, maxPolicyOverridesFileBytes)).Decode(&persisted); err != nil {
+		return nil, fmt.Errorf("failed to read policy overrides file %s: %w", path, err)
+	}
+	for _, name := range persisted.DisabledPolicies {
+		o.disabled[name] = struct{}{}
+	}
+	return o, nil
+}
+
+// IsDisabled reports whether the named policy has been disabled. It is safe
+// to call on a nil store.
+func (o *PolicyOverrides) IsDisabled(name string) bool {
+	if o == nil {
+		return false
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	_, disabled := o.disabled[name]
+	return disabled
+}
+
+// SetEnabled disables the named policy, or clears its override when enabled
+// is true, and persists the change. The change is not applied when it cannot
+// be persisted.
+func (o *PolicyOverrides) SetEnabled(name string, enabled bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, disabled := o.disabled[name]
+	if disabled == !enabled {
+		return nil
+	}
+
+	next := make(map[string]struct{}, len(o.disabled)+1)
+	for n := range o.disabled {
+		next[n] = struct{}{}
+	}
+	if enabled {
+		delete(next, name)
+	} else {
+		next[name] = struct{}{}
+	}
+	if err := o.persist(next); err != nil {
+		return err
+	}
+	o.disabled = next
+	return nil
+}
+
+// Disabled returns the names of the disabled policies, sorted.
+func (o *PolicyOverrides) Disabled() []string {
+	if o == nil {
+		return []string{}
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return sortedNames(o.disabled)
+}
+
+// persist writes disabled to the overrides file, replacing it atomically.
+func (o *PolicyOverrides) persist(disabled map[string]struct{}) error {
+	if o.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(policyOverridesFile{DisabledPolicies: sortedNames(disabled)}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode policy overrides: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(o.path), filepath.Base(o.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write policy overrides file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write policy overrides file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write policy overrides file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), o.path); err != nil {
+		return fmt.Errorf("failed to write policy overrides file: %w", err)
+	}
+	return nil
+}
+
+func sortedNames(set map[string]struct{}) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestExecuteRequestPolicies_OverrideDisabledPolicy_Skipped(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	overrides, err := NewPolicyOverrides("")
+	require.NoError(t, err)
+	executor.SetPolicyOverrides(overrides)
+	require.NoError(t, overrides.SetEnabled("header-1", false))
+
+	reqCtx := testutils.NewTestRequestContext()
+	policies := []policy.Policy{
+		&testutils.HeaderModifyingPolicy{Key: "x-header-1", Value: "value-1"},
+		&testutils.HeaderModifyingPolicy{Key: "x-header-2", Value: "value-2"},
+	}
+	specs := []policy.PolicySpec{
+		newPolicySpec("header-1", "v1.0.0", true, nil),
+		newPolicySpec("header-2", "v1.0.0", true, nil),
+	}
+
+	result, err := executor.ExecuteRequestPolicies(context.Background(), policies, reqCtx, specs, "api", "route", false)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.True(t, result.Results[0].Skipped)
+	assert.False(t, result.Results[1].Skipped)
+	assert.Empty(t, reqCtx.Headers.Get("x-header-1"))
+	assert.Equal(t, []string{"value-2"}, reqCtx.Headers.Get("x-header-2"))
+
+	// Clearing the override runs the policy again.
+	require.NoError(t, overrides.SetEnabled("header-1", true))
+	reqCtx = testutils.NewTestRequestContext()
+	result, err = executor.ExecuteRequestPolicies(context.Background(), policies, reqCtx, specs, "api", "route", false)
+	require.NoError(t, err)
+	assert.False(t, result.Results[0].Skipped)
+	assert.Equal(t, []string{"value-1"}, reqCtx.Headers.Get("x-header-1"))
+}
+
+func TestExecuteRequestHeaderPolicies_OverrideDisabledPolicy_Skipped(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	overrides, err := NewPolicyOverrides("")
+	require.NoError(t, err)
+	executor.SetPolicyOverrides(overrides)
+	require.NoError(t, overrides.SetEnabled("counting", false))
+
+	pol := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	reqCtx := &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+		Path:          "/test",
+		Method:        "GET",
+	}
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(), []policy.Policy{pol}, reqCtx,
+		[]policy.PolicySpec{newPolicySpec("counting", "v1.0.0", true, nil)}, "api", "route", false)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.True(t, result.Results[0].Skipped)
+	assert.Zero(t, pol.calls)
+}
+
+func TestPolicyOverrides_Persisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy-overrides.json")
+
+	overrides, err := NewPolicyOverrides(path)
+	require.NoError(t, err)
+	assert.Empty(t, overrides.Disabled(), "a missing file means no overrides")
+
+	require.NoError(t, overrides.SetEnabled("guardrail-b", false))
+	require.NoError(t, overrides.SetEnabled("guardrail-a", false))
+	require.NoError(t, overrides.SetEnabled("guardrail-a", false))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	reloaded, err := NewPolicyOverrides(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guardrail-a", "guardrail-b"}, reloaded.Disabled())
+	assert.True(t, reloaded.IsDisabled("guardrail-a"))
+
+	require.NoError(t, reloaded.SetEnabled("guardrail-a", true))
+	reloaded, err = NewPolicyOverrides(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guardrail-b"}, reloaded.Disabled())
+}
+
+func TestPolicyOverrides_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy-overrides.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	_, err := NewPolicyOverrides(path)
+	assert.Error(t, err)
+}
+
+func TestPolicyOverrides_NilIsEmpty(t *testing.T) {
+	var overrides *PolicyOverrides
+	assert.False(t, overrides.IsDisabled("any"))
+	assert.Empty(t, overrides.Disabled())
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// Error types of the policy_execution_errors_total metric.
+const (
+	policyErrorCondition = "condition_error"
+	policyErrorParams    = "params_error"
+	policyErrorPanic     = "panic"
+	policyErrorTimeout   = "timeout"
+	policyErrorCanceled  = "canceled"
+)
+
+// SetPolicyRouteLabel sets whether the per-policy execution metrics carry the
+// route. Routes can be numerous, so the label is left empty unless enabled.
+func (c *ChainExecutor) SetPolicyRouteLabel(enabled bool) {
+	c.policyRouteLabel = enabled
+}
+
+// metricsRoute returns the route label value of the per-policy metrics.
+func (c *ChainExecutor) metricsRoute(route string) string {
+	if !c.policyRouteLabel {
+		return ""
+	}
+	return route
+}
+
+func (c *ChainExecutor) recordPolicyDuration(spec policy.PolicySpec, route string, d time.Duration) {
+	metrics.PolicyExecutionDurationSeconds.WithLabelValues(spec.Name, spec.Version, c.metricsRoute(route)).Observe(d.Seconds())
+}
+
+func (c *ChainExecutor) recordPolicyError(spec policy.PolicySpec, route, errorType string) {
+	metrics.PolicyExecutionErrorsTotal.WithLabelValues(spec.Name, spec.Version, c.metricsRoute(route), errorType).Inc()
+}
+
+func (c *ChainExecutor) recordPolicyShortCircuit(spec policy.PolicySpec, route string) {
+	metrics.PolicyShortCircuitsTotal.WithLabelValues(spec.Name, spec.Version, c.metricsRoute(route)).Inc()
+}
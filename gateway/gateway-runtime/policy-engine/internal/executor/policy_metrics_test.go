@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// recordedMetrics counts the samples recorded per label set. Metrics are
+// disabled in this package's tests, so the policy metrics are swapped for
+// recorders.
+type recordedMetrics struct {
+	mu      sync.Mutex
+	samples map[string]int
+}
+
+func (r *recordedMetrics) record(labels []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples[strings.Join(labels, ",")]++
+}
+
+func (r *recordedMetrics) count(labels ...string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.samples[strings.Join(labels, ",")]
+}
+
+type recordingCounterVec struct{ *recordedMetrics }
+
+func (v recordingCounterVec) WithLabelValues(labels ...string) metrics.Counter {
+	return recordingSample{v.recordedMetrics, labels}
+}
+
+func (v recordingCounterVec) With(prometheus.Labels) metrics.Counter {
+	panic("not implemented")
+}
+
+type recordingHistogramVec struct{ *recordedMetrics }
+
+func (v recordingHistogramVec) WithLabelValues(labels ...string) metrics.Histogram {
+	return recordingSample{v.recordedMetrics, labels}
+}
+
+func (v recordingHistogramVec) With(prometheus.Labels) metrics.Histogram {
+	panic("not implemented")
+}
+
+type recordingSample struct {
+	*recordedMetrics
+	labels []string
+}
+
+func (s recordingSample) Inc()            { s.record(s.labels) }
+func (s recordingSample) Add(float64)     { s.record(s.labels) }
+func (s recordingSample) Observe(float64) { s.record(s.labels) }
+
+// recordPolicyMetrics swaps the per-policy metrics for recorders for the
+// duration of the test.
+func recordPolicyMetrics(t *testing.T) (durations, errors, shortCircuits *recordedMetrics) {
+	t.Helper()
+	origDuration := metrics.PolicyExecutionDurationSeconds
+	origErrors := metrics.PolicyExecutionErrorsTotal
+	origShortCircuits := metrics.PolicyShortCircuitsTotal
+	t.Cleanup(func() {
+		metrics.PolicyExecutionDurationSeconds = origDuration
+		metrics.PolicyExecutionErrorsTotal = origErrors
+		metrics.PolicyShortCircuitsTotal = origShortCircuits
+	})
+
+	durations = &recordedMetrics{samples: map[string]int{}}
+	errors = &recordedMetrics{samples: map[string]int{}}
+	shortCircuits = &recordedMetrics{samples: map[string]int{}}
+	metrics.PolicyExecutionDurationSeconds = recordingHistogramVec{durations}
+	metrics.PolicyExecutionErrorsTotal = recordingCounterVec{errors}
+	metrics.PolicyShortCircuitsTotal = recordingCounterVec{shortCircuits}
+	return durations, errors, shortCircuits
+}
+
+type panickingRequestHeaderPolicy struct{}
+
+func (p *panickingRequestHeaderPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *panickingRequestHeaderPolicy) OnRequestHeaders(_ context.Context, _ *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	panic("policy bug")
+}
+
+func TestExecuteRequestHeaderPolicies_FailingPolicy_RecordsMetrics(t *testing.T) {
+	durations, errors, _ := recordPolicyMetrics(t)
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+
+	next := &countingRequestHeaderPolicy{mode: policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}}
+	reqCtx := &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+		Path:          "/test",
+		Method:        "GET",
+	}
+	specs := []policy.PolicySpec{
+		newPolicySpec("failing", "v1.0.0", true, nil),
+		newPolicySpec("next", "v1.0.0", true, nil),
+	}
+
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(),
+		[]policy.Policy{&panickingRequestHeaderPolicy{}, next}, reqCtx, specs, "api", "route", false)
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failing:v1.0.0")
+	assert.Zero(t, next.calls, "the chain stops at the failing policy")
+
+	// The route label is left empty unless enabled.
+	assert.Equal(t, 1, durations.count("failing", "v1.0.0", ""))
+	assert.Equal(t, 1, errors.count("failing", "v1.0.0", "", policyErrorPanic))
+	assert.Zero(t, durations.count("next", "v1.0.0", ""))
+}
+
+func TestExecuteRequestPolicies_ShortCircuit_RecordsMetricsWithRoute(t *testing.T) {
+	durations, errors, shortCircuits := recordPolicyMetrics(t)
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetPolicyRouteLabel(true)
+
+	policies := []policy.Policy{
+		&testutils.HeaderModifyingPolicy{Key: "x-header", Value: "value"},
+		&testutils.ShortCircuitingPolicy{StatusCode: 403},
+	}
+	specs := []policy.PolicySpec{
+		newPolicySpec("header", "v1.0.0", true, nil),
+		newPolicySpec("deny", "v2.0.0", true, nil),
+	}
+
+	result, err := executor.ExecuteRequestPolicies(context.Background(), policies,
+		testutils.NewTestRequestContext(), specs, "api", "route-a", false)
+	require.NoError(t, err)
+	assert.True(t, result.ShortCircuited)
+
+	assert.Equal(t, 1, durations.count("header", "v1.0.0", "route-a"))
+	assert.Equal(t, 1, durations.count("deny", "v2.0.0", "route-a"))
+	assert.Equal(t, 1, shortCircuits.count("deny", "v2.0.0", "route-a"))
+	assert.Zero(t, shortCircuits.count("header", "v1.0.0", "route-a"))
+	assert.Empty(t, errors.samples)
+}
@@ -36,6 +36,7 @@ const (
 	OperationPathKey   = Wso2MetadataPrefix + "operation-path"
 	APIKindKey         = Wso2MetadataPrefix + "api-kind"
 	ProjectIDKey       = Wso2MetadataPrefix + "project-id"
+	CorrelationIDKey   = Wso2MetadataPrefix + "correlation-id"
 )
 
 // convertToStructValue converts a value to structpb.Value, handling complex types like map[string][]string
@@ -97,6 +98,9 @@ func buildAnalyticsStruct(analyticsData map[string]any, execCtx *PolicyExecution
 			fields[ProjectIDKey] = structpb.NewStringValue(sharedCtx.ProjectID)
 		}
 	}
+	if execCtx != nil && execCtx.correlationID != "" {
+		fields[CorrelationIDKey] = structpb.NewStringValue(execCtx.correlationID)
+	}
 
 	return &structpb.Struct{Fields: fields}, nil
 }
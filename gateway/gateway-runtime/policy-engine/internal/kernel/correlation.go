@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"log/slog"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+)
+
+// CorrelationIDHeader carries the correlation ID shared by the gateway
+// controller, the policy engine and the upstream. Envoy lower-cases header
+// names, so this matches the controller's X-Correlation-ID.
+const CorrelationIDHeader = "x-correlation-id"
+
+// maxCorrelationIDLength bounds client-supplied correlation IDs.
+const maxCorrelationIDLength = 128
+
+// isValidCorrelationID reports whether a client-supplied correlation ID can be
+// logged and forwarded as-is: non-empty, bounded and visible ASCII only.
+func isValidCorrelationID(id string) bool {
+	if id == "" || len(id) > maxCorrelationIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// log returns the request-scoped logger, which tags every line with the
+// request's correlation ID. Falls back to the default logger before the
+// request headers have been processed or when there is no execution context.
+func (ec *PolicyExecutionContext) log() *slog.Logger {
+	if ec == nil || ec.logger == nil {
+		return slog.Default()
+	}
+	return ec.logger
+}
+
+// addCorrelationIDHeader sets the correlation ID header on the request sent
+// upstream when the client did not supply one. A value set by a policy is kept.
+func (ec *PolicyExecutionContext) addCorrelationIDHeader(resp *extprocv3.ProcessingResponse) {
+	if ec.correlationIDFromClient || ec.correlationID == "" {
+		return
+	}
+	headersResp, ok := resp.GetResponse().(*extprocv3.ProcessingResponse_RequestHeaders)
+	if !ok || headersResp.RequestHeaders == nil {
+		return
+	}
+	if headersResp.RequestHeaders.Response == nil {
+		headersResp.RequestHeaders.Response = &extprocv3.CommonResponse{}
+	}
+	common := headersResp.RequestHeaders.Response
+	if common.HeaderMutation == nil {
+		common.HeaderMutation = &extprocv3.HeaderMutation{}
+	}
+	for _, h := range common.HeaderMutation.SetHeaders {
+		if h.GetHeader().GetKey() == CorrelationIDHeader {
+			return
+		}
+	}
+	common.HeaderMutation.SetHeaders = append(common.HeaderMutation.SetHeaders, &corev3.HeaderValueOption{
+		Header: &corev3.HeaderValue{
+			Key:      CorrelationIDHeader,
+			RawValue: []byte(ec.correlationID),
+		},
+		AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	})
+}
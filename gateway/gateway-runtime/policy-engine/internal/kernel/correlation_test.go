@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+)
+
+// processRequestHeaders runs a single request-headers exchange through Process.
+func processRequestHeaders(t *testing.T, route string, extra ...*corev3.HeaderValue) *extprocv3.ProcessingResponse {
+	t.Helper()
+	server := newStreamingTestServer(t, route)
+	req := buildRequestHeadersProcessingRequest(route)
+	headers := req.GetRequestHeaders().Headers
+	headers.Headers = append(headers.Headers, extra...)
+
+	stream := newLiveStream()
+	stream.serve(t, server)
+	return stream.exchange(t, req)
+}
+
+// upstreamCorrelationID returns the correlation header set on the upstream request, if any.
+func upstreamCorrelationID(resp *extprocv3.ProcessingResponse) (string, bool) {
+	for _, h := range resp.GetRequestHeaders().GetResponse().GetHeaderMutation().GetSetHeaders() {
+		if h.GetHeader().GetKey() == CorrelationIDHeader {
+			return string(h.GetHeader().GetRawValue()), true
+		}
+	}
+	return "", false
+}
+
+// analyticsCorrelationID returns the correlation ID recorded in the analytics metadata.
+func analyticsCorrelationID(resp *extprocv3.ProcessingResponse) string {
+	analytics := resp.GetDynamicMetadata().GetFields()[constants.ExtProcFilterName].GetStructValue().
+		GetFields()["analytics_data"].GetStructValue()
+	return analytics.GetFields()[CorrelationIDKey].GetStringValue()
+}
+
+func TestProcess_CorrelationIDGeneratedAndSentUpstream(t *testing.T) {
+	resp := processRequestHeaders(t, "correlated-route")
+
+	id, ok := upstreamCorrelationID(resp)
+	require.True(t, ok, "correlation header not injected")
+	assert.Equal(t, "bench-req-id-12345", id, "falls back to the request ID")
+	assert.Equal(t, id, analyticsCorrelationID(resp))
+}
+
+func TestProcess_ClientCorrelationIDPropagated(t *testing.T) {
+	resp := processRequestHeaders(t, "correlated-route",
+		&corev3.HeaderValue{Key: CorrelationIDHeader, RawValue: []byte("client-corr-1")})
+
+	_, ok := upstreamCorrelationID(resp)
+	assert.False(t, ok, "a client-supplied header already reaches the upstream")
+	assert.Equal(t, "client-corr-1", analyticsCorrelationID(resp))
+}
+
+func TestProcess_InvalidClientCorrelationIDReplaced(t *testing.T) {
+	resp := processRequestHeaders(t, "correlated-route",
+		&corev3.HeaderValue{Key: CorrelationIDHeader, RawValue: []byte("bad id\nwith newline")})
+
+	id, ok := upstreamCorrelationID(resp)
+	require.True(t, ok)
+	assert.Equal(t, "bench-req-id-12345", id)
+}
+
+func TestExecutionContext_LoggerCarriesCorrelationID(t *testing.T) {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	ec := newDownstreamTestExecCtx()
+	assert.Same(t, slog.Default(), ec.log(), "default logger before request headers")
+
+	ec.buildRequestContexts(httpHeaders([2]string{CorrelationIDHeader, "corr-42"}), RouteMetadata{})
+	ec.log().Info("policy executed")
+
+	assert.Contains(t, buf.String(), "correlation_id=corr-42")
+}
+
+func TestIsValidCorrelationID(t *testing.T) {
+	assert.True(t, isValidCorrelationID("3f2a-9c1e"))
+	assert.True(t, isValidCorrelationID(strings.Repeat("a", maxCorrelationIDLength)))
+	assert.False(t, isValidCorrelationID(""))
+	assert.False(t, isValidCorrelationID(strings.Repeat("a", maxCorrelationIDLength+1)))
+	assert.False(t, isValidCorrelationID("has space"))
+	assert.False(t, isValidCorrelationID("line\nbreak"))
+	assert.False(t, isValidCorrelationID("café"))
+}
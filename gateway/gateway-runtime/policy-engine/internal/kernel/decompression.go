@@ -21,6 +21,7 @@ package kernel
 import (
 	"bytes"
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"runtime"
@@ -28,23 +29,45 @@ import (
 	"github.com/andybalholm/brotli"
 )
 
+// errBodyTooLarge is returned by decompressBodyLimited when the decompressed body
+// exceeds the limit.
+var errBodyTooLarge = errors.New("decompressed body exceeds the size limit")
+
 // decompressBody decompresses body bytes based on the Content-Encoding value.
 // Supported encodings: "gzip", "br" (Brotli). Unknown encodings are returned as-is.
 func decompressBody(body []byte, encoding string) ([]byte, error) {
+	return decompressBodyLimited(body, encoding, 0)
+}
+
+// decompressBodyLimited is decompressBody with an upper bound on the decompressed
+// size, so a small compressed payload cannot expand past the route's body limit.
+// A limit of zero or less means no bound.
+func decompressBodyLimited(body []byte, encoding string, limit int64) ([]byte, error) {
+	var r io.Reader
 	switch encoding {
 	case "gzip":
-		r, err := gzip.NewReader(bytes.NewReader(body))
+		gr, err := gzip.NewReader(bytes.NewReader(body))
 		if err != nil {
 			return nil, fmt.Errorf("gzip reader: %w", err)
 		}
-		defer r.Close()
-		return io.ReadAll(r)
+		defer gr.Close()
+		r = gr
 	case "br":
-		r := brotli.NewReader(bytes.NewReader(body))
-		return io.ReadAll(r)
+		r = brotli.NewReader(bytes.NewReader(body))
 	default:
 		return body, nil
 	}
+	if limit <= 0 {
+		return io.ReadAll(r)
+	}
+	out, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(out)) > limit {
+		return nil, errBodyTooLarge
+	}
+	return out, nil
 }
 
 // streamDecompressor provides true per-chunk streaming decompression using an io.Pipe
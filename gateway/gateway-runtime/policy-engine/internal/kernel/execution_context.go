@@ -19,7 +19,9 @@
 package kernel
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -69,6 +71,10 @@ type PolicyExecutionContext struct {
 	// path, via Response*Context.Downstream.
 	downstreamHeaders *policy.Headers
 
+	// clientIP is the downstream peer IP taken from Envoy's source.address
+	// request attribute. Exposed to policies via *Context.Downstream.ClientIP.
+	clientIP string
+
 	// Policy chain for this request
 	policyChain *registry.PolicyChain
 
@@ -78,6 +84,16 @@ type PolicyExecutionContext struct {
 	// Request ID for correlation
 	requestID string
 
+	// correlationID ties the policy engine's log lines for this request to the
+	// controller and upstream logs. Taken from the x-correlation-id request
+	// header, falling back to the request ID.
+	correlationID string
+	// correlationIDFromClient is set when the client supplied the correlation
+	// ID, in which case it already reaches the upstream unchanged.
+	correlationIDFromClient bool
+	// logger is the request-scoped logger tagged with correlationID.
+	logger *slog.Logger
+
 	// Analytics metadata to be shared across request and response phases.
 	// Used internally to propagate analytics data between phases without
 	// contaminating the policy-visible metadata map.
@@ -125,9 +141,25 @@ type PolicyExecutionContext struct {
 	// request bodies. Nil when the request is not Content-Encoded.
 	requestStreamDecomp *streamDecompressor
 
+	// isUpgradeRequest is set when the route allows a connection upgrade and the client
+	// asks for it. The upgraded connection carries frames rather than a request or
+	// response body, so body phases are never requested and body policies are skipped.
+	isUpgradeRequest bool
+
+	// maxRequestBytes and maxResponseBytes are the route's per-API body limits (0 = none).
+	// Bodies read by policies are checked against them so an oversized payload is
+	// rejected instead of being buffered or decompressed in the engine.
+	maxRequestBytes  int64
+	maxResponseBytes int64
+	// requestBodyBytes counts the raw bytes of a streamed request body seen so far.
+	requestBodyBytes int64
+
 	// isStreamingResponse is set to true during response headers processing when
 	// streaming indicators are detected AND the policy chain supports streaming.
-	isStreamingResponse   bool
+	isStreamingResponse bool
+	// isEventStreamResponse is set when the streamed upstream response is
+	// server-sent events; chunks are then handed to policies in whole events.
+	isEventStreamResponse bool
 	streamAccumulator     []byte
 	responseStreamContext *policy.ResponseStreamContext
 	// responseStreamDecomp performs per-chunk decompression for compressed streaming
@@ -169,7 +201,7 @@ func (ec *PolicyExecutionContext) handlePolicyError(
 ) *extprocv3.ProcessingResponse {
 	errorID := uuid.New().String()
 
-	slog.ErrorContext(ctx, "Policy execution failed",
+	ec.log().ErrorContext(ctx, "Policy execution failed",
 		"error_id", errorID,
 		"request_id", ec.requestID,
 		"phase", phase,
@@ -195,6 +227,46 @@ func (ec *PolicyExecutionContext) handlePolicyError(
 	}
 }
 
+// bodyTooLarge reports whether size exceeds the given per-API limit (0 = no limit).
+func bodyTooLarge(size, limit int64) bool {
+	return limit > 0 && size > limit
+}
+
+// bodyTooLargeResponse rejects a request or response whose body exceeds the route's
+// per-API limit. Oversized requests get 413; an oversized upstream response is not
+// the client's fault, so it is reported as 502.
+func (ec *PolicyExecutionContext) bodyTooLargeResponse(
+	ctx context.Context,
+	phase string,
+	limit int64,
+) *extprocv3.ProcessingResponse {
+	status := typev3.StatusCode_PayloadTooLarge
+	body := `{"error":"Payload Too Large"}`
+	if phase == "response_body" {
+		status = typev3.StatusCode_BadGateway
+		body = `{"error":"Bad Gateway"}`
+	}
+
+	ec.log().WarnContext(ctx, "Body exceeds the configured size limit",
+		"request_id", ec.requestID,
+		"phase", phase,
+		"route_key", ec.routeKey,
+		"limit_bytes", limit,
+	)
+
+	return &extprocv3.ProcessingResponse{
+		Response: &extprocv3.ProcessingResponse_ImmediateResponse{
+			ImmediateResponse: &extprocv3.ImmediateResponse{
+				Status: &typev3.HttpStatus{Code: status},
+				Headers: buildHeaderValueOptions(map[string]string{
+					"content-type": "application/json",
+				}),
+				Body: []byte(body),
+			},
+		},
+	}
+}
+
 // getModeOverride returns the ProcessingMode override for this execution context.
 // Response body is always set to BUFFERED here (never FULL_DUPLEX_STREAMED).
 // The upgrade to streaming happens at response-headers phase via
@@ -204,10 +276,10 @@ func (ec *PolicyExecutionContext) getModeOverride() *extprocconfigv3.ProcessingM
 		ResponseHeaderMode: extprocconfigv3.ProcessingMode_SEND,
 	}
 
-	if ec.policyChain.RequiresRequestBody {
+	if ec.requiresRequestBody() {
 		if ec.isStreamingRequest {
 			mode.RequestBodyMode = extprocconfigv3.ProcessingMode_FULL_DUPLEX_STREAMED
-			slog.Debug("[mode] upgraded request body mode to FULL_DUPLEX_STREAMED",
+			ec.log().Debug("[mode] upgraded request body mode to FULL_DUPLEX_STREAMED",
 				"route", ec.routeKey,
 			)
 		} else {
@@ -217,14 +289,14 @@ func (ec *PolicyExecutionContext) getModeOverride() *extprocconfigv3.ProcessingM
 		mode.RequestBodyMode = extprocconfigv3.ProcessingMode_NONE
 	}
 
-	if ec.policyChain.RequiresResponseBody {
+	if ec.requiresResponseBody() {
 		mode.ResponseBodyMode = extprocconfigv3.ProcessingMode_BUFFERED
 		if ec.isStreamingResponse && (ec.sharedCtx == nil || ec.sharedCtx.APIKind != policy.APIKindMCP) {
 			// Disable streaming for MCP APIs, as there is an issue with Envoy, when Upstream MCP server sends a Transfer Encoding Chunk
 			// response with empty body, Envoy is not sending a request to the Policy Engine.
 			// Hence skip MCP.
 			mode.ResponseBodyMode = extprocconfigv3.ProcessingMode_FULL_DUPLEX_STREAMED
-			slog.Debug("[mode] upgraded response body mode to FULL_DUPLEX_STREAMED",
+			ec.log().Debug("[mode] upgraded response body mode to FULL_DUPLEX_STREAMED",
 				"route", ec.routeKey,
 			)
 		} else {
@@ -245,11 +317,12 @@ func (ec *PolicyExecutionContext) getModeOverride() *extprocconfigv3.ProcessingM
 	mode.RequestTrailerMode = extprocconfigv3.ProcessingMode_SKIP
 	mode.ResponseTrailerMode = extprocconfigv3.ProcessingMode_SKIP
 
-	slog.Debug("[mode] getModeOverride",
+	ec.log().Debug("[mode] getModeOverride",
 		"phase", ec.phase,
 		"route", ec.routeKey,
 		"requires_request_body", ec.policyChain.RequiresRequestBody,
 		"requires_response_body", ec.policyChain.RequiresResponseBody,
+		"is_upgrade_request", ec.isUpgradeRequest,
 		"supports_response_streaming", ec.policyChain.SupportsResponseStreaming,
 		"is_streaming_request", ec.isStreamingRequest,
 		"request_body_mode", mode.RequestBodyMode.String(),
@@ -260,6 +333,18 @@ func (ec *PolicyExecutionContext) getModeOverride() *extprocconfigv3.ProcessingM
 	return mode
 }
 
+// requiresRequestBody reports whether request body policies run for this request.
+// They never run on an upgraded connection.
+func (ec *PolicyExecutionContext) requiresRequestBody() bool {
+	return ec.policyChain.RequiresRequestBody && !ec.isUpgradeRequest
+}
+
+// requiresResponseBody reports whether response body policies run for this request.
+// They never run on an upgraded connection.
+func (ec *PolicyExecutionContext) requiresResponseBody() bool {
+	return ec.policyChain.RequiresResponseBody && !ec.isUpgradeRequest
+}
+
 // ─── Phase processing methods ────────────────────────────────────────────────
 
 // processRequestHeaders processes request headers phase.
@@ -296,11 +381,16 @@ func (ec *PolicyExecutionContext) processRequestHeaders(
 
 	// For bodyless requests Envoy skips the RequestBody ext_proc phase entirely.
 	// Execute body policies inline now so they run on every request, receiving a nil body.
-	if !execResult.ShortCircuited && ec.policyChain.RequiresRequestBody && ec.requestHasNoBody() {
-		return ec.processRequestBodyForEmptyRequest(ctx, execResult)
+	var resp *extprocv3.ProcessingResponse
+	if !execResult.ShortCircuited && ec.requiresRequestBody() && ec.requestHasNoBody() {
+		resp, err = ec.processRequestBodyForEmptyRequest(ctx, execResult)
+	} else {
+		resp, err = TranslateRequestHeaderActions(execResult, ec.policyChain, ec)
 	}
-
-	return TranslateRequestHeaderActions(execResult, ec.policyChain, ec)
+	if err == nil {
+		ec.addCorrelationIDHeader(resp)
+	}
+	return resp, err
 }
 
 // responseHasNoBody returns true when the response carries no body and Envoy will not
@@ -354,7 +444,7 @@ func (ec *PolicyExecutionContext) processRequestBodyForEmptyRequest(
 		ec.requestBodyCtx.Body = &policy.Body{Content: nil, EndOfStream: true, Present: false}
 	}
 
-	slog.DebugContext(ctx, "[no-body] executing request body policies inline during headers phase",
+	ec.log().DebugContext(ctx, "[no-body] executing request body policies inline during headers phase",
 		"route", ec.routeKey,
 		"method", ec.requestHeaderCtx.Method,
 	)
@@ -385,7 +475,7 @@ func (ec *PolicyExecutionContext) processResponseBodyForEmptyResponse(
 	// Ensure the body context reflects a nil/absent body.
 	ec.responseBodyCtx.ResponseBody = &policy.Body{Content: nil, EndOfStream: true, Present: false}
 
-	slog.DebugContext(ctx, "[no-body] executing response body policies inline during response-headers phase",
+	ec.log().DebugContext(ctx, "[no-body] executing response body policies inline during response-headers phase",
 		"route", ec.routeKey,
 		"status", ec.responseHeaderCtx.ResponseStatus,
 	)
@@ -417,12 +507,19 @@ func (ec *PolicyExecutionContext) processRequestBody(
 	}
 
 	if ec.policyChain.RequiresRequestBody {
+		if bodyTooLarge(int64(len(body.Body)), ec.maxRequestBytes) {
+			return ec.bodyTooLargeResponse(ctx, "request_body", ec.maxRequestBytes), nil
+		}
+
 		// Decompress body if Content-Encoding was set, so policies receive plain bytes.
 		bodyContent := body.Body
 		if ec.requestContentEncoding != "" {
-			decompressed, err := decompressBody(body.Body, ec.requestContentEncoding)
+			decompressed, err := decompressBodyLimited(body.Body, ec.requestContentEncoding, ec.maxRequestBytes)
+			if errors.Is(err, errBodyTooLarge) {
+				return ec.bodyTooLargeResponse(ctx, "request_body", ec.maxRequestBytes), nil
+			}
 			if err != nil {
-				slog.Warn("Failed to decompress request body, passing raw bytes to policies",
+				ec.log().Warn("Failed to decompress request body, passing raw bytes to policies",
 					"request_id", ec.requestID,
 					"encoding", ec.requestContentEncoding,
 					"error", err,
@@ -469,6 +566,13 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 	ctx context.Context,
 	body *extprocv3.HttpBody,
 ) (*extprocv3.ProcessingResponse, error) {
+	// The request body is still in flight, so an oversized stream can be rejected
+	// outright before any more of it is handed to policies.
+	ec.requestBodyBytes += int64(len(body.Body))
+	if bodyTooLarge(ec.requestBodyBytes, ec.maxRequestBytes) {
+		return ec.bodyTooLargeResponse(ctx, "request_body", ec.maxRequestBytes), nil
+	}
+
 	chunk := &policy.StreamBody{
 		Chunk:       body.Body,
 		EndOfStream: body.EndOfStream,
@@ -483,7 +587,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 		}
 		decompressed, err := ec.requestStreamDecomp.FeedChunk(chunk.Chunk, chunk.EndOfStream)
 		if err != nil {
-			slog.Warn("[streaming] per-chunk request decompression error; disabling decompression",
+			ec.log().Warn("[streaming] per-chunk request decompression error; disabling decompression",
 				"request_id", ec.requestID,
 				"encoding", ec.requestContentEncoding,
 				"error", err,
@@ -512,7 +616,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 			}, nil
 		}
 
-		slog.Debug("[streaming] request chunk decompressed",
+		ec.log().Debug("[streaming] request chunk decompressed",
 			"route", ec.routeKey,
 			"decompressed_bytes", len(chunk.Chunk),
 			"end_of_stream", chunk.EndOfStream,
@@ -547,7 +651,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 		ec.requestStreamAccumulator = append(ec.requestStreamAccumulator, chunk.Chunk...)
 	}
 
-	slog.Debug("[streaming] request chunk received",
+	ec.log().Debug("[streaming] request chunk received",
 		"route", ec.routeKey,
 		"chunk_bytes", len(chunk.Chunk),
 		"accumulated_bytes", len(ec.requestStreamAccumulator),
@@ -556,7 +660,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 
 	shouldForceFlush := len(ec.requestStreamAccumulator) >= maxStreamAccumulatorSize
 	if shouldForceFlush {
-		slog.Warn("[streaming] request accumulator size limit exceeded, forcing flush",
+		ec.log().Warn("[streaming] request accumulator size limit exceeded, forcing flush",
 			"route", ec.routeKey,
 			"accumulated_bytes", len(ec.requestStreamAccumulator),
 			"max_size", maxStreamAccumulatorSize,
@@ -566,8 +670,8 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 	// Consult streaming policies to decide whether to flush now.
 	// In FULL_DUPLEX_STREAMED mode an empty BodyResponse passes the chunk through unchanged,
 	// so we must explicitly suppress it with an empty StreamedBodyResponse while accumulating.
-	if !chunk.EndOfStream && !shouldForceFlush && ec.anyPolicyNeedsMoreRequestData(ec.requestStreamAccumulator) {
-		slog.Debug("[streaming] accumulating — waiting for more request data",
+	if !chunk.EndOfStream && !shouldForceFlush && ec.anyPolicyNeedsMoreRequestData(ctx, ec.requestStreamAccumulator) {
+		ec.log().Debug("[streaming] accumulating — waiting for more request data",
 			"route", ec.routeKey,
 			"accumulated_bytes", len(ec.requestStreamAccumulator),
 		)
@@ -590,7 +694,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 		Chunk:       ec.requestStreamAccumulator,
 		EndOfStream: chunk.EndOfStream,
 	}
-	slog.Debug("[streaming] flushing accumulated request data to policies",
+	ec.log().Debug("[streaming] flushing accumulated request data to policies",
 		"route", ec.routeKey,
 		"flush_bytes", len(flushChunk.Chunk),
 		"end_of_stream", flushChunk.EndOfStream,
@@ -641,7 +745,7 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 	// Detect streaming response: upgrade when chain supports streaming AND
 	// upstream signals chunked/SSE AND body is coming (not EndOfStream).
 	hasStreamingHeaders := isStreamingUpstreamResponse(ec.responseHeaderCtx.ResponseHeaders)
-	slog.Debug("[mode] response headers received — streaming detection",
+	ec.log().Debug("[mode] response headers received — streaming detection",
 		"route", ec.routeKey,
 		"supports_response_streaming", ec.policyChain.SupportsResponseStreaming,
 		"headers_end_of_stream", headers.EndOfStream,
@@ -651,8 +755,9 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 	)
 	if ec.policyChain.SupportsResponseStreaming && !headers.EndOfStream && hasStreamingHeaders {
 		ec.isStreamingResponse = true
+		ec.isEventStreamResponse = isEventStream(ec.responseHeaderCtx.ResponseHeaders)
 	}
-	slog.Debug("[mode] streaming response decision",
+	ec.log().Debug("[mode] streaming response decision",
 		"route", ec.routeKey,
 		"is_streaming_response", ec.isStreamingResponse,
 	)
@@ -676,7 +781,7 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 
 	// For bodyless responses Envoy skips the ResponseBody ext_proc phase entirely.
 	// Execute body policies inline now so they run on every response, receiving a nil body.
-	if !execResult.ShortCircuited && ec.policyChain.RequiresResponseBody && ec.responseHasNoBody() {
+	if !execResult.ShortCircuited && ec.requiresResponseBody() && ec.responseHasNoBody() {
 		return ec.processResponseBodyForEmptyResponse(ctx, execResult)
 	}
 
@@ -695,26 +800,33 @@ func (ec *PolicyExecutionContext) processResponseBody(
 ) (*extprocv3.ProcessingResponse, error) {
 	ec.phase = phaseResponseBody
 	if ec.isStreamingResponse {
-		slog.Debug("[body] routing to streaming response body handler",
+		ec.log().Debug("[body] routing to streaming response body handler",
 			"route", ec.routeKey,
 			"chunk_bytes", len(body.Body),
 			"end_of_stream", body.EndOfStream,
 		)
 		return ec.processStreamingResponseBody(ctx, body)
 	}
-	slog.Debug("[body] routing to buffered response body handler",
+	ec.log().Debug("[body] routing to buffered response body handler",
 		"route", ec.routeKey,
 		"body_bytes", len(body.Body),
 		"end_of_stream", body.EndOfStream,
 	)
 
 	if ec.policyChain.RequiresResponseBody {
+		if bodyTooLarge(int64(len(body.Body)), ec.maxResponseBytes) {
+			return ec.bodyTooLargeResponse(ctx, "response_body", ec.maxResponseBytes), nil
+		}
+
 		// Decompress body if Content-Encoding was set, so policies receive plain JSON.
 		bodyContent := body.Body
 		if ec.responseContentEncoding != "" {
-			decompressed, err := decompressBody(body.Body, ec.responseContentEncoding)
+			decompressed, err := decompressBodyLimited(body.Body, ec.responseContentEncoding, ec.maxResponseBytes)
+			if errors.Is(err, errBodyTooLarge) {
+				return ec.bodyTooLargeResponse(ctx, "response_body", ec.maxResponseBytes), nil
+			}
 			if err != nil {
-				slog.Warn("Failed to decompress response body, passing raw bytes to policies",
+				ec.log().Warn("Failed to decompress response body, passing raw bytes to policies",
 					"request_id", ec.requestID,
 					"encoding", ec.responseContentEncoding,
 					"error", err,
@@ -766,7 +878,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 	// downstream — suppress them with an empty streamed response so we do not attempt
 	// to write to a closed downstream connection.
 	if ec.streamTerminated {
-		slog.Warn("[streaming] received upstream chunk after stream was already terminated; suppressing",
+		ec.log().Warn("[streaming] received upstream chunk after stream was already terminated; suppressing",
 			"route", ec.routeKey,
 			"chunk_bytes", len(body.Body),
 			"end_of_stream", body.EndOfStream,
@@ -800,7 +912,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 		}
 		decompressed, err := ec.responseStreamDecomp.FeedChunk(chunk.Chunk, chunk.EndOfStream)
 		if err != nil {
-			slog.Warn("[streaming] per-chunk response decompression error; disabling decompression",
+			ec.log().Warn("[streaming] per-chunk response decompression error; disabling decompression",
 				"request_id", ec.requestID,
 				"encoding", ec.responseContentEncoding,
 				"error", err,
@@ -831,7 +943,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 			}, nil
 		}
 
-		slog.Debug("[streaming] response chunk decompressed",
+		ec.log().Debug("[streaming] response chunk decompressed",
 			"route", ec.routeKey,
 			"decompressed_bytes", len(chunk.Chunk),
 			"end_of_stream", chunk.EndOfStream,
@@ -862,7 +974,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 		ec.streamAccumulator = append(ec.streamAccumulator, chunk.Chunk...)
 	}
 
-	slog.Debug("[streaming] response chunk received",
+	ec.log().Debug("[streaming] response chunk received",
 		"route", ec.routeKey,
 		"chunk_bytes", len(chunk.Chunk),
 		"accumulated_bytes", len(ec.streamAccumulator),
@@ -871,16 +983,31 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 
 	shouldForceFlush := len(ec.streamAccumulator) >= maxStreamAccumulatorSize
 	if shouldForceFlush {
-		slog.Warn("[streaming] response accumulator size limit exceeded, forcing flush",
+		ec.log().Warn("[streaming] response accumulator size limit exceeded, forcing flush",
 			"route", ec.routeKey,
 			"accumulated_bytes", len(ec.streamAccumulator),
 			"max_size", maxStreamAccumulatorSize,
 		)
 	}
 
+	// Server-sent events are flushed up to the last complete event; a partial
+	// event at the end is held back until the rest of it arrives, so policies
+	// always operate on whole events. Clients only dispatch complete events, so
+	// holding back the tail adds no latency.
+	pending := ec.streamAccumulator
+	var partial []byte
+	if ec.isEventStreamResponse && !chunk.EndOfStream && !shouldForceFlush {
+		cut := lastEventBoundary(ec.streamAccumulator)
+		pending, partial = ec.streamAccumulator[:cut], ec.streamAccumulator[cut:]
+	}
+
 	// Consult streaming policies to decide whether to flush now.
-	if !chunk.EndOfStream && !shouldForceFlush && ec.anyPolicyNeedsMoreResponseData(ec.streamAccumulator) {
-		slog.Debug("[streaming] accumulating — waiting for more response data",
+	waiting := ec.isEventStreamResponse && len(pending) == 0 && !chunk.EndOfStream
+	if !waiting && !chunk.EndOfStream && !shouldForceFlush {
+		waiting = ec.anyPolicyNeedsMoreResponseData(ctx, pending)
+	}
+	if waiting {
+		ec.log().Debug("[streaming] accumulating — waiting for more response data",
 			"route", ec.routeKey,
 			"accumulated_bytes", len(ec.streamAccumulator),
 		)
@@ -900,15 +1027,20 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 	}
 
 	flushChunk := &policy.StreamBody{
-		Chunk:       ec.streamAccumulator,
+		Chunk:       pending,
 		EndOfStream: chunk.EndOfStream,
 	}
-	slog.Debug("[streaming] flushing accumulated response data to policies",
+	ec.log().Debug("[streaming] flushing accumulated response data to policies",
 		"route", ec.routeKey,
 		"flush_bytes", len(flushChunk.Chunk),
+		"held_back_bytes", len(partial),
 		"end_of_stream", flushChunk.EndOfStream,
 	)
 	ec.streamAccumulator = nil
+	if len(partial) > 0 {
+		// Copy so the held-back bytes do not alias the chunk handed to policies.
+		ec.streamAccumulator = append([]byte(nil), partial...)
+	}
 
 	execResult, err := ec.server.executor.ExecuteStreamingResponsePolicies(
 		ctx,
@@ -944,7 +1076,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 // is populated later in processRequestBody when body data arrives.
 func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHeaders, routeMetadata RouteMetadata) {
 	headersMap := make(map[string][]string)
-	var path, method, authority, scheme, requestID string
+	var path, method, authority, scheme, requestID, correlationID string
 
 	if headers.Headers != nil {
 		for _, header := range headers.Headers.GetHeaders() {
@@ -965,6 +1097,10 @@ func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHe
 				if requestID == "" {
 					requestID = value
 				}
+			case CorrelationIDHeader:
+				if correlationID == "" {
+					correlationID = value
+				}
 			case "content-encoding":
 				ec.requestContentEncoding = value
 			}
@@ -995,6 +1131,12 @@ func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHe
 
 	ec.sharedCtx = sharedCtx
 	ec.requestID = requestID
+	ec.correlationIDFromClient = isValidCorrelationID(correlationID)
+	if !ec.correlationIDFromClient {
+		correlationID = requestID
+	}
+	ec.correlationID = correlationID
+	ec.logger = slog.Default().With("correlation_id", correlationID)
 
 	wrappedHeaders := policy.NewHeaders(headersMap)
 
@@ -1003,7 +1145,10 @@ func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHe
 	// place, so body/stream-phase validators need this pristine copy to inspect
 	// what the client actually sent.
 	ec.downstreamHeaders = cloneHeaders(wrappedHeaders)
-	downstream := &policy.DownstreamContext{Request: &policy.DownstreamRequest{Headers: ec.downstreamHeaders}}
+	downstream := &policy.DownstreamContext{
+		ClientIP: ec.clientIP,
+		Request:  &policy.DownstreamRequest{Headers: ec.downstreamHeaders},
+	}
 
 	ec.requestHeaderCtx = &policy.RequestHeaderContext{
 		SharedContext: sharedCtx,
@@ -1058,6 +1203,13 @@ func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHe
 	if ec.policyChain.SupportsRequestStreaming && isStreamingClientRequest(wrappedHeaders) {
 		ec.isStreamingRequest = true
 	}
+
+	if routeMetadata.UpgradeType != "" && isUpgradeRequest(wrappedHeaders, routeMetadata.UpgradeType) {
+		ec.isUpgradeRequest = true
+	}
+
+	ec.maxRequestBytes = routeMetadata.MaxRequestBytes
+	ec.maxResponseBytes = routeMetadata.MaxResponseBytes
 }
 
 // buildResponseContexts converts Envoy response headers and stored request state into
@@ -1079,7 +1231,7 @@ func (ec *PolicyExecutionContext) buildResponseContexts(headers *extprocv3.HttpH
 				// Convert status string to int
 				_, err := fmt.Sscanf(value, "%d", &responseStatus)
 				if err != nil {
-					slog.Warn("Failed to parse response status code",
+					ec.log().Warn("Failed to parse response status code",
 						"request_id", ec.requestID,
 						"status_value", value,
 						"error", err,
@@ -1095,7 +1247,10 @@ func (ec *PolicyExecutionContext) buildResponseContexts(headers *extprocv3.HttpH
 
 	// Downstream snapshot: the pristine client request headers captured at
 	// request time (ec.downstreamHeaders).
-	downstream := &policy.DownstreamContext{Request: &policy.DownstreamRequest{Headers: ec.downstreamHeaders}}
+	downstream := &policy.DownstreamContext{
+		ClientIP: ec.clientIP,
+		Request:  &policy.DownstreamRequest{Headers: ec.downstreamHeaders},
+	}
 
 	// Upstream: the route's resolved upstream target plus a snapshot of the
 	// original upstream response headers, captured before any response-header
@@ -1155,14 +1310,43 @@ func isStreamingClientRequest(headers *policy.Headers) bool {
 			return true
 		}
 	}
-	if ctValues := headers.Get("content-type"); len(ctValues) > 0 {
-		if strings.HasPrefix(strings.ToLower(ctValues[0]), "text/event-stream") {
-			return true
+	return isEventStream(headers)
+}
+
+// isUpgradeRequest reports whether the client asks to upgrade the connection to
+// upgradeType, e.g. "Upgrade: websocket".
+func isUpgradeRequest(headers *policy.Headers, upgradeType string) bool {
+	for _, v := range headers.Get("upgrade") {
+		for _, proto := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(proto), upgradeType) {
+				return true
+			}
 		}
 	}
 	return false
 }
 
+// isEventStream reports whether the response is a server-sent events stream.
+func isEventStream(headers *policy.Headers) bool {
+	if ctValues := headers.Get("content-type"); len(ctValues) > 0 {
+		return strings.HasPrefix(strings.ToLower(ctValues[0]), "text/event-stream")
+	}
+	return false
+}
+
+// lastEventBoundary returns the offset just past the last blank line that ends
+// a server-sent event in data, or 0 when data holds no complete event.
+func lastEventBoundary(data []byte) int {
+	cut := 0
+	if i := bytes.LastIndex(data, []byte("\n\n")); i >= 0 {
+		cut = i + 2
+	}
+	if i := bytes.LastIndex(data, []byte("\r\n\r\n")); i >= 0 && i+4 > cut {
+		cut = i + 4
+	}
+	return cut
+}
+
 // isStreamingUpstreamResponse detects if the upstream response is a streaming
 // response based on transfer-encoding: chunked or content-type: text/event-stream.
 func isStreamingUpstreamResponse(headers *policy.Headers) bool {
@@ -1305,12 +1489,12 @@ func applyResponseHeaderMutations(headers *policy.Headers, results []executor.Re
 // anyPolicyNeedsMoreRequestData returns true if any streaming request policy that
 // would actually execute (enabled and condition met) is not yet ready to process
 // the accumulated bytes.
-func (ec *PolicyExecutionContext) anyPolicyNeedsMoreRequestData(accumulated []byte) bool {
+func (ec *PolicyExecutionContext) anyPolicyNeedsMoreRequestData(ctx context.Context, accumulated []byte) bool {
 	specs := ec.policyChain.PolicySpecs
 	celEval := ec.server.executor.GetCELEvaluator()
 	for i, pol := range ec.policyChain.Policies {
 		spec := specs[i]
-		if !spec.Enabled {
+		if !ec.server.executor.PolicyEnabled(ctx, spec) {
 			continue
 		}
 		if ec.policyChain.HasExecutionConditions && spec.ExecutionCondition != nil && *spec.ExecutionCondition != "" {
@@ -1334,12 +1518,12 @@ func (ec *PolicyExecutionContext) anyPolicyNeedsMoreRequestData(accumulated []by
 // anyPolicyNeedsMoreResponseData returns true if any streaming response policy that
 // would actually execute (enabled and condition met) is not yet ready to process
 // the accumulated bytes.
-func (ec *PolicyExecutionContext) anyPolicyNeedsMoreResponseData(accumulated []byte) bool {
+func (ec *PolicyExecutionContext) anyPolicyNeedsMoreResponseData(ctx context.Context, accumulated []byte) bool {
 	specs := ec.policyChain.PolicySpecs
 	celEval := ec.server.executor.GetCELEvaluator()
 	for i, pol := range ec.policyChain.Policies {
 		spec := specs[i]
-		if !spec.Enabled {
+		if !ec.server.executor.PolicyEnabled(ctx, spec) {
 			continue
 		}
 		if ec.policyChain.HasExecutionConditions && spec.ExecutionCondition != nil && *spec.ExecutionCondition != "" {
@@ -1353,7 +1537,7 @@ func (ec *PolicyExecutionContext) anyPolicyNeedsMoreResponseData(accumulated []b
 		}
 		if sp, ok := pol.(policy.StreamingResponsePolicy); ok {
 			needs := sp.NeedsMoreResponseData(accumulated)
-			slog.Debug("[streaming] NeedsMoreResponseData",
+			ec.log().Debug("[streaming] NeedsMoreResponseData",
 				"route", ec.routeKey,
 				"policy", spec.Name,
 				"accumulated_bytes", len(accumulated),
@@ -19,12 +19,14 @@
 package kernel
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extprocconfigv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -175,6 +177,54 @@ func TestGetModeOverride_BothBodiesRequired(t *testing.T) {
 	assert.Equal(t, extprocconfigv3.ProcessingMode_BUFFERED, mode.ResponseBodyMode)
 }
 
+func TestGetModeOverride_UpgradeRequestSkipsBodies(t *testing.T) {
+	kernel := NewKernel()
+	chainExecutor := executor.NewChainExecutor(nil, nil, nil)
+	server := NewExternalProcessorServer(kernel, chainExecutor, config.TracingConfig{}, "")
+
+	chain := &registry.PolicyChain{
+		RequiresRequestBody:  true,
+		RequiresResponseBody: true,
+	}
+	headers := httpHeaders(
+		[2]string{":path", "/chat/socket"},
+		[2]string{":method", "GET"},
+		[2]string{"upgrade", "websocket"},
+	)
+
+	tests := []struct {
+		name        string
+		upgradeType string
+		wantBody    extprocconfigv3.ProcessingMode_BodySendMode
+	}{
+		{name: "upgrade on a websocket route", upgradeType: "websocket", wantBody: extprocconfigv3.ProcessingMode_NONE},
+		{name: "upgrade header on a plain route", wantBody: extprocconfigv3.ProcessingMode_BUFFERED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execCtx := newPolicyExecutionContext(server, "test-route", chain)
+			execCtx.buildRequestContexts(headers, RouteMetadata{UpgradeType: tt.upgradeType})
+			execCtx.phase = phaseRequestHeaders
+
+			mode := execCtx.getModeOverride()
+
+			assert.Equal(t, tt.wantBody, mode.RequestBodyMode)
+			assert.Equal(t, tt.wantBody, mode.ResponseBodyMode)
+		})
+	}
+}
+
+func TestIsUpgradeRequest(t *testing.T) {
+	headers := func(values ...string) *policy.Headers {
+		return policy.NewHeaders(map[string][]string{"upgrade": values})
+	}
+	assert.True(t, isUpgradeRequest(headers("websocket"), "websocket"))
+	assert.True(t, isUpgradeRequest(headers("WebSocket"), "websocket"))
+	assert.True(t, isUpgradeRequest(headers("h2c, websocket"), "websocket"))
+	assert.False(t, isUpgradeRequest(headers("h2c"), "websocket"))
+	assert.False(t, isUpgradeRequest(policy.NewHeaders(nil), "websocket"))
+}
+
 func TestGetModeOverride_ResponseHeaderProcessing(t *testing.T) {
 	kernel := NewKernel()
 	chainExecutor := executor.NewChainExecutor(nil, nil, nil)
@@ -791,6 +841,88 @@ func TestProcessRequestBody_DecompressesGzip(t *testing.T) {
 	assert.Equal(t, originalJSON, execCtx.requestBodyCtx.Body.Content)
 }
 
+func TestProcessBody_EnforcesRouteBodyLimits(t *testing.T) {
+	kernel := NewKernel()
+	server := NewExternalProcessorServer(kernel, newTestExecutor(), config.TracingConfig{}, "")
+
+	chain := &registry.PolicyChain{
+		RequiresRequestBody:  true,
+		RequiresResponseBody: true,
+		Policies:             []policy.Policy{&testutils.NoopPolicy{}},
+		PolicySpecs:          []policy.PolicySpec{{Enabled: true}},
+	}
+	body := []byte(`{"messages":[{"role":"user","content":"Hello"}]}`)
+
+	tests := []struct {
+		name       string
+		encoding   string
+		payload    []byte
+		limit      int64
+		response   bool
+		wantStatus typev3.StatusCode
+	}{
+		{name: "request within limit", payload: body, limit: int64(len(body))},
+		{name: "request over limit", payload: body, limit: 8, wantStatus: typev3.StatusCode_PayloadTooLarge},
+		{name: "compressed request expands past limit", encoding: "gzip", payload: gzipCompress(bytes.Repeat([]byte("a"), 4096)),
+			limit: 1024, wantStatus: typev3.StatusCode_PayloadTooLarge},
+		{name: "response within limit", payload: body, response: true},
+		{name: "response over limit", payload: body, limit: 8, response: true, wantStatus: typev3.StatusCode_BadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execCtx := newPolicyExecutionContext(server, "test-route", chain)
+			reqHeaders := []*corev3.HeaderValue{{Key: ":path", RawValue: []byte("/api/chat")}}
+			if tt.encoding != "" && !tt.response {
+				reqHeaders = append(reqHeaders, &corev3.HeaderValue{Key: "content-encoding", RawValue: []byte(tt.encoding)})
+			}
+			execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+				Headers: &corev3.HeaderMap{Headers: reqHeaders},
+			}, RouteMetadata{MaxRequestBytes: tt.limit, MaxResponseBytes: tt.limit})
+
+			var resp *extprocv3.ProcessingResponse
+			var err error
+			if tt.response {
+				execCtx.buildResponseContexts(&extprocv3.HttpHeaders{
+					Headers: &corev3.HeaderMap{Headers: []*corev3.HeaderValue{{Key: ":status", RawValue: []byte("200")}}},
+				})
+				resp, err = execCtx.processResponseBody(context.Background(), &extprocv3.HttpBody{Body: tt.payload, EndOfStream: true})
+			} else {
+				resp, err = execCtx.processRequestBody(context.Background(), &extprocv3.HttpBody{Body: tt.payload, EndOfStream: true})
+			}
+			require.NoError(t, err)
+
+			immediate := resp.GetImmediateResponse()
+			if tt.wantStatus == 0 {
+				assert.Nil(t, immediate, "body within the limit must reach the policies")
+				return
+			}
+			require.NotNil(t, immediate)
+			assert.Equal(t, tt.wantStatus, immediate.GetStatus().GetCode())
+		})
+	}
+}
+
+func TestProcessStreamingRequestBody_EnforcesRouteBodyLimit(t *testing.T) {
+	kernel := NewKernel()
+	server := NewExternalProcessorServer(kernel, newTestExecutor(), config.TracingConfig{}, "")
+
+	execCtx := newPolicyExecutionContext(server, "test-route", &registry.PolicyChain{})
+	execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+		Headers: &corev3.HeaderMap{Headers: []*corev3.HeaderValue{{Key: ":path", RawValue: []byte("/api/chat")}}},
+	}, RouteMetadata{MaxRequestBytes: 10})
+	execCtx.isStreamingRequest = true
+
+	resp, err := execCtx.processRequestBody(context.Background(), &extprocv3.HttpBody{Body: []byte("123456")})
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetImmediateResponse(), "first chunk is within the limit")
+
+	resp, err = execCtx.processRequestBody(context.Background(), &extprocv3.HttpBody{Body: []byte("789012"), EndOfStream: true})
+	require.NoError(t, err)
+	require.NotNil(t, resp.GetImmediateResponse())
+	assert.Equal(t, typev3.StatusCode_PayloadTooLarge, resp.GetImmediateResponse().GetStatus().GetCode())
+}
+
 func TestProcessRequestBody_NoEncoding_PassesThrough(t *testing.T) {
 	kernel := NewKernel()
 	server := NewExternalProcessorServer(kernel, newTestExecutor(), config.TracingConfig{}, "")
@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
+	"net/netip"
 	"time"
 
 	extprocconfigv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
@@ -79,12 +81,17 @@ func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_Pro
 	metrics.ActiveStreams.Inc()
 	defer metrics.ActiveStreams.Dec()
 
-	// Extract trace context and create span - NoOp if tracing disabled
+	// Extract trace context from the gRPC metadata. The span is started with
+	// the first message so that, when Envoy did not propagate a trace, the
+	// traceparent header of the HTTP request can be used instead.
 	traceCtx := tracing.ExtractTraceContext(stream.Context())
-	ctx, span := s.tracer.Start(traceCtx, constants.SpanExternalProcessingProcess,
-		trace.WithSpanKind(trace.SpanKindServer),
-	)
-	defer span.End()
+	ctx := traceCtx
+	var span trace.Span
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
 
 	// Execution context for this request-response lifecycle.
 	// Initialized lazily on first request headers phase via handleProcessingPhase.
@@ -104,24 +111,35 @@ func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_Pro
 			// This happens when Envoy closes the stream after completing the request
 			if errors.Is(err, context.Canceled) || status.Code(err) == grpccodes.Canceled {
 				// Log at debug level for visibility in troubleshooting
-				slog.DebugContext(ctx, "Stream closed due to context cancellation")
+				execCtx.log().DebugContext(ctx, "Stream closed due to context cancellation")
 				return nil
 			}
-			slog.ErrorContext(ctx, "Error receiving from stream", "error", err)
+			execCtx.log().ErrorContext(ctx, "Error receiving from stream", "error", err)
 			metrics.StreamErrorsTotal.WithLabelValues("receive").Inc()
 			return status.Errorf(grpccodes.Unknown, "failed to receive request: %v", err)
 		}
 
+		// Create the stream span - NoOp if tracing disabled
+		if span == nil {
+			parentCtx := traceCtx
+			if headers := req.GetRequestHeaders(); headers != nil && !trace.SpanContextFromContext(traceCtx).IsValid() {
+				parentCtx = tracing.ExtractHTTPTraceContext(traceCtx, headers.GetHeaders())
+			}
+			ctx, span = s.tracer.Start(parentCtx, constants.SpanExternalProcessingProcess,
+				trace.WithSpanKind(trace.SpanKindServer),
+			)
+		}
+
 		// Handle the request based on phase
 		resp, err := s.handleProcessingPhase(ctx, req, &execCtx, span)
 		if err != nil {
-			slog.ErrorContext(ctx, "Error processing request", "error", err)
+			execCtx.log().ErrorContext(ctx, "Error processing request", "error", err)
 			return err
 		}
 
 		// Send response back to Envoy
 		if err := stream.Send(resp); err != nil {
-			slog.ErrorContext(ctx, "Error sending response", "error", err)
+			execCtx.log().ErrorContext(ctx, "Error sending response", "error", err)
 			metrics.StreamErrorsTotal.WithLabelValues("send").Inc()
 			return status.Errorf(grpccodes.Unknown, "failed to send response: %v", err)
 		}
@@ -194,8 +212,8 @@ func (s *ExternalProcessorServer) handleProcessingPhase(ctx context.Context, req
 		if err != nil {
 			metrics.RequestErrorsTotal.WithLabelValues("request_headers", "processing_failed", rm.RouteName).Inc()
 		}
-		if slog.Default().Enabled(ctx, slog.LevelDebug) {
-			slog.DebugContext(ctx, "ext_proc response", "phase", "request_headers", "resp", prototext.Format(resp))
+		if logger := (*execCtx).log(); logger.Enabled(ctx, slog.LevelDebug) {
+			logger.DebugContext(ctx, "ext_proc response", "phase", "request_headers", "resp", prototext.Format(resp))
 		}
 		return resp, err
 
@@ -241,8 +259,8 @@ func (s *ExternalProcessorServer) handleProcessingPhase(ctx context.Context, req
 		if err != nil {
 			metrics.RequestErrorsTotal.WithLabelValues("request_body", "processing_failed", routeName).Inc()
 		}
-		if slog.Default().Enabled(ctx, slog.LevelDebug) {
-			slog.DebugContext(ctx, "ext_proc response", "phase", "request_body", "resp", prototext.Format(resp))
+		if logger := (*execCtx).log(); logger.Enabled(ctx, slog.LevelDebug) {
+			logger.DebugContext(ctx, "ext_proc response", "phase", "request_body", "resp", prototext.Format(resp))
 		}
 		return resp, err
 
@@ -283,8 +301,8 @@ func (s *ExternalProcessorServer) handleProcessingPhase(ctx context.Context, req
 		if err != nil {
 			metrics.RequestErrorsTotal.WithLabelValues("response_headers", "processing_failed", routeName).Inc()
 		}
-		if slog.Default().Enabled(ctx, slog.LevelDebug) {
-			slog.DebugContext(ctx, "ext_proc response", "phase", "response_headers", "resp", prototext.Format(resp))
+		if logger := (*execCtx).log(); logger.Enabled(ctx, slog.LevelDebug) {
+			logger.DebugContext(ctx, "ext_proc response", "phase", "response_headers", "resp", prototext.Format(resp))
 		}
 		return resp, err
 
@@ -330,8 +348,8 @@ func (s *ExternalProcessorServer) handleProcessingPhase(ctx context.Context, req
 		if err != nil {
 			metrics.RequestErrorsTotal.WithLabelValues("response_body", "processing_failed", routeName).Inc()
 		}
-		if slog.Default().Enabled(ctx, slog.LevelDebug) {
-			slog.DebugContext(ctx, "ext_proc response", "phase", "response_body", "resp", prototext.Format(resp))
+		if logger := (*execCtx).log(); logger.Enabled(ctx, slog.LevelDebug) {
+			logger.DebugContext(ctx, "ext_proc response", "phase", "response_body", "resp", prototext.Format(resp))
 		}
 		return resp, err
 
@@ -381,6 +399,7 @@ func (s *ExternalProcessorServer) initializeExecutionContext(ctx context.Context
 		(*execCtx).apiContext = routeMetadata.Context
 		(*execCtx).upstreamDefinitionPaths = routeMetadata.UpstreamDefinitionPaths
 		(*execCtx).defaultUpstream = routeMetadata.DefaultUpstream
+		(*execCtx).clientIP = s.extractClientIP(req)
 		(*execCtx).buildRequestContexts(req.GetRequestHeaders(), routeMetadata)
 		return &routeMetadata
 	}
@@ -411,6 +430,28 @@ func (s *ExternalProcessorServer) extractRouteKey(req *extprocv3.ProcessingReque
 	return "default"
 }
 
+// extractClientIP extracts the downstream peer IP from the source.address request
+// attribute, dropping the port. Returns an empty string when the attribute is
+// absent or is not an IP address (e.g. a UNIX socket peer).
+func (s *ExternalProcessorServer) extractClientIP(req *extprocv3.ProcessingRequest) string {
+	extProcAttrs, ok := req.GetAttributes()[constants.ExtProcFilter]
+	if !ok || extProcAttrs.Fields == nil {
+		return ""
+	}
+	address := extProcAttrs.Fields["source.address"].GetStringValue()
+	if address == "" {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return ""
+	}
+	return ip.Unmap().String()
+}
+
 // skipAllProcessing returns a response that skips all processing phases
 func (s *ExternalProcessorServer) skipAllProcessing(routeMetadata RouteMetadata) *extprocv3.ProcessingResponse {
 	// Build analytics metadata using route metadata even when skipping policy processing
@@ -458,6 +499,9 @@ type RouteMetadata struct {
 	DefaultUpstreamCluster  string            // Default cluster for dynamic cluster routing
 	UpstreamBasePath        string            // Base path for the upstream (e.g., /anything)
 	UpstreamDefinitionPaths map[string]string // Maps upstream definition names to their URL base paths
+	UpgradeType             string            // Connection upgrade allowed on the route (e.g. "websocket")
+	MaxRequestBytes         int64             // Request body limit in bytes; 0 = no per-API limit
+	MaxResponseBytes        int64             // Response body limit in bytes; 0 = no per-API limit
 
 	// DefaultUpstream is this route's own compiled-in upstream (cluster name, URL, base
 	// path) — whichever slot it belongs to (main or sandbox). Always present; surfaced
@@ -30,6 +30,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -458,6 +461,7 @@ func TestInitializeExecutionContext_WithPolicyChain(t *testing.T) {
 			constants.ExtProcFilter: {
 				Fields: map[string]*structpb.Value{
 					"xds.route_name": structpb.NewStringValue("test-route"),
+					"source.address": structpb.NewStringValue("203.0.113.7:51234"),
 				},
 			},
 		},
@@ -474,4 +478,80 @@ func TestInitializeExecutionContext_WithPolicyChain(t *testing.T) {
 	assert.NotNil(t, execCtx.requestBodyCtx)
 	assert.Equal(t, "/api/v1/pets", execCtx.requestBodyCtx.Path)
 	assert.Equal(t, "GET", execCtx.requestBodyCtx.Method)
+	assert.Equal(t, "203.0.113.7", execCtx.requestHeaderCtx.Downstream.ClientIP)
+	assert.Equal(t, "203.0.113.7", execCtx.requestBodyCtx.Downstream.ClientIP)
+}
+
+func TestExtractClientIP(t *testing.T) {
+	server := NewExternalProcessorServer(NewKernel(), executor.NewChainExecutor(nil, nil, nil), config.TracingConfig{}, "")
+
+	tests := []struct {
+		name     string
+		address  *structpb.Value
+		expected string
+	}{
+		{name: "IPv4 with port", address: structpb.NewStringValue("10.1.2.3:8080"), expected: "10.1.2.3"},
+		{name: "IPv6 with port", address: structpb.NewStringValue("[2001:db8::1]:443"), expected: "2001:db8::1"},
+		{name: "IPv4-mapped IPv6", address: structpb.NewStringValue("[::ffff:10.1.2.3]:443"), expected: "10.1.2.3"},
+		{name: "Address without port", address: structpb.NewStringValue("192.168.0.1"), expected: "192.168.0.1"},
+		{name: "UNIX socket peer", address: structpb.NewStringValue("/var/run/envoy.sock"), expected: ""},
+		{name: "Missing attribute", address: nil, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := map[string]*structpb.Value{}
+			if tt.address != nil {
+				fields["source.address"] = tt.address
+			}
+			req := &extprocv3.ProcessingRequest{
+				Attributes: map[string]*structpb.Struct{
+					constants.ExtProcFilter: {Fields: fields},
+				},
+			}
+			assert.Equal(t, tt.expected, server.extractClientIP(req))
+		})
+	}
+
+	assert.Empty(t, server.extractClientIP(&extprocv3.ProcessingRequest{}))
+}
+
+func TestProcess_SpanJoinsTraceparentFromRequestHeaders(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	route := "traced-route"
+	server := newStreamingTestServer(t, route)
+	server.tracer = provider.Tracer("test")
+
+	req := buildRequestHeadersProcessingRequest(route)
+	headers := req.GetRequestHeaders().Headers
+	headers.Headers = append(headers.Headers, &corev3.HeaderValue{
+		Key:      "traceparent",
+		RawValue: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"),
+	})
+
+	stream := newLiveStream()
+	go func() {
+		defer close(stream.done)
+		_ = server.Process(stream)
+	}()
+	stream.exchange(t, req)
+	close(stream.in)
+	<-stream.done
+
+	var root sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == constants.SpanExternalProcessingProcess {
+			root = span
+		}
+	}
+	require.NotNil(t, root, "stream span not recorded")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", root.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", root.Parent().SpanID().String())
 }
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// policyChainsReloadDebounce coalesces the burst of events produced by editors
+// and ConfigMap volume updates into a single reload
+const policyChainsReloadDebounce = 500 * time.Millisecond
+
+// configMapDataDir is the symlink Kubernetes swaps atomically when a mounted
+// ConfigMap changes; the policy chains file itself is never written directly
+const configMapDataDir = "..data"
+
+// WatchFile reloads the policy chains file whenever it changes, until ctx is
+// cancelled. The parent directory is watched rather than the file so that
+// editors and ConfigMap updates that replace the file by rename are picked up.
+// A reload that fails validation is logged and the current chains are kept.
+func (cl *ConfigLoader) WatchFile(ctx context.Context, path string) error {
+	return cl.watchFile(ctx, path, policyChainsReloadDebounce)
+}
+
+func (cl *ConfigLoader) watchFile(ctx context.Context, path string, debounce time.Duration) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create policy chains file watcher: %w", err)
+	}
+	dir := filepath.Dir(path)
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return fmt.Errorf("failed to watch policy chains directory %s: %w", dir, err)
+	}
+
+	slog.InfoContext(ctx, "Watching policy chains file for changes", "path", path)
+	go cl.runFileWatcher(ctx, watcher, path, debounce)
+	return nil
+}
+
+func (cl *ConfigLoader) runFileWatcher(ctx context.Context, watcher *fsnotify.Watcher, path string, debounce time.Duration) {
+	defer watcher.Close()
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	name := filepath.Base(path)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if base := filepath.Base(event.Name); base != name && base != configMapDataDir {
+				continue
+			}
+			if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			timer.Reset(debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.WarnContext(ctx, "Policy chains file watcher error", "error", err)
+		case <-timer.C:
+			slog.InfoContext(ctx, "Policy chains file changed, reloading", "path", path)
+			if err := cl.LoadFromFile(path); err != nil {
+				slog.ErrorContext(ctx, "Policy chains reload rejected; keeping previously loaded chains",
+					"path", path,
+					"error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// newFileConfigLoader returns a loader backed by a registry holding passthrough:v1.
+func newFileConfigLoader(t *testing.T) (*ConfigLoader, *Kernel) {
+	t.Helper()
+	reg := &registry.PolicyRegistry{
+		Policies: make(map[string]*registry.PolicyEntry),
+	}
+	require.NoError(t, reg.SetConfig(map[string]interface{}{}))
+	require.NoError(t, reg.Register(&policy.PolicyDefinition{
+		Name:    "passthrough",
+		Version: "v1.0.0",
+	}, func(metadata policy.PolicyMetadata, params map[string]interface{}) (policy.Policy, error) {
+		return &passthroughPolicy{}, nil
+	}))
+
+	k := NewKernel()
+	return NewConfigLoader(k, reg), k
+}
+
+func writeChainsFile(t *testing.T, path string, routes ...string) {
+	t.Helper()
+	content := ""
+	for _, route := range routes {
+		content += "- route_key: \"" + route + "\"\n  policies:\n    - name: passthrough\n      version: v1\n"
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestLoadFromFile_ReloadReplacesAndRemovesRoutes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.yaml")
+	loader, k := newFileConfigLoader(t)
+
+	// A route registered from another source must survive file reloads
+	k.PolicyChains["other-route"] = &registry.PolicyChain{}
+
+	writeChainsFile(t, path, "route-a", "route-b")
+	require.NoError(t, loader.LoadFromFile(path))
+	first := k.PolicyChains["route-a"]
+	require.NotNil(t, first)
+	require.Contains(t, k.PolicyChains, "route-b")
+
+	writeChainsFile(t, path, "route-a", "route-c")
+	require.NoError(t, loader.LoadFromFile(path))
+
+	assert.NotSame(t, first, k.PolicyChains["route-a"], "chain is rebuilt on reload")
+	assert.Contains(t, k.PolicyChains, "route-c")
+	assert.NotContains(t, k.PolicyChains, "route-b")
+	assert.Contains(t, k.PolicyChains, "other-route")
+}
+
+func TestLoadFromFile_InvalidReloadKeepsChains(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.yaml")
+	loader, k := newFileConfigLoader(t)
+
+	writeChainsFile(t, path, "route-a")
+	require.NoError(t, loader.LoadFromFile(path))
+	chain := k.PolicyChains["route-a"]
+
+	// route-b is valid but the unknown policy on route-c rejects the whole file
+	content := "- route_key: route-b\n  policies: []\n" +
+		"- route_key: route-c\n  policies:\n    - name: unknown\n      version: v1\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	err := loader.LoadFromFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "route-c")
+	assert.Same(t, chain, k.PolicyChains["route-a"])
+	assert.NotContains(t, k.PolicyChains, "route-b")
+}
+
+func TestWatchFile_ReloadsOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.yaml")
+	loader, k := newFileConfigLoader(t)
+
+	writeChainsFile(t, path, "route-a")
+	require.NoError(t, loader.LoadFromFile(path))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, loader.watchFile(ctx, path, 10*time.Millisecond))
+
+	// Replace the file by rename, as editors and ConfigMap updates do
+	tmp := path + ".tmp"
+	writeChainsFile(t, tmp, "route-b")
+	require.NoError(t, os.Rename(tmp, path))
+
+	assert.Eventually(t, func() bool {
+		k.mu.RLock()
+		defer k.mu.RUnlock()
+		_, hasNew := k.PolicyChains["route-b"]
+		_, hasOld := k.PolicyChains["route-a"]
+		return hasNew && !hasOld
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestWatchFile_MissingDirectory(t *testing.T) {
+	loader, _ := newFileConfigLoader(t)
+
+	err := loader.WatchFile(context.Background(), filepath.Join(t.TempDir(), "missing", "chains.yaml"))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to watch policy chains directory")
+}
@@ -944,3 +944,32 @@ func TestLoadFromFile_ValidationError_PolicyNotRegistered(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "policy not found")
 }
+
+func TestLoadFromFile_ValidationError_InvalidExecutionCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		wantErr   string
+	}{
+		{name: "syntax error", condition: `request.Method ==`, wantErr: "Syntax error"},
+		{name: "type mismatch", condition: `request.Method == 42`, wantErr: "no matching overload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "chains.yaml")
+			yamlContent := "- route_key: test-route\n  policies:\n" +
+				"    - name: passthrough\n      version: v1\n      executionCondition: '" + tt.condition + "'\n"
+			require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+			loader, k := newFileConfigLoader(t)
+
+			err := loader.LoadFromFile(configPath)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "policy passthrough")
+			assert.Contains(t, err.Error(), tt.condition)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.NotContains(t, k.PolicyChains, "test-route")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// PolicyState is the runtime state reported by a policy instance on a route.
+type PolicyState struct {
+	Route         string
+	PolicyName    string
+	PolicyVersion string
+	State         map[string]interface{}
+}
+
+// DumpPolicyStates collects the state of every policy instance that implements
+// policy.StateReporter, ordered by route and chain position.
+func (k *Kernel) DumpPolicyStates() []PolicyState {
+	routes := k.DumpRoutes()
+
+	routeKeys := make([]string, 0, len(routes))
+	for routeKey := range routes {
+		routeKeys = append(routeKeys, routeKey)
+	}
+	sort.Strings(routeKeys)
+
+	var states []PolicyState
+	for _, routeKey := range routeKeys {
+		chain := routes[routeKey]
+		if chain == nil {
+			continue
+		}
+		for i, p := range chain.Policies {
+			reporter, ok := p.(policy.StateReporter)
+			if !ok || i >= len(chain.PolicySpecs) {
+				continue
+			}
+			states = append(states, PolicyState{
+				Route:         routeKey,
+				PolicyName:    chain.PolicySpecs[i].Name,
+				PolicyVersion: chain.PolicySpecs[i].Version,
+				State:         reporter.PolicyState(),
+			})
+		}
+	}
+	return states
+}
+
+var policyStateDesc = prometheus.NewDesc(
+	"policy_engine_policy_state",
+	"Runtime state reported by policies, one series per numeric or boolean state field",
+	[]string{"route", "policy", "field"}, nil,
+)
+
+// policyStateCollector exports DumpPolicyStates as gauges at scrape time so the
+// series always reflect the currently loaded chains.
+type policyStateCollector struct {
+	kernel *Kernel
+}
+
+// NewPolicyStateCollector returns a Prometheus collector for policy state.
+func NewPolicyStateCollector(k *Kernel) prometheus.Collector {
+	return &policyStateCollector{kernel: k}
+}
+
+// Describe implements prometheus.Collector.
+func (c *policyStateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- policyStateDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *policyStateCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.kernel.DumpPolicyStates() {
+		for field, raw := range s.State {
+			value, ok := stateValue(raw)
+			if !ok {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(policyStateDesc, prometheus.GaugeValue, value, s.Route, s.PolicyName, field)
+		}
+	}
+}
+
+// stateValue converts a numeric or boolean state value to a gauge value.
+func stateValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case bool:
+		if n {
+			return 1, true
+		}
+		return 0, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+type statelessPolicy struct{}
+
+func (p *statelessPolicy) Mode() policy.ProcessingMode { return policy.ProcessingMode{} }
+
+type statefulPolicy struct {
+	state map[string]interface{}
+}
+
+func (p *statefulPolicy) Mode() policy.ProcessingMode { return policy.ProcessingMode{} }
+
+func (p *statefulPolicy) PolicyState() map[string]interface{} { return p.state }
+
+func newPolicyStateKernel() *Kernel {
+	k := NewKernel()
+	k.RegisterRoute("route-b", &registry.PolicyChain{
+		Policies: []policy.Policy{
+			&statelessPolicy{},
+			&statefulPolicy{state: map[string]interface{}{"state": "open", "open": true, "failures": 5}},
+		},
+		PolicySpecs: []policy.PolicySpec{
+			{Name: "set-headers", Version: "v1.0.0"},
+			{Name: "circuit-breaker", Version: "v1.0.0"},
+		},
+	})
+	k.RegisterRoute("route-a", &registry.PolicyChain{
+		Policies: []policy.Policy{
+			&statefulPolicy{state: map[string]interface{}{"state": "closed", "open": false, "failures": 1}},
+		},
+		PolicySpecs: []policy.PolicySpec{
+			{Name: "circuit-breaker", Version: "v1.0.0"},
+		},
+	})
+	return k
+}
+
+func TestDumpPolicyStates(t *testing.T) {
+	states := newPolicyStateKernel().DumpPolicyStates()
+
+	require.Len(t, states, 2)
+	assert.Equal(t, "route-a", states[0].Route)
+	assert.Equal(t, "circuit-breaker", states[0].PolicyName)
+	assert.Equal(t, "v1.0.0", states[0].PolicyVersion)
+	assert.Equal(t, "closed", states[0].State["state"])
+	assert.Equal(t, "route-b", states[1].Route)
+	assert.Equal(t, "open", states[1].State["state"])
+}
+
+func TestDumpPolicyStates_NoReporters(t *testing.T) {
+	k := NewKernel()
+	k.RegisterRoute("route-a", &registry.PolicyChain{
+		Policies:    []policy.Policy{&statelessPolicy{}},
+		PolicySpecs: []policy.PolicySpec{{Name: "set-headers", Version: "v1.0.0"}},
+	})
+
+	assert.Empty(t, k.DumpPolicyStates())
+}
+
+func TestPolicyStateCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(NewPolicyStateCollector(newPolicyStateKernel())))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	assert.Equal(t, "policy_engine_policy_state", families[0].GetName())
+
+	values := map[string]float64{}
+	for _, m := range families[0].GetMetric() {
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		values[labels["route"]+"/"+labels["policy"]+"/"+labels["field"]] = m.GetGauge().GetValue()
+	}
+
+	// String fields are only exposed through the admin API
+	assert.Equal(t, map[string]float64{
+		"route-a/circuit-breaker/open":     0,
+		"route-a/circuit-breaker/failures": 1,
+		"route-b/circuit-breaker/open":     1,
+		"route-b/circuit-breaker/failures": 5,
+	}, values)
+}
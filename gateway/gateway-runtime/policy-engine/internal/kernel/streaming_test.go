@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocconfigv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// liveExtProcStream is an ext_proc stream driven message by message, so a test
+// can assert on each response before Envoy would deliver the next chunk.
+type liveExtProcStream struct {
+	ctx  context.Context
+	in   chan *extprocv3.ProcessingRequest
+	out  chan *extprocv3.ProcessingResponse
+	done chan struct{}
+}
+
+func newLiveStream() *liveExtProcStream {
+	return &liveExtProcStream{
+		ctx:  context.Background(),
+		in:   make(chan *extprocv3.ProcessingRequest),
+		out:  make(chan *extprocv3.ProcessingResponse),
+		done: make(chan struct{}),
+	}
+}
+
+func (s *liveExtProcStream) Recv() (*extprocv3.ProcessingRequest, error) {
+	req, ok := <-s.in
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (s *liveExtProcStream) Send(resp *extprocv3.ProcessingResponse) error {
+	s.out <- resp
+	return nil
+}
+
+func (s *liveExtProcStream) SetHeader(metadata.MD) error  { return nil }
+func (s *liveExtProcStream) SendHeader(metadata.MD) error { return nil }
+func (s *liveExtProcStream) SetTrailer(metadata.MD)       {}
+func (s *liveExtProcStream) Context() context.Context     { return s.ctx }
+func (s *liveExtProcStream) SendMsg(interface{}) error    { return nil }
+func (s *liveExtProcStream) RecvMsg(interface{}) error    { return nil }
+
+// exchange sends one message and waits for the policy engine's reply.
+func (s *liveExtProcStream) exchange(t *testing.T, req *extprocv3.ProcessingRequest) *extprocv3.ProcessingResponse {
+	t.Helper()
+	select {
+	case s.in <- req:
+	case <-time.After(2 * time.Second):
+		t.Fatal("policy engine did not read the next message")
+	}
+	select {
+	case resp := <-s.out:
+		return resp
+	case <-time.After(2 * time.Second):
+		t.Fatal("policy engine did not respond to the message")
+		return nil
+	}
+}
+
+// serve runs Process on the stream until the stream is closed.
+func (s *liveExtProcStream) serve(t *testing.T, server *ExternalProcessorServer) {
+	go func() {
+		defer close(s.done)
+		_ = server.Process(s)
+	}()
+	t.Cleanup(func() {
+		close(s.in)
+		<-s.done
+	})
+}
+
+// sseRecorderPolicy streams response bodies and records every chunk it sees.
+type sseRecorderPolicy struct {
+	mu     sync.Mutex
+	chunks []string
+}
+
+func (p *sseRecorderPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeStream,
+	}
+}
+
+func (p *sseRecorderPolicy) OnResponseBody(_ context.Context, _ *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	return nil
+}
+
+func (p *sseRecorderPolicy) OnResponseBodyChunk(_ context.Context, _ *policy.ResponseStreamContext, chunk *policy.StreamBody, _ map[string]interface{}) policy.StreamingResponseAction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.chunks = append(p.chunks, string(chunk.Chunk))
+	return policy.ForwardResponseChunk{}
+}
+
+func (p *sseRecorderPolicy) NeedsMoreResponseData([]byte) bool { return false }
+
+func (p *sseRecorderPolicy) seen() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.chunks...)
+}
+
+// bufferedBodyPolicy needs the complete response body.
+type bufferedBodyPolicy struct{}
+
+func (p *bufferedBodyPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeBuffer,
+	}
+}
+
+func (p *bufferedBodyPolicy) OnResponseBody(_ context.Context, _ *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	return nil
+}
+
+// newStreamingTestServer registers a route whose chain is built from policies
+// the same way the policy engine builds chains from configuration.
+func newStreamingTestServer(t *testing.T, route string, policies ...policy.Policy) *ExternalProcessorServer {
+	t.Helper()
+	chain := &registry.PolicyChain{
+		SupportsRequestStreaming:  false,
+		SupportsResponseStreaming: true,
+	}
+	for _, p := range policies {
+		chain.Policies = append(chain.Policies, p)
+		chain.PolicySpecs = append(chain.PolicySpecs, buildPolicySpec("test-policy", "v1.0", nil))
+		if mode := p.Mode(); mode.ResponseBodyMode != policy.BodyModeSkip {
+			chain.RequiresResponseBody = true
+			if _, ok := p.(policy.StreamingResponsePolicy); !ok || mode.ResponseBodyMode != policy.BodyModeStream {
+				chain.SupportsResponseStreaming = false
+			}
+		}
+	}
+	server := newBenchServer(map[string]*registry.PolicyChain{route: chain})
+	server.kernel.ApplyWholeRouteConfigs(map[string]*RouteConfig{
+		route: {Metadata: RouteMetadata{RouteName: route}},
+	})
+	return server
+}
+
+func sseResponseHeaders() *extprocv3.ProcessingRequest {
+	return &extprocv3.ProcessingRequest{
+		Request: &extprocv3.ProcessingRequest_ResponseHeaders{
+			ResponseHeaders: &extprocv3.HttpHeaders{
+				Headers: &corev3.HeaderMap{
+					Headers: []*corev3.HeaderValue{
+						{Key: ":status", RawValue: []byte("200")},
+						{Key: "content-type", RawValue: []byte("text/event-stream")},
+						{Key: "transfer-encoding", RawValue: []byte("chunked")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func responseBodyChunk(data string, endOfStream bool) *extprocv3.ProcessingRequest {
+	return &extprocv3.ProcessingRequest{
+		Request: &extprocv3.ProcessingRequest_ResponseBody{
+			ResponseBody: &extprocv3.HttpBody{Body: []byte(data), EndOfStream: endOfStream},
+		},
+	}
+}
+
+// streamedBody returns the body forwarded downstream for a streamed chunk.
+func streamedBody(t *testing.T, resp *extprocv3.ProcessingResponse) *extprocv3.StreamedBodyResponse {
+	t.Helper()
+	body := resp.GetResponseBody()
+	require.NotNil(t, body, "expected a response body reply")
+	streamed := body.GetResponse().GetBodyMutation().GetStreamedResponse()
+	require.NotNil(t, streamed, "expected a streamed body mutation")
+	return streamed
+}
+
+func TestProcess_SSEChunksForwardedAsTheyArrive(t *testing.T) {
+	recorder := &sseRecorderPolicy{}
+	server := newStreamingTestServer(t, "sse-route", recorder)
+	stream := newLiveStream()
+	stream.serve(t, server)
+
+	stream.exchange(t, buildRequestHeadersProcessingRequest("sse-route"))
+
+	resp := stream.exchange(t, sseResponseHeaders())
+	require.NotNil(t, resp.ModeOverride)
+	assert.Equal(t, extprocconfigv3.ProcessingMode_FULL_DUPLEX_STREAMED, resp.ModeOverride.ResponseBodyMode)
+
+	events := []string{
+		"data: {\"choices\":[{\"delta\":{\"content\":\"Hel\"}}]}\n\n",
+		"data: {\"choices\":[{\"delta\":{\"content\":\"lo\"}}]}\n\n",
+		"data: {\"usage\":{\"total_tokens\":12}}\n\ndata: [DONE]\n\n",
+	}
+	for i, event := range events {
+		last := i == len(events)-1
+		// The reply for each chunk must arrive before the next chunk is sent
+		streamed := streamedBody(t, stream.exchange(t, responseBodyChunk(event, last)))
+		assert.Equal(t, event, string(streamed.Body), "chunk %d", i)
+		assert.Equal(t, last, streamed.EndOfStream, "chunk %d", i)
+		assert.Equal(t, events[:i+1], recorder.seen(), "policy did not see chunk %d when it arrived", i)
+	}
+}
+
+func TestProcess_SSEPartialEventHeldUntilComplete(t *testing.T) {
+	recorder := &sseRecorderPolicy{}
+	server := newStreamingTestServer(t, "sse-partial-route", recorder)
+	stream := newLiveStream()
+	stream.serve(t, server)
+
+	stream.exchange(t, buildRequestHeadersProcessingRequest("sse-partial-route"))
+	stream.exchange(t, sseResponseHeaders())
+
+	// An event split across upstream chunks is not handed to policies until complete
+	streamed := streamedBody(t, stream.exchange(t, responseBodyChunk("data: {\"delta\":", false)))
+	assert.Empty(t, streamed.Body)
+	assert.Empty(t, recorder.seen())
+
+	// Complete events are flushed; the trailing partial event is held back
+	streamed = streamedBody(t, stream.exchange(t, responseBodyChunk("\"a\"}\n\ndata: {\"del", false)))
+	assert.Equal(t, "data: {\"delta\":\"a\"}\n\n", string(streamed.Body))
+
+	streamed = streamedBody(t, stream.exchange(t, responseBodyChunk("ta\":\"b\"}\r\n\r\n", false)))
+	assert.Equal(t, "data: {\"delta\":\"b\"}\r\n\r\n", string(streamed.Body))
+
+	// Whatever is left is flushed at end of stream, even without a terminator
+	streamed = streamedBody(t, stream.exchange(t, responseBodyChunk("data: [DONE]", true)))
+	assert.Equal(t, "data: [DONE]", string(streamed.Body))
+	assert.True(t, streamed.EndOfStream)
+
+	assert.Equal(t, []string{
+		"data: {\"delta\":\"a\"}\n\n",
+		"data: {\"delta\":\"b\"}\r\n\r\n",
+		"data: [DONE]",
+	}, recorder.seen())
+}
+
+func TestProcess_SSEBufferedWhenPolicyNeedsFullBody(t *testing.T) {
+	server := newStreamingTestServer(t, "sse-buffered-route", &sseRecorderPolicy{}, &bufferedBodyPolicy{})
+	stream := newLiveStream()
+	stream.serve(t, server)
+
+	stream.exchange(t, buildRequestHeadersProcessingRequest("sse-buffered-route"))
+	resp := stream.exchange(t, sseResponseHeaders())
+	require.NotNil(t, resp.ModeOverride)
+	assert.Equal(t, extprocconfigv3.ProcessingMode_BUFFERED, resp.ModeOverride.ResponseBodyMode)
+}
+
+func TestLastEventBoundary(t *testing.T) {
+	tests := []struct {
+		data string
+		want int
+	}{
+		{"", 0},
+		{"data: partial", 0},
+		{"data: a\n\n", 9},
+		{"data: a\n\ndata: b", 9},
+		{"data: a\r\n\r\ndata: b\n\n", 20},
+		{"data: a\n\ndata: b\r\n\r\n", 20},
+		{"data: a\n", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, lastEventBoundary([]byte(tt.data)), "%q", tt.data)
+	}
+}
@@ -23,9 +23,11 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 	policyenginev1 "github.com/wso2/api-platform/sdk/core/policyengine"
@@ -36,6 +38,12 @@ import (
 type ConfigLoader struct {
 	kernel   *Kernel
 	registry *registry.PolicyRegistry
+
+	// mu serializes file loads so a reload never interleaves with another
+	mu sync.Mutex
+	// fileRoutes holds the route keys registered by the last successful
+	// LoadFromFile, so a reload can drop routes removed from the file
+	fileRoutes map[string]struct{}
 }
 
 // NewConfigLoader creates a new configuration loader
@@ -48,7 +56,14 @@ func NewConfigLoader(kernel *Kernel, reg *registry.PolicyRegistry) *ConfigLoader
 
 // LoadFromFile loads policy chain configurations from a YAML file
 // T077: File-based configuration loader implementation
+//
+// The whole file is validated and built before the kernel is touched, so an
+// invalid file leaves the previously loaded chains in place. Routes loaded by
+// an earlier call that are no longer in the file are removed.
 func (cl *ConfigLoader) LoadFromFile(path string) error {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return fmt.Errorf("failed to read config file %s: %w", path, err)
@@ -83,12 +98,35 @@ func (cl *ConfigLoader) LoadFromFile(path string) error {
 	defer cl.kernel.mu.Unlock()
 
 	ctx := context.Background()
+	added, updated, removed := 0, 0, 0
+	for routeKey := range cl.fileRoutes {
+		if _, ok := chains[routeKey]; !ok {
+			delete(cl.kernel.PolicyChains, routeKey)
+			removed++
+			slog.InfoContext(ctx, "Removed policy chain for route", "route", routeKey)
+		}
+	}
+
+	fileRoutes := make(map[string]struct{}, len(chains))
 	for routeKey, chain := range chains {
+		if _, ok := cl.kernel.PolicyChains[routeKey]; ok {
+			updated++
+		} else {
+			added++
+		}
 		cl.kernel.PolicyChains[routeKey] = chain
+		fileRoutes[routeKey] = struct{}{}
 		slog.InfoContext(ctx, "Loaded policy chain for route",
 			"route", routeKey,
 			"policies", len(chain.Policies))
 	}
+	cl.fileRoutes = fileRoutes
+
+	slog.InfoContext(ctx, "Policy chains loaded from file",
+		"path", path,
+		"added", added,
+		"updated", updated,
+		"removed", removed)
 
 	return nil
 }
@@ -112,11 +150,29 @@ func (cl *ConfigLoader) validateConfig(config *policyenginev1.PolicyChain) error
 		if err := cl.registry.PolicyExists(policyConfig.Name, policyConfig.Version); err != nil {
 			return fmt.Errorf("policy[%d]: %w", i, err)
 		}
+
+		if err := ValidateExecutionCondition(&policyConfig); err != nil {
+			return fmt.Errorf("policy[%d]: %w", i, err)
+		}
 	}
 
 	return nil
 }
 
+// ValidateExecutionCondition compiles the policy's execution condition, if it
+// has one, so that a broken expression is rejected when the chain is loaded
+// instead of failing every request that reaches it.
+func ValidateExecutionCondition(policyConfig *policyenginev1.PolicyInstance) error {
+	if policyConfig.ExecutionCondition == nil || *policyConfig.ExecutionCondition == "" {
+		return nil
+	}
+	if err := cel.ValidateCondition(*policyConfig.ExecutionCondition); err != nil {
+		return fmt.Errorf("policy %s: invalid execution condition %q: %w",
+			policyConfig.Name, *policyConfig.ExecutionCondition, err)
+	}
+	return nil
+}
+
 // buildPolicyChain builds a PolicyChain from configuration
 func (cl *ConfigLoader) buildPolicyChain(routeKey string, config *policyenginev1.PolicyChain, apiMetadata policyenginev1.Metadata) (*registry.PolicyChain, error) {
 	var policyList []policy.Policy
@@ -44,6 +44,11 @@ var (
 	PolicySkippedTotal    CounterVec
 	PoliciesPerChain      GaugeVec
 
+	PolicyExecutionDurationSeconds HistogramVec
+	PolicyExecutionErrorsTotal     CounterVec
+	PolicyShortCircuitsTotal       CounterVec
+	PolicyPanicsTotal              CounterVec
+
 	PolicyChainsLoaded GaugeVec
 	XDSUpdatesTotal    CounterVec
 	XDSConnectionState GaugeVec
@@ -62,6 +67,13 @@ var (
 	StreamErrorsTotal        CounterVec
 	RouteLookupFailuresTotal Counter
 	PanicRecoveriesTotal     CounterVec
+
+	AnalyticsEventsDroppedTotal CounterVec
+	AnalyticsSampledTotal       CounterVec
+	AnalyticsDroppedTotal       CounterVec
+
+	ProviderCallDurationSeconds HistogramVec
+	SemanticCacheLookupsTotal   CounterVec
 )
 
 // initMetrics initializes all metric variables.
@@ -123,6 +135,43 @@ func initMetrics() {
 		[]string{"policy_name", "policy_version", "api", "route"},
 	)
 
+	PolicyExecutionDurationSeconds = newHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "policy_execution_duration_seconds",
+			Help:      "Duration of each policy invocation in seconds; route is empty unless policy route labels are enabled",
+			Buckets:   []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0},
+		},
+		[]string{"policy", "version", "route"},
+	)
+
+	PolicyExecutionErrorsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_execution_errors_total",
+			Help:      "Total number of policy invocations that failed, by error type",
+		},
+		[]string{"policy", "version", "route", "error_type"},
+	)
+
+	PolicyShortCircuitsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_short_circuits_total",
+			Help:      "Total number of times a policy stopped its chain with an immediate response",
+		},
+		[]string{"policy", "version", "route"},
+	)
+
+	PolicyPanicsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_panics_total",
+			Help:      "Total number of panics recovered from policies",
+		},
+		[]string{"policy"},
+	)
+
 	PolicySkippedTotal = newCounterVec(
 		prometheus.CounterOpts{
 			Namespace: namespace,
@@ -275,6 +324,52 @@ func initMetrics() {
 		},
 		[]string{"component"},
 	)
+
+	AnalyticsEventsDroppedTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "analytics_events_dropped_total",
+			Help:      "Total number of analytics events dropped because a publisher's queue was full",
+		},
+		[]string{"publisher"},
+	)
+
+	AnalyticsSampledTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "analytics_sampled_total",
+			Help:      "Total number of analytics events kept by sampling and passed to the publishers",
+		},
+		[]string{"api"},
+	)
+
+	AnalyticsDroppedTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "analytics_dropped_total",
+			Help:      "Total number of analytics events dropped by sampling",
+		},
+		[]string{"api"},
+	)
+
+	ProviderCallDurationSeconds = newHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "provider_call_duration_seconds",
+			Help:      "Duration of calls from policies to external providers (embedding, vector DB, guardrail services) in seconds",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0},
+		},
+		[]string{"provider", "outcome"},
+	)
+
+	SemanticCacheLookupsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "semantic_cache_lookups_total",
+			Help:      "Total number of semantic cache lookups by result (hit, miss, or degraded when a backend failed)",
+		},
+		[]string{"result"},
+	)
 }
 
 func registerCounterVec(v CounterVec) {
@@ -354,6 +449,10 @@ func initRegistry() {
 	registerCounterVec(PolicyExecutionsTotal)
 	registerHistogramVec(PolicyDurationSeconds)
 	registerCounterVec(PolicySkippedTotal)
+	registerHistogramVec(PolicyExecutionDurationSeconds)
+	registerCounterVec(PolicyExecutionErrorsTotal)
+	registerCounterVec(PolicyShortCircuitsTotal)
+	registerCounterVec(PolicyPanicsTotal)
 	registerGaugeVec(PoliciesPerChain)
 
 	registerGaugeVec(PolicyChainsLoaded)
@@ -375,6 +474,13 @@ func initRegistry() {
 	registerCounter(RouteLookupFailuresTotal)
 	registerCounterVec(PanicRecoveriesTotal)
 
+	registerCounterVec(AnalyticsEventsDroppedTotal)
+	registerCounterVec(AnalyticsSampledTotal)
+	registerCounterVec(AnalyticsDroppedTotal)
+
+	registerHistogramVec(ProviderCallDurationSeconds)
+	registerCounterVec(SemanticCacheLookupsTotal)
+
 	Up.Set(1)
 }
 
@@ -395,6 +501,15 @@ func Init() *prometheus.Registry {
 	return registry
 }
 
+// RegisterCollector registers an additional collector, such as one that derives
+// metrics from kernel state at scrape time. It is a no-op when metrics are disabled.
+func RegisterCollector(c prometheus.Collector) error {
+	if !Enabled {
+		return nil
+	}
+	return GetRegistry().Register(c)
+}
+
 // GetRegistry returns the prometheus registry
 func GetRegistry() *prometheus.Registry {
 	if registry == nil {
@@ -20,9 +20,13 @@ package cel
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
 
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 )
@@ -39,6 +43,10 @@ type CELEvaluator interface {
 	EvaluateResponseBodyCondition(expression string, ctx *policy.ResponseContext) (bool, error)
 	EvaluateStreamingRequestCondition(expression string, ctx *policy.RequestStreamContext) (bool, error)
 	EvaluateStreamingResponseCondition(expression string, ctx *policy.ResponseStreamContext) (bool, error)
+
+	// ExpressionEvaluator evaluates expressions of any result type for policies
+	// that template values, e.g. header values taken from JWT claims.
+	policy.ExpressionEvaluator
 }
 
 // celEvaluator implements CELEvaluator with caching
@@ -52,6 +60,11 @@ type celEvaluator struct {
 
 	// Unified CEL environment supporting both request and response contexts
 	env *cel.Env
+
+	// Decoded bearer token claims keyed by token, shared by all conditions
+	// of a request so the token is decoded once.
+	claimsMu    sync.Mutex
+	claimsCache *lruCache[map[string]any]
 }
 
 // NewCELEvaluator creates a new CEL evaluator with caching
@@ -65,14 +78,16 @@ func NewCELEvaluator() (CELEvaluator, error) {
 	return &celEvaluator{
 		programCache: newProgramLRUCache(defaultProgramCacheSize),
 		env:          env,
+		claimsCache:  newLRUCache[map[string]any](defaultJWTClaimsCacheSize),
 	}, nil
 }
 
 // createCELEnv creates a unified CEL environment supporting both request and response contexts.
 // This environment is used for all phase evaluations, allowing policies to use the same
 // executionCondition expression regardless of which phase they execute in.
+// The IP matching and JWT claim helpers are registered alongside the variables.
 func createCELEnv() (*cel.Env, error) {
-	return cel.NewEnv(
+	opts := []cel.EnvOption{
 		// Processing phase indicator — enables phase-specific logic in CEL expressions
 		// Values: "request_headers", "request_body", "response_headers", "response_body"
 		cel.Variable("processing.phase", cel.StringType),
@@ -84,6 +99,8 @@ func createCELEnv() (*cel.Env, error) {
 		cel.Variable("request.Method", cel.StringType),
 		cel.Variable("request.RequestID", cel.StringType),
 		cel.Variable("request.Metadata", cel.MapType(cel.StringType, cel.DynType)),
+		// IP address of the downstream client, empty when unavailable
+		cel.Variable("request.ClientIP", cel.StringType),
 		// ResponseContext variables
 		cel.Variable("response", cel.ObjectType("ResponseContext")),
 		cel.Variable("response.RequestHeaders", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
@@ -95,7 +112,10 @@ func createCELEnv() (*cel.Env, error) {
 		cel.Variable("response.ResponseStatus", cel.IntType),
 		cel.Variable("response.RequestID", cel.StringType),
 		cel.Variable("response.Metadata", cel.MapType(cel.StringType, cel.DynType)),
-	)
+	}
+	opts = append(opts, ipFunctions()...)
+	opts = append(opts, jwtFunctions()...)
+	return cel.NewEnv(opts...)
 }
 
 // EvaluateRequestHeaderCondition evaluates a CEL expression against a RequestHeaderContext
@@ -104,7 +124,9 @@ func (e *celEvaluator) EvaluateRequestHeaderCondition(expression string, ctx *po
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildRequestHeaderEvalCtx(ctx, "request_headers"))
+	evalCtx := buildRequestHeaderEvalCtx(ctx, "request_headers")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.Headers)
+	return e.eval(program, evalCtx)
 }
 
 // EvaluateRequestBodyCondition evaluates a CEL expression against a RequestContext
@@ -113,7 +135,9 @@ func (e *celEvaluator) EvaluateRequestBodyCondition(expression string, ctx *poli
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildRequestBodyEvalCtx(ctx, "request_body"))
+	evalCtx := buildRequestBodyEvalCtx(ctx, "request_body")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.Headers)
+	return e.eval(program, evalCtx)
 }
 
 // EvaluateResponseHeaderCondition evaluates a CEL expression against a ResponseHeaderContext
@@ -122,7 +146,9 @@ func (e *celEvaluator) EvaluateResponseHeaderCondition(expression string, ctx *p
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildResponseHeaderEvalCtx(ctx, "response_headers"))
+	evalCtx := buildResponseHeaderEvalCtx(ctx, "response_headers")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.RequestHeaders)
+	return e.eval(program, evalCtx)
 }
 
 // EvaluateResponseBodyCondition evaluates a CEL expression against a ResponseContext
@@ -131,7 +157,9 @@ func (e *celEvaluator) EvaluateResponseBodyCondition(expression string, ctx *pol
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildResponseBodyEvalCtx(ctx, "response_body"))
+	evalCtx := buildResponseBodyEvalCtx(ctx, "response_body")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.RequestHeaders)
+	return e.eval(program, evalCtx)
 }
 
 // EvaluateStreamingRequestCondition evaluates a CEL expression against a RequestStreamContext.
@@ -141,7 +169,9 @@ func (e *celEvaluator) EvaluateStreamingRequestCondition(expression string, ctx
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildStreamingRequestEvalCtx(ctx))
+	evalCtx := buildStreamingRequestEvalCtx(ctx)
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.Headers)
+	return e.eval(program, evalCtx)
 }
 
 // EvaluateStreamingResponseCondition evaluates a CEL expression against a ResponseStreamContext.
@@ -151,7 +181,9 @@ func (e *celEvaluator) EvaluateStreamingResponseCondition(expression string, ctx
 	if err != nil {
 		return false, fmt.Errorf("failed to compile CEL expression: %w", err)
 	}
-	return e.eval(program, buildStreamingResponseEvalCtx(ctx))
+	evalCtx := buildStreamingResponseEvalCtx(ctx)
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.RequestHeaders)
+	return e.eval(program, evalCtx)
 }
 
 // bodyToCEL converts a *policy.Body to the map representation expected by CEL.
@@ -167,9 +199,19 @@ func bodyToCEL(body *policy.Body) interface{} {
 	}
 }
 
+// downstreamClientIP returns the client IP from a DownstreamContext, or an empty
+// string when the kernel did not provide one.
+func downstreamClientIP(downstream *policy.DownstreamContext) string {
+	if downstream == nil {
+		return ""
+	}
+	return downstream.ClientIP
+}
+
 // buildRequestHeaderEvalCtx builds a CEL evaluation context from a RequestHeaderContext
 func buildRequestHeaderEvalCtx(ctx *policy.RequestHeaderContext, phase string) map[string]interface{} {
 	headers := ctx.Headers.GetAll()
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": phase,
 		"request": map[string]interface{}{
@@ -179,6 +221,7 @@ func buildRequestHeaderEvalCtx(ctx *policy.RequestHeaderContext, phase string) m
 			"Method":    ctx.Method,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   headers,
 		"request.Body":      nil,
@@ -186,6 +229,7 @@ func buildRequestHeaderEvalCtx(ctx *policy.RequestHeaderContext, phase string) m
 		"request.Method":    ctx.Method,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  headers,
 			"RequestBody":     nil,
@@ -213,6 +257,7 @@ func buildRequestHeaderEvalCtx(ctx *policy.RequestHeaderContext, phase string) m
 func buildRequestBodyEvalCtx(ctx *policy.RequestContext, phase string) map[string]interface{} {
 	headers := ctx.Headers.GetAll()
 	body := bodyToCEL(ctx.Body)
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": phase,
 		"request": map[string]interface{}{
@@ -222,6 +267,7 @@ func buildRequestBodyEvalCtx(ctx *policy.RequestContext, phase string) map[strin
 			"Method":    ctx.Method,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   headers,
 		"request.Body":      body,
@@ -229,6 +275,7 @@ func buildRequestBodyEvalCtx(ctx *policy.RequestContext, phase string) map[strin
 		"request.Method":    ctx.Method,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  headers,
 			"RequestBody":     body,
@@ -257,6 +304,7 @@ func buildResponseHeaderEvalCtx(ctx *policy.ResponseHeaderContext, phase string)
 	requestHeaders := ctx.RequestHeaders.GetAll()
 	requestBody := bodyToCEL(ctx.RequestBody)
 	responseHeaders := ctx.ResponseHeaders.GetAll()
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": phase,
 		"request": map[string]interface{}{
@@ -266,6 +314,7 @@ func buildResponseHeaderEvalCtx(ctx *policy.ResponseHeaderContext, phase string)
 			"Method":    ctx.RequestMethod,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   requestHeaders,
 		"request.Body":      requestBody,
@@ -273,6 +322,7 @@ func buildResponseHeaderEvalCtx(ctx *policy.ResponseHeaderContext, phase string)
 		"request.Method":    ctx.RequestMethod,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  requestHeaders,
 			"RequestBody":     requestBody,
@@ -302,6 +352,7 @@ func buildResponseBodyEvalCtx(ctx *policy.ResponseContext, phase string) map[str
 	requestBody := bodyToCEL(ctx.RequestBody)
 	responseHeaders := ctx.ResponseHeaders.GetAll()
 	responseBody := bodyToCEL(ctx.ResponseBody)
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": phase,
 		"request": map[string]interface{}{
@@ -311,6 +362,7 @@ func buildResponseBodyEvalCtx(ctx *policy.ResponseContext, phase string) map[str
 			"Method":    ctx.RequestMethod,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   requestHeaders,
 		"request.Body":      requestBody,
@@ -318,6 +370,7 @@ func buildResponseBodyEvalCtx(ctx *policy.ResponseContext, phase string) map[str
 		"request.Method":    ctx.RequestMethod,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  requestHeaders,
 			"RequestBody":     requestBody,
@@ -345,6 +398,7 @@ func buildResponseBodyEvalCtx(ctx *policy.ResponseContext, phase string) map[str
 // Uses phase "request_body" — consistent with buffered request body processing.
 func buildStreamingRequestEvalCtx(ctx *policy.RequestStreamContext) map[string]interface{} {
 	headers := ctx.Headers.GetAll()
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": "request_body",
 		"request": map[string]interface{}{
@@ -354,6 +408,7 @@ func buildStreamingRequestEvalCtx(ctx *policy.RequestStreamContext) map[string]i
 			"Method":    ctx.Method,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   headers,
 		"request.Body":      nil,
@@ -361,6 +416,7 @@ func buildStreamingRequestEvalCtx(ctx *policy.RequestStreamContext) map[string]i
 		"request.Method":    ctx.Method,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  headers,
 			"RequestBody":     nil,
@@ -390,6 +446,7 @@ func buildStreamingResponseEvalCtx(ctx *policy.ResponseStreamContext) map[string
 	requestHeaders := ctx.RequestHeaders.GetAll()
 	requestBody := bodyToCEL(ctx.RequestBody)
 	responseHeaders := ctx.ResponseHeaders.GetAll()
+	clientIP := downstreamClientIP(ctx.Downstream)
 	return map[string]interface{}{
 		"processing.phase": "response_body",
 		"request": map[string]interface{}{
@@ -399,6 +456,7 @@ func buildStreamingResponseEvalCtx(ctx *policy.ResponseStreamContext) map[string
 			"Method":    ctx.RequestMethod,
 			"RequestID": ctx.RequestID,
 			"Metadata":  ctx.Metadata,
+			"ClientIP":  clientIP,
 		},
 		"request.Headers":   requestHeaders,
 		"request.Body":      requestBody,
@@ -406,6 +464,7 @@ func buildStreamingResponseEvalCtx(ctx *policy.ResponseStreamContext) map[string
 		"request.Method":    ctx.RequestMethod,
 		"request.RequestID": ctx.RequestID,
 		"request.Metadata":  ctx.Metadata,
+		"request.ClientIP":  clientIP,
 		"response": map[string]interface{}{
 			"RequestHeaders":  requestHeaders,
 			"RequestBody":     requestBody,
@@ -429,7 +488,82 @@ func buildStreamingResponseEvalCtx(ctx *policy.ResponseStreamContext) map[string
 	}
 }
 
+// ValidateExpression compiles an expression without evaluating it. Unlike
+// ValidateCondition, the expression may return any type.
+func (e *celEvaluator) ValidateExpression(expression string) error {
+	_, err := e.getOrCompileProgram(expression)
+	return err
+}
+
+// EvaluateRequestHeaderExpression evaluates a CEL expression of any result type
+// against a RequestHeaderContext.
+func (e *celEvaluator) EvaluateRequestHeaderExpression(expression string, ctx *policy.RequestHeaderContext) (interface{}, error) {
+	program, err := e.getOrCompileProgram(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression: %w", err)
+	}
+	evalCtx := buildRequestHeaderEvalCtx(ctx, "request_headers")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.Headers)
+	return e.evalValue(program, evalCtx)
+}
+
+// EvaluateResponseHeaderExpression evaluates a CEL expression of any result type
+// against a ResponseHeaderContext.
+func (e *celEvaluator) EvaluateResponseHeaderExpression(expression string, ctx *policy.ResponseHeaderContext) (interface{}, error) {
+	program, err := e.getOrCompileProgram(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression: %w", err)
+	}
+	evalCtx := buildResponseHeaderEvalCtx(ctx, "response_headers")
+	evalCtx[jwtClaimsVar] = e.lazyJWTClaims(ctx.RequestHeaders)
+	return e.evalValue(program, evalCtx)
+}
+
+// evalValue evaluates a compiled program and returns its result as a plain Go
+// value: null becomes nil and lists become []interface{}.
+func (e *celEvaluator) evalValue(program cel.Program, evalCtx map[string]interface{}) (interface{}, error) {
+	result, _, err := program.Eval(evalCtx)
+	if err != nil {
+		return nil, fmt.Errorf("CEL evaluation failed: %w", err)
+	}
+	return nativeValue(result), nil
+}
+
+func nativeValue(val ref.Val) interface{} {
+	switch v := val.(type) {
+	case types.Null:
+		return nil
+	case traits.Lister:
+		if native, err := v.ConvertToNative(reflect.TypeOf([]interface{}{})); err == nil {
+			return native
+		}
+	}
+	return val.Value()
+}
+
 // eval evaluates a compiled program against an evaluation context
+// checkEnv is the CEL environment used to validate conditions at load time,
+// created on first use.
+var checkEnv = sync.OnceValues(createCELEnv)
+
+// ValidateCondition compiles an execution condition without evaluating it,
+// so that configuration carrying a broken condition can be rejected before
+// it is applied. Expressions that cannot yield a boolean are rejected too.
+func ValidateCondition(expression string) error {
+	env, err := checkEnv()
+	if err != nil {
+		return fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("CEL compilation failed: %w", issues.Err())
+	}
+	if out := ast.OutputType(); !out.IsAssignableType(cel.BoolType) {
+		return fmt.Errorf("CEL expression must return boolean, got %s", out)
+	}
+	return nil
+}
+
 func (e *celEvaluator) eval(program cel.Program, evalCtx map[string]interface{}) (bool, error) {
 	result, _, err := program.Eval(evalCtx)
 	if err != nil {
@@ -894,3 +894,131 @@ func TestCELEvaluator_RealWorldExpressions(t *testing.T) {
 		})
 	}
 }
+
+// =============================================================================
+// IP Matching Function Tests
+// =============================================================================
+
+func TestEvaluateCondition_IPFunctions(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		clientIP   string
+		expression string
+		expected   bool
+	}{
+		{name: "IPv4 in CIDR", clientIP: "10.1.2.3", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: true},
+		{name: "IPv4 outside CIDR", clientIP: "11.1.2.3", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: false},
+		{name: "IPv4 single address range", clientIP: "192.168.1.10", expression: `inCIDR(request.ClientIP, "192.168.1.10")`, expected: true},
+		{name: "IPv4 non-canonical CIDR", clientIP: "172.16.5.4", expression: `inCIDR(request.ClientIP, "172.16.5.0/12")`, expected: true},
+		{name: "IPv6 in CIDR", clientIP: "2001:db8::1", expression: `inCIDR(request.ClientIP, "2001:db8::/32")`, expected: true},
+		{name: "IPv6 outside CIDR", clientIP: "2001:db9::1", expression: `inCIDR(request.ClientIP, "2001:db8::/32")`, expected: false},
+		{name: "IPv4 against IPv6 CIDR", clientIP: "10.1.2.3", expression: `inCIDR(request.ClientIP, "2001:db8::/32")`, expected: false},
+		{name: "IPv4-mapped IPv6 in IPv4 CIDR", clientIP: "::ffff:10.1.2.3", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: true},
+		{name: "Ranges match second entry", clientIP: "192.168.1.10", expression: `ipInRanges(request.ClientIP, ["10.0.0.0/8", "192.168.0.0/16"])`, expected: true},
+		{name: "Ranges mixed families", clientIP: "fd00::5", expression: `ipInRanges(request.ClientIP, ["10.0.0.0/8", "fd00::/8"])`, expected: true},
+		{name: "Ranges no match", clientIP: "8.8.8.8", expression: `ipInRanges(request.ClientIP, ["10.0.0.0/8", "192.168.0.0/16"])`, expected: false},
+		{name: "Ranges empty list", clientIP: "10.1.2.3", expression: `ipInRanges(request.ClientIP, [])`, expected: false},
+		{name: "Ranges skip malformed entry", clientIP: "10.1.2.3", expression: `ipInRanges(request.ClientIP, ["bogus", "10.0.0.0/8"])`, expected: true},
+		{name: "Negated for deny lists", clientIP: "8.8.8.8", expression: `!ipInRanges(request.ClientIP, ["10.0.0.0/8"])`, expected: true},
+		{name: "Malformed IP", clientIP: "not-an-ip", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: false},
+		{name: "IP with port", clientIP: "10.1.2.3:8080", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: false},
+		{name: "Empty IP", clientIP: "", expression: `inCIDR(request.ClientIP, "10.0.0.0/8")`, expected: false},
+		{name: "Malformed CIDR", clientIP: "10.1.2.3", expression: `inCIDR(request.ClientIP, "10.0.0.0/33")`, expected: false},
+		{name: "Malformed ranges only", clientIP: "10.1.2.3", expression: `ipInRanges(request.ClientIP, ["10.0.0.0/", "::/129"])`, expected: false},
+		{name: "Malformed input in chain", clientIP: "garbage", expression: `inCIDR(request.ClientIP, "10.0.0.0/8") || request.Method == "GET"`, expected: true},
+		{name: "IP from header", clientIP: "", expression: `inCIDR(request.Headers["x-real-ip"][0], "198.51.100.0/24")`, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx := testutils.NewTestRequestContextWithHeaders(map[string][]string{"x-real-ip": {"198.51.100.20"}})
+			reqCtx.Downstream.ClientIP = tt.clientIP
+
+			result, err := evaluator.EvaluateRequestBodyCondition(tt.expression, reqCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluateCondition_IPFunctionsAllPhases(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	expression := `inCIDR(request.ClientIP, "10.0.0.0/8")`
+	downstream := &policy.DownstreamContext{ClientIP: "10.9.8.7"}
+
+	respCtx := testutils.NewTestResponseContext()
+	respCtx.Downstream = downstream
+	result, err := evaluator.EvaluateResponseBodyCondition(expression, respCtx)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	headerCtx := &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(nil),
+		Downstream:    downstream,
+	}
+	result, err = evaluator.EvaluateRequestHeaderCondition(expression, headerCtx)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	// Without a downstream context the client IP is empty and never matches
+	headerCtx.Downstream = nil
+	result, err = evaluator.EvaluateRequestHeaderCondition(expression, headerCtx)
+	require.NoError(t, err)
+	assert.False(t, result)
+}
+
+func TestEvaluateCondition_IPFunctionsTypeErrors(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	// Wrong argument types are rejected when the expression is compiled
+	for _, expression := range []string{
+		`inCIDR(request.ClientIP, 8)`,
+		`inCIDR(request.ClientIP)`,
+		`ipInRanges(request.ClientIP, "10.0.0.0/8")`,
+	} {
+		_, err := evaluator.EvaluateRequestBodyCondition(expression, testutils.NewTestRequestContext())
+		assert.Error(t, err, expression)
+	}
+}
+
+// =============================================================================
+// ValidateCondition Tests
+// =============================================================================
+
+func TestValidateCondition(t *testing.T) {
+	valid := []string{
+		`request.Method == "GET"`,
+		`processing.phase == "response_headers" && response.ResponseStatus >= 500`,
+		`inCIDR(request.ClientIP, "10.0.0.0/8")`,
+		`jwt("admin")`,
+		`jwtAud().contains("orders")`,
+	}
+	for _, expression := range valid {
+		assert.NoError(t, ValidateCondition(expression), expression)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "syntax error", expression: `request.Method == `, wantErr: "CEL compilation failed"},
+		{name: "type mismatch", expression: `request.Method == 1`, wantErr: "no matching overload"},
+		{name: "undeclared reference", expression: `req.Method == "GET"`, wantErr: "undeclared reference"},
+		{name: "non-boolean result", expression: `request.Path`, wantErr: "must return boolean"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCondition(tt.expression)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cel
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// ipFunctions returns the CEL functions for matching IP addresses against CIDR ranges:
+//
+//	inCIDR(ip string, cidr string) bool
+//	ipInRanges(ip string, cidrs list(string)) bool
+//
+// Both accept IPv4 and IPv6 addresses. A range may also be a single address,
+// which matches only that address. Malformed addresses or ranges never match
+// and never raise an evaluation error, so a bad client IP cannot fail the chain.
+func ipFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("inCIDR",
+			cel.Overload("inCIDR_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(ip, cidr ref.Val) ref.Val {
+					addr, ok := parseIP(ip)
+					if !ok {
+						return types.False
+					}
+					return types.Bool(prefixContains(cidr, addr))
+				}),
+			),
+		),
+		cel.Function("ipInRanges",
+			cel.Overload("ipInRanges_string_list",
+				[]*cel.Type{cel.StringType, cel.ListType(cel.DynType)}, cel.BoolType,
+				cel.BinaryBinding(func(ip, cidrs ref.Val) ref.Val {
+					addr, ok := parseIP(ip)
+					if !ok {
+						return types.False
+					}
+					list, ok := cidrs.(traits.Lister)
+					if !ok {
+						return types.False
+					}
+					for it := list.Iterator(); it.HasNext() == types.True; {
+						if prefixContains(it.Next(), addr) {
+							return types.True
+						}
+					}
+					return types.False
+				}),
+			),
+		),
+	}
+}
+
+// parseIP parses a CEL string value as an IP address. IPv4-mapped IPv6
+// addresses are unmapped so they match IPv4 ranges.
+func parseIP(val ref.Val) (netip.Addr, bool) {
+	s, ok := val.(types.String)
+	if !ok {
+		return netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(strings.TrimSpace(string(s)))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// prefixContains reports whether the CIDR range held by val contains addr.
+func prefixContains(val ref.Val, addr netip.Addr) bool {
+	s, ok := val.(types.String)
+	if !ok {
+		return false
+	}
+	cidr := strings.TrimSpace(string(s))
+	if !strings.Contains(cidr, "/") {
+		single, err := netip.ParseAddr(cidr)
+		return err == nil && single.Unmap() == addr
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return false
+	}
+	return prefix.Masked().Contains(addr)
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cel
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	// jwtClaimsVar is the activation variable holding the decoded claims of the
+	// request's bearer token. The leading '@' keeps it out of reach of user
+	// expressions; it is only referenced by the jwt macros below.
+	jwtClaimsVar = "@jwt_claims"
+
+	// defaultJWTClaimsCacheSize is the maximum number of decoded token payloads
+	// kept in memory, keyed by token.
+	defaultJWTClaimsCacheSize = 1024
+
+	// maxJWTSize bounds the size of a token that will be decoded.
+	maxJWTSize = 16 * 1024
+)
+
+// jwtFunctions returns the CEL functions for reading claims of the bearer token:
+//
+//	jwt(claim string) dyn       value of the claim, or null when absent
+//	jwtAud() list(string)       "aud" claim as a list, empty when absent
+//	jwtSub() string             "sub" claim, empty when absent
+//
+// The token is taken from the Authorization header and decoded WITHOUT
+// verifying its signature; authentication policies are responsible for that.
+// A missing or undecodable token behaves as a token without claims.
+//
+// To keep conditions such as jwt('roles').contains('admin') from failing the
+// chain, contains() is also defined on lists and on null (always false).
+func jwtFunctions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Variable(jwtClaimsVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Macros(
+			cel.GlobalMacro("jwt", 1, jwtMacro("@jwt_claim")),
+			cel.GlobalMacro("jwtAud", 0, jwtMacro("@jwt_aud")),
+			cel.GlobalMacro("jwtSub", 0, jwtMacro("@jwt_sub")),
+		),
+		cel.Function("@jwt_claim",
+			cel.Overload("jwt_claim_map_string",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType), cel.StringType}, cel.DynType,
+				cel.BinaryBinding(func(claims, name ref.Val) ref.Val {
+					if val, ok := findClaim(claims, name); ok {
+						return val
+					}
+					return types.NullValue
+				}),
+			),
+		),
+		cel.Function("@jwt_aud",
+			cel.Overload("jwt_aud_map",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType)}, cel.ListType(cel.StringType),
+				cel.UnaryBinding(func(claims ref.Val) ref.Val {
+					return types.NewStringList(types.DefaultTypeAdapter, audience(claims))
+				}),
+			),
+		),
+		cel.Function("@jwt_sub",
+			cel.Overload("jwt_sub_map",
+				[]*cel.Type{cel.MapType(cel.StringType, cel.DynType)}, cel.StringType,
+				cel.UnaryBinding(func(claims ref.Val) ref.Val {
+					if sub, ok := findClaim(claims, types.String("sub")); ok {
+						if s, ok := sub.(types.String); ok {
+							return s
+						}
+					}
+					return types.String("")
+				}),
+			),
+		),
+		cel.Function("contains",
+			cel.MemberOverload("list_contains_dyn",
+				[]*cel.Type{cel.ListType(cel.DynType), cel.DynType}, cel.BoolType,
+				cel.BinaryBinding(func(list, elem ref.Val) ref.Val {
+					lister, ok := list.(traits.Lister)
+					if !ok {
+						return types.False
+					}
+					for it := lister.Iterator(); it.HasNext() == types.True; {
+						if it.Next().Equal(elem) == types.True {
+							return types.True
+						}
+					}
+					return types.False
+				}),
+			),
+			cel.MemberOverload("null_contains_dyn",
+				[]*cel.Type{cel.NullType, cel.DynType}, cel.BoolType,
+				cel.BinaryBinding(func(ref.Val, ref.Val) ref.Val {
+					return types.False
+				}),
+			),
+		),
+	}
+}
+
+// jwtMacro expands a jwt macro call into a call of the internal function with
+// the claims variable prepended to the arguments.
+func jwtMacro(function string) cel.MacroFactory {
+	return func(eh cel.MacroExprFactory, _ ast.Expr, args []ast.Expr) (ast.Expr, *common.Error) {
+		return eh.NewCall(function, append([]ast.Expr{eh.NewIdent(jwtClaimsVar)}, args...)...), nil
+	}
+}
+
+// findClaim looks up a claim in the claims map.
+func findClaim(claims, name ref.Val) (ref.Val, bool) {
+	mapper, ok := claims.(traits.Mapper)
+	if !ok {
+		return nil, false
+	}
+	return mapper.Find(name)
+}
+
+// audience returns the "aud" claim, which may be a single string or an array.
+func audience(claims ref.Val) []string {
+	aud, ok := findClaim(claims, types.String("aud"))
+	if !ok {
+		return []string{}
+	}
+	if s, ok := aud.(types.String); ok {
+		return []string{string(s)}
+	}
+	values := []string{}
+	if lister, ok := aud.(traits.Lister); ok {
+		for it := lister.Iterator(); it.HasNext() == types.True; {
+			if s, ok := it.Next().(types.String); ok {
+				values = append(values, string(s))
+			}
+		}
+	}
+	return values
+}
+
+// lazyJWTClaims returns an activation value that decodes the bearer token only
+// when an expression reads it.
+func (e *celEvaluator) lazyJWTClaims(headers *policy.Headers) func() any {
+	return func() any {
+		return e.jwtClaims(bearerToken(headers))
+	}
+}
+
+// jwtClaims returns the decoded claims of token. Results, including tokens that
+// fail to decode, are cached so the conditions of a request decode the token once.
+func (e *celEvaluator) jwtClaims(token string) map[string]any {
+	if token == "" {
+		return map[string]any{}
+	}
+
+	e.claimsMu.Lock()
+	claims, ok := e.claimsCache.get(token)
+	e.claimsMu.Unlock()
+	if ok {
+		return claims
+	}
+
+	claims = decodeJWTClaims(token)
+	e.claimsMu.Lock()
+	e.claimsCache.put(token, claims)
+	e.claimsMu.Unlock()
+	return claims
+}
+
+// bearerToken extracts the token from the first Authorization header.
+func bearerToken(headers *policy.Headers) string {
+	values := headers.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	scheme, token, found := strings.Cut(strings.TrimSpace(values[0]), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// decodeJWTClaims decodes the payload of a compact JWS without verifying the
+// signature. It returns an empty map when the token is malformed.
+func decodeJWTClaims(token string) map[string]any {
+	claims := map[string]any{}
+	if len(token) > maxJWTSize {
+		return claims
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return claims
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims == nil {
+		return map[string]any{}
+	}
+	return claims
+}
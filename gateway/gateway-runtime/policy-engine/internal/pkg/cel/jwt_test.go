@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cel
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// testJWT builds an unsigned compact JWS carrying claims. The evaluator never
+// verifies signatures, so a placeholder signature segment is enough.
+func testJWT(t *testing.T, claims map[string]any) string {
+	t.Helper()
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+func requestWithAuthorization(authorization string) *policy.RequestContext {
+	headers := map[string][]string{}
+	if authorization != "" {
+		headers["authorization"] = []string{authorization}
+	}
+	return testutils.NewTestRequestContextWithHeaders(headers)
+}
+
+func TestEvaluateCondition_JWTFunctions(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	token := testJWT(t, map[string]any{
+		"sub":   "alice",
+		"aud":   []string{"orders-api", "billing-api"},
+		"roles": []string{"admin", "viewer"},
+		"scope": "read write",
+		"tier":  "gold",
+		"exp":   1893456000,
+		"realm_access": map[string]any{
+			"roles": []string{"offline_access"},
+		},
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		expression    string
+		expected      bool
+	}{
+		// Array-valued claims
+		{name: "Array claim contains", authorization: "Bearer " + token, expression: `jwt('roles').contains('admin')`, expected: true},
+		{name: "Array claim does not contain", authorization: "Bearer " + token, expression: `jwt('roles').contains('owner')`, expected: false},
+		{name: "Array claim with in operator", authorization: "Bearer " + token, expression: `'viewer' in jwt('roles')`, expected: true},
+		{name: "Array claim size", authorization: "Bearer " + token, expression: `size(jwt('roles')) == 2`, expected: true},
+		{name: "Nested claim", authorization: "Bearer " + token, expression: `'offline_access' in jwt('realm_access').roles`, expected: true},
+		{name: "Array audience", authorization: "Bearer " + token, expression: `'billing-api' in jwtAud()`, expected: true},
+
+		// Scalar claims
+		{name: "String claim equals", authorization: "Bearer " + token, expression: `jwt('tier') == 'gold'`, expected: true},
+		{name: "String claim contains substring", authorization: "Bearer " + token, expression: `jwt('scope').contains('write')`, expected: true},
+		{name: "Numeric claim", authorization: "Bearer " + token, expression: `jwt('exp') > 1700000000`, expected: true},
+		{name: "Subject", authorization: "Bearer " + token, expression: `jwtSub() == 'alice'`, expected: true},
+		{name: "Lowercase bearer scheme", authorization: "bearer " + token, expression: `jwtSub() == 'alice'`, expected: true},
+
+		// Missing claims
+		{name: "Missing claim is null", authorization: "Bearer " + token, expression: `jwt('groups') == null`, expected: true},
+		{name: "Missing claim contains is false", authorization: "Bearer " + token, expression: `jwt('groups').contains('admin')`, expected: false},
+		{name: "Missing claim guarded", authorization: "Bearer " + token, expression: `jwt('groups') != null && 'admin' in jwt('groups')`, expected: false},
+
+		// Missing or unusable tokens
+		{name: "No token claim is null", authorization: "", expression: `jwt('roles') == null`, expected: true},
+		{name: "No token contains is false", authorization: "", expression: `jwt('roles').contains('admin')`, expected: false},
+		{name: "No token subject is empty", authorization: "", expression: `jwtSub() == ''`, expected: true},
+		{name: "No token audience is empty", authorization: "", expression: `size(jwtAud()) == 0`, expected: true},
+		{name: "Basic auth is ignored", authorization: "Basic YWxpY2U6c2VjcmV0", expression: `jwtSub() == ''`, expected: true},
+		{name: "Malformed token", authorization: "Bearer not.a-jwt", expression: `jwt('sub') == null`, expected: true},
+		{name: "Encrypted token", authorization: "Bearer a.b.c.d.e", expression: `jwt('sub') == null`, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := evaluator.EvaluateRequestBodyCondition(tt.expression, requestWithAuthorization(tt.authorization))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestEvaluateCondition_JWTSingleAudience(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	reqCtx := requestWithAuthorization("Bearer " + testJWT(t, map[string]any{"aud": "orders-api"}))
+	result, err := evaluator.EvaluateRequestBodyCondition(`jwtAud() == ['orders-api']`, reqCtx)
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestEvaluateCondition_JWTResponsePhase(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	// Response-phase conditions read the token from the original request headers
+	respCtx := testutils.NewTestResponseContext()
+	respCtx.RequestHeaders = policy.NewHeaders(map[string][]string{
+		"authorization": {"Bearer " + testJWT(t, map[string]any{"sub": "bob"})},
+	})
+	result, err := evaluator.EvaluateResponseHeaderCondition(`jwtSub() == 'bob'`, &policy.ResponseHeaderContext{
+		SharedContext:   respCtx.SharedContext,
+		RequestHeaders:  respCtx.RequestHeaders,
+		ResponseHeaders: respCtx.ResponseHeaders,
+		ResponseStatus:  200,
+	})
+	require.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestEvaluateCondition_JWTClaimsCachedPerToken(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+	e := evaluator.(*celEvaluator)
+
+	reqCtx := requestWithAuthorization("Bearer " + testJWT(t, map[string]any{"sub": "alice", "roles": []string{"admin"}}))
+
+	// Expressions that never read the token do not decode it
+	_, err = evaluator.EvaluateRequestBodyCondition(`request.Method == "GET"`, reqCtx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, e.claimsCache.len())
+
+	for _, expression := range []string{`jwtSub() == 'alice'`, `jwt('roles').contains('admin')`, `size(jwtAud()) == 0`} {
+		result, err := evaluator.EvaluateRequestBodyCondition(expression, reqCtx)
+		require.NoError(t, err)
+		assert.True(t, result, expression)
+	}
+	assert.Equal(t, 1, e.claimsCache.len())
+
+	// Requests without a token are not cached
+	_, err = evaluator.EvaluateRequestBodyCondition(`jwtSub() == ''`, requestWithAuthorization(""))
+	require.NoError(t, err)
+	assert.Equal(t, 1, e.claimsCache.len())
+}
+
+func TestEvaluateCondition_JWTClaimsVariableNotAddressable(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	for _, expression := range []string{`@jwt_claims.sub == 'alice'`, `jwt()`, `jwt(1) == null`} {
+		_, err := evaluator.EvaluateRequestBodyCondition(expression, testutils.NewTestRequestContext())
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestEvaluateRequestHeaderExpression(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	reqCtx := &policy.RequestHeaderContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Headers: policy.NewHeaders(map[string][]string{
+			"authorization": {"Bearer " + testJWT(t, map[string]any{"sub": "alice", "roles": []string{"admin", "viewer"}})},
+		}),
+		Path:   "/orders",
+		Method: "GET",
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   interface{}
+	}{
+		{name: "String claim", expression: `jwtSub()`, expected: "alice"},
+		{name: "Array claim", expression: `jwt('roles')`, expected: []interface{}{"admin", "viewer"}},
+		{name: "Missing claim is nil", expression: `jwt('email')`, expected: nil},
+		{name: "Boolean", expression: `'admin' in jwt('roles')`, expected: true},
+		{name: "Integer", expression: `size(jwt('roles'))`, expected: int64(2)},
+		{name: "Request attribute", expression: `request.Method + ' ' + request.Path`, expected: "GET /orders"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, evaluator.ValidateExpression(tt.expression))
+			result, err := evaluator.EvaluateRequestHeaderExpression(tt.expression, reqCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	assert.Error(t, evaluator.ValidateExpression(`jwtSub(`))
+}
+
+func TestEvaluateResponseHeaderExpression(t *testing.T) {
+	evaluator, err := NewCELEvaluator()
+	require.NoError(t, err)
+
+	result, err := evaluator.EvaluateResponseHeaderExpression(`jwtSub() + ':' + string(response.ResponseStatus)`, &policy.ResponseHeaderContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		RequestHeaders: policy.NewHeaders(map[string][]string{
+			"authorization": {"Bearer " + testJWT(t, map[string]any{"sub": "bob"})},
+		}),
+		ResponseHeaders: policy.NewHeaders(nil),
+		ResponseStatus:  201,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bob:201", result)
+}
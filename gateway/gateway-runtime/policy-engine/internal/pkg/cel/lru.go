@@ -24,43 +24,51 @@ import (
 	"github.com/google/cel-go/cel"
 )
 
-// programLRUCache is a fixed-capacity LRU cache for compiled cel.Programs.
+// lruCache is a fixed-capacity LRU cache keyed by string.
 // All methods are O(1). The caller is responsible for external synchronisation.
-type programLRUCache struct {
+type lruCache[V any] struct {
 	capacity int
 	list     *list.List
 	items    map[string]*list.Element
 }
 
-type lruEntry struct {
-	key     string
-	program cel.Program
+type lruEntry[V any] struct {
+	key   string
+	value V
 }
 
+// programLRUCache caches compiled cel.Programs keyed by expression.
+type programLRUCache = lruCache[cel.Program]
+
 func newProgramLRUCache(capacity int) *programLRUCache {
-	return &programLRUCache{
+	return newLRUCache[cel.Program](capacity)
+}
+
+func newLRUCache[V any](capacity int) *lruCache[V] {
+	return &lruCache[V]{
 		capacity: capacity,
 		list:     list.New(),
 		items:    make(map[string]*list.Element, capacity),
 	}
 }
 
-// get returns the cached program for key and promotes it to most-recently-used.
+// get returns the cached value for key and promotes it to most-recently-used.
 // Returns false when the key is absent.
-func (c *programLRUCache) get(key string) (cel.Program, bool) {
+func (c *lruCache[V]) get(key string) (V, bool) {
 	elem, ok := c.items[key]
 	if !ok {
-		return nil, false
+		var zero V
+		return zero, false
 	}
 	c.list.MoveToFront(elem)
-	return elem.Value.(*lruEntry).program, true
+	return elem.Value.(*lruEntry[V]).value, true
 }
 
-// put inserts or updates the program for key.
+// put inserts or updates the value for key.
 // When the cache is at capacity the least-recently-used entry is evicted first.
-func (c *programLRUCache) put(key string, program cel.Program) {
+func (c *lruCache[V]) put(key string, value V) {
 	if elem, ok := c.items[key]; ok {
-		elem.Value.(*lruEntry).program = program
+		elem.Value.(*lruEntry[V]).value = value
 		c.list.MoveToFront(elem)
 		return
 	}
@@ -68,14 +76,14 @@ func (c *programLRUCache) put(key string, program cel.Program) {
 		back := c.list.Back()
 		if back != nil {
 			c.list.Remove(back)
-			delete(c.items, back.Value.(*lruEntry).key)
+			delete(c.items, back.Value.(*lruEntry[V]).key)
 		}
 	}
-	elem := c.list.PushFront(&lruEntry{key: key, program: program})
+	elem := c.list.PushFront(&lruEntry[V]{key: key, value: value})
 	c.items[key] = elem
 }
 
 // len returns the current number of cached entries.
-func (c *programLRUCache) len() int {
+func (c *lruCache[V]) len() int {
 	return c.list.Len()
 }
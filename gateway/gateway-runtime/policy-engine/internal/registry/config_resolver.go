@@ -113,7 +113,6 @@ func (r *ConfigResolver) resolveValue(value interface{}, logResolveFailures bool
 			slog.Debug("Config reference resolved",
 				"reference", strValue,
 				"celExpression", celExpr,
-				"resolvedValue", resolved,
 				"phase", "runtime")
 
 			return resolved, nil
@@ -156,7 +155,6 @@ func (r *ConfigResolver) resolveValue(value interface{}, logResolveFailures bool
 		slog.Debug("Config reference in template resolved",
 			"placeholder", placeholder,
 			"celExpression", celExpr,
-			"resolvedValue", resolved,
 			"phase", "runtime")
 	}
 
@@ -167,7 +165,6 @@ func (r *ConfigResolver) resolveValue(value interface{}, logResolveFailures bool
 
 	slog.Debug("Template resolution complete",
 		"original", strValue,
-		"resolved", result,
 		"phase", "runtime")
 
 	return result, nil
@@ -354,3 +351,117 @@ func isMissingConfigKeyError(err error) bool {
 	}
 	return strings.Contains(err.Error(), "no such key:")
 }
+
+// paramConfigRefPattern matches the ${config...} references allowed in policy parameters.
+// Unlike systemParameters, parameters are authored per API and may carry ${...} templates
+// of their own (header-transform evaluates "${jwtSub()}" per request), so only references
+// to config are resolved here.
+var paramConfigRefPattern = regexp.MustCompile(`\$\{\s*(config[.\[][^}]*?)\s*\}`)
+
+// ResolveParams resolves the ${config...} references in policy parameters, e.g.
+// "${config.embedding.api_key}", so that shared values such as provider credentials need
+// not be repeated in every API. A parameter that is a single reference takes the type of
+// the referenced value; references embedded in a longer string are substituted as text.
+// A "$" before the reference ("$${config.x}") escapes it. It returns the resolved
+// parameters along with the string values the references resolved to, which must not be
+// echoed outside the engine. Any reference that cannot be resolved is an error naming the
+// parameter.
+func (r *ConfigResolver) ResolveParams(params map[string]interface{}) (map[string]interface{}, []string, error) {
+	var resolvedValues []string
+	resolved, err := r.resolveParam(params, "", &resolvedValues)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, _ := resolved.(map[string]interface{})
+	return m, resolvedValues, nil
+}
+
+func (r *ConfigResolver) resolveParam(value interface{}, path string, resolvedValues *[]string) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return r.resolveParamString(v, path, resolvedValues)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+			resolved, err := r.resolveParam(item, itemPath, resolvedValues)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = resolved
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			resolved, err := r.resolveParam(item, fmt.Sprintf("%s[%d]", path, i), resolvedValues)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+func (r *ConfigResolver) resolveParamString(value, path string, resolvedValues *[]string) (interface{}, error) {
+	var refs [][]int
+	for _, loc := range paramConfigRefPattern.FindAllStringSubmatchIndex(value, -1) {
+		if loc[0] > 0 && value[loc[0]-1] == '$' {
+			continue // escaped: "$${config.x}"
+		}
+		refs = append(refs, loc)
+	}
+	if len(refs) == 0 {
+		return value, nil
+	}
+
+	evaluate := func(loc []int) (interface{}, error) {
+		ref := value[loc[0]:loc[1]]
+		if r == nil || r.config == nil {
+			return nil, fmt.Errorf("parameter %q: config reference %s not resolved: no config is loaded", path, ref)
+		}
+		resolved, err := r.evaluateCEL(value[loc[2]:loc[3]])
+		if err == nil && resolved == nil {
+			err = fmt.Errorf("the value is null")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: config reference %s not resolved: %w", path, ref, err)
+		}
+		return resolved, nil
+	}
+
+	// A parameter that is a single reference keeps the type of the referenced value.
+	if len(refs) == 1 && refs[0][0] == 0 && refs[0][1] == len(value) {
+		resolved, err := evaluate(refs[0])
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := resolved.(string); ok {
+			*resolvedValues = append(*resolvedValues, s)
+		}
+		return resolved, nil
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, loc := range refs {
+		resolved, err := evaluate(loc)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := resolved.(string); ok {
+			*resolvedValues = append(*resolvedValues, s)
+		}
+		b.WriteString(value[last:loc[0]])
+		b.WriteString(fmt.Sprintf("%v", resolved))
+		last = loc[1]
+	}
+	b.WriteString(value[last:])
+	return b.String(), nil
+}
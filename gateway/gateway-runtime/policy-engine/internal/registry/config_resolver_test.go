@@ -804,3 +804,52 @@ func TestConfigResolver_FallbackMarkers(t *testing.T) {
 		}
 	})
 }
+
+func TestConfigResolver_ResolveParams(t *testing.T) {
+	resolver, err := NewConfigResolver(map[string]interface{}{
+		"embedding": map[string]interface{}{"api_key": "sk-secret", "model": "text-embedding-3-small"},
+	})
+	if err != nil {
+		t.Fatalf("NewConfigResolver() unexpected error: %v", err)
+	}
+
+	params := map[string]interface{}{
+		"keys":    []interface{}{"${ config.embedding.api_key }", "static"},
+		"escaped": "$${config.embedding.api_key}",
+		"model":   "${config['embedding'].model}",
+		"count":   3.0,
+	}
+	got, resolvedValues, err := resolver.ResolveParams(params)
+	if err != nil {
+		t.Fatalf("ResolveParams() unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"keys":    []interface{}{"sk-secret", "static"},
+		"escaped": "$${config.embedding.api_key}",
+		"model":   "text-embedding-3-small",
+		"count":   3.0,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResolveParams() = %v, want %v", got, want)
+	}
+	if len(resolvedValues) != 2 {
+		t.Errorf("ResolveParams() resolved values = %v, want the two referenced values", resolvedValues)
+	}
+}
+
+func TestConfigResolver_ResolveParams_NoConfig(t *testing.T) {
+	resolver, err := NewConfigResolver(nil)
+	if err != nil {
+		t.Fatalf("NewConfigResolver() unexpected error: %v", err)
+	}
+
+	// Without config a reference cannot be resolved; parameters without one are unaffected.
+	if _, _, err := resolver.ResolveParams(map[string]interface{}{"key": "${config.embedding.api_key}"}); err == nil {
+		t.Error("ResolveParams() expected an error for an unresolved reference")
+	}
+	got, _, err := resolver.ResolveParams(map[string]interface{}{"key": "value"})
+	if err != nil || got["key"] != "value" {
+		t.Errorf("ResolveParams() = %v, %v; want the parameters unchanged", got, err)
+	}
+}
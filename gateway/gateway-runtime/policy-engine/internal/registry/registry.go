@@ -20,6 +20,7 @@ package registry
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/wso2/api-platform/common/version"
@@ -42,8 +43,18 @@ type PolicyRegistry struct {
 	// Example key: "jwtValidation:v1"
 	Policies map[string]*PolicyEntry
 
-	// ConfigResolver resolves ${config} CEL expressions in systemParameters
+	// ConfigResolver resolves ${config} CEL expressions in systemParameters, and
+	// ${config...} references in policy parameters. Swapped by SetConfig when
+	// secrets in the config rotate, so it is read under configMu.
 	ConfigResolver *ConfigResolver
+	configMu       sync.RWMutex
+
+	// paramSecrets holds the string values ${config...} references in policy
+	// parameters resolved to, and configSecrets the values secret:// references
+	// in the config resolved to, so the config dump can redact them
+	paramSecretsMu sync.RWMutex
+	paramSecrets   map[string]struct{}
+	configSecrets  []string
 }
 
 // Global singleton registry
@@ -100,15 +111,26 @@ func (r *PolicyRegistry) GetInstance(
 	}
 
 	// Resolve ${config} references in initParams
-	if r.ConfigResolver == nil {
+	r.configMu.RLock()
+	configResolver := r.ConfigResolver
+	r.configMu.RUnlock()
+	if configResolver == nil {
 		return nil, nil, fmt.Errorf("policy %s: ConfigResolver is not initialized", key)
 	}
 	var err error
-	initParams, err = r.ConfigResolver.ResolveMap(initParams)
+	initParams, err = configResolver.ResolveMap(initParams)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to resolve config for policy %s: %w", key, err)
 	}
 
+	// Resolve ${config...} references in runtime params. The resolved values stay inside
+	// the engine: they are tracked so that the config dump redacts them.
+	params, resolvedValues, err := configResolver.ResolveParams(params)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve config references in parameters of policy %s: %w", key, err)
+	}
+	r.trackParamSecrets(resolvedValues)
+
 	// Merge resolved initParams with runtime params (params override initParams)
 	mergedParams := mergeParams(initParams, params)
 
@@ -155,16 +177,55 @@ func (r *PolicyRegistry) Register(def *policy.PolicyDefinition, factory policy.P
 }
 
 // SetConfig sets the configuration for resolving ${config} references in systemParameters
-// This should be called during startup after loading the config file
+// This should be called during startup after loading the config file, and again when
+// secrets referenced by the config rotate. Instances already built keep the values
+// they were built with; chains have to be rebuilt to pick up the new config.
 func (r *PolicyRegistry) SetConfig(config map[string]interface{}) error {
 	resolver, err := NewConfigResolver(config)
 	if err != nil {
 		return fmt.Errorf("failed to create config resolver: %w", err)
 	}
+	r.configMu.Lock()
 	r.ConfigResolver = resolver
+	r.configMu.Unlock()
 	return nil
 }
 
+// SetConfigSecrets records the values secret:// references in the config resolved to,
+// replacing the previous set, so the config dump redacts them.
+func (r *PolicyRegistry) SetConfigSecrets(values []string) {
+	r.paramSecretsMu.Lock()
+	defer r.paramSecretsMu.Unlock()
+	r.configSecrets = slices.Clone(values)
+}
+
+// trackParamSecrets records values resolved from ${config...} references in policy parameters.
+func (r *PolicyRegistry) trackParamSecrets(values []string) {
+	if len(values) == 0 {
+		return
+	}
+	r.paramSecretsMu.Lock()
+	defer r.paramSecretsMu.Unlock()
+	if r.paramSecrets == nil {
+		r.paramSecrets = make(map[string]struct{}, len(values))
+	}
+	for _, v := range values {
+		r.paramSecrets[v] = struct{}{}
+	}
+}
+
+// SensitiveValues returns the values ${config...} references in policy parameters and
+// secret:// references in the config resolved to, for redaction in the config dump.
+func (r *PolicyRegistry) SensitiveValues() []string {
+	r.paramSecretsMu.RLock()
+	defer r.paramSecretsMu.RUnlock()
+	values := make([]string, 0, len(r.paramSecrets)+len(r.configSecrets))
+	for v := range r.paramSecrets {
+		values = append(values, v)
+	}
+	return append(values, r.configSecrets...)
+}
+
 // compositeKey creates a composite key from name and version
 func compositeKey(name, version string) string {
 	return fmt.Sprintf("%s:%s", name, version)
@@ -385,6 +385,58 @@ func TestGetInstance(t *testing.T) {
 		assert.Equal(t, "my-audience", mergedParams["audience"])
 	})
 
+	t.Run("resolve config references in params", func(t *testing.T) {
+		reg := newTestRegistry()
+		require.NoError(t, reg.SetConfig(map[string]interface{}{
+			"embedding": map[string]interface{}{"api_key": "sk-shared-secret", "dimensions": 1536},
+		}))
+		factory := testutils.NewMockPolicyFactory("semantic-cache", "v1.0.0")
+		require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "semantic-cache", Version: "v1.0.0"}, factory))
+
+		params := map[string]interface{}{
+			"apiKey":     "${config.embedding.api_key}",
+			"dimensions": "${config.embedding.dimensions}",
+			"header":     "Bearer ${config.embedding.api_key}",
+			"template":   "${jwtSub()}",
+		}
+		_, mergedParams, err := reg.GetInstance("semantic-cache", "v1", policy.PolicyMetadata{}, params)
+		require.NoError(t, err)
+
+		assert.Equal(t, "sk-shared-secret", mergedParams["apiKey"])
+		assert.EqualValues(t, 1536, mergedParams["dimensions"])
+		assert.Equal(t, "Bearer sk-shared-secret", mergedParams["header"])
+		assert.Equal(t, "${jwtSub()}", mergedParams["template"], "other templates are left to the policy")
+		assert.Equal(t, "${config.embedding.api_key}", params["apiKey"], "the configured params are not modified")
+		assert.Equal(t, []string{"sk-shared-secret"}, reg.SensitiveValues())
+	})
+
+	t.Run("unresolved config reference in params", func(t *testing.T) {
+		reg := newTestRegistry()
+		require.NoError(t, reg.SetConfig(map[string]interface{}{"embedding": map[string]interface{}{}}))
+		factory := testutils.NewMockPolicyFactory("semantic-cache", "v1.0.0")
+		require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "semantic-cache", Version: "v1.0.0"}, factory))
+
+		params := map[string]interface{}{
+			"provider": map[string]interface{}{"apiKey": "${config.embedding.api_key}"},
+		}
+		instance, _, err := reg.GetInstance("semantic-cache", "v1", policy.PolicyMetadata{}, params)
+		require.Error(t, err)
+		assert.Nil(t, instance)
+		assert.Contains(t, err.Error(), "semantic-cache:v1")
+		assert.Contains(t, err.Error(), `parameter "provider.apiKey": config reference ${config.embedding.api_key} not resolved`)
+		assert.Empty(t, reg.SensitiveValues())
+	})
+
+	t.Run("config secrets are sensitive values", func(t *testing.T) {
+		reg := newTestRegistry()
+		reg.SetConfigSecrets([]string{"sk-old"})
+		assert.Equal(t, []string{"sk-old"}, reg.SensitiveValues())
+
+		// A refresh replaces the previous set
+		reg.SetConfigSecrets([]string{"sk-new"})
+		assert.Equal(t, []string{"sk-new"}, reg.SensitiveValues())
+	})
+
 	t.Run("create instance without config resolver", func(t *testing.T) {
 		reg := newTestRegistry()
 
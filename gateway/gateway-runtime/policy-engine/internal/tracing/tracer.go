@@ -20,24 +20,41 @@ package tracing
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"log/slog"
-	"time"
-
+	"net/url"
+	"os"
 	"strings"
+	"time"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
+// Default collector endpoints for each OTLP protocol.
+const (
+	defaultGRPCEndpoint = "otel-collector:4317"
+	defaultHTTPEndpoint = "otel-collector:4318"
+
+	// defaultHTTPTracesPath is the OTLP/HTTP traces path, used when an endpoint
+	// URL has no path of its own.
+	defaultHTTPTracesPath = "/v1/traces"
+)
+
 // InitTracer initializes the OpenTelemetry tracer and returns a shutdown function
 // InitTracer initializes the OpenTelemetry tracer using values from cfg.
 // If tracing is disabled in the configuration, this is a no-op and a
@@ -50,20 +67,7 @@ func InitTracer(cfg *config.Config) (func(), error) {
 		return func() {}, nil
 	}
 
-	endpoint := cfg.TracingConfig.Endpoint
-	if endpoint == "" {
-		endpoint = "otel-collector:4317"
-	}
-
-	slog.InfoContext(ctx, "Initializing OTLP exporter", "endpoint", endpoint)
-
-	// Create OTLP exporter with configured options
-	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
-	if cfg.TracingConfig.Insecure {
-		opts = append(opts, otlptracegrpc.WithInsecure())
-	}
-
-	exporter, err := otlptracegrpc.New(ctx, opts...)
+	exporter, err := newExporter(ctx, cfg.TracingConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -159,6 +163,102 @@ func InitTracer(cfg *config.Config) (func(), error) {
 	}, nil
 }
 
+// newExporter creates the OTLP span exporter for the configured protocol.
+func newExporter(ctx context.Context, tc config.TracingConfig) (sdktrace.SpanExporter, error) {
+	var tlsCfg *tls.Config
+	if !tc.Insecure {
+		var err error
+		if tlsCfg, err = exporterTLSConfig(tc.TLS); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoint := tc.Endpoint
+	isURL := strings.Contains(endpoint, "://")
+
+	switch tc.Protocol {
+	case config.TracingProtocolHTTP:
+		if endpoint == "" {
+			endpoint = defaultHTTPEndpoint
+		}
+		slog.InfoContext(ctx, "Initializing OTLP exporter", "protocol", tc.Protocol, "endpoint", endpoint)
+		opts := []otlptracehttp.Option{otlptracehttp.WithHeaders(tc.Headers)}
+		if isURL {
+			opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+			if u, err := url.Parse(endpoint); err == nil && strings.Trim(u.Path, "/") == "" {
+				opts = append(opts, otlptracehttp.WithURLPath(defaultHTTPTracesPath))
+			}
+		} else {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if tc.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		} else {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsCfg))
+		}
+		return otlptracehttp.New(ctx, opts...)
+
+	case "", config.TracingProtocolGRPC:
+		if endpoint == "" {
+			endpoint = defaultGRPCEndpoint
+		}
+		slog.InfoContext(ctx, "Initializing OTLP exporter", "protocol", config.TracingProtocolGRPC, "endpoint", endpoint)
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(tc.Headers)}
+		if isURL {
+			opts = append(opts, otlptracegrpc.WithEndpointURL(endpoint))
+		} else {
+			opts = append(opts, otlptracegrpc.WithEndpoint(endpoint))
+		}
+		if tc.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsCfg)))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+
+	default:
+		return nil, fmt.Errorf("unsupported tracing protocol %q", tc.Protocol)
+	}
+}
+
+// exporterTLSConfig builds the exporter's TLS client configuration. The
+// client private key must not be readable by group or others.
+func exporterTLSConfig(tc config.TracingTLSConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: tc.ServerName,
+	}
+
+	if tc.CAFile != "" {
+		pem, err := os.ReadFile(tc.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tracing CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tracing CA file contains no PEM certificates")
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if tc.CertFile != "" || tc.KeyFile != "" {
+		info, err := os.Stat(tc.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat tracing key file: %w", err)
+		}
+		if perm := info.Mode().Perm(); perm&0o077 != 0 {
+			return nil, fmt.Errorf("tracing key file permissions %s are too permissive; restrict to owner (e.g. 0600)", perm)
+		}
+		cert, err := tls.LoadX509KeyPair(tc.CertFile, tc.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tracing client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
 // ExtractTraceContext extracts W3C Trace Context from gRPC metadata
 func ExtractTraceContext(ctx context.Context) context.Context {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -195,3 +295,27 @@ func ExtractTraceContext(ctx context.Context) context.Context {
 
 	return newCtx
 }
+
+// ExtractHTTPTraceContext extracts W3C Trace Context from the traceparent and
+// tracestate headers of the HTTP request being processed, so that gateway spans
+// join the caller's trace when Envoy did not propagate one over gRPC.
+func ExtractHTTPTraceContext(ctx context.Context, headers *corev3.HeaderMap) context.Context {
+	carrier := propagation.MapCarrier{}
+	for _, header := range headers.GetHeaders() {
+		key := strings.ToLower(header.GetKey())
+		if key != "traceparent" && key != "tracestate" {
+			continue
+		}
+		value := header.GetValue()
+		if value == "" {
+			value = string(header.GetRawValue())
+		}
+		if _, exists := carrier[key]; !exists {
+			carrier.Set(key, value)
+		}
+	}
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
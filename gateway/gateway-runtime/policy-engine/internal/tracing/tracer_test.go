@@ -20,10 +20,16 @@ package tracing
 
 import (
 	"context"
+	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
@@ -33,6 +39,7 @@ import (
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 // =============================================================================
@@ -818,3 +825,114 @@ func TestInitTracer_SecureConnectionWithoutCerts(t *testing.T) {
 	require.NotNil(t, shutdown)
 	defer shutdown()
 }
+
+// =============================================================================
+// Exporter Tests
+// =============================================================================
+
+func TestInitTracer_HTTPExporterEmitsServiceName(t *testing.T) {
+	type export struct {
+		path   string
+		apiKey string
+		req    *coltracepb.ExportTraceServiceRequest
+	}
+	exports := make(chan export, 4)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		e := export{path: r.URL.Path, apiKey: r.Header.Get("X-Api-Key"), req: &coltracepb.ExportTraceServiceRequest{}}
+		if err := proto.Unmarshal(body, e.req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		exports <- e
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer collector.Close()
+
+	cfg := &config.Config{
+		TracingConfig: config.TracingConfig{
+			Enabled:  true,
+			Protocol: config.TracingProtocolHTTP,
+			Endpoint: collector.URL,
+			Headers:  map[string]string{"X-Api-Key": "collector-key"},
+			Insecure: true,
+		},
+		PolicyEngine: config.PolicyEngine{
+			TracingServiceName: "edge-policy-engine",
+		},
+	}
+	shutdown, err := InitTracer(cfg)
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+	shutdown()
+
+	select {
+	case e := <-exports:
+		assert.Equal(t, "/v1/traces", e.path)
+		assert.Equal(t, "collector-key", e.apiKey)
+		require.NotEmpty(t, e.req.ResourceSpans)
+		var serviceName string
+		for _, attr := range e.req.ResourceSpans[0].Resource.Attributes {
+			if attr.Key == "service.name" {
+				serviceName = attr.Value.GetStringValue()
+			}
+		}
+		assert.Equal(t, "edge-policy-engine", serviceName)
+		spans := e.req.ResourceSpans[0].ScopeSpans[0].Spans
+		require.Len(t, spans, 1)
+		assert.Equal(t, "test-span", spans[0].Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no spans exported to the OTLP/HTTP collector")
+	}
+}
+
+func TestInitTracer_GRPCExporterByDefault(t *testing.T) {
+	collector := startTestOTLPServer(t)
+	defer collector.stop()
+
+	shutdown, err := InitTracer(&config.Config{
+		TracingConfig: config.TracingConfig{Enabled: true, Endpoint: collector.addr, Insecure: true},
+	})
+	require.NoError(t, err)
+	shutdown()
+}
+
+func TestExporterTLSConfig_RejectsPermissiveKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0o644))
+
+	_, err := exporterTLSConfig(config.TracingTLSConfig{CertFile: filepath.Join(dir, "client.crt"), KeyFile: keyFile})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too permissive")
+}
+
+func TestExporterTLSConfig_InvalidCAFile(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+
+	_, err := exporterTLSConfig(config.TracingTLSConfig{CAFile: caFile})
+	require.Error(t, err)
+}
+
+// =============================================================================
+// ExtractHTTPTraceContext Tests
+// =============================================================================
+
+func TestExtractHTTPTraceContext(t *testing.T) {
+	setupPropagator()
+	headers := &corev3.HeaderMap{Headers: []*corev3.HeaderValue{
+		{Key: ":path", RawValue: []byte("/pets")},
+		{Key: "Traceparent", RawValue: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+	}}
+
+	span := trace.SpanContextFromContext(ExtractHTTPTraceContext(context.Background(), headers))
+	assert.True(t, span.IsValid())
+	assert.True(t, span.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.TraceID().String())
+
+	none := trace.SpanContextFromContext(ExtractHTTPTraceContext(context.Background(), &corev3.HeaderMap{}))
+	assert.False(t, none.IsValid())
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+
+	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+)
+
+// accessLogSink writes access log entries to a writer as JSON lines, one entry
+// per line in the protobuf JSON mapping of HTTPAccessLogEntry.
+type accessLogSink struct {
+	mu      sync.Mutex
+	w       io.Writer
+	marshal protojson.MarshalOptions
+}
+
+// newAccessLogSink returns the sink selected by cfg, or nil when no sink is
+// configured. A file sink rotates the file once it reaches cfg.MaxSizeMB.
+func newAccessLogSink(cfg config.AccessLogsSinkConfig) (*accessLogSink, error) {
+	switch cfg.Sink {
+	case config.AccessLogsSinkNone:
+		return nil, nil
+	case config.AccessLogsSinkStdout:
+		return newAccessLogSinkWriter(os.Stdout), nil
+	case config.AccessLogsSinkFile:
+		return newAccessLogSinkWriter(&lumberjack.Logger{
+			Filename:   cfg.FilePath,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+		}), nil
+	}
+	return nil, fmt.Errorf("unknown access log sink: %s", cfg.Sink)
+}
+
+func newAccessLogSinkWriter(w io.Writer) *accessLogSink {
+	return &accessLogSink{
+		w:       w,
+		marshal: protojson.MarshalOptions{UseProtoNames: true},
+	}
+}
+
+// Write emits the entry as a single JSON line. The policy engine's dynamic
+// metadata is left out: it carries the unredacted headers and payloads
+// captured for analytics. Failures are logged and dropped so the sink never
+// interrupts the analytics pipeline.
+func (s *accessLogSink) Write(entry *accesslogv3.HTTPAccessLogEntry) {
+	if _, ok := entry.GetCommonProperties().GetMetadata().GetFilterMetadata()[constants.ExtProcFilterName]; ok {
+		entry = proto.Clone(entry).(*accesslogv3.HTTPAccessLogEntry)
+		delete(entry.CommonProperties.Metadata.FilterMetadata, constants.ExtProcFilterName)
+	}
+	line, err := s.marshal.Marshal(entry)
+	if err != nil {
+		slog.Warn("Failed to encode access log entry", "error", err)
+		return
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.w.Write(line); err != nil {
+		slog.Warn("Failed to write access log entry", "error", err)
+	}
+}
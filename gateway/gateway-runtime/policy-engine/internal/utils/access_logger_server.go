@@ -40,6 +40,7 @@ import (
 type AccessLogServiceServer struct {
 	cfg       *config.Config
 	analytics *analytics.Analytics
+	sink      *accessLogSink
 }
 
 func checkedUInt32FromPositiveInt(fieldName string, value int) (uint32, error) {
@@ -55,9 +56,16 @@ func checkedUInt32FromPositiveInt(fieldName string, value int) (uint32, error) {
 // newAccessLogServiceServer creates a new instance of the Access Log Service Server.
 func newAccessLogServiceServer(cfg *config.Config) *AccessLogServiceServer {
 	analytics := analytics.NewAnalytics(cfg)
+	sink, err := newAccessLogSink(cfg.Analytics.AccessLogs)
+	if err != nil {
+		slog.Error("Access log sink disabled", "error", err)
+	} else if sink != nil {
+		slog.Info("Access log sink enabled", "sink", cfg.Analytics.AccessLogs.Sink)
+	}
 	return &AccessLogServiceServer{
 		cfg:       cfg,
 		analytics: analytics,
+		sink:      sink,
 	}
 }
 
@@ -75,6 +83,9 @@ func (s *AccessLogServiceServer) StreamAccessLogs(stream v3.AccessLogService_Str
 		if httpLogs != nil {
 			slog.Debug("Received a stream of access logs", "count", len(httpLogs.LogEntry))
 			for _, logEntry := range httpLogs.LogEntry {
+				if s.sink != nil {
+					s.sink.Write(logEntry)
+				}
 				s.analytics.Process(logEntry)
 			}
 		}
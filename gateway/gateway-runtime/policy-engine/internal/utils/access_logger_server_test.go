@@ -19,17 +19,26 @@
 package utils
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
 	v3 "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
 )
 
 // =============================================================================
@@ -204,6 +213,84 @@ func TestStreamAccessLogs_MultipleMessages(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStreamAccessLogs_WritesEntriesToSink(t *testing.T) {
+	cfg := createTestConfig()
+	server := newAccessLogServiceServer(cfg)
+	var buf bytes.Buffer
+	server.sink = newAccessLogSinkWriter(&buf)
+
+	stream := &mockAccessLogStream{
+		messages: []*v3.StreamAccessLogsMessage{
+			{
+				LogEntries: &v3.StreamAccessLogsMessage_HttpLogs{
+					HttpLogs: &v3.StreamAccessLogsMessage_HTTPAccessLogEntries{
+						LogEntry: []*accesslogv3.HTTPAccessLogEntry{
+							{Request: &accesslogv3.HTTPRequestProperties{Path: "/pets", RequestMethod: corev3.RequestMethod_GET}},
+							{Response: &accesslogv3.HTTPResponseProperties{ResponseCode: wrapperspb.UInt32(503)}},
+						},
+					},
+				},
+			},
+		},
+		ctx: context.Background(),
+	}
+
+	require.NoError(t, server.StreamAccessLogs(stream))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	var first, second map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.Equal(t, map[string]any{"path": "/pets", "request_method": "GET"}, first["request"])
+	assert.Equal(t, map[string]any{"response_code": float64(503)}, second["response"])
+}
+
+func TestAccessLogSink_OmitsAnalyticsMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	sink := newAccessLogSinkWriter(&buf)
+	analyticsData, err := structpb.NewStruct(map[string]any{
+		"analytics_data": map[string]any{"request_headers": `{"authorization":"Bearer secret"}`},
+	})
+	require.NoError(t, err)
+	entry := &accesslogv3.HTTPAccessLogEntry{
+		CommonProperties: &accesslogv3.AccessLogCommon{
+			Metadata: &corev3.Metadata{FilterMetadata: map[string]*structpb.Struct{
+				constants.ExtProcFilterName: analyticsData,
+			}},
+		},
+		Request: &accesslogv3.HTTPRequestProperties{Path: "/pets"},
+	}
+
+	sink.Write(entry)
+
+	assert.NotContains(t, buf.String(), "secret")
+	assert.Contains(t, buf.String(), "/pets")
+	assert.Contains(t, entry.CommonProperties.Metadata.FilterMetadata, constants.ExtProcFilterName,
+		"the entry passed on to analytics is not modified")
+}
+
+func TestNewAccessLogSink(t *testing.T) {
+	sink, err := newAccessLogSink(config.AccessLogsSinkConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, sink, "no sink is configured by default")
+
+	sink, err = newAccessLogSink(config.AccessLogsSinkConfig{Sink: "stdout"})
+	require.NoError(t, err)
+	assert.NotNil(t, sink)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	sink, err = newAccessLogSink(config.AccessLogsSinkConfig{Sink: "file", FilePath: path, MaxSizeMB: 1})
+	require.NoError(t, err)
+	sink.Write(&accesslogv3.HTTPAccessLogEntry{Request: &accesslogv3.HTTPRequestProperties{Path: "/orders"}})
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"request":{"path":"/orders"}}`, string(data))
+
+	_, err = newAccessLogSink(config.AccessLogsSinkConfig{Sink: "syslog"})
+	assert.Error(t, err)
+}
+
 // =============================================================================
 // StartAccessLogServiceServer Tests
 // =============================================================================
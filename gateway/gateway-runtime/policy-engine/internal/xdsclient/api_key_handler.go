@@ -65,7 +65,11 @@ func (h *APIKeyOperationHandler) HandleAPIKeyOperation(ctx context.Context, reso
 
 		h.logger.Info("Processing API key state",
 			"version", apiKeyState.Version,
-			"api_key_count", len(apiKeyState.APIKeys))
+			"api_key_count", len(apiKeyState.APIKeys),
+			"revoked_count", len(apiKeyState.RevokedAPIKeys))
+
+		// Revoke first so a regenerated signed key is never accepted in between
+		h.apiKeyStore.ReplaceRevocations(apiKeyState.RevokedAPIKeys)
 
 		// Replace all API keys with the new state (state-of-the-world approach)
 		if err := h.replaceAllAPIKeys(apiKeyState.APIKeys); err != nil {
@@ -66,6 +66,13 @@ func createValidAPIKeyStateResource(t *testing.T) *anypb.Any {
 		},
 	}
 
+	return createAPIKeyStateResource(t, state)
+}
+
+// createAPIKeyStateResource wraps state the way the controller publishes it
+func createAPIKeyStateResource(t *testing.T, state APIKeyStateResource) *anypb.Any {
+	t.Helper()
+
 	// Convert to JSON
 	jsonBytes, err := json.Marshal(state)
 	require.NoError(t, err)
@@ -208,6 +215,37 @@ func TestHandleAPIKeyOperation_ValidResource(t *testing.T) {
 	assert.Equal(t, "App 123", resolvedKey.ApplicationName)
 }
 
+func TestHandleAPIKeyOperation_AppliesRevocations(t *testing.T) {
+	codec, err := apikey.NewSignedAPIKeyCodec("apip_", []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	revokedKey, err := codec.Sign(apikey.SignedAPIKeyClaims{KeyID: "key-1", APIID: "api-1"})
+	require.NoError(t, err)
+	validKey, err := codec.Sign(apikey.SignedAPIKeyClaims{KeyID: "key-2", APIID: "api-1"})
+	require.NoError(t, err)
+
+	store := createTestAPIKeyStore()
+	store.SetSignedAPIKeyCodec(codec)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	handler := NewAPIKeyOperationHandler(store, logger)
+
+	resources := map[string]*anypb.Any{
+		"resource-1": createAPIKeyStateResource(t, APIKeyStateResource{
+			Version:        1,
+			RevokedAPIKeys: []string{apikey.ComputeAPIKeyHash(revokedKey)},
+		}),
+	}
+	require.NoError(t, handler.HandleAPIKeyOperation(context.Background(), resources))
+
+	resolved, err := store.ResolveValidatedAPIKey("api-1", "*", "GET", revokedKey)
+	assert.NoError(t, err)
+	assert.Nil(t, resolved, "revoked signed key must not validate")
+
+	resolved, err = store.ResolveValidatedAPIKey("api-1", "*", "GET", validKey)
+	assert.NoError(t, err)
+	require.NotNil(t, resolved)
+	assert.Equal(t, "key-2", resolved.ID)
+}
+
 // TestReplaceAllAPIKeys_ClearsExistingKeys tests that replaceAllAPIKeys clears existing keys first
 func TestReplaceAllAPIKeys_ClearsExistingKeys(t *testing.T) {
 	store := createTestAPIKeyStore()
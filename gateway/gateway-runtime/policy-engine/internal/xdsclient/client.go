@@ -37,6 +37,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/wso2/api-platform/common/apikey"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
@@ -48,6 +49,7 @@ type Client struct {
 	config           *Config
 	handler          *ResourceHandler
 	reconnectManager *ReconnectManager
+	apiKeyStore      *apikey.APIkeyStore
 
 	// Connection state
 	mu     sync.RWMutex
@@ -82,6 +84,7 @@ func NewClient(config *Config, k *kernel.Kernel, reg *registry.PolicyRegistry) (
 		config:           config,
 		handler:          NewResourceHandler(k, reg),
 		reconnectManager: NewReconnectManager(config),
+		apiKeyStore:      apikey.GetAPIkeyStoreInstance(),
 		state:            StateDisconnected,
 		ctx:              ctx,
 		cancel:           cancel,
@@ -97,6 +100,9 @@ func (c *Client) Start() error {
 		"node_id", constants.XDSNodeID)
 
 	go c.run()
+	if c.config.APIKeyUsageReportInterval > 0 {
+		go c.runAPIKeyUsageReporter()
+	}
 
 	return nil
 }
@@ -150,6 +156,12 @@ func (c *Client) IsHealthy() bool {
 	return c.policyChainVersion != ""
 }
 
+// RebuildPolicyChains builds the policy chains of the last applied snapshot
+// again with the current registry config.
+func (c *Client) RebuildPolicyChains(ctx context.Context) error {
+	return c.handler.RebuildPolicyChains(ctx)
+}
+
 // setState updates the client state
 func (c *Client) setState(state ClientState) {
 	c.mu.Lock()
@@ -51,6 +51,10 @@ type Config struct {
 
 	// TLSCAPath is the path to the CA certificate for server verification (if TLSEnabled)
 	TLSCAPath string
+
+	// APIKeyUsageReportInterval is how often the API keys validated by the engine are
+	// reported to the xDS server; zero disables usage reporting
+	APIKeyUsageReportInterval time.Duration
 }
 
 // Validate validates the xDS client configuration
@@ -75,6 +79,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("max reconnect delay must be positive")
 	}
 
+	if c.APIKeyUsageReportInterval < 0 {
+		return fmt.Errorf("API key usage report interval must not be negative")
+	}
+
 	if c.TLSEnabled {
 		if c.TLSCertPath == "" {
 			return fmt.Errorf("TLS cert path is required when TLS is enabled")
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -70,12 +71,19 @@ type ResourceHandler struct {
 	subscriptionStore   *policyenginev1.SubscriptionStore
 	subscriptionHandler *SubscriptionStateHandler
 
+	// chainMu serializes policy chain updates from the ADS recv goroutine with
+	// rebuilds requested by RebuildPolicyChains, and guards the fields below.
+	chainMu sync.Mutex
+
 	// lastApplied maps routeKey -> the signature and chain currently applied.
 	// Used by HandlePolicyChainUpdate to reuse unchanged chains instead of
 	// re-invoking each policy's GetPolicy factory on every SotW snapshot.
-	// Safe without a lock: HandlePolicyChainUpdate runs serially on the single
-	// ADS recv goroutine.
 	lastApplied map[string]appliedRoute
+
+	// lastResources and lastVersion hold the last snapshot applied, so
+	// RebuildPolicyChains can build its chains again.
+	lastResources []*anypb.Any
+	lastVersion   string
 }
 
 // NewResourceHandler creates a new ResourceHandler
@@ -103,6 +111,34 @@ type policyChainWithMetadata struct {
 
 // HandlePolicyChainUpdate processes custom PolicyChainConfig resources from ADS response
 func (h *ResourceHandler) HandlePolicyChainUpdate(ctx context.Context, resources []*anypb.Any, version string) error {
+	h.chainMu.Lock()
+	defer h.chainMu.Unlock()
+
+	if err := h.applyPolicyChains(ctx, resources, version); err != nil {
+		return err
+	}
+	h.lastResources = resources
+	h.lastVersion = version
+	return nil
+}
+
+// RebuildPolicyChains builds every policy chain of the last snapshot again, so
+// policies are re-instantiated with the current registry config, e.g. after
+// secrets it references rotated. It is a no-op before the first snapshot.
+func (h *ResourceHandler) RebuildPolicyChains(ctx context.Context) error {
+	h.chainMu.Lock()
+	defer h.chainMu.Unlock()
+
+	if h.lastResources == nil {
+		return nil
+	}
+	// Forget the applied signatures: the config changed, not the snapshot
+	h.lastApplied = make(map[string]appliedRoute)
+	return h.applyPolicyChains(ctx, h.lastResources, h.lastVersion)
+}
+
+// applyPolicyChains builds and applies the policy chains of a snapshot. The caller holds chainMu.
+func (h *ResourceHandler) applyPolicyChains(ctx context.Context, resources []*anypb.Any, version string) error {
 	slog.InfoContext(ctx, "Handling policy chain update via ADS",
 		"version", version,
 		"num_resources", len(resources))
@@ -370,6 +406,9 @@ func (h *ResourceHandler) HandleRouteConfigUpdate(ctx context.Context, resources
 
 		rc.Metadata.DefaultUpstreamCluster = getStringFromMap(data, "default_upstream_cluster")
 		rc.Metadata.UpstreamBasePath = getStringFromMap(data, "upstream_base_path")
+		rc.Metadata.UpgradeType = getStringFromMap(data, "upgrade_type")
+		rc.Metadata.MaxRequestBytes = getInt64FromMap(data, "max_request_bytes")
+		rc.Metadata.MaxResponseBytes = getInt64FromMap(data, "max_response_bytes")
 
 		if m, ok := data["default_upstream"].(map[string]interface{}); ok {
 			info := policyenginev1.UpstreamInfoFromMap(m)
@@ -409,6 +448,17 @@ func getStringFromMap(m map[string]interface{}, key string) string {
 	return ""
 }
 
+// getInt64FromMap safely extracts an integer value from a map. Numbers arrive as
+// float64 from the structpb/JSON decode; anything else yields zero.
+func getInt64FromMap(m map[string]interface{}, key string) int64 {
+	if v, ok := m[key]; ok {
+		if f, ok := v.(float64); ok {
+			return int64(f)
+		}
+	}
+	return 0
+}
+
 // convertStoredConfigToPolicyChains extracts PolicyChain configurations from StoredPolicyConfig
 // With SDK types, the routes are already in the correct format
 func (h *ResourceHandler) convertStoredConfigToPolicyChains(stored *StoredPolicyConfig) []*policyenginev1.PolicyChain {
@@ -422,8 +472,8 @@ func (h *ResourceHandler) convertStoredConfigToPolicyChains(stored *StoredPolicy
 }
 
 // validatePolicyChainConfig validates a PolicyChain configuration.
-// Only checks structural requirements (non-empty route key, name, version).
-// Policy existence is handled gracefully in buildPolicyChain.
+// Checks structural requirements (non-empty route key, name, version), that the
+// policy is registered and that its execution condition compiles.
 func (h *ResourceHandler) validatePolicyChainConfig(config *policyenginev1.PolicyChain) error {
 	if config.RouteKey == "" {
 		return fmt.Errorf("route_key is required")
@@ -441,6 +491,10 @@ func (h *ResourceHandler) validatePolicyChainConfig(config *policyenginev1.Polic
 		if err := h.registry.PolicyExists(policyConfig.Name, policyConfig.Version); err != nil {
 			return fmt.Errorf("policy[%d]: %w", i, err)
 		}
+
+		if err := kernel.ValidateExecutionCondition(&policyConfig); err != nil {
+			return fmt.Errorf("policy[%d]: %w", i, err)
+		}
 	}
 
 	return nil
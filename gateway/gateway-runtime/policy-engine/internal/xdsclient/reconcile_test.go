@@ -229,6 +229,37 @@ func TestHandlePolicyChainUpdate_RemovesAbsentRoutes(t *testing.T) {
 	assert.Nil(t, k.GetPolicyChain("rA"), "absent route must be dropped from the kernel")
 }
 
+// A rebuild re-instantiates every route of the last snapshot with the current
+// registry config, e.g. after a referenced secret rotated.
+func TestRebuildPolicyChains_RebuildsUnchangedRoutes(t *testing.T) {
+	metrics.Init()
+	reg, counts := regWithCounters(t, "polA:v1")
+	k := kernel.NewKernel()
+	h := NewResourceHandler(k, reg)
+	ctx := context.Background()
+
+	// Nothing applied yet: nothing to rebuild.
+	require.NoError(t, h.RebuildPolicyChains(ctx))
+	require.Equal(t, 0, *counts["polA:v1"])
+
+	rA := route("rA", pol("polA", "v1", map[string]interface{}{"x": "1"}))
+	require.NoError(t, h.HandlePolicyChainUpdate(ctx,
+		[]*anypb.Any{mustResource(t, storedConfig("A", "apiA", "A", "v1", 1, rA))}, "1"))
+	chainA1 := k.GetPolicyChain("rA")
+	require.NotNil(t, chainA1)
+
+	require.NoError(t, h.RebuildPolicyChains(ctx))
+	assert.Equal(t, 2, *counts["polA:v1"], "rebuild must re-run GetPolicy for unchanged routes")
+	assert.NotSame(t, chainA1, k.GetPolicyChain("rA"))
+
+	// The next identical snapshot reuses the rebuilt chain.
+	chainA2 := k.GetPolicyChain("rA")
+	require.NoError(t, h.HandlePolicyChainUpdate(ctx,
+		[]*anypb.Any{mustResource(t, storedConfig("A", "apiA", "A", "v1", 1, rA))}, "2"))
+	assert.Equal(t, 2, *counts["polA:v1"])
+	assert.Same(t, chainA2, k.GetPolicyChain("rA"))
+}
+
 // =============================================================================
 // routeSignature — field sensitivity
 // =============================================================================
@@ -66,9 +66,9 @@ type routeSignatureView struct {
 // sorts map keys, so the output is deterministic for a given value. Parameters
 // arrive as JSON-native types (float64/string/…) from the original unmarshal, so
 // re-marshaling here round-trips stably. ${config} references are not resolved
-// here on purpose: resolution is process-constant (from baked-in SystemParameters
-// against PE-local config) and changes only on a restart, which rebuilds every
-// chain from scratch anyway.
+// here on purpose: resolution is process-constant (baked-in SystemParameters and
+// the references of policy parameters, against PE-local config) and changes only
+// on a restart, which rebuilds every chain from scratch anyway.
 func routeSignature(config *policyenginev1.PolicyChain, md policyenginev1.Metadata) (string, error) {
 	return signatureOf(routeSignatureView{
 		RouteKey:   config.RouteKey,
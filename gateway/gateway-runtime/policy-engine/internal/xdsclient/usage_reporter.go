@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xdsclient
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/wso2/api-platform/common/apikey"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// runAPIKeyUsageReporter periodically sends the API keys validated since the last
+// report to the gateway controller, which records them as the keys' last-used time.
+func (c *Client) runAPIKeyUsageReporter() {
+	c.apiKeyStore.EnableUsageTracking()
+
+	ticker := time.NewTicker(c.config.APIKeyUsageReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.reportAPIKeyUsage()
+		}
+	}
+}
+
+// reportAPIKeyUsage sends the collected usage in batches. Usage that cannot be delivered
+// is kept for the next report, unless the controller rejects it outright.
+func (c *Client) reportAPIKeyUsage() {
+	usage := c.apiKeyStore.TakeUsage()
+	if len(usage) == 0 {
+		return
+	}
+
+	c.mu.RLock()
+	conn := c.conn
+	connected := c.state == StateConnected
+	c.mu.RUnlock()
+	if conn == nil || !connected {
+		c.apiKeyStore.RequeueUsage(usage)
+		return
+	}
+
+	for start := 0; start < len(usage); start += apikey.MaxUsageReportKeys {
+		batch := usage[start:min(start+apikey.MaxUsageReportKeys, len(usage))]
+		err := c.sendAPIKeyUsage(conn, batch)
+		if err == nil {
+			continue
+		}
+
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			slog.DebugContext(c.ctx, "xDS server does not accept API key usage reports")
+		case codes.InvalidArgument:
+			slog.WarnContext(c.ctx, "xDS server rejected API key usage report", "error", err)
+		default:
+			c.apiKeyStore.RequeueUsage(usage[start:])
+			slog.WarnContext(c.ctx, "Failed to report API key usage, will retry",
+				"key_count", len(usage)-start,
+				"error", err)
+		}
+		return
+	}
+
+	slog.DebugContext(c.ctx, "Reported API key usage", "key_count", len(usage))
+}
+
+// sendAPIKeyUsage reports a batch of API key usage on the xDS connection.
+func (c *Client) sendAPIKeyUsage(conn *grpc.ClientConn, usage []apikey.KeyUsage) error {
+	report := &usagepb.UsageReport{Keys: make([]*usagepb.KeyUsage, 0, len(usage))}
+	for _, u := range usage {
+		report.Keys = append(report.Keys, &usagepb.KeyUsage{
+			ApiId:      u.APIId,
+			KeyId:      u.KeyID,
+			LastUsedAt: timestamppb.New(u.LastUsedAt),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, c.config.RequestTimeout)
+	defer cancel()
+	_, err := usagepb.NewAPIKeyUsageServiceClient(conn).ReportUsage(ctx, report)
+	return err
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xdsclient
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/common/apikey"
+	usagepb "github.com/wso2/api-platform/common/apikey/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// fakeUsageServer stands in for the gateway controller's API key usage service.
+type fakeUsageServer struct {
+	usagepb.UnimplementedAPIKeyUsageServiceServer
+
+	mu      sync.Mutex
+	reports []*usagepb.UsageReport
+	err     error
+}
+
+func (s *fakeUsageServer) ReportUsage(_ context.Context, report *usagepb.UsageReport) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.reports = append(s.reports, report)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *fakeUsageServer) received() []*usagepb.UsageReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reports
+}
+
+func startFakeUsageServer(t *testing.T, server *fakeUsageServer) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	usagepb.RegisterAPIKeyUsageServiceServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func newUsageReportingClient(t *testing.T, conn *grpc.ClientConn) *Client {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	config := createValidTestConfig()
+	config.APIKeyUsageReportInterval = time.Minute
+	store := apikey.NewAPIkeyStore()
+	store.EnableUsageTracking()
+	return &Client{
+		config:      config,
+		apiKeyStore: store,
+		conn:        conn,
+		state:       StateConnected,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func validateUsageTestKey(t *testing.T, store *apikey.APIkeyStore, apiID, keyID string) {
+	t.Helper()
+	plainAPIKey := "apip_" + keyID
+	require.NoError(t, store.StoreAPIKey(apiID, &apikey.APIKey{
+		ID:         keyID,
+		Name:       keyID,
+		APIKey:     apikey.ComputeAPIKeyHash(plainAPIKey),
+		APIId:      apiID,
+		Operations: "*",
+		Status:     apikey.Active,
+	}))
+	_, err := store.ResolveValidatedAPIKey(apiID, "/", "GET", plainAPIKey)
+	require.NoError(t, err)
+}
+
+func TestReportAPIKeyUsage_SendsValidatedKeys(t *testing.T) {
+	server := &fakeUsageServer{}
+	client := newUsageReportingClient(t, startFakeUsageServer(t, server))
+	validateUsageTestKey(t, client.apiKeyStore, "api-1", "key-1")
+	validateUsageTestKey(t, client.apiKeyStore, "api-2", "key-2")
+
+	client.reportAPIKeyUsage()
+
+	reports := server.received()
+	require.Len(t, reports, 1)
+	require.Len(t, reports[0].Keys, 2)
+	assert.Equal(t, "key-1", reports[0].Keys[0].GetKeyId())
+	assert.Equal(t, "api-2", reports[0].Keys[1].GetApiId())
+	assert.True(t, reports[0].Keys[0].GetLastUsedAt().IsValid())
+	assert.Empty(t, client.apiKeyStore.TakeUsage(), "reported usage should not be reported again")
+}
+
+func TestReportAPIKeyUsage_RetriesUndeliveredUsage(t *testing.T) {
+	t.Run("controller unavailable", func(t *testing.T) {
+		server := &fakeUsageServer{err: status.Error(codes.Unavailable, "failed to record API key usage")}
+		client := newUsageReportingClient(t, startFakeUsageServer(t, server))
+		validateUsageTestKey(t, client.apiKeyStore, "api-1", "key-1")
+
+		client.reportAPIKeyUsage()
+
+		usage := client.apiKeyStore.TakeUsage()
+		require.Len(t, usage, 1)
+		assert.Equal(t, "key-1", usage[0].KeyID)
+	})
+
+	t.Run("disconnected", func(t *testing.T) {
+		client := newUsageReportingClient(t, nil)
+		validateUsageTestKey(t, client.apiKeyStore, "api-1", "key-1")
+
+		client.reportAPIKeyUsage()
+
+		assert.Len(t, client.apiKeyStore.TakeUsage(), 1)
+	})
+
+	t.Run("rejected report is dropped", func(t *testing.T) {
+		server := &fakeUsageServer{err: status.Error(codes.InvalidArgument, "invalid API key usage report")}
+		client := newUsageReportingClient(t, startFakeUsageServer(t, server))
+		validateUsageTestKey(t, client.apiKeyStore, "api-1", "key-1")
+
+		client.reportAPIKeyUsage()
+
+		assert.Empty(t, client.apiKeyStore.TakeUsage())
+	})
+}
@@ -37,9 +37,11 @@ import (
 
 // APIKeyStateResource represents the complete API key snapshot distributed over xDS.
 type APIKeyStateResource struct {
-	APIKeys   []APIKeyData `json:"apiKeys"`
-	Version   int64        `json:"version"`
-	Timestamp int64        `json:"timestamp"`
+	APIKeys []APIKeyData `json:"apiKeys"`
+	// RevokedAPIKeys holds the stored hashes of revoked keys that signed keys are checked against.
+	RevokedAPIKeys []string `json:"revokedApiKeys,omitempty"`
+	Version        int64    `json:"version"`
+	Timestamp      int64    `json:"timestamp"`
 }
 
 // APIKeyData represents a single API key entry in the xDS state snapshot.
@@ -97,9 +99,10 @@ func DecodeAPIKeyStateResource(resource *anypb.Any) (*APIKeyStateResource, error
 	return &state, nil
 }
 
-// ApplyToStore replaces the contents of store with the API keys from state.
+// ApplyToStore replaces the contents of store with the API keys and revocations from state.
 // It builds an intermediate snapshot and calls store.ReplaceAll in one shot
-// so that the store is never partially updated.
+// so that the store is never partially updated. Revocations are applied first, so a
+// regenerated signed key is never accepted between dropping its record and revoking it.
 func ApplyToStore(ctx interface{ Done() <-chan struct{} }, state *APIKeyStateResource, store *commonapikey.APIkeyStore) error {
 	snapshot := make(map[string]map[string]*commonapikey.APIKey, len(state.APIKeys))
 
@@ -126,6 +129,7 @@ func ApplyToStore(ctx interface{ Done() <-chan struct{} }, state *APIKeyStateRes
 		}
 	}
 
+	store.ReplaceRevocations(state.RevokedAPIKeys)
 	if err := store.ReplaceAll(snapshot); err != nil {
 		return fmt.Errorf("failed to replace API key store: %w", err)
 	}
@@ -174,7 +174,7 @@ Feature: Analytics Header Filter Policy
                     headers:
                       - "authorization"
       """
-    Then the response status code should be 400
+    Then the response status code should be 422
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the response body should contain "Configuration validation failed"
@@ -206,7 +206,7 @@ Feature: Analytics Header Filter Policy
                     headers:
                       - "authorization"
       """
-    Then the response status code should be 400
+    Then the response status code should be 422
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the response body should contain "Configuration validation failed"
@@ -43,9 +43,9 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "spec.context"
+    And the response body should contain "/spec/context"
     And the response body should contain "Context is required"
-    And the response body should contain "spec.operations"
+    And the response body should contain "/spec/operations"
     And the response body should contain "At least one operation is required"
 
   Scenario: Create API returns policy schema validation errors
@@ -75,7 +75,7 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "spec.policies[0].params.statusCode"
+    And the response body should contain "/spec/policies/0/params/statusCode"
     And the response body should contain "Invalid type"
 
   Scenario: Create API returns policy not found errors
@@ -103,7 +103,7 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "spec.policies[0].version"
+    And the response body should contain "/spec/policies/0/version"
     And the response body should contain "policy-does-not-exist"
     And the response body should contain "not found in loaded policy definitions"
 
@@ -128,7 +128,7 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "metadata.name"
+    And the response body should contain "/metadata/name"
     And the response body should contain "Metadata name is required"
 
   Scenario: Create API returns version format validation errors
@@ -153,7 +153,7 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "spec.version"
+    And the response body should contain "/spec/version"
     And the response body should contain "semantic versioning pattern"
 
   Scenario: Update API parse errors include detailed message
@@ -228,7 +228,7 @@ Feature: API Error Responses
     And the response should be valid JSON
     And the JSON response field "status" should be "error"
     And the JSON response field "message" should be "Configuration validation failed"
-    And the response body should contain "spec.context"
+    And the response body should contain "/spec/context"
     And the response body should contain "Context is required"
     # Cleanup
     When I delete the API "update-validation-error-api"
@@ -0,0 +1,373 @@
+package cors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	wildcard = "*"
+
+	headerOrigin                 = "origin"
+	headerVary                   = "vary"
+	headerRequestMethod          = "access-control-request-method"
+	headerRequestHeaders         = "access-control-request-headers"
+	headerAllowOrigin            = "access-control-allow-origin"
+	headerAllowMethods           = "access-control-allow-methods"
+	headerAllowHeaders           = "access-control-allow-headers"
+	headerAllowCredentials       = "access-control-allow-credentials"
+	headerExposeHeaders          = "access-control-expose-headers"
+	headerMaxAge                 = "access-control-max-age"
+	preflightRejectedStatusCode  = 403
+	preflightSucceededStatusCode = 204
+)
+
+var defaultAllowedMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS"}
+
+// corsResponseHeaders are the CORS headers the policy owns on actual responses.
+// Any the upstream sets are replaced or removed so the policy's decision is the
+// only one the client sees.
+var corsResponseHeaders = []string{
+	headerAllowOrigin,
+	headerAllowCredentials,
+	headerExposeHeaders,
+}
+
+// config is the parsed policy configuration.
+type config struct {
+	allowedOrigins   map[string]bool // lower-cased exact origins
+	anyOrigin        bool            // allowedOrigins contains "*"
+	originPatterns   []*regexp.Regexp
+	allowedMethods   []string
+	allowedHeaders   map[string]bool // lower-cased header names
+	anyHeader        bool            // allowedHeaders contains "*"
+	exposedHeaders   []string
+	allowCredentials bool
+	maxAge           int // seconds; negative omits Access-Control-Max-Age
+	forwardPreflight bool
+}
+
+// CORSPolicy answers CORS preflight requests at the gateway and adds CORS
+// headers to responses for allowed origins.
+type CORSPolicy struct {
+	cfg config
+}
+
+// GetPolicy creates a CORS policy from its parameters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	return &CORSPolicy{cfg: cfg}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy.
+func (p *CORSPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeProcess,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+}
+
+// OnRequestHeaders answers preflight requests without calling the upstream.
+// Other requests, including cross-origin ones from disallowed origins, are
+// forwarded unchanged; the browser enforces CORS on the response.
+func (p *CORSPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	origin := firstHeader(reqCtx.Headers, headerOrigin)
+	if origin == "" || !isPreflight(reqCtx.Method, reqCtx.Headers) || p.cfg.forwardPreflight {
+		return nil
+	}
+
+	requestMethod := firstHeader(reqCtx.Headers, headerRequestMethod)
+	requestHeaders := splitList(reqCtx.Headers.Get(headerRequestHeaders))
+	if !p.originAllowed(origin) || !p.methodAllowed(requestMethod) || !p.headersAllowed(requestHeaders) {
+		slog.Debug("CORS: rejecting preflight request",
+			"origin", origin,
+			"request_method", requestMethod,
+			"request_headers", requestHeaders,
+		)
+		return policy.ImmediateResponse{
+			StatusCode: preflightRejectedStatusCode,
+			Headers:    map[string]string{headerVary: "Origin"},
+		}
+	}
+
+	headers := p.originHeaders(origin)
+	headers[headerVary] = "Origin, Access-Control-Request-Method, Access-Control-Request-Headers"
+	headers[headerAllowMethods] = strings.Join(p.cfg.allowedMethods, ", ")
+	if len(requestHeaders) > 0 {
+		// Echo back the requested headers; headersAllowed has checked each of them.
+		headers[headerAllowHeaders] = strings.Join(requestHeaders, ", ")
+	}
+	if p.cfg.maxAge >= 0 {
+		headers[headerMaxAge] = strconv.Itoa(p.cfg.maxAge)
+	}
+	return policy.ImmediateResponse{
+		StatusCode: preflightSucceededStatusCode,
+		Headers:    headers,
+	}
+}
+
+// OnResponseHeaders adds the CORS headers for an allowed origin, and removes
+// any the upstream set when the origin is not allowed.
+func (p *CORSPolicy) OnResponseHeaders(_ context.Context, respCtx *policy.ResponseHeaderContext, _ map[string]interface{}) policy.ResponseHeaderAction {
+	origin := firstHeader(respCtx.RequestHeaders, headerOrigin)
+	if origin == "" {
+		return nil
+	}
+	if !p.originAllowed(origin) {
+		return policy.DownstreamResponseHeaderModifications{
+			HeadersToRemove: corsResponseHeaders,
+		}
+	}
+
+	headers := p.originHeaders(origin)
+	if len(p.cfg.exposedHeaders) > 0 {
+		headers[headerExposeHeaders] = strings.Join(p.cfg.exposedHeaders, ", ")
+	}
+	var remove []string
+	for _, name := range corsResponseHeaders {
+		if _, ok := headers[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	mods := policy.DownstreamResponseHeaderModifications{
+		HeadersToSet:    headers,
+		HeadersToRemove: remove,
+	}
+	if !p.cfg.anyOrigin {
+		mods.HeadersToAppend = map[string][]string{headerVary: {"Origin"}}
+	}
+	return mods
+}
+
+// originHeaders returns the Access-Control-Allow-Origin and credentials headers
+// for an allowed origin. A wildcard policy answers "*" for every caller and never
+// allows credentials (parseConfig rejects that combination).
+func (p *CORSPolicy) originHeaders(origin string) map[string]string {
+	if p.cfg.anyOrigin {
+		return map[string]string{headerAllowOrigin: wildcard}
+	}
+	headers := map[string]string{headerAllowOrigin: origin}
+	if p.cfg.allowCredentials {
+		headers[headerAllowCredentials] = "true"
+	}
+	return headers
+}
+
+// originAllowed reports whether origin is in the allowlist. Plain entries are
+// compared for exact (case-insensitive) equality; patterns are anchored.
+func (p *CORSPolicy) originAllowed(origin string) bool {
+	if p.cfg.anyOrigin || p.cfg.allowedOrigins[strings.ToLower(origin)] {
+		return true
+	}
+	for _, re := range p.cfg.originPatterns {
+		if re.MatchString(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *CORSPolicy) methodAllowed(method string) bool {
+	for _, allowed := range p.cfg.allowedMethods {
+		if allowed == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *CORSPolicy) headersAllowed(headers []string) bool {
+	if p.cfg.anyHeader {
+		return true
+	}
+	for _, h := range headers {
+		if !p.cfg.allowedHeaders[strings.ToLower(h)] {
+			return false
+		}
+	}
+	return true
+}
+
+// isPreflight reports whether the request is a CORS preflight: an OPTIONS
+// request naming the method of the actual request.
+func isPreflight(method string, headers *policy.Headers) bool {
+	return strings.EqualFold(method, "OPTIONS") && firstHeader(headers, headerRequestMethod) != ""
+}
+
+func firstHeader(headers *policy.Headers, name string) string {
+	if headers == nil {
+		return ""
+	}
+	for _, v := range headers.Get(name) {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// splitList splits comma-separated header values into trimmed, non-empty items.
+func splitList(values []string) []string {
+	var items []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// parseConfig reads the allowed origins, origin patterns, methods and headers, the
+// exposed headers, allowCredentials, forwardPreflight and maxAge. Credentials are
+// rejected together with a wildcard origin or an origin pattern.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		allowedOrigins: map[string]bool{},
+		allowedMethods: defaultAllowedMethods,
+		allowedHeaders: map[string]bool{},
+		maxAge:         -1,
+	}
+
+	origins, err := toStringList(params, "allowedOrigins")
+	if err != nil {
+		return cfg, err
+	}
+	for _, origin := range origins {
+		if origin == wildcard {
+			cfg.anyOrigin = true
+			continue
+		}
+		cfg.allowedOrigins[strings.ToLower(origin)] = true
+	}
+
+	patterns, err := toStringList(params, "allowedOriginPatterns")
+	if err != nil {
+		return cfg, err
+	}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return cfg, fmt.Errorf("invalid allowedOriginPatterns entry %q: %w", pattern, err)
+		}
+		cfg.originPatterns = append(cfg.originPatterns, re)
+	}
+
+	if _, ok := params["allowedMethods"]; ok {
+		methods, err := toStringList(params, "allowedMethods")
+		if err != nil {
+			return cfg, err
+		}
+		cfg.allowedMethods = make([]string, len(methods))
+		for i, m := range methods {
+			cfg.allowedMethods[i] = strings.ToUpper(m)
+		}
+	}
+
+	headers, err := toStringList(params, "allowedHeaders")
+	if err != nil {
+		return cfg, err
+	}
+	for _, h := range headers {
+		if h == wildcard {
+			cfg.anyHeader = true
+			continue
+		}
+		cfg.allowedHeaders[strings.ToLower(h)] = true
+	}
+
+	if cfg.exposedHeaders, err = toStringList(params, "exposedHeaders"); err != nil {
+		return cfg, err
+	}
+
+	if v, ok := params["allowCredentials"]; ok {
+		if cfg.allowCredentials, ok = v.(bool); !ok {
+			return cfg, fmt.Errorf("allowCredentials must be a boolean")
+		}
+	}
+	if v, ok := params["forwardPreflight"]; ok {
+		if cfg.forwardPreflight, ok = v.(bool); !ok {
+			return cfg, fmt.Errorf("forwardPreflight must be a boolean")
+		}
+	}
+	if v, ok := params["maxAge"]; ok {
+		if cfg.maxAge, err = toInt(v); err != nil || cfg.maxAge < 0 {
+			return cfg, fmt.Errorf("maxAge must be a non-negative integer")
+		}
+	}
+
+	// Credentialed responses must only ever be readable by origins the operator
+	// listed exactly; a wildcard or pattern would hand them to any matching site.
+	if cfg.allowCredentials && (cfg.anyOrigin || len(cfg.originPatterns) > 0) {
+		return cfg, fmt.Errorf("allowCredentials cannot be combined with a wildcard origin or allowedOriginPatterns")
+	}
+	return cfg, nil
+}
+
+func toStringList(params map[string]interface{}, name string) ([]string, error) {
+	v, ok := params[name]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	var items []interface{}
+	switch list := v.(type) {
+	case []interface{}:
+		items = list
+	case []string:
+		for _, s := range list {
+			items = append(items, s)
+		}
+	default:
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("%s must be a list of non-empty strings", name)
+		}
+		out = append(out, strings.TrimSpace(s))
+	}
+	return out, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
@@ -0,0 +1,239 @@
+package cors
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *CORSPolicy {
+	t.Helper()
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*CORSPolicy)
+}
+
+func onRequest(p *CORSPolicy, method string, headers map[string][]string) policy.RequestHeaderAction {
+	return p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Method:        method,
+		Headers:       policy.NewHeaders(headers),
+	}, nil)
+}
+
+func onResponse(p *CORSPolicy, reqHeaders map[string][]string) policy.ResponseHeaderAction {
+	return p.OnResponseHeaders(context.Background(), &policy.ResponseHeaderContext{
+		SharedContext:   &policy.SharedContext{Metadata: map[string]interface{}{}},
+		RequestHeaders:  policy.NewHeaders(reqHeaders),
+		RequestMethod:   "GET",
+		ResponseHeaders: policy.NewHeaders(nil),
+		ResponseStatus:  200,
+	}, nil)
+}
+
+func preflight(origin, method, headers string) map[string][]string {
+	h := map[string][]string{
+		"origin":                        {origin},
+		"access-control-request-method": {method},
+	}
+	if headers != "" {
+		h["access-control-request-headers"] = []string{headers}
+	}
+	return h
+}
+
+func TestPreflightIsAnsweredAtTheGateway(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins":   []interface{}{"https://app.example.com"},
+		"allowedMethods":   []interface{}{"GET", "post"},
+		"allowedHeaders":   []interface{}{"Content-Type", "Authorization"},
+		"allowCredentials": true,
+		"maxAge":           float64(600),
+	})
+
+	action := onRequest(p, "OPTIONS", preflight("https://app.example.com", "POST", "content-type, authorization"))
+	resp, ok := action.(policy.ImmediateResponse)
+	if !ok {
+		t.Fatalf("action = %T, want ImmediateResponse", action)
+	}
+	if resp.StatusCode != 204 {
+		t.Errorf("StatusCode = %d, want 204", resp.StatusCode)
+	}
+	want := map[string]string{
+		"access-control-allow-origin":      "https://app.example.com",
+		"access-control-allow-credentials": "true",
+		"access-control-allow-methods":     "GET, POST",
+		"access-control-allow-headers":     "content-type, authorization",
+		"access-control-max-age":           "600",
+		"vary":                             "Origin, Access-Control-Request-Method, Access-Control-Request-Headers",
+	}
+	if !reflect.DeepEqual(resp.Headers, want) {
+		t.Errorf("Headers = %v, want %v", resp.Headers, want)
+	}
+}
+
+func TestPreflightRejections(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins": []interface{}{"https://app.example.com"},
+		"allowedMethods": []interface{}{"GET"},
+		"allowedHeaders": []interface{}{"Content-Type"},
+	})
+
+	tests := map[string]map[string][]string{
+		"disallowed origin": preflight("https://evil.example.com", "GET", ""),
+		"disallowed method": preflight("https://app.example.com", "DELETE", ""),
+		"disallowed header": preflight("https://app.example.com", "GET", "x-secret"),
+	}
+	for name, headers := range tests {
+		action := onRequest(p, "OPTIONS", headers)
+		resp, ok := action.(policy.ImmediateResponse)
+		if !ok {
+			t.Fatalf("%s: action = %T, want ImmediateResponse", name, action)
+		}
+		if resp.StatusCode != 403 {
+			t.Errorf("%s: StatusCode = %d, want 403", name, resp.StatusCode)
+		}
+		if _, ok := resp.Headers["access-control-allow-origin"]; ok {
+			t.Errorf("%s: rejected preflight must not carry Access-Control-Allow-Origin", name)
+		}
+	}
+}
+
+func TestPreflightWithWildcards(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins": []interface{}{"*"},
+		"allowedHeaders": []interface{}{"*"},
+	})
+
+	resp, ok := onRequest(p, "OPTIONS", preflight("https://anyone.example.org", "PATCH", "x-custom")).(policy.ImmediateResponse)
+	if !ok {
+		t.Fatal("expected the preflight to be answered")
+	}
+	if got := resp.Headers["access-control-allow-origin"]; got != "*" {
+		t.Errorf("access-control-allow-origin = %q, want %q", got, "*")
+	}
+	if got := resp.Headers["access-control-allow-headers"]; got != "x-custom" {
+		t.Errorf("access-control-allow-headers = %q, want %q", got, "x-custom")
+	}
+	if _, ok := resp.Headers["access-control-max-age"]; ok {
+		t.Error("access-control-max-age must be omitted when maxAge is unset")
+	}
+}
+
+func TestNonPreflightRequestsAreForwarded(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"allowedOrigins": []interface{}{"https://app.example.com"}})
+
+	cases := map[string]struct {
+		method  string
+		headers map[string][]string
+	}{
+		"same-origin request":       {method: "GET"},
+		"simple cross-origin":       {method: "GET", headers: map[string][]string{"origin": {"https://app.example.com"}}},
+		"disallowed origin":         {method: "POST", headers: map[string][]string{"origin": {"https://evil.example.com"}}},
+		"OPTIONS without preflight": {method: "OPTIONS", headers: map[string][]string{"origin": {"https://app.example.com"}}},
+	}
+	for name, tc := range cases {
+		if action := onRequest(p, tc.method, tc.headers); action != nil {
+			t.Errorf("%s: action = %#v, want nil", name, action)
+		}
+	}
+
+	forwarding := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins":   []interface{}{"https://app.example.com"},
+		"forwardPreflight": true,
+	})
+	if action := onRequest(forwarding, "OPTIONS", preflight("https://app.example.com", "GET", "")); action != nil {
+		t.Errorf("forwardPreflight: action = %#v, want nil", action)
+	}
+}
+
+func TestSimpleRequestResponseHeaders(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins":   []interface{}{"https://app.example.com"},
+		"exposedHeaders":   []interface{}{"X-Request-Id"},
+		"allowCredentials": true,
+	})
+
+	mods, ok := onResponse(p, map[string][]string{"origin": {"https://APP.example.com"}}).(policy.DownstreamResponseHeaderModifications)
+	if !ok {
+		t.Fatal("expected response header modifications")
+	}
+	want := map[string]string{
+		"access-control-allow-origin":      "https://APP.example.com",
+		"access-control-allow-credentials": "true",
+		"access-control-expose-headers":    "X-Request-Id",
+	}
+	if !reflect.DeepEqual(mods.HeadersToSet, want) {
+		t.Errorf("HeadersToSet = %v, want %v", mods.HeadersToSet, want)
+	}
+	if !reflect.DeepEqual(mods.HeadersToAppend, map[string][]string{"vary": {"Origin"}}) {
+		t.Errorf("HeadersToAppend = %v, want Vary: Origin", mods.HeadersToAppend)
+	}
+
+	if action := onResponse(p, nil); action != nil {
+		t.Errorf("response without Origin: action = %#v, want nil", action)
+	}
+}
+
+func TestDisallowedOriginGetsNoCORSHeaders(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"allowedOrigins": []interface{}{"https://app.example.com"}})
+
+	mods, ok := onResponse(p, map[string][]string{"origin": {"https://evil.example.com"}}).(policy.DownstreamResponseHeaderModifications)
+	if !ok {
+		t.Fatal("expected response header modifications")
+	}
+	if len(mods.HeadersToSet) != 0 {
+		t.Errorf("HeadersToSet = %v, want none", mods.HeadersToSet)
+	}
+	if !reflect.DeepEqual(mods.HeadersToRemove, corsResponseHeaders) {
+		t.Errorf("HeadersToRemove = %v, want %v", mods.HeadersToRemove, corsResponseHeaders)
+	}
+}
+
+func TestOriginMatchingRejectsPartialMatches(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"allowedOrigins":        []interface{}{"https://app.example.com"},
+		"allowedOriginPatterns": []interface{}{`https://[a-z0-9-]+\.preview\.example\.com`},
+	})
+
+	for _, origin := range []string{"https://app.example.com", "https://pr-12.preview.example.com"} {
+		if !p.originAllowed(origin) {
+			t.Errorf("origin %q should be allowed", origin)
+		}
+	}
+	for _, origin := range []string{
+		"https://app.example.com.evil.com",
+		"https://evil.com/?x=https://app.example.com",
+		"https://appXexample.com",
+		"https://pr-12.preview.example.com.evil.com",
+		"http://evil.com#https://pr-12.preview.example.com",
+	} {
+		if p.originAllowed(origin) {
+			t.Errorf("origin %q should NOT be allowed", origin)
+		}
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"credentials with wildcard origin": {"allowedOrigins": []interface{}{"*"}, "allowCredentials": true},
+		"credentials with pattern": {
+			"allowedOriginPatterns": []interface{}{`https://.*\.example\.com`},
+			"allowCredentials":      true,
+		},
+		"invalid pattern":         {"allowedOriginPatterns": []interface{}{"("}},
+		"origins not a list":      {"allowedOrigins": "https://app.example.com"},
+		"empty origin":            {"allowedOrigins": []interface{}{" "}},
+		"negative maxAge":         {"maxAge": float64(-1)},
+		"non-boolean credentials": {"allowCredentials": "true"},
+	}
+	for name, params := range cases {
+		if _, err := GetPolicy(policy.PolicyMetadata{}, params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/wso2/api-platform/gateway/policies/cors
+
+go 1.26.5
+
+require github.com/wso2/api-platform/sdk/core v0.2.9
@@ -0,0 +1,484 @@
+package semanticcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wso2/api-platform/sdk/ai/embeddings"
+	"github.com/wso2/api-platform/sdk/ai/vectordb"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/utils"
+)
+
+const (
+	authHeaderName      = "Authorization"
+	azureAuthHeaderName = "api-key"
+
+	headerCacheStatus = "x-cache-status"
+
+	// embeddingMetadataKey carries the prompt embedding of a cache miss to the
+	// response phase, where the response is stored under it.
+	embeddingMetadataKey = "__semantic_cache_embedding"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// newEmbeddingProvider and newVectorDBProvider build the backends; they are
+// replaced in tests.
+var (
+	newEmbeddingProvider = embeddings.NewEmbeddingProvider
+	newVectorDBProvider  = vectordb.NewVectorDBProvider
+)
+
+// stats holds the counters of each route. Policy instances are rebuilt
+// whenever the route's chain is updated, so the counters live here to survive
+// updates.
+var stats sync.Map // route name -> *vectordb.CacheStats
+
+// backends holds one embedding provider and vector store client per
+// configuration, so their connections are kept across chain updates and
+// shared by routes with the same settings.
+var backends sync.Map // backendConfig -> *backend
+
+// embeddingConfig is the embedding provider settings.
+type embeddingConfig struct {
+	provider  string
+	endpoint  string
+	apiKey    string
+	model     string
+	dimension int
+}
+
+// vectorStoreConfig is the vector store settings.
+type vectorStoreConfig struct {
+	provider       string
+	host           string
+	port           int
+	username       string
+	password       string
+	database       string
+	distanceMetric vectordb.DistanceMetric
+}
+
+// backendConfig identifies a backend. The TTL is part of it because Milvus
+// applies it to the whole collection.
+type backendConfig struct {
+	embedding   embeddingConfig
+	vectorStore vectorStoreConfig
+	ttlSeconds  int
+}
+
+type backend struct {
+	embedder embeddings.EmbeddingProvider
+	db       vectordb.VectorDBProvider
+
+	mu      sync.Mutex
+	indexed bool
+}
+
+// config is the parsed policy configuration.
+type config struct {
+	jsonPath   string
+	threshold  string
+	maxEntries int
+	backend    backendConfig
+}
+
+// SemanticCachePolicy serves LLM requests from cached responses to similar
+// prompts.
+type SemanticCachePolicy struct {
+	route   string
+	apiID   string
+	cfg     config
+	stats   *vectordb.CacheStats
+	backend *backend
+}
+
+// GetPolicy binds the policy to the route's shared counters and to the
+// backend of its embedding provider and vector store settings.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	b, err := sharedBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Entries are kept per API; a policy outside an API falls back to its route
+	apiID := metadata.APIId
+	if apiID == "" {
+		apiID = metadata.RouteName
+	}
+	s, _ := stats.LoadOrStore(metadata.RouteName, &vectordb.CacheStats{})
+	return &SemanticCachePolicy{
+		route:   metadata.RouteName,
+		apiID:   apiID,
+		cfg:     cfg,
+		stats:   s.(*vectordb.CacheStats),
+		backend: b,
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. The request body holds the
+// prompt and the response body is stored on a miss.
+func (p *SemanticCachePolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeBuffer,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeBuffer,
+	}
+}
+
+// OnRequestBody answers the request with the cached response of a similar
+// prompt. On a miss the prompt embedding is kept for the response phase.
+func (p *SemanticCachePolicy) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	if reqCtx.Body == nil || !reqCtx.Body.Present || len(reqCtx.Body.Content) == 0 {
+		return nil
+	}
+	prompt, err := utils.ExtractStringValueFromJsonpath(reqCtx.Body.Content, p.cfg.jsonPath)
+	if err != nil {
+		slog.DebugContext(ctx, "Semantic cache could not read the prompt", "route", p.route, "error", err)
+		return errorResponse(http.StatusBadRequest, "The request body has no prompt at the configured jsonPath")
+	}
+	if strings.TrimSpace(prompt) == "" {
+		return nil
+	}
+	if err := p.backend.ensureIndex(); err != nil {
+		p.stats.RecordDegraded()
+		slog.WarnContext(ctx, "Semantic cache degraded; passing request to upstream",
+			"reason", vectordb.DegradedReasonVectorDB, "route", p.route, "error", err)
+		return nil
+	}
+
+	result := vectordb.Lookup(p.backend.embedder, p.backend.db, prompt, p.filter(ctx), p.stats)
+	if result.Hit {
+		if resp, ok := cachedResponse(result.Response); ok {
+			return resp
+		}
+	}
+	if result.Embedding != nil {
+		setMetadata(reqCtx.SharedContext, embeddingMetadataKey, result.Embedding)
+	}
+	return nil
+}
+
+// OnResponseBody stores a successful JSON response under the prompt embedding
+// of a cache miss.
+func (p *SemanticCachePolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	if respCtx.SharedContext == nil {
+		return nil
+	}
+	embedding, ok := respCtx.SharedContext.Metadata[embeddingMetadataKey].([]float32)
+	if !ok {
+		return nil
+	}
+	if respCtx.ResponseStatus != http.StatusOK || respCtx.ResponseBody == nil || len(respCtx.ResponseBody.Content) == 0 {
+		return nil
+	}
+	// Streamed (server-sent events) and non-object bodies are not cached
+	var payload map[string]interface{}
+	if err := json.Unmarshal(respCtx.ResponseBody.Content, &payload); err != nil {
+		return nil
+	}
+
+	response := vectordb.CacheResponse{
+		ResponsePayload:     payload,
+		StatusCode:          strconv.Itoa(http.StatusOK),
+		JSON:                true,
+		ResponseFetchedTime: now(),
+	}
+	if err := p.backend.db.Store(embedding, response, p.filter(ctx)); err != nil {
+		p.stats.RecordError()
+		slog.WarnContext(ctx, "Semantic cache failed to store response", "route", p.route, "error", err)
+		return nil
+	}
+	p.stats.RecordStore()
+	return nil
+}
+
+// PolicyState reports the route's hit, miss, store and error counts for the
+// admin API and metrics.
+func (p *SemanticCachePolicy) PolicyState() map[string]interface{} {
+	return p.stats.State()
+}
+
+// filter returns the vector store filter of a lookup or store for the API.
+func (p *SemanticCachePolicy) filter(ctx context.Context) map[string]interface{} {
+	return map[string]interface{}{
+		"ctx":                        ctx,
+		"api_id":                     p.apiID,
+		"threshold":                  p.cfg.threshold,
+		vectordb.FilterKeyTTL:        p.cfg.backend.ttlSeconds,
+		vectordb.FilterKeyMaxEntries: p.cfg.maxEntries,
+	}
+}
+
+// cachedResponse builds the response served for a cache hit, or reports false
+// when the cached entry cannot be replayed.
+func cachedResponse(cached vectordb.CacheResponse) (policy.ImmediateResponse, bool) {
+	if cached.ResponsePayload == nil {
+		return policy.ImmediateResponse{}, false
+	}
+	body, err := json.Marshal(cached.ResponsePayload)
+	if err != nil {
+		return policy.ImmediateResponse{}, false
+	}
+	status, err := strconv.Atoi(cached.StatusCode)
+	if err != nil {
+		status = http.StatusOK
+	}
+	return policy.ImmediateResponse{
+		StatusCode: status,
+		Headers: map[string]string{
+			"content-type":    "application/json",
+			headerCacheStatus: "HIT",
+		},
+		Body: body,
+	}, true
+}
+
+// errorResponse builds a JSON error response naming the policy.
+func errorResponse(status int, message string) policy.ImmediateResponse {
+	body, _ := json.Marshal(map[string]string{
+		"type":    "SEMANTIC_CACHE",
+		"error":   http.StatusText(status),
+		"message": message,
+	})
+	return policy.ImmediateResponse{
+		StatusCode: status,
+		Headers:    map[string]string{"content-type": "application/json"},
+		Body:       body,
+	}
+}
+
+// sharedBackend returns the backend of cfg, creating it on first use.
+func sharedBackend(cfg config) (*backend, error) {
+	if b, ok := backends.Load(cfg.backend); ok {
+		return b.(*backend), nil
+	}
+	em := cfg.backend.embedding
+	header := authHeaderName
+	if em.provider == "AZURE_OPENAI" {
+		header = azureAuthHeaderName
+	}
+	embedder, err := newEmbeddingProvider(embeddings.EmbeddingProviderConfig{
+		EmbeddingProvider:  em.provider,
+		EmbeddingEndpoint:  em.endpoint,
+		APIKey:             em.apiKey,
+		AuthHeaderName:     header,
+		EmbeddingModel:     em.model,
+		EmbeddingDimension: strconv.Itoa(em.dimension),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid embedding provider settings: %w", err)
+	}
+	vs := cfg.backend.vectorStore
+	db, err := newVectorDBProvider(vectordb.VectorDBProviderConfig{
+		VectorStoreProvider: vs.provider,
+		EmbeddingDimension:  strconv.Itoa(em.dimension),
+		DistanceMetric:      string(vs.distanceMetric),
+		Threshold:           cfg.threshold,
+		DBHost:              vs.host,
+		DBPort:              vs.port,
+		Username:            vs.username,
+		Password:            vs.password,
+		DatabaseName:        vs.database,
+		TTL:                 strconv.Itoa(cfg.backend.ttlSeconds),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid vector store settings: %w", err)
+	}
+	b, loaded := backends.LoadOrStore(cfg.backend, &backend{embedder: embedder, db: db})
+	if loaded {
+		_ = db.Close()
+	}
+	return b.(*backend), nil
+}
+
+// ensureIndex creates the vector index on first use, so a vector store that is
+// unreachable when the chain is built only degrades lookups until it is back.
+func (b *backend) ensureIndex() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.indexed {
+		return nil
+	}
+	if err := b.db.CreateIndex(); err != nil {
+		return err
+	}
+	b.indexed = true
+	return nil
+}
+
+// parseConfig reads the policy parameters and the system parameters merged
+// into them.
+func parseConfig(params map[string]interface{}) (config, error) {
+	var cfg config
+
+	if v, ok := params["jsonPath"]; ok {
+		if cfg.jsonPath, ok = v.(string); !ok {
+			return cfg, fmt.Errorf("jsonPath must be a string")
+		}
+	}
+	cfg.backend.ttlSeconds = vectordb.DefaultTTL
+	if v, ok := params["ttl"]; ok {
+		n, err := toInt(v)
+		if err != nil || n < 0 {
+			return cfg, fmt.Errorf("ttl must be a non-negative number of seconds")
+		}
+		cfg.backend.ttlSeconds = n
+	}
+	if v, ok := params["maxEntries"]; ok {
+		n, err := toInt(v)
+		if err != nil || n < 0 {
+			return cfg, fmt.Errorf("maxEntries must be a non-negative integer")
+		}
+		cfg.maxEntries = n
+	}
+
+	var err error
+	if cfg.backend.embedding, err = parseEmbeddingConfig(params); err != nil {
+		return cfg, err
+	}
+	if cfg.backend.vectorStore, err = parseVectorStoreConfig(params); err != nil {
+		return cfg, err
+	}
+
+	v, ok := params["similarityThreshold"]
+	if !ok {
+		return cfg, fmt.Errorf("similarityThreshold is required")
+	}
+	threshold, ok := v.(float64)
+	if !ok {
+		n, err := toInt(v)
+		if err != nil {
+			return cfg, fmt.Errorf("similarityThreshold must be a number")
+		}
+		threshold = float64(n)
+	}
+	cfg.threshold = strconv.FormatFloat(threshold, 'f', -1, 64)
+	if _, err := cfg.backend.vectorStore.distanceMetric.ParseThreshold(cfg.threshold); err != nil {
+		return cfg, fmt.Errorf("invalid similarityThreshold: %w", err)
+	}
+	return cfg, nil
+}
+
+// parseEmbeddingConfig reads the embedding provider system parameters.
+func parseEmbeddingConfig(params map[string]interface{}) (embeddingConfig, error) {
+	var cfg embeddingConfig
+	if err := readStrings(params, map[string]*string{
+		"embeddingProvider": &cfg.provider,
+		"embeddingEndpoint": &cfg.endpoint,
+		"embeddingModel":    &cfg.model,
+		"apiKey":            &cfg.apiKey,
+	}); err != nil {
+		return cfg, err
+	}
+	cfg.provider = strings.ToUpper(cfg.provider)
+
+	n, err := toInt(params["embeddingDimension"])
+	if err != nil || n < 1 {
+		return cfg, fmt.Errorf("embeddingDimension must be a positive integer")
+	}
+	cfg.dimension = n
+	return cfg, nil
+}
+
+// parseVectorStoreConfig reads the vector store system parameters.
+func parseVectorStoreConfig(params map[string]interface{}) (vectorStoreConfig, error) {
+	var cfg vectorStoreConfig
+	var metric string
+	if err := readStrings(params, map[string]*string{
+		"vectorStoreProvider": &cfg.provider,
+		"dbHost":              &cfg.host,
+		"username":            &cfg.username,
+		"password":            &cfg.password,
+		"distanceMetric":      &metric,
+	}); err != nil {
+		return cfg, err
+	}
+	cfg.provider = strings.ToUpper(cfg.provider)
+
+	port, err := toInt(params["dbPort"])
+	if err != nil || port < 1 || port > 65535 {
+		return cfg, fmt.Errorf("dbPort must be between 1 and 65535")
+	}
+	cfg.port = port
+	// A database name for pgvector and Milvus, a number for Redis
+	switch database := params["database"].(type) {
+	case nil:
+	case string:
+		cfg.database = database
+	default:
+		n, err := toInt(database)
+		if err != nil || n < 0 {
+			return cfg, fmt.Errorf("database must be a name or a non-negative integer")
+		}
+		cfg.database = strconv.Itoa(n)
+	}
+	if cfg.distanceMetric, err = vectordb.ParseDistanceMetric(metric); err != nil {
+		return cfg, fmt.Errorf("invalid distanceMetric: %w", err)
+	}
+	return cfg, nil
+}
+
+// readStrings sets each field to its parameter, if present.
+func readStrings(params map[string]interface{}, fields map[string]*string) error {
+	for name, field := range fields {
+		if v, ok := params[name]; ok {
+			if *field, ok = v.(string); !ok {
+				return fmt.Errorf("%s must be a string", name)
+			}
+		}
+	}
+	return nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func setMetadata(shared *policy.SharedContext, key string, value interface{}) {
+	if shared == nil {
+		return
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = make(map[string]interface{})
+	}
+	shared.Metadata[key] = value
+}
@@ -0,0 +1,438 @@
+package semanticcache
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wso2/api-platform/sdk/ai/vectordb"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// Embeddings returned by the fake embedding provider. The first two prompts
+// are similar (cosine 0.98); the third is unrelated to both.
+var testEmbeddings = map[string][]float64{
+	"What is the capital of France?": {1, 0, 0},
+	"Tell me France's capital city.": {0.98, 0.199, 0},
+	"How do I bake bread?":           {0, 1, 0},
+}
+
+// startEmbeddingServer serves OpenAI-compatible embeddings of testEmbeddings
+// and rejects any other input, and records the auth headers it receives.
+func startEmbeddingServer(t *testing.T) (string, *http.Header) {
+	t.Helper()
+	var mu sync.Mutex
+	var last http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		last = r.Header.Clone()
+		mu.Unlock()
+		var req struct {
+			Input string `json:"input"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		embedding, ok := testEmbeddings[req.Input]
+		if !ok {
+			http.Error(w, `{"error":{"message":"rejected"}}`, http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{{"embedding": embedding}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, &last
+}
+
+// fakeStore is an in-memory vector store comparing embeddings by cosine
+// similarity.
+type fakeStore struct {
+	mu       sync.Mutex
+	config   vectordb.VectorDBProviderConfig
+	entries  []fakeEntry
+	filters  []map[string]interface{}
+	indexErr error
+	indexed  int
+	closed   bool
+}
+
+type fakeEntry struct {
+	apiID     string
+	embedding []float32
+	response  vectordb.CacheResponse
+}
+
+func (s *fakeStore) Init(config vectordb.VectorDBProviderConfig) error {
+	s.config = config
+	return nil
+}
+
+func (s *fakeStore) GetType() string { return "FAKE" }
+
+func (s *fakeStore) CreateIndex() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.indexErr != nil {
+		return s.indexErr
+	}
+	s.indexed++
+	return nil
+}
+
+func (s *fakeStore) Store(embedding []float32, response vectordb.CacheResponse, filter map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filters = append(s.filters, filter)
+	s.entries = append(s.entries, fakeEntry{apiID: filter["api_id"].(string), embedding: embedding, response: response})
+	return nil
+}
+
+func (s *fakeStore) Retrieve(embedding []float32, filter map[string]interface{}) (vectordb.CacheResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	threshold, err := strconv.ParseFloat(filter["threshold"].(string), 64)
+	if err != nil {
+		return vectordb.CacheResponse{}, err
+	}
+	best, found := -1.0, false
+	var response vectordb.CacheResponse
+	for _, e := range s.entries {
+		if e.apiID != filter["api_id"] {
+			continue
+		}
+		if score := cosine(embedding, e.embedding); score > best {
+			best, response, found = score, e.response, true
+		}
+	}
+	if !found || !vectordb.MetricCosine.IsHit(best, threshold) {
+		return vectordb.CacheResponse{}, vectordb.ErrNoResults
+	}
+	return response, nil
+}
+
+func (s *fakeStore) Close() error {
+	s.closed = true
+	return nil
+}
+
+func cosine(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// testParams returns the merged policy and system parameters of a policy
+// backed by the embedding server at endpoint.
+func testParams(endpoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"similarityThreshold": 0.9,
+		"jsonPath":            "$.messages[-1].content",
+		"maxEntries":          int64(0),
+		"embeddingProvider":   "OPENAI",
+		"embeddingEndpoint":   endpoint,
+		"embeddingModel":      "text-embedding-3-small",
+		"embeddingDimension":  int64(3),
+		"apiKey":              "test-key",
+		"vectorStoreProvider": "REDIS",
+		"dbHost":              "localhost",
+		"dbPort":              int64(6379),
+		"username":            "",
+		"password":            "",
+		"database":            "0",
+		"distanceMetric":      "COSINE",
+		"ttl":                 int64(3600),
+	}
+}
+
+// newTestPolicy builds a policy for the route whose vector store is the
+// returned fake. Backends and counters are cleared around the test.
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) (*SemanticCachePolicy, *fakeStore) {
+	t.Helper()
+	store := &fakeStore{}
+	reset := func() {
+		newVectorDBProvider = vectordb.NewVectorDBProvider
+		backends.Range(func(k, _ interface{}) bool { backends.Delete(k); return true })
+		stats.Delete(route)
+	}
+	reset()
+	newVectorDBProvider = func(config vectordb.VectorDBProviderConfig) (vectordb.VectorDBProvider, error) {
+		if err := store.Init(config); err != nil {
+			return nil, err
+		}
+		return store, nil
+	}
+	t.Cleanup(reset)
+
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route, APIId: "api-" + route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*SemanticCachePolicy), store
+}
+
+func chatRequest(prompt string) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"messages": []map[string]string{{"role": "user", "content": prompt}},
+	})
+	return string(body)
+}
+
+type upstreamResponse struct {
+	status int
+	body   string
+}
+
+// roundTrip runs a request through the policy. It returns the response served
+// by the policy, or nil when the request reached the upstream, in which case
+// the upstream response passes through the response phase.
+func roundTrip(p *SemanticCachePolicy, body string, upstream upstreamResponse) *policy.ImmediateResponse {
+	ctx := context.Background()
+	shared := &policy.SharedContext{Metadata: map[string]interface{}{}}
+	action := p.OnRequestBody(ctx, &policy.RequestContext{
+		SharedContext: shared,
+		Body:          &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+		Path:          "/chat",
+		Method:        http.MethodPost,
+	}, nil)
+	if action != nil {
+		resp := action.(policy.ImmediateResponse)
+		return &resp
+	}
+	if mods := p.OnResponseBody(ctx, &policy.ResponseContext{
+		SharedContext:  shared,
+		ResponseBody:   &policy.Body{Content: []byte(upstream.body), EndOfStream: true, Present: true},
+		ResponseStatus: upstream.status,
+	}, nil); mods != nil {
+		panic("OnResponseBody must not modify the upstream response")
+	}
+	return nil
+}
+
+const answer = `{"choices":[{"message":{"content":"Paris"}}]}`
+
+func TestMissStoresThenSimilarPromptHits(t *testing.T) {
+	endpoint, headers := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+
+	if resp := roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer}); resp != nil {
+		t.Fatalf("first request served from cache: %+v", resp)
+	}
+	if len(store.entries) != 1 || store.indexed != 1 {
+		t.Fatalf("entries = %d, indexed = %d, want 1 and 1", len(store.entries), store.indexed)
+	}
+	if got := headers.Get("Authorization"); got != "Bearer test-key" {
+		t.Errorf("Authorization = %q, want the bearer API key", got)
+	}
+
+	resp := roundTrip(p, chatRequest("Tell me France's capital city."), upstreamResponse{})
+	if resp == nil {
+		t.Fatal("similar prompt was not served from cache")
+	}
+	if resp.StatusCode != http.StatusOK || resp.Headers[headerCacheStatus] != "HIT" {
+		t.Errorf("hit = %d %v, want 200 with %s: HIT", resp.StatusCode, resp.Headers, headerCacheStatus)
+	}
+	if string(resp.Body) != answer {
+		t.Errorf("cached body = %s, want %s", resp.Body, answer)
+	}
+
+	if resp := roundTrip(p, chatRequest("How do I bake bread?"), upstreamResponse{200, answer}); resp != nil {
+		t.Fatal("dissimilar prompt was served from cache")
+	}
+
+	state := p.PolicyState()
+	if state["hits"] != uint64(1) || state["misses"] != uint64(2) || state["stores"] != uint64(2) {
+		t.Errorf("PolicyState() = %v, want 1 hit, 2 misses and 2 stores", state)
+	}
+	if store.indexed != 1 {
+		t.Errorf("CreateIndex called %d times, want once", store.indexed)
+	}
+}
+
+func TestOnlySuccessfulJSONResponsesStored(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+	prompt := chatRequest("What is the capital of France?")
+
+	for _, upstream := range []upstreamResponse{
+		{http.StatusInternalServerError, `{"error":"upstream failed"}`},
+		{http.StatusOK, "data: {\"choices\":[]}\n\n"},
+		{http.StatusOK, ""},
+	} {
+		if resp := roundTrip(p, prompt, upstream); resp != nil {
+			t.Fatalf("request served from cache: %+v", resp)
+		}
+	}
+	if len(store.entries) != 0 {
+		t.Errorf("stored %d entries, want none", len(store.entries))
+	}
+}
+
+func TestFilterCarriesTTLAndMaxEntries(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	params := testParams(endpoint)
+	params["ttl"] = int64(120)
+	params["maxEntries"] = int64(50)
+	p, store := newTestPolicy(t, "chat", params)
+
+	roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer})
+	if len(store.filters) != 1 {
+		t.Fatalf("stores = %d, want 1", len(store.filters))
+	}
+	filter := store.filters[0]
+	if filter[vectordb.FilterKeyTTL] != 120 || filter[vectordb.FilterKeyMaxEntries] != 50 || filter["api_id"] != "api-chat" {
+		t.Errorf("filter = %v, want ttl 120, maxEntries 50 and the API ID", filter)
+	}
+	if store.config.TTL != "120" || store.config.Threshold != "0.9" || store.config.EmbeddingDimension != "3" {
+		t.Errorf("vector store config = %+v", store.config)
+	}
+}
+
+func TestEmbeddingFailurePassesThrough(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+
+	if resp := roundTrip(p, chatRequest("unknown prompt"), upstreamResponse{200, answer}); resp != nil {
+		t.Fatalf("request not passed to upstream: %+v", resp)
+	}
+	if len(store.entries) != 0 {
+		t.Errorf("stored %d entries after a failed embedding, want none", len(store.entries))
+	}
+	if state := p.PolicyState(); state["degraded"] != uint64(1) || state["misses"] != uint64(0) {
+		t.Errorf("PolicyState() = %v, want 1 degraded lookup", state)
+	}
+}
+
+func TestUnreachableVectorStorePassesThrough(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+	store.indexErr = context.DeadlineExceeded
+
+	if resp := roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer}); resp != nil {
+		t.Fatalf("request not passed to upstream: %+v", resp)
+	}
+	if state := p.PolicyState(); state["degraded"] != uint64(1) {
+		t.Errorf("PolicyState() = %v, want 1 degraded lookup", state)
+	}
+
+	store.indexErr = nil
+	roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer})
+	if store.indexed != 1 || len(store.entries) != 1 {
+		t.Errorf("indexed = %d, entries = %d after recovery, want 1 and 1", store.indexed, len(store.entries))
+	}
+}
+
+func TestPromptNotAtJSONPathRejected(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, _ := newTestPolicy(t, "chat", testParams(endpoint))
+
+	resp := roundTrip(p, `{"message":"no messages here"}`, upstreamResponse{})
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("response = %+v, want 400", resp)
+	}
+	var body map[string]string
+	if err := json.Unmarshal(resp.Body, &body); err != nil || body["type"] != "SEMANTIC_CACHE" {
+		t.Errorf("body = %s, want a SEMANTIC_CACHE error", resp.Body)
+	}
+	if strings.Contains(string(resp.Body), "messages") {
+		t.Errorf("body = %s leaks the jsonPath", resp.Body)
+	}
+}
+
+func TestEmptyBodyPassesThrough(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+
+	if resp := roundTrip(p, "", upstreamResponse{200, answer}); resp != nil {
+		t.Fatalf("empty body not passed to upstream: %+v", resp)
+	}
+	if len(store.entries) != 0 || store.indexed != 0 {
+		t.Errorf("entries = %d, indexed = %d, want no vector store calls", len(store.entries), store.indexed)
+	}
+}
+
+func TestAzureUsesAPIKeyHeader(t *testing.T) {
+	endpoint, headers := startEmbeddingServer(t)
+	params := testParams(endpoint)
+	params["embeddingProvider"] = "AZURE_OPENAI"
+	params["embeddingModel"] = ""
+	p, _ := newTestPolicy(t, "chat", params)
+
+	roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer})
+	if got := headers.Get("api-key"); got != "test-key" {
+		t.Errorf("api-key = %q, want the API key", got)
+	}
+}
+
+func TestBackendSharedAcrossRoutes(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	params := testParams(endpoint)
+	a, _ := newTestPolicy(t, "a", params)
+	stats.Delete("b")
+	t.Cleanup(func() { stats.Delete("b") })
+	b, err := GetPolicy(policy.PolicyMetadata{RouteName: "b", APIId: "api-b"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	if a.backend != b.(*SemanticCachePolicy).backend {
+		t.Error("routes with the same settings do not share a backend")
+	}
+	if a.stats == b.(*SemanticCachePolicy).stats {
+		t.Error("routes share counters")
+	}
+}
+
+func TestParseConfigRejectsInvalid(t *testing.T) {
+	tests := map[string]func(map[string]interface{}){
+		"missing threshold":     func(p map[string]interface{}) { delete(p, "similarityThreshold") },
+		"threshold above one":   func(p map[string]interface{}) { p["similarityThreshold"] = 1.5 },
+		"threshold not number":  func(p map[string]interface{}) { p["similarityThreshold"] = "high" },
+		"negative ttl":          func(p map[string]interface{}) { p["ttl"] = int64(-1) },
+		"negative maxEntries":   func(p map[string]interface{}) { p["maxEntries"] = int64(-1) },
+		"fractional maxEntries": func(p map[string]interface{}) { p["maxEntries"] = 1.5 },
+		"missing dimension":     func(p map[string]interface{}) { delete(p, "embeddingDimension") },
+		"invalid port":          func(p map[string]interface{}) { p["dbPort"] = int64(70000) },
+		"invalid metric":        func(p map[string]interface{}) { p["distanceMetric"] = "MANHATTAN" },
+		"non-string apiKey":     func(p map[string]interface{}) { p["apiKey"] = 42 },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := testParams("http://localhost")
+			mutate(params)
+			if _, err := parseConfig(params); err == nil {
+				t.Error("parseConfig() error = nil, want an error")
+			}
+		})
+	}
+
+	cfg, err := parseConfig(testParams("http://localhost"))
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.threshold != "0.9" || cfg.backend.ttlSeconds != 3600 || cfg.maxEntries != 0 {
+		t.Errorf("parseConfig() = %+v", cfg)
+	}
+}
+
+func TestStoredResponseRecordsFetchTime(t *testing.T) {
+	endpoint, _ := startEmbeddingServer(t)
+	p, store := newTestPolicy(t, "chat", testParams(endpoint))
+	fetched := time.Unix(1_700_000_000, 0)
+	now = func() time.Time { return fetched }
+	t.Cleanup(func() { now = time.Now })
+
+	roundTrip(p, chatRequest("What is the capital of France?"), upstreamResponse{200, answer})
+	if len(store.entries) != 1 || !store.entries[0].response.ResponseFetchedTime.Equal(fetched) {
+		t.Fatalf("entries = %+v, want one fetched at %v", store.entries, fetched)
+	}
+}
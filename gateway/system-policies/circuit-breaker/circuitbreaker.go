@@ -0,0 +1,351 @@
+package circuitbreaker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultWindow           = 30 * time.Second
+	defaultOpenDuration     = 30 * time.Second
+	defaultOpenStatusCode   = 503
+
+	// SharedContext.Metadata keys marking how the request was admitted, so the
+	// response phase only records outcomes of requests that reached the upstream.
+	probeMetadataKey    = "__circuit_breaker_probe"
+	rejectedMetadataKey = "__circuit_breaker_rejected"
+)
+
+var defaultFailureStatusCodes = []int{500, 502, 503, 504}
+
+// Circuit states reported by PolicyState.
+const (
+	stateClosed   = "closed"
+	stateOpen     = "open"
+	stateHalfOpen = "half-open"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// breakers holds one breaker per route. Policy instances are rebuilt whenever
+// the route's chain is updated, so the state lives here to survive updates.
+var breakers sync.Map // route name -> *breaker
+
+// config is the parsed policy configuration.
+type config struct {
+	failureThreshold   int
+	window             time.Duration
+	openDuration       time.Duration
+	failureStatusCodes map[int]bool
+	openStatusCode     int
+}
+
+// breaker tracks failures of a single route.
+type breaker struct {
+	mu            sync.Mutex
+	state         string
+	failures      []time.Time // failure times within the window, oldest first
+	openedAt      time.Time
+	probeInFlight bool
+	probeStarted  time.Time
+	trips         uint64
+	rejected      uint64
+}
+
+// CircuitBreakerPolicy short-circuits requests to an upstream that keeps failing.
+type CircuitBreakerPolicy struct {
+	route   string
+	cfg     config
+	breaker *breaker
+}
+
+// GetPolicy creates a circuit breaker bound to the route's shared breaker.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	b, _ := breakers.LoadOrStore(metadata.RouteName, &breaker{state: stateClosed})
+	return &CircuitBreakerPolicy{
+		route:   metadata.RouteName,
+		cfg:     cfg,
+		breaker: b.(*breaker),
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy.
+func (p *CircuitBreakerPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeProcess,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+}
+
+// OnRequestHeaders rejects the request while the circuit is open.
+func (p *CircuitBreakerPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	allowed, probe, retryAfter := p.breaker.admit(p.cfg, now())
+	if probe {
+		setMetadata(reqCtx.SharedContext, probeMetadataKey, true)
+		slog.Debug("Circuit breaker: letting probe request through", "route", p.route)
+	}
+	if allowed {
+		return nil
+	}
+
+	setMetadata(reqCtx.SharedContext, rejectedMetadataKey, true)
+	body, _ := json.Marshal(map[string]string{
+		"error":   "Service Unavailable",
+		"message": "Upstream is temporarily unavailable",
+	})
+	return policy.ImmediateResponse{
+		StatusCode: p.cfg.openStatusCode,
+		Headers: map[string]string{
+			"content-type": "application/json",
+			"retry-after":  strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+		},
+		Body: body,
+	}
+}
+
+// OnResponseHeaders records the upstream response status.
+func (p *CircuitBreakerPolicy) OnResponseHeaders(_ context.Context, respCtx *policy.ResponseHeaderContext, _ map[string]interface{}) policy.ResponseHeaderAction {
+	if metadataFlag(respCtx.SharedContext, rejectedMetadataKey) {
+		return nil
+	}
+	failed := p.cfg.failureStatusCodes[respCtx.ResponseStatus]
+	probe := metadataFlag(respCtx.SharedContext, probeMetadataKey)
+	if tripped := p.breaker.record(p.cfg, now(), failed, probe); tripped {
+		slog.Warn("Circuit breaker opened", "route", p.route, "status", respCtx.ResponseStatus)
+	}
+	return nil
+}
+
+// PolicyState reports the route's circuit state for the admin API and metrics.
+func (p *CircuitBreakerPolicy) PolicyState() map[string]interface{} {
+	b := p.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneLocked(p.cfg, now())
+	return map[string]interface{}{
+		"state":    b.state,
+		"open":     b.state != stateClosed,
+		"failures": len(b.failures),
+		"trips":    b.trips,
+		"rejected": b.rejected,
+	}
+}
+
+// admit decides whether a request may be forwarded. probe is true when the
+// request is the single trial request of a half-open circuit. When rejected,
+// retryAfter is the time until the next probe is allowed.
+func (b *breaker) admit(cfg config, t time.Time) (allowed, probe bool, retryAfter time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if wait := b.openedAt.Add(cfg.openDuration).Sub(t); wait > 0 {
+			b.rejected++
+			return false, false, wait
+		}
+		b.state = stateHalfOpen
+		fallthrough
+	case stateHalfOpen:
+		// A probe that never completed (e.g. the client went away) is given
+		// up on after openDuration so the circuit cannot stay stuck.
+		if b.probeInFlight {
+			if wait := b.probeStarted.Add(cfg.openDuration).Sub(t); wait > 0 {
+				b.rejected++
+				return false, false, wait
+			}
+		}
+		b.probeInFlight = true
+		b.probeStarted = t
+		return true, true, 0
+	default:
+		return true, false, 0
+	}
+}
+
+// record applies the outcome of a forwarded request and reports whether it
+// opened the circuit.
+func (b *breaker) record(cfg config, t time.Time, failed, probe bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == stateHalfOpen {
+		// Outcomes of requests admitted before the circuit opened are ignored;
+		// only the probe decides.
+		if !probe {
+			return false
+		}
+		b.probeInFlight = false
+		if failed {
+			b.openLocked(t)
+			return true
+		}
+		b.state = stateClosed
+		b.failures = nil
+		return false
+	}
+	if b.state != stateClosed || !failed {
+		return false
+	}
+
+	b.pruneLocked(cfg, t)
+	b.failures = append(b.failures, t)
+	if len(b.failures) < cfg.failureThreshold {
+		return false
+	}
+	b.openLocked(t)
+	return true
+}
+
+func (b *breaker) openLocked(t time.Time) {
+	b.state = stateOpen
+	b.openedAt = t
+	b.failures = nil
+	b.trips++
+}
+
+// pruneLocked drops failures that fell out of the rolling window.
+func (b *breaker) pruneLocked(cfg config, t time.Time) {
+	cutoff := t.Add(-cfg.window)
+	i := 0
+	for i < len(b.failures) && !b.failures[i].After(cutoff) {
+		i++
+	}
+	b.failures = b.failures[i:]
+}
+
+// parseConfig reads the failure threshold and window, how long the breaker stays open,
+// the status code returned while open and the upstream status codes counted as failures.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		failureThreshold: defaultFailureThreshold,
+		window:           defaultWindow,
+		openDuration:     defaultOpenDuration,
+		openStatusCode:   defaultOpenStatusCode,
+	}
+
+	var err error
+	if v, ok := params["failureThreshold"]; ok {
+		if cfg.failureThreshold, err = toInt(v); err != nil || cfg.failureThreshold < 1 {
+			return cfg, fmt.Errorf("failureThreshold must be a positive integer")
+		}
+	}
+	if v, ok := params["window"]; ok {
+		if cfg.window, err = toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid window: %w", err)
+		}
+	}
+	if v, ok := params["openDuration"]; ok {
+		if cfg.openDuration, err = toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid openDuration: %w", err)
+		}
+	}
+	if v, ok := params["openStatusCode"]; ok {
+		if cfg.openStatusCode, err = toInt(v); err != nil || !validStatusCode(cfg.openStatusCode) {
+			return cfg, fmt.Errorf("openStatusCode must be an HTTP status code")
+		}
+	}
+
+	codes := defaultFailureStatusCodes
+	if v, ok := params["failureStatusCodes"]; ok {
+		list, ok := v.([]interface{})
+		if !ok {
+			return cfg, fmt.Errorf("failureStatusCodes must be a list of HTTP status codes")
+		}
+		codes = make([]int, 0, len(list))
+		for _, item := range list {
+			code, err := toInt(item)
+			if err != nil || !validStatusCode(code) {
+				return cfg, fmt.Errorf("failureStatusCodes must be a list of HTTP status codes")
+			}
+			codes = append(codes, code)
+		}
+	}
+	cfg.failureStatusCodes = make(map[int]bool, len(codes))
+	for _, code := range codes {
+		cfg.failureStatusCodes[code] = true
+	}
+	return cfg, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func toDuration(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected a duration string such as \"30s\"")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return d, nil
+}
+
+func validStatusCode(code int) bool {
+	return code >= 100 && code <= 599
+}
+
+func setMetadata(shared *policy.SharedContext, key string, value interface{}) {
+	if shared == nil {
+		return
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = make(map[string]interface{})
+	}
+	shared.Metadata[key] = value
+}
+
+func metadataFlag(shared *policy.SharedContext, key string) bool {
+	if shared == nil || shared.Metadata == nil {
+		return false
+	}
+	flag, _ := shared.Metadata[key].(bool)
+	return flag
+}
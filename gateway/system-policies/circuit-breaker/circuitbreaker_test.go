@@ -0,0 +1,209 @@
+package circuitbreaker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+// testStart is the time the clock is pinned to when a test starts.
+var testStart = time.Unix(1_700_000_000, 0)
+
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) *CircuitBreakerPolicy {
+	t.Helper()
+	breakers.Delete(route)
+	t.Cleanup(func() { breakers.Delete(route) })
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*CircuitBreakerPolicy)
+}
+
+// roundTrip runs one request through the policy and returns the immediate
+// response, or nil when the request was forwarded with the given upstream status.
+func roundTrip(p *CircuitBreakerPolicy, upstreamStatus int) *policy.ImmediateResponse {
+	shared := &policy.SharedContext{Metadata: map[string]interface{}{}}
+	action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{SharedContext: shared}, nil)
+	if resp, ok := action.(policy.ImmediateResponse); ok {
+		return &resp
+	}
+	p.OnResponseHeaders(context.Background(), &policy.ResponseHeaderContext{SharedContext: shared, ResponseStatus: upstreamStatus}, nil)
+	return nil
+}
+
+func TestCircuitOpensAtThreshold(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-open", map[string]interface{}{"failureThreshold": 3, "openStatusCode": float64(502)})
+
+	for i := 0; i < 3; i++ {
+		if resp := roundTrip(p, 503); resp != nil {
+			t.Fatalf("request %d rejected before the circuit opened", i)
+		}
+	}
+
+	resp := roundTrip(p, 200)
+	if resp == nil {
+		t.Fatal("expected request to be rejected while open")
+	}
+	if resp.StatusCode != 502 {
+		t.Errorf("StatusCode = %d, want 502", resp.StatusCode)
+	}
+	if resp.Headers["retry-after"] != "30" {
+		t.Errorf("retry-after = %q, want %q", resp.Headers["retry-after"], "30")
+	}
+
+	state := p.PolicyState()
+	if state["state"] != stateOpen || state["open"] != true {
+		t.Errorf("state = %v, want open", state)
+	}
+	if state["trips"] != uint64(1) || state["rejected"] != uint64(1) {
+		t.Errorf("trips/rejected = %v/%v, want 1/1", state["trips"], state["rejected"])
+	}
+}
+
+func TestSuccessesAndUnlistedCodesDoNotCount(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-success", map[string]interface{}{"failureThreshold": 2})
+
+	for _, status := range []int{200, 404, 429, 500, 201} {
+		if resp := roundTrip(p, status); resp != nil {
+			t.Fatalf("status %d: request rejected", status)
+		}
+	}
+	if got := p.PolicyState()["failures"]; got != 1 {
+		t.Errorf("failures = %v, want 1", got)
+	}
+}
+
+func TestFailuresOutsideWindowExpire(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-window", map[string]interface{}{"failureThreshold": 2, "window": "10s"})
+
+	roundTrip(p, 500)
+	*clock = clock.Add(11 * time.Second)
+	roundTrip(p, 500)
+
+	state := p.PolicyState()
+	if state["state"] != stateClosed {
+		t.Errorf("state = %v, want closed", state["state"])
+	}
+	if state["failures"] != 1 {
+		t.Errorf("failures = %v, want 1", state["failures"])
+	}
+}
+
+func TestHalfOpenProbe(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-probe", map[string]interface{}{"failureThreshold": 1, "openDuration": "5s"})
+
+	roundTrip(p, 500)
+	if roundTrip(p, 200) == nil {
+		t.Fatal("expected rejection while open")
+	}
+
+	// After openDuration one probe is let through; concurrent requests are still rejected
+	*clock = clock.Add(5 * time.Second)
+	probeCtx := &policy.SharedContext{Metadata: map[string]interface{}{}}
+	if action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{SharedContext: probeCtx}, nil); action != nil {
+		t.Fatalf("probe rejected: %v", action)
+	}
+	if p.PolicyState()["state"] != stateHalfOpen {
+		t.Errorf("state = %v, want half-open", p.PolicyState()["state"])
+	}
+	if roundTrip(p, 200) == nil {
+		t.Fatal("expected rejection while probe is in flight")
+	}
+
+	// A failed probe opens the circuit again
+	p.OnResponseHeaders(context.Background(), &policy.ResponseHeaderContext{SharedContext: probeCtx, ResponseStatus: 503}, nil)
+	if p.PolicyState()["state"] != stateOpen {
+		t.Fatalf("state = %v, want open", p.PolicyState()["state"])
+	}
+
+	// A successful probe closes it
+	*clock = clock.Add(5 * time.Second)
+	if resp := roundTrip(p, 200); resp != nil {
+		t.Fatal("probe rejected")
+	}
+	state := p.PolicyState()
+	if state["state"] != stateClosed || state["trips"] != uint64(2) {
+		t.Errorf("state = %v, want closed after 2 trips", state)
+	}
+}
+
+func TestStalledProbeIsReplaced(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-stalled", map[string]interface{}{"failureThreshold": 1, "openDuration": "5s"})
+
+	roundTrip(p, 500)
+	*clock = clock.Add(5 * time.Second)
+	p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{SharedContext: &policy.SharedContext{}}, nil)
+
+	*clock = clock.Add(5 * time.Second)
+	if resp := roundTrip(p, 200); resp != nil {
+		t.Fatal("expected a new probe once the previous one timed out")
+	}
+	if p.PolicyState()["state"] != stateClosed {
+		t.Errorf("state = %v, want closed", p.PolicyState()["state"])
+	}
+}
+
+func TestStateSharedAcrossChainRebuilds(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-shared", map[string]interface{}{"failureThreshold": 1})
+	roundTrip(p, 500)
+
+	rebuilt, err := GetPolicy(policy.PolicyMetadata{RouteName: "route-shared"}, map[string]interface{}{"failureThreshold": 1})
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	if roundTrip(rebuilt.(*CircuitBreakerPolicy), 200) == nil {
+		t.Error("expected rebuilt policy to see the open circuit")
+	}
+
+	other := newTestPolicy(t, "route-other", map[string]interface{}{"failureThreshold": 1})
+	if roundTrip(other, 200) != nil {
+		t.Error("circuit state leaked to another route")
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := parseConfig(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.failureThreshold != 5 || cfg.window != 30*time.Second || cfg.openDuration != 30*time.Second || cfg.openStatusCode != 503 {
+		t.Errorf("unexpected defaults: %+v", cfg)
+	}
+	if len(cfg.failureStatusCodes) != 4 || !cfg.failureStatusCodes[502] {
+		t.Errorf("unexpected default failure codes: %v", cfg.failureStatusCodes)
+	}
+
+	cfg, err = parseConfig(map[string]interface{}{"failureStatusCodes": []interface{}{float64(429), 500}})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if len(cfg.failureStatusCodes) != 2 || !cfg.failureStatusCodes[429] || cfg.failureStatusCodes[503] {
+		t.Errorf("failureStatusCodes = %v, want 429 and 500", cfg.failureStatusCodes)
+	}
+
+	invalid := []map[string]interface{}{
+		{"failureThreshold": 0},
+		{"failureThreshold": 1.5},
+		{"window": "soon"},
+		{"window": "-1s"},
+		{"openDuration": 30},
+		{"openStatusCode": 700},
+		{"failureStatusCodes": "500"},
+		{"failureStatusCodes": []interface{}{"500"}},
+	}
+	for _, params := range invalid {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("parseConfig(%v) expected error", params)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package contentsafety
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+)
+
+const (
+	azureAPIVersion               = "2024-09-01"
+	defaultAzureSeverityThreshold = 4
+)
+
+var defaultAzureCategories = []string{"Hate", "Sexual", "SelfHarm", "Violence"}
+
+// azureProvider calls the Azure AI Content Safety text:analyze API. Text is
+// flagged when any category reaches the severity threshold.
+type azureProvider struct {
+	client     *http.Client
+	analyzeURL string
+	apiKey     string
+	categories []string
+	threshold  int
+}
+
+type azureRequest struct {
+	Text       string   `json:"text"`
+	Categories []string `json:"categories"`
+	OutputType string   `json:"outputType"`
+}
+
+type azureResponse struct {
+	CategoriesAnalysis []struct {
+		Category string `json:"category"`
+		Severity int    `json:"severity"`
+	} `json:"categoriesAnalysis"`
+}
+
+// newAzureProvider reads endpoint and apiKey, and optionally categories and
+// severityThreshold (0-7).
+func newAzureProvider(cfg map[string]interface{}, client *http.Client) (ContentSafetyProvider, error) {
+	endpoint, err := stringParam(cfg, "endpoint", true)
+	if err != nil {
+		return nil, err
+	}
+	base, err := parseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := stringParam(cfg, "apiKey", true)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := stringListParam(cfg, "categories")
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		categories = defaultAzureCategories
+	}
+	threshold := defaultAzureSeverityThreshold
+	if v, ok := cfg["severityThreshold"]; ok {
+		f, ok := v.(float64)
+		if !ok || f != math.Trunc(f) || f < 0 || f > 7 {
+			return nil, fmt.Errorf("severityThreshold must be an integer between 0 and 7")
+		}
+		threshold = int(f)
+	}
+	return &azureProvider{
+		client:     client,
+		analyzeURL: base + "/contentsafety/text:analyze?api-version=" + azureAPIVersion,
+		apiKey:     apiKey,
+		categories: categories,
+		threshold:  threshold,
+	}, nil
+}
+
+// Analyze runs the configured categories against text.
+func (p *azureProvider) Analyze(ctx context.Context, text string) (Assessment, error) {
+	payload, err := json.Marshal(azureRequest{
+		Text:       text,
+		Categories: p.categories,
+		OutputType: "FourSeverityLevels",
+	})
+	if err != nil {
+		return Assessment{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.analyzeURL, bytes.NewReader(payload))
+	if err != nil {
+		return Assessment{}, err
+	}
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("ocp-apim-subscription-key", p.apiKey)
+
+	body, err := doJSON(p.client, req)
+	if err != nil {
+		return Assessment{}, err
+	}
+	var resp azureResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Assessment{}, fmt.Errorf("decoding provider response: %w", err)
+	}
+	var categories []string
+	for _, c := range resp.CategoriesAnalysis {
+		if c.Severity >= p.threshold {
+			categories = append(categories, c.Category)
+		}
+	}
+	return Assessment{Flagged: len(categories) > 0, Categories: categories}, nil
+}
@@ -0,0 +1,277 @@
+package contentsafety
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	bedrockIntervened = "GUARDRAIL_INTERVENED"
+	bedrockBlocked    = "BLOCKED"
+	bedrockService    = "bedrock"
+)
+
+// bedrockProvider calls the AWS Bedrock ApplyGuardrail API. Text is flagged
+// when the guardrail blocks it; anonymized PII alone does not flag it.
+type bedrockProvider struct {
+	client       *http.Client
+	applyURL     string
+	region       string
+	accessKeyID  string
+	secretKey    string
+	sessionToken string
+}
+
+type bedrockRequest struct {
+	Source  string                `json:"source"`
+	Content []bedrockContentBlock `json:"content"`
+}
+
+type bedrockContentBlock struct {
+	Text bedrockText `json:"text"`
+}
+
+type bedrockText struct {
+	Text string `json:"text"`
+}
+
+type bedrockResponse struct {
+	Action      string `json:"action"`
+	Assessments []struct {
+		ContentPolicy *struct {
+			Filters []struct {
+				Type   string `json:"type"`
+				Action string `json:"action"`
+			} `json:"filters"`
+		} `json:"contentPolicy"`
+		TopicPolicy *struct {
+			Topics []struct {
+				Name   string `json:"name"`
+				Action string `json:"action"`
+			} `json:"topics"`
+		} `json:"topicPolicy"`
+		WordPolicy *struct {
+			CustomWords []struct {
+				Action string `json:"action"`
+			} `json:"customWords"`
+			ManagedWordLists []struct {
+				Type   string `json:"type"`
+				Action string `json:"action"`
+			} `json:"managedWordLists"`
+		} `json:"wordPolicy"`
+		SensitiveInformationPolicy *struct {
+			PiiEntities []struct {
+				Type   string `json:"type"`
+				Action string `json:"action"`
+			} `json:"piiEntities"`
+			Regexes []struct {
+				Name   string `json:"name"`
+				Action string `json:"action"`
+			} `json:"regexes"`
+		} `json:"sensitiveInformationPolicy"`
+	} `json:"assessments"`
+}
+
+// newBedrockProvider reads region, guardrailId and guardrailVersion, and the
+// AWS credentials from accessKeyId, secretAccessKey and sessionToken or, when
+// unset, from the standard AWS environment variables. endpoint overrides the
+// regional bedrock-runtime endpoint.
+func newBedrockProvider(cfg map[string]interface{}, client *http.Client) (ContentSafetyProvider, error) {
+	region, err := stringParam(cfg, "region", true)
+	if err != nil {
+		return nil, err
+	}
+	guardrailID, err := stringParam(cfg, "guardrailId", true)
+	if err != nil {
+		return nil, err
+	}
+	version, err := stringParam(cfg, "guardrailVersion", false)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = "DRAFT"
+	}
+	endpoint, err := stringParam(cfg, "endpoint", false)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == "" {
+		endpoint = "https://bedrock-runtime." + region + ".amazonaws.com"
+	}
+	base, err := parseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &bedrockProvider{
+		client:   client,
+		applyURL: base + "/guardrail/" + url.PathEscape(guardrailID) + "/version/" + url.PathEscape(version) + "/apply",
+		region:   region,
+	}
+	for _, c := range []struct {
+		param, env string
+		dst        *string
+	}{
+		{"accessKeyId", "AWS_ACCESS_KEY_ID", &p.accessKeyID},
+		{"secretAccessKey", "AWS_SECRET_ACCESS_KEY", &p.secretKey},
+		{"sessionToken", "AWS_SESSION_TOKEN", &p.sessionToken},
+	} {
+		if *c.dst, err = stringParam(cfg, c.param, false); err != nil {
+			return nil, err
+		}
+		if *c.dst == "" {
+			*c.dst = os.Getenv(c.env)
+		}
+	}
+	if p.accessKeyID == "" || p.secretKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required: set accessKeyId and secretAccessKey")
+	}
+	return p, nil
+}
+
+// Analyze applies the guardrail to text.
+func (p *bedrockProvider) Analyze(ctx context.Context, text string) (Assessment, error) {
+	source := "INPUT"
+	if DirectionFromContext(ctx) == DirectionResponse {
+		source = "OUTPUT"
+	}
+	payload, err := json.Marshal(bedrockRequest{
+		Source:  source,
+		Content: []bedrockContentBlock{{Text: bedrockText{Text: text}}},
+	})
+	if err != nil {
+		return Assessment{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.applyURL, bytes.NewReader(payload))
+	if err != nil {
+		return Assessment{}, err
+	}
+	req.Header.Set("content-type", "application/json")
+	p.sign(req, payload, time.Now())
+
+	body, err := doJSON(p.client, req)
+	if err != nil {
+		return Assessment{}, err
+	}
+	var resp bedrockResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Assessment{}, fmt.Errorf("decoding provider response: %w", err)
+	}
+	if resp.Action != bedrockIntervened {
+		return Assessment{}, nil
+	}
+
+	var categories []string
+	add := func(action, category string) {
+		if action == bedrockBlocked {
+			categories = append(categories, category)
+		}
+	}
+	for _, a := range resp.Assessments {
+		if a.ContentPolicy != nil {
+			for _, f := range a.ContentPolicy.Filters {
+				add(f.Action, f.Type)
+			}
+		}
+		if a.TopicPolicy != nil {
+			for _, t := range a.TopicPolicy.Topics {
+				add(t.Action, t.Name)
+			}
+		}
+		if a.WordPolicy != nil {
+			for _, w := range a.WordPolicy.CustomWords {
+				add(w.Action, "CUSTOM_WORD")
+			}
+			for _, w := range a.WordPolicy.ManagedWordLists {
+				add(w.Action, w.Type)
+			}
+		}
+		if a.SensitiveInformationPolicy != nil {
+			for _, e := range a.SensitiveInformationPolicy.PiiEntities {
+				add(e.Action, e.Type)
+			}
+			for _, r := range a.SensitiveInformationPolicy.Regexes {
+				add(r.Action, r.Name)
+			}
+		}
+	}
+	return Assessment{Flagged: len(categories) > 0, Categories: categories}, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req. SigV4 is
+// defined over HMAC-SHA256, so the hash cannot be chosen here.
+func (p *bedrockProvider) sign(req *http.Request, payload []byte, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	headers := []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	if p.sessionToken != "" {
+		req.Header.Set("x-amz-security-token", p.sessionToken)
+		headers = append(headers, "x-amz-security-token")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headers {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(headers, ";")
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + p.region + "/" + bedrockService + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+p.secretKey), date)
+	key = hmacSHA256(key, p.region)
+	key = hmacSHA256(key, bedrockService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("authorization", "AWS4-HMAC-SHA256 Credential="+p.accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// parseEndpoint validates a provider base URL and returns it without a
+// trailing slash.
+func parseEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User != nil {
+		return "", fmt.Errorf("endpoint must be an http or https URL")
+	}
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
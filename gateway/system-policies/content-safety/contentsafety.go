@@ -0,0 +1,327 @@
+package contentsafety
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/utils"
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
+
+const (
+	defaultTimeout          = 5 * time.Second
+	defaultMaxRetries       = 1
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+	maxRetriesLimit         = 5
+
+	applyRequest  = "request"
+	applyResponse = "response"
+	applyBoth     = "both"
+
+	// onProviderError values.
+	providerErrorBlock = "block"
+	providerErrorAllow = "allow"
+)
+
+// stats holds the counters of each route. Policy instances are rebuilt
+// whenever the route's chain is updated, so the counters live here to survive
+// updates.
+var stats sync.Map // route name -> *routeStats
+
+// callers holds the provider caller of each route, so the circuit breaker
+// state also survives chain updates.
+var callers sync.Map // route name -> *routeCaller
+
+type routeCaller struct {
+	provider string
+	cfg      resilience.Config
+	caller   *resilience.Caller
+}
+
+// routeStats separates content the provider flagged from provider failures,
+// so an outage is not mistaken for a surge of unsafe content.
+type routeStats struct {
+	blocked              atomic.Int64
+	providerErrors       atomic.Int64
+	allowedOnProviderErr atomic.Int64
+}
+
+// config is the parsed policy configuration.
+type config struct {
+	providerName    string
+	provider        ContentSafetyProvider
+	jsonPath        string
+	request         bool
+	response        bool
+	onProviderError string
+	timeout         time.Duration
+	resilience      resilience.Config
+}
+
+// ContentSafetyPolicy blocks requests and responses whose text a content
+// safety provider flags.
+type ContentSafetyPolicy struct {
+	route  string
+	cfg    config
+	stats  *routeStats
+	caller *resilience.Caller
+}
+
+// GetPolicy builds the configured provider and binds the policy to the route's
+// shared counters and provider caller. The caller, and with it the circuit
+// state, is replaced when the provider or its resilience settings change. The
+// policy itself does not depend on which provider is used.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := stats.LoadOrStore(metadata.RouteName, &routeStats{})
+	return &ContentSafetyPolicy{
+		route:  metadata.RouteName,
+		cfg:    cfg,
+		stats:  s.(*routeStats),
+		caller: routeProviderCaller(metadata.RouteName, cfg),
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. Only the bodies the
+// policy analyzes are buffered.
+func (p *ContentSafetyPolicy) Mode() policy.ProcessingMode {
+	mode := policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+	if p.cfg.request {
+		mode.RequestBodyMode = policy.BodyModeBuffer
+	}
+	if p.cfg.response {
+		mode.ResponseBodyMode = policy.BodyModeBuffer
+	}
+	return mode
+}
+
+// OnRequestBody rejects flagged requests with 422 before they reach the
+// upstream.
+func (p *ContentSafetyPolicy) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	if !p.cfg.request || reqCtx.Body == nil || !reqCtx.Body.Present || len(reqCtx.Body.Content) == 0 {
+		return nil
+	}
+	status, body := p.check(WithDirection(ctx, DirectionRequest), reqCtx.Body.Content)
+	if status == 0 {
+		return nil
+	}
+	return policy.ImmediateResponse{
+		StatusCode: status,
+		Headers:    map[string]string{"content-type": "application/json"},
+		Body:       body,
+	}
+}
+
+// OnResponseBody replaces flagged successful responses with 422.
+func (p *ContentSafetyPolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	if !p.cfg.response || respCtx.ResponseStatus < 200 || respCtx.ResponseStatus > 299 {
+		return nil
+	}
+	if respCtx.ResponseBody == nil || !respCtx.ResponseBody.Present || len(respCtx.ResponseBody.Content) == 0 {
+		return nil
+	}
+	status, body := p.check(WithDirection(ctx, DirectionResponse), respCtx.ResponseBody.Content)
+	if status == 0 {
+		return nil
+	}
+	return policy.DownstreamResponseModifications{
+		StatusCode:   &status,
+		Body:         body,
+		HeadersToSet: map[string]string{"content-type": "application/json"},
+	}
+}
+
+// PolicyState reports the route's block and provider error counts for the
+// admin API and metrics.
+func (p *ContentSafetyPolicy) PolicyState() map[string]interface{} {
+	return map[string]interface{}{
+		"blocked":                   p.stats.blocked.Load(),
+		"providerErrors":            p.stats.providerErrors.Load(),
+		"allowedAfterProviderError": p.stats.allowedOnProviderErr.Load(),
+		"circuitState":              p.caller.State(),
+		"circuitOpen":               p.caller.State() == resilience.StateOpen,
+	}
+}
+
+// check analyzes payload and returns the status and body of the response that
+// replaces it, or a zero status when it may pass. When the provider fails,
+// onProviderError decides between blocking with 503 and letting the payload
+// through.
+func (p *ContentSafetyPolicy) check(ctx context.Context, payload []byte) (int, []byte) {
+	text, err := utils.ExtractStringValueFromJsonpath(payload, p.cfg.jsonPath)
+	if err != nil {
+		return http.StatusUnprocessableEntity, errorBody("Unprocessable Entity", "Content could not be read for the content safety check")
+	}
+
+	var assessment Assessment
+	err = p.caller.Do(ctx, func(ctx context.Context) error {
+		var err error
+		assessment, err = p.cfg.provider.Analyze(ctx, text)
+		return err
+	})
+	if err != nil {
+		p.stats.providerErrors.Add(1)
+		if p.cfg.onProviderError == providerErrorAllow {
+			p.stats.allowedOnProviderErr.Add(1)
+			slog.Warn("Content safety provider failed; allowing content",
+				"route", p.route, "provider", p.cfg.providerName, "direction", DirectionFromContext(ctx), "error", err)
+			return 0, nil
+		}
+		return http.StatusServiceUnavailable, errorBody("Service Unavailable", "Content safety check failed")
+	}
+	if !assessment.Flagged {
+		return 0, nil
+	}
+	p.stats.blocked.Add(1)
+	body, _ := json.Marshal(map[string]interface{}{
+		"error":      "Unprocessable Entity",
+		"message":    "Content was blocked by the content safety policy",
+		"categories": assessment.Categories,
+	})
+	return http.StatusUnprocessableEntity, body
+}
+
+func errorBody(errorText, message string) []byte {
+	body, _ := json.Marshal(map[string]string{
+		"error":   errorText,
+		"message": message,
+	})
+	return body
+}
+
+func routeProviderCaller(route string, cfg config) *resilience.Caller {
+	want := &routeCaller{provider: cfg.providerName, cfg: cfg.resilience}
+	if v, ok := callers.Load(route); ok {
+		if rc := v.(*routeCaller); rc.provider == want.provider && rc.cfg == want.cfg {
+			return rc.caller
+		}
+	}
+	want.caller = resilience.NewCaller(cfg.providerName, cfg.resilience)
+	callers.Store(route, want)
+	return want.caller
+}
+
+// parseConfig validates jsonPath, applyTo, onProviderError and timeout, the retry and
+// circuit breaker settings for the provider, and builds the provider named by provider
+// from providerConfig. Only request content is checked unless applyTo says otherwise.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		request:         true,
+		onProviderError: providerErrorBlock,
+		timeout:         defaultTimeout,
+	}
+
+	if v, ok := params["jsonPath"]; ok {
+		if cfg.jsonPath, ok = v.(string); !ok {
+			return cfg, fmt.Errorf("jsonPath must be a string")
+		}
+	}
+	if v, ok := params["applyTo"]; ok {
+		applyTo, _ := v.(string)
+		switch applyTo {
+		case applyRequest:
+		case applyResponse:
+			cfg.request, cfg.response = false, true
+		case applyBoth:
+			cfg.response = true
+		default:
+			return cfg, fmt.Errorf("applyTo must be one of %s, %s or %s", applyRequest, applyResponse, applyBoth)
+		}
+	}
+	if v, ok := params["onProviderError"]; ok {
+		onProviderError, _ := v.(string)
+		switch onProviderError {
+		case providerErrorBlock, providerErrorAllow:
+			cfg.onProviderError = onProviderError
+		default:
+			return cfg, fmt.Errorf("onProviderError must be %s or %s", providerErrorBlock, providerErrorAllow)
+		}
+	}
+	if v, ok := params["timeout"]; ok {
+		s, _ := v.(string)
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("timeout must be a positive duration such as \"5s\"")
+		}
+		cfg.timeout = d
+	}
+	cfg.resilience = resilience.Config{
+		Timeout:          cfg.timeout,
+		MaxRetries:       defaultMaxRetries,
+		FailureThreshold: defaultFailureThreshold,
+		OpenDuration:     defaultOpenDuration,
+	}
+	if v, ok := params["maxRetries"]; ok {
+		n, ok := v.(float64)
+		if !ok || n != float64(int(n)) || n < 0 || n > maxRetriesLimit {
+			return cfg, fmt.Errorf("maxRetries must be an integer between 0 and %d", maxRetriesLimit)
+		}
+		// resilience.Config treats zero as unset
+		cfg.resilience.MaxRetries = int(n)
+		if n == 0 {
+			cfg.resilience.MaxRetries = -1
+		}
+	}
+	if v, ok := params["failureThreshold"]; ok {
+		n, ok := v.(float64)
+		if !ok || n != float64(int(n)) || n < 1 {
+			return cfg, fmt.Errorf("failureThreshold must be a positive integer")
+		}
+		cfg.resilience.FailureThreshold = int(n)
+	}
+	if v, ok := params["openDuration"]; ok {
+		s, _ := v.(string)
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("openDuration must be a positive duration such as \"30s\"")
+		}
+		cfg.resilience.OpenDuration = d
+	}
+
+	name, _ := params["provider"].(string)
+	factory, ok := providers[name]
+	if !ok {
+		return cfg, fmt.Errorf("provider must be one of %s", providerNames())
+	}
+	providerCfg := map[string]interface{}{}
+	if v, ok := params["providerConfig"]; ok {
+		if providerCfg, ok = v.(map[string]interface{}); !ok {
+			return cfg, fmt.Errorf("providerConfig must be an object")
+		}
+	}
+	provider, err := factory(providerCfg, &http.Client{Timeout: cfg.timeout})
+	if err != nil {
+		return cfg, fmt.Errorf("invalid %s providerConfig: %w", name, err)
+	}
+	cfg.providerName = name
+	cfg.provider = provider
+	return cfg, nil
+}
@@ -0,0 +1,280 @@
+package contentsafety
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// stubProvider returns a fixed assessment and records the analyzed text.
+type stubProvider struct {
+	assessment Assessment
+	err        error
+	texts      []string
+	directions []Direction
+}
+
+func (s *stubProvider) Analyze(ctx context.Context, text string) (Assessment, error) {
+	s.texts = append(s.texts, text)
+	s.directions = append(s.directions, DirectionFromContext(ctx))
+	return s.assessment, s.err
+}
+
+func newTestPolicy(t *testing.T, params map[string]interface{}, stub *stubProvider) *ContentSafetyPolicy {
+	t.Helper()
+	if _, ok := params["provider"]; !ok {
+		params["provider"] = "keyword"
+		params["providerConfig"] = map[string]interface{}{"keywords": []interface{}{"unused"}}
+	}
+	stats.Delete("route")
+	callers.Delete("route")
+	t.Cleanup(func() {
+		stats.Delete("route")
+		callers.Delete("route")
+	})
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	csp := p.(*ContentSafetyPolicy)
+	if stub != nil {
+		csp.cfg.provider = stub
+	}
+	return csp
+}
+
+func sendRequest(p *ContentSafetyPolicy, body string) policy.RequestAction {
+	return p.OnRequestBody(context.Background(), &policy.RequestContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Body:          &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+		Path:          "/chat",
+		Method:        "POST",
+	}, nil)
+}
+
+func TestBlocksFlaggedRequest(t *testing.T) {
+	stub := &stubProvider{assessment: Assessment{Flagged: true, Categories: []string{"Violence"}}}
+	p := newTestPolicy(t, map[string]interface{}{"jsonPath": "$.messages[-1].content"}, stub)
+
+	action := sendRequest(p, `{"messages":[{"content":"first"},{"content":"last"}]}`)
+	resp, ok := action.(policy.ImmediateResponse)
+	if !ok || resp.StatusCode != 422 {
+		t.Fatalf("action = %#v, want 422", action)
+	}
+	var body struct {
+		Message    string   `json:"message"`
+		Categories []string `json:"categories"`
+	}
+	if err := json.Unmarshal(resp.Body, &body); err != nil || len(body.Categories) != 1 || body.Categories[0] != "Violence" {
+		t.Errorf("body = %s", resp.Body)
+	}
+	if len(stub.texts) != 1 || stub.texts[0] != "last" || stub.directions[0] != DirectionRequest {
+		t.Errorf("analyzed %q %v, want the last message as a request", stub.texts, stub.directions)
+	}
+}
+
+func TestPassesUnflaggedRequest(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{}, &stubProvider{})
+	if action := sendRequest(p, "hello"); action != nil {
+		t.Errorf("action = %#v, want nil", action)
+	}
+}
+
+// The mock servers answer "simulate error" with 500.
+func TestProviderError(t *testing.T) {
+	srv := newBedrockMock(t, nil)
+	for _, tt := range []struct {
+		onProviderError string
+		wantStatus      int
+		wantAllowed     int64
+	}{
+		{onProviderError: "", wantStatus: 503},
+		{onProviderError: "block", wantStatus: 503},
+		{onProviderError: "allow", wantStatus: 0, wantAllowed: 1},
+	} {
+		t.Run("onProviderError="+tt.onProviderError, func(t *testing.T) {
+			params := map[string]interface{}{
+				"provider":       "aws-bedrock-guardrail",
+				"providerConfig": bedrockConfig(srv.URL),
+			}
+			if tt.onProviderError != "" {
+				params["onProviderError"] = tt.onProviderError
+			}
+			p := newTestPolicy(t, params, nil)
+
+			action := sendRequest(p, "please simulate an error")
+			if tt.wantStatus == 0 {
+				if action != nil {
+					t.Fatalf("action = %#v, want the request allowed", action)
+				}
+			} else {
+				resp, ok := action.(policy.ImmediateResponse)
+				if !ok || resp.StatusCode != tt.wantStatus {
+					t.Fatalf("action = %#v, want %d", action, tt.wantStatus)
+				}
+				if string(resp.Body) != `{"error":"Service Unavailable","message":"Content safety check failed"}` {
+					t.Errorf("body = %s", resp.Body)
+				}
+			}
+
+			// Provider errors are counted apart from content blocks
+			sendRequest(p, "violence")
+			state := p.PolicyState()
+			if state["providerErrors"] != int64(1) || state["blocked"] != int64(1) || state["allowedAfterProviderError"] != tt.wantAllowed {
+				t.Errorf("state = %v", state)
+			}
+		})
+	}
+}
+
+func TestMissingJSONPath(t *testing.T) {
+	stub := &stubProvider{}
+	p := newTestPolicy(t, map[string]interface{}{"jsonPath": "$.prompt"}, stub)
+	if resp, ok := sendRequest(p, `{"other":"x"}`).(policy.ImmediateResponse); !ok || resp.StatusCode != 422 {
+		t.Errorf("action = %#v, want 422", resp)
+	}
+	if len(stub.texts) != 0 {
+		t.Error("provider called without text")
+	}
+}
+
+func TestResponseChecks(t *testing.T) {
+	stub := &stubProvider{assessment: Assessment{Flagged: true, Categories: []string{"Hate"}}}
+	p := newTestPolicy(t, map[string]interface{}{"applyTo": "response"}, stub)
+	if p.Mode().RequestBodyMode != policy.BodyModeSkip || p.Mode().ResponseBodyMode != policy.BodyModeBuffer {
+		t.Fatalf("mode = %+v", p.Mode())
+	}
+
+	respond := func(status int) policy.ResponseAction {
+		return p.OnResponseBody(context.Background(), &policy.ResponseContext{
+			SharedContext:  &policy.SharedContext{Metadata: map[string]interface{}{}},
+			ResponseBody:   &policy.Body{Content: []byte("text"), EndOfStream: true, Present: true},
+			ResponseStatus: status,
+		}, nil)
+	}
+	mods, ok := respond(200).(policy.DownstreamResponseModifications)
+	if !ok || mods.StatusCode == nil || *mods.StatusCode != 422 {
+		t.Fatalf("action = %#v, want 422", mods)
+	}
+	if stub.directions[0] != DirectionResponse {
+		t.Errorf("direction = %s, want response", stub.directions[0])
+	}
+	if action := respond(500); action != nil {
+		t.Errorf("error response checked: %#v", action)
+	}
+
+	stub.assessment, stub.err = Assessment{}, errors.New("timeout")
+	if mods, ok := respond(200).(policy.DownstreamResponseModifications); !ok || *mods.StatusCode != 503 {
+		t.Errorf("provider failure on response = %#v, want 503", mods)
+	}
+}
+
+// The policy selects the provider by name; this drives the keyword adapter
+// end to end.
+func TestKeywordProviderSelected(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"provider":       "keyword",
+		"providerConfig": map[string]interface{}{"keywords": []interface{}{"secret"}},
+	}, nil)
+	if resp, ok := sendRequest(p, "tell me the SECRET").(policy.ImmediateResponse); !ok || resp.StatusCode != 422 {
+		t.Errorf("action = %#v, want 422", resp)
+	}
+}
+
+func TestParseConfigRejectsInvalid(t *testing.T) {
+	keyword := map[string]interface{}{"keywords": []interface{}{"x"}}
+	for name, params := range map[string]map[string]interface{}{
+		"no provider":            {},
+		"unknown provider":       {"provider": "openai-moderation"},
+		"invalid providerConfig": {"provider": "azure-content-safety", "providerConfig": map[string]interface{}{}},
+		"bad applyTo":            {"provider": "keyword", "providerConfig": keyword, "applyTo": "all"},
+		"bad timeout":            {"provider": "keyword", "providerConfig": keyword, "timeout": "0s"},
+		"bad onProviderError":    {"provider": "keyword", "providerConfig": keyword, "onProviderError": "ignore"},
+		"fractional maxRetries":  {"provider": "keyword", "providerConfig": keyword, "maxRetries": 1.5},
+		"too many maxRetries":    {"provider": "keyword", "providerConfig": keyword, "maxRetries": float64(6)},
+		"bad failureThreshold":   {"provider": "keyword", "providerConfig": keyword, "failureThreshold": float64(0)},
+		"bad openDuration":       {"provider": "keyword", "providerConfig": keyword, "openDuration": "soon"},
+	} {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// A provider slower than the timeout is retried, then counted as a provider
+// error; once the circuit opens the provider is no longer called.
+func TestSlowProviderOpensCircuit(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	p := newTestPolicy(t, map[string]interface{}{
+		"provider":         "azure-content-safety",
+		"providerConfig":   map[string]interface{}{"endpoint": srv.URL, "apiKey": "k"},
+		"timeout":          "20ms",
+		"maxRetries":       float64(1),
+		"failureThreshold": float64(2),
+		"openDuration":     "1m",
+	}, nil)
+
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		resp, ok := sendRequest(p, "hello").(policy.ImmediateResponse)
+		if !ok || resp.StatusCode != 503 {
+			t.Fatalf("call %d: action = %#v, want 503", i, resp)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Fatalf("call %d took %v; the timeout was not applied", i, elapsed)
+		}
+	}
+	if calls.Load() != 4 {
+		t.Errorf("calls = %d, want 4 (two calls with one retry each, then the circuit opened)", calls.Load())
+	}
+	state := p.PolicyState()
+	if state["providerErrors"] != int64(3) || state["circuitOpen"] != true || state["circuitState"] != "open" {
+		t.Errorf("state = %v", state)
+	}
+
+	// The circuit is kept across chain rebuilds with the same settings
+	rebuilt, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, map[string]interface{}{
+		"provider":         "azure-content-safety",
+		"providerConfig":   map[string]interface{}{"endpoint": srv.URL, "apiKey": "k"},
+		"timeout":          "20ms",
+		"maxRetries":       float64(1),
+		"failureThreshold": float64(2),
+		"openDuration":     "1m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuilt.(*ContentSafetyPolicy).caller != p.caller {
+		t.Error("rebuilt policy got a new circuit")
+	}
+}
+
+func TestClientErrorsDoNotOpenCircuit(t *testing.T) {
+	srv := newAzureMock(t)
+	p := newTestPolicy(t, map[string]interface{}{
+		"provider":         "azure-content-safety",
+		"providerConfig":   map[string]interface{}{"endpoint": srv.URL, "apiKey": "wrong"},
+		"failureThreshold": float64(1),
+	}, nil)
+	for i := 0; i < 3; i++ {
+		sendRequest(p, "hello")
+	}
+	if state := p.PolicyState(); state["providerErrors"] != int64(3) || state["circuitState"] != "closed" {
+		t.Errorf("state = %v, want three errors and a closed circuit", state)
+	}
+}
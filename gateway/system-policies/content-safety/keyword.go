@@ -0,0 +1,44 @@
+package contentsafety
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// keywordCategory is the category reported for keyword matches. The matched
+// keyword itself is not reported.
+const keywordCategory = "BlockedKeyword"
+
+// keywordProvider flags text containing any of a list of keywords, matched
+// case-insensitively. It needs no external service.
+type keywordProvider struct {
+	keywords []string // lower-cased
+}
+
+// newKeywordProvider reads the keywords list.
+func newKeywordProvider(cfg map[string]interface{}, _ *http.Client) (ContentSafetyProvider, error) {
+	keywords, err := stringListParam(cfg, "keywords")
+	if err != nil {
+		return nil, err
+	}
+	if len(keywords) == 0 {
+		return nil, fmt.Errorf("keywords must list at least one keyword")
+	}
+	for i, k := range keywords {
+		keywords[i] = strings.ToLower(k)
+	}
+	return &keywordProvider{keywords: keywords}, nil
+}
+
+// Analyze reports whether text contains a keyword.
+func (p *keywordProvider) Analyze(_ context.Context, text string) (Assessment, error) {
+	lower := strings.ToLower(text)
+	for _, k := range p.keywords {
+		if strings.Contains(lower, k) {
+			return Assessment{Flagged: true, Categories: []string{keywordCategory}}, nil
+		}
+	}
+	return Assessment{}, nil
+}
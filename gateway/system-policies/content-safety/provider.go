@@ -0,0 +1,139 @@
+package contentsafety
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
+
+// maxProviderResponseSize caps how much of a provider response is read.
+const maxProviderResponseSize = 1 << 20
+
+// ContentSafetyProvider analyzes text for harmful content. Each moderation
+// service is wrapped in an adapter implementing this interface; a new service
+// is supported by implementing it and registering a constructor in providers.
+type ContentSafetyProvider interface {
+	Analyze(ctx context.Context, text string) (Assessment, error)
+}
+
+// Assessment is a provider's verdict on a piece of text.
+type Assessment struct {
+	// Flagged reports whether the text must be blocked.
+	Flagged bool
+	// Categories names what the text was flagged for, e.g. "Violence". It is
+	// returned to the client, so it never contains the matched text.
+	Categories []string
+}
+
+// Direction tells a provider whether the analyzed text is a request or a
+// response. Providers that distinguish the two, such as Bedrock guardrails,
+// read it with DirectionFromContext.
+type Direction string
+
+const (
+	DirectionRequest  Direction = "request"
+	DirectionResponse Direction = "response"
+)
+
+type directionKey struct{}
+
+// WithDirection returns a context carrying the direction of the text passed to
+// Analyze.
+func WithDirection(ctx context.Context, d Direction) context.Context {
+	return context.WithValue(ctx, directionKey{}, d)
+}
+
+// DirectionFromContext returns the direction set by WithDirection, defaulting
+// to DirectionRequest.
+func DirectionFromContext(ctx context.Context) Direction {
+	if d, ok := ctx.Value(directionKey{}).(Direction); ok {
+		return d
+	}
+	return DirectionRequest
+}
+
+// providerFactory builds a provider from the policy's providerConfig.
+type providerFactory func(cfg map[string]interface{}, client *http.Client) (ContentSafetyProvider, error)
+
+// providers maps the provider parameter to its adapter.
+var providers = map[string]providerFactory{
+	"aws-bedrock-guardrail": newBedrockProvider,
+	"azure-content-safety":  newAzureProvider,
+	"keyword":               newKeywordProvider,
+}
+
+func providerNames() string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// doJSON sends req and returns the response body, failing on non-2xx status
+// codes. Errors carry the status code only; provider responses may echo
+// credentials or the analyzed text. Client errors other than 408 and 429 are
+// marked permanent so they are neither retried nor counted as an outage.
+func doJSON(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling provider: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxProviderResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading provider response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("provider returned status %d", resp.StatusCode)
+		// Other client errors, such as a rejected API key, fail the same way
+		// on every attempt
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return nil, resilience.Permanent(err)
+		}
+		return nil, err
+	}
+	return body, nil
+}
+
+func stringParam(cfg map[string]interface{}, name string, required bool) (string, error) {
+	v, ok := cfg[name]
+	if !ok {
+		if required {
+			return "", fmt.Errorf("%s is required", name)
+		}
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok || (required && strings.TrimSpace(s) == "") {
+		return "", fmt.Errorf("%s must be a non-empty string", name)
+	}
+	return strings.TrimSpace(s), nil
+}
+
+func stringListParam(cfg map[string]interface{}, name string) ([]string, error) {
+	v, ok := cfg[name]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("%s must be a list of strings", name)
+		}
+		values = append(values, strings.TrimSpace(s))
+	}
+	return values, nil
+}
@@ -0,0 +1,256 @@
+package contentsafety
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newBedrockMock serves ApplyGuardrail the way tests/mock-servers/
+// mock-aws-bedrock-guardrail does: violence, hate and illegal are blocked,
+// @example.com addresses are anonymized and "simulate error" fails.
+func newBedrockMock(t *testing.T, requests chan<- *http.Request) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req bedrockRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Content) == 0 {
+			http.Error(w, "Invalid JSON request", http.StatusBadRequest)
+			return
+		}
+		if requests != nil {
+			r.Header.Set("x-test-source", req.Source)
+			requests <- r
+		}
+		text := strings.ToLower(req.Content[0].Text.Text)
+		switch {
+		case strings.Contains(text, "simulate") && strings.Contains(text, "error"):
+			http.Error(w, "Simulated AWS Bedrock Guardrail error", http.StatusInternalServerError)
+		case strings.Contains(text, "violence") || strings.Contains(text, "hate") || strings.Contains(text, "illegal"):
+			_, _ = w.Write([]byte(`{"action":"GUARDRAIL_INTERVENED","outputs":[{"text":"Content blocked due to policy violation"}],
+				"assessments":[{"contentPolicy":{"filters":[{"type":"VIOLENCE","confidence":"HIGH","action":"BLOCKED"}]}}]}`))
+		case strings.Contains(text, "@example.com"):
+			_, _ = w.Write([]byte(`{"action":"GUARDRAIL_INTERVENED","outputs":[{"text":"*****"}],
+				"assessments":[{"sensitiveInformationPolicy":{"piiEntities":[{"type":"EMAIL","match":"a@example.com","action":"ANONYMIZED"}]}}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"action":"NONE","outputs":[],"assessments":[]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newAzureMock serves text:analyze the way tests/mock-servers/
+// mock-azure-content-safety does: keywords of a requested category give it
+// severity 6 and a wrong subscription key is rejected.
+func newAzureMock(t *testing.T) *httptest.Server {
+	t.Helper()
+	keywords := map[string][]string{
+		"Hate":     {"hate", "racist"},
+		"Violence": {"violence", "kill"},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/contentsafety/text:analyze" || r.URL.Query().Get("api-version") == "" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("Ocp-Apim-Subscription-Key") != "test-subscription-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"Invalid or missing API key"}`))
+			return
+		}
+		var req azureRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid JSON request", http.StatusBadRequest)
+			return
+		}
+		var resp azureResponse
+		for _, category := range req.Categories {
+			severity := 0
+			for _, k := range keywords[category] {
+				if strings.Contains(strings.ToLower(req.Text), k) {
+					severity = 6
+				}
+			}
+			resp.CategoriesAnalysis = append(resp.CategoriesAnalysis, struct {
+				Category string `json:"category"`
+				Severity int    `json:"severity"`
+			}{category, severity})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newProvider(t *testing.T, name string, cfg map[string]interface{}) ContentSafetyProvider {
+	t.Helper()
+	p, err := providers[name](cfg, http.DefaultClient)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return p
+}
+
+func bedrockConfig(endpoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"endpoint":         endpoint,
+		"region":           "us-east-1",
+		"guardrailId":      "gr-123",
+		"guardrailVersion": "1",
+		"accessKeyId":      "AKIDEXAMPLE",
+		"secretAccessKey":  "secret",
+	}
+}
+
+func TestBedrockProvider(t *testing.T) {
+	requests := make(chan *http.Request, 10)
+	srv := newBedrockMock(t, requests)
+	p := newProvider(t, "aws-bedrock-guardrail", bedrockConfig(srv.URL))
+
+	tests := []struct {
+		text string
+		want Assessment
+	}{
+		{text: "What is the weather?", want: Assessment{}},
+		{text: "Instructions for violence", want: Assessment{Flagged: true, Categories: []string{"VIOLENCE"}}},
+		// Anonymized PII is masked by the guardrail but does not block
+		{text: "Mail a@example.com", want: Assessment{}},
+	}
+	for _, tt := range tests {
+		got, err := p.Analyze(context.Background(), tt.text)
+		if err != nil {
+			t.Fatalf("Analyze(%q) error = %v", tt.text, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Analyze(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+
+	if _, err := p.Analyze(context.Background(), "simulate error"); err == nil || strings.Contains(err.Error(), "Simulated") {
+		t.Errorf("provider error = %v, want a status-only error", err)
+	}
+
+	r := <-requests
+	if r.URL.Path != "/guardrail/gr-123/version/1/apply" || r.Method != http.MethodPost {
+		t.Errorf("request = %s %s", r.Method, r.URL.Path)
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+		!strings.Contains(auth, "/us-east-1/bedrock/aws4_request, SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature=") {
+		t.Errorf("Authorization = %q", auth)
+	}
+	if r.Header.Get("x-test-source") != "INPUT" {
+		t.Errorf("source = %q, want INPUT", r.Header.Get("x-test-source"))
+	}
+
+	// Responses are sent as guardrail output
+	for len(requests) > 0 {
+		<-requests
+	}
+	if _, err := p.Analyze(WithDirection(context.Background(), DirectionResponse), "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-requests; r.Header.Get("x-test-source") != "OUTPUT" {
+		t.Errorf("source = %q, want OUTPUT", r.Header.Get("x-test-source"))
+	}
+}
+
+func TestBedrockSignature(t *testing.T) {
+	p := &bedrockProvider{region: "us-east-1", accessKeyID: "AKIDEXAMPLE", secretKey: "secret"}
+	at := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	sign := func(payload string) *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "https://bedrock-runtime.us-east-1.amazonaws.com/guardrail/g/version/1/apply", nil)
+		req.Header.Set("content-type", "application/json")
+		p.sign(req, []byte(payload), at)
+		return req
+	}
+
+	req := sign(`{}`)
+	if req.Header.Get("x-amz-date") != "20150830T123600Z" {
+		t.Errorf("x-amz-date = %q", req.Header.Get("x-amz-date"))
+	}
+	if !strings.Contains(req.Header.Get("authorization"), "Credential=AKIDEXAMPLE/20150830/us-east-1/bedrock/aws4_request") {
+		t.Errorf("Authorization = %q", req.Header.Get("authorization"))
+	}
+	if sign(`{}`).Header.Get("authorization") != req.Header.Get("authorization") {
+		t.Error("signature is not deterministic")
+	}
+	if sign(`{"a":1}`).Header.Get("authorization") == req.Header.Get("authorization") {
+		t.Error("signature does not cover the payload")
+	}
+
+	p.sessionToken = "token"
+	withToken := sign(`{}`)
+	if withToken.Header.Get("x-amz-security-token") != "token" ||
+		!strings.Contains(withToken.Header.Get("authorization"), "SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date;x-amz-security-token,") {
+		t.Errorf("session token not signed: %q", withToken.Header.Get("authorization"))
+	}
+}
+
+func TestAzureProvider(t *testing.T) {
+	srv := newAzureMock(t)
+	p := newProvider(t, "azure-content-safety", map[string]interface{}{
+		"endpoint": srv.URL + "/",
+		"apiKey":   "test-subscription-key",
+	})
+
+	got, err := p.Analyze(context.Background(), "I will kill the process")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Flagged || !reflect.DeepEqual(got.Categories, []string{"Violence"}) {
+		t.Errorf("assessment = %+v, want Violence", got)
+	}
+	if got, _ := p.Analyze(context.Background(), "hello"); got.Flagged {
+		t.Errorf("benign text flagged: %+v", got)
+	}
+
+	// A severity threshold above the reported severity passes the text
+	lenient := newProvider(t, "azure-content-safety", map[string]interface{}{
+		"endpoint":          srv.URL,
+		"apiKey":            "test-subscription-key",
+		"categories":        []interface{}{"Hate"},
+		"severityThreshold": float64(7),
+	})
+	if got, _ := lenient.Analyze(context.Background(), "racist"); got.Flagged {
+		t.Errorf("text below the threshold flagged: %+v", got)
+	}
+
+	wrongKey := newProvider(t, "azure-content-safety", map[string]interface{}{"endpoint": srv.URL, "apiKey": "other"})
+	if _, err := wrongKey.Analyze(context.Background(), "hello"); err == nil || strings.Contains(err.Error(), "other") {
+		t.Errorf("error = %v, want a status-only error", err)
+	}
+}
+
+func TestKeywordProvider(t *testing.T) {
+	p := newProvider(t, "keyword", map[string]interface{}{"keywords": []interface{}{"Password", "drop table"}})
+	if got, _ := p.Analyze(context.Background(), "my PASSWORD is hunter2"); !got.Flagged || got.Categories[0] != keywordCategory {
+		t.Errorf("assessment = %+v, want flagged", got)
+	}
+	if got, _ := p.Analyze(context.Background(), "hello"); got.Flagged {
+		t.Errorf("benign text flagged: %+v", got)
+	}
+}
+
+func TestProviderConfigRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		provider string
+		cfg      map[string]interface{}
+	}{
+		{provider: "aws-bedrock-guardrail", cfg: map[string]interface{}{"guardrailId": "g", "accessKeyId": "a", "secretAccessKey": "s"}},
+		{provider: "aws-bedrock-guardrail", cfg: map[string]interface{}{"region": "us-east-1", "guardrailId": "g", "accessKeyId": "a", "secretAccessKey": "s", "endpoint": "file:///etc/passwd"}},
+		{provider: "azure-content-safety", cfg: map[string]interface{}{"endpoint": "https://cs.example.com"}},
+		{provider: "azure-content-safety", cfg: map[string]interface{}{"endpoint": "https://cs.example.com", "apiKey": "k", "severityThreshold": float64(8)}},
+		{provider: "keyword", cfg: map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		if _, err := providers[tt.provider](tt.cfg, http.DefaultClient); err == nil {
+			t.Errorf("%s %v: expected an error", tt.provider, tt.cfg)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+package headertransform
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	// templateOpen starts an expression in a header value template; the
+	// expression runs to the matching '}'. "$${" produces a literal "${".
+	templateOpen   = "${"
+	templateEscape = "$${"
+
+	// targetUpstreamHeader is set by the policy engine to route the request and
+	// must not be touched by policies.
+	targetUpstreamHeader = "x-target-upstream"
+)
+
+// reservedHeaders are headers Envoy manages itself or that frame the message.
+// Changing them from a policy either has no effect or corrupts the request.
+var reservedHeaders = map[string]bool{
+	"host":               true,
+	"connection":         true,
+	"keep-alive":         true,
+	"proxy-connection":   true,
+	"transfer-encoding":  true,
+	"upgrade":            true,
+	"te":                 true,
+	"trailer":            true,
+	"content-length":     true,
+	targetUpstreamHeader: true,
+}
+
+// templatePart is either a literal string or a CEL expression.
+type templatePart struct {
+	literal    string
+	expression string
+}
+
+// template is a parsed header value.
+type template []templatePart
+
+// headerValue is a header name with its value template.
+type headerValue struct {
+	name  string
+	value template
+}
+
+// phaseConfig holds the transformations for the request or response headers.
+type phaseConfig struct {
+	remove []string
+	set    []headerValue
+	add    []headerValue
+}
+
+func (c phaseConfig) empty() bool {
+	return len(c.remove) == 0 && len(c.set) == 0 && len(c.add) == 0
+}
+
+// HeaderTransformPolicy adds, sets and removes request and response headers.
+// Values may embed CEL expressions, e.g. "${jwtSub()}".
+type HeaderTransformPolicy struct {
+	request   phaseConfig
+	response  phaseConfig
+	evaluator policy.ExpressionEvaluator
+}
+
+// GetPolicy creates a header transform policy from its parameters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	p := &HeaderTransformPolicy{}
+	var err error
+	if p.request, err = parsePhase(params, "request"); err != nil {
+		return nil, err
+	}
+	if p.response, err = parsePhase(params, "response"); err != nil {
+		return nil, err
+	}
+
+	var expressions []string
+	for _, c := range []phaseConfig{p.request, p.response} {
+		for _, hv := range append(append([]headerValue{}, c.set...), c.add...) {
+			for _, part := range hv.value {
+				if part.expression != "" {
+					expressions = append(expressions, part.expression)
+				}
+			}
+		}
+	}
+	if len(expressions) > 0 {
+		p.evaluator = policy.GetExpressionEvaluator()
+		if p.evaluator == nil {
+			return nil, fmt.Errorf("header value templates require an expression evaluator, but none is registered")
+		}
+		for _, expr := range expressions {
+			if err := p.evaluator.ValidateExpression(expr); err != nil {
+				return nil, fmt.Errorf("invalid expression %q: %w", expr, err)
+			}
+		}
+	}
+	return p, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. Header phases without any
+// transformation are skipped.
+func (p *HeaderTransformPolicy) Mode() policy.ProcessingMode {
+	mode := policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+	if !p.request.empty() {
+		mode.RequestHeaderMode = policy.HeaderModeProcess
+	}
+	if !p.response.empty() {
+		mode.ResponseHeaderMode = policy.HeaderModeProcess
+	}
+	return mode
+}
+
+// OnRequestHeaders applies the request header transformations.
+func (p *HeaderTransformPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	if p.request.empty() {
+		return nil
+	}
+	set, appendHeaders, remove := p.apply(p.request, func(expr string) (interface{}, error) {
+		return p.evaluator.EvaluateRequestHeaderExpression(expr, reqCtx)
+	})
+	return policy.UpstreamRequestHeaderModifications{
+		HeadersToSet:    set,
+		HeadersToAppend: appendHeaders,
+		HeadersToRemove: remove,
+	}
+}
+
+// OnResponseHeaders applies the response header transformations.
+func (p *HeaderTransformPolicy) OnResponseHeaders(_ context.Context, respCtx *policy.ResponseHeaderContext, _ map[string]interface{}) policy.ResponseHeaderAction {
+	if p.response.empty() {
+		return nil
+	}
+	set, appendHeaders, remove := p.apply(p.response, func(expr string) (interface{}, error) {
+		return p.evaluator.EvaluateResponseHeaderExpression(expr, respCtx)
+	})
+	return policy.DownstreamResponseHeaderModifications{
+		HeadersToSet:    set,
+		HeadersToAppend: appendHeaders,
+		HeadersToRemove: remove,
+	}
+}
+
+// apply renders the configured headers and orders the result so removals take
+// effect before additions. The policy engine applies a single action's set,
+// append and remove operations in that order, so a header that is both removed
+// and added is expressed as a set of its first value plus appends of the rest,
+// and dropped from the removals.
+//
+// A set whose value cannot be rendered, or renders empty, removes the header
+// instead: forwarding a client-supplied value in place of the computed one
+// (e.g. X-User) would let the caller choose it.
+func (p *HeaderTransformPolicy) apply(c phaseConfig, eval func(string) (interface{}, error)) (map[string]string, map[string][]string, []string) {
+	removed := make(map[string]bool, len(c.remove))
+	for _, name := range c.remove {
+		removed[name] = true
+	}
+
+	set := map[string]string{}
+	for _, hv := range c.set {
+		value, ok := render(hv, eval)
+		if !ok || value == "" {
+			delete(set, hv.name)
+			removed[hv.name] = true
+			continue
+		}
+		set[hv.name] = value
+		delete(removed, hv.name)
+	}
+
+	appendHeaders := map[string][]string{}
+	for _, hv := range c.add {
+		value, ok := render(hv, eval)
+		if !ok || value == "" {
+			continue
+		}
+		if _, isSet := set[hv.name]; !isSet && removed[hv.name] {
+			set[hv.name] = value
+			delete(removed, hv.name)
+			continue
+		}
+		appendHeaders[hv.name] = append(appendHeaders[hv.name], value)
+	}
+
+	var remove []string
+	for _, name := range c.remove {
+		if removed[name] {
+			remove = append(remove, name)
+			delete(removed, name)
+		}
+	}
+	for _, hv := range c.set {
+		if removed[hv.name] {
+			remove = append(remove, hv.name)
+			delete(removed, hv.name)
+		}
+	}
+
+	if len(set) == 0 {
+		set = nil
+	}
+	if len(appendHeaders) == 0 {
+		appendHeaders = nil
+	}
+	return set, appendHeaders, remove
+}
+
+// render evaluates a header value template. It reports false when an
+// expression fails to evaluate or the value is not a valid header value.
+func render(hv headerValue, eval func(string) (interface{}, error)) (string, bool) {
+	var b strings.Builder
+	for _, part := range hv.value {
+		if part.expression == "" {
+			b.WriteString(part.literal)
+			continue
+		}
+		value, err := eval(part.expression)
+		if err != nil {
+			slog.Debug("HeaderTransform: failed to evaluate header value expression",
+				"header", hv.name,
+				"error", err,
+			)
+			return "", false
+		}
+		b.WriteString(stringify(value))
+	}
+	// Claims and other request data are caller-controlled; never let them
+	// smuggle extra header lines.
+	if strings.ContainsAny(b.String(), "\r\n\x00") {
+		slog.Debug("HeaderTransform: rendered header value contains control characters", "header", hv.name)
+		return "", false
+	}
+	return b.String(), true
+}
+
+// stringify converts an expression result to a header value. Lists are joined
+// with commas, as for repeated header values; null renders as an empty string.
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := stringify(item); s != "" {
+				items = append(items, s)
+			}
+		}
+		return strings.Join(items, ",")
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseTemplate splits a header value into literals and "${...}" expressions.
+// Braces and quoted strings inside an expression are balanced so that map
+// literals and strings containing '}' work.
+func parseTemplate(value string) (template, error) {
+	var t template
+	var literal strings.Builder
+	for i := 0; i < len(value); {
+		if strings.HasPrefix(value[i:], templateEscape) {
+			literal.WriteString(templateOpen)
+			i += len(templateEscape)
+			continue
+		}
+		if !strings.HasPrefix(value[i:], templateOpen) {
+			literal.WriteByte(value[i])
+			i++
+			continue
+		}
+		end, err := expressionEnd(value, i+len(templateOpen))
+		if err != nil {
+			return nil, err
+		}
+		expr := strings.TrimSpace(value[i+len(templateOpen) : end])
+		if expr == "" {
+			return nil, fmt.Errorf("empty expression in %q", value)
+		}
+		if literal.Len() > 0 {
+			t = append(t, templatePart{literal: literal.String()})
+			literal.Reset()
+		}
+		t = append(t, templatePart{expression: expr})
+		i = end + 1
+	}
+	if literal.Len() > 0 {
+		t = append(t, templatePart{literal: literal.String()})
+	}
+	return t, nil
+}
+
+// expressionEnd returns the index of the '}' closing the expression that
+// starts at start.
+func expressionEnd(value string, start int) (int, error) {
+	depth := 0
+	var quote byte
+	for i := start; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				return i, nil
+			}
+			depth--
+		}
+	}
+	return 0, fmt.Errorf("unterminated expression in %q", value)
+}
+
+// parsePhase reads the "request" or "response" transformations.
+func parsePhase(params map[string]interface{}, phase string) (phaseConfig, error) {
+	var c phaseConfig
+	raw, ok := params[phase]
+	if !ok || raw == nil {
+		return c, nil
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return c, fmt.Errorf("%s must be an object", phase)
+	}
+
+	if v, ok := m["remove"]; ok && v != nil {
+		list, ok := v.([]interface{})
+		if !ok {
+			return c, fmt.Errorf("%s.remove must be a list of header names", phase)
+		}
+		for _, item := range list {
+			s, _ := item.(string)
+			name, err := headerName(s)
+			if err != nil {
+				return c, fmt.Errorf("%s.remove: %w", phase, err)
+			}
+			c.remove = append(c.remove, name)
+		}
+	}
+
+	var err error
+	if c.set, err = parseHeaderValues(m, phase, "set"); err != nil {
+		return c, err
+	}
+	if c.add, err = parseHeaderValues(m, phase, "add"); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+func parseHeaderValues(m map[string]interface{}, phase, op string) ([]headerValue, error) {
+	v, ok := m[op]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s.%s must be a list of {name, value} objects", phase, op)
+	}
+	values := make([]headerValue, 0, len(list))
+	for i, item := range list {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.%s[%d] must be an object", phase, op, i)
+		}
+		rawName, _ := entry["name"].(string)
+		name, err := headerName(rawName)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s[%d]: %w", phase, op, i, err)
+		}
+		rawValue, ok := entry["value"].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s[%d].value must be a string", phase, op, i)
+		}
+		value, err := parseTemplate(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s[%d].value: %w", phase, op, i, err)
+		}
+		values = append(values, headerValue{name: name, value: value})
+	}
+	return values, nil
+}
+
+// headerName validates and lower-cases a header name. Pseudo-headers, Envoy's
+// internal x-envoy-* headers and headers Envoy manages itself are rejected.
+func headerName(raw string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(raw))
+	switch {
+	case name == "":
+		return "", fmt.Errorf("header name must not be empty")
+	case strings.HasPrefix(name, ":"):
+		return "", fmt.Errorf("pseudo-header %q cannot be modified", name)
+	case strings.HasPrefix(name, "x-envoy-"):
+		return "", fmt.Errorf("header %q is reserved for Envoy", name)
+	case reservedHeaders[name]:
+		return "", fmt.Errorf("header %q cannot be modified", name)
+	}
+	for i := 0; i < len(name); i++ {
+		if !isTokenChar(name[i]) {
+			return "", fmt.Errorf("invalid header name %q", raw)
+		}
+	}
+	return name, nil
+}
+
+// isTokenChar reports whether c may appear in an HTTP header name (RFC 9110 token).
+func isTokenChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+}
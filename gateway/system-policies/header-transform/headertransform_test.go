@@ -0,0 +1,243 @@
+package headertransform
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// fakeEvaluator resolves expressions from a fixed table, standing in for the
+// policy engine's CEL evaluator.
+type fakeEvaluator struct {
+	values map[string]interface{}
+}
+
+func (f fakeEvaluator) ValidateExpression(expression string) error {
+	if _, ok := f.values[expression]; !ok {
+		return fmt.Errorf("undeclared reference %q", expression)
+	}
+	return nil
+}
+
+func (f fakeEvaluator) EvaluateRequestHeaderExpression(expression string, _ *policy.RequestHeaderContext) (interface{}, error) {
+	return f.eval(expression)
+}
+
+func (f fakeEvaluator) EvaluateResponseHeaderExpression(expression string, _ *policy.ResponseHeaderContext) (interface{}, error) {
+	return f.eval(expression)
+}
+
+func (f fakeEvaluator) eval(expression string) (interface{}, error) {
+	v := f.values[expression]
+	if err, ok := v.(error); ok {
+		return nil, err
+	}
+	return v, nil
+}
+
+func withEvaluator(t *testing.T, values map[string]interface{}) {
+	t.Helper()
+	policy.SetExpressionEvaluator(fakeEvaluator{values: values})
+	t.Cleanup(func() { policy.SetExpressionEvaluator(nil) })
+}
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *HeaderTransformPolicy {
+	t.Helper()
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*HeaderTransformPolicy)
+}
+
+func header(name, value string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "value": value}
+}
+
+func onRequest(t *testing.T, p *HeaderTransformPolicy) policy.UpstreamRequestHeaderModifications {
+	t.Helper()
+	action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Headers:       policy.NewHeaders(nil),
+	}, nil)
+	mods, ok := action.(policy.UpstreamRequestHeaderModifications)
+	if !ok {
+		t.Fatalf("action = %T, want UpstreamRequestHeaderModifications", action)
+	}
+	return mods
+}
+
+func TestTemplatedValues(t *testing.T) {
+	withEvaluator(t, map[string]interface{}{
+		"jwtSub()":           "alice",
+		"jwt('roles')":       []interface{}{"admin", "viewer"},
+		"size(jwt('roles'))": int64(2),
+		"jwt('email')":       nil,
+		"{'a': 1}['a']":      int64(1),
+	})
+	p := newTestPolicy(t, map[string]interface{}{
+		"request": map[string]interface{}{
+			"set": []interface{}{
+				header("X-User", "${jwtSub()}"),
+				header("X-Roles", "${jwt('roles')}"),
+				header("X-Summary", "user=${ jwtSub() };roles=${size(jwt('roles'))}"),
+				header("X-Literal", "cost: $${price}"),
+				header("X-Map", "${{'a': 1}['a']}"),
+			},
+		},
+	})
+
+	mods := onRequest(t, p)
+	want := map[string]string{
+		"x-user":    "alice",
+		"x-roles":   "admin,viewer",
+		"x-summary": "user=alice;roles=2",
+		"x-literal": "cost: ${price}",
+		"x-map":     "1",
+	}
+	if !reflect.DeepEqual(mods.HeadersToSet, want) {
+		t.Errorf("HeadersToSet = %v, want %v", mods.HeadersToSet, want)
+	}
+	if len(mods.HeadersToRemove) != 0 {
+		t.Errorf("HeadersToRemove = %v, want none", mods.HeadersToRemove)
+	}
+}
+
+func TestUnresolvedTemplateRemovesHeader(t *testing.T) {
+	withEvaluator(t, map[string]interface{}{
+		"jwtSub()":     "",
+		"jwt('email')": nil,
+		"jwt('tier')":  fmt.Errorf("no such key"),
+		"jwt('name')":  "alice\r\nX-Admin: true",
+	})
+	p := newTestPolicy(t, map[string]interface{}{
+		"request": map[string]interface{}{
+			"set": []interface{}{
+				header("X-User", "${jwtSub()}"),
+				header("X-Email", "${jwt('email')}"),
+				header("X-Tier", "${jwt('tier')}"),
+				header("X-Name", "${jwt('name')}"),
+			},
+			"add": []interface{}{header("X-Audit", "${jwt('tier')}")},
+		},
+	})
+
+	// A client-supplied X-User must not survive when the claim is missing.
+	mods := onRequest(t, p)
+	if len(mods.HeadersToSet) != 0 || len(mods.HeadersToAppend) != 0 {
+		t.Errorf("set = %v, append = %v, want none", mods.HeadersToSet, mods.HeadersToAppend)
+	}
+	want := []string{"x-user", "x-email", "x-tier", "x-name"}
+	if !reflect.DeepEqual(mods.HeadersToRemove, want) {
+		t.Errorf("HeadersToRemove = %v, want %v", mods.HeadersToRemove, want)
+	}
+}
+
+func TestRemovalsHappenBeforeAdds(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"request": map[string]interface{}{
+			"remove": []interface{}{"X-Forwarded-User", "X-Debug", "X-Tag"},
+			"set":    []interface{}{header("X-Debug", "off")},
+			"add": []interface{}{
+				header("X-Forwarded-User", "gateway"),
+				header("X-Forwarded-User", "proxy"),
+				header("X-Debug", "trace"),
+				header("X-Extra", "1"),
+			},
+		},
+	})
+
+	mods := onRequest(t, p)
+	wantSet := map[string]string{"x-forwarded-user": "gateway", "x-debug": "off"}
+	if !reflect.DeepEqual(mods.HeadersToSet, wantSet) {
+		t.Errorf("HeadersToSet = %v, want %v", mods.HeadersToSet, wantSet)
+	}
+	wantAppend := map[string][]string{
+		"x-forwarded-user": {"proxy"},
+		"x-debug":          {"trace"},
+		"x-extra":          {"1"},
+	}
+	if !reflect.DeepEqual(mods.HeadersToAppend, wantAppend) {
+		t.Errorf("HeadersToAppend = %v, want %v", mods.HeadersToAppend, wantAppend)
+	}
+	if !reflect.DeepEqual(mods.HeadersToRemove, []string{"x-tag"}) {
+		t.Errorf("HeadersToRemove = %v, want [x-tag]", mods.HeadersToRemove)
+	}
+}
+
+func TestResponseHeaders(t *testing.T) {
+	withEvaluator(t, map[string]interface{}{"response.ResponseStatus": int64(201)})
+	p := newTestPolicy(t, map[string]interface{}{
+		"response": map[string]interface{}{
+			"remove": []interface{}{"Server"},
+			"set":    []interface{}{header("X-Status", "${response.ResponseStatus}")},
+		},
+	})
+
+	mode := p.Mode()
+	if mode.RequestHeaderMode != policy.HeaderModeSkip || mode.ResponseHeaderMode != policy.HeaderModeProcess {
+		t.Errorf("Mode() = %+v, want only response headers processed", mode)
+	}
+
+	action := p.OnResponseHeaders(context.Background(), &policy.ResponseHeaderContext{
+		SharedContext:   &policy.SharedContext{Metadata: map[string]interface{}{}},
+		RequestHeaders:  policy.NewHeaders(nil),
+		ResponseHeaders: policy.NewHeaders(nil),
+		ResponseStatus:  201,
+	}, nil)
+	mods, ok := action.(policy.DownstreamResponseHeaderModifications)
+	if !ok {
+		t.Fatalf("action = %T, want DownstreamResponseHeaderModifications", action)
+	}
+	if !reflect.DeepEqual(mods.HeadersToSet, map[string]string{"x-status": "201"}) {
+		t.Errorf("HeadersToSet = %v", mods.HeadersToSet)
+	}
+	if !reflect.DeepEqual(mods.HeadersToRemove, []string{"server"}) {
+		t.Errorf("HeadersToRemove = %v", mods.HeadersToRemove)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	withEvaluator(t, map[string]interface{}{"jwtSub()": "alice"})
+
+	cases := map[string]map[string]interface{}{
+		"request not an object": {"request": "x"},
+		"remove not a list":     {"request": map[string]interface{}{"remove": "x-a"}},
+		"pseudo-header":         {"request": map[string]interface{}{"remove": []interface{}{":path"}}},
+		"host":                  {"request": map[string]interface{}{"set": []interface{}{header("Host", "a")}}},
+		"hop-by-hop":            {"response": map[string]interface{}{"add": []interface{}{header("Transfer-Encoding", "chunked")}}},
+		"envoy internal":        {"request": map[string]interface{}{"set": []interface{}{header("x-envoy-original-path", "/")}}},
+		"target upstream":       {"request": map[string]interface{}{"set": []interface{}{header("x-target-upstream", "evil")}}},
+		"invalid name":          {"request": map[string]interface{}{"set": []interface{}{header("X User", "a")}}},
+		"missing value":         {"request": map[string]interface{}{"set": []interface{}{map[string]interface{}{"name": "x-a"}}}},
+		"unterminated template": {"request": map[string]interface{}{"set": []interface{}{header("x-a", "${jwtSub()")}}},
+		"empty expression":      {"request": map[string]interface{}{"set": []interface{}{header("x-a", "${ }")}}},
+		"invalid expression":    {"request": map[string]interface{}{"set": []interface{}{header("x-a", "${nope()}")}}},
+	}
+	for name, params := range cases {
+		if _, err := GetPolicy(policy.PolicyMetadata{}, params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestTemplatesRequireEvaluator(t *testing.T) {
+	policy.SetExpressionEvaluator(nil)
+
+	params := map[string]interface{}{
+		"request": map[string]interface{}{"set": []interface{}{header("X-User", "${jwtSub()}")}},
+	}
+	if _, err := GetPolicy(policy.PolicyMetadata{}, params); err == nil {
+		t.Error("expected an error when no expression evaluator is registered")
+	}
+
+	static := map[string]interface{}{
+		"request": map[string]interface{}{"set": []interface{}{header("X-Env", "prod")}},
+	}
+	if _, err := GetPolicy(policy.PolicyMetadata{}, static); err != nil {
+		t.Errorf("static values must not need an evaluator: %v", err)
+	}
+}
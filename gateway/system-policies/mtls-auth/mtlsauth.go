@@ -0,0 +1,400 @@
+package mtlsauth
+
+import (
+	"context"
+	"crypto/sha3"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	authType = "mtls"
+
+	// xfccHeader carries the client certificate Envoy observed on the TLS
+	// connection. The listener sanitizes it, so clients cannot supply their own.
+	xfccHeader = "x-forwarded-client-cert"
+
+	// trustedCertificateResourceType is the lazy resource type under which the
+	// gateway controller publishes stored certificates, keyed by name.
+	trustedCertificateResourceType = "TrustedCertificate"
+)
+
+// unauthorizedBody is returned for every authentication failure so callers
+// cannot tell a missing certificate from an expired or untrusted one.
+var unauthorizedBody = []byte(`{"error":"unauthorized","message":"Invalid or expired credentials."}`)
+
+// allowedSignatureAlgorithms are the certificate signature algorithms accepted
+// anywhere in the client's chain. The algorithm comes from the peer, so anything
+// outside this list is rejected rather than negotiated.
+// TODO(pqc): migrate — X.509 client certificates are RSA/ECDSA/Ed25519 signed
+// until ML-DSA certificates are supported by clients and crypto/x509.
+var allowedSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.SHA256WithRSA:    true,
+	x509.SHA384WithRSA:    true,
+	x509.SHA512WithRSA:    true,
+	x509.SHA256WithRSAPSS: true,
+	x509.SHA384WithRSAPSS: true,
+	x509.SHA512WithRSAPSS: true,
+	x509.ECDSAWithSHA256:  true,
+	x509.ECDSAWithSHA384:  true,
+	x509.ECDSAWithSHA512:  true,
+	x509.PureEd25519:      true,
+}
+
+// now is the clock used for certificate validity checks; tests replace it.
+var now = time.Now
+
+// MTLSAuthPolicy authenticates requests by the client certificate presented to
+// the gateway over TLS, verified against a configured set of trusted CAs.
+type MTLSAuthPolicy struct {
+	trustedCAs  []string
+	sanPatterns []*regexp.Regexp
+
+	mu        sync.Mutex
+	poolKey   string
+	cachedCAs *x509.CertPool
+}
+
+// GetPolicy creates an mTLS authentication policy from its parameters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	trustedCAs, err := toStringList(params, "trustedCACertificates")
+	if err != nil {
+		return nil, err
+	}
+	if len(trustedCAs) == 0 {
+		return nil, fmt.Errorf("trustedCACertificates must name at least one certificate")
+	}
+
+	patterns, err := toStringList(params, "requiredSANPatterns")
+	if err != nil {
+		return nil, err
+	}
+	p := &MTLSAuthPolicy{trustedCAs: trustedCAs}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid requiredSANPatterns entry %q: %w", pattern, err)
+		}
+		p.sanPatterns = append(p.sanPatterns, re)
+	}
+	return p, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy.
+func (p *MTLSAuthPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+}
+
+// OnRequestHeaders verifies the client certificate and records the subject in
+// the auth context. Any failure rejects the request with 401.
+func (p *MTLSAuthPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	leaf, err := p.authenticate(reqCtx.Headers)
+	if err != nil {
+		slog.Debug("mTLS auth: rejecting request", "reason", err)
+		return policy.ImmediateResponse{
+			StatusCode: 401,
+			Headers:    map[string]string{"content-type": "application/json"},
+			Body:       unauthorizedBody,
+		}
+	}
+
+	fingerprint := sha3.Sum256(leaf.Raw)
+	reqCtx.SharedContext.AuthContext = &policy.AuthContext{
+		Authenticated: true,
+		AuthType:      authType,
+		Subject:       leaf.Subject.String(),
+		Issuer:        leaf.Issuer.String(),
+		CredentialID:  hex.EncodeToString(fingerprint[:]),
+		Properties:    certificateProperties(leaf),
+		Previous:      reqCtx.SharedContext.AuthContext,
+	}
+	return nil
+}
+
+// authenticate returns the verified client certificate, or the reason it was rejected.
+func (p *MTLSAuthPolicy) authenticate(headers *policy.Headers) (*x509.Certificate, error) {
+	leaf, intermediates, err := clientCertificate(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := p.trustPool()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cert := range append([]*x509.Certificate{leaf}, intermediates.certs...) {
+		if !allowedSignatureAlgorithms[cert.SignatureAlgorithm] {
+			return nil, fmt.Errorf("unsupported signature algorithm %s", cert.SignatureAlgorithm)
+		}
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates.pool,
+		CurrentTime:   now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, fmt.Errorf("certificate verification failed: %w", err)
+	}
+
+	if len(p.sanPatterns) > 0 && !p.sanAllowed(leaf) {
+		return nil, fmt.Errorf("no subject alternative name matches requiredSANPatterns")
+	}
+	return leaf, nil
+}
+
+func (p *MTLSAuthPolicy) sanAllowed(cert *x509.Certificate) bool {
+	for _, san := range subjectAltNames(cert) {
+		for _, re := range p.sanPatterns {
+			if re.MatchString(san) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// trustPool returns the pool of trusted CA certificates, rebuilding it when the
+// certificates published by the controller change. A configured CA that has not
+// been published (or was deleted) is skipped; with none left every request fails.
+func (p *MTLSAuthPolicy) trustPool() (*x509.CertPool, error) {
+	store := policy.GetLazyResourceStoreInstance()
+	var key strings.Builder
+	var pems []string
+	for _, name := range p.trustedCAs {
+		resource, err := store.GetResourceByIDAndType(name, trustedCertificateResourceType)
+		if err != nil {
+			slog.Warn("mTLS auth: trusted CA certificate is not available", "certificate", name)
+			continue
+		}
+		pemData, _ := resource.Resource["certificate"].(string)
+		pems = append(pems, pemData)
+		key.WriteString(name)
+		key.WriteByte(0)
+		key.WriteString(pemData)
+		key.WriteByte(0)
+	}
+	if len(pems) == 0 {
+		return nil, fmt.Errorf("none of the trusted CA certificates are available")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cachedCAs != nil && p.poolKey == key.String() {
+		return p.cachedCAs, nil
+	}
+	pool := x509.NewCertPool()
+	for _, pemData := range pems {
+		pool.AppendCertsFromPEM([]byte(pemData))
+	}
+	p.poolKey = key.String()
+	p.cachedCAs = pool
+	return pool, nil
+}
+
+// intermediateCerts holds the chain certificates sent by the client after the leaf.
+type intermediateCerts struct {
+	certs []*x509.Certificate
+	pool  *x509.CertPool
+}
+
+// clientCertificate extracts the client certificate and chain from the
+// x-forwarded-client-cert header. When the header has several elements (one per
+// proxy), the last one describes the client connected to this gateway.
+func clientCertificate(headers *policy.Headers) (*x509.Certificate, intermediateCerts, error) {
+	intermediates := intermediateCerts{pool: x509.NewCertPool()}
+	values := headers.Get(xfccHeader)
+	if len(values) == 0 {
+		return nil, intermediates, fmt.Errorf("no client certificate presented")
+	}
+	elements := splitQuoted(values[len(values)-1], ',')
+	fields := parseElement(elements[len(elements)-1])
+
+	certPEM, ok := fields["cert"]
+	if !ok || certPEM == "" {
+		return nil, intermediates, fmt.Errorf("no client certificate presented")
+	}
+	certs, err := parsePEMCertificates(certPEM)
+	if err != nil || len(certs) != 1 {
+		return nil, intermediates, fmt.Errorf("malformed client certificate")
+	}
+	leaf := certs[0]
+
+	if chainPEM := fields["chain"]; chainPEM != "" {
+		chain, err := parsePEMCertificates(chainPEM)
+		if err != nil {
+			return nil, intermediates, fmt.Errorf("malformed client certificate chain")
+		}
+		for _, cert := range chain {
+			if cert.Equal(leaf) {
+				continue
+			}
+			intermediates.certs = append(intermediates.certs, cert)
+			intermediates.pool.AddCert(cert)
+		}
+	}
+	return leaf, intermediates, nil
+}
+
+// parseElement parses one XFCC element (key=value pairs separated by ';') into
+// lower-cased keys and unquoted, URL-decoded values.
+func parseElement(element string) map[string]string {
+	fields := map[string]string{}
+	for _, pair := range splitQuoted(element, ';') {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`)
+		}
+		if decoded, err := url.PathUnescape(value); err == nil {
+			value = decoded
+		}
+		fields[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return fields
+}
+
+// splitQuoted splits s on sep, ignoring separators inside double quotes.
+func splitQuoted(s string, sep byte) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && inQuotes:
+			i++
+		case s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == sep && !inQuotes:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func parsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found")
+	}
+	return certs, nil
+}
+
+func subjectAltNames(cert *x509.Certificate) []string {
+	sans := append([]string{}, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return sans
+}
+
+// certificateProperties exposes the certificate details downstream policies and
+// analytics commonly need.
+func certificateProperties(cert *x509.Certificate) map[string]string {
+	props := map[string]string{
+		"serial_number": cert.SerialNumber.String(),
+		"not_after":     cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+	if cert.Subject.CommonName != "" {
+		props["common_name"] = cert.Subject.CommonName
+	}
+	if len(cert.DNSNames) > 0 {
+		props["san_dns"] = strings.Join(cert.DNSNames, ",")
+	}
+	if len(cert.EmailAddresses) > 0 {
+		props["san_email"] = strings.Join(cert.EmailAddresses, ",")
+	}
+	if len(cert.URIs) > 0 {
+		uris := make([]string, len(cert.URIs))
+		for i, uri := range cert.URIs {
+			uris[i] = uri.String()
+		}
+		props["san_uri"] = strings.Join(uris, ",")
+	}
+	if len(cert.IPAddresses) > 0 {
+		ips := make([]string, len(cert.IPAddresses))
+		for i, ip := range cert.IPAddresses {
+			ips[i] = ip.String()
+		}
+		props["san_ip"] = strings.Join(ips, ",")
+	}
+	return props
+}
+
+func toStringList(params map[string]interface{}, name string) ([]string, error) {
+	v, ok := params[name]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	var items []interface{}
+	switch list := v.(type) {
+	case []interface{}:
+		items = list
+	case []string:
+		for _, s := range list {
+			items = append(items, s)
+		}
+	default:
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("%s must be a list of non-empty strings", name)
+		}
+		out = append(out, strings.TrimSpace(s))
+	}
+	return out, nil
+}
@@ -0,0 +1,244 @@
+package mtlsauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+// newTestCA creates a self-signed CA.
+// TODO(pqc): migrate — test certificates use ECDSA like the clients they stand in for.
+func newTestCA(t *testing.T, name string) testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testCA{cert: cert, key: key, pem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))}
+}
+
+// issue returns a PEM client certificate signed by the CA.
+func (ca testCA) issue(t *testing.T, cn string, notAfter time.Time, dnsNames ...string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"Partner"}},
+		NotBefore:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     dnsNames,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func publishCA(t *testing.T, name string, ca testCA) {
+	t.Helper()
+	store := policy.GetLazyResourceStoreInstance()
+	if err := store.StoreResource(&policy.LazyResource{
+		ID:           name,
+		ResourceType: trustedCertificateResourceType,
+		Resource:     map[string]interface{}{"name": name, "certificate": ca.pem},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.RemoveResourceByIDAndType(name, trustedCertificateResourceType) })
+}
+
+// xfcc builds the x-forwarded-client-cert value Envoy sets for a client certificate.
+func xfcc(certPEM string) string {
+	return `Hash=0a1b;Cert=` + url.PathEscape(certPEM) + `;Chain=` + url.PathEscape(certPEM) +
+		`;Subject="CN=partner,O=Partner";DNS=partner.example.com`
+}
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *MTLSAuthPolicy {
+	t.Helper()
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*MTLSAuthPolicy)
+}
+
+func onRequest(p *MTLSAuthPolicy, headers map[string][]string) (policy.RequestHeaderAction, *policy.SharedContext) {
+	shared := &policy.SharedContext{Metadata: map[string]interface{}{}}
+	action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{
+		SharedContext: shared,
+		Headers:       policy.NewHeaders(headers),
+	}, nil)
+	return action, shared
+}
+
+func assertUnauthorized(t *testing.T, action policy.RequestHeaderAction, shared *policy.SharedContext) {
+	t.Helper()
+	resp, ok := action.(policy.ImmediateResponse)
+	if !ok {
+		t.Fatalf("action = %T, want ImmediateResponse", action)
+	}
+	if resp.StatusCode != 401 || string(resp.Body) != string(unauthorizedBody) {
+		t.Errorf("response = %d %s, want 401 %s", resp.StatusCode, resp.Body, unauthorizedBody)
+	}
+	if shared.AuthContext != nil {
+		t.Errorf("AuthContext = %+v, want nil", shared.AuthContext)
+	}
+}
+
+func TestValidCertificate(t *testing.T) {
+	ca := newTestCA(t, "Partner CA")
+	publishCA(t, "partner-ca", ca)
+	p := newTestPolicy(t, map[string]interface{}{
+		"trustedCACertificates": []interface{}{"partner-ca"},
+		"requiredSANPatterns":   []interface{}{`[a-z0-9-]+\.example\.com`},
+	})
+	previous := &policy.AuthContext{Authenticated: true, AuthType: "apikey"}
+
+	shared := &policy.SharedContext{Metadata: map[string]interface{}{}, AuthContext: previous}
+	action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{
+		SharedContext: shared,
+		Headers: policy.NewHeaders(map[string][]string{
+			"x-forwarded-client-cert": {xfcc(ca.issue(t, "partner", time.Now().Add(time.Hour), "partner.example.com"))},
+		}),
+	}, nil)
+	if action != nil {
+		t.Fatalf("action = %#v, want nil", action)
+	}
+
+	auth := shared.AuthContext
+	if auth == nil || !auth.Authenticated || auth.AuthType != "mtls" {
+		t.Fatalf("AuthContext = %+v, want an authenticated mtls context", auth)
+	}
+	if auth.Subject != "CN=partner,O=Partner" || auth.Issuer != "CN=Partner CA" {
+		t.Errorf("Subject = %q, Issuer = %q", auth.Subject, auth.Issuer)
+	}
+	if auth.Properties["san_dns"] != "partner.example.com" || auth.Properties["common_name"] != "partner" {
+		t.Errorf("Properties = %v", auth.Properties)
+	}
+	if len(auth.CredentialID) != 64 {
+		t.Errorf("CredentialID = %q, want a SHA3-256 fingerprint", auth.CredentialID)
+	}
+	if auth.Previous != previous {
+		t.Error("the previous auth layer must be kept")
+	}
+}
+
+func TestExpiredCertificate(t *testing.T) {
+	ca := newTestCA(t, "Partner CA")
+	publishCA(t, "partner-ca", ca)
+	p := newTestPolicy(t, map[string]interface{}{"trustedCACertificates": []interface{}{"partner-ca"}})
+
+	expired := ca.issue(t, "partner", time.Now().Add(-time.Hour))
+	action, shared := onRequest(p, map[string][]string{"x-forwarded-client-cert": {xfcc(expired)}})
+	assertUnauthorized(t, action, shared)
+}
+
+func TestUntrustedIssuer(t *testing.T) {
+	trusted := newTestCA(t, "Partner CA")
+	publishCA(t, "partner-ca", trusted)
+	rogue := newTestCA(t, "Partner CA") // same name, different key
+	p := newTestPolicy(t, map[string]interface{}{"trustedCACertificates": []interface{}{"partner-ca"}})
+
+	cert := rogue.issue(t, "partner", time.Now().Add(time.Hour))
+	action, shared := onRequest(p, map[string][]string{"x-forwarded-client-cert": {xfcc(cert)}})
+	assertUnauthorized(t, action, shared)
+}
+
+func TestRejectedRequests(t *testing.T) {
+	ca := newTestCA(t, "Partner CA")
+	publishCA(t, "partner-ca", ca)
+	valid := ca.issue(t, "partner", time.Now().Add(time.Hour), "evil.example.org")
+
+	p := newTestPolicy(t, map[string]interface{}{
+		"trustedCACertificates": []interface{}{"partner-ca"},
+		"requiredSANPatterns":   []interface{}{`partner\.example\.com`},
+	})
+	cases := map[string]map[string][]string{
+		"no certificate":      nil,
+		"no cert field":       {"x-forwarded-client-cert": {`Hash=0a1b;Subject="CN=partner"`}},
+		"garbage certificate": {"x-forwarded-client-cert": {`Cert=` + url.PathEscape("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n")}},
+		"SAN does not match":  {"x-forwarded-client-cert": {xfcc(valid)}},
+		"SAN partially match": {"x-forwarded-client-cert": {xfcc(ca.issue(t, "partner", time.Now().Add(time.Hour), "partner.example.com.evil.org"))}},
+		"only an earlier hop": {"x-forwarded-client-cert": {xfcc(ca.issue(t, "p", time.Now().Add(time.Hour), "partner.example.com")) + `,Hash=ff`}},
+	}
+	for name, headers := range cases {
+		t.Run(name, func(t *testing.T) {
+			action, shared := onRequest(p, headers)
+			assertUnauthorized(t, action, shared)
+		})
+	}
+
+	// A CA that was never published cannot be trusted
+	unpublished := newTestPolicy(t, map[string]interface{}{"trustedCACertificates": []interface{}{"missing-ca"}})
+	action, shared := onRequest(unpublished, map[string][]string{"x-forwarded-client-cert": {xfcc(valid)}})
+	assertUnauthorized(t, action, shared)
+}
+
+func TestTrustPoolFollowsPublishedCertificates(t *testing.T) {
+	oldCA := newTestCA(t, "Partner CA")
+	publishCA(t, "partner-ca", oldCA)
+	p := newTestPolicy(t, map[string]interface{}{"trustedCACertificates": []interface{}{"partner-ca"}})
+	headers := map[string][]string{"x-forwarded-client-cert": {xfcc(oldCA.issue(t, "partner", time.Now().Add(time.Hour)))}}
+
+	if action, _ := onRequest(p, headers); action != nil {
+		t.Fatalf("action = %#v, want nil", action)
+	}
+
+	// Rotating the CA in the certificate store revokes trust in the old one
+	publishCA(t, "partner-ca", newTestCA(t, "Partner CA"))
+	action, shared := onRequest(p, headers)
+	assertUnauthorized(t, action, shared)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"no trusted CAs":      {},
+		"empty trusted CAs":   {"trustedCACertificates": []interface{}{}},
+		"CAs not a list":      {"trustedCACertificates": "partner-ca"},
+		"invalid SAN pattern": {"trustedCACertificates": []interface{}{"partner-ca"}, "requiredSANPatterns": []interface{}{"("}},
+	}
+	for name, params := range cases {
+		if _, err := GetPolicy(policy.PolicyMetadata{}, params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
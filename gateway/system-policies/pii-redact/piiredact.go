@@ -0,0 +1,429 @@
+package piiredact
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	entityEmail      = "email"
+	entityPhone      = "phone"
+	entitySSN        = "ssn"
+	entityCreditCard = "creditCard"
+
+	maskRedact      = "redact"
+	maskPlaceholder = "placeholder"
+	maskPartial     = "partial"
+
+	applyRequest  = "request"
+	applyResponse = "response"
+	applyBoth     = "both"
+
+	redactedText = "*****"
+)
+
+// entity is a kind of personal data the policy can find and mask.
+type entity struct {
+	name        string
+	placeholder string
+	pattern     *regexp.Regexp
+	// valid filters pattern matches that are not really of this kind.
+	valid func(match string) bool
+}
+
+// entities are scanned in this order. Credit card numbers go before phone
+// numbers so a card is not partly masked as a phone number.
+var entities = []entity{
+	{
+		name:        entityEmail,
+		placeholder: "[EMAIL]",
+		pattern:     regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`),
+	},
+	{
+		name:        entityCreditCard,
+		placeholder: "[CREDIT_CARD]",
+		pattern:     regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		valid:       luhnValid,
+	},
+	{
+		name:        entitySSN,
+		placeholder: "[SSN]",
+		pattern:     regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+		valid:       ssnValid,
+	},
+	{
+		name:        entityPhone,
+		placeholder: "[PHONE]",
+		pattern:     regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{3}\) ?|\b\d{3}[ .-])\d{3}[ .-]\d{4}\b`),
+	},
+}
+
+// stats holds the redaction counters of each route. Policy instances are
+// rebuilt whenever the route's chain is updated, so the counters live here to
+// survive updates.
+var stats sync.Map // route name -> *routeStats
+
+type routeStats struct {
+	redactions map[string]*atomic.Int64 // entity name -> matches masked
+}
+
+func newRouteStats() *routeStats {
+	s := &routeStats{redactions: make(map[string]*atomic.Int64, len(entities))}
+	for _, e := range entities {
+		s.redactions[e.name] = &atomic.Int64{}
+	}
+	return s
+}
+
+// config is the parsed policy configuration.
+type config struct {
+	entities  []entity // in scan order
+	maskStyle string
+	request   bool
+	response  bool
+}
+
+// PiiRedactPolicy masks personal data in request and response bodies.
+type PiiRedactPolicy struct {
+	cfg   config
+	stats *routeStats
+}
+
+// GetPolicy creates a redaction policy bound to the route's shared counters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := stats.LoadOrStore(metadata.RouteName, newRouteStats())
+	return &PiiRedactPolicy{cfg: cfg, stats: s.(*routeStats)}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. Only the bodies the
+// policy redacts are buffered.
+func (p *PiiRedactPolicy) Mode() policy.ProcessingMode {
+	mode := policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+	if p.cfg.request {
+		mode.RequestBodyMode = policy.BodyModeBuffer
+	}
+	if p.cfg.response {
+		mode.ResponseBodyMode = policy.BodyModeBuffer
+	}
+	return mode
+}
+
+// OnRequestBody masks personal data in the request body before it is sent
+// upstream.
+func (p *PiiRedactPolicy) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	if !p.cfg.request || reqCtx.Body == nil || !reqCtx.Body.Present {
+		return nil
+	}
+	body, changed := p.redactBody(reqCtx.Body.Content, reqCtx.Headers)
+	if !changed {
+		return nil
+	}
+	return policy.UpstreamRequestModifications{Body: body}
+}
+
+// OnResponseBody masks personal data in the response body before it is sent
+// to the client.
+func (p *PiiRedactPolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	if !p.cfg.response || respCtx.ResponseBody == nil || !respCtx.ResponseBody.Present {
+		return nil
+	}
+	body, changed := p.redactBody(respCtx.ResponseBody.Content, respCtx.ResponseHeaders)
+	if !changed {
+		return nil
+	}
+	return policy.DownstreamResponseModifications{Body: body}
+}
+
+// PolicyState reports the number of matches masked on the route, per entity
+// type, for the admin API and metrics.
+func (p *PiiRedactPolicy) PolicyState() map[string]interface{} {
+	state := make(map[string]interface{}, len(p.cfg.entities))
+	for _, e := range p.cfg.entities {
+		state["redactions_"+e.name] = p.stats.redactions[e.name].Load()
+	}
+	return state
+}
+
+// redactBody masks the body and reports whether anything was masked. Only
+// the string values of JSON bodies are scanned, so the masked body is still
+// valid JSON. Compressed and binary bodies are left untouched.
+func (p *PiiRedactPolicy) redactBody(body []byte, headers *policy.Headers) ([]byte, bool) {
+	if len(body) == 0 {
+		return nil, false
+	}
+	if encoding := headerValue(headers, "content-encoding"); encoding != "" && !strings.EqualFold(encoding, "identity") {
+		return nil, false
+	}
+	contentType := strings.ToLower(headerValue(headers, "content-type"))
+	if !textual(contentType) {
+		return nil, false
+	}
+
+	counts := make(map[string]int64)
+	var redacted []byte
+	if strings.Contains(contentType, "json") {
+		redacted = redactJSONStrings(body, func(s string) string { return p.redact(s, counts) })
+	} else {
+		redacted = []byte(p.redact(string(body), counts))
+	}
+	if len(counts) == 0 {
+		return nil, false
+	}
+	for name, n := range counts {
+		p.stats.redactions[name].Add(n)
+	}
+	return redacted, true
+}
+
+// redact masks every enabled entity in s, adding the matches to counts.
+func (p *PiiRedactPolicy) redact(s string, counts map[string]int64) string {
+	for _, e := range p.cfg.entities {
+		s = e.pattern.ReplaceAllStringFunc(s, func(match string) string {
+			if e.valid != nil && !e.valid(match) {
+				return match
+			}
+			counts[e.name]++
+			return mask(e, match, p.cfg.maskStyle)
+		})
+	}
+	return s
+}
+
+// mask returns the replacement for a match. The partial style keeps the first
+// character and domain of an email address and the last four digits of a
+// number.
+func mask(e entity, match, style string) string {
+	switch style {
+	case maskPlaceholder:
+		return e.placeholder
+	case maskPartial:
+		if e.name == entityEmail {
+			at := strings.LastIndexByte(match, '@')
+			return match[:1] + "***" + match[at:]
+		}
+		return maskDigits(match, 4)
+	default:
+		return redactedText
+	}
+}
+
+// maskDigits replaces all but the last keep digits of s with '*', leaving
+// separators in place.
+func maskDigits(s string, keep int) string {
+	digits := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			digits++
+		}
+	}
+	b := []byte(s)
+	for i := range b {
+		if b[i] >= '0' && b[i] <= '9' && digits > keep {
+			b[i] = '*'
+			digits--
+		}
+	}
+	return string(b)
+}
+
+// redactJSONStrings applies redact to every string literal in a JSON document
+// and re-encodes the literals that changed. Everything else, including
+// formatting, is copied unchanged.
+func redactJSONStrings(body []byte, redact func(string) string) []byte {
+	var out []byte
+	last := 0
+	for i := 0; i < len(body); i++ {
+		if body[i] != '"' {
+			continue
+		}
+		start := i
+		for i++; i < len(body) && body[i] != '"'; i++ {
+			if body[i] == '\\' {
+				i++
+			}
+		}
+		if i >= len(body) {
+			break
+		}
+		literal := body[start : i+1]
+		var s string
+		if err := json.Unmarshal(literal, &s); err != nil {
+			continue
+		}
+		r := redact(s)
+		if r == s {
+			continue
+		}
+		out = append(out, body[last:start]...)
+		out = append(out, quote(r)...)
+		last = i + 1
+	}
+	if out == nil {
+		return body
+	}
+	return append(out, body[last:]...)
+}
+
+// quote encodes s as a JSON string without escaping HTML characters.
+func quote(s string) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// textual reports whether a body of the given content type can be scanned as
+// text. Bodies without a content type are treated as text.
+func textual(contentType string) bool {
+	if contentType == "" || strings.HasPrefix(contentType, "text/") {
+		return true
+	}
+	for _, kind := range []string{"json", "xml", "x-www-form-urlencoded", "javascript"} {
+		if strings.Contains(contentType, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// luhnValid reports whether the digits of a candidate card number pass the
+// Luhn checksum.
+func luhnValid(match string) bool {
+	sum, n := 0, 0
+	for i := len(match) - 1; i >= 0; i-- {
+		c := match[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
+
+// ssnValid rejects numbers that are never issued as US social security
+// numbers: area 000, 666 or 9xx, group 00 and serial 0000.
+func ssnValid(match string) bool {
+	area, group, serial := match[0:3], match[4:6], match[7:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+func headerValue(headers *policy.Headers, name string) string {
+	if headers == nil {
+		return ""
+	}
+	if values := headers.Get(name); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// parseConfig selects the entityTypes to detect (all by default), the maskStyle
+// (redact by default) and whether request bodies, response bodies or both are scanned.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		entities:  entities,
+		maskStyle: maskRedact,
+		request:   true,
+		response:  true,
+	}
+
+	if v, ok := params["entityTypes"]; ok {
+		list, ok := v.([]interface{})
+		if !ok || len(list) == 0 {
+			return cfg, fmt.Errorf("entityTypes must be a non-empty list of %s", entityNames())
+		}
+		enabled := make(map[string]bool, len(list))
+		for _, item := range list {
+			name, ok := item.(string)
+			if !ok || !knownEntity(name) {
+				return cfg, fmt.Errorf("entityTypes must be a non-empty list of %s", entityNames())
+			}
+			enabled[name] = true
+		}
+		cfg.entities = nil
+		for _, e := range entities {
+			if enabled[e.name] {
+				cfg.entities = append(cfg.entities, e)
+			}
+		}
+	}
+
+	if v, ok := params["maskStyle"]; ok {
+		style, _ := v.(string)
+		switch style {
+		case maskRedact, maskPlaceholder, maskPartial:
+			cfg.maskStyle = style
+		default:
+			return cfg, fmt.Errorf("maskStyle must be one of %s, %s or %s", maskRedact, maskPlaceholder, maskPartial)
+		}
+	}
+
+	if v, ok := params["applyTo"]; ok {
+		applyTo, _ := v.(string)
+		switch applyTo {
+		case applyRequest:
+			cfg.response = false
+		case applyResponse:
+			cfg.request = false
+		case applyBoth:
+		default:
+			return cfg, fmt.Errorf("applyTo must be one of %s, %s or %s", applyRequest, applyResponse, applyBoth)
+		}
+	}
+	return cfg, nil
+}
+
+func knownEntity(name string) bool {
+	for _, e := range entities {
+		if e.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func entityNames() string {
+	names := make([]string, len(entities))
+	for i, e := range entities {
+		names[i] = e.name
+	}
+	return strings.Join(names, ", ")
+}
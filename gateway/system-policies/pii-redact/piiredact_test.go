@@ -0,0 +1,181 @@
+package piiredact
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) *PiiRedactPolicy {
+	t.Helper()
+	stats.Delete(route)
+	t.Cleanup(func() { stats.Delete(route) })
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*PiiRedactPolicy)
+}
+
+// redactRequest runs a request body through the policy and returns the body
+// sent upstream.
+func redactRequest(p *PiiRedactPolicy, contentType, body string) string {
+	action := p.OnRequestBody(context.Background(), &policy.RequestContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Headers:       policy.NewHeaders(map[string][]string{"content-type": {contentType}}),
+		Body:          &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+		Path:          "/chat",
+		Method:        "POST",
+	}, nil)
+	if action == nil {
+		return body
+	}
+	return string(action.(policy.UpstreamRequestModifications).Body)
+}
+
+// redactResponse runs a response body through the policy and returns the body
+// sent to the client.
+func redactResponse(p *PiiRedactPolicy, headers map[string][]string, body string) string {
+	action := p.OnResponseBody(context.Background(), &policy.ResponseContext{
+		SharedContext:   &policy.SharedContext{Metadata: map[string]interface{}{}},
+		ResponseHeaders: policy.NewHeaders(headers),
+		ResponseBody:    &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+		ResponseStatus:  200,
+	}, nil)
+	if action == nil {
+		return body
+	}
+	return string(action.(policy.DownstreamResponseModifications).Body)
+}
+
+// The Bedrock guardrail mock masks any address matching its email pattern,
+// including the "mask-" addresses used to trigger anonymization.
+func TestEmailRedaction(t *testing.T) {
+	p := newTestPolicy(t, "route-email", map[string]interface{}{})
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Contact john.doe@example.com for details", want: "Contact ***** for details"},
+		{in: "Send it to mask-user@example.com please", want: "Send it to ***** please"},
+		{in: "first+tag@sub.example.co.uk, second_x%y@test.io", want: "*****, *****"},
+		{in: "no address @example or user@localhost here", want: "no address @example or user@localhost here"},
+	}
+	for _, tt := range tests {
+		if got := redactRequest(p, "text/plain", tt.in); got != tt.want {
+			t.Errorf("redact(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestJSONBodyStaysValid(t *testing.T) {
+	p := newTestPolicy(t, "route-json", map[string]interface{}{"maskStyle": "placeholder"})
+	body := `{"messages": [{"role": "user", "content": "Hi,\nI am \"Jo\" <jo@example.com>, card 4111 1111 1111 1111"}], "n": 1}`
+
+	got := redactRequest(p, "application/json", body)
+	var decoded struct {
+		Messages []struct {
+			Content string `json:"content"`
+		} `json:"messages"`
+		N int `json:"n"`
+	}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("redacted body is not valid JSON: %v\n%s", err, got)
+	}
+	want := "Hi,\nI am \"Jo\" <[EMAIL]>, card [CREDIT_CARD]"
+	if len(decoded.Messages) != 1 || decoded.Messages[0].Content != want || decoded.N != 1 {
+		t.Errorf("redacted body = %s", got)
+	}
+}
+
+func TestEntityTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "ssn", in: "SSN 123-45-6789.", want: "SSN ***-**-6789."},
+		{name: "unissued ssn", in: "ref 666-12-3456", want: "ref 666-12-3456"},
+		{name: "phone", in: "call (555) 123-4567 or +1 555.123.4567", want: "call (***) ***-4567 or +* ***.***.4567"},
+		{name: "card", in: "card 4111-1111-1111-1111", want: "card ****-****-****-1111"},
+		{name: "card failing luhn", in: "order 4111 1111 1111 1112", want: "order 4111 1111 1111 1112"},
+		{name: "email", in: "mail jane@example.com", want: "mail j***@example.com"},
+	}
+	p := newTestPolicy(t, "route-entities", map[string]interface{}{"maskStyle": "partial"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactRequest(p, "text/plain", tt.in); got != tt.want {
+				t.Errorf("redact(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectedEntityTypesOnly(t *testing.T) {
+	p := newTestPolicy(t, "route-selected", map[string]interface{}{"entityTypes": []interface{}{"ssn"}})
+	in := "jo@example.com 123-45-6789"
+	if got := redactRequest(p, "text/plain", in); got != "jo@example.com *****" {
+		t.Errorf("redact(%q) = %q", in, got)
+	}
+}
+
+func TestApplyTo(t *testing.T) {
+	in := "jo@example.com"
+	requestOnly := newTestPolicy(t, "route-request", map[string]interface{}{"applyTo": "request"})
+	if requestOnly.Mode().ResponseBodyMode != policy.BodyModeSkip {
+		t.Error("response body buffered with applyTo request")
+	}
+	if got := redactResponse(requestOnly, nil, in); got != in {
+		t.Errorf("response redacted with applyTo request: %q", got)
+	}
+
+	responseOnly := newTestPolicy(t, "route-response", map[string]interface{}{"applyTo": "response"})
+	if responseOnly.Mode().RequestBodyMode != policy.BodyModeSkip {
+		t.Error("request body buffered with applyTo response")
+	}
+	if got := redactResponse(responseOnly, nil, in); got != redactedText {
+		t.Errorf("response = %q, want redacted", got)
+	}
+}
+
+func TestSkipsBinaryAndCompressedBodies(t *testing.T) {
+	p := newTestPolicy(t, "route-skip", map[string]interface{}{})
+	in := "jo@example.com"
+	if got := redactResponse(p, map[string][]string{"content-type": {"image/png"}}, in); got != in {
+		t.Errorf("binary body redacted: %q", got)
+	}
+	if got := redactResponse(p, map[string][]string{"content-encoding": {"gzip"}}, in); got != in {
+		t.Errorf("compressed body redacted: %q", got)
+	}
+}
+
+func TestRedactionCounts(t *testing.T) {
+	p := newTestPolicy(t, "route-counts", map[string]interface{}{"entityTypes": []interface{}{"email", "ssn"}})
+	redactRequest(p, "text/plain", "a@example.com b@example.com 123-45-6789")
+	redactResponse(p, nil, "c@example.com")
+
+	// The counters survive a chain rebuild
+	rebuilt, err := GetPolicy(policy.PolicyMetadata{RouteName: "route-counts"}, map[string]interface{}{"entityTypes": []interface{}{"email", "ssn"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := rebuilt.(*PiiRedactPolicy).PolicyState()
+	if state["redactions_email"] != int64(3) || state["redactions_ssn"] != int64(1) || len(state) != 2 {
+		t.Errorf("state = %v", state)
+	}
+}
+
+func TestParseConfigRejectsInvalid(t *testing.T) {
+	for name, params := range map[string]map[string]interface{}{
+		"unknown entity":    {"entityTypes": []interface{}{"passport"}},
+		"empty entity list": {"entityTypes": []interface{}{}},
+		"bad mask style":    {"maskStyle": "hash"},
+		"bad applyTo":       {"applyTo": "neither"},
+	} {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package quota
+
+import (
+	"context"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	// Embedded so time zones resolve in images without a zoneinfo database
+	_ "time/tzdata"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	headerLimit     = "x-quota-limit"
+	headerRemaining = "x-quota-remaining"
+	headerReset     = "x-quota-reset"
+
+	// headersMetadataKey carries the quota headers computed in the request
+	// phase to the response phase.
+	headersMetadataKey = "__quota_headers"
+
+	// unresolvedConsumer shares one quota among the requests that lack the
+	// identity selected by the key parameter, so leaving it out does not earn
+	// a fresh quota.
+	unresolvedConsumer = "unresolved"
+
+	// sweepInterval is how often ended periods are dropped from the memory
+	// counters.
+	sweepInterval = time.Hour
+)
+
+// Quota periods accepted by the period parameter.
+const (
+	periodDay   = "day"
+	periodWeek  = "week"
+	periodMonth = "month"
+)
+
+// Consumer identities accepted by the key parameter.
+const (
+	keyAPIKey       = "api_key"
+	keyJWTSubject   = "jwt_sub"
+	keyClientIP     = "client_ip"
+	keyHeaderPrefix = "header:"
+)
+
+// Counter backends accepted by the backend system parameter.
+const (
+	backendMemory = "memory"
+	backendRedis  = "redis"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// counters holds the in-memory call counters of each route. Policy instances
+// are rebuilt whenever the route's chain is updated, so the counters live here
+// to survive updates.
+var counters sync.Map // route name -> *memoryStore
+
+// config is the parsed policy configuration.
+type config struct {
+	limit    int64
+	period   string
+	location *time.Location
+	// key selects the consumer identity; empty resolves it automatically.
+	key string
+	// header is the lower-cased header name of a "header:<name>" key.
+	header string
+	// backend selects where the counters are kept.
+	backend string
+	redis   redisConfig
+}
+
+// counterStore counts the calls of each consumer of a route in a quota period.
+type counterStore interface {
+	// increment counts one call of the consumer in the period [start, end)
+	// and returns the number of calls counted in the period so far.
+	increment(ctx context.Context, consumer string, start, end time.Time) (int64, error)
+}
+
+// memoryStore is the in-memory counterStore. Its counters are local to one
+// policy engine instance.
+type memoryStore struct {
+	mu        sync.Mutex
+	periods   map[string]*periodCount // consumer -> calls
+	lastSweep time.Time
+}
+
+type periodCount struct {
+	start time.Time
+	end   time.Time
+	calls int64
+}
+
+// QuotaPolicy enforces per-consumer call quotas over calendar periods.
+type QuotaPolicy struct {
+	route string
+	cfg   config
+	store counterStore
+}
+
+// GetPolicy creates a quota bound to the route's shared counters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	var store counterStore
+	if cfg.backend == backendRedis {
+		store = newRedisStore(cfg.redis, metadata.RouteName)
+	} else {
+		s, _ := counters.LoadOrStore(metadata.RouteName, &memoryStore{periods: map[string]*periodCount{}})
+		store = s.(*memoryStore)
+	}
+	return &QuotaPolicy{
+		route: metadata.RouteName,
+		cfg:   cfg,
+		store: store,
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. Only headers are needed.
+func (p *QuotaPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeProcess,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+}
+
+// OnRequestHeaders counts the call against the consumer's quota and rejects it
+// when the quota of the current period is used up.
+func (p *QuotaPolicy) OnRequestHeaders(ctx context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	consumer := p.consumer(reqCtx.SharedContext, reqCtx.Downstream)
+	t := now()
+	start, end := p.cfg.periodBounds(t)
+
+	calls, err := p.store.increment(ctx, consumer, start, end)
+	if err != nil {
+		// An outage of the counter store must not take the route down with it
+		slog.Warn("Quota: failed to count request, allowing it", "route", p.route, "error", err)
+		return nil
+	}
+
+	headers := p.quotaHeaders(p.cfg.limit-calls, end.Sub(t))
+	if calls > p.cfg.limit {
+		slog.Debug("Quota: quota exhausted", "route", p.route, "period", p.cfg.period)
+		return p.reject(headers)
+	}
+	setMetadata(reqCtx.SharedContext, headersMetadataKey, headers)
+	return nil
+}
+
+// OnResponseHeaders reports the remaining quota computed for the request.
+func (p *QuotaPolicy) OnResponseHeaders(_ context.Context, respCtx *policy.ResponseHeaderContext, _ map[string]interface{}) policy.ResponseHeaderAction {
+	if respCtx.SharedContext == nil || respCtx.SharedContext.Metadata == nil {
+		return nil
+	}
+	headers, ok := respCtx.SharedContext.Metadata[headersMetadataKey].(map[string]string)
+	if !ok {
+		return nil
+	}
+	return policy.DownstreamResponseHeaderModifications{HeadersToSet: headers}
+}
+
+func (p *QuotaPolicy) reject(headers map[string]string) policy.ImmediateResponse {
+	headers["content-type"] = "application/json"
+	headers["retry-after"] = headers[headerReset]
+	body, _ := json.Marshal(map[string]string{
+		"error":   "Too Many Requests",
+		"message": "Quota exceeded",
+	})
+	return policy.ImmediateResponse{
+		StatusCode: 429,
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+func (p *QuotaPolicy) quotaHeaders(remaining int64, reset time.Duration) map[string]string {
+	if remaining < 0 {
+		remaining = 0
+	}
+	return map[string]string{
+		headerLimit:     strconv.FormatInt(p.cfg.limit, 10),
+		headerRemaining: strconv.FormatInt(remaining, 10),
+		headerReset:     strconv.Itoa(int(math.Ceil(reset.Seconds()))),
+	}
+}
+
+// periodBounds returns the calendar period containing t in the configured
+// time zone. Days start at local midnight, weeks on Monday and months on the
+// first day, so a period can be shorter or longer than its nominal length
+// across a daylight saving change.
+func (c config) periodBounds(t time.Time) (start, end time.Time) {
+	local := t.In(c.location)
+	y, m, d := local.Date()
+	switch c.period {
+	case periodDay:
+		return time.Date(y, m, d, 0, 0, 0, 0, c.location), time.Date(y, m, d+1, 0, 0, 0, 0, c.location)
+	case periodWeek:
+		monday := d - (int(local.Weekday())+6)%7
+		return time.Date(y, m, monday, 0, 0, 0, 0, c.location), time.Date(y, m, monday+7, 0, 0, 0, 0, c.location)
+	default:
+		return time.Date(y, m, 1, 0, 0, 0, 0, c.location), time.Date(y, m+1, 1, 0, 0, 0, 0, c.location)
+	}
+}
+
+func (s *memoryStore) increment(_ context.Context, consumer string, start, end time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := now()
+	if t.Sub(s.lastSweep) >= sweepInterval {
+		for key, c := range s.periods {
+			if !t.Before(c.end) {
+				delete(s.periods, key)
+			}
+		}
+		s.lastSweep = t
+	}
+
+	c, ok := s.periods[consumer]
+	if !ok || !c.start.Equal(start) {
+		c = &periodCount{start: start, end: end}
+		s.periods[consumer] = c
+	}
+	c.calls++
+	return c.calls, nil
+}
+
+// consumer identifies who the call is counted against. Without a key
+// parameter the identity is resolved by consumerKey; otherwise only the
+// selected identity is used, and requests without it share the unresolved
+// quota.
+func (p *QuotaPolicy) consumer(shared *policy.SharedContext, downstream *policy.DownstreamContext) string {
+	switch p.cfg.key {
+	case "":
+		return consumerKey(shared, downstream)
+	case keyAPIKey:
+		if auth := authLayer(shared, isAPIKeyAuth); auth != nil {
+			if auth.CredentialID != "" {
+				return "credential:" + auth.CredentialID
+			}
+			if auth.Subject != "" {
+				return "subject:" + auth.Subject
+			}
+		}
+	case keyJWTSubject:
+		if auth := authLayer(shared, isJWTAuth); auth != nil && auth.Subject != "" {
+			return "subject:" + auth.Subject
+		}
+	case keyClientIP:
+		if downstream != nil && downstream.ClientIP != "" {
+			return "ip:" + downstream.ClientIP
+		}
+	default:
+		if downstream != nil && downstream.Request != nil {
+			if values := downstream.Request.Headers.Get(p.cfg.header); len(values) > 0 && values[0] != "" {
+				// The header may carry a credential; keep only its digest
+				sum := sha3.Sum256([]byte(values[0]))
+				return "header:" + hex.EncodeToString(sum[:])
+			}
+		}
+	}
+	return unresolvedConsumer
+}
+
+// authLayer returns the most recent authenticated layer of the auth chain
+// accepted by match.
+func authLayer(shared *policy.SharedContext, match func(authType string) bool) *policy.AuthContext {
+	if shared == nil {
+		return nil
+	}
+	for auth := shared.AuthContext; auth != nil; auth = auth.Previous {
+		if auth.Authenticated && match(auth.AuthType) {
+			return auth
+		}
+	}
+	return nil
+}
+
+func isAPIKeyAuth(authType string) bool {
+	return authType == "apikey" || authType == "api-key"
+}
+
+func isJWTAuth(authType string) bool {
+	return authType == "jwt" || strings.HasPrefix(authType, "mcp/oauth")
+}
+
+// consumerKey identifies who the call is counted against: the authenticated
+// credential, then the authenticated subject, then the client IP.
+func consumerKey(shared *policy.SharedContext, downstream *policy.DownstreamContext) string {
+	if shared != nil && shared.AuthContext != nil && shared.AuthContext.Authenticated {
+		if id := shared.AuthContext.CredentialID; id != "" {
+			return "credential:" + id
+		}
+		if sub := shared.AuthContext.Subject; sub != "" {
+			return "subject:" + sub
+		}
+	}
+	if downstream != nil && downstream.ClientIP != "" {
+		return "ip:" + downstream.ClientIP
+	}
+	return "anonymous"
+}
+
+// parseConfig reads limit, which is required, the calendar period and timezone it resets
+// in, the key requests are counted by and the memory or redis backend.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{period: periodMonth, location: time.UTC, backend: backendMemory}
+
+	v, ok := params["limit"]
+	if !ok {
+		return cfg, fmt.Errorf("limit is required")
+	}
+	n, err := toInt(v)
+	if err != nil || n < 1 {
+		return cfg, fmt.Errorf("limit must be a positive integer")
+	}
+	cfg.limit = int64(n)
+
+	if v, ok := params["period"]; ok {
+		period, ok := v.(string)
+		if !ok || (period != periodDay && period != periodWeek && period != periodMonth) {
+			return cfg, fmt.Errorf("period must be day, week or month")
+		}
+		cfg.period = period
+	}
+	if v, ok := params["timezone"]; ok {
+		name, ok := v.(string)
+		if !ok || name == "" {
+			return cfg, fmt.Errorf("timezone must be an IANA time zone name")
+		}
+		if cfg.location, err = time.LoadLocation(name); err != nil {
+			return cfg, fmt.Errorf("unknown timezone %q", name)
+		}
+	}
+	if v, ok := params["key"]; ok {
+		key, ok := v.(string)
+		if !ok {
+			return cfg, fmt.Errorf("key must be a string")
+		}
+		switch {
+		case key == keyAPIKey, key == keyJWTSubject, key == keyClientIP:
+			cfg.key = key
+		case strings.HasPrefix(key, keyHeaderPrefix):
+			cfg.header = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(key, keyHeaderPrefix)))
+			if cfg.header == "" {
+				return cfg, fmt.Errorf("key %q must name a header", key)
+			}
+			cfg.key = key
+		default:
+			return cfg, fmt.Errorf("key must be api_key, jwt_sub, client_ip or header:<name>, got %q", key)
+		}
+	}
+	if v, ok := params["backend"]; ok {
+		backend, ok := v.(string)
+		if !ok || (backend != backendMemory && backend != backendRedis) {
+			return cfg, fmt.Errorf("backend must be memory or redis")
+		}
+		cfg.backend = backend
+	}
+	if cfg.backend == backendRedis {
+		if cfg.redis, err = parseRedisConfig(params["redis"]); err != nil {
+			return cfg, fmt.Errorf("invalid redis configuration: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func setMetadata(shared *policy.SharedContext, key string, value interface{}) {
+	if shared == nil {
+		return
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = make(map[string]interface{})
+	}
+	shared.Metadata[key] = value
+}
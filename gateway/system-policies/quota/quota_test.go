@@ -0,0 +1,260 @@
+package quota
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) *QuotaPolicy {
+	t.Helper()
+	counters.Delete(route)
+	t.Cleanup(func() { counters.Delete(route) })
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*QuotaPolicy)
+}
+
+func sharedFor(subject string) *policy.SharedContext {
+	return &policy.SharedContext{
+		Metadata:    map[string]interface{}{},
+		AuthContext: &policy.AuthContext{Authenticated: true, Subject: subject},
+	}
+}
+
+// call runs one request through the policy. It returns the immediate response
+// when the request was rejected, otherwise the headers set on the response.
+func call(p *QuotaPolicy, shared *policy.SharedContext) (*policy.ImmediateResponse, map[string]string) {
+	ctx := context.Background()
+	if action := p.OnRequestHeaders(ctx, &policy.RequestHeaderContext{SharedContext: shared}, nil); action != nil {
+		resp := action.(policy.ImmediateResponse)
+		return &resp, nil
+	}
+	action := p.OnResponseHeaders(ctx, &policy.ResponseHeaderContext{SharedContext: shared}, nil)
+	if action == nil {
+		return nil, nil
+	}
+	return nil, action.(policy.DownstreamResponseHeaderModifications).HeadersToSet
+}
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return loc
+}
+
+func TestQuotaEnforcedPerConsumer(t *testing.T) {
+	testutils.PinClock(t, &now, time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC))
+	p := newTestPolicy(t, "route-quota", map[string]interface{}{"limit": float64(2), "period": "day"})
+
+	for want := 1; want >= 0; want-- {
+		rejected, headers := call(p, sharedFor("alice"))
+		if rejected != nil {
+			t.Fatal("request within quota rejected")
+		}
+		if headers[headerLimit] != "2" || headers[headerRemaining] != strconv.Itoa(want) || headers[headerReset] != "43200" {
+			t.Errorf("headers = %v, want limit 2, remaining %d, reset 43200", headers, want)
+		}
+	}
+
+	rejected, _ := call(p, sharedFor("alice"))
+	if rejected == nil || rejected.StatusCode != 429 {
+		t.Fatalf("expected 429 once the quota is used up, got %v", rejected)
+	}
+	if rejected.Headers[headerRemaining] != "0" || rejected.Headers["retry-after"] != "43200" {
+		t.Errorf("rejection headers = %v, want remaining 0, retry-after 43200", rejected.Headers)
+	}
+
+	if rejected, _ := call(p, sharedFor("bob")); rejected != nil {
+		t.Error("other consumers keep their own quota")
+	}
+}
+
+func TestQuotaRollsOverAtPeriodBoundary(t *testing.T) {
+	colombo := mustLocation(t, "Asia/Colombo")
+	tests := []struct {
+		name   string
+		period string
+		last   time.Time // last instant of a period
+	}{
+		{"day", periodDay, time.Date(2026, time.October, 17, 23, 59, 59, 0, colombo)},
+		// 2026-10-18 is a Sunday
+		{"week", periodWeek, time.Date(2026, time.October, 18, 23, 59, 59, 0, colombo)},
+		{"month", periodMonth, time.Date(2026, time.February, 28, 23, 59, 59, 0, colombo)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := testutils.PinClock(t, &now, tt.last)
+			p := newTestPolicy(t, "route-rollover-"+tt.name, map[string]interface{}{
+				"limit": float64(1), "period": tt.period, "timezone": "Asia/Colombo",
+			})
+
+			if rejected, headers := call(p, sharedFor("alice")); rejected != nil || headers[headerReset] != "1" {
+				t.Fatalf("rejected = %v, headers = %v, want reset in 1s", rejected, headers)
+			}
+			if rejected, _ := call(p, sharedFor("alice")); rejected == nil {
+				t.Fatal("quota must be used up before the boundary")
+			}
+
+			*clock = clock.Add(time.Second)
+			if rejected, headers := call(p, sharedFor("alice")); rejected != nil || headers[headerRemaining] != "0" {
+				t.Fatalf("after the boundary: rejected = %v, headers = %v, want a fresh quota", rejected, headers)
+			}
+		})
+	}
+}
+
+func TestPeriodBoundsFollowTimezone(t *testing.T) {
+	newYork := mustLocation(t, "America/New_York")
+	colombo := mustLocation(t, "Asia/Colombo")
+
+	tests := []struct {
+		name      string
+		cfg       config
+		at        time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			// 20:00 UTC on the 17th is already the 18th in Colombo (UTC+5:30)
+			name:      "day starts at local midnight",
+			cfg:       config{period: periodDay, location: colombo},
+			at:        time.Date(2026, time.October, 17, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, time.October, 17, 18, 30, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.October, 18, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "utc day",
+			cfg:       config{period: periodDay, location: time.UTC},
+			at:        time.Date(2026, time.October, 17, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Clocks spring forward on 2026-03-08, so the day lasts 23 hours
+			name:      "daylight saving day",
+			cfg:       config{period: periodDay, location: newYork},
+			at:        time.Date(2026, time.March, 8, 12, 0, 0, 0, newYork),
+			wantStart: time.Date(2026, time.March, 8, 5, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.March, 9, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			// Sunday 2026-10-18 belongs to the week starting Monday the 12th
+			name:      "week starts on monday",
+			cfg:       config{period: periodWeek, location: newYork},
+			at:        time.Date(2026, time.October, 18, 23, 0, 0, 0, newYork),
+			wantStart: time.Date(2026, time.October, 12, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2026, time.October, 19, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:      "week across a month boundary",
+			cfg:       config{period: periodWeek, location: time.UTC},
+			at:        time.Date(2026, time.November, 1, 8, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, time.October, 26, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// 03:00 UTC on 1 December is still November in New York
+			name:      "month in local time",
+			cfg:       config{period: periodMonth, location: newYork},
+			at:        time.Date(2026, time.December, 1, 3, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, time.November, 1, 0, 0, 0, 0, newYork),
+			wantEnd:   time.Date(2026, time.December, 1, 0, 0, 0, 0, newYork),
+		},
+		{
+			name:      "month across a year boundary",
+			cfg:       config{period: periodMonth, location: time.UTC},
+			at:        time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.cfg.periodBounds(tt.at)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("periodBounds(%v) = [%v, %v), want [%v, %v)", tt.at, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestKeyedConsumer(t *testing.T) {
+	shared := &policy.SharedContext{
+		AuthContext: &policy.AuthContext{
+			Authenticated: true, AuthType: "jwt", Subject: "alice",
+			Previous: &policy.AuthContext{Authenticated: true, AuthType: "apikey", CredentialID: "app-1"},
+		},
+	}
+	downstream := &policy.DownstreamContext{ClientIP: "10.0.0.1"}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"", "subject:alice"},
+		{keyAPIKey, "credential:app-1"},
+		{keyJWTSubject, "subject:alice"},
+		{keyClientIP, "ip:10.0.0.1"},
+		{"header:x-tenant", unresolvedConsumer},
+	}
+	for _, tt := range tests {
+		params := map[string]interface{}{"limit": 1}
+		if tt.key != "" {
+			params["key"] = tt.key
+		}
+		cfg, err := parseConfig(params)
+		if err != nil {
+			t.Fatalf("parseConfig(%v) error = %v", params, err)
+		}
+		if got := (&QuotaPolicy{cfg: cfg}).consumer(shared, downstream); got != tt.want {
+			t.Errorf("key %q: consumer = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := parseConfig(map[string]interface{}{"limit": 100})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.limit != 100 || cfg.period != periodMonth || cfg.location != time.UTC || cfg.backend != backendMemory {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+
+	cfg, err = parseConfig(map[string]interface{}{"limit": 10, "period": "week", "timezone": "Europe/London"})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.period != periodWeek || cfg.location.String() != "Europe/London" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+
+	invalid := []map[string]interface{}{
+		{},
+		{"limit": 0},
+		{"limit": 1.5},
+		{"limit": 10, "period": "year"},
+		{"limit": 10, "timezone": "Mars/Olympus_Mons"},
+		{"limit": 10, "timezone": ""},
+		{"limit": 10, "key": "session"},
+		{"limit": 10, "key": "header:"},
+		{"limit": 10, "backend": "memcached"},
+		{"limit": 10, "backend": "redis"},
+		{"limit": 10, "backend": "redis", "redis": map[string]interface{}{"host": "redis", "port": 70000}},
+	}
+	for _, params := range invalid {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("parseConfig(%v) expected error", params)
+		}
+	}
+}
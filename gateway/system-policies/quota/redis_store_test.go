@@ -0,0 +1,100 @@
+package quota
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+func redisParams(addr string, limit int) map[string]interface{} {
+	host, port, _ := net.SplitHostPort(addr)
+	p, _ := strconv.Atoi(port)
+	return map[string]interface{}{
+		"limit":   float64(limit),
+		"period":  periodDay,
+		"backend": backendRedis,
+		"redis":   map[string]interface{}{"host": host, "port": int64(p)},
+	}
+}
+
+func TestRedisQuotaSharedAcrossReplicas(t *testing.T) {
+	clock := testutils.PinClock(t, &now, time.Date(2026, time.October, 17, 23, 0, 0, 0, time.UTC))
+	server := testutils.StartFakeRedis(t, func() time.Time { return now() })
+	params := redisParams(server.Addr, 2)
+
+	var replicas []*QuotaPolicy
+	for range 2 {
+		p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route-redis"}, params)
+		if err != nil {
+			t.Fatalf("GetPolicy() error = %v", err)
+		}
+		replicas = append(replicas, p.(*QuotaPolicy))
+	}
+
+	if rejected, headers := call(replicas[0], sharedFor("alice")); rejected != nil || headers[headerRemaining] != "1" {
+		t.Fatalf("replica 0: rejected = %v, headers = %v, want remaining 1", rejected, headers)
+	}
+	if rejected, headers := call(replicas[1], sharedFor("alice")); rejected != nil || headers[headerRemaining] != "0" {
+		t.Fatalf("replica 1: rejected = %v, headers = %v, want remaining 0", rejected, headers)
+	}
+	if rejected, _ := call(replicas[0], sharedFor("alice")); rejected == nil || rejected.StatusCode != 429 {
+		t.Fatalf("replica 0 must reject once the quota is used up, got %v", rejected)
+	}
+
+	periodEnd := time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+	expiries := server.Expiries()
+	if len(expiries) != 1 {
+		t.Fatalf("keys with expiry = %d, want 1", len(expiries))
+	}
+	for _, at := range expiries {
+		if !at.Equal(periodEnd.Add(redisExpiryGrace)) {
+			t.Errorf("counter expires at %v, want %v", at, periodEnd.Add(redisExpiryGrace))
+		}
+	}
+
+	*clock = periodEnd
+	if rejected, _ := call(replicas[1], sharedFor("alice")); rejected != nil {
+		t.Fatal("the quota must reset when the period ends")
+	}
+}
+
+func TestRedisKeysDoNotExposeConsumers(t *testing.T) {
+	store := &redisStore{route: "route-a"}
+	start := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	key := store.key("subject:alice@example.com", start)
+	if strings.Contains(key, "alice") || !strings.HasPrefix(key, redisKeyPrefix) {
+		t.Errorf("key = %q, want an opaque %s key", key, redisKeyPrefix)
+	}
+	if key == (&redisStore{route: "route-b"}).key("subject:alice@example.com", start) {
+		t.Error("routes must not share keys")
+	}
+	if key == store.key("subject:alice@example.com", start.AddDate(0, 1, 0)) {
+		t.Error("periods must not share keys")
+	}
+}
+
+func TestRedisUnavailableAllowsRequests(t *testing.T) {
+	testutils.PinClock(t, &now, time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC))
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route-redis-down"}, redisParams(addr, 1))
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	for range 2 {
+		if rejected, _ := call(p.(*QuotaPolicy), sharedFor("alice")); rejected != nil {
+			t.Fatal("requests must be allowed while Redis is unavailable")
+		}
+	}
+}
@@ -0,0 +1,541 @@
+package responsecache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/utils/cache"
+)
+
+const (
+	defaultTTL           = 5 * time.Minute
+	defaultMaxObjectSize = 1 << 20
+	defaultMaxEntries    = 1000
+
+	headerCache = "x-cache"
+
+	// keyMetadataKey carries the cache key of a cacheable request to the
+	// response phase, where the response is stored under it.
+	keyMetadataKey = "__response_cache_key"
+
+	// noStoreMetadataKey marks requests whose response must not be stored.
+	noStoreMetadataKey = "__response_cache_no_store"
+)
+
+var defaultCacheableStatusCodes = []int{200, 203, 204, 301, 404, 410}
+
+// unstoredHeaders are response headers that are not replayed from the cache:
+// hop-by-hop headers, per-client cookies and headers recomputed on every hit.
+var unstoredHeaders = map[string]bool{
+	"age":                 true,
+	"connection":          true,
+	"content-length":      true,
+	"date":                true,
+	"keep-alive":          true,
+	"proxy-authenticate":  true,
+	"proxy-authorization": true,
+	"set-cookie":          true,
+	"te":                  true,
+	"trailer":             true,
+	"transfer-encoding":   true,
+	"upgrade":             true,
+	headerCache:           true,
+}
+
+// notModifiedHeaders are the stored headers sent with a 304 response.
+var notModifiedHeaders = []string{"cache-control", "content-location", "etag", "expires", "last-modified", "vary"}
+
+// now is replaced in tests.
+var now = time.Now
+
+// caches holds the entries of each route. Policy instances are rebuilt
+// whenever the route's chain is updated, so the entries live here to survive
+// updates.
+var caches sync.Map // route name -> *routeCache
+
+// config is the parsed policy configuration.
+type config struct {
+	defaultTTL     time.Duration
+	maxObjectSize  int
+	maxEntries     int
+	cacheableCodes map[int]bool
+	varyHeaders    []string // lower-cased and sorted
+}
+
+type routeCache struct {
+	maxEntries int
+	entries    *cache.InMemoryCache[*cachedResponse]
+}
+
+// cachedResponse is a stored upstream response.
+type cachedResponse struct {
+	status       int
+	headers      map[string]string
+	body         []byte
+	etag         string
+	lastModified string
+	storedAt     time.Time
+	initialAge   time.Duration // age reported by the upstream when stored
+	expiresAt    time.Time
+	// vary holds the request values of the headers named in the upstream's
+	// Vary header; a request must carry the same values to be served.
+	vary map[string]string
+}
+
+// ResponseCachePolicy serves GET requests from a per-route response cache.
+type ResponseCachePolicy struct {
+	route string
+	cfg   config
+	store *routeCache
+}
+
+// GetPolicy creates a response cache bound to the route's shared entries. The
+// entries are dropped when maxEntries changes.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	s, loaded := caches.LoadOrStore(metadata.RouteName, newRouteCache(metadata.RouteName, cfg.maxEntries))
+	store := s.(*routeCache)
+	if loaded && store.maxEntries != cfg.maxEntries {
+		store = newRouteCache(metadata.RouteName, cfg.maxEntries)
+		caches.Store(metadata.RouteName, store)
+	}
+	return &ResponseCachePolicy{
+		route: metadata.RouteName,
+		cfg:   cfg,
+		store: store,
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+func newRouteCache(route string, maxEntries int) *routeCache {
+	// Entries expire individually, so the cache itself has no TTL. A nil
+	// logger keeps request paths out of the debug log.
+	return &routeCache{
+		maxEntries: maxEntries,
+		entries:    cache.NewInMemoryCache[*cachedResponse]("response-cache:"+route, maxEntries, 0, cache.LRUEvictionPolicy, nil),
+	}
+}
+
+// Mode returns the processing mode for this policy. Response bodies are
+// buffered so they can be stored.
+func (p *ResponseCachePolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeProcess,
+		ResponseBodyMode:   policy.BodyModeBuffer,
+	}
+}
+
+// OnRequestHeaders answers a GET request from the cache when a fresh entry
+// matches it.
+func (p *ResponseCachePolicy) OnRequestHeaders(ctx context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	if reqCtx.Method != http.MethodGet {
+		return nil
+	}
+	key := p.cacheKey(reqCtx.Method, reqCtx.Path, reqCtx.Headers)
+	setMetadata(reqCtx.SharedContext, keyMetadataKey, key)
+
+	cc := parseCacheControl(reqCtx.Headers)
+	if _, noStore := cc["no-store"]; noStore {
+		setMetadata(reqCtx.SharedContext, noStoreMetadataKey, true)
+		return nil
+	}
+	if _, noCache := cc["no-cache"]; noCache || strings.Contains(strings.ToLower(headerValue(reqCtx.Headers, "pragma")), "no-cache") {
+		return nil
+	}
+
+	cacheKey := cache.CacheKey{Key: key}
+	entry, ok := p.store.entries.Get(ctx, cacheKey)
+	if !ok {
+		return nil
+	}
+	t := now()
+	if !t.Before(entry.expiresAt) {
+		_ = p.store.entries.Delete(ctx, cacheKey)
+		return nil
+	}
+	if !entry.varyMatches(reqCtx.Headers) {
+		return nil
+	}
+
+	age := strconv.Itoa(int(math.Floor((entry.initialAge + t.Sub(entry.storedAt)).Seconds())))
+	if notModified(reqCtx.Headers, entry) {
+		headers := map[string]string{"age": age, headerCache: "HIT"}
+		for _, name := range notModifiedHeaders {
+			if v, ok := entry.headers[name]; ok {
+				headers[name] = v
+			}
+		}
+		return policy.ImmediateResponse{StatusCode: http.StatusNotModified, Headers: headers}
+	}
+
+	headers := make(map[string]string, len(entry.headers)+2)
+	for name, v := range entry.headers {
+		headers[name] = v
+	}
+	headers["age"] = age
+	headers[headerCache] = "HIT"
+	return policy.ImmediateResponse{
+		StatusCode: entry.status,
+		Headers:    headers,
+		Body:       entry.body,
+	}
+}
+
+// OnResponseBody stores a cacheable upstream response and marks it as a miss.
+func (p *ResponseCachePolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	key, ok := metadataString(respCtx.SharedContext, keyMetadataKey)
+	if !ok {
+		return nil
+	}
+	mods := policy.DownstreamResponseModifications{HeadersToSet: map[string]string{headerCache: "MISS"}}
+	if noStore, _ := respCtx.SharedContext.Metadata[noStoreMetadataKey].(bool); noStore {
+		return mods
+	}
+	if entry, ok := p.storable(respCtx, now()); ok {
+		_ = p.store.entries.Set(ctx, cache.CacheKey{Key: key}, entry)
+	}
+	return mods
+}
+
+// PolicyState reports the route's cache size and hit rate for the admin API
+// and metrics.
+func (p *ResponseCachePolicy) PolicyState() map[string]interface{} {
+	stats := p.store.entries.GetStats()
+	return map[string]interface{}{
+		"entries":    stats.Size,
+		"maxEntries": stats.MaxSize,
+		"hits":       stats.HitCount,
+		"misses":     stats.MissCount,
+		"evictions":  stats.EvictCount,
+	}
+}
+
+// storable builds the cache entry for a response, or reports false when the
+// response may not be cached.
+func (p *ResponseCachePolicy) storable(respCtx *policy.ResponseContext, t time.Time) (*cachedResponse, bool) {
+	if !p.cfg.cacheableCodes[respCtx.ResponseStatus] || respCtx.ResponseHeaders == nil {
+		return nil, false
+	}
+	headers := respCtx.ResponseHeaders
+	cc := parseCacheControl(headers)
+	for _, directive := range []string{"no-store", "no-cache", "private"} {
+		if _, ok := cc[directive]; ok {
+			return nil, false
+		}
+	}
+	if headers.Has("set-cookie") {
+		return nil, false
+	}
+
+	// A shared cache may only reuse an authorized response the upstream marked
+	// as shareable
+	_, public := cc["public"]
+	_, sMaxAge := cc["s-maxage"]
+	if respCtx.RequestHeaders != nil && respCtx.RequestHeaders.Has("authorization") && !public && !sMaxAge {
+		return nil, false
+	}
+
+	var body []byte
+	if respCtx.ResponseBody != nil {
+		body = respCtx.ResponseBody.Content
+	}
+	if len(body) > p.cfg.maxObjectSize {
+		return nil, false
+	}
+
+	vary := map[string]string{}
+	for _, name := range splitList(headerValue(headers, "vary")) {
+		if name == "*" {
+			return nil, false
+		}
+		vary[strings.ToLower(name)] = headerValue(respCtx.RequestHeaders, name)
+	}
+
+	ttl := p.freshness(cc, headers, t)
+	initialAge, _ := parseSeconds(headerValue(headers, "age"))
+	if ttl-initialAge <= 0 {
+		return nil, false
+	}
+
+	entry := &cachedResponse{
+		status:       respCtx.ResponseStatus,
+		headers:      map[string]string{},
+		body:         append([]byte(nil), body...),
+		etag:         headerValue(headers, "etag"),
+		lastModified: headerValue(headers, "last-modified"),
+		storedAt:     t,
+		initialAge:   initialAge,
+		expiresAt:    t.Add(ttl - initialAge),
+		vary:         vary,
+	}
+	headers.Iterate(func(name string, values []string) {
+		name = strings.ToLower(name)
+		if !unstoredHeaders[name] {
+			entry.headers[name] = strings.Join(values, ", ")
+		}
+	})
+	return entry, true
+}
+
+// freshness returns the lifetime of a response: s-maxage, then max-age, then
+// Expires relative to Date, then the configured default.
+func (p *ResponseCachePolicy) freshness(cc map[string]string, headers *policy.Headers, t time.Time) time.Duration {
+	for _, directive := range []string{"s-maxage", "max-age"} {
+		if v, ok := cc[directive]; ok {
+			d, err := parseSeconds(v)
+			if err != nil {
+				return 0
+			}
+			return d
+		}
+	}
+	if v := headerValue(headers, "expires"); v != "" {
+		expires, err := http.ParseTime(v)
+		if err != nil {
+			return 0
+		}
+		date := t
+		if d, err := http.ParseTime(headerValue(headers, "date")); err == nil {
+			date = d
+		}
+		return expires.Sub(date)
+	}
+	return p.cfg.defaultTTL
+}
+
+// cacheKey identifies a request by method, path and the values of the
+// configured vary headers.
+func (p *ResponseCachePolicy) cacheKey(method, path string, headers *policy.Headers) string {
+	var b strings.Builder
+	b.WriteString(method)
+	b.WriteByte(' ')
+	b.WriteString(path)
+	for _, name := range p.cfg.varyHeaders {
+		b.WriteByte('\n')
+		b.WriteString(name)
+		b.WriteByte(':')
+		b.WriteString(headerValue(headers, name))
+	}
+	return b.String()
+}
+
+// varyMatches reports whether a request carries the values of the headers the
+// upstream varied the stored response on.
+func (e *cachedResponse) varyMatches(headers *policy.Headers) bool {
+	for name, value := range e.vary {
+		if headerValue(headers, name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// notModified reports whether a conditional request is satisfied by the
+// cached entry. If-None-Match takes precedence over If-Modified-Since.
+func notModified(headers *policy.Headers, entry *cachedResponse) bool {
+	if inm := headerValue(headers, "if-none-match"); inm != "" {
+		if entry.etag == "" {
+			return false
+		}
+		if strings.TrimSpace(inm) == "*" {
+			return true
+		}
+		for _, tag := range splitList(inm) {
+			if weakETag(tag) == weakETag(entry.etag) {
+				return true
+			}
+		}
+		return false
+	}
+	ims := headerValue(headers, "if-modified-since")
+	if ims == "" || entry.lastModified == "" {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(entry.lastModified)
+	return err == nil && !modified.After(since)
+}
+
+// weakETag strips the weak validator prefix; If-None-Match uses weak comparison.
+func weakETag(tag string) string {
+	return strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+}
+
+// parseCacheControl returns the directives of the Cache-Control header with
+// lower-cased names and unquoted values.
+func parseCacheControl(headers *policy.Headers) map[string]string {
+	directives := map[string]string{}
+	for _, part := range splitList(headerValue(headers, "cache-control")) {
+		name, value, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return directives
+}
+
+func parseSeconds(v string) (time.Duration, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid seconds value %q", v)
+	}
+	return time.Duration(n) * time.Second, nil
+}
+
+// headerValue returns all values of a header joined by commas.
+func headerValue(headers *policy.Headers, name string) string {
+	if headers == nil {
+		return ""
+	}
+	return strings.Join(headers.Get(name), ", ")
+}
+
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseConfig reads defaultTTL, the maxObjectSize and maxEntries bounds, the
+// cacheableStatusCodes and the request headers the cache key varies on.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		defaultTTL:    defaultTTL,
+		maxObjectSize: defaultMaxObjectSize,
+		maxEntries:    defaultMaxEntries,
+	}
+
+	var err error
+	if v, ok := params["defaultTTL"]; ok {
+		if cfg.defaultTTL, err = toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid defaultTTL: %w", err)
+		}
+	}
+	if v, ok := params["maxObjectSize"]; ok {
+		if cfg.maxObjectSize, err = toInt(v); err != nil || cfg.maxObjectSize < 1 {
+			return cfg, fmt.Errorf("maxObjectSize must be a positive integer")
+		}
+	}
+	if v, ok := params["maxEntries"]; ok {
+		if cfg.maxEntries, err = toInt(v); err != nil || cfg.maxEntries < 1 {
+			return cfg, fmt.Errorf("maxEntries must be a positive integer")
+		}
+	}
+
+	codes := defaultCacheableStatusCodes
+	if v, ok := params["cacheableStatusCodes"]; ok {
+		list, ok := v.([]interface{})
+		if !ok || len(list) == 0 {
+			return cfg, fmt.Errorf("cacheableStatusCodes must be a list of HTTP status codes")
+		}
+		codes = make([]int, 0, len(list))
+		for _, item := range list {
+			code, err := toInt(item)
+			if err != nil || code < 200 || code > 599 {
+				return cfg, fmt.Errorf("cacheableStatusCodes must be a list of HTTP status codes")
+			}
+			codes = append(codes, code)
+		}
+	}
+	cfg.cacheableCodes = make(map[int]bool, len(codes))
+	for _, code := range codes {
+		cfg.cacheableCodes[code] = true
+	}
+
+	if v, ok := params["varyHeaders"]; ok {
+		list, ok := v.([]interface{})
+		if !ok {
+			return cfg, fmt.Errorf("varyHeaders must be a list of header names")
+		}
+		for _, item := range list {
+			name, ok := item.(string)
+			if !ok || strings.TrimSpace(name) == "" {
+				return cfg, fmt.Errorf("varyHeaders must be a list of header names")
+			}
+			cfg.varyHeaders = append(cfg.varyHeaders, strings.ToLower(strings.TrimSpace(name)))
+		}
+		sort.Strings(cfg.varyHeaders)
+	}
+	return cfg, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func toDuration(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected a duration string such as \"5m\"")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return d, nil
+}
+
+func setMetadata(shared *policy.SharedContext, key string, value interface{}) {
+	if shared == nil {
+		return
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = make(map[string]interface{})
+	}
+	shared.Metadata[key] = value
+}
+
+func metadataString(shared *policy.SharedContext, key string) (string, bool) {
+	if shared == nil || shared.Metadata == nil {
+		return "", false
+	}
+	s, ok := shared.Metadata[key].(string)
+	return s, ok
+}
@@ -0,0 +1,229 @@
+package responsecache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+// testStart is the time the clock is pinned to when a test starts.
+var testStart = time.Unix(1_700_000_000, 0)
+
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) *ResponseCachePolicy {
+	t.Helper()
+	caches.Delete(route)
+	t.Cleanup(func() { caches.Delete(route) })
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*ResponseCachePolicy)
+}
+
+type upstreamResponse struct {
+	status  int
+	headers map[string][]string
+	body    string
+}
+
+// roundTrip runs a request through the policy. It returns the response served
+// from the cache, or nil when the request reached the upstream, in which case
+// the upstream response passes through the response phase.
+func roundTrip(p *ResponseCachePolicy, method, path string, reqHeaders map[string][]string, upstream upstreamResponse) *policy.ImmediateResponse {
+	ctx := context.Background()
+	shared := &policy.SharedContext{Metadata: map[string]interface{}{}}
+	headers := policy.NewHeaders(reqHeaders)
+	action := p.OnRequestHeaders(ctx, &policy.RequestHeaderContext{
+		SharedContext: shared,
+		Headers:       headers,
+		Path:          path,
+		Method:        method,
+	}, nil)
+	if action != nil {
+		resp := action.(policy.ImmediateResponse)
+		return &resp
+	}
+	p.OnResponseBody(ctx, &policy.ResponseContext{
+		SharedContext:   shared,
+		RequestHeaders:  headers,
+		RequestPath:     path,
+		RequestMethod:   method,
+		ResponseHeaders: policy.NewHeaders(upstream.headers),
+		ResponseBody:    &policy.Body{Content: []byte(upstream.body), EndOfStream: true, Present: true},
+		ResponseStatus:  upstream.status,
+	}, nil)
+	return nil
+}
+
+func okResponse(body string, headers map[string][]string) upstreamResponse {
+	return upstreamResponse{status: 200, headers: headers, body: body}
+}
+
+func TestCacheHit(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-hit", map[string]interface{}{})
+	upstream := okResponse(`{"items":[]}`, map[string][]string{
+		"content-type":  {"application/json"},
+		"cache-control": {"max-age=60"},
+		"etag":          {`"v1"`},
+	})
+
+	if resp := roundTrip(p, "GET", "/items?page=1", nil, upstream); resp != nil {
+		t.Fatal("first request served from an empty cache")
+	}
+
+	*clock = clock.Add(10 * time.Second)
+	resp := roundTrip(p, "GET", "/items?page=1", nil, upstream)
+	if resp == nil {
+		t.Fatal("expected cache hit")
+	}
+	if resp.StatusCode != 200 || string(resp.Body) != `{"items":[]}` {
+		t.Errorf("hit = %d %q", resp.StatusCode, resp.Body)
+	}
+	if resp.Headers["content-type"] != "application/json" || resp.Headers["age"] != "10" || resp.Headers[headerCache] != "HIT" {
+		t.Errorf("hit headers = %v", resp.Headers)
+	}
+
+	// Another query string is another entry
+	if resp := roundTrip(p, "GET", "/items?page=2", nil, upstream); resp != nil {
+		t.Error("entry served for a different query string")
+	}
+
+	// The entry expires after max-age
+	*clock = clock.Add(50 * time.Second)
+	if resp := roundTrip(p, "GET", "/items?page=1", nil, upstream); resp != nil {
+		t.Error("expired entry served")
+	}
+}
+
+func TestConditionalRequestNotModified(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-conditional", map[string]interface{}{})
+	upstream := okResponse("hello", map[string][]string{
+		"etag":          {`W/"abc"`},
+		"last-modified": {"Tue, 14 Nov 2023 22:00:00 GMT"},
+	})
+	roundTrip(p, "GET", "/greeting", nil, upstream)
+
+	resp := roundTrip(p, "GET", "/greeting", map[string][]string{"if-none-match": {`"xyz", "abc"`}}, upstream)
+	if resp == nil || resp.StatusCode != 304 || len(resp.Body) != 0 || resp.Headers["etag"] != `W/"abc"` {
+		t.Fatalf("If-None-Match response = %+v, want 304 with the stored ETag", resp)
+	}
+
+	resp = roundTrip(p, "GET", "/greeting", map[string][]string{"if-modified-since": {"Wed, 15 Nov 2023 00:00:00 GMT"}}, upstream)
+	if resp == nil || resp.StatusCode != 304 {
+		t.Fatalf("If-Modified-Since response = %+v, want 304", resp)
+	}
+
+	// A non-matching validator gets the full cached response
+	resp = roundTrip(p, "GET", "/greeting", map[string][]string{"if-none-match": {`"other"`}}, upstream)
+	if resp == nil || resp.StatusCode != 200 || string(resp.Body) != "hello" {
+		t.Fatalf("non-matching If-None-Match response = %+v, want the cached 200", resp)
+	}
+}
+
+func TestVaryMiss(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-vary", map[string]interface{}{"varyHeaders": []interface{}{"Accept-Language"}})
+	english := map[string][]string{"accept-language": {"en"}}
+	french := map[string][]string{"accept-language": {"fr"}}
+
+	roundTrip(p, "GET", "/greeting", english, okResponse("hello", nil))
+	if resp := roundTrip(p, "GET", "/greeting", french, okResponse("bonjour", nil)); resp != nil {
+		t.Fatalf("entry for another Accept-Language served: %q", resp.Body)
+	}
+	if resp := roundTrip(p, "GET", "/greeting", english, upstreamResponse{}); resp == nil || string(resp.Body) != "hello" {
+		t.Errorf("english entry = %+v, want hello", resp)
+	}
+	if resp := roundTrip(p, "GET", "/greeting", french, upstreamResponse{}); resp == nil || string(resp.Body) != "bonjour" {
+		t.Errorf("french entry = %+v, want bonjour", resp)
+	}
+}
+
+func TestUpstreamVaryMiss(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-upstream-vary", map[string]interface{}{})
+	upstream := okResponse("gzipped", map[string][]string{"vary": {"Accept-Encoding"}})
+
+	roundTrip(p, "GET", "/doc", map[string][]string{"accept-encoding": {"gzip"}}, upstream)
+	if resp := roundTrip(p, "GET", "/doc", map[string][]string{"accept-encoding": {"identity"}}, upstream); resp != nil {
+		t.Error("entry served to a request with another Accept-Encoding")
+	}
+	// The entry is keyed by path only; the response to the last miss replaces it
+	if resp := roundTrip(p, "GET", "/doc", map[string][]string{"accept-encoding": {"identity"}}, upstream); resp == nil {
+		t.Error("expected cache hit for the same Accept-Encoding")
+	}
+}
+
+func TestNotCached(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		reqHeaders map[string][]string
+		upstream   upstreamResponse
+	}{
+		{name: "no-store response", method: "GET", upstream: okResponse("secret", map[string][]string{"cache-control": {"no-store"}})},
+		{name: "private response", method: "GET", upstream: okResponse("mine", map[string][]string{"cache-control": {"private, max-age=60"}})},
+		{name: "response setting a cookie", method: "GET", upstream: okResponse("hi", map[string][]string{"set-cookie": {"session=1"}})},
+		{name: "zero max-age", method: "GET", upstream: okResponse("hi", map[string][]string{"cache-control": {"max-age=0"}})},
+		{name: "vary on everything", method: "GET", upstream: okResponse("hi", map[string][]string{"vary": {"*"}})},
+		{name: "uncacheable status", method: "GET", upstream: upstreamResponse{status: 500, body: "boom"}},
+		{name: "object too large", method: "GET", upstream: okResponse("0123456789abcdef", nil)},
+		{name: "POST request", method: "POST", upstream: okResponse("created", nil)},
+		{name: "no-store request", method: "GET", reqHeaders: map[string][]string{"cache-control": {"no-store"}}, upstream: okResponse("hi", nil)},
+		{name: "authorized request", method: "GET", reqHeaders: map[string][]string{"authorization": {"Bearer token"}}, upstream: okResponse("hi", map[string][]string{"cache-control": {"max-age=60"}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutils.PinClock(t, &now, testStart)
+			p := newTestPolicy(t, "route-not-cached", map[string]interface{}{"maxObjectSize": float64(8)})
+			roundTrip(p, tt.method, "/resource", tt.reqHeaders, tt.upstream)
+			if resp := roundTrip(p, tt.method, "/resource", tt.reqHeaders, tt.upstream); resp != nil {
+				t.Errorf("response served from the cache: %d %q", resp.StatusCode, resp.Body)
+			}
+		})
+	}
+}
+
+func TestAuthorizedPublicResponseCached(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-public", map[string]interface{}{})
+	auth := map[string][]string{"authorization": {"Bearer token"}}
+
+	roundTrip(p, "GET", "/catalog", auth, okResponse("catalog", map[string][]string{"cache-control": {"public, max-age=60"}}))
+	if resp := roundTrip(p, "GET", "/catalog", auth, upstreamResponse{}); resp == nil {
+		t.Error("expected public response to be cached for authorized requests")
+	}
+}
+
+func TestRequestNoCacheBypassesLookup(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-no-cache", map[string]interface{}{})
+	roundTrip(p, "GET", "/items", nil, okResponse("v1", nil))
+
+	if resp := roundTrip(p, "GET", "/items", map[string][]string{"cache-control": {"no-cache"}}, okResponse("v2", nil)); resp != nil {
+		t.Fatal("no-cache request served from the cache")
+	}
+	// The refreshed response replaces the entry
+	if resp := roundTrip(p, "GET", "/items", nil, upstreamResponse{}); resp == nil || string(resp.Body) != "v2" {
+		t.Errorf("entry = %+v, want v2", resp)
+	}
+}
+
+func TestParseConfigRejectsInvalid(t *testing.T) {
+	for name, params := range map[string]map[string]interface{}{
+		"negative ttl":      {"defaultTTL": "-1s"},
+		"zero size":         {"maxObjectSize": float64(0)},
+		"bad status":        {"cacheableStatusCodes": []interface{}{float64(99)}},
+		"empty status list": {"cacheableStatusCodes": []interface{}{}},
+		"bad vary":          {"varyHeaders": []interface{}{""}},
+	} {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	defaultMaxAttempts = 3
+
+	// retryOn lists the Envoy retry conditions applied to eligible requests, in
+	// addition to the configured status codes.
+	retryOn = "connect-failure,refused-stream,reset,retriable-status-codes"
+
+	headerRetryOn          = "x-envoy-retry-on"
+	headerRetryGrpcOn      = "x-envoy-retry-grpc-on"
+	headerMaxRetries       = "x-envoy-max-retries"
+	headerRetriableCodes   = "x-envoy-retriable-status-codes"
+	headerRetriableHeaders = "x-envoy-retriable-header-names"
+	headerPerTryTimeout    = "x-envoy-upstream-rq-per-try-timeout-ms"
+	headerHedgeOnPerTry    = "x-envoy-hedge-on-per-try-timeout"
+	headerIdempotencyKey   = "idempotency-key"
+)
+
+var defaultRetryableStatusCodes = []int{502, 503, 504}
+
+// idempotentMethods are retried without an Idempotency-Key header.
+var idempotentMethods = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+	"PUT":     true,
+	"DELETE":  true,
+}
+
+// clientRetryHeaders are Envoy router headers that enable or tune retries. A
+// client must not be able to set them, so any the policy does not set itself
+// are removed from the request.
+var clientRetryHeaders = []string{
+	headerRetryOn,
+	headerRetryGrpcOn,
+	headerRetriableCodes,
+	headerRetriableHeaders,
+	headerPerTryTimeout,
+	headerHedgeOnPerTry,
+}
+
+// config is the parsed policy configuration. backoffBase is not used here; the
+// gateway controller reads it to configure the route's retry back-off.
+type config struct {
+	maxAttempts          int
+	perTryTimeout        time.Duration
+	retryableStatusCodes []int
+}
+
+// RetryPolicy asks Envoy to retry failed upstream calls of idempotent requests.
+type RetryPolicy struct {
+	cfg     config
+	headers map[string]string
+}
+
+// GetPolicy creates a retry policy from its parameters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	return &RetryPolicy{cfg: cfg, headers: retryHeaders(cfg)}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy.
+func (p *RetryPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+}
+
+// OnRequestHeaders enables retries for eligible requests and disables them for
+// all others.
+func (p *RetryPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	if !retryable(reqCtx) {
+		slog.Debug("Retry policy: request is not idempotent, retries disabled", "method", reqCtx.Method)
+		return policy.UpstreamRequestHeaderModifications{
+			HeadersToSet:    map[string]string{headerMaxRetries: "0"},
+			HeadersToRemove: clientRetryHeaders,
+		}
+	}
+
+	headers := make(map[string]string, len(p.headers))
+	for name, value := range p.headers {
+		headers[name] = value
+	}
+	var remove []string
+	for _, name := range clientRetryHeaders {
+		if _, ok := headers[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	return policy.UpstreamRequestHeaderModifications{
+		HeadersToSet:    headers,
+		HeadersToRemove: remove,
+	}
+}
+
+// retryable reports whether the request may safely be sent more than once.
+func retryable(reqCtx *policy.RequestHeaderContext) bool {
+	if idempotentMethods[strings.ToUpper(reqCtx.Method)] {
+		return true
+	}
+	for _, key := range reqCtx.Headers.Get(headerIdempotencyKey) {
+		if strings.TrimSpace(key) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// retryHeaders builds the Envoy router headers for an eligible request.
+func retryHeaders(cfg config) map[string]string {
+	codes := make([]string, len(cfg.retryableStatusCodes))
+	for i, code := range cfg.retryableStatusCodes {
+		codes[i] = strconv.Itoa(code)
+	}
+	headers := map[string]string{
+		headerRetryOn:    retryOn,
+		headerMaxRetries: strconv.Itoa(cfg.maxAttempts - 1),
+	}
+	if len(codes) > 0 {
+		headers[headerRetriableCodes] = strings.Join(codes, ",")
+	}
+	if cfg.perTryTimeout > 0 {
+		headers[headerPerTryTimeout] = strconv.FormatInt(cfg.perTryTimeout.Milliseconds(), 10)
+	}
+	return headers
+}
+
+// parseConfig reads maxAttempts, perTryTimeout and retryableStatusCodes. backoffBase is
+// only validated here; the gateway controller applies it to the route.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		maxAttempts:          defaultMaxAttempts,
+		retryableStatusCodes: defaultRetryableStatusCodes,
+	}
+
+	var err error
+	if v, ok := params["maxAttempts"]; ok {
+		if cfg.maxAttempts, err = toInt(v); err != nil || cfg.maxAttempts < 1 || cfg.maxAttempts > 10 {
+			return cfg, fmt.Errorf("maxAttempts must be an integer between 1 and 10")
+		}
+	}
+	if v, ok := params["perTryTimeout"]; ok {
+		if cfg.perTryTimeout, err = toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid perTryTimeout: %w", err)
+		}
+		if cfg.perTryTimeout < time.Millisecond {
+			return cfg, fmt.Errorf("perTryTimeout must be at least 1ms")
+		}
+	}
+	if v, ok := params["backoffBase"]; ok {
+		if _, err := toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid backoffBase: %w", err)
+		}
+	}
+	if v, ok := params["retryableStatusCodes"]; ok {
+		list, ok := v.([]interface{})
+		if !ok {
+			return cfg, fmt.Errorf("retryableStatusCodes must be a list of HTTP status codes")
+		}
+		cfg.retryableStatusCodes = make([]int, 0, len(list))
+		for _, item := range list {
+			code, err := toInt(item)
+			if err != nil || code < 100 || code > 599 {
+				return cfg, fmt.Errorf("retryableStatusCodes must be a list of HTTP status codes")
+			}
+			cfg.retryableStatusCodes = append(cfg.retryableStatusCodes, code)
+		}
+	}
+	return cfg, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func toDuration(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected a duration string such as \"2s\"")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return d, nil
+}
@@ -0,0 +1,125 @@
+package retry
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *RetryPolicy {
+	t.Helper()
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*RetryPolicy)
+}
+
+func onRequest(t *testing.T, p *RetryPolicy, method string, headers map[string][]string) policy.UpstreamRequestHeaderModifications {
+	t.Helper()
+	action := p.OnRequestHeaders(context.Background(), &policy.RequestHeaderContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Method:        method,
+		Headers:       policy.NewHeaders(headers),
+	}, nil)
+	mods, ok := action.(policy.UpstreamRequestHeaderModifications)
+	if !ok {
+		t.Fatalf("action = %T, want UpstreamRequestHeaderModifications", action)
+	}
+	return mods
+}
+
+func TestIdempotentMethodsAreRetried(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"maxAttempts":          float64(4),
+		"perTryTimeout":        "1500ms",
+		"retryableStatusCodes": []interface{}{float64(503), float64(504)},
+	})
+
+	for _, method := range []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE", "get"} {
+		mods := onRequest(t, p, method, nil)
+		want := map[string]string{
+			"x-envoy-retry-on":                       retryOn,
+			"x-envoy-max-retries":                    "3",
+			"x-envoy-retriable-status-codes":         "503,504",
+			"x-envoy-upstream-rq-per-try-timeout-ms": "1500",
+		}
+		if !reflect.DeepEqual(mods.HeadersToSet, want) {
+			t.Errorf("%s: HeadersToSet = %v, want %v", method, mods.HeadersToSet, want)
+		}
+	}
+}
+
+func TestPostWithoutIdempotencyKeyIsNeverRetried(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{})
+
+	// Retry headers sent by the client must not enable retries either
+	clientHeaders := map[string][]string{
+		"x-envoy-retry-on":               {"5xx"},
+		"x-envoy-retriable-status-codes": {"500"},
+	}
+	for _, headers := range []map[string][]string{nil, clientHeaders, {"idempotency-key": {"  "}}} {
+		mods := onRequest(t, p, "POST", headers)
+		if got := mods.HeadersToSet["x-envoy-max-retries"]; got != "0" {
+			t.Errorf("x-envoy-max-retries = %q, want %q", got, "0")
+		}
+		if _, ok := mods.HeadersToSet["x-envoy-retry-on"]; ok {
+			t.Error("x-envoy-retry-on must not be set")
+		}
+		if !reflect.DeepEqual(mods.HeadersToRemove, clientRetryHeaders) {
+			t.Errorf("HeadersToRemove = %v, want %v", mods.HeadersToRemove, clientRetryHeaders)
+		}
+	}
+
+	for _, method := range []string{"PATCH", "CONNECT"} {
+		if got := onRequest(t, p, method, nil).HeadersToSet["x-envoy-max-retries"]; got != "0" {
+			t.Errorf("%s: x-envoy-max-retries = %q, want %q", method, got, "0")
+		}
+	}
+}
+
+func TestPostWithIdempotencyKeyIsRetried(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{})
+
+	mods := onRequest(t, p, "POST", map[string][]string{"idempotency-key": {"8e03978e-40d5-43e8-bc93-6894a57f9324"}})
+	if got := mods.HeadersToSet["x-envoy-max-retries"]; got != "2" {
+		t.Errorf("x-envoy-max-retries = %q, want %q", got, "2")
+	}
+	if got := mods.HeadersToSet["x-envoy-retriable-status-codes"]; got != "502,503,504" {
+		t.Errorf("x-envoy-retriable-status-codes = %q, want default codes", got)
+	}
+
+	// Client headers the policy does not set are still stripped
+	removed := append([]string(nil), mods.HeadersToRemove...)
+	sort.Strings(removed)
+	want := []string{
+		"x-envoy-hedge-on-per-try-timeout",
+		"x-envoy-retriable-header-names",
+		"x-envoy-retry-grpc-on",
+		"x-envoy-upstream-rq-per-try-timeout-ms",
+	}
+	if !reflect.DeepEqual(removed, want) {
+		t.Errorf("HeadersToRemove = %v, want %v", removed, want)
+	}
+}
+
+func TestParseConfigInvalid(t *testing.T) {
+	invalid := []map[string]interface{}{
+		{"maxAttempts": 0},
+		{"maxAttempts": 11},
+		{"maxAttempts": "3"},
+		{"perTryTimeout": "fast"},
+		{"perTryTimeout": "100us"},
+		{"backoffBase": "0s"},
+		{"retryableStatusCodes": []interface{}{float64(42)}},
+		{"retryableStatusCodes": 503},
+	}
+	for _, params := range invalid {
+		if _, err := GetPolicy(policy.PolicyMetadata{}, params); err == nil {
+			t.Errorf("GetPolicy(%v) expected error", params)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/wso2/api-platform/gateway/system-policies/schema-validation
+
+go 1.26.5
+
+require (
+	github.com/wso2/api-platform/sdk/core v0.2.9
+	github.com/xeipuuv/gojsonschema v1.2.0
+)
+
+require (
+	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
+	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
+)
@@ -0,0 +1,289 @@
+package schemavalidation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	defaultMaxErrors = 10
+	maxErrorsLimit   = 100
+
+	// rootField is how gojsonschema names the document root.
+	rootField = "(root)"
+)
+
+// fieldError is one schema violation reported to the client.
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// config is the parsed policy configuration.
+type config struct {
+	requestSchema  *gojsonschema.Schema
+	responseSchema *gojsonschema.Schema
+	requireBody    bool
+	maxErrors      int
+}
+
+// SchemaValidationPolicy validates JSON request, and optionally response,
+// bodies against a JSON Schema.
+type SchemaValidationPolicy struct {
+	cfg config
+}
+
+// GetPolicy compiles the configured schemas. Compilation errors surface when
+// the policy chain is built rather than on the first request.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaValidationPolicy{cfg: cfg}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. The request body is
+// buffered by the policy engine before validation; the response body is only
+// buffered when a response schema is configured.
+func (p *SchemaValidationPolicy) Mode() policy.ProcessingMode {
+	mode := policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeBuffer,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+	if p.cfg.responseSchema != nil {
+		mode.ResponseBodyMode = policy.BodyModeBuffer
+	}
+	return mode
+}
+
+// OnRequestBody rejects request bodies that do not match the request schema
+// with 400 and the failing fields. Valid bodies are passed on unmodified.
+func (p *SchemaValidationPolicy) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	if p.cfg.requestSchema == nil {
+		return nil
+	}
+	var body []byte
+	if reqCtx.Body != nil && reqCtx.Body.Present {
+		body = reqCtx.Body.Content
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		if !p.cfg.requireBody {
+			return nil
+		}
+		return badRequest("Request body is required", nil)
+	}
+
+	errs, err := validate(p.cfg.requestSchema, body, p.cfg.maxErrors)
+	if err != nil {
+		return badRequest("Request body is not valid JSON", nil)
+	}
+	if len(errs) > 0 {
+		return badRequest("Request body failed schema validation", errs)
+	}
+	return nil
+}
+
+// OnResponseBody replaces successful upstream responses that do not match the
+// response schema with 502. The violations are not sent to the client, as they
+// describe the upstream's payload rather than the client's request.
+func (p *SchemaValidationPolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	if p.cfg.responseSchema == nil || respCtx.ResponseStatus < 200 || respCtx.ResponseStatus > 299 {
+		return nil
+	}
+	if respCtx.ResponseBody == nil || !respCtx.ResponseBody.Present || len(respCtx.ResponseBody.Content) == 0 {
+		return nil
+	}
+
+	errs, err := validate(p.cfg.responseSchema, respCtx.ResponseBody.Content, 1)
+	if err == nil && len(errs) == 0 {
+		return nil
+	}
+	status := http.StatusBadGateway
+	body, _ := json.Marshal(map[string]string{
+		"error":   "Bad Gateway",
+		"message": "Upstream response failed schema validation",
+	})
+	return policy.DownstreamResponseModifications{
+		StatusCode:      &status,
+		Body:            body,
+		HeadersToSet:    map[string]string{"content-type": "application/json"},
+		HeadersToRemove: []string{"content-length", "content-encoding", "etag"},
+	}
+}
+
+// validate checks body against schema and returns up to maxErrors violations.
+// An error is returned when body is not JSON.
+func validate(schema *gojsonschema.Schema, body []byte, maxErrors int) ([]fieldError, error) {
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(body))
+	if err != nil {
+		return nil, err
+	}
+	if result.Valid() {
+		return nil, nil
+	}
+	resultErrs := result.Errors()
+	errs := make([]fieldError, 0, min(len(resultErrs), maxErrors))
+	for _, e := range resultErrs {
+		if len(errs) == maxErrors {
+			break
+		}
+		errs = append(errs, fieldError{Field: fieldName(e), Message: e.Description()})
+	}
+	return errs, nil
+}
+
+// fieldName returns the dotted path of the field a violation refers to. A
+// missing required property is reported against the property itself rather
+// than its parent object.
+func fieldName(e gojsonschema.ResultError) string {
+	field := e.Field()
+	if field == rootField {
+		field = ""
+	}
+	if e.Type() == "required" {
+		if property, ok := e.Details()["property"].(string); ok {
+			if field == "" {
+				return property
+			}
+			return field + "." + property
+		}
+	}
+	return field
+}
+
+func badRequest(message string, errs []fieldError) policy.ImmediateResponse {
+	payload := map[string]interface{}{
+		"error":   "Bad Request",
+		"message": message,
+	}
+	if len(errs) > 0 {
+		payload["errors"] = errs
+	}
+	body, _ := json.Marshal(payload)
+	return policy.ImmediateResponse{
+		StatusCode: http.StatusBadRequest,
+		Headers:    map[string]string{"content-type": "application/json"},
+		Body:       body,
+	}
+}
+
+// parseConfig compiles requestSchema and responseSchema, at least one of which is
+// required, and reads requireBody and how many errors (maxErrors) a rejection lists.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{
+		requireBody: true,
+		maxErrors:   defaultMaxErrors,
+	}
+
+	var err error
+	if v, ok := params["requestSchema"]; ok {
+		if cfg.requestSchema, err = compileSchema(v); err != nil {
+			return cfg, fmt.Errorf("invalid requestSchema: %w", err)
+		}
+	}
+	if v, ok := params["responseSchema"]; ok {
+		if cfg.responseSchema, err = compileSchema(v); err != nil {
+			return cfg, fmt.Errorf("invalid responseSchema: %w", err)
+		}
+	}
+	if cfg.requestSchema == nil && cfg.responseSchema == nil {
+		return cfg, fmt.Errorf("at least one of requestSchema or responseSchema is required")
+	}
+	if v, ok := params["requireBody"]; ok {
+		if cfg.requireBody, ok = v.(bool); !ok {
+			return cfg, fmt.Errorf("requireBody must be a boolean")
+		}
+	}
+	if v, ok := params["maxErrors"]; ok {
+		if cfg.maxErrors, err = toInt(v); err != nil || cfg.maxErrors < 1 || cfg.maxErrors > maxErrorsLimit {
+			return cfg, fmt.Errorf("maxErrors must be an integer between 1 and %d", maxErrorsLimit)
+		}
+	}
+	return cfg, nil
+}
+
+// compileSchema compiles a schema given as a JSON object or as a JSON string.
+func compileSchema(v interface{}) (*gojsonschema.Schema, error) {
+	doc := v
+	if s, ok := v.(string); ok {
+		if err := json.Unmarshal([]byte(s), &doc); err != nil {
+			return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+		}
+	}
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("schema must be a JSON object")
+	}
+	// The gateway must not fetch schemas from the network while building a
+	// policy chain, so only references within the schema itself are allowed
+	if err := checkLocalRefs(doc); err != nil {
+		return nil, err
+	}
+	return gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc))
+}
+
+// checkLocalRefs rejects $ref values that point outside the schema document
+// and $id values that would rebase references onto another document.
+func checkLocalRefs(node interface{}) error {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, value := range n {
+			if s, ok := value.(string); ok {
+				if key == "$ref" && !strings.HasPrefix(s, "#") {
+					return fmt.Errorf("$ref %q must refer to a definition within the schema", s)
+				}
+				if (key == "$id" || key == "id") && s != "" && !strings.HasPrefix(s, "#") {
+					return fmt.Errorf("%s is not supported; use local references instead", key)
+				}
+				continue
+			}
+			if err := checkLocalRefs(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range n {
+			if err := checkLocalRefs(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
@@ -0,0 +1,184 @@
+package schemavalidation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const petSchema = `{
+	"type": "object",
+	"required": ["name", "owner"],
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0},
+		"owner": {
+			"type": "object",
+			"required": ["email"],
+			"properties": {"email": {"type": "string"}}
+		}
+	}
+}`
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *SchemaValidationPolicy {
+	t.Helper()
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: "route"}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*SchemaValidationPolicy)
+}
+
+func sendRequest(p *SchemaValidationPolicy, body string) policy.RequestAction {
+	return p.OnRequestBody(context.Background(), &policy.RequestContext{
+		SharedContext: &policy.SharedContext{Metadata: map[string]interface{}{}},
+		Headers:       policy.NewHeaders(map[string][]string{"content-type": {"application/json"}}),
+		Body:          &policy.Body{Content: []byte(body), EndOfStream: true, Present: body != ""},
+		Path:          "/pets",
+		Method:        "POST",
+	}, nil)
+}
+
+type errorBody struct {
+	Error   string       `json:"error"`
+	Message string       `json:"message"`
+	Errors  []fieldError `json:"errors"`
+}
+
+// rejection decodes a 400 response, failing the test for any other action.
+func rejection(t *testing.T, action policy.RequestAction) errorBody {
+	t.Helper()
+	resp, ok := action.(policy.ImmediateResponse)
+	if !ok {
+		t.Fatalf("action = %#v, want an ImmediateResponse", action)
+	}
+	if resp.StatusCode != 400 || resp.Headers["content-type"] != "application/json" {
+		t.Fatalf("response = %d %v, want 400 JSON", resp.StatusCode, resp.Headers)
+	}
+	var body errorBody
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		t.Fatalf("response body %q: %v", resp.Body, err)
+	}
+	return body
+}
+
+func TestValidBody(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema})
+	if action := sendRequest(p, `{"name":"Rex","age":3,"owner":{"email":"a@example.com"}}`); action != nil {
+		t.Errorf("valid body rejected: %#v", action)
+	}
+}
+
+func TestMissingRequiredField(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema})
+
+	body := rejection(t, sendRequest(p, `{"owner":{}}`))
+	if body.Error != "Bad Request" || body.Message != "Request body failed schema validation" {
+		t.Errorf("body = %+v", body)
+	}
+	fields := map[string]bool{}
+	for _, e := range body.Errors {
+		fields[e.Field] = true
+		if e.Message == "" {
+			t.Errorf("error for %q has no message", e.Field)
+		}
+	}
+	if len(fields) != 2 || !fields["name"] || !fields["owner.email"] {
+		t.Errorf("failing fields = %v, want name and owner.email", body.Errors)
+	}
+}
+
+func TestTypeMismatch(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema})
+
+	body := rejection(t, sendRequest(p, `{"name":"Rex","age":"three","owner":{"email":"a@example.com"}}`))
+	if len(body.Errors) != 1 || body.Errors[0].Field != "age" {
+		t.Errorf("errors = %+v, want a single error for age", body.Errors)
+	}
+}
+
+func TestInvalidOrMissingBody(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema})
+	if body := rejection(t, sendRequest(p, `{"name":`)); body.Message != "Request body is not valid JSON" || len(body.Errors) != 0 {
+		t.Errorf("malformed body = %+v", body)
+	}
+	if body := rejection(t, sendRequest(p, "")); body.Message != "Request body is required" {
+		t.Errorf("empty body = %+v", body)
+	}
+
+	optional := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema, "requireBody": false})
+	if action := sendRequest(optional, ""); action != nil {
+		t.Errorf("empty body rejected with requireBody false: %#v", action)
+	}
+}
+
+func TestMaxErrors(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": petSchema, "maxErrors": float64(1)})
+	if body := rejection(t, sendRequest(p, `{"age":-1,"owner":{}}`)); len(body.Errors) != 1 {
+		t.Errorf("errors = %+v, want 1", body.Errors)
+	}
+}
+
+func TestResponseValidation(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{
+		"responseSchema": map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"id"},
+		},
+	})
+	if p.Mode().ResponseBodyMode != policy.BodyModeBuffer {
+		t.Fatalf("response body mode = %s, want BUFFER", p.Mode().ResponseBodyMode)
+	}
+	if action := sendRequest(p, ""); action != nil {
+		t.Errorf("request rejected without a request schema: %#v", action)
+	}
+
+	respond := func(status int, body string) policy.ResponseAction {
+		return p.OnResponseBody(context.Background(), &policy.ResponseContext{
+			SharedContext:  &policy.SharedContext{Metadata: map[string]interface{}{}},
+			ResponseBody:   &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+			ResponseStatus: status,
+		}, nil)
+	}
+	if action := respond(200, `{"id":1}`); action != nil {
+		t.Errorf("valid response replaced: %#v", action)
+	}
+	if action := respond(404, `{"error":"not found"}`); action != nil {
+		t.Errorf("error response validated: %#v", action)
+	}
+	mods, ok := respond(200, `{"name":"x"}`).(policy.DownstreamResponseModifications)
+	if !ok || mods.StatusCode == nil || *mods.StatusCode != 502 {
+		t.Fatalf("invalid response action = %#v, want 502", mods)
+	}
+}
+
+func TestParseConfigRejectsInvalid(t *testing.T) {
+	for name, params := range map[string]map[string]interface{}{
+		"no schema":         {},
+		"malformed schema":  {"requestSchema": `{"type":`},
+		"non-object schema": {"requestSchema": []interface{}{}},
+		"unknown type":      {"requestSchema": `{"type":"pet"}`},
+		"remote ref":        {"requestSchema": `{"$ref":"http://169.254.169.254/schema.json"}`},
+		"file ref":          {"requestSchema": `{"properties":{"a":{"$ref":"file:///etc/passwd"}}}`},
+		"remote id":         {"requestSchema": `{"$id":"https://example.com/pet.json","type":"object"}`},
+		"bad requireBody":   {"requestSchema": petSchema, "requireBody": "yes"},
+		"zero maxErrors":    {"requestSchema": petSchema, "maxErrors": float64(0)},
+	} {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestLocalRefsAllowed(t *testing.T) {
+	p := newTestPolicy(t, map[string]interface{}{"requestSchema": `{
+		"definitions": {"name": {"type": "string"}},
+		"type": "object",
+		"properties": {"id": {"type": "integer"}, "name": {"$ref": "#/definitions/name"}}
+	}`})
+	if body := rejection(t, sendRequest(p, `{"name":1}`)); len(body.Errors) != 1 || body.Errors[0].Field != "name" {
+		t.Errorf("errors = %+v, want a single error for name", body.Errors)
+	}
+}
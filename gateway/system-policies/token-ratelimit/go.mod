@@ -0,0 +1,13 @@
+module github.com/wso2/api-platform/gateway/system-policies/token-ratelimit
+
+go 1.26.5
+
+require (
+	github.com/redis/go-redis/v9 v9.17.3
+	github.com/wso2/api-platform/sdk/core v0.2.9
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+)
@@ -0,0 +1,168 @@
+package tokenratelimit
+
+import (
+	"context"
+	"crypto/sha3"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultRedisPort = 6379
+
+	// redisKeyPrefix namespaces the counters in a Redis database shared with
+	// other policies.
+	redisKeyPrefix = "token-ratelimit:"
+
+	// redisTimeout bounds each Redis call so an unreachable server does not
+	// stall the request.
+	redisTimeout = 500 * time.Millisecond
+)
+
+// redisClients holds one client per Redis configuration. Policy instances are
+// rebuilt whenever the route's chain is updated, so sharing the clients keeps
+// their connection pools across updates.
+var redisClients sync.Map // redisConfig -> *redis.Client
+
+// redisConfig is the connection to the Redis server that keeps the counters
+// of the redis backend.
+type redisConfig struct {
+	host     string
+	port     int
+	username string
+	password string
+	database int
+	tls      bool
+}
+
+// redisStore is the counterStore of the redis backend. Every policy engine
+// replica connected to the same Redis server charges the same counters, so
+// budgets are enforced cluster-wide.
+//
+// Each consumer's window is one key, named after the window start, that is
+// incremented with INCRBY and given its expiry in the same MULTI/EXEC
+// transaction. Windows are aligned to the epoch, so replicas agree on the key
+// as long as their clocks do.
+//
+// Redis errors are logged and the request is allowed: an outage of the
+// counter store must not take the LLM routes down with it.
+type redisStore struct {
+	client *redis.Client
+	route  string
+}
+
+func newRedisStore(cfg redisConfig, route string) *redisStore {
+	c, ok := redisClients.Load(cfg)
+	if !ok {
+		opts := &redis.Options{
+			Addr:     net.JoinHostPort(cfg.host, strconv.Itoa(cfg.port)),
+			Username: cfg.username,
+			Password: cfg.password,
+			DB:       cfg.database,
+			Protocol: 2,
+		}
+		if cfg.tls {
+			opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS13, ServerName: cfg.host}
+		}
+		client := redis.NewClient(opts)
+		if c, ok = redisClients.LoadOrStore(cfg, client); ok {
+			client.Close()
+		}
+	}
+	return &redisStore{client: c.(*redis.Client), route: route}
+}
+
+func (s *redisStore) remaining(ctx context.Context, cfg config, consumer string, t time.Time) (int64, time.Duration) {
+	start := t.Truncate(cfg.window)
+	reset := start.Add(cfg.window).Sub(t)
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+	used, err := s.client.Get(ctx, s.key(consumer, start)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		slog.Warn("Token rate limit: failed to read counter from Redis, allowing request",
+			"route", s.route, "error", err)
+		return cfg.tokensPerWindow, reset
+	}
+	return cfg.tokensPerWindow - used, reset
+}
+
+func (s *redisStore) consume(ctx context.Context, cfg config, consumer string, tokens int64, t time.Time) (int64, time.Duration) {
+	start := t.Truncate(cfg.window)
+	reset := start.Add(cfg.window).Sub(t)
+	key := s.key(consumer, start)
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+	var used *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		used = pipe.IncrBy(ctx, key, tokens)
+		// The key outlives its window by one window length so a replica
+		// whose clock lags behind still finds it.
+		pipe.PExpireAt(ctx, key, start.Add(2*cfg.window))
+		return nil
+	})
+	if err != nil {
+		slog.Warn("Token rate limit: failed to charge tokens in Redis",
+			"route", s.route, "tokens", tokens, "error", err)
+		return cfg.tokensPerWindow - tokens, reset
+	}
+	return cfg.tokensPerWindow - used.Val(), reset
+}
+
+// key names the consumer's counter for the window starting at start. The
+// route and consumer are hashed so subjects and client addresses are not
+// stored in Redis.
+func (s *redisStore) key(consumer string, start time.Time) string {
+	sum := sha3.Sum256([]byte(s.route + "\x00" + consumer))
+	return redisKeyPrefix + hex.EncodeToString(sum[:]) + ":" + strconv.FormatInt(start.UnixMilli(), 10)
+}
+
+// parseRedisConfig reads the redis system parameter.
+func parseRedisConfig(v interface{}) (redisConfig, error) {
+	cfg := redisConfig{port: defaultRedisPort}
+	params, ok := v.(map[string]interface{})
+	if !ok {
+		return cfg, fmt.Errorf("redis settings are required by the redis backend")
+	}
+
+	if cfg.host, ok = params["host"].(string); !ok || cfg.host == "" {
+		return cfg, fmt.Errorf("host is required")
+	}
+	if v, ok := params["port"]; ok {
+		port, err := toInt(v)
+		if err != nil || port < 1 || port > 65535 {
+			return cfg, fmt.Errorf("port must be between 1 and 65535")
+		}
+		cfg.port = port
+	}
+	for name, field := range map[string]*string{"username": &cfg.username, "password": &cfg.password} {
+		if v, ok := params[name]; ok {
+			if *field, ok = v.(string); !ok {
+				return cfg, fmt.Errorf("%s must be a string", name)
+			}
+		}
+	}
+	if v, ok := params["database"]; ok {
+		database, err := toInt(v)
+		if err != nil || database < 0 {
+			return cfg, fmt.Errorf("database must be a non-negative integer")
+		}
+		cfg.database = database
+	}
+	if v, ok := params["tls"]; ok {
+		if cfg.tls, ok = v.(bool); !ok {
+			return cfg, fmt.Errorf("tls must be a boolean")
+		}
+	}
+	return cfg, nil
+}
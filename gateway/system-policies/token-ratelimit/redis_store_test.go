@@ -0,0 +1,193 @@
+package tokenratelimit
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+// newReplicas creates n instances of the policy on one route, as if served by
+// n policy engine replicas. Memory-backed instances share the process-wide
+// counters of the route.
+func newReplicas(t *testing.T, n int, route string, params map[string]interface{}) []*TokenRateLimitPolicy {
+	t.Helper()
+	replicas := []*TokenRateLimitPolicy{newTestPolicy(t, route, params)}
+	for len(replicas) < n {
+		p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+		if err != nil {
+			t.Fatalf("GetPolicy() error = %v", err)
+		}
+		replicas = append(replicas, p.(*TokenRateLimitPolicy))
+	}
+	return replicas
+}
+
+func redisParams(addr string, tokensPerWindow int) map[string]interface{} {
+	host, port, _ := net.SplitHostPort(addr)
+	p, _ := strconv.Atoi(port)
+	return map[string]interface{}{
+		"tokensPerWindow": float64(tokensPerWindow),
+		"window":          "1m",
+		"backend":         backendRedis,
+		"redis":           map[string]interface{}{"host": host, "port": int64(p)},
+	}
+}
+
+func TestRedisBudgetSharedAcrossReplicas(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	server := testutils.StartFakeRedis(t, func() time.Time { return now() })
+	params := redisParams(server.Addr, 20)
+	usage := `{"usage":{"total_tokens":12}}`
+
+	replicas := newReplicas(t, 2, "route-redis", params)
+	replicaA, replicaB := replicas[0], replicas[1]
+
+	if rejected, headers := roundTrip(replicaA, sharedFor("alice"), "", usage); rejected != nil || headers[headerRemaining] != "8" {
+		t.Fatalf("replica A: rejected = %v, headers = %v, want remaining 8", rejected, headers)
+	}
+	if rejected, headers := roundTrip(replicaB, sharedFor("alice"), "", usage); rejected != nil || headers[headerRemaining] != "0" {
+		t.Fatalf("replica B: rejected = %v, headers = %v, want remaining 0", rejected, headers)
+	}
+	if rejected, _ := roundTrip(replicaA, sharedFor("alice"), "", usage); rejected == nil || rejected.StatusCode != 429 {
+		t.Fatalf("replica A must reject once replica B used up the budget, got %v", rejected)
+	}
+	if rejected, _ := roundTrip(replicaB, sharedFor("bob"), "", usage); rejected != nil {
+		t.Fatal("other consumers keep their own budget")
+	}
+	if n := len(server.Keys()); n != 2 {
+		t.Errorf("keys = %d, want one per consumer and window", n)
+	}
+
+	*clock = clock.Add(time.Minute)
+	if rejected, headers := roundTrip(replicaB, sharedFor("alice"), "", usage); rejected != nil || headers[headerRemaining] != "8" {
+		t.Fatalf("after reset: rejected = %v, headers = %v, want remaining 8", rejected, headers)
+	}
+}
+
+func TestRedisKeysDoNotExposeConsumers(t *testing.T) {
+	store := &redisStore{route: "route-a"}
+	start := time.Unix(1_700_000_040, 0)
+
+	key := store.key("subject:alice@example.com", start)
+	if strings.Contains(key, "alice") || !strings.HasPrefix(key, redisKeyPrefix) {
+		t.Errorf("key = %q, want an opaque %s key", key, redisKeyPrefix)
+	}
+	if key == (&redisStore{route: "route-b"}).key("subject:alice@example.com", start) {
+		t.Error("routes must not share keys")
+	}
+	if key == store.key("subject:alice@example.com", start.Add(time.Minute)) {
+		t.Error("windows must not share keys")
+	}
+}
+
+// TestBudgetHoldsUnderConcurrentRequests sends requests from many goroutines
+// until the budget is used up. No charge may be lost, and once the budget is
+// used up every instance rejects the consumer.
+func TestBudgetHoldsUnderConcurrentRequests(t *testing.T) {
+	const (
+		limit      = 200
+		goroutines = 32
+	)
+	testutils.PinClock(t, &now, testStart)
+	server := testutils.StartFakeRedis(t, func() time.Time { return now() })
+
+	backends := map[string]map[string]interface{}{
+		backendMemory: {"tokensPerWindow": float64(limit)},
+		backendRedis:  redisParams(server.Addr, limit),
+	}
+	for name, params := range backends {
+		t.Run(name, func(t *testing.T) {
+			route := "route-concurrent-" + name
+			instances := newReplicas(t, 2, route, params)
+			usage := `{"usage":{"total_tokens":1}}`
+
+			var (
+				wg       sync.WaitGroup
+				mu       sync.Mutex
+				admitted int
+			)
+			for i := range goroutines {
+				wg.Add(1)
+				go func(p *TokenRateLimitPolicy) {
+					defer wg.Done()
+					for {
+						rejected, _ := roundTrip(p, sharedFor("alice"), "", usage)
+						if rejected != nil {
+							return
+						}
+						mu.Lock()
+						admitted++
+						mu.Unlock()
+					}
+				}(instances[i%len(instances)])
+			}
+			wg.Wait()
+
+			// A request is checked before its response is charged, so each
+			// goroutine may have one request in flight when the budget runs out.
+			if admitted < limit || admitted >= limit+goroutines {
+				t.Errorf("admitted = %d, want between %d and %d", admitted, limit, limit+goroutines-1)
+			}
+			for _, p := range instances {
+				remaining, _ := p.store.remaining(t.Context(), p.cfg, "subject:alice", now())
+				if remaining != int64(limit-admitted) {
+					t.Errorf("remaining = %d, want %d: every admitted request must be charged", remaining, limit-admitted)
+				}
+				if rejected, _ := roundTrip(p, sharedFor("alice"), "", usage); rejected == nil {
+					t.Error("a consumer over budget must be rejected by every instance")
+				}
+			}
+		})
+	}
+}
+
+func TestRedisUnavailableAllowsRequests(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	p := newTestPolicy(t, "route-redis-down", redisParams(addr, 20))
+	rejected, headers := roundTrip(p, sharedFor("alice"), "", `{"usage":{"total_tokens":12}}`)
+	if rejected != nil {
+		t.Fatal("requests must be allowed while Redis is unavailable")
+	}
+	if headers[headerLimit] != "20" {
+		t.Errorf("headers = %v, want limit 20", headers)
+	}
+}
+
+func TestParseRedisConfig(t *testing.T) {
+	cfg, err := parseConfig(map[string]interface{}{
+		"tokensPerWindow": 10,
+		"backend":         backendRedis,
+		"redis": map[string]interface{}{
+			"host":     "redis.internal",
+			"username": "gateway",
+			"password": "secret",
+			"database": int64(2),
+			"tls":      true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	want := redisConfig{host: "redis.internal", port: defaultRedisPort, username: "gateway", password: "secret", database: 2, tls: true}
+	if cfg.redis != want {
+		t.Errorf("redis = %+v, want %+v", cfg.redis, want)
+	}
+
+	// Redis settings are not needed by the memory backend.
+	if _, err := parseConfig(map[string]interface{}{"tokensPerWindow": 10, "backend": backendMemory}); err != nil {
+		t.Errorf("parseConfig() error = %v", err)
+	}
+}
@@ -0,0 +1,609 @@
+package tokenratelimit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	defaultWindow = time.Minute
+
+	// charsPerToken is the rough number of characters per token used to
+	// estimate the prompt size of a request.
+	charsPerToken = 4
+
+	headerLimit     = "x-ratelimit-limit-tokens"
+	headerRemaining = "x-ratelimit-remaining-tokens"
+	headerReset     = "x-ratelimit-reset-tokens"
+
+	// consumerMetadataKey carries the consumer resolved in the request phase
+	// to the response phase, where its usage is charged.
+	consumerMetadataKey = "__token_ratelimit_consumer"
+
+	// streamUsageMetadataKey holds the latest usage seen in a streamed
+	// response until the stream ends.
+	streamUsageMetadataKey = "__token_ratelimit_stream_usage"
+
+	// unresolvedConsumer shares one budget among the requests that lack the
+	// identity selected by the key parameter, so leaving it out does not earn
+	// a fresh budget.
+	unresolvedConsumer = "unresolved"
+)
+
+// Consumer identities accepted by the key parameter.
+const (
+	keyAPIKey       = "api_key"
+	keyJWTSubject   = "jwt_sub"
+	keyClientIP     = "client_ip"
+	keyHeaderPrefix = "header:"
+)
+
+// Counter backends accepted by the backend system parameter.
+const (
+	backendMemory = "memory"
+	backendRedis  = "redis"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// budgets holds the in-memory token counters of each route. Policy instances
+// are rebuilt whenever the route's chain is updated, so the counters live here
+// to survive updates.
+var budgets sync.Map // route name -> *budgetStore
+
+// config is the parsed policy configuration.
+type config struct {
+	tokensPerWindow       int64
+	window                time.Duration
+	estimateRequestTokens bool
+	// key selects the consumer identity; empty resolves it automatically.
+	key string
+	// header is the lower-cased header name of a "header:<name>" key.
+	header string
+	// backend selects where the counters are kept.
+	backend string
+	redis   redisConfig
+}
+
+// counterStore counts the tokens used by each consumer of a route in the
+// current fixed window.
+type counterStore interface {
+	// remaining returns the consumer's remaining tokens and the time until
+	// the window resets.
+	remaining(ctx context.Context, cfg config, consumer string, t time.Time) (int64, time.Duration)
+	// consume charges tokens to the consumer and returns the remaining
+	// tokens and the time until the window resets. A response may use more
+	// tokens than were left, so the remaining budget can drop below zero.
+	consume(ctx context.Context, cfg config, consumer string, tokens int64, t time.Time) (int64, time.Duration)
+}
+
+// budgetStore is the in-memory counterStore. Its counters are local to one
+// policy engine instance.
+type budgetStore struct {
+	mu        sync.Mutex
+	windows   map[string]*usageWindow // consumer -> usage
+	lastSweep time.Time
+}
+
+type usageWindow struct {
+	start time.Time
+	used  int64
+}
+
+// TokenRateLimitPolicy enforces per-consumer token budgets on LLM traffic.
+type TokenRateLimitPolicy struct {
+	route string
+	cfg   config
+	store counterStore
+}
+
+// GetPolicy creates a token rate limit bound to the route's shared counters.
+func GetPolicy(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	cfg, err := parseConfig(params)
+	if err != nil {
+		return nil, err
+	}
+	var store counterStore
+	if cfg.backend == backendRedis {
+		store = newRedisStore(cfg.redis, metadata.RouteName)
+	} else {
+		s, _ := budgets.LoadOrStore(metadata.RouteName, &budgetStore{windows: map[string]*usageWindow{}})
+		store = s.(*budgetStore)
+	}
+	return &TokenRateLimitPolicy{
+		route: metadata.RouteName,
+		cfg:   cfg,
+		store: store,
+	}, nil
+}
+
+// GetPolicyV2 is an alias for GetPolicy, provided for compatibility with the
+// Builder-generated plugin registry which calls GetPolicyV2 on all plugins.
+func GetPolicyV2(
+	metadata policy.PolicyMetadata,
+	params map[string]interface{},
+) (policy.Policy, error) {
+	return GetPolicy(metadata, params)
+}
+
+// Mode returns the processing mode for this policy. The request body is only
+// buffered when request tokens are estimated. Response bodies are streamed so
+// streamed completions are not buffered.
+func (p *TokenRateLimitPolicy) Mode() policy.ProcessingMode {
+	requestBodyMode := policy.BodyModeSkip
+	if p.cfg.estimateRequestTokens {
+		requestBodyMode = policy.BodyModeBuffer
+	}
+	return policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    requestBodyMode,
+		ResponseHeaderMode: policy.HeaderModeProcess,
+		ResponseBodyMode:   policy.BodyModeStream,
+	}
+}
+
+// OnRequestHeaders rejects the request when the consumer's budget is used up.
+func (p *TokenRateLimitPolicy) OnRequestHeaders(ctx context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	consumer := p.consumer(reqCtx.SharedContext, reqCtx.Downstream)
+	setMetadata(reqCtx.SharedContext, consumerMetadataKey, consumer)
+
+	remaining, reset := p.store.remaining(ctx, p.cfg, consumer, now())
+	if remaining > 0 {
+		return nil
+	}
+	slog.Debug("Token rate limit: budget exhausted", "route", p.route)
+	return p.reject(remaining, reset)
+}
+
+// OnRequestBody rejects the request when its estimated prompt tokens exceed
+// the consumer's remaining budget.
+func (p *TokenRateLimitPolicy) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	if !p.cfg.estimateRequestTokens || reqCtx.Body == nil || len(reqCtx.Body.Content) == 0 {
+		return nil
+	}
+	consumer, ok := metadataString(reqCtx.SharedContext, consumerMetadataKey)
+	if !ok {
+		consumer = p.consumer(reqCtx.SharedContext, reqCtx.Downstream)
+	}
+
+	estimate := estimateTokens(reqCtx.Body.Content)
+	remaining, reset := p.store.remaining(ctx, p.cfg, consumer, now())
+	if estimate <= remaining {
+		return nil
+	}
+	slog.Debug("Token rate limit: estimated request tokens exceed budget",
+		"route", p.route, "estimate", estimate, "remaining", remaining)
+	return p.reject(remaining, reset)
+}
+
+// OnResponseBody charges the tokens reported by the upstream to the consumer
+// and reports the remaining budget.
+func (p *TokenRateLimitPolicy) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, _ map[string]interface{}) policy.ResponseAction {
+	consumer, ok := metadataString(respCtx.SharedContext, consumerMetadataKey)
+	if !ok {
+		consumer = p.consumer(respCtx.SharedContext, respCtx.Downstream)
+	}
+
+	var used int64
+	if respCtx.ResponseBody != nil {
+		used = usageTokens(respCtx.ResponseBody.Content)
+		if used == 0 {
+			// An event stream buffered because another policy needs the full body
+			used = streamUsageTokens(respCtx.ResponseBody.Content)
+		}
+	}
+	remaining, reset := p.store.consume(ctx, p.cfg, consumer, used, now())
+	return policy.DownstreamResponseModifications{
+		HeadersToSet: p.budgetHeaders(remaining, reset),
+	}
+}
+
+// OnResponseHeaders reports the remaining budget on server-sent event streams,
+// whose headers are sent before the usage of the response is known.
+func (p *TokenRateLimitPolicy) OnResponseHeaders(ctx context.Context, respCtx *policy.ResponseHeaderContext, _ map[string]interface{}) policy.ResponseHeaderAction {
+	if !isEventStream(respCtx.ResponseHeaders) {
+		return nil
+	}
+	consumer, ok := metadataString(respCtx.SharedContext, consumerMetadataKey)
+	if !ok {
+		consumer = p.consumer(respCtx.SharedContext, respCtx.Downstream)
+	}
+	remaining, reset := p.store.remaining(ctx, p.cfg, consumer, now())
+	return policy.DownstreamResponseHeaderModifications{
+		HeadersToSet: p.budgetHeaders(remaining, reset),
+	}
+}
+
+// OnResponseBodyChunk reads the token usage of a streamed completion and
+// charges it to the consumer when the stream ends. OpenAI-compatible streams
+// report usage in one of the final events.
+func (p *TokenRateLimitPolicy) OnResponseBodyChunk(ctx context.Context, respCtx *policy.ResponseStreamContext, chunk *policy.StreamBody, _ map[string]interface{}) policy.StreamingResponseAction {
+	var used int64
+	if respCtx.SharedContext != nil && respCtx.Metadata != nil {
+		used, _ = respCtx.Metadata[streamUsageMetadataKey].(int64)
+	}
+	if tokens := streamUsageTokens(chunk.Chunk); tokens > 0 {
+		used = tokens
+		setMetadata(respCtx.SharedContext, streamUsageMetadataKey, used)
+	}
+	if !chunk.EndOfStream {
+		return policy.ForwardResponseChunk{}
+	}
+
+	consumer, ok := metadataString(respCtx.SharedContext, consumerMetadataKey)
+	if !ok {
+		consumer = p.consumer(respCtx.SharedContext, respCtx.Downstream)
+	}
+	p.store.consume(ctx, p.cfg, consumer, used, now())
+	return policy.ForwardResponseChunk{}
+}
+
+// NeedsMoreResponseData never holds chunks back; the policy engine already
+// delivers server-sent events whole.
+func (p *TokenRateLimitPolicy) NeedsMoreResponseData([]byte) bool {
+	return false
+}
+
+func (p *TokenRateLimitPolicy) reject(remaining int64, reset time.Duration) policy.ImmediateResponse {
+	headers := p.budgetHeaders(remaining, reset)
+	headers["content-type"] = "application/json"
+	headers["retry-after"] = headers[headerReset]
+	body, _ := json.Marshal(map[string]string{
+		"error":   "Too Many Requests",
+		"message": "Token limit exceeded",
+	})
+	return policy.ImmediateResponse{
+		StatusCode: 429,
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+func (p *TokenRateLimitPolicy) budgetHeaders(remaining int64, reset time.Duration) map[string]string {
+	if remaining < 0 {
+		remaining = 0
+	}
+	return map[string]string{
+		headerLimit:     strconv.FormatInt(p.cfg.tokensPerWindow, 10),
+		headerRemaining: strconv.FormatInt(remaining, 10),
+		headerReset:     strconv.Itoa(int(math.Ceil(reset.Seconds()))),
+	}
+}
+
+func (s *budgetStore) remaining(_ context.Context, cfg config, consumer string, t time.Time) (int64, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := s.windowLocked(cfg, consumer, t)
+	return cfg.tokensPerWindow - w.used, w.start.Add(cfg.window).Sub(t)
+}
+
+func (s *budgetStore) consume(_ context.Context, cfg config, consumer string, tokens int64, t time.Time) (int64, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := s.windowLocked(cfg, consumer, t)
+	w.used += tokens
+	return cfg.tokensPerWindow - w.used, w.start.Add(cfg.window).Sub(t)
+}
+
+// windowLocked returns the consumer's window for t, starting a new one when
+// the previous window has ended. Ended windows of other consumers are dropped
+// at most once per window length.
+func (s *budgetStore) windowLocked(cfg config, consumer string, t time.Time) *usageWindow {
+	start := t.Truncate(cfg.window)
+	if t.Sub(s.lastSweep) >= cfg.window {
+		for key, w := range s.windows {
+			if w.start.Before(start) {
+				delete(s.windows, key)
+			}
+		}
+		s.lastSweep = t
+	}
+
+	w, ok := s.windows[consumer]
+	if !ok || w.start.Before(start) {
+		w = &usageWindow{start: start}
+		s.windows[consumer] = w
+	}
+	return w
+}
+
+// consumer identifies who the tokens are charged to. Without a key parameter
+// the identity is resolved by consumerKey; otherwise only the selected identity
+// is used, and requests without it share the unresolved budget.
+func (p *TokenRateLimitPolicy) consumer(shared *policy.SharedContext, downstream *policy.DownstreamContext) string {
+	switch p.cfg.key {
+	case "":
+		return consumerKey(shared, downstream)
+	case keyAPIKey:
+		if auth := authLayer(shared, isAPIKeyAuth); auth != nil {
+			if auth.CredentialID != "" {
+				return "credential:" + auth.CredentialID
+			}
+			if auth.Subject != "" {
+				return "subject:" + auth.Subject
+			}
+		}
+	case keyJWTSubject:
+		if auth := authLayer(shared, isJWTAuth); auth != nil && auth.Subject != "" {
+			return "subject:" + auth.Subject
+		}
+	case keyClientIP:
+		if downstream != nil && downstream.ClientIP != "" {
+			return "ip:" + downstream.ClientIP
+		}
+	default:
+		if downstream != nil && downstream.Request != nil {
+			if values := downstream.Request.Headers.Get(p.cfg.header); len(values) > 0 && values[0] != "" {
+				// The header may carry a credential; keep only its digest
+				sum := sha3.Sum256([]byte(values[0]))
+				return "header:" + hex.EncodeToString(sum[:])
+			}
+		}
+	}
+	return unresolvedConsumer
+}
+
+// authLayer returns the most recent authenticated layer of the auth chain
+// accepted by match.
+func authLayer(shared *policy.SharedContext, match func(authType string) bool) *policy.AuthContext {
+	if shared == nil {
+		return nil
+	}
+	for auth := shared.AuthContext; auth != nil; auth = auth.Previous {
+		if auth.Authenticated && match(auth.AuthType) {
+			return auth
+		}
+	}
+	return nil
+}
+
+func isAPIKeyAuth(authType string) bool {
+	return authType == "apikey" || authType == "api-key"
+}
+
+func isJWTAuth(authType string) bool {
+	return authType == "jwt" || strings.HasPrefix(authType, "mcp/oauth")
+}
+
+// consumerKey identifies who the tokens are charged to: the authenticated
+// credential, then the authenticated subject, then the client IP.
+func consumerKey(shared *policy.SharedContext, downstream *policy.DownstreamContext) string {
+	if shared != nil && shared.AuthContext != nil && shared.AuthContext.Authenticated {
+		if id := shared.AuthContext.CredentialID; id != "" {
+			return "credential:" + id
+		}
+		if sub := shared.AuthContext.Subject; sub != "" {
+			return "subject:" + sub
+		}
+	}
+	if downstream != nil && downstream.ClientIP != "" {
+		return "ip:" + downstream.ClientIP
+	}
+	return "anonymous"
+}
+
+// usageTokens reads the token usage of an OpenAI-compatible response.
+func usageTokens(body []byte) int64 {
+	var resp struct {
+		Usage *struct {
+			TotalTokens      int64 `json:"total_tokens"`
+			PromptTokens     int64 `json:"prompt_tokens"`
+			CompletionTokens int64 `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Usage == nil {
+		return 0
+	}
+	if resp.Usage.TotalTokens > 0 {
+		return resp.Usage.TotalTokens
+	}
+	return max(resp.Usage.PromptTokens, 0) + max(resp.Usage.CompletionTokens, 0)
+}
+
+// streamUsageTokens returns the token usage reported by the last event with a
+// usage object in a block of server-sent events, or 0 when there is none.
+func streamUsageTokens(data []byte) int64 {
+	var used int64
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		payload, ok := bytes.CutPrefix(bytes.TrimRight(line, "\r"), []byte("data:"))
+		if !ok {
+			continue
+		}
+		payload = bytes.TrimSpace(payload)
+		if len(payload) == 0 || payload[0] != '{' {
+			continue
+		}
+		if tokens := usageTokens(payload); tokens > 0 {
+			used = tokens
+		}
+	}
+	return used
+}
+
+func isEventStream(headers *policy.Headers) bool {
+	if headers == nil {
+		return false
+	}
+	values := headers.Get("content-type")
+	return len(values) > 0 && strings.HasPrefix(strings.ToLower(values[0]), "text/event-stream")
+}
+
+// estimateTokens approximates the prompt tokens of an OpenAI-compatible
+// request from the length of its text. Bodies that are not JSON are estimated
+// from their size.
+func estimateTokens(body []byte) int64 {
+	var req struct {
+		Messages []struct {
+			Content json.RawMessage `json:"content"`
+		} `json:"messages"`
+		Prompt json.RawMessage `json:"prompt"`
+		Input  json.RawMessage `json:"input"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return ceilDiv(int64(len(body)), charsPerToken)
+	}
+
+	var chars int64
+	for _, m := range req.Messages {
+		chars += textLength(m.Content)
+	}
+	chars += textLength(req.Prompt) + textLength(req.Input)
+	return ceilDiv(chars, charsPerToken)
+}
+
+// textLength returns the number of characters of text in a content value: a
+// string, a list of strings, or a list of content parts with a "text" field.
+func textLength(raw json.RawMessage) int64 {
+	if len(raw) == 0 {
+		return 0
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return int64(len([]rune(s)))
+	}
+	var parts []json.RawMessage
+	if json.Unmarshal(raw, &parts) != nil {
+		return 0
+	}
+	var n int64
+	for _, part := range parts {
+		var obj struct {
+			Text string `json:"text"`
+		}
+		if json.Unmarshal(part, &obj) == nil {
+			n += int64(len([]rune(obj.Text)))
+			continue
+		}
+		n += textLength(part)
+	}
+	return n
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// parseConfig reads tokensPerWindow, which is required, and the optional window,
+// estimateRequestTokens, key and backend. Redis settings are only read for the redis backend.
+func parseConfig(params map[string]interface{}) (config, error) {
+	cfg := config{window: defaultWindow, backend: backendMemory}
+
+	v, ok := params["tokensPerWindow"]
+	if !ok {
+		return cfg, fmt.Errorf("tokensPerWindow is required")
+	}
+	n, err := toInt(v)
+	if err != nil || n < 1 {
+		return cfg, fmt.Errorf("tokensPerWindow must be a positive integer")
+	}
+	cfg.tokensPerWindow = int64(n)
+
+	if v, ok := params["window"]; ok {
+		if cfg.window, err = toDuration(v); err != nil {
+			return cfg, fmt.Errorf("invalid window: %w", err)
+		}
+	}
+	if v, ok := params["estimateRequestTokens"]; ok {
+		if cfg.estimateRequestTokens, ok = v.(bool); !ok {
+			return cfg, fmt.Errorf("estimateRequestTokens must be a boolean")
+		}
+	}
+	if v, ok := params["key"]; ok {
+		key, ok := v.(string)
+		if !ok {
+			return cfg, fmt.Errorf("key must be a string")
+		}
+		switch {
+		case key == keyAPIKey, key == keyJWTSubject, key == keyClientIP:
+			cfg.key = key
+		case strings.HasPrefix(key, keyHeaderPrefix):
+			cfg.header = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(key, keyHeaderPrefix)))
+			if cfg.header == "" {
+				return cfg, fmt.Errorf("key %q must name a header", key)
+			}
+			cfg.key = key
+		default:
+			return cfg, fmt.Errorf("key must be api_key, jwt_sub, client_ip or header:<name>, got %q", key)
+		}
+	}
+	if v, ok := params["backend"]; ok {
+		backend, ok := v.(string)
+		if !ok || (backend != backendMemory && backend != backendRedis) {
+			return cfg, fmt.Errorf("backend must be memory or redis")
+		}
+		cfg.backend = backend
+	}
+	if cfg.backend == backendRedis {
+		if cfg.redis, err = parseRedisConfig(params["redis"]); err != nil {
+			return cfg, fmt.Errorf("invalid redis configuration: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("not an integer: %v", n)
+		}
+		return int(n), nil
+	default:
+		return 0, fmt.Errorf("not an integer: %v", v)
+	}
+}
+
+func toDuration(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected a duration string such as \"1m\"")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return d, nil
+}
+
+func setMetadata(shared *policy.SharedContext, key string, value interface{}) {
+	if shared == nil {
+		return
+	}
+	if shared.Metadata == nil {
+		shared.Metadata = make(map[string]interface{})
+	}
+	shared.Metadata[key] = value
+}
+
+func metadataString(shared *policy.SharedContext, key string) (string, bool) {
+	if shared == nil || shared.Metadata == nil {
+		return "", false
+	}
+	s, ok := shared.Metadata[key].(string)
+	return s, ok
+}
@@ -0,0 +1,345 @@
+package tokenratelimit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+// testStart is the time the clock is pinned to when a test starts.
+var testStart = time.Unix(1_700_000_040, 0)
+
+func newTestPolicy(t *testing.T, route string, params map[string]interface{}) *TokenRateLimitPolicy {
+	t.Helper()
+	budgets.Delete(route)
+	t.Cleanup(func() { budgets.Delete(route) })
+	p, err := GetPolicy(policy.PolicyMetadata{RouteName: route}, params)
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	return p.(*TokenRateLimitPolicy)
+}
+
+func sharedFor(subject string) *policy.SharedContext {
+	return &policy.SharedContext{
+		Metadata:    map[string]interface{}{},
+		AuthContext: &policy.AuthContext{Authenticated: true, Subject: subject},
+	}
+}
+
+// roundTrip runs one request through the policy. It returns the immediate
+// response when the request was rejected, otherwise the headers set on the
+// response carrying responseBody.
+func roundTrip(p *TokenRateLimitPolicy, shared *policy.SharedContext, requestBody, responseBody string) (*policy.ImmediateResponse, map[string]string) {
+	ctx := context.Background()
+	if action := p.OnRequestHeaders(ctx, &policy.RequestHeaderContext{SharedContext: shared}, nil); action != nil {
+		resp := action.(policy.ImmediateResponse)
+		return &resp, nil
+	}
+	if p.cfg.estimateRequestTokens {
+		body := &policy.Body{Content: []byte(requestBody), EndOfStream: true, Present: true}
+		if action := p.OnRequestBody(ctx, &policy.RequestContext{SharedContext: shared, Body: body}, nil); action != nil {
+			resp := action.(policy.ImmediateResponse)
+			return &resp, nil
+		}
+	}
+	action := p.OnResponseBody(ctx, &policy.ResponseContext{
+		SharedContext:  shared,
+		ResponseBody:   &policy.Body{Content: []byte(responseBody), EndOfStream: true, Present: true},
+		ResponseStatus: 200,
+	}, nil)
+	return nil, action.(policy.DownstreamResponseModifications).HeadersToSet
+}
+
+func TestBudgetEnforcedPerConsumer(t *testing.T) {
+	clock := testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-budget", map[string]interface{}{"tokensPerWindow": float64(20), "window": "1m"})
+	usage := `{"usage":{"prompt_tokens":5,"completion_tokens":7,"total_tokens":12}}`
+
+	rejected, headers := roundTrip(p, sharedFor("alice"), "", usage)
+	if rejected != nil {
+		t.Fatal("first request rejected")
+	}
+	if headers[headerLimit] != "20" || headers[headerRemaining] != "8" || headers[headerReset] != "60" {
+		t.Errorf("headers = %v, want limit 20, remaining 8, reset 60", headers)
+	}
+
+	// The second response overdraws the budget; remaining is reported as 0
+	*clock = clock.Add(15 * time.Second)
+	_, headers = roundTrip(p, sharedFor("alice"), "", usage)
+	if headers[headerRemaining] != "0" || headers[headerReset] != "45" {
+		t.Errorf("headers = %v, want remaining 0, reset 45", headers)
+	}
+
+	rejected, _ = roundTrip(p, sharedFor("alice"), "", usage)
+	if rejected == nil {
+		t.Fatal("expected request to be rejected once the budget is used up")
+	}
+	if rejected.StatusCode != 429 || rejected.Headers["retry-after"] != "45" || rejected.Headers[headerRemaining] != "0" {
+		t.Errorf("rejection = %d %v", rejected.StatusCode, rejected.Headers)
+	}
+
+	// Other consumers have their own budget
+	if rejected, _ := roundTrip(p, sharedFor("bob"), "", usage); rejected != nil {
+		t.Error("budget of one consumer applied to another")
+	}
+
+	// The budget is restored when the window resets
+	*clock = clock.Add(45 * time.Second)
+	if rejected, _ := roundTrip(p, sharedFor("alice"), "", usage); rejected != nil {
+		t.Error("request rejected after the window reset")
+	}
+}
+
+func TestEstimateRejectsEarly(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-estimate", map[string]interface{}{"tokensPerWindow": 10, "estimateRequestTokens": true})
+
+	small := `{"messages":[{"role":"user","content":"Hello there"}]}`
+	large := `{"messages":[{"role":"user","content":[{"type":"text","text":"This prompt is far too long for the budget"}]}]}`
+
+	if rejected, _ := roundTrip(p, sharedFor("alice"), large, ""); rejected == nil {
+		t.Error("expected oversized prompt to be rejected")
+	}
+	rejected, headers := roundTrip(p, sharedFor("alice"), small, `{"usage":{"total_tokens":4}}`)
+	if rejected != nil {
+		t.Fatal("small prompt rejected")
+	}
+	if headers[headerRemaining] != "6" {
+		t.Errorf("remaining = %q, want 6", headers[headerRemaining])
+	}
+}
+
+func TestConsumerKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		shared *policy.SharedContext
+		down   *policy.DownstreamContext
+		want   string
+	}{
+		{"credential", &policy.SharedContext{AuthContext: &policy.AuthContext{Authenticated: true, Subject: "alice", CredentialID: "app-1"}}, nil, "credential:app-1"},
+		{"subject", &policy.SharedContext{AuthContext: &policy.AuthContext{Authenticated: true, Subject: "alice"}}, nil, "subject:alice"},
+		{"unauthenticated falls back to IP", &policy.SharedContext{AuthContext: &policy.AuthContext{Subject: "alice"}}, &policy.DownstreamContext{ClientIP: "10.0.0.1"}, "ip:10.0.0.1"},
+		{"anonymous", &policy.SharedContext{}, nil, "anonymous"},
+	}
+	for _, tt := range tests {
+		if got := consumerKey(tt.shared, tt.down); got != tt.want {
+			t.Errorf("%s: consumerKey() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestKeyedConsumer(t *testing.T) {
+	apiKey := &policy.AuthContext{Authenticated: true, AuthType: "apikey", Subject: "alice", CredentialID: "app-1"}
+	jwt := &policy.AuthContext{Authenticated: true, AuthType: "jwt", Subject: "bob", Previous: apiKey}
+	down := &policy.DownstreamContext{
+		ClientIP: "10.0.0.1",
+		Request:  &policy.DownstreamRequest{Headers: policy.NewHeaders(map[string][]string{"x-tenant": {"acme"}})},
+	}
+	tests := []struct {
+		key    string
+		shared *policy.SharedContext
+		down   *policy.DownstreamContext
+		want   string
+	}{
+		{"api_key", &policy.SharedContext{AuthContext: jwt}, nil, "credential:app-1"},
+		{"api_key", &policy.SharedContext{AuthContext: &policy.AuthContext{Authenticated: true, AuthType: "jwt", Subject: "bob"}}, down, unresolvedConsumer},
+		{"jwt_sub", &policy.SharedContext{AuthContext: jwt}, nil, "subject:bob"},
+		{"jwt_sub", &policy.SharedContext{AuthContext: apiKey}, down, unresolvedConsumer},
+		{"client_ip", &policy.SharedContext{AuthContext: jwt}, down, "ip:10.0.0.1"},
+		{"header:X-Tenant", &policy.SharedContext{}, down, "header:"},
+		{"header:x-missing", &policy.SharedContext{}, down, unresolvedConsumer},
+	}
+	for _, tt := range tests {
+		p := newTestPolicy(t, "route-keyed", map[string]interface{}{"tokensPerWindow": 10, "key": tt.key})
+		got := p.consumer(tt.shared, tt.down)
+		if tt.want == "header:" {
+			if !strings.HasPrefix(got, "header:") || strings.Contains(got, "acme") {
+				t.Errorf("%s: consumer() = %q, want a digest of the header value", tt.key, got)
+			}
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: consumer() = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestKeyedBudgetsAreIndependent(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-api-keys", map[string]interface{}{"tokensPerWindow": float64(10), "key": "api_key"})
+	usage := `{"usage":{"total_tokens":10}}`
+	shared := func(app string) *policy.SharedContext {
+		return &policy.SharedContext{
+			Metadata:    map[string]interface{}{},
+			AuthContext: &policy.AuthContext{Authenticated: true, AuthType: "apikey", Subject: "owner", CredentialID: app},
+		}
+	}
+
+	if rejected, headers := roundTrip(p, shared("app-1"), "", usage); rejected != nil || headers[headerRemaining] != "0" {
+		t.Fatalf("app-1 first request: rejected = %v, headers = %v", rejected, headers)
+	}
+	if rejected, _ := roundTrip(p, shared("app-1"), "", usage); rejected == nil {
+		t.Fatal("app-1 should be out of budget")
+	}
+	rejected, headers := roundTrip(p, shared("app-2"), "", `{"usage":{"total_tokens":4}}`)
+	if rejected != nil {
+		t.Fatal("app-2 must have its own budget")
+	}
+	if headers[headerLimit] != "10" || headers[headerRemaining] != "6" {
+		t.Errorf("app-2 headers = %v, want limit 10, remaining 6", headers)
+	}
+}
+
+func TestHeaderKeyedBudgetsAreIndependent(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-header-keys", map[string]interface{}{"tokensPerWindow": float64(10), "key": "header:X-Consumer"})
+	request := func(consumer string, body string) (*policy.ImmediateResponse, map[string]string) {
+		shared := &policy.SharedContext{Metadata: map[string]interface{}{}}
+		ctx := context.Background()
+		headers := map[string][]string{}
+		if consumer != "" {
+			headers["x-consumer"] = []string{consumer}
+		}
+		down := &policy.DownstreamContext{Request: &policy.DownstreamRequest{Headers: policy.NewHeaders(headers)}}
+		if action := p.OnRequestHeaders(ctx, &policy.RequestHeaderContext{SharedContext: shared, Downstream: down}, nil); action != nil {
+			resp := action.(policy.ImmediateResponse)
+			return &resp, nil
+		}
+		action := p.OnResponseBody(ctx, &policy.ResponseContext{
+			SharedContext: shared,
+			Downstream:    down,
+			ResponseBody:  &policy.Body{Content: []byte(body), EndOfStream: true, Present: true},
+		}, nil)
+		return nil, action.(policy.DownstreamResponseModifications).HeadersToSet
+	}
+
+	request("a", `{"usage":{"total_tokens":10}}`)
+	if rejected, _ := request("a", ""); rejected == nil {
+		t.Fatal("consumer a should be out of budget")
+	}
+	if rejected, headers := request("b", `{"usage":{"total_tokens":3}}`); rejected != nil || headers[headerRemaining] != "7" {
+		t.Fatalf("consumer b: rejected = %v, headers = %v", rejected, headers)
+	}
+
+	// Requests without the header share one budget
+	request("", `{"usage":{"total_tokens":10}}`)
+	if rejected, _ := request("", ""); rejected == nil {
+		t.Fatal("requests without the header should share a budget")
+	}
+}
+
+func TestUsageTokens(t *testing.T) {
+	tests := []struct {
+		body string
+		want int64
+	}{
+		{`{"usage":{"prompt_tokens":5,"completion_tokens":3,"total_tokens":8}}`, 8},
+		{`{"usage":{"prompt_tokens":5,"completion_tokens":3}}`, 8},
+		{`{"object":"list","data":[],"usage":{"prompt_tokens":9,"total_tokens":9}}`, 9},
+		{`{"choices":[]}`, 0},
+		{`data: not json`, 0},
+	}
+	for _, tt := range tests {
+		if got := usageTokens([]byte(tt.body)); got != tt.want {
+			t.Errorf("usageTokens(%s) = %d, want %d", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		body string
+		want int64
+	}{
+		{`{"messages":[{"content":"abcdefgh"},{"content":"abc"}]}`, 3},
+		{`{"prompt":"abcd"}`, 1},
+		{`{"input":["abcd","efgh"]}`, 2},
+		{`{"model":"gpt-4"}`, 0},
+		{`not json`, 2},
+	}
+	for _, tt := range tests {
+		if got := estimateTokens([]byte(tt.body)); got != tt.want {
+			t.Errorf("estimateTokens(%s) = %d, want %d", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := parseConfig(map[string]interface{}{"tokensPerWindow": 100})
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.tokensPerWindow != 100 || cfg.window != time.Minute || cfg.estimateRequestTokens || cfg.backend != backendMemory {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+
+	invalid := []map[string]interface{}{
+		{},
+		{"tokensPerWindow": 0},
+		{"tokensPerWindow": 1.5},
+		{"tokensPerWindow": 10, "window": "-1m"},
+		{"tokensPerWindow": 10, "window": 60},
+		{"tokensPerWindow": 10, "estimateRequestTokens": "yes"},
+		{"tokensPerWindow": 10, "key": "session"},
+		{"tokensPerWindow": 10, "key": "header:"},
+		{"tokensPerWindow": 10, "key": 1},
+		{"tokensPerWindow": 10, "backend": "memcached"},
+		{"tokensPerWindow": 10, "backend": "redis"},
+		{"tokensPerWindow": 10, "backend": "redis", "redis": map[string]interface{}{"host": ""}},
+		{"tokensPerWindow": 10, "backend": "redis", "redis": map[string]interface{}{"host": "redis", "port": 0}},
+		{"tokensPerWindow": 10, "backend": "redis", "redis": map[string]interface{}{"host": "redis", "database": -1}},
+		{"tokensPerWindow": 10, "backend": "redis", "redis": map[string]interface{}{"host": "redis", "tls": "true"}},
+	}
+	for _, params := range invalid {
+		if _, err := parseConfig(params); err == nil {
+			t.Errorf("parseConfig(%v) expected error", params)
+		}
+	}
+}
+
+func TestStreamedUsageChargedAtEndOfStream(t *testing.T) {
+	testutils.PinClock(t, &now, testStart)
+	p := newTestPolicy(t, "route-stream", map[string]interface{}{"tokensPerWindow": 100})
+	shared := sharedFor("alice")
+	ctx := context.Background()
+
+	p.OnRequestHeaders(ctx, &policy.RequestHeaderContext{SharedContext: shared}, nil)
+
+	headers := policy.NewHeaders(map[string][]string{"content-type": {"text/event-stream; charset=utf-8"}})
+	action := p.OnResponseHeaders(ctx, &policy.ResponseHeaderContext{SharedContext: shared, ResponseHeaders: headers}, nil)
+	mods, ok := action.(policy.DownstreamResponseHeaderModifications)
+	if !ok || mods.HeadersToSet[headerRemaining] != "100" {
+		t.Fatalf("response headers action = %v, want remaining 100", action)
+	}
+
+	streamCtx := &policy.ResponseStreamContext{SharedContext: shared, ResponseHeaders: headers}
+	chunks := []string{
+		"data: {\"choices\":[{\"delta\":{\"content\":\"Hi\"}}],\"usage\":null}\n\n",
+		"data: {\"choices\":[],\"usage\":{\"prompt_tokens\":20,\"completion_tokens\":15,\"total_tokens\":35}}\r\n\r\n",
+		"data: [DONE]\n\n",
+	}
+	for i, chunk := range chunks {
+		p.OnResponseBodyChunk(ctx, streamCtx, &policy.StreamBody{Chunk: []byte(chunk), EndOfStream: i == len(chunks)-1}, nil)
+		if i < len(chunks)-1 {
+			if remaining, _ := p.store.remaining(context.Background(), p.cfg, "subject:alice", now()); remaining != 100 {
+				t.Fatalf("usage charged before the end of the stream: remaining = %d", remaining)
+			}
+		}
+	}
+	if remaining, _ := p.store.remaining(context.Background(), p.cfg, "subject:alice", now()); remaining != 65 {
+		t.Errorf("remaining = %d, want 65", remaining)
+	}
+}
+
+func TestNonStreamedResponseHeadersLeftToBodyPhase(t *testing.T) {
+	p := newTestPolicy(t, "route-json", map[string]interface{}{"tokensPerWindow": 100})
+	headers := policy.NewHeaders(map[string][]string{"content-type": {"application/json"}})
+	if action := p.OnResponseHeaders(context.Background(), &policy.ResponseHeaderContext{SharedContext: sharedFor("alice"), ResponseHeaders: headers}, nil); action != nil {
+		t.Errorf("OnResponseHeaders() = %v, want nil for non-streamed responses", action)
+	}
+}
@@ -1,13 +1,12 @@
 package embeddings
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"strconv"
-	"time"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
 )
 
 // AzureOpenAIEmbeddingProvider implements the EmbeddingProvider interface for Azure OpenAI
@@ -16,6 +15,7 @@ type AzureOpenAIEmbeddingProvider struct {
 	azureAPIKey    string
 	endpointURL    string
 	client         *http.Client
+	caller         *resilience.Caller
 }
 
 // Init initializes the Azure OpenAI embedding provider with configuration
@@ -27,14 +27,8 @@ func (a *AzureOpenAIEmbeddingProvider) Init(config EmbeddingProviderConfig) erro
 	a.azureAPIKey = config.APIKey
 	a.endpointURL = config.EmbeddingEndpoint
 	a.authHeaderName = config.AuthHeaderName
-	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
-	if v, err := strconv.Atoi(config.TimeOut); err == nil {
-		timeout = v
-	}
-
-	a.client = &http.Client{
-		Timeout: time.Duration(timeout) * time.Second,
-	}
+	a.client = &http.Client{}
+	a.caller = newCaller("azure-openai-embedding", config)
 	return nil
 }
 
@@ -45,6 +39,12 @@ func (a *AzureOpenAIEmbeddingProvider) GetType() string {
 
 // GetEmbedding generates an embedding vector for a single input text, with strict response checks
 func (a *AzureOpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	return a.GetEmbeddingContext(context.Background(), input)
+}
+
+// GetEmbeddingContext generates an embedding vector for a single input text,
+// aborting the request when ctx is done
+func (a *AzureOpenAIEmbeddingProvider) GetEmbeddingContext(ctx context.Context, input string) ([]float32, error) {
 	requestBody := map[string]interface{}{
 		"input": input,
 	}
@@ -53,26 +53,16 @@ func (a *AzureOpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", a.endpointURL, bytes.NewBuffer(body))
+	status, respBody, err := postJSON(ctx, a.caller, a.client, a.endpointURL, body, map[string]string{
+		a.authHeaderName: a.azureAPIKey, // Header should be "api-key"
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set(a.authHeaderName, a.azureAPIKey) // Header should be "api-key"
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := a.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Azure OpenAI API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Azure OpenAI API error: status %d, body: %s", status, string(respBody))
 	}
 
 	var response map[string]interface{}
@@ -109,6 +99,12 @@ func (a *AzureOpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, er
 
 // GetEmbeddings generates embedding vectors for multiple input texts
 func (a *AzureOpenAIEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	return a.GetEmbeddingsContext(context.Background(), inputs)
+}
+
+// GetEmbeddingsContext generates embedding vectors for multiple input texts,
+// aborting the request when ctx is done
+func (a *AzureOpenAIEmbeddingProvider) GetEmbeddingsContext(ctx context.Context, inputs []string) ([][]float32, error) {
 	requestBody := map[string]interface{}{
 		"input": inputs,
 	}
@@ -117,26 +113,17 @@ func (a *AzureOpenAIEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", a.endpointURL, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set(a.authHeaderName, a.azureAPIKey)
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := a.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
+	status, respBody, err := postJSON(ctx, a.caller, a.client, a.endpointURL, body, map[string]string{
+		a.authHeaderName: a.azureAPIKey,
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Azure OpenAI API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Azure OpenAI API error: status %d, body: %s", status, string(respBody))
 	}
 
 	var response map[string]interface{}
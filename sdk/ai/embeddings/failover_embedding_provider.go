@@ -0,0 +1,138 @@
+package embeddings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrAllProvidersFailed is returned by FailoverEmbeddingProvider when no
+// provider produced an embedding. Callers such as the semantic cache treat it
+// as a cache miss rather than failing the request.
+var ErrAllProvidersFailed = errors.New("all embedding providers failed")
+
+// FailoverEmbeddingProvider implements the EmbeddingProvider interface by
+// trying a primary provider and its fallbacks in order, moving on when a
+// provider returns an error or times out.
+//
+// Embeddings of different models are not comparable, so a fallback mostly
+// keeps the cache available for new entries while the primary is down.
+type FailoverEmbeddingProvider struct {
+	providers []EmbeddingProvider
+	dimension int
+}
+
+// Init initializes the primary provider described by config and each of its
+// fallbacks.
+func (f *FailoverEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	if config.EmbeddingDimension != "" {
+		dimension, err := strconv.Atoi(config.EmbeddingDimension)
+		if err != nil || dimension <= 0 {
+			return fmt.Errorf("invalid embedding dimension in the embedding provider configuration")
+		}
+		f.dimension = dimension
+	}
+
+	primary := config
+	primary.Fallbacks = nil
+	configs := append([]EmbeddingProviderConfig{primary}, config.Fallbacks...)
+	f.providers = make([]EmbeddingProvider, 0, len(configs))
+	for i, c := range configs {
+		if len(c.Fallbacks) > 0 {
+			return fmt.Errorf("embedding provider %d: fallbacks cannot be nested", i)
+		}
+		p, err := newSingleProvider(c)
+		if err != nil {
+			return fmt.Errorf("embedding provider %d: %w", i, err)
+		}
+		f.providers = append(f.providers, p)
+	}
+	return nil
+}
+
+// GetType returns the type of the primary provider
+func (f *FailoverEmbeddingProvider) GetType() string {
+	if len(f.providers) == 0 {
+		return ""
+	}
+	return f.providers[0].GetType()
+}
+
+// GetEmbedding generates an embedding vector with the first provider that succeeds
+func (f *FailoverEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	return f.GetEmbeddingContext(context.Background(), input)
+}
+
+// GetEmbeddingContext generates an embedding vector with the first provider
+// that succeeds. No further provider is tried once ctx is done.
+func (f *FailoverEmbeddingProvider) GetEmbeddingContext(ctx context.Context, input string) ([]float32, error) {
+	var errs []error
+	for _, p := range f.providers {
+		embedding, err := GetEmbedding(ctx, p, input)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.GetType(), err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		return f.normalize(embedding), nil
+	}
+	return nil, f.failed(errs)
+}
+
+// GetEmbeddings generates embedding vectors with the first provider that succeeds
+func (f *FailoverEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	return f.GetEmbeddingsContext(context.Background(), inputs)
+}
+
+// GetEmbeddingsContext generates embedding vectors with the first provider
+// that succeeds. No further provider is tried once ctx is done.
+func (f *FailoverEmbeddingProvider) GetEmbeddingsContext(ctx context.Context, inputs []string) ([][]float32, error) {
+	var errs []error
+	for _, p := range f.providers {
+		embeddings, err := GetEmbeddings(ctx, p, inputs)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.GetType(), err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		for i := range embeddings {
+			embeddings[i] = f.normalize(embeddings[i])
+		}
+		return embeddings, nil
+	}
+	return nil, f.failed(errs)
+}
+
+func (f *FailoverEmbeddingProvider) failed(errs []error) error {
+	return fmt.Errorf("%w: %w", ErrAllProvidersFailed, errors.Join(errs...))
+}
+
+// normalize truncates or zero-pads embedding to the configured dimension and
+// rescales it to unit length, so cosine and inner product distances stay
+// meaningful after truncation.
+func (f *FailoverEmbeddingProvider) normalize(embedding []float32) []float32 {
+	if f.dimension == 0 || len(embedding) == f.dimension {
+		return embedding
+	}
+	resized := make([]float32, f.dimension)
+	copy(resized, embedding)
+
+	var norm float64
+	for _, v := range resized {
+		norm += float64(v) * float64(v)
+	}
+	if norm == 0 {
+		return resized
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range resized {
+		resized[i] *= scale
+	}
+	return resized
+}
@@ -0,0 +1,185 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newEmbeddingMock serves OpenAI-style embedding responses with the given
+// vector, or fails with status when it is not 200.
+func newEmbeddingMock(t *testing.T, status int, vector []float32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if status != http.StatusOK {
+			http.Error(w, `{"error":{"message":"upstream failure"}}`, status)
+			return
+		}
+		var req struct {
+			Input json.RawMessage `json:"input"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		n := 1
+		var inputs []string
+		if json.Unmarshal(req.Input, &inputs) == nil {
+			n = len(inputs)
+		}
+		data := make([]map[string]interface{}, n)
+		for i := range data {
+			data[i] = map[string]interface{}{"embedding": vector}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func providerConfig(provider, endpoint string) EmbeddingProviderConfig {
+	return EmbeddingProviderConfig{
+		AuthHeaderName:    "Authorization",
+		EmbeddingProvider: provider,
+		EmbeddingEndpoint: endpoint,
+		APIKey:            "key",
+		EmbeddingModel:    "model",
+		MaxRetries:        "0",
+	}
+}
+
+func TestFailoverToFallbackProvider(t *testing.T) {
+	primary, primaryCalls := newEmbeddingMock(t, http.StatusInternalServerError, nil)
+	fallback, fallbackCalls := newEmbeddingMock(t, http.StatusOK, []float32{0.6, 0.8})
+
+	config := providerConfig("MISTRAL", primary.URL)
+	config.Fallbacks = []EmbeddingProviderConfig{providerConfig("OPENAI", fallback.URL)}
+	p, err := NewEmbeddingProvider(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetType() != "MISTRAL" {
+		t.Errorf("GetType() = %q, want the primary type", p.GetType())
+	}
+
+	embedding, err := p.GetEmbedding("hello")
+	if err != nil {
+		t.Fatalf("GetEmbedding() error = %v", err)
+	}
+	if len(embedding) != 2 || embedding[0] != 0.6 {
+		t.Errorf("embedding = %v, want the fallback's", embedding)
+	}
+	embeddings, err := p.GetEmbeddings([]string{"a", "b"})
+	if err != nil || len(embeddings) != 2 {
+		t.Fatalf("GetEmbeddings() = %v, %v", embeddings, err)
+	}
+	if primaryCalls.Load() != 2 || fallbackCalls.Load() != 2 {
+		t.Errorf("calls = %d primary, %d fallback; want 2 each", primaryCalls.Load(), fallbackCalls.Load())
+	}
+}
+
+func TestAllProvidersFailed(t *testing.T) {
+	primary, _ := newEmbeddingMock(t, http.StatusInternalServerError, nil)
+	fallback, _ := newEmbeddingMock(t, http.StatusServiceUnavailable, nil)
+
+	config := providerConfig("OPENAI", primary.URL)
+	config.Fallbacks = []EmbeddingProviderConfig{providerConfig("OPENAI", fallback.URL)}
+	p, err := NewEmbeddingProvider(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetEmbedding("hello"); !errors.Is(err, ErrAllProvidersFailed) {
+		t.Errorf("error = %v, want ErrAllProvidersFailed", err)
+	}
+}
+
+func TestDimensionNormalization(t *testing.T) {
+	srv, _ := newEmbeddingMock(t, http.StatusOK, []float32{3, 4, 12})
+	for _, tt := range []struct {
+		dimension string
+		want      []float32
+	}{
+		{dimension: "2", want: []float32{0.6, 0.8}},
+		{dimension: "4", want: []float32{3.0 / 13, 4.0 / 13, 12.0 / 13, 0}},
+	} {
+		config := providerConfig("OPENAI", srv.URL)
+		config.EmbeddingDimension = tt.dimension
+		p, err := NewEmbeddingProvider(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := p.GetEmbedding("hello")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("dimension %s: embedding = %v, want %v", tt.dimension, got, tt.want)
+		}
+		for i := range got {
+			if math.Abs(float64(got[i]-tt.want[i])) > 1e-6 {
+				t.Errorf("dimension %s: embedding = %v, want %v", tt.dimension, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFailoverConfigRejectsInvalid(t *testing.T) {
+	valid := providerConfig("OPENAI", "http://localhost")
+	withFallback := func(fallback EmbeddingProviderConfig) EmbeddingProviderConfig {
+		c := valid
+		c.Fallbacks = []EmbeddingProviderConfig{fallback}
+		return c
+	}
+	badDimension := valid
+	badDimension.EmbeddingDimension = "-1"
+
+	for name, config := range map[string]EmbeddingProviderConfig{
+		"invalid fallback": withFallback(providerConfig("COHERE", "http://localhost")),
+		"nested fallbacks": withFallback(withFallback(valid)),
+		"bad dimension":    badDimension,
+	} {
+		if _, err := NewEmbeddingProvider(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestCancelledContextAbortsRequestAndFailover(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(hung.Close)
+	fallback, fallbackCalls := newEmbeddingMock(t, http.StatusOK, []float32{1})
+
+	config := providerConfig("OPENAI", hung.URL)
+	config.Fallbacks = []EmbeddingProviderConfig{providerConfig("OPENAI", fallback.URL)}
+	p, err := NewEmbeddingProvider(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = GetEmbedding(ctx, p, "hello")
+	if err == nil {
+		t.Fatal("expected an error when the context times out")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("request was not aborted with its context, took %s", elapsed)
+	}
+	if n := fallbackCalls.Load(); n != 0 {
+		t.Fatalf("fallback called %d times after the context was done", n)
+	}
+}
@@ -1,14 +1,13 @@
 package embeddings
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"strconv"
-	"time"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
 )
 
 // MistralEmbeddingProvider implements the EmbeddingProvider interface for Mistral
@@ -18,6 +17,7 @@ type MistralEmbeddingProvider struct {
 	endpointURL    string
 	model          string
 	client         *http.Client
+	caller         *resilience.Caller
 }
 
 // Init initializes the Mistral embedding provider with configuration
@@ -30,13 +30,8 @@ func (m *MistralEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
 	m.endpointURL = config.EmbeddingEndpoint
 	m.model = config.EmbeddingModel
 	m.authHeaderName = config.AuthHeaderName
-	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
-	if v, err := strconv.Atoi(config.TimeOut); err == nil {
-		timeout = v
-	}
-	m.client = &http.Client{
-		Timeout: time.Duration(timeout) * time.Second,
-	}
+	m.client = &http.Client{}
+	m.caller = newCaller("mistral-embedding", config)
 	return nil
 }
 
@@ -47,6 +42,12 @@ func (m *MistralEmbeddingProvider) GetType() string {
 
 // GetEmbedding generates an embedding vector for a single input text
 func (m *MistralEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	return m.GetEmbeddingContext(context.Background(), input)
+}
+
+// GetEmbeddingContext generates an embedding vector for a single input text,
+// aborting the request when ctx is done
+func (m *MistralEmbeddingProvider) GetEmbeddingContext(ctx context.Context, input string) ([]float32, error) {
 	requestBody := map[string]string{
 		"model": m.model,
 		"input": input,
@@ -56,26 +57,16 @@ func (m *MistralEmbeddingProvider) GetEmbedding(input string) ([]float32, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", m.endpointURL, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set(m.authHeaderName, "Bearer "+m.mistralAPIKey) // Header should be "Authorization"
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := m.client.Do(req)
+	status, respBody, err := postJSON(ctx, m.caller, m.client, m.endpointURL, body, map[string]string{
+		m.authHeaderName: "Bearer " + m.mistralAPIKey, // Header should be "Authorization"
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		errStr := fmt.Sprintf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		errStr := fmt.Sprintf("API request failed with status %d: %s", status, string(respBody))
 		return nil, errors.New(errStr)
 	}
 
@@ -109,6 +100,12 @@ func (m *MistralEmbeddingProvider) GetEmbedding(input string) ([]float32, error)
 
 // GetEmbeddings generates embedding vectors for multiple input texts
 func (m *MistralEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	return m.GetEmbeddingsContext(context.Background(), inputs)
+}
+
+// GetEmbeddingsContext generates embedding vectors for multiple input texts,
+// aborting the request when ctx is done
+func (m *MistralEmbeddingProvider) GetEmbeddingsContext(ctx context.Context, inputs []string) ([][]float32, error) {
 	requestBody := map[string]interface{}{
 		"model": m.model,
 		"input": inputs,
@@ -118,26 +115,16 @@ func (m *MistralEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", m.endpointURL, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set(m.authHeaderName, "Bearer "+m.mistralAPIKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := m.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
+	status, respBody, err := postJSON(ctx, m.caller, m.client, m.endpointURL, body, map[string]string{
+		m.authHeaderName: "Bearer " + m.mistralAPIKey,
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		errStr := fmt.Sprintf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		errStr := fmt.Sprintf("API request failed with status %d: %s", status, string(respBody))
 		return nil, errors.New(errStr)
 	}
 
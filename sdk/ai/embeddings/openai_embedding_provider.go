@@ -1,13 +1,12 @@
 package embeddings
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"strconv"
-	"time"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
 )
 
 // OpenAIEmbeddingProvider implements the EmbeddingProvider interface for OpenAI
@@ -17,6 +16,7 @@ type OpenAIEmbeddingProvider struct {
 	endpointURL    string
 	model          string
 	client         *http.Client
+	caller         *resilience.Caller
 }
 
 // Init initializes the OpenAI embedding provider with configuration
@@ -29,14 +29,8 @@ func (o *OpenAIEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
 	o.endpointURL = config.EmbeddingEndpoint
 	o.model = config.EmbeddingModel
 	o.authHeaderName = config.AuthHeaderName
-	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
-	if v, err := strconv.Atoi(config.TimeOut); err == nil {
-		timeout = v
-	}
-
-	o.client = &http.Client{
-		Timeout: time.Duration(timeout) * time.Second,
-	}
+	o.client = &http.Client{}
+	o.caller = newCaller("openai-embedding", config)
 	return nil
 }
 
@@ -47,6 +41,12 @@ func (o *OpenAIEmbeddingProvider) GetType() string {
 
 // GetEmbedding generates an embedding vector for a single input text
 func (o *OpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	return o.GetEmbeddingContext(context.Background(), input)
+}
+
+// GetEmbeddingContext generates an embedding vector for a single input text,
+// aborting the request when ctx is done
+func (o *OpenAIEmbeddingProvider) GetEmbeddingContext(ctx context.Context, input string) ([]float32, error) {
 	requestBody := map[string]interface{}{
 		"model": o.model,
 		"input": input,
@@ -56,26 +56,17 @@ func (o *OpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", o.endpointURL, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set(o.authHeaderName, "Bearer "+o.openAiAPIKey) // Header should be "Authorization"
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := o.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
+	status, respBody, err := postJSON(ctx, o.caller, o.client, o.endpointURL, body, map[string]string{
+		o.authHeaderName: "Bearer " + o.openAiAPIKey, // Header should be "Authorization"
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OpenAI API returned status %d: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("OpenAI API returned status %d: %s", status, string(respBody))
 	}
 
 	var response map[string]interface{}
@@ -132,6 +123,12 @@ func (o *OpenAIEmbeddingProvider) GetEmbedding(input string) ([]float32, error)
 
 // GetEmbeddings generates embedding vectors for multiple input texts
 func (o *OpenAIEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	return o.GetEmbeddingsContext(context.Background(), inputs)
+}
+
+// GetEmbeddingsContext generates embedding vectors for multiple input texts,
+// aborting the request when ctx is done
+func (o *OpenAIEmbeddingProvider) GetEmbeddingsContext(ctx context.Context, inputs []string) ([][]float32, error) {
 	requestBody := map[string]interface{}{
 		"model": o.model,
 		"input": inputs,
@@ -141,27 +138,17 @@ func (o *OpenAIEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", o.endpointURL, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set(o.authHeaderName, "Bearer "+o.openAiAPIKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := o.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
+	status, respBody, err := postJSON(ctx, o.caller, o.client, o.endpointURL, body, map[string]string{
+		o.authHeaderName: "Bearer " + o.openAiAPIKey,
+		"Content-Type":   "application/json",
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("OpenAI API returned status %d: %s", resp.StatusCode, string(respBody))
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("OpenAI API returned status %d: %s", status, string(respBody))
 	}
 
 	var response map[string]interface{}
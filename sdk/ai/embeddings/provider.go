@@ -1,9 +1,20 @@
 package embeddings
 
-import "fmt"
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
 
 const (
 	DefaultRequestTimeout = 30 // DefaultRequestTimeout is the default timeout for requests in seconds (30 seconds)
+	DefaultMaxRetries     = 2  // DefaultMaxRetries is the default number of retries of a failed request
 )
 
 // EmbeddingProvider defines the interface for services that provide text embedding
@@ -14,6 +25,34 @@ type EmbeddingProvider interface {
 	GetEmbeddings(inputs []string) ([][]float32, error)
 }
 
+// ContextEmbeddingProvider is an EmbeddingProvider whose requests can be
+// bound to a context, so they are aborted when the request that needs the
+// embedding is cancelled or times out. All providers of this package
+// implement it.
+type ContextEmbeddingProvider interface {
+	EmbeddingProvider
+	GetEmbeddingContext(ctx context.Context, input string) ([]float32, error)
+	GetEmbeddingsContext(ctx context.Context, inputs []string) ([][]float32, error)
+}
+
+// GetEmbedding generates an embedding vector for input with p, bound to ctx
+// when p implements ContextEmbeddingProvider.
+func GetEmbedding(ctx context.Context, p EmbeddingProvider, input string) ([]float32, error) {
+	if cp, ok := p.(ContextEmbeddingProvider); ok {
+		return cp.GetEmbeddingContext(ctx, input)
+	}
+	return p.GetEmbedding(input)
+}
+
+// GetEmbeddings generates embedding vectors for inputs with p, bound to ctx
+// when p implements ContextEmbeddingProvider.
+func GetEmbeddings(ctx context.Context, p EmbeddingProvider, inputs []string) ([][]float32, error) {
+	if cp, ok := p.(ContextEmbeddingProvider); ok {
+		return cp.GetEmbeddingsContext(ctx, inputs)
+	}
+	return p.GetEmbeddings(inputs)
+}
+
 // EmbeddingProviderConfig defines the properties required for initializing an embedding provider
 type EmbeddingProviderConfig struct {
 	AuthHeaderName    string
@@ -21,7 +60,48 @@ type EmbeddingProviderConfig struct {
 	EmbeddingEndpoint string
 	APIKey            string
 	EmbeddingModel    string
-	TimeOut           string
+	TimeOut           string // TimeOut bounds each request attempt, in seconds
+	MaxRetries        string // MaxRetries is the number of retries of a failed or timed out request
+
+	// Fallbacks are tried in order when this provider fails. They are used
+	// through NewEmbeddingProvider, which returns a FailoverEmbeddingProvider.
+	Fallbacks []EmbeddingProviderConfig
+	// EmbeddingDimension, when set, is the length every returned embedding is
+	// normalized to, so providers with different dimensions can back the same
+	// vector index.
+	EmbeddingDimension string
+}
+
+// NewEmbeddingProvider returns the initialized provider named by
+// config.EmbeddingProvider, wrapped in a FailoverEmbeddingProvider when the
+// config lists fallbacks or a dimension.
+func NewEmbeddingProvider(config EmbeddingProviderConfig) (EmbeddingProvider, error) {
+	if len(config.Fallbacks) > 0 || config.EmbeddingDimension != "" {
+		f := &FailoverEmbeddingProvider{}
+		if err := f.Init(config); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	return newSingleProvider(config)
+}
+
+func newSingleProvider(config EmbeddingProviderConfig) (EmbeddingProvider, error) {
+	var p EmbeddingProvider
+	switch config.EmbeddingProvider {
+	case "OPENAI":
+		p = &OpenAIEmbeddingProvider{}
+	case "MISTRAL":
+		p = &MistralEmbeddingProvider{}
+	case "AZURE_OPENAI":
+		p = &AzureOpenAIEmbeddingProvider{}
+	default:
+		return nil, fmt.Errorf("missing/Invalid embedding provider found in the embedding provider configuration")
+	}
+	if err := p.Init(config); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 // ValidateEmbeddingProviderConfigProps validates the properties of the embedding provider configuration.
@@ -43,3 +123,60 @@ func ValidateEmbeddingProviderConfigProps(config EmbeddingProviderConfig) error
 	}
 	return nil
 }
+
+// newCaller returns the resilience.Caller guarding the requests of an embedding
+// provider, configured from the TimeOut and MaxRetries properties.
+func newCaller(name string, config EmbeddingProviderConfig) *resilience.Caller {
+	timeout := DefaultRequestTimeout
+	if v, err := strconv.Atoi(config.TimeOut); err == nil && v > 0 {
+		timeout = v
+	}
+	retries := DefaultMaxRetries
+	if v, err := strconv.Atoi(config.MaxRetries); err == nil && v >= 0 {
+		retries = v
+	}
+	if retries == 0 {
+		retries = -1 // resilience.Config treats zero as unset
+	}
+	return resilience.NewCaller(name, resilience.Config{
+		Timeout:    time.Duration(timeout) * time.Second,
+		MaxRetries: retries,
+	})
+}
+
+// postJSON posts body to url through caller, retrying transport errors,
+// timeouts and 408, 429 and 5xx responses until ctx is done. It returns the
+// status and body of the last response, leaving the interpretation of non-200
+// responses to the provider.
+func postJSON(ctx context.Context, caller *resilience.Caller, client *http.Client, url string, body []byte, headers map[string]string) (int, []byte, error) {
+	var status int
+	var respBody []byte
+	err := caller.Do(ctx, func(ctx context.Context) error {
+		status, respBody = 0, nil
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return resilience.Permanent(err)
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		status, respBody = resp.StatusCode, b
+		if status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= 500 {
+			return fmt.Errorf("embedding provider returned status %d", status)
+		}
+		return nil
+	})
+	if err != nil && status == 0 {
+		return 0, nil, err
+	}
+	return status, respBody, nil
+}
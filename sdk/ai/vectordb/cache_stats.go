@@ -0,0 +1,57 @@
+package vectordb
+
+import (
+	"sync/atomic"
+
+	"github.com/wso2/api-platform/sdk/core/utils/semanticcache"
+)
+
+// CacheStats counts semantic cache lookups and stores. It is safe for concurrent
+// use. A cache policy can return State() from its PolicyState method so the
+// policy engine exports the counters as metrics and on its admin API.
+type CacheStats struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	stores atomic.Uint64
+	errors atomic.Uint64
+
+	degraded atomic.Uint64
+}
+
+// RecordHit counts a lookup that returned a cached response.
+func (s *CacheStats) RecordHit() {
+	s.hits.Add(1)
+	semanticcache.ObserveLookup(semanticcache.ResultHit)
+}
+
+// RecordMiss counts a lookup that found no response above the threshold.
+func (s *CacheStats) RecordMiss() {
+	s.misses.Add(1)
+	semanticcache.ObserveLookup(semanticcache.ResultMiss)
+}
+
+// RecordDegraded counts a lookup skipped because a backend failed. It is also
+// counted as an error.
+func (s *CacheStats) RecordDegraded() {
+	s.degraded.Add(1)
+	s.errors.Add(1)
+	semanticcache.ObserveLookup(semanticcache.ResultDegraded)
+}
+
+// RecordStore counts a response added to the cache.
+func (s *CacheStats) RecordStore() { s.stores.Add(1) }
+
+// RecordError counts a failed embedding, lookup or store.
+func (s *CacheStats) RecordError() { s.errors.Add(1) }
+
+// State returns the counters keyed by name.
+func (s *CacheStats) State() map[string]interface{} {
+	return map[string]interface{}{
+		"hits":   s.hits.Load(),
+		"misses": s.misses.Load(),
+		"stores": s.stores.Load(),
+		"errors": s.errors.Load(),
+		// degraded lookups are also counted in errors
+		"degraded": s.degraded.Load(),
+	}
+}
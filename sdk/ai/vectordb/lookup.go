@@ -0,0 +1,99 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
+
+// Reasons logged when a lookup degrades to a pass-through.
+const (
+	DegradedReasonEmbedding   = "embedding_provider_error"
+	DegradedReasonVectorDB    = "vector_db_error"
+	DegradedReasonCircuitOpen = "circuit_open"
+)
+
+// Embedder is the part of an embedding provider that Lookup needs. It is
+// satisfied by embeddings.EmbeddingProvider.
+type Embedder interface {
+	GetEmbedding(input string) ([]float32, error)
+}
+
+// ContextEmbedder is an Embedder whose requests can be bound to a context.
+// Lookup uses it, when implemented, so that the embedding request is aborted
+// with the request being served.
+type ContextEmbedder interface {
+	GetEmbeddingContext(ctx context.Context, input string) ([]float32, error)
+}
+
+// LookupResult is the outcome of Lookup.
+type LookupResult struct {
+	// Hit reports whether Response holds a cached response to serve.
+	Hit      bool
+	Response CacheResponse
+	// Embedding is the embedding of the input, for storing the upstream
+	// response after a miss. It is nil when the lookup degraded.
+	Embedding []float32
+	// Degraded reports that a backend failed and the request must go to the
+	// upstream uncached.
+	Degraded bool
+}
+
+// Lookup embeds input and retrieves the nearest cached response for the API in
+// filter, which takes the same keys as Retrieve. A failure of the embedding provider or vector DB never fails the
+// request: the lookup is reported as degraded, counted in stats and logged at
+// warn level, and the caller passes the request to the upstream. stats may be
+// nil.
+func Lookup(embedder Embedder, db VectorDBProvider, input string, filter map[string]interface{}, stats *CacheStats) LookupResult {
+	ctx, ok := filter["ctx"].(context.Context)
+	if !ok {
+		ctx = context.Background()
+	}
+	apiID, _ := filter["api_id"].(string)
+
+	embedding, err := embed(ctx, embedder, input)
+	if err != nil {
+		degraded(ctx, stats, DegradedReasonEmbedding, apiID, err)
+		return LookupResult{Degraded: true}
+	}
+
+	response, err := db.Retrieve(embedding, filter)
+	switch {
+	case errors.Is(err, ErrNoResults):
+		// Nothing cached for the API yet
+	case err != nil:
+		degraded(ctx, stats, DegradedReasonVectorDB, apiID, err)
+		return LookupResult{Degraded: true}
+	case response.StatusCode != "" || response.ResponsePayload != nil:
+		if stats != nil {
+			stats.RecordHit()
+		}
+		return LookupResult{Hit: true, Response: response, Embedding: embedding}
+	}
+
+	if stats != nil {
+		stats.RecordMiss()
+	}
+	return LookupResult{Embedding: embedding}
+}
+
+func embed(ctx context.Context, embedder Embedder, input string) ([]float32, error) {
+	if ce, ok := embedder.(ContextEmbedder); ok {
+		return ce.GetEmbeddingContext(ctx, input)
+	}
+	return embedder.GetEmbedding(input)
+}
+
+func degraded(ctx context.Context, stats *CacheStats, reason, apiID string, err error) {
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		reason = DegradedReasonCircuitOpen
+	}
+	if stats != nil {
+		stats.RecordDegraded()
+	}
+	// The input is not logged; prompts may carry personal data
+	slog.WarnContext(ctx, "Semantic cache degraded; passing request to upstream",
+		"reason", reason, "api_id", apiID, "error", err)
+}
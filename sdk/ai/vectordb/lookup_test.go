@@ -0,0 +1,139 @@
+package vectordb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/wso2/api-platform/sdk/core/utils/semanticcache"
+)
+
+type stubEmbedder struct{ err error }
+
+func (s stubEmbedder) GetEmbedding(string) ([]float32, error) {
+	return []float32{1, 0, 0}, s.err
+}
+
+// stubDB is a VectorDBProvider returning a fixed Retrieve result.
+type stubDB struct {
+	RedisVectorDBProvider
+	response CacheResponse
+	err      error
+}
+
+func (s *stubDB) Retrieve([]float32, map[string]interface{}) (CacheResponse, error) {
+	return s.response, s.err
+}
+
+// newFailingRedis starts a server that answers every command with an error,
+// standing in for a Redis instance that is up but failing.
+func newFailingRedis(t *testing.T) int {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					// Answer once per command, which starts with an array header
+					if strings.HasPrefix(line, "*") {
+						_, _ = conn.Write([]byte("-ERR simulated failure\r\n"))
+					}
+				}
+			}()
+		}
+	}()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func recordLookups(t *testing.T) *[]string {
+	t.Helper()
+	var results []string
+	semanticcache.SetLookupObserver(func(result string) { results = append(results, result) })
+	t.Cleanup(func() { semanticcache.SetLookupObserver(nil) })
+	return &results
+}
+
+func lookupFilter() map[string]interface{} {
+	return map[string]interface{}{"ctx": context.Background(), "api_id": "api-1", "threshold": "0.9"}
+}
+
+func TestLookupDegradesWhenVectorDBFails(t *testing.T) {
+	results := recordLookups(t)
+	config := storeConfig("REDIS")
+	config.DBHost = "127.0.0.1"
+	config.DBPort = newFailingRedis(t)
+	config.MaxRetries = "0"
+	db := &RedisVectorDBProvider{}
+	if err := db.Init(config); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	stats := &CacheStats{}
+	result := Lookup(stubEmbedder{}, db, "prompt", lookupFilter(), stats)
+	if !result.Degraded || result.Hit {
+		t.Fatalf("result = %+v, want degraded", result)
+	}
+	state := stats.State()
+	if state["degraded"] != uint64(1) || state["errors"] != uint64(1) || state["misses"] != uint64(0) {
+		t.Errorf("state = %v", state)
+	}
+	if len(*results) != 1 || (*results)[0] != semanticcache.ResultDegraded {
+		t.Errorf("observed %v, want [degraded]", *results)
+	}
+}
+
+func TestLookupDegradesWhenEmbeddingFails(t *testing.T) {
+	stats := &CacheStats{}
+	db := &stubDB{}
+	result := Lookup(stubEmbedder{err: errors.New("status 503")}, db, "prompt", lookupFilter(), stats)
+	if !result.Degraded || result.Embedding != nil {
+		t.Errorf("result = %+v, want degraded without an embedding", result)
+	}
+	if stats.State()["degraded"] != uint64(1) {
+		t.Errorf("state = %v", stats.State())
+	}
+}
+
+func TestLookupHitAndMiss(t *testing.T) {
+	results := recordLookups(t)
+	stats := &CacheStats{}
+
+	hit := Lookup(stubEmbedder{}, &stubDB{response: CacheResponse{StatusCode: "200"}}, "prompt", lookupFilter(), stats)
+	if !hit.Hit || hit.Response.StatusCode != "200" {
+		t.Errorf("hit = %+v", hit)
+	}
+	// Below the threshold, providers return an empty response
+	belowThreshold := Lookup(stubEmbedder{}, &stubDB{}, "prompt", lookupFilter(), stats)
+	// Redis reports an API without entries as ErrNoResults
+	empty := Lookup(stubEmbedder{}, &stubDB{err: ErrNoResults}, "prompt", lookupFilter(), stats)
+	for name, miss := range map[string]LookupResult{"below threshold": belowThreshold, "no entries": empty} {
+		if miss.Hit || miss.Degraded || miss.Embedding == nil {
+			t.Errorf("%s: result = %+v, want a miss with the embedding kept for storing", name, miss)
+		}
+	}
+
+	if got := strings.Join(*results, ","); got != "hit,miss,miss" {
+		t.Errorf("observed %s", got)
+	}
+	if state := stats.State(); state["hits"] != uint64(1) || state["misses"] != uint64(2) || state["degraded"] != uint64(0) {
+		t.Errorf("state = %v", state)
+	}
+}
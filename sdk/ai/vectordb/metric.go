@@ -0,0 +1,134 @@
+package vectordb
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DistanceMetric is the measure used to compare embeddings. It must match the
+// metric the vector index was built with, and it decides how the similarity
+// threshold is read.
+type DistanceMetric string
+
+const (
+	// MetricCosine compares direction only. Scores are cosine similarities in
+	// [-1, 1] and the threshold is the minimum similarity of a hit.
+	MetricCosine DistanceMetric = "COSINE"
+	// MetricDotProduct uses the inner product, which equals cosine similarity
+	// for unit-length embeddings. The threshold is the minimum inner product.
+	MetricDotProduct DistanceMetric = "DOT_PRODUCT"
+	// MetricEuclidean uses the straight-line distance. Scores are distances
+	// and the threshold is the maximum distance of a hit.
+	MetricEuclidean DistanceMetric = "EUCLIDEAN"
+)
+
+// ParseDistanceMetric reads the DistanceMetric configuration property. An
+// empty value selects cosine, and the index names IP and L2 are accepted as
+// aliases.
+func ParseDistanceMetric(s string) (DistanceMetric, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "", "COSINE":
+		return MetricCosine, nil
+	case "DOT_PRODUCT", "IP":
+		return MetricDotProduct, nil
+	case "EUCLIDEAN", "L2":
+		return MetricEuclidean, nil
+	}
+	return "", fmt.Errorf("invalid distance metric %q: expected COSINE, DOT_PRODUCT or EUCLIDEAN", s)
+}
+
+// ParseThreshold parses a threshold and checks it is meaningful for the metric.
+func (m DistanceMetric) ParseThreshold(s string) (float64, error) {
+	threshold, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return 0, fmt.Errorf("invalid threshold %q", s)
+	}
+	switch m {
+	case MetricCosine:
+		if threshold < -1 || threshold > 1 {
+			return 0, fmt.Errorf("cosine similarity threshold must be between -1 and 1")
+		}
+	case MetricEuclidean:
+		if threshold < 0 {
+			return 0, fmt.Errorf("euclidean distance threshold must not be negative")
+		}
+	}
+	return threshold, nil
+}
+
+// IsHit reports whether a match with the given score is close enough to be
+// served from the cache.
+func (m DistanceMetric) IsHit(score, threshold float64) bool {
+	if m == MetricEuclidean {
+		return score <= threshold
+	}
+	return score >= threshold
+}
+
+// redisName returns the DISTANCE_METRIC of a Redis vector field.
+func (m DistanceMetric) redisName() string {
+	switch m {
+	case MetricDotProduct:
+		return "IP"
+	case MetricEuclidean:
+		return "L2"
+	}
+	return "COSINE"
+}
+
+// scoreFromRedis converts the distance returned by a Redis KNN query into a
+// score of the metric. Redis reports 1 - similarity for COSINE and IP, and
+// the squared distance for L2.
+func (m DistanceMetric) scoreFromRedis(distance float64) float64 {
+	if m == MetricEuclidean {
+		return math.Sqrt(math.Max(distance, 0))
+	}
+	return 1 - distance
+}
+
+// scoreFromMilvus converts a Milvus search score into a score of the metric.
+// Milvus reports similarities for COSINE and IP, and the squared distance for
+// L2.
+func (m DistanceMetric) scoreFromMilvus(score float64) float64 {
+	if m == MetricEuclidean {
+		return math.Sqrt(math.Max(score, 0))
+	}
+	return score
+}
+
+// pgvector returns the pgvector distance operator and index operator class.
+// Every operator orders nearest first; scoreFromPgvector turns its value into
+// a score.
+func (m DistanceMetric) pgvector() (operator, opclass string) {
+	switch m {
+	case MetricDotProduct:
+		return "<#>", "vector_ip_ops"
+	case MetricEuclidean:
+		return "<->", "vector_l2_ops"
+	}
+	return "<=>", "vector_cosine_ops"
+}
+
+// scoreFromPgvector converts a pgvector operator value into a score of the
+// metric. <=> is the cosine distance and <#> the negative inner product.
+func (m DistanceMetric) scoreFromPgvector(value float64) float64 {
+	switch m {
+	case MetricDotProduct:
+		return -value
+	case MetricEuclidean:
+		return value
+	}
+	return 1 - value
+}
+
+// indexSuffix distinguishes indexes built with a non-default metric, so
+// changing the metric creates a new index rather than querying one built for
+// another metric. Cosine keeps the existing index names.
+func (m DistanceMetric) indexSuffix() string {
+	if m == MetricCosine || m == "" {
+		return ""
+	}
+	return "_" + strings.ToLower(string(m))
+}
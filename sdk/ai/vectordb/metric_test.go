@@ -0,0 +1,124 @@
+package vectordb
+
+import (
+	"math"
+	"testing"
+)
+
+// Each backend reports raw values differently; a neighbor just outside the
+// threshold must be a miss and one just inside a hit, whichever backend
+// produced it.
+func TestThresholdPerMetric(t *testing.T) {
+	tests := []struct {
+		metric    DistanceMetric
+		threshold string
+		// raw backend values for the hit and the miss
+		redisHit, redisMiss   float64
+		milvusHit, milvusMiss float64
+		pgHit, pgMiss         float64
+	}{
+		{
+			// similarity 0.95 hits and 0.85 misses a 0.9 threshold
+			metric: MetricCosine, threshold: "0.9",
+			redisHit: 0.05, redisMiss: 0.15,
+			milvusHit: 0.95, milvusMiss: 0.85,
+			pgHit: 0.05, pgMiss: 0.15,
+		},
+		{
+			// inner product 12 hits and 8 misses a threshold of 10
+			metric: MetricDotProduct, threshold: "10",
+			redisHit: -11, redisMiss: -7,
+			milvusHit: 12, milvusMiss: 8,
+			pgHit: -12, pgMiss: -8,
+		},
+		{
+			// distance 0.4 hits and 0.6 misses a maximum distance of 0.5;
+			// Redis and Milvus report squared distances
+			metric: MetricEuclidean, threshold: "0.5",
+			redisHit: 0.16, redisMiss: 0.36,
+			milvusHit: 0.16, milvusMiss: 0.36,
+			pgHit: 0.4, pgMiss: 0.6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.metric), func(t *testing.T) {
+			threshold, err := tt.metric.ParseThreshold(tt.threshold)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for backend, scores := range map[string][2]float64{
+				"redis":    {tt.metric.scoreFromRedis(tt.redisHit), tt.metric.scoreFromRedis(tt.redisMiss)},
+				"milvus":   {tt.metric.scoreFromMilvus(tt.milvusHit), tt.metric.scoreFromMilvus(tt.milvusMiss)},
+				"pgvector": {tt.metric.scoreFromPgvector(tt.pgHit), tt.metric.scoreFromPgvector(tt.pgMiss)},
+			} {
+				if !tt.metric.IsHit(scores[0], threshold) {
+					t.Errorf("%s: score %v should hit threshold %v", backend, scores[0], threshold)
+				}
+				if tt.metric.IsHit(scores[1], threshold) {
+					t.Errorf("%s: below-threshold score %v treated as a hit", backend, scores[1])
+				}
+			}
+		})
+	}
+}
+
+func TestEuclideanScoreIsDistance(t *testing.T) {
+	if got := MetricEuclidean.scoreFromRedis(25); got != 5 {
+		t.Errorf("scoreFromRedis(25) = %v, want 5", got)
+	}
+	if got := MetricEuclidean.scoreFromRedis(-1e-9); got != 0 || math.IsNaN(got) {
+		t.Errorf("rounding below zero gave %v", got)
+	}
+}
+
+func TestParseDistanceMetric(t *testing.T) {
+	for in, want := range map[string]DistanceMetric{
+		"":            MetricCosine,
+		"cosine":      MetricCosine,
+		"IP":          MetricDotProduct,
+		"dot_product": MetricDotProduct,
+		"L2":          MetricEuclidean,
+		"EUCLIDEAN":   MetricEuclidean,
+	} {
+		if got, err := ParseDistanceMetric(in); err != nil || got != want {
+			t.Errorf("ParseDistanceMetric(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseDistanceMetric("MANHATTAN"); err == nil {
+		t.Error("expected an error for an unsupported metric")
+	}
+}
+
+func TestParseThresholdRejectsMeaninglessValues(t *testing.T) {
+	for _, tt := range []struct {
+		metric    DistanceMetric
+		threshold string
+	}{
+		{MetricCosine, "1.5"},
+		{MetricCosine, "-2"},
+		{MetricEuclidean, "-0.1"},
+		{MetricDotProduct, "NaN"},
+		{MetricDotProduct, "high"},
+	} {
+		if _, err := tt.metric.ParseThreshold(tt.threshold); err == nil {
+			t.Errorf("%s threshold %q: expected an error", tt.metric, tt.threshold)
+		}
+	}
+	// Inner products are unbounded
+	if _, err := MetricDotProduct.ParseThreshold("42"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigValidatesThresholdForMetric(t *testing.T) {
+	config := storeConfig("REDIS")
+	config.DistanceMetric = "EUCLIDEAN"
+	config.Threshold = "-1"
+	if err := ValidateVectorStoreConfigProps(config); err == nil {
+		t.Error("negative distance threshold accepted")
+	}
+	config.Threshold = "1.5"
+	if err := ValidateVectorStoreConfigProps(config); err != nil {
+		t.Errorf("distance threshold above 1 rejected: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package vectordb
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/client/v2/index"
 	"github.com/milvus-io/milvus/client/v2/milvusclient"
 	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
 )
 
 // MilvusVectorDBProvider implements the VectorDBProvider interface for Milvus
@@ -21,6 +23,8 @@ type MilvusVectorDBProvider struct {
 	ttl            int
 	client         *milvusclient.Client
 	collectionName string
+	metric         DistanceMetric
+	caller         *resilience.Caller
 }
 
 // Init initializes the Milvus vector DB provider with configuration
@@ -31,7 +35,8 @@ func (m *MilvusVectorDBProvider) Init(config VectorDBProviderConfig) error {
 	}
 	embeddingDimension := config.EmbeddingDimension
 	m.milvusURL = config.DBHost + ":" + strconv.Itoa(config.DBPort)
-	m.collectionName = fmt.Sprintf("%s_%s", VectorIndexPrefix, embeddingDimension)
+	m.metric, _ = ParseDistanceMetric(config.DistanceMetric)
+	m.collectionName = fmt.Sprintf("%s_%s%s", VectorIndexPrefix, embeddingDimension, m.metric.indexSuffix())
 	m.dimension, _ = strconv.Atoi(embeddingDimension)
 
 	m.ttl = DefaultTTL
@@ -43,6 +48,8 @@ func (m *MilvusVectorDBProvider) Init(config VectorDBProviderConfig) error {
 		m.ttl = parsedTTL
 	}
 
+	m.caller = newCaller("milvus-vectordb", config)
+
 	m.client, err = milvusclient.New(context.Background(), &milvusclient.ClientConfig{
 		Address: m.milvusURL,
 	})
@@ -100,9 +107,9 @@ func (m *MilvusVectorDBProvider) CreateIndex() error {
 
 	// Define HNSW Index Parameter
 	hnswIndex := index.NewHNSWIndex(
-		entity.COSINE, // MetricType: L2, IP, or COSINE (using COSINE for semantic similarity)
-		64,            // M: Maximum number of neighbors per node
-		100,           // efConstruction: Number of candidates during construction
+		m.milvusMetricType(), // MetricType: L2, IP, or COSINE, per the configured distance metric
+		64,                   // M: Maximum number of neighbors per node
+		100,                  // efConstruction: Number of candidates during construction
 	)
 
 	// Create the Index Option
@@ -119,8 +126,26 @@ func (m *MilvusVectorDBProvider) CreateIndex() error {
 	return nil
 }
 
-// Store stores the embeddings and associated response in Milvus
+func (m *MilvusVectorDBProvider) milvusMetricType() entity.MetricType {
+	switch m.metric {
+	case MetricDotProduct:
+		return entity.IP
+	case MetricEuclidean:
+		return entity.L2
+	}
+	return entity.COSINE
+}
+
+// Store stores an embedding along with the response. It is attempted once,
+// bounded by the configured timeout.
 func (m *MilvusVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	return callWithFilterContext(m.caller, filter, false, func(filter map[string]interface{}) error {
+		return m.store(embeddings, response, filter)
+	})
+}
+
+// store inserts the embeddings and associated response into Milvus
+func (m *MilvusVectorDBProvider) store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
 	// Safely retrieve and validate ctx
 	ctxVal, ok := filter["ctx"]
 	if !ok {
@@ -162,15 +187,83 @@ func (m *MilvusVectorDBProvider) Store(embeddings []float32, response CacheRespo
 		return fmt.Errorf("failed to insert data into Milvus: %w", err)
 	}
 
+	if maxEntries := filterInt(filter, FilterKeyMaxEntries); maxEntries > 0 {
+		if err := m.evictOldest(ctx, apiID, maxEntries); err != nil {
+			return fmt.Errorf("failed to evict old entries from Milvus: %w", err)
+		}
+	}
+
 	return nil
 }
 
-// Retrieve retrieves the most similar embedding from Milvus
+// evictOldest deletes the oldest entries of an API beyond maxEntries.
+func (m *MilvusVectorDBProvider) evictOldest(ctx context.Context, apiID string, maxEntries int) error {
+	rs, err := m.client.Query(ctx, milvusclient.NewQueryOption(m.collectionName).
+		WithConsistencyLevel(entity.ClStrong).
+		WithFilter(milvusAPIFilter(apiID)).
+		WithOutputFields("id", "created_at"))
+	if err != nil {
+		return err
+	}
+	if rs.ResultCount <= maxEntries {
+		return nil
+	}
+
+	type entry struct {
+		id        string
+		createdAt int64
+	}
+	entries := make([]entry, 0, rs.ResultCount)
+	for i := 0; i < rs.ResultCount; i++ {
+		id, err := rs.GetColumn("id").GetAsString(i)
+		if err != nil {
+			return err
+		}
+		createdAt, err := rs.GetColumn("created_at").GetAsInt64(i)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{id: id, createdAt: createdAt})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].createdAt < entries[j].createdAt })
+
+	ids := make([]string, 0, len(entries)-maxEntries)
+	for _, e := range entries[:len(entries)-maxEntries] {
+		ids = append(ids, e.id)
+	}
+	_, err = m.client.Delete(ctx, milvusclient.NewDeleteOption(m.collectionName).WithStringIDs("id", ids))
+	return err
+}
+
+// milvusAPIFilter returns the expression selecting the entries of an API. The
+// ID is written as a quoted string literal, which Milvus unquotes like Go does,
+// so quotes or operators in it cannot alter the expression.
+func milvusAPIFilter(apiID string) string {
+	return "api_id == " + strconv.Quote(apiID)
+}
+
+// Retrieve returns the cached response closest to the embedding, retrying
+// failed calls.
 func (m *MilvusVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	var result CacheResponse
+	err := callWithFilterContext(m.caller, filter, true, func(filter map[string]interface{}) error {
+		var err error
+		result, err = m.retrieve(embeddings, filter)
+		return err
+	})
+	return result, err
+}
+
+// retrieve searches Milvus for the most similar embedding
+func (m *MilvusVectorDBProvider) retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
 	ctx, ok := filter["ctx"].(context.Context)
 	if !ok {
 		return CacheResponse{}, fmt.Errorf("missing or invalid context in filter")
 	}
+	apiID, ok := filter["api_id"].(string)
+	if !ok || apiID == "" {
+		return CacheResponse{}, fmt.Errorf("missing or invalid api_id in filter")
+	}
 	loadTask, err := m.client.LoadCollection(ctx, milvusclient.NewLoadCollectionOption(m.collectionName))
 	if err != nil {
 		return CacheResponse{}, fmt.Errorf("failed to load collection: %w", err)
@@ -186,8 +279,9 @@ func (m *MilvusVectorDBProvider) Retrieve(embeddings []float32, filter map[strin
 		[]entity.Vector{entity.FloatVector(embeddings)},
 	).WithConsistencyLevel(entity.ClStrong).
 		WithANNSField(embeddingField).
-		WithFilter("api_id == '"+filter["api_id"].(string)+"'").
-		WithFilter("created_at >= "+strconv.FormatInt(time.Now().Unix()-int64(m.ttl), 10)).
+		// WithFilter replaces the expression, so both conditions go in one call
+		WithFilter(milvusAPIFilter(apiID)+" && created_at >= "+
+			strconv.FormatInt(time.Now().Unix()-int64(filterTTL(filter, m.ttl)), 10)).
 		WithOutputFields(responseField))
 
 	if err != nil {
@@ -204,8 +298,7 @@ func (m *MilvusVectorDBProvider) Retrieve(embeddings []float32, filter map[strin
 		return CacheResponse{}, nil
 	}
 
-	// Milvus COSINE metric returns similarity score (0-1, higher is better)
-	simScore := float64(rs.Scores[0])
+	score := m.metric.scoreFromMilvus(float64(rs.Scores[0]))
 	response := rs.GetColumn("response").FieldData().GetScalars()
 
 	// Check for threshold and compare with similarity score
@@ -213,13 +306,13 @@ func (m *MilvusVectorDBProvider) Retrieve(embeddings []float32, filter map[strin
 	if !ok {
 		return CacheResponse{}, fmt.Errorf("missing threshold")
 	}
-	thr, err := strconv.ParseFloat(thrRaw, 64)
-	if err != nil || thr == 0 {
+	thr, err := m.metric.ParseThreshold(thrRaw)
+	if err != nil {
 		return CacheResponse{}, fmt.Errorf("bad threshold value found: %w", err)
 	}
 
-	fmt.Printf("Similarity score: %f, Threshold: %f\n", simScore, thr)
-	if simScore < thr {
+	fmt.Printf("%s score: %f, Threshold: %f\n", m.metric, score, thr)
+	if !m.metric.IsHit(score, thr) {
 		return CacheResponse{}, nil
 	}
 	var resp CacheResponse
@@ -0,0 +1,302 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
+
+// PgVectorDBProvider implements the VectorDBProvider and VectorStore
+// interfaces for PostgreSQL with the pgvector extension. Postgres has no
+// native expiry, so each row records when it expires; expired rows are
+// skipped by queries and purged on writes to the same API.
+type PgVectorDBProvider struct {
+	table     string
+	dimension int
+	ttl       int
+	metric    DistanceMetric
+	pool      *pgxpool.Pool
+	caller    *resilience.Caller
+}
+
+// Init initializes the pgvector provider with configuration
+func (p *PgVectorDBProvider) Init(config VectorDBProviderConfig) error {
+	err := ValidateVectorStoreConfigProps(config)
+	if err != nil {
+		return err
+	}
+	p.dimension, err = strconv.Atoi(config.EmbeddingDimension)
+	if err != nil || p.dimension <= 0 {
+		return fmt.Errorf("invalid embedding dimension in the vector store configuration")
+	}
+	p.metric, _ = ParseDistanceMetric(config.DistanceMetric)
+	p.table = pgx.Identifier{VectorIndexPrefix + config.EmbeddingDimension + p.metric.indexSuffix()}.Sanitize()
+
+	p.ttl = DefaultTTL
+	if config.TTL != "" {
+		parsedTTL, err := strconv.Atoi(config.TTL)
+		if err != nil {
+			return fmt.Errorf("invalid TTL value: %v", err)
+		}
+		p.ttl = parsedTTL
+	}
+
+	connString := (&url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(config.Username, config.Password),
+		Host:   net.JoinHostPort(config.DBHost, strconv.Itoa(config.DBPort)),
+		Path:   "/" + config.DatabaseName,
+	}).String()
+	p.caller = newCaller("pgvector-vectordb", config)
+
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		// The connection string holds the password; report the cause only
+		return errors.New("invalid PostgreSQL connection settings in the vector store configuration")
+	}
+	p.pool, err = pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+	}
+	return nil
+}
+
+// GetType returns the type of the provider
+func (p *PgVectorDBProvider) GetType() string {
+	return "PGVECTOR"
+}
+
+// CreateIndex creates the pgvector extension, the entry table and its indexes
+// if they do not exist.
+func (p *PgVectorDBProvider) CreateIndex() error {
+	ctx := context.Background()
+	_, opclass := p.metric.pgvector()
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS vector`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			id TEXT PRIMARY KEY,
+			api_id TEXT NOT NULL,
+			embedding vector(%d) NOT NULL,
+			response BYTEA NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			expires_at TIMESTAMPTZ
+		)`, p.table, p.dimension),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (embedding %s)`,
+			p.indexName("embedding"), p.table, opclass),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (api_id, created_at)`,
+			p.indexName("api_created"), p.table),
+	}
+	for _, stmt := range statements {
+		if _, err := p.pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create the pgvector table: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *PgVectorDBProvider) indexName(suffix string) string {
+	return pgx.Identifier{strings.Trim(p.table, `"`) + "_" + suffix}.Sanitize()
+}
+
+// Store stores an embedding along with the response. It is attempted once,
+// bounded by the configured timeout.
+func (p *PgVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	return callWithFilterContext(p.caller, filter, false, func(filter map[string]interface{}) error {
+		return p.store(embeddings, response, filter)
+	})
+}
+
+// store inserts an embedding and its response, then trims the API's entries
+// to the max entries limit.
+func (p *PgVectorDBProvider) store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	ctx, ok := filter["ctx"].(context.Context)
+	if !ok {
+		return fmt.Errorf("missing or invalid context in filter")
+	}
+	apiID, ok := filter["api_id"].(string)
+	if !ok {
+		return fmt.Errorf("missing or invalid 'api_id' in filter")
+	}
+
+	err := p.Upsert(ctx, VectorEntry{
+		ID:        uuid.New().String(),
+		APIID:     apiID,
+		Embedding: embeddings,
+		Response:  response,
+		TTL:       time.Duration(filterTTL(filter, p.ttl)) * time.Second,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert data into PostgreSQL: %w", err)
+	}
+
+	if maxEntries := filterInt(filter, FilterKeyMaxEntries); maxEntries > 0 {
+		_, err := p.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %[1]s WHERE id IN (
+			SELECT id FROM %[1]s WHERE api_id = $1 ORDER BY created_at DESC OFFSET $2
+		)`, p.table), apiID, maxEntries)
+		if err != nil {
+			return fmt.Errorf("failed to evict old entries from PostgreSQL: %w", err)
+		}
+	}
+	return nil
+}
+
+// Retrieve returns the cached response closest to the embedding, retrying
+// failed calls.
+func (p *PgVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	var result CacheResponse
+	err := callWithFilterContext(p.caller, filter, true, func(filter map[string]interface{}) error {
+		var err error
+		result, err = p.retrieve(embeddings, filter)
+		return err
+	})
+	return result, err
+}
+
+// retrieve returns the response of the nearest entry when its score is within
+// the threshold in filter, and an empty response otherwise.
+func (p *PgVectorDBProvider) retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	ctx, ok := filter["ctx"].(context.Context)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing or invalid context in filter")
+	}
+	apiID, ok := filter["api_id"].(string)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing or invalid 'api_id' in filter")
+	}
+	thresholdStr, ok := filter["threshold"].(string)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing threshold")
+	}
+	threshold, err := p.metric.ParseThreshold(thresholdStr)
+	if err != nil {
+		return CacheResponse{}, fmt.Errorf("bad threshold value found: %w", err)
+	}
+
+	query := VectorQuery{APIID: apiID, Embedding: embeddings}
+	if ttl := filterInt(filter, FilterKeyTTL); ttl > 0 {
+		query.MaxAge = time.Duration(ttl) * time.Second
+	}
+	matches, err := p.Query(ctx, query)
+	if err != nil {
+		return CacheResponse{}, fmt.Errorf("failed to search in PostgreSQL: %w", err)
+	}
+	if len(matches) == 0 || !p.metric.IsHit(matches[0].Score, threshold) {
+		return CacheResponse{}, nil
+	}
+	return matches[0].Response, nil
+}
+
+// Upsert inserts the entry or replaces the entry with the same ID, and purges
+// the API's expired entries.
+func (p *PgVectorDBProvider) Upsert(ctx context.Context, entry VectorEntry) error {
+	if err := validateVectorEntry(entry); err != nil {
+		return err
+	}
+	responseBytes, err := SerializeObject(entry.Response)
+	if err != nil {
+		return err
+	}
+	ttl := entry.TTL
+	if ttl == 0 {
+		ttl = time.Duration(p.ttl) * time.Second
+	}
+	var expiresAt *time.Time
+	if ttl > 0 {
+		t := time.Now().Add(ttl)
+		expiresAt = &t
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(fmt.Sprintf(`INSERT INTO %s (id, api_id, embedding, response, created_at, expires_at)
+		VALUES ($1, $2, $3::vector, $4, now(), $5)
+		ON CONFLICT (id) DO UPDATE SET api_id = EXCLUDED.api_id, embedding = EXCLUDED.embedding,
+			response = EXCLUDED.response, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at`, p.table),
+		entry.ID, entry.APIID, vectorLiteral(entry.Embedding), responseBytes, expiresAt)
+	batch.Queue(fmt.Sprintf(`DELETE FROM %s WHERE api_id = $1 AND expires_at <= now()`, p.table), entry.APIID)
+	return p.pool.SendBatch(ctx, batch).Close()
+}
+
+// Query returns the API's unexpired entries ordered by the configured metric.
+func (p *PgVectorDBProvider) Query(ctx context.Context, query VectorQuery) ([]VectorMatch, error) {
+	if err := validateVectorQuery(query); err != nil {
+		return nil, err
+	}
+	var minCreatedAt *time.Time
+	if query.MaxAge > 0 {
+		t := time.Now().Add(-query.MaxAge)
+		minCreatedAt = &t
+	}
+	operator, _ := p.metric.pgvector()
+	rows, err := p.pool.Query(ctx, fmt.Sprintf(`SELECT id, response, embedding %[2]s $1::vector AS distance
+		FROM %[1]s
+		WHERE api_id = $2 AND (expires_at IS NULL OR expires_at > now())
+			AND ($3::timestamptz IS NULL OR created_at >= $3)
+		ORDER BY distance
+		LIMIT $4`, p.table, operator),
+		vectorLiteral(query.Embedding), query.APIID, minCreatedAt, query.limit())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []VectorMatch
+	for rows.Next() {
+		var match VectorMatch
+		var responseBytes []byte
+		var distance float64
+		if err := rows.Scan(&match.ID, &responseBytes, &distance); err != nil {
+			return nil, err
+		}
+		match.Score = p.metric.scoreFromPgvector(distance)
+		if err := deserializeObject(responseBytes, &match.Response); err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	return matches, rows.Err()
+}
+
+// Delete removes the given entries of the API.
+func (p *PgVectorDBProvider) Delete(ctx context.Context, apiID string, ids ...string) error {
+	if apiID == "" {
+		return errors.New("api_id is required")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := p.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE api_id = $1 AND id = ANY($2)`, p.table), apiID, ids)
+	return err
+}
+
+// Close closes the connection pool
+func (p *PgVectorDBProvider) Close() error {
+	if p.pool != nil {
+		p.pool.Close()
+	}
+	return nil
+}
+
+// vectorLiteral formats an embedding in pgvector's text representation, which
+// avoids a dependency on a pgvector type codec.
+func vectorLiteral(embedding []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range embedding {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
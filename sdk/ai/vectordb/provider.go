@@ -1,12 +1,41 @@
 package vectordb
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
+)
 
 const (
 	VectorIndexPrefix = "api_platform_semantic_cache_" // VectorIndexPrefix is the prefix for vector index keys in the cache
 	DefaultTTL        = 3600                           // DefaultTTL is the default time-to-live for cache entries in seconds (1 hour)
+	DefaultTimeout    = 5                              // DefaultTimeout is the default timeout of a database call in seconds
+	DefaultMaxRetries = 2                              // DefaultMaxRetries is the default number of retries of a failed Retrieve
 )
 
+// Optional filter keys understood by Store and Retrieve, alongside the required
+// "ctx", "api_id" and (for Retrieve) "threshold" keys. They let a policy override
+// the provider configuration per API.
+const (
+	// FilterKeyTTL overrides the provider TTL, in seconds. Store applies it to the
+	// new entry and Retrieve ignores entries older than it.
+	FilterKeyTTL = "ttl"
+
+	// FilterKeyMaxEntries caps the number of entries kept per API. When Store
+	// exceeds it, the oldest entries of that API are evicted. Zero or absent
+	// means unbounded.
+	FilterKeyMaxEntries = "max_entries"
+)
+
+// ErrNoResults is returned by Retrieve when the API has no cached entries.
+// Lookup counts it as a miss, not a failure.
+var ErrNoResults = errors.New("no results found")
+
 // VectorDBProvider defines the interface for vector database providers
 type VectorDBProvider interface {
 	Init(config VectorDBProviderConfig) error
@@ -29,11 +58,34 @@ type VectorDBProviderConfig struct {
 	Password            string
 	DatabaseName        string
 	TTL                 string
+	Timeout             string // Timeout bounds each database call, in seconds
+	MaxRetries          string // MaxRetries is the number of retries of a failed Retrieve; Store is never retried
+}
+
+// NewVectorDBProvider returns the initialized provider named by
+// config.VectorStoreProvider. The index is not created; call CreateIndex once
+// the database is reachable.
+func NewVectorDBProvider(config VectorDBProviderConfig) (VectorDBProvider, error) {
+	var p VectorDBProvider
+	switch config.VectorStoreProvider {
+	case "REDIS":
+		p = &RedisVectorDBProvider{}
+	case "MILVUS":
+		p = &MilvusVectorDBProvider{}
+	case "PGVECTOR":
+		p = &PgVectorDBProvider{}
+	default:
+		return nil, fmt.Errorf("invalid vector store provider found in the vector store configuration")
+	}
+	if err := p.Init(config); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 // ValidateVectorStoreConfigProps validates the properties of the vector store configuration.
 func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
-	if config.VectorStoreProvider != "REDIS" && config.VectorStoreProvider != "MILVUS" {
+	if config.VectorStoreProvider != "REDIS" && config.VectorStoreProvider != "MILVUS" && config.VectorStoreProvider != "PGVECTOR" {
 		return fmt.Errorf("invalid vector store provider found in the vector store configuration")
 	}
 	if config.EmbeddingDimension == "" {
@@ -42,6 +94,13 @@ func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
 	if config.Threshold == "" {
 		return fmt.Errorf("missing threshold in the vector store configuration")
 	}
+	metric, err := ParseDistanceMetric(config.DistanceMetric)
+	if err != nil {
+		return err
+	}
+	if _, err := metric.ParseThreshold(config.Threshold); err != nil {
+		return fmt.Errorf("invalid threshold in the vector store configuration: %w", err)
+	}
 	if config.DBHost == "" {
 		return fmt.Errorf("missing database host in the vector store configuration")
 	}
@@ -59,3 +118,75 @@ func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
 	}
 	return nil
 }
+
+// filterTTL returns the TTL override in filter, or def when absent or invalid.
+func filterTTL(filter map[string]interface{}, def int) int {
+	if ttl := filterInt(filter, FilterKeyTTL); ttl > 0 {
+		return ttl
+	}
+	return def
+}
+
+// filterInt reads a non-negative integer filter value. Numbers decoded from
+// policy parameters may arrive as float64.
+func filterInt(filter map[string]interface{}, key string) int {
+	switch v := filter[key].(type) {
+	case int:
+		if v > 0 {
+			return v
+		}
+	case int64:
+		if v > 0 {
+			return int(v)
+		}
+	case float64:
+		if v > 0 {
+			return int(v)
+		}
+	case string:
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// newCaller returns the resilience.Caller guarding the calls of a vector DB
+// provider, configured from the Timeout and MaxRetries properties.
+func newCaller(name string, config VectorDBProviderConfig) *resilience.Caller {
+	timeout := DefaultTimeout
+	if v, err := strconv.Atoi(config.Timeout); err == nil && v > 0 {
+		timeout = v
+	}
+	retries := DefaultMaxRetries
+	if v, err := strconv.Atoi(config.MaxRetries); err == nil && v >= 0 {
+		retries = v
+	}
+	if retries == 0 {
+		retries = -1 // resilience.Config treats zero as unset
+	}
+	return resilience.NewCaller(name, resilience.Config{
+		Timeout:    time.Duration(timeout) * time.Second,
+		MaxRetries: retries,
+	})
+}
+
+// callWithFilterContext runs fn through caller, replacing the "ctx" filter
+// value with the context of each attempt. Store calls pass retry false, since
+// every attempt inserts a new entry. A filter without a context is passed to
+// fn unchanged so the provider reports it.
+func callWithFilterContext(caller *resilience.Caller, filter map[string]interface{}, retry bool, fn func(filter map[string]interface{}) error) error {
+	ctx, ok := filter["ctx"].(context.Context)
+	if !ok {
+		return fn(filter)
+	}
+	call := caller.Once
+	if retry {
+		call = caller.Do
+	}
+	return call(ctx, func(ctx context.Context) error {
+		attempt := maps.Clone(filter)
+		attempt["ctx"] = ctx
+		return fn(attempt)
+	})
+}
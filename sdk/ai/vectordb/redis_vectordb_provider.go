@@ -9,16 +9,23 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/wso2/api-platform/sdk/core/utils/resilience"
 )
 
 const (
 	embeddingField = "embedding"
 	responseField  = "response"
 	keyPrefix      = "doc:"
+
+	// entriesKeyPrefix prefixes the sorted sets that track the entries of each
+	// API by insertion time, used to enforce the max entries limit.
+	entriesKeyPrefix = "cacheidx:"
 )
 
 // RedisVectorDBProvider implements the VectorDBProvider interface for Redis
@@ -30,7 +37,9 @@ type RedisVectorDBProvider struct {
 	indexID   string
 	dimension int
 	ttl       int
+	metric    DistanceMetric
 	client    *redis.Client
+	caller    *resilience.Caller
 }
 
 // Init initializes the Redis vector DB provider with configuration
@@ -51,7 +60,8 @@ func (r *RedisVectorDBProvider) Init(config VectorDBProviderConfig) error {
 	}
 
 	embeddingDimension := config.EmbeddingDimension
-	r.indexID = VectorIndexPrefix + embeddingDimension
+	r.metric, _ = ParseDistanceMetric(config.DistanceMetric)
+	r.indexID = VectorIndexPrefix + embeddingDimension + r.metric.indexSuffix()
 	r.dimension, err = strconv.Atoi(embeddingDimension)
 	if err != nil {
 		fmt.Printf("unable to parse and convert the embedding dimension to Int: %v", err)
@@ -68,6 +78,8 @@ func (r *RedisVectorDBProvider) Init(config VectorDBProviderConfig) error {
 		r.ttl = parsedTTL
 	}
 
+	r.caller = newCaller("redis-vectordb", config)
+
 	r.client = redis.NewClient(&redis.Options{
 		Addr:     r.redisURL,
 		Username: r.username,
@@ -109,7 +121,7 @@ func (r *RedisVectorDBProvider) CreateIndex() error {
 			VectorArgs: &redis.FTVectorArgs{
 				HNSWOptions: &redis.FTHNSWOptions{
 					Dim:            r.dimension,
-					DistanceMetric: "COSINE",
+					DistanceMetric: r.metric.redisName(),
 					Type:           "FLOAT32",
 				},
 			},
@@ -123,8 +135,16 @@ func (r *RedisVectorDBProvider) CreateIndex() error {
 	return nil
 }
 
-// Store stores an embedding in Redis along with the response
+// Store stores an embedding along with the response. It is attempted once,
+// bounded by the configured timeout.
 func (r *RedisVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	return callWithFilterContext(r.caller, filter, false, func(filter map[string]interface{}) error {
+		return r.store(embeddings, response, filter)
+	})
+}
+
+// store writes an embedding to Redis along with the response
+func (r *RedisVectorDBProvider) store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
 	// Safely retrieve and validate ctx
 	ctxVal, ok := filter["ctx"]
 	if !ok {
@@ -145,31 +165,24 @@ func (r *RedisVectorDBProvider) Store(embeddings []float32, response CacheRespon
 		return fmt.Errorf("'api_id' must be of type string, got %T", apiIDVal)
 	}
 
-	embeddingBytes := FloatsToBytes(embeddings)
-	responseBytes, err := SerializeObject(response)
-	if err != nil {
-		fmt.Printf("Unable to serialize the response object: %v\n", err.Error())
-		return err
-	}
-
 	docID := uuid.New().String()
 	redisKey := keyPrefix + docID
-
-	_, err = r.client.HSet(ctx, redisKey, map[string]any{
-		responseField:  responseBytes,
-		"api_id":       apiID,
-		embeddingField: embeddingBytes,
-	}).Result()
-
+	ttl := filterTTL(filter, r.ttl)
+	err := r.Upsert(ctx, VectorEntry{
+		ID:        docID,
+		APIID:     apiID,
+		Embedding: embeddings,
+		Response:  response,
+		TTL:       time.Duration(ttl) * time.Second,
+	})
 	if err != nil {
 		fmt.Printf("Failed to store the redis entry: %v\n", err.Error())
 		return err
 	}
 
-	if r.ttl > 0 {
-		_, err = r.client.Expire(ctx, redisKey, time.Duration(r.ttl)*time.Second).Result()
-		if err != nil {
-			fmt.Printf("Failed to set the ttl for the specified redis entry: %v\n", err.Error())
+	if maxEntries := filterInt(filter, FilterKeyMaxEntries); maxEntries > 0 {
+		if err := r.evictOldest(ctx, apiID, redisKey, ttl, maxEntries); err != nil {
+			fmt.Printf("Failed to evict old redis entries: %v\n", err.Error())
 			return err
 		}
 	}
@@ -177,8 +190,59 @@ func (r *RedisVectorDBProvider) Store(embeddings []float32, response CacheRespon
 	return nil
 }
 
-// Retrieve retrieves the most similar embedding from Redis
+// evictOldest records a stored entry in the API's insertion-ordered index and
+// deletes the oldest entries beyond maxEntries.
+func (r *RedisVectorDBProvider) evictOldest(ctx context.Context, apiID, redisKey string, ttl, maxEntries int) error {
+	entriesKey := entriesKeyPrefix + r.indexID + ":" + apiID
+	now := time.Now()
+
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, entriesKey, redis.Z{Score: float64(now.UnixMilli()), Member: redisKey})
+	if ttl > 0 {
+		// Entries whose hash has already expired only need to leave the index
+		expiredBefore := now.Add(-time.Duration(ttl) * time.Second).UnixMilli()
+		pipe.ZRemRangeByScore(ctx, entriesKey, "-inf", strconv.FormatInt(expiredBefore, 10))
+		pipe.Expire(ctx, entriesKey, time.Duration(ttl)*time.Second)
+	}
+	count := pipe.ZCard(ctx, entriesKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	excess := count.Val() - int64(maxEntries)
+	if excess <= 0 {
+		return nil
+	}
+	evicted, err := r.client.ZPopMin(ctx, entriesKey, excess).Result()
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(evicted))
+	for _, z := range evicted {
+		if key, ok := z.Member.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
+
+// Retrieve returns the cached response closest to the embedding, retrying
+// failed calls.
 func (r *RedisVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	var result CacheResponse
+	err := callWithFilterContext(r.caller, filter, true, func(filter map[string]interface{}) error {
+		var err error
+		result, err = r.retrieve(embeddings, filter)
+		return err
+	})
+	return result, err
+}
+
+// retrieve searches Redis for the most similar embedding
+func (r *RedisVectorDBProvider) retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
 	// Safely retrieve and validate ctx
 	ctxVal, ok := filter["ctx"]
 	if !ok {
@@ -205,13 +269,72 @@ func (r *RedisVectorDBProvider) Retrieve(embeddings []float32, filter map[string
 		return CacheResponse{}, errors.New("api_id is required in filter")
 	}
 
-	embeddingBytes := FloatsToBytes(embeddings)
+	matches, err := r.Query(ctx, VectorQuery{APIID: apiID, Embedding: embeddings})
+	if err != nil {
+		fmt.Printf("Error during FTSearch: %v\n", err)
+		return CacheResponse{}, err
+	}
+	if len(matches) == 0 {
+		// A miss is an answer, not a failure of the database
+		return CacheResponse{}, resilience.Permanent(ErrNoResults)
+	}
+
+	thresholdStr, ok := filter["threshold"].(string)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing threshold in filter")
+	}
+	threshold, err := r.metric.ParseThreshold(thresholdStr)
+	if err != nil {
+		return CacheResponse{}, fmt.Errorf("invalid threshold: %w", err)
+	}
+
+	score := matches[0].Score
+	fmt.Printf("%s Score: %f | Threshold: %f", r.metric, score, threshold)
 
-	knnQuery := fmt.Sprintf(
-		"@api_id:{\"%s\"}=>[KNN $K @%s $vec AS score]",
-		apiID, embeddingField,
-	)
-	fmt.Printf("KNN Query: %s", knnQuery)
+	if !r.metric.IsHit(score, threshold) {
+		return CacheResponse{}, nil
+	}
+	return matches[0].Response, nil
+}
+
+// Upsert writes the entry as a hash, replacing an entry with the same ID, and
+// sets its expiry.
+func (r *RedisVectorDBProvider) Upsert(ctx context.Context, entry VectorEntry) error {
+	if err := validateVectorEntry(entry); err != nil {
+		return err
+	}
+	responseBytes, err := SerializeObject(entry.Response)
+	if err != nil {
+		return err
+	}
+	ttl := entry.TTL
+	if ttl == 0 {
+		ttl = time.Duration(r.ttl) * time.Second
+	}
+
+	redisKey := keyPrefix + entry.ID
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, redisKey, map[string]any{
+		responseField:  responseBytes,
+		"api_id":       entry.APIID,
+		embeddingField: FloatsToBytes(entry.Embedding),
+	})
+	if ttl > 0 {
+		pipe.Expire(ctx, redisKey, ttl)
+	} else {
+		pipe.Persist(ctx, redisKey)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Query runs a KNN search restricted to the API's entries. Redis does not
+// record insertion time, so MaxAge is left to the entry TTL.
+func (r *RedisVectorDBProvider) Query(ctx context.Context, query VectorQuery) ([]VectorMatch, error) {
+	if err := validateVectorQuery(query); err != nil {
+		return nil, err
+	}
+	knnQuery := fmt.Sprintf("@api_id:{%s}=>[KNN $K @%s $vec AS score]", escapeTagValue(query.APIID), embeddingField)
 	results, err := r.client.FTSearchWithArgs(ctx,
 		r.indexID,
 		knnQuery,
@@ -220,65 +343,80 @@ func (r *RedisVectorDBProvider) Retrieve(embeddings []float32, filter map[string
 				{FieldName: responseField},
 				{FieldName: "score"},
 			},
+			SortBy:         []redis.FTSearchSortBy{{FieldName: "score", Asc: true}},
 			DialectVersion: 2,
 			Params: map[string]any{
-				"K":   1,
-				"vec": embeddingBytes,
+				"K":   query.limit(),
+				"vec": FloatsToBytes(query.Embedding),
 			},
 		},
 	).Result()
-
 	if err != nil {
-		fmt.Printf("Error during FTSearch: %v\n", err)
-		return CacheResponse{}, err
+		return nil, err
 	}
 
-	if results.Total == 0 {
-		fmt.Printf("No results found: %v\n", err)
-		return CacheResponse{}, errors.New("no results found")
+	matches := make([]VectorMatch, 0, len(results.Docs))
+	for _, doc := range results.Docs {
+		scoreStr, ok := doc.Fields["score"]
+		if !ok {
+			return nil, fmt.Errorf("missing 'score' field in document %s", doc.ID)
+		}
+		distance, err := strconv.ParseFloat(scoreStr, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid score '%s' for document %s: %w", scoreStr, doc.ID, err)
+		}
+		var resp CacheResponse
+		if err := deserializeObject([]byte(doc.Fields[responseField]), &resp); err != nil {
+			return nil, err
+		}
+		matches = append(matches, VectorMatch{
+			ID:       strings.TrimPrefix(doc.ID, keyPrefix),
+			Response: resp,
+			Score:    r.metric.scoreFromRedis(distance),
+		})
 	}
+	return matches, nil
+}
 
-	// Take the top-hit document
-	doc := results.Docs[0]
-	scoreStr, ok := doc.Fields["score"]
-	if !ok {
-		fmt.Printf("Error: missing 'score' field in document %s", doc.ID)
-		return CacheResponse{}, fmt.Errorf("missing 'score' field in document %s", doc.ID)
+// Delete removes the given entries if they belong to the API.
+func (r *RedisVectorDBProvider) Delete(ctx context.Context, apiID string, ids ...string) error {
+	if apiID == "" {
+		return errors.New("api_id is required")
 	}
-	score, err := strconv.ParseFloat(scoreStr, 64)
-	if err != nil {
-		fmt.Printf("Error: failed to parse score '%s' for document %s: %v", scoreStr, doc.ID, err)
-		return CacheResponse{}, fmt.Errorf("invalid score '%s' for document %s: %w", scoreStr, doc.ID, err)
+	if len(ids) == 0 {
+		return nil
 	}
-
-	thresholdStr, ok := filter["threshold"].(string)
-	if !ok {
-		return CacheResponse{}, fmt.Errorf("missing threshold in filter")
+	owners := make([]*redis.StringCmd, len(ids))
+	pipe := r.client.Pipeline()
+	for i, id := range ids {
+		owners[i] = pipe.HGet(ctx, keyPrefix+id, "api_id")
 	}
-	threshold, err := strconv.ParseFloat(thresholdStr, 64)
-	if err != nil {
-		return CacheResponse{}, fmt.Errorf("invalid threshold: %w", err)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return err
 	}
-
-	similarityScore := 1.0 - score
-	fmt.Printf("Distance Score: %f | Similarity Score: %f | Similarity Threshold: %f", score, similarityScore, threshold)
-
-	if similarityScore < threshold {
-		return CacheResponse{}, nil
+	keys := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if owner, err := owners[i].Result(); err == nil && owner == apiID {
+			keys = append(keys, keyPrefix+id)
+		}
 	}
-
-	// Fetch the serialized response blob from Redis
-	respBytes, err := r.client.HGet(ctx, doc.ID, responseField).Bytes()
-	if err != nil {
-		return CacheResponse{}, err
+	if len(keys) == 0 {
+		return nil
 	}
+	return r.client.Del(ctx, keys...).Err()
+}
 
-	var resp CacheResponse
-	if err := deserializeObject(respBytes, &resp); err != nil {
-		return CacheResponse{}, err
+// escapeTagValue escapes the characters RediSearch treats as separators or
+// syntax in a TAG query, so an API ID cannot alter the query.
+func escapeTagValue(v string) string {
+	var b strings.Builder
+	for _, c := range v {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
 	}
-
-	return resp, nil
+	return b.String()
 }
 
 // Close closes the Redis client connection
@@ -0,0 +1,99 @@
+package vectordb
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// VectorStore is a backend-neutral view of a vector database, for callers that
+// manage entries directly rather than through Store and Retrieve. The Redis and
+// pgvector providers implement it.
+type VectorStore interface {
+	// Upsert inserts the entry, or replaces the entry with the same ID.
+	Upsert(ctx context.Context, entry VectorEntry) error
+	// Query returns the entries of an API closest to the query embedding in
+	// the configured DistanceMetric, nearest first.
+	Query(ctx context.Context, query VectorQuery) ([]VectorMatch, error)
+	// Delete removes the given entries of an API. Unknown IDs are ignored.
+	Delete(ctx context.Context, apiID string, ids ...string) error
+}
+
+// VectorEntry is an embedding stored with the response it maps to.
+type VectorEntry struct {
+	ID        string
+	APIID     string
+	Embedding []float32
+	Response  CacheResponse
+	// TTL is how long the entry is kept. Zero selects the provider TTL.
+	TTL time.Duration
+}
+
+// VectorQuery selects the entries nearest to Embedding within one API.
+type VectorQuery struct {
+	APIID     string
+	Embedding []float32
+	// Limit is the maximum number of matches returned. Zero means one.
+	Limit int
+	// MaxAge, when set, skips entries stored longer ago than it. Backends
+	// that do not record insertion time rely on the entry TTL instead.
+	MaxAge time.Duration
+}
+
+// VectorMatch is an entry returned by Query.
+type VectorMatch struct {
+	ID       string
+	Response CacheResponse
+	// Score compares the entry to the query embedding in the provider's
+	// DistanceMetric: a similarity for cosine and dot product, a distance for
+	// Euclidean. Use DistanceMetric.IsHit to compare it with a threshold.
+	Score float64
+}
+
+// NewVectorStore returns the initialized VectorStore selected by
+// config.VectorStoreProvider.
+func NewVectorStore(config VectorDBProviderConfig) (VectorStore, error) {
+	var store interface {
+		VectorStore
+		Init(config VectorDBProviderConfig) error
+	}
+	switch config.VectorStoreProvider {
+	case "REDIS":
+		store = &RedisVectorDBProvider{}
+	case "PGVECTOR":
+		store = &PgVectorDBProvider{}
+	default:
+		return nil, fmt.Errorf("vector store provider %q does not support the VectorStore interface", config.VectorStoreProvider)
+	}
+	if err := store.Init(config); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (q VectorQuery) limit() int {
+	if q.Limit <= 0 {
+		return 1
+	}
+	return q.Limit
+}
+
+func validateVectorQuery(q VectorQuery) error {
+	if q.APIID == "" {
+		return fmt.Errorf("api_id is required in the query")
+	}
+	if len(q.Embedding) == 0 {
+		return fmt.Errorf("embedding is required in the query")
+	}
+	return nil
+}
+
+func validateVectorEntry(e VectorEntry) error {
+	if e.ID == "" || e.APIID == "" {
+		return fmt.Errorf("entry id and api_id are required")
+	}
+	if len(e.Embedding) == 0 {
+		return fmt.Errorf("entry embedding is required")
+	}
+	return nil
+}
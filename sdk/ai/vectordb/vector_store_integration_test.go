@@ -0,0 +1,143 @@
+//go:build integration
+
+package vectordb
+
+// VectorStore integration tests against real backends. Each backend is skipped
+// unless its address is set, e.g.:
+//
+//	docker run -d -p 5432:5432 -e POSTGRES_PASSWORD=postgres pgvector/pgvector:pg17
+//	PGVECTOR_HOST=localhost go test -tags integration ./vectordb/...
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func integrationStore(t *testing.T, provider, hostEnv string, defaultPort int, config VectorDBProviderConfig) VectorStore {
+	t.Helper()
+	host := os.Getenv(hostEnv)
+	if host == "" {
+		t.Skipf("%s not set", hostEnv)
+	}
+	config.VectorStoreProvider = provider
+	config.DBHost = host
+	config.DBPort = defaultPort
+	config.EmbeddingDimension = "3"
+	config.Threshold = "0.9"
+	store, err := NewVectorStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := store.(VectorDBProvider)
+	t.Cleanup(func() { _ = db.Close() })
+	if err := db.CreateIndex(); err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestPgVectorStore(t *testing.T) {
+	port, _ := strconv.Atoi(os.Getenv("PGVECTOR_PORT"))
+	if port == 0 {
+		port = 5432
+	}
+	store := integrationStore(t, "PGVECTOR", "PGVECTOR_HOST", port, VectorDBProviderConfig{
+		Username:     envOr("PGVECTOR_USER", "postgres"),
+		Password:     envOr("PGVECTOR_PASSWORD", "postgres"),
+		DatabaseName: envOr("PGVECTOR_DB", "postgres"),
+	})
+	testVectorStore(t, store)
+
+	// Postgres records insertion time, so MaxAge is applied
+	ctx := context.Background()
+	apiID := uuid.New().String()
+	if err := store.Upsert(ctx, VectorEntry{ID: uuid.New().String(), APIID: apiID, Embedding: []float32{1, 0, 0}}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	matches, err := store.Query(ctx, VectorQuery{APIID: apiID, Embedding: []float32{1, 0, 0}, MaxAge: 10 * time.Millisecond})
+	if err != nil || len(matches) != 0 {
+		t.Errorf("Query(MaxAge) = %v, %v; want no matches", matches, err)
+	}
+}
+
+func TestRedisVectorStore(t *testing.T) {
+	store := integrationStore(t, "REDIS", "REDIS_HOST", 6379, VectorDBProviderConfig{
+		Username:     envOr("REDIS_USER", "default"),
+		Password:     envOr("REDIS_PASSWORD", "redis"),
+		DatabaseName: "0",
+	})
+	testVectorStore(t, store)
+}
+
+// testVectorStore checks the behavior every VectorStore shares.
+func testVectorStore(t *testing.T, store VectorStore) {
+	ctx := context.Background()
+	apiID, otherAPI := uuid.New().String(), uuid.New().String()
+	near, far, foreign := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	for _, e := range []VectorEntry{
+		{ID: near, APIID: apiID, Embedding: []float32{1, 0.1, 0}, Response: CacheResponse{StatusCode: "200"}},
+		{ID: far, APIID: apiID, Embedding: []float32{0, 1, 0}, Response: CacheResponse{StatusCode: "201"}},
+		{ID: foreign, APIID: otherAPI, Embedding: []float32{1, 0, 0}},
+	} {
+		if err := store.Upsert(ctx, e); err != nil {
+			t.Fatalf("Upsert(%s): %v", e.ID, err)
+		}
+	}
+
+	matches, err := store.Query(ctx, VectorQuery{APIID: apiID, Embedding: []float32{1, 0, 0}, Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 || matches[0].ID != near || matches[0].Response.StatusCode != "200" {
+		t.Fatalf("Query() = %+v, want the API's two entries, nearest first", matches)
+	}
+	if matches[0].Score < 0.99 || matches[1].Score > 0.1 {
+		t.Errorf("scores = %v, %v", matches[0].Score, matches[1].Score)
+	}
+
+	// Upsert replaces an entry with the same ID
+	if err := store.Upsert(ctx, VectorEntry{ID: near, APIID: apiID, Embedding: []float32{1, 0.1, 0}, Response: CacheResponse{StatusCode: "203"}}); err != nil {
+		t.Fatal(err)
+	}
+	if matches, _ := store.Query(ctx, VectorQuery{APIID: apiID, Embedding: []float32{1, 0, 0}}); len(matches) != 1 || matches[0].Response.StatusCode != "203" {
+		t.Errorf("after upsert Query() = %+v", matches)
+	}
+
+	// Delete is scoped to the API
+	if err := store.Delete(ctx, apiID, near, foreign); err != nil {
+		t.Fatal(err)
+	}
+	if matches, _ := store.Query(ctx, VectorQuery{APIID: apiID, Embedding: []float32{1, 0, 0}, Limit: 5}); len(matches) != 1 || matches[0].ID != far {
+		t.Errorf("after delete Query() = %+v, want only %s", matches, far)
+	}
+	if matches, _ := store.Query(ctx, VectorQuery{APIID: otherAPI, Embedding: []float32{1, 0, 0}}); len(matches) != 1 {
+		t.Errorf("Delete removed another API's entry")
+	}
+
+	// Entries expire with their TTL
+	expiring := uuid.New().String()
+	if err := store.Upsert(ctx, VectorEntry{ID: expiring, APIID: otherAPI, Embedding: []float32{0, 0, 1}, TTL: time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(1500 * time.Millisecond)
+	matches, _ = store.Query(ctx, VectorQuery{APIID: otherAPI, Embedding: []float32{0, 0, 1}, Limit: 5})
+	for _, m := range matches {
+		if m.ID == expiring {
+			t.Error("expired entry returned")
+		}
+	}
+}
+
+func envOr(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,184 @@
+package vectordb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/wso2/api-platform/sdk/core/testutils"
+)
+
+func storeConfig(provider string) VectorDBProviderConfig {
+	return VectorDBProviderConfig{
+		VectorStoreProvider: provider,
+		EmbeddingDimension:  "3",
+		Threshold:           "0.9",
+		DBHost:              "localhost",
+		DBPort:              1,
+		Username:            "user",
+		Password:            "secret",
+		DatabaseName:        "0",
+	}
+}
+
+// Clients connect lazily, so selection works without a running database.
+func TestNewVectorStoreSelectsProvider(t *testing.T) {
+	for provider, want := range map[string]string{"REDIS": "REDIS", "PGVECTOR": "PGVECTOR"} {
+		store, err := NewVectorStore(storeConfig(provider))
+		if err != nil {
+			t.Fatalf("%s: %v", provider, err)
+		}
+		if got := store.(VectorDBProvider).GetType(); got != want {
+			t.Errorf("%s: GetType() = %q", provider, got)
+		}
+		_ = store.(VectorDBProvider).Close()
+	}
+}
+
+func TestNewVectorStoreRejectsInvalid(t *testing.T) {
+	milvus := storeConfig("MILVUS")
+	badDimension := storeConfig("PGVECTOR")
+	badDimension.EmbeddingDimension = "abc"
+	noPassword := storeConfig("PGVECTOR")
+	noPassword.Password = ""
+
+	for name, config := range map[string]VectorDBProviderConfig{
+		"no VectorStore support": milvus,
+		"unknown provider":       storeConfig("QDRANT"),
+		"bad dimension":          badDimension,
+		"missing password":       noPassword,
+	} {
+		if _, err := NewVectorStore(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestNewVectorDBProvider(t *testing.T) {
+	for _, provider := range []string{"REDIS", "PGVECTOR"} {
+		db, err := NewVectorDBProvider(storeConfig(provider))
+		if err != nil {
+			t.Fatalf("%s: %v", provider, err)
+		}
+		if got := db.GetType(); got != provider {
+			t.Errorf("%s: GetType() = %q", provider, got)
+		}
+		_ = db.Close()
+	}
+	if _, err := NewVectorDBProvider(storeConfig("QDRANT")); err == nil {
+		t.Error("expected an error for an unknown provider")
+	}
+}
+
+func TestPgVectorConnectionErrorsHidePassword(t *testing.T) {
+	config := storeConfig("PGVECTOR")
+	config.DBHost = "bad host%"
+	_, err := NewVectorStore(config)
+	if err == nil {
+		t.Skip("pgx accepted the host")
+	}
+	if strings.Contains(err.Error(), config.Password) {
+		t.Errorf("error %q leaks the password", err)
+	}
+}
+
+func TestVectorLiteral(t *testing.T) {
+	if got := vectorLiteral([]float32{0.5, -1, 3e-8}); got != "[0.5,-1,3e-08]" {
+		t.Errorf("vectorLiteral() = %q", got)
+	}
+}
+
+func TestEscapeTagValue(t *testing.T) {
+	for in, want := range map[string]string{
+		"api_1":                "api_1",
+		"a-b.c":                `a\-b\.c`,
+		`x} | @api_id:{*`:      `x\}\ \|\ \@api_id\:\{\*`,
+		"550e8400-e29b-41d4-a": `550e8400\-e29b\-41d4\-a`,
+	} {
+		if got := escapeTagValue(in); got != want {
+			t.Errorf("escapeTagValue(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMilvusAPIFilterQuotesID(t *testing.T) {
+	for in, want := range map[string]string{
+		"api_1":                      `api_id == "api_1"`,
+		`x' || api_id != '`:          `api_id == "x' || api_id != '"`,
+		`x" || api_id != "`:          `api_id == "x\" || api_id != \""`,
+		`x\" || true || api_id == "`: `api_id == "x\\\" || true || api_id == \""`,
+	} {
+		if got := milvusAPIFilter(in); got != want {
+			t.Errorf("milvusAPIFilter(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestFilterIntAndTTL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		value interface{}
+		want  int
+	}{
+		"int":          {5, 5},
+		"int64":        {int64(5), 5},
+		"float64":      {float64(5), 5},
+		"string":       {"5", 5},
+		"zero":         {0, 0},
+		"negative":     {-3, 0},
+		"bad string":   {"five", 0},
+		"unknown type": {true, 0},
+	} {
+		filter := map[string]interface{}{FilterKeyMaxEntries: tc.value, FilterKeyTTL: tc.value}
+		if got := filterInt(filter, FilterKeyMaxEntries); got != tc.want {
+			t.Errorf("%s: filterInt() = %d, want %d", name, got, tc.want)
+		}
+		wantTTL := tc.want
+		if wantTTL == 0 {
+			wantTTL = DefaultTTL
+		}
+		if got := filterTTL(filter, DefaultTTL); got != wantTTL {
+			t.Errorf("%s: filterTTL() = %d, want %d", name, got, wantTTL)
+		}
+	}
+	if got := filterTTL(map[string]interface{}{}, 60); got != 60 {
+		t.Errorf("filterTTL() without override = %d, want 60", got)
+	}
+}
+
+func TestRedisEvictOldestKeepsNewestEntries(t *testing.T) {
+	start := time.Now()
+	server := testutils.StartFakeRedis(t, func() time.Time { return start })
+	r := &RedisVectorDBProvider{indexID: "idx", client: redis.NewClient(&redis.Options{Addr: server.Addr})}
+	t.Cleanup(func() { _ = r.Close() })
+	ctx := context.Background()
+
+	// An entry whose hash has already expired only leaves the index
+	server.ZAdd("cacheidx:idx:api", float64(time.Now().Add(-2*time.Hour).UnixMilli()), "doc:expired")
+	for _, key := range []string{"doc:a", "doc:b", "doc:c"} {
+		server.Set(key, "1")
+		if err := r.evictOldest(ctx, "api", key, 3600, 2); err != nil {
+			t.Fatalf("evictOldest(%s): %v", key, err)
+		}
+	}
+
+	if got := server.Members("cacheidx:idx:api"); strings.Join(got, ",") != "doc:b,doc:c" {
+		t.Errorf("index = %v, want doc:b,doc:c", got)
+	}
+	if server.Exists("doc:a") || !server.Exists("doc:b") || !server.Exists("doc:c") {
+		t.Errorf("doc:a must be deleted and doc:b, doc:c kept, got keys %v", server.Keys())
+	}
+	if got := server.ExpiryOf("cacheidx:idx:api"); !got.Equal(start.Add(time.Hour)) {
+		t.Errorf("index expiry = %q, want the entry TTL", got)
+	}
+
+	// Other APIs are tracked in their own index
+	server.Set("doc:other", "1")
+	if err := r.evictOldest(ctx, "other", "doc:other", 3600, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !server.Exists("doc:other") || !server.Exists("doc:b") {
+		t.Errorf("evicting for one API must not touch another, got keys %v", server.Keys())
+	}
+}
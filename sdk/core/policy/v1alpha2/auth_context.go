@@ -12,15 +12,16 @@ type AuthContext struct {
 	Authorized bool
 
 	// AuthType identifies the authentication mechanism used.
-	// Common values: "jwt", "basic", "apikey".
+	// Common values: "jwt", "basic", "apikey", "mtls".
 	// MCP convention: "mcp/oauth" for MCP OAuth authentication; "mcp/oauth+authz" after MCP authorization passes.
 	AuthType string
 
 	// Subject is the principal identity — JWT "sub" claim, basic-auth username,
-	// or API key owner.
+	// API key owner, or client certificate subject DN.
 	Subject string
 
-	// Issuer is the JWT "iss" claim. Empty for basic auth and API key auth.
+	// Issuer is the JWT "iss" claim, or the client certificate issuer DN for mTLS.
+	// Empty for basic auth and API key auth.
 	Issuer string
 
 	// Audience holds the JWT "aud" claim values. Nil for basic auth and API key auth.
@@ -20,6 +20,10 @@ type Body struct {
 // DownstreamContext identifies the downstream client and carries a snapshot of
 // the client request.
 type DownstreamContext struct {
+	// ClientIP is the IP address of the downstream peer connected to the
+	// gateway, without the port. Empty when the address is unavailable.
+	ClientIP string
+
 	Request *DownstreamRequest
 }
 
@@ -26,6 +26,9 @@ type PolicyDefinition struct {
 
 	// SystemParameters for THIS version
 	SystemParameters map[string]interface{} `yaml:"systemParameters" json:"systemParameters"`
+
+	// Names of policies that must run before this one when both are in the same chain
+	DependsOn []string `yaml:"dependsOn,omitempty" json:"dependsOn,omitempty"`
 }
 
 // PolicySpec is a configuration instance specifying how to use a policy
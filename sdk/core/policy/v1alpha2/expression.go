@@ -0,0 +1,40 @@
+package policyv1alpha2
+
+import "sync"
+
+// ExpressionEvaluator evaluates CEL expressions against the request or response
+// being processed. Expressions see the same variables and helper functions as
+// execution conditions (request.Headers, response.ResponseStatus, jwt('sub'), …)
+// but may return any value, not only a boolean.
+//
+// The policy engine registers its evaluator at startup; policies obtain it
+// through GetExpressionEvaluator.
+type ExpressionEvaluator interface {
+	// ValidateExpression compiles an expression without evaluating it.
+	ValidateExpression(expression string) error
+
+	// EvaluateRequestHeaderExpression evaluates an expression in the request headers phase.
+	EvaluateRequestHeaderExpression(expression string, ctx *RequestHeaderContext) (interface{}, error)
+
+	// EvaluateResponseHeaderExpression evaluates an expression in the response headers phase.
+	EvaluateResponseHeaderExpression(expression string, ctx *ResponseHeaderContext) (interface{}, error)
+}
+
+var (
+	expressionEvaluatorMu sync.RWMutex
+	expressionEvaluator   ExpressionEvaluator
+)
+
+// SetExpressionEvaluator registers the evaluator returned by GetExpressionEvaluator.
+func SetExpressionEvaluator(e ExpressionEvaluator) {
+	expressionEvaluatorMu.Lock()
+	defer expressionEvaluatorMu.Unlock()
+	expressionEvaluator = e
+}
+
+// GetExpressionEvaluator returns the registered evaluator, or nil when none is registered.
+func GetExpressionEvaluator() ExpressionEvaluator {
+	expressionEvaluatorMu.RLock()
+	defer expressionEvaluatorMu.RUnlock()
+	return expressionEvaluator
+}
@@ -151,3 +151,12 @@ type StreamingResponsePolicy interface {
 	OnResponseBodyChunk(ctx context.Context, respCtx *ResponseStreamContext, chunk *StreamBody, params map[string]interface{}) StreamingResponseAction
 	NeedsMoreResponseData(accumulated []byte) bool
 }
+
+// StateReporter is implemented by policies that keep runtime state across
+// requests (e.g. a circuit breaker). The policy engine calls PolicyState when
+// its admin API or metrics are scraped and exposes the snapshot per route.
+// Numeric and boolean values are also exported as metrics; other values only
+// appear on the admin API. Implementations must be safe for concurrent use.
+type StateReporter interface {
+	PolicyState() map[string]interface{}
+}
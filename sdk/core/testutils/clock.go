@@ -0,0 +1,18 @@
+// Package testutils holds helpers shared by the tests of policies and SDK
+// packages. It is imported from _test.go files only.
+package testutils
+
+import (
+	"testing"
+	"time"
+)
+
+// PinClock replaces the clock function *now with one returning start for the
+// duration of a test. Tests advance the clock through the returned time.
+func PinClock(t *testing.T, now *func() time.Time, start time.Time) *time.Time {
+	t.Helper()
+	clock := start
+	*now = func() time.Time { return clock }
+	t.Cleanup(func() { *now = time.Now })
+	return &clock
+}
@@ -0,0 +1,312 @@
+package testutils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// FakeRedis is an in-process Redis server speaking enough RESP2 for the redis
+// backed stores: strings and counters (GET, INCR, INCRBY), sorted sets (ZADD,
+// ZCARD, ZPOPMIN, ZREMRANGEBYSCORE), DEL, EXPIRE, PEXPIREAT, PING and
+// MULTI/EXEC. Commands run one at a time, as on a real server, and keys
+// expire against the clock given to StartFakeRedis.
+type FakeRedis struct {
+	Addr string
+
+	now func() time.Time
+
+	mu     sync.Mutex
+	values map[string]string
+	zsets  map[string]map[string]float64
+	expiry map[string]time.Time
+}
+
+// StartFakeRedis starts a FakeRedis on a loopback port and stops it when the
+// test ends. now is called for every command, so it may follow a pinned clock.
+func StartFakeRedis(t *testing.T, now func() time.Time) *FakeRedis {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	f := &FakeRedis{
+		Addr:   ln.Addr().String(),
+		now:    now,
+		values: map[string]string{},
+		zsets:  map[string]map[string]float64{},
+		expiry: map[string]time.Time{},
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { ln.Close() })
+	return f
+}
+
+// Set stores a string value under key.
+func (f *FakeRedis) Set(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.values[key] = value
+}
+
+// ZAdd adds member to the sorted set at key.
+func (f *FakeRedis) ZAdd(key string, score float64, member string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.zaddLocked(key, score, member)
+}
+
+// Members returns the members of the sorted set at key, lowest score first.
+func (f *FakeRedis) Members(key string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sortedLocked(key)
+}
+
+// Exists reports whether key holds a string or a sorted set.
+func (f *FakeRedis) Exists(key string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expireLocked(key)
+	return f.exists(key)
+}
+
+// Keys returns the keys holding a string value, in lexical order.
+func (f *FakeRedis) Keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.values))
+	for key := range f.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ExpiryOf returns the expiry of key, or the zero time when it has none.
+func (f *FakeRedis) ExpiryOf(key string) time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.expiry[key]
+}
+
+// Expiries returns the expiries of all keys that have one.
+func (f *FakeRedis) Expiries() map[string]time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	expiries := make(map[string]time.Time, len(f.expiry))
+	for key, at := range f.expiry {
+		expiries[key] = at
+	}
+	return expiries
+}
+
+func (f *FakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	var queued [][]string
+	inTx := false
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		switch strings.ToUpper(args[0]) {
+		case "MULTI":
+			inTx, queued = true, nil
+			w.WriteString("+OK\r\n")
+		case "EXEC":
+			f.mu.Lock()
+			fmt.Fprintf(w, "*%d\r\n", len(queued))
+			for _, cmd := range queued {
+				f.execLocked(w, cmd)
+			}
+			f.mu.Unlock()
+			inTx = false
+		default:
+			if inTx {
+				queued = append(queued, args)
+				w.WriteString("+QUEUED\r\n")
+				break
+			}
+			f.mu.Lock()
+			f.execLocked(w, args)
+			f.mu.Unlock()
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// expireLocked drops key once its expiry has passed.
+func (f *FakeRedis) expireLocked(key string) {
+	if at, ok := f.expiry[key]; ok && !f.now().Before(at) {
+		delete(f.values, key)
+		delete(f.zsets, key)
+		delete(f.expiry, key)
+	}
+}
+
+func (f *FakeRedis) exists(key string) bool {
+	_, ok := f.values[key]
+	return ok || len(f.zsets[key]) > 0
+}
+
+func (f *FakeRedis) execLocked(w io.Writer, args []string) {
+	if len(args) > 1 {
+		f.expireLocked(args[1])
+	}
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		io.WriteString(w, "+PONG\r\n")
+	case "GET":
+		v, ok := f.values[args[1]]
+		if !ok {
+			io.WriteString(w, "$-1\r\n")
+			return
+		}
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(v), v)
+	case "INCR", "INCRBY":
+		by := int64(1)
+		if len(args) > 2 {
+			by, _ = strconv.ParseInt(args[2], 10, 64)
+		}
+		v, _ := strconv.ParseInt(f.values[args[1]], 10, 64)
+		v += by
+		f.values[args[1]] = strconv.FormatInt(v, 10)
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case "EXPIRE", "PEXPIREAT":
+		if !f.exists(args[1]) {
+			io.WriteString(w, ":0\r\n")
+			return
+		}
+		n, _ := strconv.ParseInt(args[2], 10, 64)
+		if strings.EqualFold(args[0], "EXPIRE") {
+			f.expiry[args[1]] = f.now().Add(time.Duration(n) * time.Second)
+		} else {
+			f.expiry[args[1]] = time.UnixMilli(n)
+		}
+		io.WriteString(w, ":1\r\n")
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if f.exists(key) {
+				delete(f.values, key)
+				delete(f.zsets, key)
+				delete(f.expiry, key)
+				deleted++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", deleted)
+	case "ZADD":
+		score, _ := strconv.ParseFloat(args[2], 64)
+		f.zaddLocked(args[1], score, args[3])
+		io.WriteString(w, ":1\r\n")
+	case "ZREMRANGEBYSCORE":
+		max, _ := strconv.ParseFloat(args[3], 64)
+		removed := 0
+		for member, score := range f.zsets[args[1]] {
+			if score <= max {
+				delete(f.zsets[args[1]], member)
+				removed++
+			}
+		}
+		fmt.Fprintf(w, ":%d\r\n", removed)
+	case "ZCARD":
+		fmt.Fprintf(w, ":%d\r\n", len(f.zsets[args[1]]))
+	case "ZPOPMIN":
+		count, _ := strconv.Atoi(args[2])
+		members := f.sortedLocked(args[1])
+		if count > len(members) {
+			count = len(members)
+		}
+		fmt.Fprintf(w, "*%d\r\n", 2*count)
+		for _, member := range members[:count] {
+			score := strconv.FormatFloat(f.zsets[args[1]][member], 'f', -1, 64)
+			fmt.Fprintf(w, "$%d\r\n%s\r\n$%d\r\n%s\r\n", len(member), member, len(score), score)
+			delete(f.zsets[args[1]], member)
+		}
+	default:
+		// HELLO and CLIENT SETINFO are refused, so the client falls back to
+		// plain RESP2.
+		fmt.Fprintf(w, "-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+func (f *FakeRedis) zaddLocked(key string, score float64, member string) {
+	if f.zsets[key] == nil {
+		f.zsets[key] = map[string]float64{}
+	}
+	f.zsets[key][member] = score
+}
+
+// sortedLocked returns the members of a sorted set, lowest score first and
+// ties in lexical order, as Redis orders them.
+func (f *FakeRedis) sortedLocked(key string) []string {
+	members := make([]string, 0, len(f.zsets[key]))
+	for member := range f.zsets[key] {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		si, sj := f.zsets[key][members[i]], f.zsets[key][members[j]]
+		if si != sj {
+			return si < sj
+		}
+		return members[i] < members[j]
+	})
+	return members
+}
+
+// readCommand reads one command sent as an array of bulk strings.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected line %q", line)
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("bad array length %q", line)
+	}
+	args := make([]string, n)
+	for i := range args {
+		header, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimPrefix(header, "$"))
+		if err != nil {
+			return nil, fmt.Errorf("bad bulk length %q", header)
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	return strings.TrimSuffix(line, "\r\n"), err
+}
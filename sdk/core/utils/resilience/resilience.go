@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package resilience guards calls from policies to external providers, such as
+// embedding services, vector databases and guardrail services, with per-attempt
+// timeouts, limited retries with backoff and a circuit breaker.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// Defaults applied by NewCaller to unset Config fields.
+const (
+	DefaultTimeout          = 5 * time.Second
+	DefaultMaxRetries       = 2
+	DefaultInitialBackoff   = 100 * time.Millisecond
+	DefaultMaxBackoff       = 2 * time.Second
+	DefaultFailureThreshold = 5
+	DefaultOpenDuration     = 30 * time.Second
+)
+
+// Outcomes reported to the LatencyObserver.
+const (
+	OutcomeSuccess  = "success"
+	OutcomeError    = "error"
+	OutcomeTimeout  = "timeout"
+	OutcomeRejected = "rejected" // the provider answered but refused the call
+)
+
+// Circuit states reported by Caller.State.
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half-open"
+)
+
+// ErrCircuitOpen is returned without calling the provider while the circuit is
+// open.
+var ErrCircuitOpen = errors.New("provider circuit is open")
+
+// Config tunes a Caller.
+type Config struct {
+	// Timeout bounds each attempt.
+	Timeout time.Duration
+	// MaxRetries is the number of attempts after the first one. Negative
+	// values disable retries; zero selects DefaultMaxRetries.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry. It doubles on every
+	// further retry up to MaxBackoff, with jitter.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// FailureThreshold is the number of consecutive failed calls that opens
+	// the circuit.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a single probe
+	// call is let through.
+	OpenDuration time.Duration
+}
+
+// LatencyObserver receives the duration of every attempt, labelled with the
+// provider name and an Outcome constant.
+type LatencyObserver func(provider, outcome string, d time.Duration)
+
+var (
+	observerMu sync.RWMutex
+	observer   LatencyObserver
+)
+
+// SetLatencyObserver registers the observer of provider call latencies. The
+// policy engine registers one that feeds a histogram metric.
+func SetLatencyObserver(o LatencyObserver) {
+	observerMu.Lock()
+	defer observerMu.Unlock()
+	observer = o
+}
+
+func observe(provider, outcome string, d time.Duration) {
+	observerMu.RLock()
+	o := observer
+	observerMu.RUnlock()
+	if o != nil {
+		o(provider, outcome, d)
+	}
+}
+
+// permanentError marks an error that is not worth retrying.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as a refusal by a reachable provider, such as a 4xx
+// response or a cache miss. Such errors are not retried and do not count
+// towards opening the circuit. Caller.Do returns the unwrapped error.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Caller runs calls to one provider. It is safe for concurrent use; share one
+// Caller between all calls to the same provider so the circuit sees them all.
+type Caller struct {
+	name string
+	cfg  Config
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewCaller returns a Caller for the named provider. The name labels the
+// latency metric, so it should identify the kind of provider rather than a
+// tenant or an endpoint.
+func NewCaller(name string, cfg Config) *Caller {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	} else if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.MaxBackoff < cfg.InitialBackoff {
+		cfg.MaxBackoff = cfg.InitialBackoff
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.OpenDuration <= 0 {
+		cfg.OpenDuration = DefaultOpenDuration
+	}
+	return &Caller{name: name, cfg: cfg}
+}
+
+// Config returns the effective configuration, with defaults applied.
+func (c *Caller) Config() Config {
+	return c.cfg
+}
+
+// Do runs fn, retrying failed attempts with backoff. Each attempt gets a
+// context bounded by the configured timeout. Do returns ErrCircuitOpen without
+// calling fn while the circuit is open.
+func (c *Caller) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.run(ctx, c.cfg.MaxRetries, fn)
+}
+
+// Once runs fn like Do but without retries, for calls that are not safe to
+// repeat, such as inserts.
+func (c *Caller) Once(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.run(ctx, 0, fn)
+}
+
+// State returns the state of the circuit.
+func (c *Caller) State() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.failures < c.cfg.FailureThreshold:
+		return StateClosed
+	case time.Now().Before(c.openUntil):
+		return StateOpen
+	default:
+		return StateHalfOpen
+	}
+}
+
+func (c *Caller) run(ctx context.Context, retries int, fn func(ctx context.Context) error) error {
+	probe, ok := c.acquire()
+	if !ok {
+		return ErrCircuitOpen
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		start := time.Now()
+		err = fn(attemptCtx)
+		timedOut := errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil
+		cancel()
+
+		var permanent *permanentError
+		switch {
+		case err == nil:
+			observe(c.name, OutcomeSuccess, time.Since(start))
+			c.release(probe, true)
+			return nil
+		case errors.As(err, &permanent):
+			observe(c.name, OutcomeRejected, time.Since(start))
+			c.release(probe, true)
+			return permanent.err
+		case timedOut:
+			observe(c.name, OutcomeTimeout, time.Since(start))
+		default:
+			observe(c.name, OutcomeError, time.Since(start))
+		}
+
+		// A probe gets a single attempt so a dead provider is not hammered
+		if probe || attempt >= retries || ctx.Err() != nil {
+			c.release(probe, false)
+			return err
+		}
+		timer := time.NewTimer(c.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			c.release(probe, false)
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// acquire reports whether a call may proceed and whether it is the single
+// probe let through a circuit whose open period has elapsed.
+func (c *Caller) acquire() (probe, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures < c.cfg.FailureThreshold {
+		return false, true
+	}
+	if c.probing || time.Now().Before(c.openUntil) {
+		return false, false
+	}
+	c.probing = true
+	return true, true
+}
+
+// release records the result of a call. A successful call closes the circuit;
+// a failure that reaches the threshold, or a failed probe, opens it again.
+func (c *Caller) release(probe, success bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if probe {
+		c.probing = false
+	}
+	if success {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= c.cfg.FailureThreshold {
+		c.openUntil = time.Now().Add(c.cfg.OpenDuration)
+	}
+}
+
+// backoff returns the wait before retry number attempt+1: exponential from
+// InitialBackoff, capped at MaxBackoff, with up to half of it randomized so
+// concurrent callers do not retry in lockstep.
+func (c *Caller) backoff(attempt int) time.Duration {
+	d := c.cfg.InitialBackoff << attempt
+	if d <= 0 || d > c.cfg.MaxBackoff {
+		d = c.cfg.MaxBackoff
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
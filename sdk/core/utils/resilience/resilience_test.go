@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordObserver captures observed outcomes for the duration of a test.
+func recordObserver(t *testing.T) func() []string {
+	t.Helper()
+	var mu sync.Mutex
+	var outcomes []string
+	SetLatencyObserver(func(provider, outcome string, d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		outcomes = append(outcomes, provider+":"+outcome)
+	})
+	t.Cleanup(func() { SetLatencyObserver(nil) })
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), outcomes...)
+	}
+}
+
+// slowServer answers after delay, or immediately once fast is set.
+func slowServer(t *testing.T, delay time.Duration, fast *atomic.Bool) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if fast == nil || !fast.Load() {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func get(url string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return Permanent(fmt.Errorf("status %d", resp.StatusCode))
+		}
+		return nil
+	}
+}
+
+func TestTimeoutAndRetries(t *testing.T) {
+	outcomes := recordObserver(t)
+	srv, calls := slowServer(t, time.Second, nil)
+	c := NewCaller("slow", Config{Timeout: 20 * time.Millisecond, MaxRetries: 2, InitialBackoff: time.Millisecond})
+
+	start := time.Now()
+	err := c.Do(context.Background(), get(srv.URL))
+	if err == nil {
+		t.Fatal("expected the slow provider to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Do took %v; attempts were not bounded by the timeout", elapsed)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3 (1 attempt + 2 retries)", calls.Load())
+	}
+	want := []string{"slow:timeout", "slow:timeout", "slow:timeout"}
+	if got := outcomes(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("outcomes = %v, want %v", got, want)
+	}
+}
+
+func TestOnceDoesNotRetry(t *testing.T) {
+	srv, calls := slowServer(t, time.Second, nil)
+	c := NewCaller("slow", Config{Timeout: 10 * time.Millisecond, MaxRetries: 3})
+	if err := c.Once(context.Background(), get(srv.URL)); err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestPermanentErrorsAreNotRetried(t *testing.T) {
+	outcomes := recordObserver(t)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+	c := NewCaller("auth", Config{FailureThreshold: 1})
+
+	for i := 0; i < 3; i++ {
+		err := c.Do(context.Background(), get(srv.URL))
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) {
+			t.Fatalf("err = %#v, want the unwrapped provider error", err)
+		}
+	}
+	if calls.Load() != 3 || c.State() != StateClosed {
+		t.Errorf("calls = %d, state = %s; refusals must not be retried or open the circuit", calls.Load(), c.State())
+	}
+	if got := outcomes(); len(got) != 3 || got[0] != "auth:rejected" {
+		t.Errorf("outcomes = %v", got)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var fast atomic.Bool
+	srv, calls := slowServer(t, time.Second, &fast)
+	c := NewCaller("dead", Config{
+		Timeout:          10 * time.Millisecond,
+		MaxRetries:       -1,
+		FailureThreshold: 2,
+		OpenDuration:     50 * time.Millisecond,
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := c.Do(context.Background(), get(srv.URL)); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d: err = %v, want a timeout", i, err)
+		}
+	}
+	if c.State() != StateOpen {
+		t.Fatalf("state = %s, want open", c.State())
+	}
+
+	// While open, calls fail fast without reaching the provider
+	before := calls.Load()
+	start := time.Now()
+	if err := c.Do(context.Background(), get(srv.URL)); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if calls.Load() != before || time.Since(start) > 5*time.Millisecond {
+		t.Error("open circuit still called the provider")
+	}
+
+	// After the open period a successful probe closes the circuit
+	time.Sleep(60 * time.Millisecond)
+	if c.State() != StateHalfOpen {
+		t.Fatalf("state = %s, want half-open", c.State())
+	}
+	fast.Store(true)
+	if err := c.Do(context.Background(), get(srv.URL)); err != nil {
+		t.Fatalf("probe err = %v", err)
+	}
+	if c.State() != StateClosed {
+		t.Errorf("state = %s, want closed", c.State())
+	}
+}
+
+func TestFailedProbeReopensCircuit(t *testing.T) {
+	srv, calls := slowServer(t, time.Second, nil)
+	c := NewCaller("dead", Config{Timeout: 10 * time.Millisecond, MaxRetries: 3, FailureThreshold: 1, OpenDuration: 20 * time.Millisecond})
+
+	_ = c.Do(context.Background(), get(srv.URL))
+	time.Sleep(30 * time.Millisecond)
+	before := calls.Load()
+	if err := c.Do(context.Background(), get(srv.URL)); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("probe err = %v, want a timeout", err)
+	}
+	if calls.Load() != before+1 {
+		t.Errorf("probe made %d calls, want 1", calls.Load()-before)
+	}
+	if c.State() != StateOpen {
+		t.Errorf("state = %s, want open after a failed probe", c.State())
+	}
+}
+
+func TestCancelledContextStopsRetries(t *testing.T) {
+	c := NewCaller("p", Config{MaxRetries: 5, InitialBackoff: time.Second})
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	start := time.Now()
+	err := c.Do(ctx, func(context.Context) error {
+		calls++
+		cancel()
+		return errors.New("boom")
+	})
+	if err == nil || calls != 1 || time.Since(start) > 100*time.Millisecond {
+		t.Errorf("err = %v, calls = %d after cancel", err, calls)
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	c := NewCaller("p", Config{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		for i := 0; i < 20; i++ {
+			if d := c.backoff(attempt); d < max/2 || d > max {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", attempt, d, max/2, max)
+			}
+		}
+	}
+	if d := c.backoff(100); d > time.Second {
+		t.Errorf("backoff(100) = %v overflowed the cap", d)
+	}
+}
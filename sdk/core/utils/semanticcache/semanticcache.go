@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package semanticcache reports the outcome of semantic cache lookups to the
+// policy engine, which exports them as metrics. It lives in the core SDK so the
+// engine does not depend on the embedding and vector DB clients.
+package semanticcache
+
+import "sync"
+
+// Lookup results reported to the LookupObserver.
+const (
+	ResultHit  = "hit"
+	ResultMiss = "miss"
+	// ResultDegraded is a lookup that could not run because the embedding
+	// provider or vector DB failed. The request goes to the upstream as if
+	// the cache were disabled.
+	ResultDegraded = "degraded"
+)
+
+// LookupObserver receives the result of every semantic cache lookup.
+type LookupObserver func(result string)
+
+var (
+	observerMu sync.RWMutex
+	observer   LookupObserver
+)
+
+// SetLookupObserver registers the observer of lookup results. The policy
+// engine registers one that feeds a counter metric.
+func SetLookupObserver(o LookupObserver) {
+	observerMu.Lock()
+	defer observerMu.Unlock()
+	observer = o
+}
+
+// ObserveLookup reports the result of a lookup to the registered observer.
+func ObserveLookup(result string) {
+	observerMu.RLock()
+	o := observer
+	observerMu.RUnlock()
+	if o != nil {
+		o(result)
+	}
+}
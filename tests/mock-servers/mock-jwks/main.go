@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
@@ -17,6 +19,9 @@ var (
 	privateKey *rsa.PrivateKey
 	signer     jose.Signer
 	jwkSet     jose.JSONWebKeySet
+
+	// jwksDown makes /jwks fail, to simulate an unreachable identity provider
+	jwksDown atomic.Bool
 )
 
 type tokenClaims struct {
@@ -52,6 +57,10 @@ func init() {
 }
 
 func jwksHandler(w http.ResponseWriter, r *http.Request) {
+	if jwksDown.Load() {
+		http.Error(w, "JWKS endpoint is down", http.StatusServiceUnavailable)
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(jwkSet)
 }
@@ -88,9 +97,27 @@ func tokenHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(raw))
 }
 
+// jwksStateHandler takes /jwks down and brings it back up:
+// POST /jwks/state?down=true or POST /jwks/state?down=false
+func jwksStateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	down, err := strconv.ParseBool(r.URL.Query().Get("down"))
+	if err != nil {
+		http.Error(w, "down must be true or false", http.StatusBadRequest)
+		return
+	}
+	jwksDown.Store(down)
+	log.Printf("JWKS endpoint down: %t", down)
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func main() {
 	http.HandleFunc("/jwks", jwksHandler)
 	http.HandleFunc("/token", tokenHandler)
+	http.HandleFunc("/jwks/state", jwksStateHandler)
 
 	log.Println("Mock JWKS server listening on :8080")
 	if err := http.ListenAndServe(":8080", nil); err != nil {